@@ -0,0 +1,128 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+)
+
+func TestHardEndBlockerEmitsBlockStats(t *testing.T) {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	tApp := app.TestApp{App: *app.NewApp(log.NewNopLogger(), db.NewMemDB(), nil, app.AppOptions{})}
+	tApp.InitializeFromGenesisStatesWithTime(genTime, genesis.NewBuilder().
+		WithAccountBalance(depositor, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6))).
+		WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+		WithHardMarket(hard.NewMoneyMarket("ukava", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(1e6), zeroInterestRateModel(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		Build(),
+	)
+
+	header := abci.Header{Height: tApp.LastBlockHeight() + 1, Time: genTime}
+	ctx := tApp.NewContext(false, header)
+	require.NoError(t, tApp.GetHardKeeper().Deposit(ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10e6))))
+
+	res := tApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.True(t, hasABCIEvent(res.Events, hard.EventTypeHardBlockStats))
+}
+
+func TestHardEndBlockerAppliesStopLosses(t *testing.T) {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	tApp := app.TestApp{App: *app.NewApp(log.NewNopLogger(), db.NewMemDB(), nil, app.AppOptions{})}
+	tApp.InitializeFromGenesisStatesWithTime(genTime, genesis.NewBuilder().
+		WithAccountBalance(borrower, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100e6), sdk.NewInt64Coin("usdx", 5e6))).
+		WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+		WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+		WithHardMarket(hard.NewMoneyMarket("usdx", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(1e6), zeroInterestRateModel(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		WithHardMarket(hard.NewMoneyMarket("ukava", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(1e6), zeroInterestRateModel(), sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		WithModuleAccountBalance(hard.ModuleAccountName, sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000e6))).
+		Build(),
+	)
+
+	header := abci.Header{Height: tApp.LastBlockHeight() + 1, Time: genTime}
+	ctx := tApp.NewContext(false, header)
+	hardKeeper := tApp.GetHardKeeper()
+	require.NoError(t, hardKeeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10e6), sdk.NewInt64Coin("usdx", 5e6))))
+	require.NoError(t, hardKeeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewInt64Coin("usdx", 15e6))))
+	require.NoError(t, hardKeeper.RegisterStopLoss(ctx, hard.NewStopLoss(borrower, sdk.MustNewDecFromStr("0.5"), sdk.NewDec(3))))
+
+	res := tApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.True(t, hasABCIEvent(res.Events, hard.EventTypeHardStopLoss))
+
+	borrow, found := hardKeeper.GetBorrow(tApp.NewContext(false, header), borrower)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdx", 12e6)), borrow.Amount)
+}
+
+func TestAuctionEndBlockerMatchesAutoBids(t *testing.T) {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	buyer := sdk.AccAddress(crypto.AddressHash([]byte("buyer")))
+	returnAddr := sdk.AccAddress(crypto.AddressHash([]byte("returnAddr")))
+	auctionGS := auction.DefaultGenesisState()
+	auctionGS.Params.AutoBidDenoms = auction.AutoBidDenoms{
+		auction.NewAutoBidDenom("bnb", "bnb:usd", sdk.OneInt()),
+		auction.NewAutoBidDenom("usdx", "usdx:usd", sdk.OneInt()),
+	}
+	tApp := app.TestApp{App: *app.NewApp(log.NewNopLogger(), db.NewMemDB(), nil, app.AppOptions{})}
+	tApp.InitializeFromGenesisStatesWithTime(genTime,
+		genesis.NewBuilder().
+			WithAccountBalance(buyer, sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000))).
+			WithPricefeedPrice("bnb:usd", sdk.MustNewDecFromStr("10.00")).
+			WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+			Build(),
+		app.GenesisState{auction.ModuleName: auction.ModuleCdc.MustMarshalJSON(auctionGS)},
+	)
+
+	// the first block only records the auto-bid cursor
+	tApp.EndBlock(abci.RequestEndBlock{Height: tApp.LastBlockHeight() + 1})
+	tApp.Commit()
+
+	header := abci.Header{Height: tApp.LastBlockHeight() + 1, Time: genTime.Add(time.Minute)}
+	tApp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := tApp.NewContext(false, header)
+	auctionKeeper := tApp.GetAuctionKeeper()
+	require.NoError(t, auctionKeeper.DepositEscrow(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin("usdx", 500))))
+	_, err := auctionKeeper.RegisterAutoBid(ctx, buyer, auction.CollateralAuctionType, "bnb", sdk.MustNewDecFromStr("0.9"), sdk.NewInt64Coin("usdx", 300))
+	require.NoError(t, err)
+	require.NoError(t, tApp.GetSupplyKeeper().MintCoins(ctx, cdp.LiquidatorMacc, sdk.NewCoins(sdk.NewInt64Coin("bnb", 10), sdk.NewInt64Coin("debt", 200))))
+	auctionID, err := auctionKeeper.StartCollateralAuction(ctx, cdp.LiquidatorMacc, sdk.NewInt64Coin("bnb", 10), sdk.NewInt64Coin("usdx", 200),
+		[]sdk.AccAddress{returnAddr}, []sdk.Int{sdk.OneInt()}, sdk.NewInt64Coin("debt", 200))
+	require.NoError(t, err)
+
+	res := tApp.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	require.True(t, hasABCIEvent(res.Events, auction.EventTypeAutoBid))
+
+	// the lot is worth 100 usdx, so the auto-bid bids 90
+	a, found := auctionKeeper.GetAuction(tApp.NewContext(false, header), auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, a.GetBidder())
+	require.Equal(t, sdk.NewInt64Coin("usdx", 90), a.GetBid())
+}
+
+func zeroInterestRateModel() hard.InterestRateModel {
+	return hard.NewInterestRateModel(sdk.ZeroDec(), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+}
+
+func hasABCIEvent(events []abci.Event, eventType string) bool {
+	for _, event := range events {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -4,11 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // NewAnteHandler returns an 'AnteHandler' that will run actions before a tx is sent to a module's handler.
-func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer ante.SignatureVerificationGasConsumer, addressFetchers ...AddressFetcher) sdk.AnteHandler {
+// Ante handler params are read from the param subspace, which must have the ante ParamKeyTable.
+// The mempool priority config is local to the node, so it only affects CheckTx.
+func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper SupplyKeeper, pricefeedKeeper PricefeedKeeper, circuitKeeper CircuitKeeper, paramSubspace params.Subspace, priorityConfig MempoolPriorityConfig, sigGasConsumer ante.SignatureVerificationGasConsumer, addressFetchers ...AddressFetcher) sdk.AnteHandler {
 	decorators := []sdk.AnteDecorator{}
 
 	decorators = append(decorators, ante.NewSetUpContextDecorator()) // outermost AnteDecorator. SetUpContext must be called first
@@ -17,13 +19,17 @@ func NewAnteHandler(ak keeper.AccountKeeper, supplyKeeper types.SupplyKeeper, si
 		decorators = append(decorators, NewAuthenticatedMempoolDecorator(addressFetchers...))
 	}
 	decorators = append(decorators,
-		ante.NewMempoolFeeDecorator(),
+		NewMempoolPriorityDecorator(priorityConfig),
+		NewCircuitBreakerDecorator(circuitKeeper),
+		NewStableFeeDecorator(pricefeedKeeper, paramSubspace), // replaces the sdk MempoolFeeDecorator
 		ante.NewValidateBasicDecorator(),
 		ante.NewValidateMemoDecorator(ak),
 		ante.NewConsumeGasForTxSizeDecorator(ak),
+		NewGasSurchargeDecorator(paramSubspace),
 		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(ak),
 		ante.NewDeductFeeDecorator(ak, supplyKeeper),
+		NewOracleFeeWaiverDecorator(pricefeedKeeper, supplyKeeper, paramSubspace), // must run after fees are deducted
 		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
 		ante.NewSigVerificationDecorator(ak),
 		ante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
@@ -179,6 +179,8 @@ func newBep3GenStateMulti(deputyAddress sdk.AccAddress) app.GenesisState {
 					MaxBlockLock:  bep3.DefaultMaxBlockLock,
 				},
 			},
+			LongtermStorageDuration: bep3.DefaultLongtermStorageDuration,
+			MaxPrunedSwapsPerBlock:  bep3.DefaultMaxPrunedSwapsPerBlock,
 		},
 		Supplies: bep3.AssetSupplies{
 			bep3.NewAssetSupply(
@@ -0,0 +1,34 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	circuittypes "github.com/kava-labs/kava/x/circuit/types"
+)
+
+// CircuitKeeper defines the expected circuit keeper used to check for disabled messages
+type CircuitKeeper interface {
+	IsMsgDisabled(ctx sdk.Context, msg sdk.Msg) bool
+}
+
+// CircuitBreakerDecorator rejects txs that contain a message disabled in the circuit params.
+// It runs in both CheckTx and DeliverTx so disabled messages can neither enter the mempool nor be included in a block.
+type CircuitBreakerDecorator struct {
+	circuitKeeper CircuitKeeper
+}
+
+func NewCircuitBreakerDecorator(ck CircuitKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	for _, msg := range tx.GetMsgs() {
+		if cbd.circuitKeeper.IsMsgDisabled(ctx, msg) {
+			return ctx, sdkerrors.Wrapf(circuittypes.ErrMsgDisabled, "%s/%s", msg.Route(), msg.Type())
+		}
+	}
+	return next(ctx, tx, simulate)
+}
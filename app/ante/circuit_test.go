@@ -0,0 +1,54 @@
+package ante_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/ante"
+	"github.com/kava-labs/kava/x/circuit"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	circuitKeeper := tApp.GetCircuitKeeper()
+	decorator := ante.NewCircuitBreakerDecorator(circuitKeeper)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	liquidate := hardtypes.NewMsgLiquidate(addrs[0], addrs[1])
+	withdraw := hardtypes.NewMsgWithdraw(addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))
+	send := bank.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))
+	handle := func(msgs ...sdk.Msg) error {
+		_, err := decorator.AnteHandle(ctx, auth.NewStdTx(msgs, auth.NewStdFee(1000000, nil), nil, ""), false, next)
+		return err
+	}
+
+	require.NoError(t, handle(liquidate, withdraw, send))
+
+	// disable a single message type
+	circuitKeeper.SetParams(ctx, circuit.NewParams(circuit.DisabledMsgs{circuit.NewDisabledMsg(hardtypes.RouterKey, "liquidate")}))
+	require.True(t, errors.Is(handle(liquidate), circuit.ErrMsgDisabled))
+	require.True(t, errors.Is(handle(send, liquidate), circuit.ErrMsgDisabled))
+	require.NoError(t, handle(withdraw, send))
+
+	// disable every message on a route
+	circuitKeeper.SetParams(ctx, circuit.NewParams(circuit.DisabledMsgs{circuit.NewDisabledMsg(hardtypes.RouterKey, "")}))
+	require.True(t, errors.Is(handle(withdraw), circuit.ErrMsgDisabled))
+	require.NoError(t, handle(send))
+
+	// re-enable
+	circuitKeeper.SetParams(ctx, circuit.DefaultParams())
+	require.NoError(t, handle(liquidate, withdraw, send))
+}
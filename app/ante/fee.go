@@ -0,0 +1,102 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// PricefeedKeeper defines the expected pricefeed keeper used to price fee denoms and waive oracle fees
+type PricefeedKeeper interface {
+	GetCurrentPrice(ctx sdk.Context, marketID string) (pricefeedtypes.CurrentPrice, error)
+	GetMarket(ctx sdk.Context, marketID string) (pricefeedtypes.Market, bool)
+	GetOracle(ctx sdk.Context, marketID string, address sdk.AccAddress) (sdk.AccAddress, error)
+	GetFeeWaiverCount(ctx sdk.Context, marketID string) uint64
+	IncrementFeeWaiverCount(ctx sdk.Context, marketID string)
+}
+
+// StableFeeDecorator checks that the fee of a tx covers the validator's minimum gas prices, like the sdk
+// MempoolFeeDecorator, but also accepts fees paid in the stable denoms of the fee denom params. Stable denom fees are
+// converted to the base denom at the current oracle prices, and are paid to validators in the stable denom.
+// It only runs before entry to mempool (CheckTx), and not in consensus (DeliverTx).
+type StableFeeDecorator struct {
+	pricefeedKeeper PricefeedKeeper
+	paramSubspace   params.Subspace
+}
+
+func NewStableFeeDecorator(pk PricefeedKeeper, paramSubspace params.Subspace) StableFeeDecorator {
+	return StableFeeDecorator{
+		pricefeedKeeper: pk,
+		paramSubspace:   paramSubspace,
+	}
+}
+
+func (sfd StableFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	if ctx.IsCheckTx() && !simulate {
+		minGasPrices := ctx.MinGasPrices()
+		if !minGasPrices.IsZero() {
+			requiredFees := make(sdk.Coins, len(minGasPrices))
+
+			// fee = ceil(minGasPrice * gasLimit), as in the sdk MempoolFeeDecorator
+			glDec := sdk.NewDec(int64(gas))
+			for i, gp := range minGasPrices {
+				fee := gp.Amount.Mul(glDec)
+				requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+			}
+
+			if !feeCoins.IsAnyGTE(requiredFees) && !sfd.convertStableFees(ctx, feeCoins).IsAnyGTE(requiredFees) {
+				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// convertStableFees returns the fee coins with the value of any stable denom fees added to the base denom. Stable
+// denoms that cannot be priced are not converted.
+func (sfd StableFeeDecorator) convertStableFees(ctx sdk.Context, feeCoins sdk.Coins) sdk.Coins {
+	var p FeeDenomParams
+	sfd.paramSubspace.GetIfExists(ctx, KeyFeeDenoms, &p)
+	if len(p.StableDenoms) == 0 {
+		return feeCoins
+	}
+	basePrice, err := sfd.pricefeedKeeper.GetCurrentPrice(ctx, p.BaseDenom.MarketID)
+	if err != nil || !basePrice.Price.IsPositive() {
+		return feeCoins
+	}
+
+	converted := sdk.ZeroDec()
+	for _, fd := range p.StableDenoms {
+		amount := feeCoins.AmountOf(fd.Denom)
+		if amount.IsZero() {
+			continue
+		}
+		price, err := sfd.pricefeedKeeper.GetCurrentPrice(ctx, fd.MarketID)
+		if err != nil {
+			continue
+		}
+		value := amount.ToDec().Mul(price.Price).Quo(conversionMultiplier(fd.ConversionFactor))
+		converted = converted.Add(value)
+	}
+	baseAmount := converted.Mul(conversionMultiplier(p.BaseDenom.ConversionFactor)).Quo(basePrice.Price).TruncateInt()
+	if baseAmount.IsZero() {
+		return feeCoins
+	}
+	return feeCoins.Add(sdk.NewCoin(p.BaseDenom.Denom, baseAmount))
+}
+
+// conversionMultiplier returns the number of base units in one unit of a denom with the input number of decimals
+func conversionMultiplier(conversionFactor sdk.Int) sdk.Dec {
+	return sdk.NewDec(10).Power(conversionFactor.Uint64())
+}
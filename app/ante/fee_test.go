@@ -0,0 +1,76 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/ante"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestStableFeeDecorator(t *testing.T) {
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime}).
+		WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("ukava", sdk.MustNewDecFromStr("0.25"))))
+
+	subspace, found := tApp.GetParamsKeeper().GetSubspace(ante.DefaultParamspace)
+	require.True(t, found)
+	decorator := ante.NewStableFeeDecorator(tApp.GetPriceFeedKeeper(), subspace)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+	checkFee := func(fee sdk.Coins) error {
+		tx := auth.NewStdTx(nil, auth.NewStdFee(100000, fee), nil, "")
+		_, err := decorator.AnteHandle(ctx, tx, false, next)
+		return err
+	}
+
+	// required fee is 25000ukava, worth $0.05
+	require.NoError(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("ukava", 25000))))
+	require.Error(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("usdx", 50000))), "stable denoms are not accepted without params")
+
+	params := ante.NewFeeDenomParams(
+		ante.NewFeeDenom("ukava", "kava:usd", sdk.NewInt(6)),
+		ante.FeeDenoms{ante.NewFeeDenom("usdx", "usdx:usd", sdk.NewInt(6))},
+	)
+	subspace.Set(ctx, ante.KeyFeeDenoms, params)
+
+	require.NoError(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("usdx", 50000))))
+	require.Error(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("usdx", 49999))))
+	require.Error(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000000))))
+	// fees can be split between the base and stable denoms
+	require.NoError(t, checkFee(sdk.NewCoins(sdk.NewInt64Coin("ukava", 12500), sdk.NewInt64Coin("usdx", 25000))))
+
+	// fees are only checked before entry to the mempool
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(false), auth.NewStdTx(nil, auth.NewStdFee(100000, nil), nil, ""), false, next)
+	require.NoError(t, err)
+}
+
+func TestFeeDenomParamsValidate(t *testing.T) {
+	base := ante.NewFeeDenom("ukava", "kava:usd", sdk.NewInt(6))
+	require.NoError(t, ante.NewFeeDenomParams(base, ante.FeeDenoms{ante.NewFeeDenom("usdx", "usdx:usd", sdk.NewInt(6))}).Validate())
+	require.Error(t, ante.NewFeeDenomParams(base, ante.FeeDenoms{ante.NewFeeDenom("ukava", "kava:usd", sdk.NewInt(6))}).Validate())
+	require.Error(t, ante.NewFeeDenomParams(base, ante.FeeDenoms{ante.NewFeeDenom("usdx", "", sdk.NewInt(6))}).Validate())
+	require.Error(t, ante.NewFeeDenomParams(base, ante.FeeDenoms{ante.NewFeeDenom("usdx", "usdx:usd", sdk.NewInt(-1))}).Validate())
+	require.Error(t, ante.NewFeeDenomParams(ante.FeeDenom{}, nil).Validate())
+}
@@ -0,0 +1,88 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SupplyKeeper defines the expected supply keeper used to deduct and refund fees
+type SupplyKeeper interface {
+	authtypes.SupplyKeeper
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// OracleFeeWaiverDecorator refunds the fee of a tx that only posts prices, when every post is by an oracle of an active
+// market with a fee waiver and the market has waived fewer posts than its limit in the current block. Fees must still
+// be paid to enter the mempool, so the waiver cannot be used to flood it.
+// It must run after the fee is deducted, and runs in both CheckTx and DeliverTx so account balances agree.
+type OracleFeeWaiverDecorator struct {
+	pricefeedKeeper PricefeedKeeper
+	supplyKeeper    SupplyKeeper
+	paramSubspace   params.Subspace
+}
+
+func NewOracleFeeWaiverDecorator(pk PricefeedKeeper, sk SupplyKeeper, paramSubspace params.Subspace) OracleFeeWaiverDecorator {
+	return OracleFeeWaiverDecorator{
+		pricefeedKeeper: pk,
+		supplyKeeper:    sk,
+		paramSubspace:   paramSubspace,
+	}
+}
+
+func (ofd OracleFeeWaiverDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	fee := feeTx.GetFee()
+	if fee.IsZero() || !ofd.isWaived(ctx, tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	if err := ofd.supplyKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, feeTx.FeePayer(), fee); err != nil {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to refund oracle fee: %s", err)
+	}
+	for _, msg := range tx.GetMsgs() {
+		ofd.pricefeedKeeper.IncrementFeeWaiverCount(ctx, msg.(pricefeedtypes.MsgPostPrice).MarketID)
+	}
+	return next(ctx, tx, simulate)
+}
+
+// isWaived returns true if all messages are price posts eligible for a fee waiver
+func (ofd OracleFeeWaiverDecorator) isWaived(ctx sdk.Context, msgs []sdk.Msg) bool {
+	var waivers OracleFeeWaivers
+	ofd.paramSubspace.GetIfExists(ctx, KeyOracleFeeWaivers, &waivers)
+	if len(waivers) == 0 || len(msgs) == 0 {
+		return false
+	}
+
+	// a tx may post to a market more than once, so waived posts are counted per market
+	posts := make(map[string]uint64)
+	for _, msg := range msgs {
+		postPrice, ok := msg.(pricefeedtypes.MsgPostPrice)
+		if !ok {
+			return false
+		}
+		waiver, found := waivers.Get(postPrice.MarketID)
+		if !found {
+			return false
+		}
+		market, found := ofd.pricefeedKeeper.GetMarket(ctx, postPrice.MarketID)
+		if !found || !market.Active {
+			return false
+		}
+		if _, err := ofd.pricefeedKeeper.GetOracle(ctx, postPrice.MarketID, postPrice.From); err != nil {
+			return false
+		}
+		posts[postPrice.MarketID]++
+		if ofd.pricefeedKeeper.GetFeeWaiverCount(ctx, postPrice.MarketID)+posts[postPrice.MarketID] > waiver.MaxPerBlock {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package ante_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/ante"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestOracleFeeWaiverDecorator(t *testing.T) {
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	oracle, other := addrs[0], addrs[1]
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: false},
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime,
+		app.NewAuthGenState(addrs, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000)), sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000))}),
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: blockTime})
+
+	subspace, found := tApp.GetParamsKeeper().GetSubspace(ante.DefaultParamspace)
+	require.True(t, found)
+	sk := tApp.GetSupplyKeeper()
+	decorator := ante.NewOracleFeeWaiverDecorator(tApp.GetPriceFeedKeeper(), sk, subspace)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))
+	postPrice := func(from sdk.AccAddress, marketID string) sdk.Msg {
+		return pricefeed.NewMsgPostPrice(from, marketID, sdk.OneDec(), blockTime.Add(time.Hour))
+	}
+	// deducts the fee as the DeductFeeDecorator would, then returns the fee refunded to the sender
+	refunded := func(sender sdk.AccAddress, msgs ...sdk.Msg) sdk.Coins {
+		require.NoError(t, sk.SendCoinsFromAccountToModule(ctx, sender, auth.FeeCollectorName, fee))
+		before := tApp.GetAccountKeeper().GetAccount(ctx, sender).GetCoins()
+		_, err := decorator.AnteHandle(ctx, auth.NewStdTx(msgs, auth.NewStdFee(100000, fee), nil, ""), false, next)
+		require.NoError(t, err)
+		return tApp.GetAccountKeeper().GetAccount(ctx, sender).GetCoins().Sub(before)
+	}
+
+	require.True(t, refunded(oracle, postPrice(oracle, "kava:usd")).IsZero(), "no waiver without params")
+
+	subspace.Set(ctx, ante.KeyOracleFeeWaivers, ante.OracleFeeWaivers{
+		ante.NewOracleFeeWaiver("kava:usd", 2),
+		ante.NewOracleFeeWaiver("btc:usd", 2),
+	})
+	require.True(t, refunded(other, postPrice(other, "kava:usd")).IsZero(), "sender is not an oracle")
+	require.True(t, refunded(oracle, postPrice(oracle, "btc:usd")).IsZero(), "market is not active")
+	require.True(t, refunded(oracle, postPrice(oracle, "xrp:usd")).IsZero(), "market has no waiver")
+	require.True(t, refunded(oracle, postPrice(oracle, "kava:usd"), bank.NewMsgSend(oracle, other, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))).IsZero(), "tx does not only post prices")
+
+	// waived posts are limited per market per block
+	require.Equal(t, fee, refunded(oracle, postPrice(oracle, "kava:usd")))
+	require.True(t, refunded(oracle, postPrice(oracle, "kava:usd"), postPrice(oracle, "kava:usd")).IsZero())
+	require.Equal(t, fee, refunded(oracle, postPrice(oracle, "kava:usd")))
+	require.True(t, refunded(oracle, postPrice(oracle, "kava:usd")).IsZero())
+	require.Equal(t, uint64(2), tApp.GetPriceFeedKeeper().GetFeeWaiverCount(ctx, "kava:usd"))
+
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, uint64(0), tApp.GetPriceFeedKeeper().GetFeeWaiverCount(ctx, "kava:usd"))
+	require.Equal(t, fee, refunded(oracle, postPrice(oracle, "kava:usd"), postPrice(oracle, "kava:usd")))
+}
+
+func TestOracleFeeWaiversValidate(t *testing.T) {
+	require.NoError(t, ante.OracleFeeWaivers{ante.NewOracleFeeWaiver("kava:usd", 1)}.Validate())
+	require.Error(t, ante.OracleFeeWaivers{ante.NewOracleFeeWaiver("kava:usd", 0)}.Validate())
+	require.Error(t, ante.OracleFeeWaivers{ante.NewOracleFeeWaiver("", 1)}.Validate())
+	require.Error(t, ante.OracleFeeWaivers{
+		ante.NewOracleFeeWaiver("kava:usd", 1),
+		ante.NewOracleFeeWaiver("kava:usd", 2),
+	}.Validate())
+}
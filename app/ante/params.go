@@ -0,0 +1,229 @@
+package ante
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the name of the param subspace for app level ante handler params
+const DefaultParamspace = "ante"
+
+// Parameter keys
+var (
+	KeyFeeDenoms        = []byte("FeeDenoms")
+	KeyGasSurcharges    = []byte("GasSurcharges")
+	KeyOracleFeeWaivers = []byte("OracleFeeWaivers")
+)
+
+// ParamKeyTable returns the key table for the ante handler params. Params that are not set disable the decorators
+// that use them, so no genesis state is needed.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable(
+		params.NewParamSetPair(KeyFeeDenoms, FeeDenomParams{}, validateFeeDenomParams),
+		params.NewParamSetPair(KeyGasSurcharges, GasSurcharges{}, validateGasSurcharges),
+		params.NewParamSetPair(KeyOracleFeeWaivers, OracleFeeWaivers{}, validateOracleFeeWaivers),
+	)
+}
+
+// FeeDenom is a denom that fees can be paid in, priced by a pricefeed market
+type FeeDenom struct {
+	Denom            string  `json:"denom" yaml:"denom"`
+	MarketID         string  `json:"market_id" yaml:"market_id"`
+	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"` // the number of decimals of the denom
+}
+
+// NewFeeDenom returns a new FeeDenom
+func NewFeeDenom(denom, marketID string, conversionFactor sdk.Int) FeeDenom {
+	return FeeDenom{
+		Denom:            denom,
+		MarketID:         marketID,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// Validate performs a basic check of a FeeDenom
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return err
+	}
+	if strings.TrimSpace(fd.MarketID) == "" {
+		return fmt.Errorf("market id cannot be blank for fee denom %s", fd.Denom)
+	}
+	if fd.ConversionFactor.IsNil() || fd.ConversionFactor.IsNegative() || fd.ConversionFactor.GT(sdk.NewInt(sdk.Precision)) {
+		return fmt.Errorf("invalid conversion factor for fee denom %s: %s", fd.Denom, fd.ConversionFactor)
+	}
+	return nil
+}
+
+// FeeDenoms slice of FeeDenom
+type FeeDenoms []FeeDenom
+
+// FeeDenomParams sets the stable denoms that are accepted for fees in place of the base denom. Fees in stable denoms
+// are converted to the base denom at the oracle price when checked against the minimum gas prices.
+type FeeDenomParams struct {
+	BaseDenom    FeeDenom  `json:"base_denom" yaml:"base_denom"`
+	StableDenoms FeeDenoms `json:"stable_denoms" yaml:"stable_denoms"`
+}
+
+// NewFeeDenomParams returns a new FeeDenomParams
+func NewFeeDenomParams(baseDenom FeeDenom, stableDenoms FeeDenoms) FeeDenomParams {
+	return FeeDenomParams{
+		BaseDenom:    baseDenom,
+		StableDenoms: stableDenoms,
+	}
+}
+
+// Validate checks that the base and stable denoms are valid and that no denom is listed twice
+func (p FeeDenomParams) Validate() error {
+	if err := p.BaseDenom.Validate(); err != nil {
+		return err
+	}
+	seen := map[string]bool{p.BaseDenom.Denom: true}
+	for _, fd := range p.StableDenoms {
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+		if seen[fd.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", fd.Denom)
+		}
+		seen[fd.Denom] = true
+	}
+	return nil
+}
+
+func validateFeeDenomParams(i interface{}) error {
+	p, ok := i.(FeeDenomParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return p.Validate()
+}
+
+// GasSurcharge is extra gas consumed for each message with the route and type, used to price state heavy messages
+type GasSurcharge struct {
+	Route   string `json:"route" yaml:"route"`
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+	Gas     uint64 `json:"gas" yaml:"gas"`
+}
+
+// NewGasSurcharge returns a new GasSurcharge
+func NewGasSurcharge(route, msgType string, gas uint64) GasSurcharge {
+	return GasSurcharge{
+		Route:   route,
+		MsgType: msgType,
+		Gas:     gas,
+	}
+}
+
+// Validate performs a basic check of a GasSurcharge
+func (gs GasSurcharge) Validate() error {
+	if strings.TrimSpace(gs.Route) == "" || strings.TrimSpace(gs.MsgType) == "" {
+		return fmt.Errorf("gas surcharge route and msg type cannot be blank: %s/%s", gs.Route, gs.MsgType)
+	}
+	if gs.Gas == 0 {
+		return fmt.Errorf("gas surcharge for %s/%s must be positive", gs.Route, gs.MsgType)
+	}
+	return nil
+}
+
+// GasSurcharges slice of GasSurcharge
+type GasSurcharges []GasSurcharge
+
+// Validate checks that each surcharge is valid and that no message has more than one surcharge
+func (gss GasSurcharges) Validate() error {
+	seen := make(map[string]bool)
+	for _, gs := range gss {
+		if err := gs.Validate(); err != nil {
+			return err
+		}
+		key := gs.Route + "/" + gs.MsgType
+		if seen[key] {
+			return fmt.Errorf("duplicated gas surcharge for %s", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Get returns the gas surcharge for a message route and type
+func (gss GasSurcharges) Get(route, msgType string) (uint64, bool) {
+	for _, gs := range gss {
+		if gs.Route == route && gs.MsgType == msgType {
+			return gs.Gas, true
+		}
+	}
+	return 0, false
+}
+
+func validateGasSurcharges(i interface{}) error {
+	gss, ok := i.(GasSurcharges)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return gss.Validate()
+}
+
+// OracleFeeWaiver refunds the fees of price posts to a market by its oracles, up to a maximum number of posts per block
+type OracleFeeWaiver struct {
+	MarketID    string `json:"market_id" yaml:"market_id"`
+	MaxPerBlock uint64 `json:"max_per_block" yaml:"max_per_block"`
+}
+
+// NewOracleFeeWaiver returns a new OracleFeeWaiver
+func NewOracleFeeWaiver(marketID string, maxPerBlock uint64) OracleFeeWaiver {
+	return OracleFeeWaiver{
+		MarketID:    marketID,
+		MaxPerBlock: maxPerBlock,
+	}
+}
+
+// Validate performs a basic check of an OracleFeeWaiver
+func (ofw OracleFeeWaiver) Validate() error {
+	if strings.TrimSpace(ofw.MarketID) == "" {
+		return errors.New("oracle fee waiver market id cannot be blank")
+	}
+	if ofw.MaxPerBlock == 0 {
+		return fmt.Errorf("oracle fee waiver max per block for %s must be positive", ofw.MarketID)
+	}
+	return nil
+}
+
+// OracleFeeWaivers slice of OracleFeeWaiver
+type OracleFeeWaivers []OracleFeeWaiver
+
+// Validate checks that each waiver is valid and that no market has more than one waiver
+func (ofws OracleFeeWaivers) Validate() error {
+	seen := make(map[string]bool)
+	for _, ofw := range ofws {
+		if err := ofw.Validate(); err != nil {
+			return err
+		}
+		if seen[ofw.MarketID] {
+			return fmt.Errorf("duplicated oracle fee waiver for %s", ofw.MarketID)
+		}
+		seen[ofw.MarketID] = true
+	}
+	return nil
+}
+
+// Get returns the oracle fee waiver for a market
+func (ofws OracleFeeWaivers) Get(marketID string) (OracleFeeWaiver, bool) {
+	for _, ofw := range ofws {
+		if ofw.MarketID == marketID {
+			return ofw, true
+		}
+	}
+	return OracleFeeWaiver{}, false
+}
+
+func validateOracleFeeWaivers(i interface{}) error {
+	ofws, ok := i.(OracleFeeWaivers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ofws.Validate()
+}
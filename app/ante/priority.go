@@ -0,0 +1,141 @@
+package ante
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// PriorityMsg identifies a message that is prioritized in the mempool by its route and type
+type PriorityMsg struct {
+	Route   string
+	MsgType string
+}
+
+// NewPriorityMsg returns a new PriorityMsg
+func NewPriorityMsg(route, msgType string) PriorityMsg {
+	return PriorityMsg{
+		Route:   route,
+		MsgType: msgType,
+	}
+}
+
+// String returns the message in the "route/type" format it is configured with
+func (pm PriorityMsg) String() string {
+	return fmt.Sprintf("%s/%s", pm.Route, pm.MsgType)
+}
+
+// DefaultPriorityMsgs are the price posts, liquidations, and auction bids that keep the protocol safe when blocks are full
+var DefaultPriorityMsgs = []PriorityMsg{
+	NewPriorityMsg(pricefeedtypes.RouterKey, pricefeedtypes.TypeMsgPostPrice),
+	NewPriorityMsg(cdptypes.MsgLiquidate{}.Route(), cdptypes.MsgLiquidate{}.Type()),
+	NewPriorityMsg(hardtypes.MsgLiquidate{}.Route(), hardtypes.MsgLiquidate{}.Type()),
+	NewPriorityMsg(auctiontypes.MsgPlaceBid{}.Route(), auctiontypes.MsgPlaceBid{}.Type()),
+}
+
+// ParsePriorityMsgs parses priority messages from "route/type" strings, such as "pricefeed/post_price"
+func ParsePriorityMsgs(strs []string) ([]PriorityMsg, error) {
+	var msgs []PriorityMsg
+	for _, str := range strs {
+		parts := strings.Split(str, "/")
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" || strings.TrimSpace(parts[1]) == "" {
+			return nil, fmt.Errorf("invalid priority message %q, expected route/type", str)
+		}
+		msgs = append(msgs, NewPriorityMsg(parts[0], parts[1]))
+	}
+	return msgs, nil
+}
+
+// MempoolPriorityConfig configures which txs are prioritized in the local mempool
+type MempoolPriorityConfig struct {
+	// MaxOrdinaryTxs is the most txs without priority accepted into the mempool between blocks, zero disables prioritization
+	MaxOrdinaryTxs uint64
+	// PriorityMsgs are the messages a tx must only contain to be prioritized
+	PriorityMsgs []PriorityMsg
+}
+
+// IsPriority returns true if the tx messages are all priority messages
+func (c MempoolPriorityConfig) IsPriority(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		found := false
+		for _, pm := range c.PriorityMsgs {
+			if pm.Route == msg.Route() && pm.MsgType == msg.Type() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MempoolPriorityDecorator reserves room in the local mempool for priority txs, such as price posts and liquidations.
+// The tendermint mempool is first in first out, so rather than reordering txs, it limits the number of ordinary txs
+// accepted between blocks, leaving the rest of the mempool for priority txs when it is congested. Txs still in the
+// mempool are counted again when they are rechecked after a block, but are never evicted.
+// It only runs before entry to mempool (CheckTx), and not in consensus (DeliverTx)
+type MempoolPriorityDecorator struct {
+	config  MempoolPriorityConfig
+	counter *ordinaryTxCounter
+}
+
+func NewMempoolPriorityDecorator(config MempoolPriorityConfig) MempoolPriorityDecorator {
+	return MempoolPriorityDecorator{
+		config:  config,
+		counter: &ordinaryTxCounter{},
+	}
+}
+
+func (mpd MempoolPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if !ctx.IsCheckTx() || simulate || mpd.config.MaxOrdinaryTxs == 0 || mpd.config.IsPriority(tx.GetMsgs()) {
+		return next(ctx, tx, simulate)
+	}
+
+	if !ctx.IsReCheckTx() && mpd.counter.get(ctx.BlockHeight()) >= mpd.config.MaxOrdinaryTxs {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrMempoolIsFull, "mempool is reserving space for priority txs, the limit of %d other txs is reached", mpd.config.MaxOrdinaryTxs)
+	}
+	newCtx, err = next(ctx, tx, simulate)
+	if err == nil {
+		mpd.counter.increment(ctx.BlockHeight())
+	}
+	return newCtx, err
+}
+
+// ordinaryTxCounter counts the ordinary txs accepted into the mempool since the last block
+type ordinaryTxCounter struct {
+	mtx    sync.Mutex
+	height int64
+	count  uint64
+}
+
+func (c *ordinaryTxCounter) get(height int64) uint64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.height != height {
+		return 0
+	}
+	return c.count
+}
+
+func (c *ordinaryTxCounter) increment(height int64) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.height != height {
+		c.height = height
+		c.count = 0
+	}
+	c.count++
+}
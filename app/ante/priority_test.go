@@ -0,0 +1,94 @@
+package ante
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestMempoolPriorityDecorator_AnteHandle(t *testing.T) {
+	testPrivKeys, testAddresses := generatePrivKeyAddressPairs(2)
+	genTx := func(msgs ...sdk.Msg) sdk.Tx {
+		return helpers.GenTx(msgs, sdk.NewCoins(), helpers.DefaultGenTxGas, "testing-chain-id", []uint64{0}, []uint64{0}, testPrivKeys[0])
+	}
+	send := bank.NewMsgSend(testAddresses[0], testAddresses[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))
+	post := pricefeedtypes.NewMsgPostPrice(testAddresses[0], "bnb:usd", sdk.OneDec(), time.Now().Add(time.Hour))
+	ordinaryTx := genTx(send)
+	priorityTx := genTx(post)
+	mixedTx := genTx(post, send)
+
+	decorator := NewMempoolPriorityDecorator(MempoolPriorityConfig{MaxOrdinaryTxs: 2, PriorityMsgs: DefaultPriorityMsgs})
+	ctx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeight(1)
+	mah := MockAnteHandler{}
+
+	// txs that fail later in the ante handler are not counted
+	failing := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return ctx, errors.New("failed")
+	}
+	_, err := decorator.AnteHandle(ctx, ordinaryTx, false, failing)
+	require.Error(t, err)
+
+	// ordinary txs are accepted up to the limit
+	for i := 0; i < 2; i++ {
+		_, err = decorator.AnteHandle(ctx, ordinaryTx, false, mah.AnteHandle)
+		require.NoError(t, err)
+	}
+	_, err = decorator.AnteHandle(ctx, ordinaryTx, false, mah.AnteHandle)
+	require.True(t, errors.Is(err, sdkerrors.ErrMempoolIsFull))
+	_, err = decorator.AnteHandle(ctx, mixedTx, false, mah.AnteHandle)
+	require.True(t, errors.Is(err, sdkerrors.ErrMempoolIsFull))
+
+	// priority txs are not limited
+	_, err = decorator.AnteHandle(ctx, priorityTx, false, mah.AnteHandle)
+	require.NoError(t, err)
+
+	// txs are not limited in simulations or when delivered
+	_, err = decorator.AnteHandle(ctx, ordinaryTx, true, mah.AnteHandle)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), ordinaryTx, false, mah.AnteHandle)
+	require.NoError(t, err)
+
+	// the count resets after a block, and rechecked txs are counted but not evicted
+	ctx = ctx.WithBlockHeight(2).WithIsReCheckTx(true)
+	for i := 0; i < 3; i++ {
+		_, err = decorator.AnteHandle(ctx, ordinaryTx, false, mah.AnteHandle)
+		require.NoError(t, err)
+	}
+	_, err = decorator.AnteHandle(ctx.WithIsReCheckTx(false), ordinaryTx, false, mah.AnteHandle)
+	require.True(t, errors.Is(err, sdkerrors.ErrMempoolIsFull))
+}
+
+func TestMempoolPriorityDecorator_AnteHandle_Disabled(t *testing.T) {
+	testPrivKeys, testAddresses := generatePrivKeyAddressPairs(2)
+	tx := helpers.GenTx(
+		[]sdk.Msg{bank.NewMsgSend(testAddresses[0], testAddresses[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))},
+		sdk.NewCoins(), helpers.DefaultGenTxGas, "testing-chain-id", []uint64{0}, []uint64{0}, testPrivKeys[0],
+	)
+	decorator := NewMempoolPriorityDecorator(MempoolPriorityConfig{PriorityMsgs: DefaultPriorityMsgs})
+	ctx := sdk.Context{}.WithIsCheckTx(true).WithBlockHeight(1)
+	mah := MockAnteHandler{}
+
+	for i := 0; i < 10; i++ {
+		_, err := decorator.AnteHandle(ctx, tx, false, mah.AnteHandle)
+		require.NoError(t, err)
+	}
+}
+
+func TestParsePriorityMsgs(t *testing.T) {
+	msgs, err := ParsePriorityMsgs([]string{"pricefeed/post_price", "cdp/liquidate"})
+	require.NoError(t, err)
+	require.Equal(t, []PriorityMsg{NewPriorityMsg("pricefeed", "post_price"), NewPriorityMsg("cdp", "liquidate")}, msgs)
+
+	for _, str := range []string{"pricefeed", "pricefeed/", "/post_price", "a/b/c"} {
+		_, err = ParsePriorityMsgs([]string{str})
+		require.Error(t, err, str)
+	}
+}
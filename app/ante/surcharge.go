@@ -0,0 +1,32 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// GasSurchargeDecorator consumes the configured extra gas for each message in a tx that has a gas surcharge, so state
+// heavy messages such as liquidations and auction bids pay for their worst case execution.
+// It runs in both CheckTx and DeliverTx so the surcharge is covered by the tx gas limit and fee.
+type GasSurchargeDecorator struct {
+	paramSubspace params.Subspace
+}
+
+func NewGasSurchargeDecorator(paramSubspace params.Subspace) GasSurchargeDecorator {
+	return GasSurchargeDecorator{
+		paramSubspace: paramSubspace,
+	}
+}
+
+func (gsd GasSurchargeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	var surcharges GasSurcharges
+	gsd.paramSubspace.GetIfExists(ctx, KeyGasSurcharges, &surcharges)
+	if len(surcharges) > 0 {
+		for _, msg := range tx.GetMsgs() {
+			if gas, found := surcharges.Get(msg.Route(), msg.Type()); found {
+				ctx.GasMeter().ConsumeGas(gas, "message gas surcharge")
+			}
+		}
+	}
+	return next(ctx, tx, simulate)
+}
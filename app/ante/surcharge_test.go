@@ -0,0 +1,56 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/ante"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+func TestGasSurchargeDecorator(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	subspace, found := tApp.GetParamsKeeper().GetSubspace(ante.DefaultParamspace)
+	require.True(t, found)
+	decorator := ante.NewGasSurchargeDecorator(subspace)
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	liquidate := hardtypes.NewMsgLiquidate(addrs[0], addrs[1])
+	send := bank.NewMsgSend(addrs[0], addrs[1], sdk.NewCoins(sdk.NewInt64Coin("ukava", 1)))
+	gasUsed := func(msgs ...sdk.Msg) uint64 {
+		gasCtx := ctx.WithGasMeter(sdk.NewGasMeter(1000000))
+		_, err := decorator.AnteHandle(gasCtx, auth.NewStdTx(msgs, auth.NewStdFee(1000000, nil), nil, ""), false, next)
+		require.NoError(t, err)
+		return gasCtx.GasMeter().GasConsumed()
+	}
+
+	// reading the params consumes the same gas for every tx
+	baseline := gasUsed(send)
+	require.Equal(t, baseline, gasUsed(liquidate), "no surcharge without params")
+
+	subspace.Set(ctx, ante.KeyGasSurcharges, ante.GasSurcharges{ante.NewGasSurcharge(hardtypes.RouterKey, "liquidate", 50000)})
+	baseline = gasUsed(send)
+	require.Equal(t, baseline+50000, gasUsed(liquidate))
+	require.Equal(t, baseline+100000, gasUsed(liquidate, send, liquidate))
+}
+
+func TestGasSurchargesValidate(t *testing.T) {
+	require.NoError(t, ante.GasSurcharges{ante.NewGasSurcharge("hard", "liquidate", 1)}.Validate())
+	require.Error(t, ante.GasSurcharges{ante.NewGasSurcharge("hard", "liquidate", 0)}.Validate())
+	require.Error(t, ante.GasSurcharges{ante.NewGasSurcharge("", "liquidate", 1)}.Validate())
+	require.Error(t, ante.GasSurcharges{
+		ante.NewGasSurcharge("hard", "liquidate", 1),
+		ante.NewGasSurcharge("hard", "liquidate", 2),
+	}.Validate())
+}
@@ -1,6 +1,7 @@
 package app
 
 import (
+	"fmt"
 	"io"
 	"os"
 
@@ -34,15 +35,21 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 
 	"github.com/kava-labs/kava/app/ante"
+	"github.com/kava-labs/kava/app/listener"
+	"github.com/kava-labs/kava/app/metadata"
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/bep3"
 	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/circuit"
 	"github.com/kava-labs/kava/x/committee"
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/incentive"
 	"github.com/kava-labs/kava/x/issuance"
 	"github.com/kava-labs/kava/x/kavadist"
+	"github.com/kava-labs/kava/x/liquid"
 	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/savings"
+	"github.com/kava-labs/kava/x/swap"
 	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
 )
 
@@ -85,6 +92,10 @@ var (
 		incentive.AppModuleBasic{},
 		issuance.AppModuleBasic{},
 		hard.AppModuleBasic{},
+		swap.AppModuleBasic{},
+		circuit.AppModuleBasic{},
+		savings.AppModuleBasic{},
+		liquid.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -100,14 +111,38 @@ var (
 		cdp.ModuleName:              {supply.Minter, supply.Burner},
 		cdp.LiquidatorMacc:          {supply.Minter, supply.Burner},
 		bep3.ModuleName:             {supply.Minter, supply.Burner},
-		kavadist.ModuleName:         {supply.Minter},
+		kavadist.ModuleName:         {supply.Minter, supply.Burner},
+		incentive.ModuleName:        {supply.Burner},
 		issuance.ModuleAccountName:  {supply.Minter, supply.Burner},
 		hard.ModuleAccountName:      {supply.Minter, supply.Burner},
+		swap.ModuleAccountName:      nil,
+		pricefeed.ModuleAccountName: nil,
+		savings.ModuleAccountName:   nil,
+		liquid.ModuleAccountName:    {supply.Minter, supply.Burner},
 	}
 
-	// module accounts that are allowed to receive tokens
+	// module accounts that are allowed to receive tokens through bank sends
+	// every module account in mAccPerms must be listed explicitly, all others are blacklisted
 	allowedReceivingModAcc = map[string]bool{
-		distr.ModuleName: true,
+		auth.FeeCollectorName:       false,
+		distr.ModuleName:            true,
+		mint.ModuleName:             false,
+		staking.BondedPoolName:      false,
+		staking.NotBondedPoolName:   false,
+		gov.ModuleName:              false,
+		validatorvesting.ModuleName: false,
+		auction.ModuleName:          false,
+		cdp.ModuleName:              false,
+		cdp.LiquidatorMacc:          false,
+		bep3.ModuleName:             false,
+		kavadist.ModuleName:         false,
+		incentive.ModuleName:        false,
+		issuance.ModuleAccountName:  false,
+		hard.ModuleAccountName:      false,
+		swap.ModuleAccountName:      false,
+		pricefeed.ModuleAccountName: true,
+		savings.ModuleAccountName:   false,
+		liquid.ModuleAccountName:    false,
 	}
 )
 
@@ -122,6 +157,12 @@ type AppOptions struct {
 	InvariantCheckPeriod uint
 	MempoolEnableAuth    bool
 	MempoolAuthAddresses []sdk.AccAddress
+	MempoolPriority      ante.MempoolPriorityConfig
+	TelemetryEnabled     bool
+	MockOracle           pricefeed.MockOracleConfig
+	// StateListener streams changes to module stores when each block is committed. Its stores are only wrapped if
+	// the base app's inter-block cache is set to the cache returned by the listener's WrapCache.
+	StateListener *listener.Listener
 }
 
 // App represents an extended ABCI application
@@ -158,17 +199,28 @@ type App struct {
 	incentiveKeeper incentive.Keeper
 	issuanceKeeper  issuance.Keeper
 	hardKeeper      hard.Keeper
+	swapKeeper      swap.Keeper
+	circuitKeeper   circuit.Keeper
+	savingsKeeper   savings.Keeper
+	liquidKeeper    liquid.Keeper
 
 	// the module manager
 	mm *module.Manager
 
 	// simulation manager
 	sm *module.SimulationManager
+
+	// streams module store changes on commit, nil if disabled
+	stateListener *listener.Listener
 }
 
 // NewApp returns a reference to an initialized App.
 func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptions, baseAppOptions ...func(*bam.BaseApp)) *App {
 
+	if err := validateModuleAccountRegistry(); err != nil {
+		panic(err)
+	}
+
 	cdc := MakeCodec()
 
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
@@ -181,9 +233,9 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		validatorvesting.StoreKey, auction.StoreKey, cdp.StoreKey, pricefeed.StoreKey,
 		bep3.StoreKey, kavadist.StoreKey, incentive.StoreKey, issuance.StoreKey, committee.StoreKey,
-		hard.StoreKey,
+		hard.StoreKey, swap.StoreKey, savings.StoreKey, liquid.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, hard.TStoreKey)
 
 	var app = &App{
 		BaseApp:        bApp,
@@ -191,6 +243,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		invCheckPeriod: appOpts.InvariantCheckPeriod,
 		keys:           keys,
 		tkeys:          tkeys,
+		stateListener:  appOpts.StateListener,
 	}
 
 	// init params keeper and subspaces
@@ -212,6 +265,13 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	incentiveSubspace := app.paramsKeeper.Subspace(incentive.DefaultParamspace)
 	issuanceSubspace := app.paramsKeeper.Subspace(issuance.DefaultParamspace)
 	hardSubspace := app.paramsKeeper.Subspace(hard.DefaultParamspace)
+	swapSubspace := app.paramsKeeper.Subspace(swap.DefaultParamspace)
+	circuitSubspace := app.paramsKeeper.Subspace(circuit.DefaultParamspace)
+	committeeSubspace := app.paramsKeeper.Subspace(committee.DefaultParamspace)
+	savingsSubspace := app.paramsKeeper.Subspace(savings.DefaultParamspace)
+	liquidSubspace := app.paramsKeeper.Subspace(liquid.DefaultParamspace)
+	validatorvestingSubspace := app.paramsKeeper.Subspace(validatorvesting.DefaultParamspace)
+	anteSubspace := app.paramsKeeper.Subspace(ante.DefaultParamspace).WithKeyTable(ante.ParamKeyTable())
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(
@@ -285,18 +345,58 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
+	app.pricefeedKeeper = pricefeed.NewKeeper(
+		app.cdc,
+		keys[pricefeed.StoreKey],
+		pricefeedSubspace,
+		app.supplyKeeper,
+	)
+	app.auctionKeeper = auction.NewKeeper(
+		app.cdc,
+		keys[auction.StoreKey],
+		app.supplyKeeper,
+		app.pricefeedKeeper,
+		auctionSubspace,
+	)
+	app.swapKeeper = swap.NewKeeper(
+		app.cdc,
+		keys[swap.StoreKey],
+		swapSubspace,
+		app.accountKeeper,
+		app.supplyKeeper,
+		app.distrKeeper,
+	)
+	hardKeeper := hard.NewKeeper(
+		app.cdc,
+		keys[hard.StoreKey],
+		tkeys[hard.TStoreKey],
+		hardSubspace,
+		app.accountKeeper,
+		app.supplyKeeper,
+		&stakingKeeper,
+		app.pricefeedKeeper,
+		app.auctionKeeper,
+		app.swapKeeper,
+		app.distrKeeper,
+	)
+	hardKeeper.SetDenomMetadata(metadata.NewRegistry(metadata.DefaultDenomMetadata...))
+
 	// create committee keeper with router
+	// NOTE: the hard proposal handler does not call the hard hooks, so it can use the keeper before they are set
 	committeeGovRouter := gov.NewRouter()
 	committeeGovRouter.
 		AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(hard.RouterKey, hard.NewProposalHandler(hardKeeper)).
+		AddRoute(pricefeed.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper))
 	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
 	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
 	app.committeeKeeper = committee.NewKeeper(
 		app.cdc,
 		keys[committee.StoreKey],
+		committeeSubspace,
 		committeeGovRouter,
 		app.paramsKeeper,
 	)
@@ -308,7 +408,9 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
+		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper)).
+		AddRoute(hard.RouterKey, hard.NewProposalHandler(hardKeeper)).
+		AddRoute(pricefeed.RouterKey, pricefeed.NewProposalHandler(app.pricefeedKeeper))
 	app.govKeeper = gov.NewKeeper(
 		app.cdc,
 		keys[gov.StoreKey],
@@ -321,22 +423,12 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	app.vvKeeper = validatorvesting.NewKeeper(
 		app.cdc,
 		keys[validatorvesting.StoreKey],
+		validatorvestingSubspace,
 		app.accountKeeper,
 		app.bankKeeper,
 		app.supplyKeeper,
 		&stakingKeeper,
 	)
-	app.pricefeedKeeper = pricefeed.NewKeeper(
-		app.cdc,
-		keys[pricefeed.StoreKey],
-		pricefeedSubspace,
-	)
-	app.auctionKeeper = auction.NewKeeper(
-		app.cdc,
-		keys[auction.StoreKey],
-		app.supplyKeeper,
-		auctionSubspace,
-	)
 	cdpKeeper := cdp.NewKeeper(
 		app.cdc,
 		keys[cdp.StoreKey],
@@ -345,6 +437,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.auctionKeeper,
 		app.supplyKeeper,
 		app.accountKeeper,
+		app.swapKeeper,
 		mAccPerms,
 	)
 	app.bep3Keeper = bep3.NewKeeper(
@@ -355,16 +448,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		bep3Subspace,
 		app.ModuleAccountAddrs(),
 	)
-	hardKeeper := hard.NewKeeper(
-		app.cdc,
-		keys[hard.StoreKey],
-		hardSubspace,
-		app.accountKeeper,
-		app.supplyKeeper,
-		&stakingKeeper,
-		app.pricefeedKeeper,
-		app.auctionKeeper,
-	)
 	app.kavadistKeeper = kavadist.NewKeeper(
 		app.cdc,
 		keys[kavadist.StoreKey],
@@ -388,6 +471,26 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.accountKeeper,
 		app.supplyKeeper,
 	)
+	app.circuitKeeper = circuit.NewKeeper(
+		app.cdc,
+		circuitSubspace,
+	)
+	app.savingsKeeper = savings.NewKeeper(
+		app.cdc,
+		keys[savings.StoreKey],
+		savingsSubspace,
+		app.supplyKeeper,
+		&hardKeeper,
+	)
+	app.liquidKeeper = liquid.NewKeeper(
+		app.cdc,
+		keys[liquid.StoreKey],
+		liquidSubspace,
+		app.supplyKeeper,
+		&stakingKeeper,
+		app.distrKeeper,
+		app.pricefeedKeeper,
+	)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -398,6 +501,26 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 
 	app.hardKeeper = *hardKeeper.SetHooks(hard.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 
+	// NOTE: the cdp and hard keepers hold copies of the auction keeper, which only start auctions and do not need hooks
+	app.auctionKeeper = *app.auctionKeeper.SetHooks(auction.NewMultiAuctionHooks(app.cdpKeeper.AuctionHooks(), app.hardKeeper.AuctionHooks()))
+
+	// report module metrics on the default prometheus registry served by tendermint
+	// NOTE: metrics are only reported by the keepers passed to the module manager below
+	if appOpts.TelemetryEnabled {
+		app.auctionKeeper.SetMetrics(auction.PrometheusMetrics(appName))
+		app.cdpKeeper.SetMetrics(cdp.PrometheusMetrics(appName))
+		app.hardKeeper.SetMetrics(hard.PrometheusMetrics(appName))
+		app.pricefeedKeeper.SetMetrics(pricefeed.PrometheusMetrics(appName))
+	}
+
+	// post prices every block from the mock oracle, on local networks only
+	if appOpts.MockOracle.Enabled() {
+		logger.Error("pricefeed mock oracle is enabled, prices are not from real oracles", "markets", len(appOpts.MockOracle.Prices))
+		app.pricefeedKeeper.SetMockOracle(appOpts.MockOracle)
+	}
+
+	app.registerUpgradeHandlers(hardSubspace)
+
 	// create the module manager (Note: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.)
 	app.mm = module.NewManager(
@@ -416,13 +539,17 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		validatorvesting.NewAppModule(app.vvKeeper, app.accountKeeper),
 		auction.NewAppModule(app.auctionKeeper, app.accountKeeper, app.supplyKeeper),
 		cdp.NewAppModule(app.cdpKeeper, app.accountKeeper, app.pricefeedKeeper, app.supplyKeeper),
-		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper),
+		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper, app.supplyKeeper),
 		bep3.NewAppModule(app.bep3Keeper, app.accountKeeper, app.supplyKeeper),
 		kavadist.NewAppModule(app.kavadistKeeper, app.supplyKeeper),
 		incentive.NewAppModule(app.incentiveKeeper, app.accountKeeper, app.supplyKeeper, app.cdpKeeper),
 		committee.NewAppModule(app.committeeKeeper, app.accountKeeper),
 		issuance.NewAppModule(app.issuanceKeeper, app.accountKeeper, app.supplyKeeper),
 		hard.NewAppModule(app.hardKeeper, app.supplyKeeper, app.pricefeedKeeper),
+		swap.NewAppModule(app.swapKeeper, app.accountKeeper, app.supplyKeeper),
+		circuit.NewAppModule(app.circuitKeeper),
+		savings.NewAppModule(app.savingsKeeper, app.supplyKeeper),
+		liquid.NewAppModule(app.liquidKeeper, app.supplyKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -433,10 +560,15 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	app.mm.SetOrderBeginBlockers(
 		upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
 		validatorvesting.ModuleName, kavadist.ModuleName, auction.ModuleName, cdp.ModuleName,
-		bep3.ModuleName, hard.ModuleName, committee.ModuleName, issuance.ModuleName, incentive.ModuleName,
+		bep3.ModuleName, hard.ModuleName, savings.ModuleName, liquid.ModuleName, committee.ModuleName,
+		issuance.ModuleName, incentive.ModuleName,
 	)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, pricefeed.ModuleName)
+	// Liquid.EndBlocker pays out unbonding records, so it must run after staking.EndBlocker completes unbonding delegations.
+	// hard.EndBlocker runs after pricefeed so stop losses are checked against the block's updated prices.
+	// auction.EndBlocker runs after cdp and hard so auto-bids are placed on the auctions their liquidations start.
+	// kavadist routes its share of the block's fees before distribution allocates them at the start of the next block
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, liquid.ModuleName, pricefeed.ModuleName, hard.ModuleName, cdp.ModuleName, auction.ModuleName, kavadist.ModuleName)
 
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, // loads all accounts - should run before any module with a module account
@@ -445,6 +577,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		gov.ModuleName, mint.ModuleName, evidence.ModuleName,
 		pricefeed.ModuleName, cdp.ModuleName, hard.ModuleName, auction.ModuleName,
 		bep3.ModuleName, kavadist.ModuleName, incentive.ModuleName, committee.ModuleName, issuance.ModuleName,
+		swap.ModuleName, circuit.ModuleName, savings.ModuleName, liquid.ModuleName,
 		supply.ModuleName,  // calculates the total supply from account - should run after modules that modify accounts in genesis
 		crisis.ModuleName,  // runs the invariants at genesis - should run after other modules
 		genutil.ModuleName, // genutils must occur after staking so that pools are properly initialized with tokens from genesis accounts.
@@ -467,7 +600,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		distr.NewAppModule(app.distrKeeper, app.accountKeeper, app.supplyKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.accountKeeper, app.supplyKeeper),
 		slashing.NewAppModule(app.slashingKeeper, app.accountKeeper, app.stakingKeeper),
-		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper),
+		pricefeed.NewAppModule(app.pricefeedKeeper, app.accountKeeper, app.supplyKeeper),
 		cdp.NewAppModule(app.cdpKeeper, app.accountKeeper, app.pricefeedKeeper, app.supplyKeeper),
 		auction.NewAppModule(app.auctionKeeper, app.accountKeeper, app.supplyKeeper),
 		bep3.NewAppModule(app.bep3Keeper, app.accountKeeper, app.supplyKeeper),
@@ -476,6 +609,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		committee.NewAppModule(app.committeeKeeper, app.accountKeeper),
 		issuance.NewAppModule(app.issuanceKeeper, app.accountKeeper, app.supplyKeeper),
 		hard.NewAppModule(app.hardKeeper, app.supplyKeeper, app.pricefeedKeeper),
+		swap.NewAppModule(app.swapKeeper, app.accountKeeper, app.supplyKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -490,9 +624,9 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	var antehandler sdk.AnteHandler
 	if appOpts.MempoolEnableAuth {
 		var getAuthorizedAddresses ante.AddressFetcher = func(sdk.Context) []sdk.AccAddress { return appOpts.MempoolAuthAddresses }
-		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer, app.bep3Keeper.GetAuthorizedAddresses, app.pricefeedKeeper.GetAuthorizedAddresses, getAuthorizedAddresses)
+		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.pricefeedKeeper, app.circuitKeeper, anteSubspace, appOpts.MempoolPriority, auth.DefaultSigVerificationGasConsumer, app.bep3Keeper.GetAuthorizedAddresses, app.pricefeedKeeper.GetAuthorizedAddresses, getAuthorizedAddresses)
 	} else {
-		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer)
+		antehandler = ante.NewAnteHandler(app.accountKeeper, app.supplyKeeper, app.pricefeedKeeper, app.circuitKeeper, anteSubspace, appOpts.MempoolPriority, auth.DefaultSigVerificationGasConsumer)
 	}
 	app.SetAnteHandler(antehandler)
 	app.SetEndBlocker(app.EndBlocker)
@@ -543,6 +677,18 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	return app.mm.EndBlock(ctx, req)
 }
 
+// Commit commits the state of the block and writes the changes made to listened stores to the state listener.
+// Failing to write the changes is logged rather than halting the node, so a broken sink only affects indexers.
+func (app *App) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.stateListener != nil {
+		if err := app.stateListener.Commit(app.LastBlockHeight()); err != nil {
+			app.Logger().Error("failed to write state changes to listener", "height", app.LastBlockHeight(), "err", err)
+		}
+	}
+	return res
+}
+
 // custom logic for app initialization
 func (app *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
@@ -565,6 +711,21 @@ func (app *App) ModuleAccountAddrs() map[string]bool {
 	return modAccAddrs
 }
 
+// validateModuleAccountRegistry checks that every module account has an explicit entry in the receiving registry
+func validateModuleAccountRegistry() error {
+	for acc := range mAccPerms {
+		if _, found := allowedReceivingModAcc[acc]; !found {
+			return fmt.Errorf("module account %s is not listed in the receiving module account registry", acc)
+		}
+	}
+	for acc := range allowedReceivingModAcc {
+		if _, found := mAccPerms[acc]; !found {
+			return fmt.Errorf("receiving module account registry lists unknown module account %s", acc)
+		}
+	}
+	return nil
+}
+
 // BlacklistedAccAddrs returns all the app's module account addresses black listed for receiving tokens.
 func (app *App) BlacklistedAccAddrs() map[string]bool {
 	blacklistedAddrs := make(map[string]bool)
@@ -1,17 +1,26 @@
 package app
 
 import (
+	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app/listener"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 func TestExport(t *testing.T) {
@@ -25,6 +34,98 @@ func TestExport(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+func TestExportModules(t *testing.T) {
+	db := db.NewMemDB()
+	app := NewApp(log.NewNopLogger(), db, nil, AppOptions{})
+	require.NoError(t, setGenesis(app))
+
+	newApp := NewApp(log.NewNopLogger(), db, nil, AppOptions{})
+	appState, _, err := newApp.ExportAppStateAndValidatorsForModules(false, []string{}, []string{hard.ModuleName, cdp.ModuleName})
+	require.NoError(t, err)
+
+	var genState GenesisState
+	require.NoError(t, json.Unmarshal(appState, &genState))
+	require.Len(t, genState, 2)
+	require.Contains(t, genState, hard.ModuleName)
+	require.Contains(t, genState, cdp.ModuleName)
+
+	_, _, err = newApp.ExportAppStateAndValidatorsForModules(false, []string{}, []string{"unknown"})
+	require.Error(t, err)
+}
+
+func TestExportForZeroHeightResetsHardAccrualTimes(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	accrualTime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	hardGS := hard.NewGenesisState(
+		hard.NewParams(hard.MoneyMarkets{
+			hard.NewMoneyMarket("ukava", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "kava:usd",
+				sdk.NewInt(1000000), hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+				sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		}),
+		hard.GenesisAccumulationTimes{hard.NewGenesisAccumulationTime("ukava", accrualTime, sdk.OneDec(), sdk.OneDec())},
+		hard.DefaultDeposits, hard.DefaultBorrows,
+		hard.DefaultTotalSupplied, hard.DefaultTotalBorrowed, hard.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(accrualTime,
+		GenesisState{pricefeed.ModuleName: tApp.cdc.MustMarshalJSON(pricefeedGS)},
+		GenesisState{hard.ModuleName: tApp.cdc.MustMarshalJSON(hardGS)},
+	)
+
+	appState, _, err := tApp.ExportAppStateAndValidatorsForModules(true, []string{}, []string{hard.ModuleName})
+	require.NoError(t, err)
+
+	var genState GenesisState
+	require.NoError(t, json.Unmarshal(appState, &genState))
+	var exportedHardGS hard.GenesisState
+	tApp.cdc.MustUnmarshalJSON(genState[hard.ModuleName], &exportedHardGS)
+	require.Len(t, exportedHardGS.PreviousAccumulationTimes, 1)
+	require.True(t, exportedHardGS.PreviousAccumulationTimes[0].PreviousAccumulationTime.IsZero())
+	require.NoError(t, exportedHardGS.Validate())
+
+	// importing the exported state starts accruing interest from the first block of the new chain
+	newApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	genTime := accrualTime.Add(30 * 24 * time.Hour)
+	newApp.InitializeFromGenesisStatesWithTime(genTime,
+		GenesisState{pricefeed.ModuleName: tApp.cdc.MustMarshalJSON(pricefeedGS)},
+		GenesisState{hard.ModuleName: genState[hard.ModuleName]},
+	)
+	ctx := newApp.NewContext(false, abci.Header{Height: 1, Time: genTime})
+	previousAccrualTime, found := newApp.GetHardKeeper().GetPreviousAccrualTime(ctx, "ukava")
+	require.True(t, found)
+	require.Equal(t, genTime, previousAccrualTime)
+}
+
+type recordingSink struct {
+	blocks []listener.BlockChanges
+}
+
+func (s *recordingSink) WriteBlock(block listener.BlockChanges) error {
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func TestStateListener(t *testing.T) {
+	sink := &recordingSink{}
+	stateListener := listener.NewListener(sink, hard.StoreKey)
+	app := NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{StateListener: stateListener},
+		bam.SetInterBlockCache(stateListener.WrapCache(nil)))
+	require.NoError(t, setGenesis(app))
+
+	// the genesis state written to the hard store is streamed when the chain is initialized
+	require.Len(t, sink.blocks, 1)
+	require.NotEmpty(t, sink.blocks[0].Changes)
+	for _, change := range sink.blocks[0].Changes {
+		require.Equal(t, hard.StoreKey, change.StoreKey)
+	}
+}
+
 // ensure that black listed addresses are properly set in bank keeper
 func TestBlackListedAddrs(t *testing.T) {
 	db := db.NewMemDB()
@@ -35,6 +136,16 @@ func TestBlackListedAddrs(t *testing.T) {
 	}
 }
 
+// ensure that every module account has an explicit entry in the receiving registry
+func TestModuleAccountRegistry(t *testing.T) {
+	require.NoError(t, validateModuleAccountRegistry())
+	require.Equal(t, len(mAccPerms), len(allowedReceivingModAcc))
+
+	mAccPerms["unregistered"] = nil
+	defer delete(mAccPerms, "unregistered")
+	require.Error(t, validateModuleAccountRegistry())
+}
+
 func setGenesis(app *App) error {
 	genesisState := NewDefaultGenesisState()
 
@@ -2,6 +2,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,6 +17,19 @@ import (
 // ExportAppStateAndValidators export the state of the app for a genesis file
 func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []string,
 ) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
+	return app.ExportAppStateAndValidatorsForModules(forZeroHeight, jailWhiteList, nil)
+}
+
+// ExportAppStateAndValidatorsForModules export the state of the app for a genesis file, including only the
+// genesis states of the given modules. All modules are exported if the module list is empty.
+func (app *App) ExportAppStateAndValidatorsForModules(forZeroHeight bool, jailWhiteList []string, modules []string,
+) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
+	for _, moduleName := range modules {
+		if _, found := app.mm.Modules[moduleName]; !found {
+			return nil, nil, fmt.Errorf("unknown module %s", moduleName)
+		}
+	}
+
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 
@@ -24,6 +38,13 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteList []
 	}
 
 	genState := app.mm.ExportGenesis(ctx)
+	if len(modules) > 0 {
+		filteredGenState := make(map[string]json.RawMessage, len(modules))
+		for _, moduleName := range modules {
+			filteredGenState[moduleName] = genState[moduleName]
+		}
+		genState = filteredGenState
+	}
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
 		return nil, nil, err
@@ -169,4 +190,12 @@ func (app *App) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []string
 			return false
 		},
 	)
+
+	/* Handle hard state. */
+
+	// clear the previous accrual times so that interest starts accruing from the first block of the new chain,
+	// rather than charging interest for the time between the export and the new genesis time
+	for _, mm := range app.hardKeeper.GetParams(ctx).MoneyMarkets {
+		app.hardKeeper.DeletePreviousAccrualTime(ctx, mm.Denom)
+	}
 }
@@ -0,0 +1,165 @@
+package genesis
+
+import (
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// DefaultPriceExpiry is the expiry of prices posted with WithPricefeedPrice. It is far in the future so that prices do
+// not expire while a test or devnet is running.
+var DefaultPriceExpiry = time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Builder builds genesis states for the auth, pricefeed, cdp and hard modules that are consistent with each other, so
+// that they pass the cross-module checks made when the chain is initialized.
+type Builder struct {
+	cdc *codec.Codec
+
+	accounts    authexported.GenesisAccounts
+	pricefeedGS pricefeed.GenesisState
+	cdpGS       cdp.GenesisState
+	hardGS      hard.GenesisState
+}
+
+// NewBuilder returns a builder starting from the default genesis states of the modules it builds
+func NewBuilder() *Builder {
+	return &Builder{
+		cdc:         app.MakeCodec(),
+		accounts:    authexported.GenesisAccounts{},
+		pricefeedGS: pricefeed.DefaultGenesisState(),
+		cdpGS:       cdp.DefaultGenesisState(),
+		hardGS:      hard.DefaultGenesisState(),
+	}
+}
+
+// WithAccountBalance adds coins to the balance of an account, creating a base account if it does not exist
+func (b *Builder) WithAccountBalance(addr sdk.AccAddress, coins sdk.Coins) *Builder {
+	for _, acc := range b.accounts {
+		if acc.GetAddress().Equals(addr) {
+			if err := acc.SetCoins(acc.GetCoins().Add(coins...)); err != nil {
+				panic(err)
+			}
+			return b
+		}
+	}
+	b.accounts = append(b.accounts, auth.NewBaseAccount(addr, coins, nil, 0, 0))
+	return b
+}
+
+// WithModuleAccountBalance adds coins to the balance of a module account, creating the account with the permissions
+// the app gives it if it does not exist
+func (b *Builder) WithModuleAccountBalance(moduleName string, coins sdk.Coins) *Builder {
+	addr := supply.NewModuleAddress(moduleName)
+	for _, acc := range b.accounts {
+		if acc.GetAddress().Equals(addr) {
+			return b.WithAccountBalance(addr, coins)
+		}
+	}
+	macc := supply.NewEmptyModuleAccount(moduleName, app.GetMaccPerms()[moduleName]...)
+	macc.Coins = coins
+	b.accounts = append(b.accounts, macc)
+	return b
+}
+
+// WithPricefeedMarket adds an active market with no oracles, if a market with the same id does not exist.
+// Market ids are expected to be of the form base:quote, optionally followed by a suffix such as :30.
+func (b *Builder) WithPricefeedMarket(marketID string) *Builder {
+	for _, market := range b.pricefeedGS.Params.Markets {
+		if market.MarketID == marketID {
+			return b
+		}
+	}
+	assets := strings.Split(marketID, ":")
+	if len(assets) < 2 {
+		panic("market id must be of the form base:quote: " + marketID)
+	}
+	b.pricefeedGS.Params.Markets = append(b.pricefeedGS.Params.Markets, pricefeed.Market{
+		MarketID: marketID, BaseAsset: assets[0], QuoteAsset: assets[1], Oracles: []sdk.AccAddress{}, Active: true,
+	})
+	return b
+}
+
+// WithPricefeedOracle adds an oracle to a market, adding the market if it does not exist
+func (b *Builder) WithPricefeedOracle(marketID string, oracle sdk.AccAddress) *Builder {
+	b.WithPricefeedMarket(marketID)
+	for i, market := range b.pricefeedGS.Params.Markets {
+		if market.MarketID != marketID {
+			continue
+		}
+		for _, o := range market.Oracles {
+			if o.Equals(oracle) {
+				return b
+			}
+		}
+		b.pricefeedGS.Params.Markets[i].Oracles = append(market.Oracles, oracle)
+	}
+	return b
+}
+
+// WithPricefeedPrice posts a price for a market that expires at DefaultPriceExpiry, adding the market if it does not
+// exist. The price becomes the market's current price when the chain is initialized. The price is posted without an
+// oracle address, which InitChain accepts but genesis file validation does not; use WithPricefeedOraclePrice for
+// genesis files.
+func (b *Builder) WithPricefeedPrice(marketID string, price sdk.Dec) *Builder {
+	b.WithPricefeedMarket(marketID)
+	return b.withPostedPrice(marketID, sdk.AccAddress{}, price)
+}
+
+// WithPricefeedOraclePrice posts a price for a market from an oracle, like WithPricefeedPrice, adding the oracle to
+// the market if it is not one of its oracles
+func (b *Builder) WithPricefeedOraclePrice(marketID string, oracle sdk.AccAddress, price sdk.Dec) *Builder {
+	b.WithPricefeedOracle(marketID, oracle)
+	return b.withPostedPrice(marketID, oracle, price)
+}
+
+func (b *Builder) withPostedPrice(marketID string, oracle sdk.AccAddress, price sdk.Dec) *Builder {
+	b.pricefeedGS.PostedPrices = append(b.pricefeedGS.PostedPrices, pricefeed.PostedPrice{
+		MarketID: marketID, OracleAddress: oracle, Price: price, Expiry: DefaultPriceExpiry,
+	})
+	return b
+}
+
+// WithHardMarket adds a money market to the hard params, along with the pricefeed markets it reads prices from
+func (b *Builder) WithHardMarket(mm hard.MoneyMarket) *Builder {
+	b.hardGS.Params.MoneyMarkets = append(b.hardGS.Params.MoneyMarkets, mm)
+	b.WithPricefeedMarket(mm.SpotMarketID)
+	if mm.PriceSource == hard.PriceSourceTwap || mm.PriceSource == hard.PriceSourceConservative {
+		b.WithPricefeedMarket(mm.TwapMarketID)
+	}
+	return b
+}
+
+// WithCDPCollateral adds a collateral type to the cdp params, along with the pricefeed markets it reads prices from.
+// The global debt limit is raised by the collateral's debt limit.
+func (b *Builder) WithCDPCollateral(cp cdp.CollateralParam) *Builder {
+	params := &b.cdpGS.Params
+	params.CollateralParams = append(params.CollateralParams, cp)
+	params.GlobalDebtLimit = params.GlobalDebtLimit.Add(cp.DebtLimit)
+	b.cdpGS.PreviousAccumulationTimes = append(b.cdpGS.PreviousAccumulationTimes,
+		cdp.NewGenesisAccumulationTime(cp.Type, time.Time{}, sdk.OneDec()))
+	b.cdpGS.TotalPrincipals = append(b.cdpGS.TotalPrincipals, cdp.NewGenesisTotalPrincipal(cp.Type, sdk.ZeroInt()))
+	b.WithPricefeedMarket(cp.SpotMarketID)
+	b.WithPricefeedMarket(cp.LiquidationMarketID)
+	return b
+}
+
+// Build returns the genesis states, to be used with app.TestApp's InitializeFromGenesisStates or merged into a
+// genesis file. Modules other than auth, pricefeed, cdp and hard are not included and keep their default genesis states.
+func (b *Builder) Build() app.GenesisState {
+	return app.GenesisState{
+		auth.ModuleName:      b.cdc.MustMarshalJSON(auth.NewGenesisState(auth.DefaultParams(), b.accounts)),
+		pricefeed.ModuleName: b.cdc.MustMarshalJSON(b.pricefeedGS),
+		cdp.ModuleName:       b.cdc.MustMarshalJSON(b.cdpGS),
+		hard.ModuleName:      b.cdc.MustMarshalJSON(b.hardGS),
+	}
+}
@@ -0,0 +1,69 @@
+package genesis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+)
+
+func TestBuilder(t *testing.T) {
+	app.SetBech32AddressPrefixes(sdk.GetConfig())
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	model := hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	bnb := hard.NewMoneyMarket("bnb", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd",
+		sdk.NewInt(100000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	bnb.PriceSource = hard.PriceSourceConservative
+	bnb.TwapMarketID = "bnb:usd:30"
+
+	gs := genesis.NewBuilder().
+		WithAccountBalance(addrs[0], sdk.NewCoins(sdk.NewInt64Coin("bnb", 100))).
+		WithAccountBalance(addrs[0], sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))).
+		WithModuleAccountBalance(hard.ModuleAccountName, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50))).
+		WithPricefeedPrice("bnb:usd", sdk.MustNewDecFromStr("10.00")).
+		WithHardMarket(bnb).
+		WithCDPCollateral(cdp.NewCollateralParam("bnb", "bnb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewInt64Coin("usdx", 1000000000),
+			sdk.OneDec(), sdk.NewInt(1000000000), sdk.MustNewDecFromStr("0.05"), 0x20, "bnb:usd", "bnb:usd:liq",
+			sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), sdk.NewInt(8))).
+		WithPricefeedOraclePrice("bnb:usd:liq", addrs[1], sdk.MustNewDecFromStr("9.00")).
+		Build()
+
+	tApp := app.NewTestApp()
+	require.NotPanics(t, func() { tApp.InitializeFromGenesisStates(gs) })
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	tApp.CheckBalance(t, ctx, addrs[0], sdk.NewCoins(sdk.NewInt64Coin("bnb", 100), sdk.NewInt64Coin("ukava", 100)))
+	macc := tApp.GetSupplyKeeper().GetModuleAccount(ctx, hard.ModuleAccountName)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50)), macc.GetCoins())
+
+	// the money market's spot and twap markets are added to pricefeed, and posted prices are current
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	for _, marketID := range []string{"bnb:usd", "bnb:usd:30", "bnb:usd:liq"} {
+		_, found := pricefeedKeeper.GetMarket(ctx, marketID)
+		require.True(t, found, marketID)
+	}
+	price, err := pricefeedKeeper.GetCurrentPrice(ctx, "bnb:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.00"), price.Price)
+
+	oracles, err := pricefeedKeeper.GetOracles(ctx, "bnb:usd:liq")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{addrs[1]}, oracles)
+
+	// the collateral type is added to cdp, with the global debt limit raised to cover it
+	cdpParams := tApp.GetCDPKeeper().GetParams(ctx)
+	require.Len(t, cdpParams.CollateralParams, 1)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 1000000000), cdpParams.GlobalDebtLimit)
+
+	mm, found := tApp.GetHardKeeper().GetMoneyMarket(ctx, "bnb")
+	require.True(t, found)
+	require.Equal(t, bnb, mm)
+}
@@ -0,0 +1,15 @@
+/*
+Package genesis provides a builder for multi-module genesis states, for use in tests and local devnets.
+
+Modules validate their genesis against each other when the chain is initialized, for example every hard money market
+must read prices from a market that exists in pricefeed. The builder keeps the states consistent, so a test only has to
+describe what it needs:
+
+	gs := genesis.NewBuilder().
+		WithAccountBalance(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000000))).
+		WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+		WithHardMarket(hard.NewMoneyMarket("ukava", ...)).
+		Build()
+	tApp.InitializeFromGenesisStates(gs)
+*/
+package genesis
@@ -0,0 +1,174 @@
+// Package listener streams the key-value changes written to selected module stores to an external sink, so indexers
+// can consume state diffs directly instead of re-deriving state from events.
+//
+// Stores are wrapped through the root multistore's inter-block cache hook, which is the only point where the
+// committed stores can be wrapped, so writes are recorded as the block's state is written at commit. The changes of a
+// block are buffered until the app commits and are then written to the sink in the order they were made.
+package listener
+
+import (
+	"io"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StoreChange is a single write to a module store
+type StoreChange struct {
+	StoreKey string `json:"store_key" yaml:"store_key"`
+	Key      []byte `json:"key" yaml:"key"`
+	Value    []byte `json:"value,omitempty" yaml:"value,omitempty"`
+	Delete   bool   `json:"delete" yaml:"delete"`
+}
+
+// BlockChanges are the store changes committed in a block
+type BlockChanges struct {
+	Height  int64         `json:"height" yaml:"height"`
+	Changes []StoreChange `json:"changes" yaml:"changes"`
+}
+
+// Sink receives the store changes of each committed block
+type Sink interface {
+	WriteBlock(block BlockChanges) error
+}
+
+// Listener records the changes written to a set of module stores and writes them to a sink when a block is committed
+type Listener struct {
+	sink      Sink
+	storeKeys map[string]bool
+	changes   []StoreChange
+}
+
+// NewListener returns a new Listener streaming changes to the stores with the given names
+func NewListener(sink Sink, storeKeys ...string) *Listener {
+	keys := make(map[string]bool)
+	for _, key := range storeKeys {
+		keys[key] = true
+	}
+	return &Listener{
+		sink:      sink,
+		storeKeys: keys,
+	}
+}
+
+// IsListening returns true if changes to the store with the given name are recorded
+func (l *Listener) IsListening(storeKey string) bool {
+	return l.storeKeys[storeKey]
+}
+
+// Commit writes the changes recorded since the last commit to the sink
+func (l *Listener) Commit(height int64) error {
+	block := BlockChanges{Height: height, Changes: l.changes}
+	if block.Changes == nil {
+		block.Changes = []StoreChange{}
+	}
+	l.changes = nil
+	return l.sink.WriteBlock(block)
+}
+
+func (l *Listener) record(change StoreChange) {
+	l.changes = append(l.changes, change)
+}
+
+// WrapCache returns an inter-block cache that wraps the listened stores after they are wrapped by an existing cache.
+// The existing cache may be nil if the node runs without an inter-block cache.
+func (l *Listener) WrapCache(cache sdk.MultiStorePersistentCache) sdk.MultiStorePersistentCache {
+	return &listeningCache{
+		listener: l,
+		parent:   cache,
+		stores:   make(map[sdk.StoreKey]sdk.CommitKVStore),
+	}
+}
+
+var _ sdk.MultiStorePersistentCache = (*listeningCache)(nil)
+
+// listeningCache implements sdk.MultiStorePersistentCache to wrap the committed stores with listening stores
+type listeningCache struct {
+	listener *Listener
+	parent   sdk.MultiStorePersistentCache
+	stores   map[sdk.StoreKey]sdk.CommitKVStore
+}
+
+// GetStoreCache wraps a committed store with the parent cache, then with a listening store if it is listened to
+func (c *listeningCache) GetStoreCache(key sdk.StoreKey, store sdk.CommitKVStore) sdk.CommitKVStore {
+	c.stores[key] = store
+	wrapped := store
+	if c.parent != nil {
+		wrapped = c.parent.GetStoreCache(key, store)
+	}
+	if !c.listener.IsListening(key.Name()) {
+		return wrapped
+	}
+	return &listeningStore{
+		CommitKVStore: wrapped,
+		underlying:    store,
+		storeKey:      key.Name(),
+		listener:      c.listener,
+	}
+}
+
+// Unwrap returns the committed store passed to GetStoreCache for a key
+func (c *listeningCache) Unwrap(key sdk.StoreKey) sdk.CommitKVStore {
+	return c.stores[key]
+}
+
+// Reset resets the parent cache
+func (c *listeningCache) Reset() {
+	if c.parent != nil {
+		c.parent.Reset()
+	}
+}
+
+var _ sdk.CommitKVStore = (*listeningStore)(nil)
+
+// listeningStore records the sets and deletes made to a committed store
+type listeningStore struct {
+	sdk.CommitKVStore
+	underlying sdk.CommitKVStore
+	storeKey   string
+	listener   *Listener
+}
+
+// Set records the change and sets the value in the wrapped store
+func (s *listeningStore) Set(key, value []byte) {
+	s.listener.record(StoreChange{StoreKey: s.storeKey, Key: copyBytes(key), Value: copyBytes(value)})
+	s.CommitKVStore.Set(key, value)
+}
+
+// Delete records the change and deletes the key from the wrapped store
+func (s *listeningStore) Delete(key []byte) {
+	s.listener.record(StoreChange{StoreKey: s.storeKey, Key: copyBytes(key), Delete: true})
+	s.CommitKVStore.Delete(key)
+}
+
+// CacheWrap wraps the listening store, rather than the wrapped store, so writes from the cache are recorded
+func (s *listeningStore) CacheWrap() sdk.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+// CacheWrapWithTrace wraps the listening store with tracing
+func (s *listeningStore) CacheWrapWithTrace(w io.Writer, tc sdk.TraceContext) sdk.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+// Query forwards raw store queries to the underlying committed store
+func (s *listeningStore) Query(req abci.RequestQuery) abci.ResponseQuery {
+	queryable, ok := s.underlying.(sdk.Queryable)
+	if !ok {
+		return sdkerrors.QueryResult(sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "store does not support queries"))
+	}
+	return queryable.Query(req)
+}
+
+func copyBytes(bz []byte) []byte {
+	if bz == nil {
+		return nil
+	}
+	out := make([]byte, len(bz))
+	copy(out, bz)
+	return out
+}
@@ -0,0 +1,100 @@
+package listener_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app/listener"
+)
+
+type recordingSink struct {
+	blocks []listener.BlockChanges
+}
+
+func (s *recordingSink) WriteBlock(block listener.BlockChanges) error {
+	s.blocks = append(s.blocks, block)
+	return nil
+}
+
+func TestListener(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		cache sdk.MultiStorePersistentCache
+	}{
+		{"without inter-block cache", nil},
+		{"with inter-block cache", store.NewCommitKVStoreCacheManager()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &recordingSink{}
+			l := listener.NewListener(sink, "hard")
+			hardKey, swapKey := sdk.NewKVStoreKey("hard"), sdk.NewKVStoreKey("swap")
+
+			cms := store.NewCommitMultiStore(dbm.NewMemDB())
+			cms.MountStoreWithDB(hardKey, sdk.StoreTypeIAVL, nil)
+			cms.MountStoreWithDB(swapKey, sdk.StoreTypeIAVL, nil)
+			cms.SetInterBlockCache(l.WrapCache(tc.cache))
+			require.NoError(t, cms.LoadLatestVersion())
+
+			// writes reach the listener when the block's cache is written at commit
+			deliverState := cms.CacheMultiStore()
+			txState := deliverState.CacheMultiStore()
+			txState.GetKVStore(hardKey).Set([]byte("a"), []byte("1"))
+			txState.GetKVStore(hardKey).Set([]byte("b"), []byte("2"))
+			txState.GetKVStore(swapKey).Set([]byte("a"), []byte("1"))
+			txState.Write()
+			require.Empty(t, sink.blocks)
+			deliverState.Write()
+			cms.Commit()
+			require.NoError(t, l.Commit(1))
+
+			require.Equal(t, []listener.BlockChanges{{
+				Height: 1,
+				Changes: []listener.StoreChange{
+					{StoreKey: "hard", Key: []byte("a"), Value: []byte("1")},
+					{StoreKey: "hard", Key: []byte("b"), Value: []byte("2")},
+				},
+			}}, sink.blocks)
+
+			// state that is never written, such as check tx state, is not recorded
+			checkState := cms.CacheMultiStore()
+			checkState.GetKVStore(hardKey).Set([]byte("c"), []byte("3"))
+
+			deliverState = cms.CacheMultiStore()
+			deliverState.GetKVStore(hardKey).Delete([]byte("a"))
+			deliverState.Write()
+			cms.Commit()
+			require.NoError(t, l.Commit(2))
+			require.Equal(t, listener.BlockChanges{
+				Height:  2,
+				Changes: []listener.StoreChange{{StoreKey: "hard", Key: []byte("a"), Delete: true}},
+			}, sink.blocks[1])
+
+			// raw store queries reach the committed store
+			res := cms.(sdk.Queryable).Query(abci.RequestQuery{Path: "/hard/key", Data: []byte("b")})
+			require.Equal(t, []byte("2"), res.Value)
+		})
+	}
+}
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := listener.NewWriterSink(&buf)
+	block := listener.BlockChanges{Height: 1, Changes: []listener.StoreChange{{StoreKey: "hard", Key: []byte("a"), Value: []byte("1")}}}
+	require.NoError(t, sink.WriteBlock(block))
+	require.NoError(t, sink.WriteBlock(listener.BlockChanges{Height: 2, Changes: []listener.StoreChange{}}))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var decoded listener.BlockChanges
+	require.NoError(t, json.Unmarshal(lines[0], &decoded))
+	require.Equal(t, block, decoded)
+}
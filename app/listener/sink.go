@@ -0,0 +1,33 @@
+package listener
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+var _ Sink = (*WriterSink)(nil)
+
+// WriterSink writes the changes of each block to a writer as a line of JSON
+type WriterSink struct {
+	encoder *json.Encoder
+}
+
+// NewWriterSink returns a new WriterSink
+func NewWriterSink(w io.Writer) *WriterSink {
+	return &WriterSink{encoder: json.NewEncoder(w)}
+}
+
+// NewFileSink opens a file, creating it if it does not exist, and returns a sink appending blocks to it
+func NewFileSink(path string) (*WriterSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterSink(file), nil
+}
+
+// WriteBlock writes the changes of a block as a line of JSON
+func (s *WriterSink) WriteBlock(block BlockChanges) error {
+	return s.encoder.Encode(block)
+}
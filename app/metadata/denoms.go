@@ -0,0 +1,14 @@
+package metadata
+
+// DefaultDenomMetadata is the metadata of the denoms held and listed on Kava
+var DefaultDenomMetadata = []DenomMetadata{
+	NewDenomMetadata("ukava", "kava", "KAVA", 6),
+	NewDenomMetadata("hard", "hard", "HARD", 6),
+	NewDenomMetadata("swp", "swp", "SWP", 6),
+	NewDenomMetadata("usdx", "usdx", "USDX", 6),
+	NewDenomMetadata("bkava", "bkava", "bKAVA", 6),
+	NewDenomMetadata("bnb", "bnb", "BNB", 8),
+	NewDenomMetadata("btcb", "btcb", "BTCB", 8),
+	NewDenomMetadata("busd", "busd", "BUSD", 8),
+	NewDenomMetadata("xrpb", "xrpb", "XRPB", 8),
+}
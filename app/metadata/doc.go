@@ -0,0 +1,8 @@
+/*
+Package metadata defines the registry of denom metadata for the Kava app.
+
+Each denom is registered with its display denom, symbol, and the number of decimals between the base and display
+units. Modules that value amounts of a denom, such as hard money markets, derive their conversion factor from the
+registry rather than relying on a value entered by hand.
+*/
+package metadata
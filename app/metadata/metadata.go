@@ -0,0 +1,91 @@
+package metadata
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDecimals is the largest number of decimals a denom can be registered with
+const MaxDecimals = 18
+
+// DenomMetadata describes how amounts of a denom are displayed
+type DenomMetadata struct {
+	Base     string `json:"base" yaml:"base"`
+	Display  string `json:"display" yaml:"display"`
+	Symbol   string `json:"symbol" yaml:"symbol"`
+	Decimals uint32 `json:"decimals" yaml:"decimals"`
+}
+
+// NewDenomMetadata returns a new DenomMetadata
+func NewDenomMetadata(base, display, symbol string, decimals uint32) DenomMetadata {
+	return DenomMetadata{
+		Base:     base,
+		Display:  display,
+		Symbol:   symbol,
+		Decimals: decimals,
+	}
+}
+
+// Validate performs basic validation of a DenomMetadata
+func (dm DenomMetadata) Validate() error {
+	if err := sdk.ValidateDenom(dm.Base); err != nil {
+		return err
+	}
+	if len(dm.Display) == 0 {
+		return errors.New("display denom cannot be empty")
+	}
+	if len(dm.Symbol) == 0 {
+		return errors.New("symbol cannot be empty")
+	}
+	if dm.Decimals > MaxDecimals {
+		return fmt.Errorf("decimals of %s cannot be greater than %d: %d", dm.Base, MaxDecimals, dm.Decimals)
+	}
+	return nil
+}
+
+// ConversionFactor returns the number of base units in one display unit
+func (dm DenomMetadata) ConversionFactor() sdk.Int {
+	factor := sdk.OneInt()
+	for i := uint32(0); i < dm.Decimals; i++ {
+		factor = factor.MulRaw(10)
+	}
+	return factor
+}
+
+// Registry holds the metadata of the denoms known to the app, indexed by base denom
+type Registry struct {
+	denoms map[string]DenomMetadata
+}
+
+// NewRegistry returns a registry of the given denom metadata. It panics if any metadata is invalid or a base denom
+// is registered twice, since the registry is only built when the app starts.
+func NewRegistry(metadatas ...DenomMetadata) Registry {
+	denoms := make(map[string]DenomMetadata, len(metadatas))
+	for _, dm := range metadatas {
+		if err := dm.Validate(); err != nil {
+			panic(err)
+		}
+		if _, found := denoms[dm.Base]; found {
+			panic(fmt.Sprintf("denom metadata for %s registered twice", dm.Base))
+		}
+		denoms[dm.Base] = dm
+	}
+	return Registry{denoms: denoms}
+}
+
+// GetDenomMetadata returns the metadata of a base denom
+func (r Registry) GetDenomMetadata(denom string) (DenomMetadata, bool) {
+	dm, found := r.denoms[denom]
+	return dm, found
+}
+
+// GetConversionFactor returns the number of base units in one display unit of a base denom
+func (r Registry) GetConversionFactor(denom string) (sdk.Int, bool) {
+	dm, found := r.GetDenomMetadata(denom)
+	if !found {
+		return sdk.Int{}, false
+	}
+	return dm.ConversionFactor(), true
+}
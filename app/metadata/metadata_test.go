@@ -0,0 +1,54 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app/metadata"
+)
+
+func TestDenomMetadata_Validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		metadata   metadata.DenomMetadata
+		expectPass bool
+	}{
+		{"valid", metadata.NewDenomMetadata("ukava", "kava", "KAVA", 6), true},
+		{"invalid base", metadata.NewDenomMetadata("", "kava", "KAVA", 6), false},
+		{"empty display", metadata.NewDenomMetadata("ukava", "", "KAVA", 6), false},
+		{"empty symbol", metadata.NewDenomMetadata("ukava", "kava", "", 6), false},
+		{"too many decimals", metadata.NewDenomMetadata("ukava", "kava", "KAVA", metadata.MaxDecimals+1), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.metadata.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	registry := metadata.NewRegistry(metadata.DefaultDenomMetadata...)
+
+	cf, found := registry.GetConversionFactor("ukava")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000000), cf)
+
+	cf, found = registry.GetConversionFactor("bnb")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(100000000), cf)
+
+	_, found = registry.GetConversionFactor("xyz")
+	require.False(t, found)
+
+	require.Panics(t, func() {
+		metadata.NewRegistry(metadata.NewDenomMetadata("ukava", "kava", "KAVA", 6), metadata.NewDenomMetadata("ukava", "kava", "KAVA", 6))
+	})
+}
@@ -18,4 +18,7 @@ const (
 	DefaultWeightMsgBlock                 int = 20
 	DefaultWeightMsgPause                 int = 20
 	OpWeightSubmitCommitteeChangeProposal int = 20
+	DefaultWeightMsgSwapDeposit           int = 20
+	DefaultWeightMsgSwapWithdraw          int = 20
+	DefaultWeightMsgSwapExactForTokens    int = 20
 )
@@ -32,12 +32,16 @@ import (
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/bep3"
 	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/circuit"
 	"github.com/kava-labs/kava/x/committee"
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/incentive"
 	"github.com/kava-labs/kava/x/issuance"
 	"github.com/kava-labs/kava/x/kavadist"
+	"github.com/kava-labs/kava/x/liquid"
 	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/savings"
+	"github.com/kava-labs/kava/x/swap"
 	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
 )
 
@@ -87,6 +91,10 @@ func (tApp TestApp) GetIncentiveKeeper() incentive.Keeper { return tApp.incentiv
 func (tApp TestApp) GetHardKeeper() hard.Keeper           { return tApp.hardKeeper }
 func (tApp TestApp) GetCommitteeKeeper() committee.Keeper { return tApp.committeeKeeper }
 func (tApp TestApp) GetIssuanceKeeper() issuance.Keeper   { return tApp.issuanceKeeper }
+func (tApp TestApp) GetSwapKeeper() swap.Keeper           { return tApp.swapKeeper }
+func (tApp TestApp) GetCircuitKeeper() circuit.Keeper     { return tApp.circuitKeeper }
+func (tApp TestApp) GetSavingsKeeper() savings.Keeper     { return tApp.savingsKeeper }
+func (tApp TestApp) GetLiquidKeeper() liquid.Keeper       { return tApp.liquidKeeper }
 
 // InitializeFromGenesisStates calls InitChain on the app using the default genesis state, overwitten with any passed in genesis states
 func (tApp TestApp) InitializeFromGenesisStates(genesisStates ...GenesisState) TestApp {
@@ -0,0 +1,195 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	"github.com/kava-labs/kava/x/hard"
+	hardmigrations "github.com/kava-labs/kava/x/hard/migrations"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+const (
+	// UpgradeNameHardStoreV2 is the software upgrade plan name that migrates the hard store to version 2
+	UpgradeNameHardStoreV2 = "hard-store-v2"
+	// UpgradeNameHardStoreV3 is the software upgrade plan name that migrates the hard store to version 3
+	UpgradeNameHardStoreV3 = "hard-store-v3"
+	// UpgradeNameHardStoreV4 is the software upgrade plan name that migrates the hard store to version 4
+	UpgradeNameHardStoreV4 = "hard-store-v4"
+	// UpgradeNameHardStoreV5 is the software upgrade plan name that migrates the hard store to version 5
+	UpgradeNameHardStoreV5 = "hard-store-v5"
+	// UpgradeNameHardStoreV6 is the software upgrade plan name that migrates the hard store to version 6
+	UpgradeNameHardStoreV6 = "hard-store-v6"
+	// UpgradeNameHardStoreV7 is the software upgrade plan name that migrates the hard store to version 7
+	UpgradeNameHardStoreV7 = "hard-store-v7"
+	// UpgradeNameHardStoreV8 is the software upgrade plan name that migrates the hard store to version 8
+	UpgradeNameHardStoreV8 = "hard-store-v8"
+	// UpgradeNameHardStoreV9 is the software upgrade plan name that migrates the hard store to version 9
+	UpgradeNameHardStoreV9 = "hard-store-v9"
+	// UpgradeNameCdpParamDefaults is the software upgrade plan name that sets the cdp params added since launch to their defaults
+	UpgradeNameCdpParamDefaults = "cdp-param-defaults"
+	// UpgradeNameBep3SwapPruning is the software upgrade plan name that adds the bep3 swap pruning params
+	UpgradeNameBep3SwapPruning = "bep3-swap-pruning"
+	// UpgradeNameBep3SwapFees is the software upgrade plan name that adds the bep3 swap fee params
+	UpgradeNameBep3SwapFees = "bep3-swap-fees"
+	// UpgradeNameBep3AddressLimits is the software upgrade plan name that adds the bep3 address limit params
+	UpgradeNameBep3AddressLimits = "bep3-address-limits"
+	// UpgradeNameIncentiveRewardRollovers is the software upgrade plan name that adds the incentive reward period rollover params
+	UpgradeNameIncentiveRewardRollovers = "incentive-reward-rollovers"
+	// UpgradeNameIncentiveEarlyUnlock is the software upgrade plan name that adds the incentive early unlock penalty param
+	UpgradeNameIncentiveEarlyUnlock = "incentive-early-unlock"
+	// UpgradeNameCircuitBreaker is the software upgrade plan name that adds the circuit module params
+	UpgradeNameCircuitBreaker = "circuit-breaker"
+	// UpgradeNameAuctionAutoBids is the software upgrade plan name that adds the auction auto-bid params
+	UpgradeNameAuctionAutoBids = "auction-auto-bids"
+	// UpgradeNameCommitteeMemberRotation is the software upgrade plan name that adds the committee member rotation params
+	UpgradeNameCommitteeMemberRotation = "committee-member-rotation"
+	// UpgradeNameAuctionLimits is the software upgrade plan name that adds the auction min duration and per-block limit params
+	UpgradeNameAuctionLimits = "auction-limits"
+	// UpgradeNameBep3AssetFloats is the software upgrade plan name that starts tracking bep3 asset mints and burns
+	UpgradeNameBep3AssetFloats = "bep3-asset-floats"
+	// UpgradeNameIncentiveClaimDeadlines is the software upgrade plan name that adds the incentive claim deadline params
+	UpgradeNameIncentiveClaimDeadlines = "incentive-claim-deadlines"
+	// UpgradeNameIncentiveExcludedAddresses is the software upgrade plan name that adds the incentive excluded addresses param
+	UpgradeNameIncentiveExcludedAddresses = "incentive-excluded-addresses"
+	// UpgradeNameKavadistBurns is the software upgrade plan name that adds the kavadist burn period params and allows
+	// the kavadist module account to burn coins
+	UpgradeNameKavadistBurns = "kavadist-burns"
+	// UpgradeNameCdpTotals is the software upgrade plan name that starts tracking the total collateral of each cdp
+	// collateral type
+	UpgradeNameCdpTotals = "cdp-totals"
+	// UpgradeNameCdpRedemptions is the software upgrade plan name that adds the cdp redemption param
+	UpgradeNameCdpRedemptions = "cdp-redemptions"
+	// UpgradeNameKavadistFeeSplit is the software upgrade plan name that adds the kavadist fee split param
+	UpgradeNameKavadistFeeSplit = "kavadist-fee-split"
+	// UpgradeNameIncentiveMultiDenomDelegatorRewards is the software upgrade plan name that migrates the hard delegator
+	// reward params, reward factors and claims to multiple reward denoms
+	UpgradeNameIncentiveMultiDenomDelegatorRewards = "incentive-multi-denom-delegator-rewards"
+	// UpgradeNameValidatorVestingParams is the software upgrade plan name that adds the validator vesting params and
+	// sets the signing threshold of each validator vesting account to the param value
+	UpgradeNameValidatorVestingParams = "validator-vesting-params"
+	// UpgradeNameSavings is the software upgrade plan name that adds the savings module params
+	UpgradeNameSavings = "savings"
+	// UpgradeNameLiquid is the software upgrade plan name that adds the liquid module params
+	UpgradeNameLiquid = "liquid"
+)
+
+// registerUpgradeHandlers sets the handlers run by the upgrade module when a software upgrade plan is reached
+func (app *App) registerUpgradeHandlers(hardSubspace params.Subspace) {
+	hardMigrator := hardmigrations.NewMigrator(app.hardKeeper, app.cdc, app.keys[hard.StoreKey], hardSubspace)
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV2, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV3, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV4, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV5, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV6, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV7, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV8, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameHardStoreV9, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := hardMigrator.Migrate(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameCdpParamDefaults, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.cdpKeeper.InitializeParamDefaults(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameBep3SwapPruning, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.bep3Keeper.InitializeSwapPruningParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameBep3SwapFees, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.bep3Keeper.InitializeSwapFeeParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameBep3AddressLimits, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.bep3Keeper.InitializeAddressLimitParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameIncentiveRewardRollovers, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.incentiveKeeper.InitializeRewardPeriodRolloverParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameIncentiveEarlyUnlock, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.incentiveKeeper.InitializeEarlyUnlockPenaltyParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameCircuitBreaker, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.circuitKeeper.InitializeParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameAuctionAutoBids, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.auctionKeeper.InitializeAutoBidParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameCommitteeMemberRotation, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.committeeKeeper.InitializeParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameAuctionLimits, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.auctionKeeper.InitializeAuctionLimitParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameBep3AssetFloats, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.bep3Keeper.InitializeAssetFloats(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameIncentiveClaimDeadlines, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.incentiveKeeper.InitializeClaimDeadlineParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameIncentiveExcludedAddresses, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.incentiveKeeper.InitializeExcludedAddressParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameKavadistBurns, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.kavadistKeeper.InitializeBurnParams(ctx)
+		// module account permissions are stored with the account, so the account created before burns were added
+		// must be given the burner permission
+		macc, ok := app.supplyKeeper.GetModuleAccount(ctx, kavadist.ModuleName).(*supply.ModuleAccount)
+		if ok && !macc.HasPermission(supply.Burner) {
+			macc.Permissions = append(macc.Permissions, supply.Burner)
+			app.supplyKeeper.SetModuleAccount(ctx, macc)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameCdpTotals, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.cdpKeeper.InitializeTotalCollateral(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameCdpRedemptions, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.cdpKeeper.InitializeParamDefaults(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameKavadistFeeSplit, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.kavadistKeeper.InitializeFeeSplitParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameIncentiveMultiDenomDelegatorRewards, func(ctx sdk.Context, plan upgrade.Plan) {
+		if err := app.incentiveKeeper.MigrateMultiDenomHardDelegatorRewards(ctx); err != nil {
+			panic(err)
+		}
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameValidatorVestingParams, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.vvKeeper.InitializeParams(ctx)
+		app.vvKeeper.MigrateSigningThresholds(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameSavings, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.savingsKeeper.InitializeParams(ctx)
+	})
+	app.upgradeKeeper.SetUpgradeHandler(UpgradeNameLiquid, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.liquidKeeper.InitializeParams(ctx)
+	})
+}
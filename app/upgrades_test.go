@@ -0,0 +1,711 @@
+package app
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/bep3"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/circuit"
+	"github.com/kava-labs/kava/x/committee"
+	"github.com/kava-labs/kava/x/hard"
+	hardmigrations "github.com/kava-labs/kava/x/hard/migrations"
+	"github.com/kava-labs/kava/x/incentive"
+	"github.com/kava-labs/kava/x/kavadist"
+	"github.com/kava-labs/kava/x/liquid"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/savings"
+	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
+)
+
+func TestHardStoreV2Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	hardKeeper := tApp.GetHardKeeper()
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+
+	hardKeeper.SetStoreVersion(ctx, 1)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV2, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.NoError(t, hardKeeper.GetParams(ctx).Validate())
+}
+
+func TestHardStoreV3Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// write a borrow without indexing it to match a store from before the borrows by denom index was added
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	borrow := hard.NewBorrow(borrower, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), hard.BorrowInterestFactors{})
+	store := prefix.NewStore(ctx.KVStore(tApp.keys[hard.StoreKey]), hard.BorrowsKeyPrefix)
+	store.Set(borrower, tApp.cdc.MustMarshalBinaryBare(borrow))
+
+	// remove the liquidation gas budget param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyLiquidationGasBudget...))
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 2)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV3, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Equal(t, hard.DefaultLiquidationGasBudget, hardKeeper.GetParams(ctx).LiquidationGasBudget)
+
+	var borrowers []sdk.AccAddress
+	hardKeeper.IterateBorrowersByDenom(ctx, "bnb", func(addr sdk.AccAddress) bool {
+		borrowers = append(borrowers, addr)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{borrower}, borrowers)
+}
+
+func TestHardStoreV4Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetParams(ctx, hard.NewParams(hard.MoneyMarkets{
+		hard.NewMoneyMarket("ukava", hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "kava:usd",
+			sdk.NewInt(1000000), hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+	}))
+
+	// remove the money market fields added since version 3 to match a store written before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	removeParamFields(t, paramStore, append([]byte(hard.DefaultParamspace+"/"), hard.KeyMoneyMarkets...),
+		"price_source", "min_borrow_apy", "max_borrow_apy", "withdraw_fee", "max_strategy_allocation")
+	require.Error(t, hardKeeper.GetParams(ctx).Validate())
+
+	hardKeeper.SetStoreVersion(ctx, 3)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV4, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	hardParams := hardKeeper.GetParams(ctx)
+	require.NoError(t, hardParams.Validate())
+	mm := hardParams.MoneyMarkets[0]
+	require.Equal(t, hard.PriceSourceSpot, mm.PriceSource)
+	require.Equal(t, hard.DefaultMinBorrowAPY, mm.MinBorrowAPY)
+	require.Equal(t, hard.DefaultMaxBorrowAPY, mm.MaxBorrowAPY)
+	require.Equal(t, hard.DefaultWithdrawFee, mm.WithdrawFee)
+	require.Equal(t, hard.DefaultMaxStrategyAllocation, mm.MaxStrategyAllocation)
+}
+
+func TestHardStoreV5Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the liquidation order param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyLiquidationOrder...))
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 4)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV5, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Equal(t, hard.LiquidationOrderProportional, hardKeeper.GetParams(ctx).LiquidationOrder)
+}
+
+func TestHardStoreV6Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the max annual rate param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyMaxAnnualRate...))
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 5)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV6, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Equal(t, hard.DefaultMaxAnnualRate, hardKeeper.GetParams(ctx).MaxAnnualRate)
+}
+
+func TestHardStoreV7Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the borrow history length param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyBorrowHistoryLength...))
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 6)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV7, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Equal(t, hard.DefaultBorrowHistoryLength, hardKeeper.GetParams(ctx).BorrowHistoryLength)
+}
+
+func TestHardStoreV8Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the health factor warning param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyHealthFactorWarning...))
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 7)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV8, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Equal(t, hard.DefaultHealthFactorWarning, hardKeeper.GetParams(ctx).HealthFactorWarning)
+}
+
+func TestHardStoreV9Upgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the tier borrow rules param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(hard.DefaultParamspace+"/"), hard.KeyTierBorrowRules...))
+	require.Panics(t, func() { tApp.GetHardKeeper().GetParams(ctx) })
+
+	hardKeeper := tApp.GetHardKeeper()
+	hardKeeper.SetStoreVersion(ctx, 8)
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameHardStoreV9, Height: 1})
+	require.Equal(t, hard.StoreVersion, hardKeeper.GetStoreVersion(ctx))
+	require.Empty(t, hardKeeper.GetParams(ctx).TierBorrowRules)
+}
+
+func TestHardMigratorRejectsNewerVersion(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	hardSubspace, found := tApp.GetParamsKeeper().GetSubspace(hard.DefaultParamspace)
+	require.True(t, found)
+	migrator := hardmigrations.NewMigrator(tApp.GetHardKeeper(), tApp.cdc, tApp.keys[hard.StoreKey], hardSubspace)
+
+	// migrating a current store is a no-op
+	require.NoError(t, migrator.Migrate(ctx))
+
+	tApp.GetHardKeeper().SetStoreVersion(ctx, hard.StoreVersion+1)
+	require.Error(t, migrator.Migrate(ctx))
+}
+
+func TestHardMigratorRenameDenom(t *testing.T) {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("test_depositor")))
+	model := hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	newMoneyMarket := func(denom, spotMarketID string) hard.MoneyMarket {
+		return hard.NewMoneyMarket(denom, hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), spotMarketID,
+			sdk.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	}
+	// exported state with a position in each money market, including a borrow of the renamed denom
+	hardGS := hard.NewGenesisState(
+		hard.NewParams(hard.MoneyMarkets{newMoneyMarket("bnb", "bnb:usd"), newMoneyMarket("ukava", "kava:usd")}),
+		hard.GenesisAccumulationTimes{
+			hard.NewGenesisAccumulationTime("bnb", genTime, sdk.OneDec(), sdk.OneDec()),
+			hard.NewGenesisAccumulationTime("ukava", genTime, sdk.OneDec(), sdk.OneDec()),
+		},
+		hard.Deposits{hard.NewDeposit(addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100), sdk.NewInt64Coin("ukava", 50)),
+			hard.SupplyInterestFactors{hard.NewSupplyInterestFactor("bnb", sdk.OneDec()), hard.NewSupplyInterestFactor("ukava", sdk.OneDec())})},
+		hard.Borrows{hard.NewBorrow(addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 10)), hard.BorrowInterestFactors{hard.NewBorrowInterestFactor("bnb", sdk.OneDec())})},
+		sdk.NewCoins(sdk.NewInt64Coin("bnb", 100), sdk.NewInt64Coin("ukava", 50)),
+		sdk.NewCoins(sdk.NewInt64Coin("bnb", 10)),
+		hard.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+	}
+	hardMacc := supply.NewEmptyModuleAccount(hard.ModuleAccountName, supply.Minter, supply.Burner)
+	hardMacc.Coins = sdk.NewCoins(sdk.NewInt64Coin("bnb", 90), sdk.NewInt64Coin("ukava", 50))
+	authGS := auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{hardMacc})
+
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStatesWithTime(genTime,
+		GenesisState{auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(authGS)},
+		GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		GenesisState{hard.ModuleName: hard.ModuleCdc.MustMarshalJSON(hardGS)},
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: genTime})
+	hardKeeper := tApp.GetHardKeeper()
+	hardSubspace, found := tApp.GetParamsKeeper().GetSubspace(hard.DefaultParamspace)
+	require.True(t, found)
+	migrator := hardmigrations.NewMigrator(hardKeeper, tApp.cdc, tApp.keys[hard.StoreKey], hardSubspace)
+
+	// the renamed denom sorts in the same place among the coins, so only the denom strings change
+	exported := string(hard.ModuleCdc.MustMarshalJSON(hard.ExportGenesis(ctx, hardKeeper)))
+	expected := strings.ReplaceAll(exported, `"bnb"`, `"bnbx"`)
+
+	require.Error(t, migrator.RenameDenom(ctx, "bnb", "ukava"))
+	require.Error(t, migrator.RenameDenom(ctx, "bnb", "bnb"))
+	require.Error(t, migrator.RenameDenom(ctx, "bnb", "!"))
+	require.NoError(t, migrator.RenameDenom(ctx, "bnb", "bnbx"))
+	require.JSONEq(t, expected, string(hard.ModuleCdc.MustMarshalJSON(hard.ExportGenesis(ctx, hardKeeper))))
+
+	// the borrow is moved in the index of borrows by denom
+	var borrowers []sdk.AccAddress
+	hardKeeper.IterateBorrowersByDenom(ctx, "bnbx", func(borrower sdk.AccAddress) bool {
+		borrowers = append(borrowers, borrower)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr}, borrowers)
+	hardKeeper.IterateBorrowersByDenom(ctx, "bnb", func(borrower sdk.AccAddress) bool {
+		t.Fatalf("borrower %s still indexed under the old denom", borrower)
+		return true
+	})
+	_, found = hardKeeper.GetMoneyMarket(ctx, "bnb")
+	require.False(t, found)
+	mm, found := hardKeeper.GetMoneyMarket(ctx, "bnbx")
+	require.True(t, found)
+	require.Equal(t, "bnbx", mm.Denom)
+	require.Equal(t, "bnb:usd", mm.SpotMarketID)
+}
+
+func TestCdpParamDefaultsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the swap liquidations and auction thresholds to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	collateralParamsKey := append([]byte(cdp.DefaultParamspace+"/"), cdp.KeyCollateralParams...)
+	paramStore.Set(collateralParamsKey, tApp.cdc.MustMarshalJSON(cdp.CollateralParams{
+		cdp.NewCollateralParam("bnb", "bnb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewInt64Coin("usdx", 1000000000), sdk.OneDec(),
+			sdk.NewInt(100), sdk.MustNewDecFromStr("0.05"), 0x20, "bnb:usd", "bnb:usd", sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), sdk.NewInt(8)),
+	}))
+	removeParamFields(t, paramStore, collateralParamsKey, "auction_threshold")
+	paramStore.Delete(append([]byte(cdp.DefaultParamspace+"/"), cdp.KeySwapLiquidations...))
+	require.Panics(t, func() { tApp.GetCDPKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameCdpParamDefaults, Height: 1})
+	cdpParams := tApp.GetCDPKeeper().GetParams(ctx)
+	require.Empty(t, cdpParams.SwapLiquidations)
+	require.Equal(t, sdk.ZeroInt(), cdpParams.CollateralParams[0].AuctionThreshold)
+}
+
+func TestBep3SwapPruningUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the pruning params to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(bep3.DefaultParamspace+"/"), bep3.KeyLongtermStorageDuration...))
+	paramStore.Delete(append([]byte(bep3.DefaultParamspace+"/"), bep3.KeyMaxPrunedSwapsPerBlock...))
+	require.Panics(t, func() { tApp.GetBep3Keeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameBep3SwapPruning, Height: 1})
+	bep3Params := tApp.GetBep3Keeper().GetParams(ctx)
+	require.Equal(t, bep3.DefaultLongtermStorageDuration, bep3Params.LongtermStorageDuration)
+	require.Equal(t, bep3.DefaultMaxPrunedSwapsPerBlock, bep3Params.MaxPrunedSwapsPerBlock)
+}
+
+func TestBep3SwapFeesUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the swap fees to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(bep3.DefaultParamspace+"/"), bep3.KeySwapFees...))
+	require.Panics(t, func() { tApp.GetBep3Keeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameBep3SwapFees, Height: 1})
+	bep3Params := tApp.GetBep3Keeper().GetParams(ctx)
+	require.Empty(t, bep3Params.SwapFees)
+}
+
+func TestBep3AddressLimitsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the address limits to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(bep3.DefaultParamspace+"/"), bep3.KeyAddressLimits...))
+	require.Panics(t, func() { tApp.GetBep3Keeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameBep3AddressLimits, Height: 1})
+	bep3Params := tApp.GetBep3Keeper().GetParams(ctx)
+	require.Empty(t, bep3Params.AddressLimits)
+}
+
+func TestIncentiveRewardRolloversUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the reward period rollovers to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(incentive.DefaultParamspace+"/"), incentive.KeyRewardPeriodRollovers...))
+	require.Panics(t, func() { tApp.GetIncentiveKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameIncentiveRewardRollovers, Height: 1})
+	incentiveParams := tApp.GetIncentiveKeeper().GetParams(ctx)
+	require.Empty(t, incentiveParams.RewardPeriodRollovers)
+}
+
+func TestIncentiveEarlyUnlockUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the early unlock penalty to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(incentive.DefaultParamspace+"/"), incentive.KeyEarlyUnlockPenalty...))
+	require.Panics(t, func() { tApp.GetIncentiveKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameIncentiveEarlyUnlock, Height: 1})
+	incentiveParams := tApp.GetIncentiveKeeper().GetParams(ctx)
+	require.Equal(t, incentive.DefaultEarlyUnlockPenalty, incentiveParams.EarlyUnlockPenalty)
+}
+
+func TestCircuitBreakerUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the circuit params to match a store from before the module was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(circuit.DefaultParamspace+"/"), circuit.KeyDisabledMsgs...))
+	require.Panics(t, func() { tApp.GetCircuitKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameCircuitBreaker, Height: 1})
+	require.Empty(t, tApp.GetCircuitKeeper().GetParams(ctx).DisabledMsgs)
+}
+
+func TestAuctionAutoBidsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the auto-bid params to match a store from before auto-bids were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(auction.DefaultParamspace+"/"), auction.KeyAutoBidDenoms...))
+	require.Panics(t, func() { tApp.GetAuctionKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameAuctionAutoBids, Height: 1})
+	require.Empty(t, tApp.GetAuctionKeeper().GetParams(ctx).AutoBidDenoms)
+}
+
+func TestCommitteeMemberRotationUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the committee params to match a store from before the module had params
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	for _, key := range [][]byte{committee.KeyMinCommitteeSize, committee.KeyMaxCommitteeSize, committee.KeyMemberRotationVoteThreshold} {
+		paramStore.Delete(append([]byte(committee.DefaultParamspace+"/"), key...))
+	}
+	require.Panics(t, func() { tApp.GetCommitteeKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameCommitteeMemberRotation, Height: 1})
+	require.Equal(t, committee.DefaultParams(), tApp.GetCommitteeKeeper().GetParams(ctx))
+}
+
+func TestAuctionLimitsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the limit params to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	for _, key := range [][]byte{auction.KeyMinAuctionDuration, auction.KeyMaxCollateralAuctionsPerBlock} {
+		paramStore.Delete(append([]byte(auction.DefaultParamspace+"/"), key...))
+	}
+	require.Panics(t, func() { tApp.GetAuctionKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameAuctionLimits, Height: 1})
+	auctionParams := tApp.GetAuctionKeeper().GetParams(ctx)
+	require.Equal(t, auction.DefaultMinAuctionDuration, auctionParams.MinAuctionDuration)
+	require.Equal(t, auction.DefaultMaxCollateralAuctionsPerBlock, auctionParams.MaxCollateralAuctionsPerBlock)
+}
+
+func TestBep3AssetFloatsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// set a supply without a float to match a store from before mints and burns were tracked
+	bep3Keeper := tApp.GetBep3Keeper()
+	supply := bep3.NewAssetSupply(sdk.NewInt64Coin("bnb", 0), sdk.NewInt64Coin("bnb", 0), sdk.NewInt64Coin("bnb", 1000), sdk.NewInt64Coin("bnb", 0), 0)
+	bep3Keeper.SetAssetSupply(ctx, supply, "bnb")
+	_, found := bep3Keeper.GetAssetFloat(ctx, "bnb")
+	require.False(t, found)
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameBep3AssetFloats, Height: 1})
+	float, found := bep3Keeper.GetAssetFloat(ctx, "bnb")
+	require.True(t, found)
+	require.Equal(t, bep3.NewAssetFloat("bnb", sdk.NewInt(1000), sdk.ZeroInt()), float)
+}
+
+func TestIncentiveClaimDeadlinesUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the claim deadlines to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(incentive.DefaultParamspace+"/"), incentive.KeyClaimDeadlines...))
+	require.Panics(t, func() { tApp.GetIncentiveKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameIncentiveClaimDeadlines, Height: 1})
+	require.Empty(t, tApp.GetIncentiveKeeper().GetParams(ctx).ClaimDeadlines)
+}
+
+func TestIncentiveExcludedAddressesUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the excluded addresses to match a store from before they were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(incentive.DefaultParamspace+"/"), incentive.KeyExcludedAddresses...))
+	require.Panics(t, func() { tApp.GetIncentiveKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameIncentiveExcludedAddresses, Height: 1})
+	require.Empty(t, tApp.GetIncentiveKeeper().GetParams(ctx).ExcludedAddresses)
+}
+
+func TestKavadistBurnsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the burn periods and the burner permission to match a store from before burns were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(kavadist.DefaultParamspace+"/"), kavadist.KeyBurnPeriods...))
+	require.Panics(t, func() { tApp.GetKavadistKeeper().GetParams(ctx) })
+	supplyKeeper := tApp.GetSupplyKeeper()
+	macc := supplyKeeper.GetModuleAccount(ctx, kavadist.ModuleName).(*supply.ModuleAccount)
+	macc.Permissions = []string{supply.Minter}
+	supplyKeeper.SetModuleAccount(ctx, macc)
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameKavadistBurns, Height: 1})
+	require.Empty(t, tApp.GetKavadistKeeper().GetParams(ctx).BurnPeriods)
+	macc = supplyKeeper.GetModuleAccount(ctx, kavadist.ModuleName).(*supply.ModuleAccount)
+	require.True(t, macc.HasPermission(supply.Minter))
+	require.True(t, macc.HasPermission(supply.Burner))
+}
+
+func TestCdpTotalsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// a total that does not match the cdps in the store is recalculated
+	cdpKeeper := tApp.GetCDPKeeper()
+	cdpKeeper.SetTotalCollateral(ctx, "bnb-a", sdk.NewInt(1000))
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameCdpTotals, Height: 1})
+	require.Equal(t, sdk.ZeroInt(), cdpKeeper.GetTotalCollateral(ctx, "bnb-a"))
+	_, broken := cdp.TotalCollateralInvariant(cdpKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestCdpRedemptionsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the redemption param to match a store from before it was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(cdp.DefaultParamspace+"/"), cdp.KeyRedemption...))
+	require.Panics(t, func() { tApp.GetCDPKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameCdpRedemptions, Height: 1})
+	require.Equal(t, cdp.DefaultRedemption, tApp.GetCDPKeeper().GetParams(ctx).Redemption)
+}
+
+// removeParamFields deletes fields from each element of a raw param array, matching params written before the fields
+// were added
+func removeParamFields(t *testing.T, paramStore sdk.KVStore, key []byte, fields ...string) {
+	var elements []map[string]interface{}
+	require.NoError(t, json.Unmarshal(paramStore.Get(key), &elements))
+	for _, element := range elements {
+		for _, field := range fields {
+			delete(element, field)
+		}
+	}
+	bz, err := json.Marshal(elements)
+	require.NoError(t, err)
+	paramStore.Set(key, bz)
+}
+
+func TestKavadistFeeSplitUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the fee split to match a store from before fee splits were added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(kavadist.DefaultParamspace+"/"), kavadist.KeyFeeSplit...))
+	require.Panics(t, func() { tApp.GetKavadistKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameKavadistFeeSplit, Height: 1})
+	require.Equal(t, sdk.ZeroDec(), tApp.GetKavadistKeeper().GetParams(ctx).FeeSplit)
+}
+
+// legacyHardLiquidityProviderClaim matches hard liquidity provider claims stored before hard delegator rewards could be
+// paid in more than one denom
+type legacyHardLiquidityProviderClaim struct {
+	incentive.BaseMultiClaim `json:"base_claim" yaml:"base_claim"`
+	SupplyRewardIndexes      incentive.MultiRewardIndexes `json:"supply_reward_indexes" yaml:"supply_reward_indexes"`
+	BorrowRewardIndexes      incentive.MultiRewardIndexes `json:"borrow_reward_indexes" yaml:"borrow_reward_indexes"`
+	DelegatorRewardIndexes   incentive.RewardIndexes      `json:"delegator_reward_indexes" yaml:"delegator_reward_indexes"`
+}
+
+func TestIncentiveMultiDenomDelegatorRewardsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+	cdc := tApp.Codec()
+
+	// write single denom delegator reward periods, factors and claims to match a store from before multiple denoms
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Set(
+		append([]byte(incentive.DefaultParamspace+"/"), incentive.KeyHardDelegatorRewardPeriods...),
+		cdc.MustMarshalJSON(incentive.RewardPeriods{
+			incentive.NewRewardPeriod(true, "ukava", start, end, sdk.NewInt64Coin(incentive.HardLiquidityRewardDenom, 1000)),
+		}),
+	)
+	require.Panics(t, func() { tApp.GetIncentiveKeeper().GetParams(ctx) })
+
+	incentiveStore := ctx.KVStore(tApp.keys[incentive.StoreKey])
+	factorStore := prefix.NewStore(incentiveStore, incentive.HardDelegatorRewardIndexesKeyPrefix)
+	factorStore.Set([]byte("ukava"), cdc.MustMarshalBinaryBare(sdk.MustNewDecFromStr("0.5")))
+
+	legacyCdc := codec.New()
+	legacyCdc.RegisterConcrete(legacyHardLiquidityProviderClaim{}, "incentive/HardLiquidityProviderClaim", nil)
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("owner")))
+	supplyRewardIndexes := incentive.MultiRewardIndexes{
+		incentive.NewMultiRewardIndex("bnb", incentive.RewardIndexes{incentive.NewRewardIndex("hard", sdk.OneDec())}),
+	}
+	claimStore := prefix.NewStore(incentiveStore, incentive.HardLiquidityClaimKeyPrefix)
+	claimStore.Set(owner, legacyCdc.MustMarshalBinaryBare(legacyHardLiquidityProviderClaim{
+		BaseMultiClaim:         incentive.BaseMultiClaim{Owner: owner, Reward: sdk.NewCoins(sdk.NewInt64Coin("hard", 10))},
+		SupplyRewardIndexes:    supplyRewardIndexes,
+		BorrowRewardIndexes:    incentive.MultiRewardIndexes{},
+		DelegatorRewardIndexes: incentive.RewardIndexes{incentive.NewRewardIndex("ukava", sdk.MustNewDecFromStr("0.25"))},
+	}))
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameIncentiveMultiDenomDelegatorRewards, Height: 1})
+	incentiveKeeper := tApp.GetIncentiveKeeper()
+	require.Equal(t,
+		incentive.MultiRewardPeriods{
+			incentive.NewMultiRewardPeriod(true, "ukava", start, end, sdk.NewCoins(sdk.NewInt64Coin(incentive.HardLiquidityRewardDenom, 1000))),
+		},
+		incentiveKeeper.GetParams(ctx).HardDelegatorRewardPeriods,
+	)
+
+	rewardIndexes, found := incentiveKeeper.GetHardDelegatorRewardIndexes(ctx, "ukava")
+	require.True(t, found)
+	require.Equal(t,
+		incentive.RewardIndexes{incentive.NewRewardIndex(incentive.HardLiquidityRewardDenom, sdk.MustNewDecFromStr("0.5"))},
+		rewardIndexes,
+	)
+
+	claim, found := incentiveKeeper.GetHardLiquidityProviderClaim(ctx, owner)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("hard", 10)), claim.Reward)
+	require.Equal(t, supplyRewardIndexes, claim.SupplyRewardIndexes)
+	require.Equal(t,
+		incentive.MultiRewardIndexes{
+			incentive.NewMultiRewardIndex("ukava", incentive.RewardIndexes{
+				incentive.NewRewardIndex(incentive.HardLiquidityRewardDenom, sdk.MustNewDecFromStr("0.25")),
+			}),
+		},
+		claim.DelegatorRewardIndexes,
+	)
+}
+
+func TestValidatorVestingParamsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the params to match a store from before the params were added, when each account had its own threshold
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(validatorvesting.DefaultParamspace+"/"), validatorvesting.KeySigningThreshold...))
+	paramStore.Delete(append([]byte(validatorvesting.DefaultParamspace+"/"), validatorvesting.KeySignedBlocksWindow...))
+	require.Panics(t, func() { tApp.GetVVKeeper().GetParams(ctx) })
+
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("validator vesting")))
+	bacc := auth.NewBaseAccountWithAddress(addr)
+	periods := vesting.Periods{vesting.Period{Length: 60, Amount: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100))}}
+	vva := validatorvesting.NewValidatorVestingAccount(&bacc, ctx.BlockTime().Unix(), periods, sdk.ConsAddress(addr), nil, 50)
+	tApp.GetAccountKeeper().SetAccount(ctx, vva)
+	vvKeeper := tApp.GetVVKeeper()
+	vvKeeper.SetValidatorVestingAccountKey(ctx, addr)
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameValidatorVestingParams, Height: 1})
+	require.Equal(t, validatorvesting.DefaultParams(), vvKeeper.GetParams(ctx))
+	require.Equal(t, validatorvesting.DefaultSigningThreshold, vvKeeper.GetAccountFromAuthKeeper(ctx, addr).SigningThreshold)
+}
+
+func TestSavingsUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the savings params to match a store from before the module was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	paramStore.Delete(append([]byte(savings.DefaultParamspace+"/"), savings.KeyAllowedDenoms...))
+	require.Panics(t, func() { tApp.GetSavingsKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameSavings, Height: 1})
+	require.Empty(t, tApp.GetSavingsKeeper().GetParams(ctx).AllowedDenoms)
+	require.NotPanics(t, func() { tApp.BeginBlocker(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()}) })
+}
+
+func TestLiquidUpgrade(t *testing.T) {
+	tApp := TestApp{App: *NewApp(log.NewNopLogger(), db.NewMemDB(), nil, AppOptions{})}
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	// remove the liquid params to match a store from before the module was added
+	paramStore := ctx.KVStore(tApp.keys[params.StoreKey])
+	for _, key := range [][]byte{
+		liquid.KeyValidators, liquid.KeyUnbondingBatchInterval, liquid.KeyDerivativeMarketID, liquid.KeyUnderlyingMarketID,
+	} {
+		paramStore.Delete(append([]byte(liquid.DefaultParamspace+"/"), key...))
+	}
+	require.Panics(t, func() { tApp.GetLiquidKeeper().GetParams(ctx) })
+
+	tApp.GetUpgradeKeeper().ApplyUpgrade(ctx, upgrade.Plan{Name: UpgradeNameLiquid, Height: 1})
+	liquidParams := tApp.GetLiquidKeeper().GetParams(ctx)
+	require.Empty(t, liquidParams.Validators)
+	require.Equal(t, liquid.DefaultUnbondingBatchInterval, liquidParams.UnbondingBatchInterval)
+	require.NotPanics(t, func() { tApp.BeginBlocker(ctx, abci.RequestBeginBlock{Header: ctx.BlockHeader()}) })
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,7 +12,9 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmcfg "github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/libs/cli"
+	tmflags "github.com/tendermint/tendermint/libs/cli/flags"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 
@@ -25,7 +28,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/ante"
+	"github.com/kava-labs/kava/app/listener"
 	"github.com/kava-labs/kava/migrate"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 // kvd custom flags
@@ -33,6 +42,15 @@ const (
 	flagInvCheckPeriod       = "inv-check-period"
 	flagMempoolEnableAuth    = "mempool.enable-authentication"
 	flagMempoolAuthAddresses = "mempool.authorized-addresses"
+	flagMempoolMaxOrdinary   = "mempool.max-ordinary-txs"
+	flagMempoolPriorityMsgs  = "mempool.priority-msgs"
+	flagTelemetryEnabled     = "telemetry.enabled"
+	flagLogModuleLevels      = "log.module-levels"
+	flagMockOraclePrices     = "pricefeed.mock-oracle-prices"
+	flagMockOracleRandomWalk = "pricefeed.mock-oracle-random-walk"
+	flagExportModules        = "modules"
+	flagStateListenerFile    = "state-listener.file"
+	flagStateListenerStores  = "state-listener.stores"
 )
 
 var invCheckPeriod uint
@@ -50,7 +68,7 @@ func main() {
 	rootCmd := &cobra.Command{
 		Use:               "kvd",
 		Short:             "Kava Daemon (server)",
-		PersistentPreRunE: server.PersistentPreRunEFn(ctx),
+		PersistentPreRunE: persistentPreRunEFn(ctx),
 	}
 
 	rootCmd.AddCommand(
@@ -91,6 +109,59 @@ func main() {
 	if err != nil {
 		panic(fmt.Sprintf("failed to bind flag: %s", err))
 	}
+	startCmd.Flags().Uint64(flagMempoolMaxOrdinary, 0, "Reserve mempool space for priority txs by limiting the number of other txs accepted between blocks, zero disables prioritization")
+	err = viper.BindPFlag(flagMempoolMaxOrdinary, startCmd.Flags().Lookup(flagMempoolMaxOrdinary))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+	startCmd.Flags().StringSlice(flagMempoolPriorityMsgs, priorityMsgStrings(ante.DefaultPriorityMsgs), "Messages that txs must only contain to be prioritized in the mempool (comma separated route/type)")
+	err = viper.BindPFlag(flagMempoolPriorityMsgs, startCmd.Flags().Lookup(flagMempoolPriorityMsgs))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+	startCmd.Flags().Bool(flagTelemetryEnabled, false, "Report module metrics (hard, cdp, auction, pricefeed) on the tendermint prometheus endpoint (requires instrumentation.prometheus)")
+	err = viper.BindPFlag(flagTelemetryEnabled, startCmd.Flags().Lookup(flagTelemetryEnabled))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+
+	startCmd.Flags().String(flagLogModuleLevels, "", "Log levels for individual modules, applied on top of the tendermint log_level (e.g. \"x/hard:debug,x/cdp:info\")")
+	err = viper.BindPFlag(flagLogModuleLevels, startCmd.Flags().Lookup(flagLogModuleLevels))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+
+	startCmd.Flags().StringSlice(flagMockOraclePrices, []string{}, "DEV ONLY: post these prices every block from a mock oracle, so they never expire on local networks (comma separated market_id=price, e.g. \"kava:usd=2.00,bnb:usd=300\")")
+	err = viper.BindPFlag(flagMockOraclePrices, startCmd.Flags().Lookup(flagMockOraclePrices))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+	startCmd.Flags().String(flagMockOracleRandomWalk, "0", "DEV ONLY: largest fraction mock oracle prices move by each block (e.g. 0.01), zero posts static prices")
+	err = viper.BindPFlag(flagMockOracleRandomWalk, startCmd.Flags().Lookup(flagMockOracleRandomWalk))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+
+	startCmd.Flags().String(flagStateListenerFile, "", "Append the changes made to listened module stores in each committed block to this file as lines of JSON, for external indexers")
+	err = viper.BindPFlag(flagStateListenerFile, startCmd.Flags().Lookup(flagStateListenerFile))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+	startCmd.Flags().StringSlice(flagStateListenerStores, []string{hard.StoreKey, cdp.StoreKey, auction.StoreKey}, "Module stores whose changes are written to the state listener file (comma separated store names)")
+	err = viper.BindPFlag(flagStateListenerStores, startCmd.Flags().Lookup(flagStateListenerStores))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
+
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil {
+		panic(fmt.Sprintf("could not find 'export' command on root command: %s", err))
+	}
+	exportCmd.Flags().StringSlice(flagExportModules, []string{}, "Only export the genesis state of these modules (comma separated module names, defaults to all modules)")
+	err = viper.BindPFlag(flagExportModules, exportCmd.Flags().Lookup(flagExportModules))
+	if err != nil {
+		panic(fmt.Sprintf("failed to bind flag: %s", err))
+	}
 
 	// run main command
 	err = executor.Execute()
@@ -99,6 +170,32 @@ func main() {
 	}
 }
 
+// persistentPreRunEFn loads the server context and applies any per-module log levels.
+// Module levels are appended to the tendermint log_level so they override it for the listed modules.
+func persistentPreRunEFn(ctx *server.Context) func(*cobra.Command, []string) error {
+	serverPreRunE := server.PersistentPreRunEFn(ctx)
+	return func(cmd *cobra.Command, args []string) error {
+		err := serverPreRunE(cmd, args)
+		if err != nil {
+			return err
+		}
+		moduleLogLevels := viper.GetString(flagLogModuleLevels)
+		if moduleLogLevels == "" || ctx.Config == nil {
+			return nil
+		}
+		logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+		logger, err = tmflags.ParseLogLevel(fmt.Sprintf("%s,%s", ctx.Config.LogLevel, moduleLogLevels), logger, tmcfg.DefaultLogLevel())
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", flagLogModuleLevels, err)
+		}
+		if viper.GetBool(cli.TraceFlag) {
+			logger = log.NewTracingLogger(logger)
+		}
+		ctx.Logger = logger.With("module", "main")
+		return nil
+	}
+}
+
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
 	var cache sdk.MultiStorePersistentCache
 
@@ -122,6 +219,30 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		panic(fmt.Sprintf("could not get authorized address from config: %v", err))
 	}
 
+	priorityMsgs, err := ante.ParsePriorityMsgs(viper.GetStringSlice(flagMempoolPriorityMsgs))
+	if err != nil {
+		panic(fmt.Sprintf("could not get mempool priority messages from config: %v", err))
+	}
+
+	mockOraclePrices, err := pricefeed.ParseMockPrices(viper.GetStringSlice(flagMockOraclePrices))
+	if err != nil {
+		panic(fmt.Sprintf("could not get mock oracle prices from config: %v", err))
+	}
+	mockOracleRandomWalk, err := sdk.NewDecFromStr(viper.GetString(flagMockOracleRandomWalk))
+	if err != nil {
+		panic(fmt.Sprintf("could not get mock oracle random walk from config: %v", err))
+	}
+
+	var stateListener *listener.Listener
+	if path := viper.GetString(flagStateListenerFile); path != "" {
+		sink, err := listener.NewFileSink(path)
+		if err != nil {
+			panic(fmt.Sprintf("could not open state listener file: %v", err))
+		}
+		stateListener = listener.NewListener(sink, viper.GetStringSlice(flagStateListenerStores)...)
+		cache = stateListener.WrapCache(cache)
+	}
+
 	return app.NewApp(
 		logger, db, traceStore,
 		app.AppOptions{
@@ -130,6 +251,10 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 			InvariantCheckPeriod: invCheckPeriod,
 			MempoolEnableAuth:    mempoolEnableAuth,
 			MempoolAuthAddresses: mempoolAuthAddresses,
+			MempoolPriority:      ante.MempoolPriorityConfig{MaxOrdinaryTxs: viper.GetUint64(flagMempoolMaxOrdinary), PriorityMsgs: priorityMsgs},
+			TelemetryEnabled:     viper.GetBool(flagTelemetryEnabled),
+			MockOracle:           pricefeed.MockOracleConfig{Prices: mockOraclePrices, RandomWalk: mockOracleRandomWalk},
+			StateListener:        stateListener,
 		},
 		baseapp.SetPruning(pruningOpts),
 		baseapp.SetMinGasPrices(viper.GetString(server.FlagMinGasPrices)),
@@ -142,6 +267,7 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 func exportAppStateAndTMValidators(
 	logger log.Logger, db dbm.DB, traceStore io.Writer, height int64, forZeroHeight bool, jailWhiteList []string,
 ) (json.RawMessage, []tmtypes.GenesisValidator, error) {
+	modules := viper.GetStringSlice(flagExportModules)
 
 	if height != -1 {
 		opts := app.AppOptions{
@@ -153,14 +279,22 @@ func exportAppStateAndTMValidators(
 		if err != nil {
 			return nil, nil, err
 		}
-		return tempApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+		return tempApp.ExportAppStateAndValidatorsForModules(forZeroHeight, jailWhiteList, modules)
 	}
 	opts := app.AppOptions{
 		SkipLoadLatest:       false,
 		InvariantCheckPeriod: uint(1),
 	}
 	tempApp := app.NewApp(logger, db, traceStore, opts)
-	return tempApp.ExportAppStateAndValidators(forZeroHeight, jailWhiteList)
+	return tempApp.ExportAppStateAndValidatorsForModules(forZeroHeight, jailWhiteList, modules)
+}
+
+func priorityMsgStrings(msgs []ante.PriorityMsg) []string {
+	strs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		strs[i] = msg.String()
+	}
+	return strs
 }
 
 func accAddressesFromBech32(addresses ...string) ([]sdk.AccAddress, error) {
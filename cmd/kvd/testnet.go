@@ -54,8 +54,14 @@ necessary files (private validator, genesis, config, etc.).
 
 Note, strict routability for addresses is turned off in the config file.
 
+With --seed-defi, the genesis is seeded with pricefeed markets and prices, hard money markets, a bnb-a cdp collateral
+type, mock oracle accounts that can post prices, and funded test accounts. The oracle and test account keys are added
+to the first node's cli keyring as oracle0, oracle1, ... and test0, test1, ... They are derived from their names, so
+every seeded testnet has the same accounts.
+
 Example:
 	$ kvd testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	$ kvd testnet --v 1 --output-dir ./output --seed-defi --num-test-accounts 5
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			config := ctx.Config
@@ -68,10 +74,14 @@ Example:
 			nodeCLIHome := viper.GetString(flagNodeCLIHome)
 			startingIPAddress := viper.GetString(flagStartingIPAddress)
 			numValidators := viper.GetInt(flagNumValidators)
+			seedDeFi := viper.GetBool(flagSeedDeFi)
+			numOracles := viper.GetInt(flagNumOracles)
+			numTestAccounts := viper.GetInt(flagNumTestAccounts)
 
 			return InitTestnet(
 				cmd, config, cdc, mbm, genAccIterator, outputDir, chainID,
 				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators,
+				seedDeFi, numOracles, numTestAccounts,
 			)
 		},
 	}
@@ -94,6 +104,12 @@ Example:
 		server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", sdk.DefaultBondDenom),
 		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001stake)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().Bool(flagSeedDeFi, false,
+		"Seed the genesis with pricefeed markets, hard money markets, cdp collateral, mock oracles and funded test accounts")
+	cmd.Flags().Int(flagNumOracles, 1,
+		"Number of mock oracle accounts to create when seeding DeFi state")
+	cmd.Flags().Int(flagNumTestAccounts, 3,
+		"Number of funded test accounts to create when seeding DeFi state")
 
 	return cmd
 }
@@ -106,6 +122,7 @@ func InitTestnet(
 	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
 	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
 	nodeCLIHome, startingIPAddress string, numValidators int,
+	seedDeFi bool, numOracles, numTestAccounts int,
 ) error {
 
 	if chainID == "" {
@@ -233,7 +250,37 @@ func InitTestnet(
 		srvconfig.WriteConfigFile(appConfigFilePath, kavaConfig)
 	}
 
-	if err := initGenFiles(cdc, mbm, chainID, genAccounts, genFiles, numValidators); err != nil {
+	var seededGenState map[string]json.RawMessage
+	if seedDeFi {
+		if numOracles < 1 {
+			_ = os.RemoveAll(outputDir)
+			return fmt.Errorf("at least one oracle is required to seed DeFi state, got %d", numOracles)
+		}
+		clientDir := filepath.Join(outputDir, fmt.Sprintf("%s%d", nodeDirPrefix, 0), nodeCLIHome)
+		kb, err := keys.NewKeyring(
+			sdk.KeyringServiceName(),
+			viper.GetString(flags.FlagKeyringBackend),
+			clientDir,
+			inBuf,
+		)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		oracles, err := seedDeFiKeys(kb, "oracle", numOracles, clientkeys.DefaultKeyPass)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		testAccounts, err := seedDeFiKeys(kb, "test", numTestAccounts, clientkeys.DefaultKeyPass)
+		if err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+		seededGenState = seedDeFiGenesis(genAccounts, oracles, testAccounts)
+	}
+
+	if err := initGenFiles(cdc, mbm, chainID, genAccounts, seededGenState, genFiles, numValidators); err != nil {
 		return err
 	}
 
@@ -251,7 +298,7 @@ func InitTestnet(
 
 func initGenFiles(
 	cdc *codec.Codec, mbm module.BasicManager, chainID string,
-	genAccounts []authexported.GenesisAccount, genFiles []string, numValidators int,
+	genAccounts []authexported.GenesisAccount, seededGenState map[string]json.RawMessage, genFiles []string, numValidators int,
 ) error {
 
 	appGenState := mbm.DefaultGenesis()
@@ -263,6 +310,11 @@ func initGenFiles(
 	authGenState.Accounts = genAccounts
 	appGenState[auth.ModuleName] = cdc.MustMarshalJSON(authGenState)
 
+	// seeded module states include the validator accounts, so replace the states set above
+	for module, state := range seededGenState {
+		appGenState[module] = state
+	}
+
 	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
 	if err != nil {
 		return err
@@ -0,0 +1,111 @@
+package main
+
+// DONTCOVER
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/mintkey"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
+	"github.com/kava-labs/kava/x/cdp"
+	"github.com/kava-labs/kava/x/hard"
+)
+
+var (
+	flagSeedDeFi        = "seed-defi"
+	flagNumOracles      = "num-oracles"
+	flagNumTestAccounts = "num-test-accounts"
+)
+
+// devnetPrices are the pricefeed markets seeded on a DeFi devnet, with their initial prices
+var devnetPrices = []struct {
+	marketID string
+	price    string
+}{
+	{"kava:usd", "2.00"},
+	{"bnb:usd", "300.00"},
+	{"bnb:usd:30", "300.00"},
+	{"btc:usd", "40000.00"},
+	{"usdx:usd", "1.00"},
+}
+
+// devnetMoneyMarkets returns the hard money markets seeded on a DeFi devnet
+func devnetMoneyMarkets() hard.MoneyMarkets {
+	model := hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	newMoneyMarket := func(denom, spotMarketID string, conversionFactor int64, loanToValue string) hard.MoneyMarket {
+		return hard.NewMoneyMarket(denom, hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr(loanToValue)), spotMarketID,
+			sdk.NewInt(conversionFactor), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.02"))
+	}
+	return hard.MoneyMarkets{
+		newMoneyMarket("ukava", "kava:usd", 1000000, "0.6"),
+		newMoneyMarket("bnb", "bnb:usd", 100000000, "0.5"),
+		newMoneyMarket("btcb", "btc:usd", 100000000, "0.5"),
+		newMoneyMarket("usdx", "usdx:usd", 1000000, "0.8"),
+	}
+}
+
+// devnetCollateralParam returns the cdp collateral type seeded on a DeFi devnet
+func devnetCollateralParam() cdp.CollateralParam {
+	return cdp.NewCollateralParam(
+		"bnb", "bnb-a", sdk.MustNewDecFromStr("1.5"), sdk.NewInt64Coin("usdx", 10000000000000),
+		sdk.MustNewDecFromStr("1.000000001547125958"), // 5% apr
+		sdk.NewInt(50000000000), sdk.MustNewDecFromStr("0.05"), 0x20, "bnb:usd", "bnb:usd:30",
+		sdk.MustNewDecFromStr("0.01"), sdk.NewInt(10), sdk.NewInt(8),
+	)
+}
+
+// devnetAccountCoins are the coins each seeded test account is funded with
+func devnetAccountCoins() sdk.Coins {
+	return sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100)),
+		sdk.NewInt64Coin("ukava", 10000000000),
+		sdk.NewInt64Coin("bnb", 100000000000),
+		sdk.NewInt64Coin("btcb", 1000000000),
+		sdk.NewInt64Coin("usdx", 100000000000),
+	)
+}
+
+// seedDeFiKeys adds the keys of seeded devnet accounts to a keybase and returns their addresses. The keys are derived
+// from the account names, so every devnet has the same accounts. They must not be used outside of local networks.
+func seedDeFiKeys(kb keys.Keybase, namePrefix string, n int, keyPass string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, n)
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("%s%d", namePrefix, i)
+		privKey := secp256k1.GenPrivKeySecp256k1([]byte("kava-devnet-" + name))
+		armor := mintkey.EncryptArmorPrivKey(privKey, keyPass, string(keys.Secp256k1))
+		if err := kb.ImportPrivKey(name, armor, keyPass); err != nil {
+			return nil, err
+		}
+		addrs[i] = sdk.AccAddress(privKey.PubKey().Address())
+	}
+	return addrs, nil
+}
+
+// seedDeFiGenesis returns genesis states with pricefeed markets reported by the mock oracles, hard money markets, a
+// cdp collateral type and funded test accounts, in addition to the validator accounts
+func seedDeFiGenesis(genAccounts []authexported.GenesisAccount, oracles, testAccounts []sdk.AccAddress) app.GenesisState {
+	builder := genesis.NewBuilder()
+	for _, acc := range genAccounts {
+		builder.WithAccountBalance(acc.GetAddress(), acc.GetCoins())
+	}
+	for _, oracle := range oracles {
+		for _, market := range devnetPrices {
+			builder.WithPricefeedOraclePrice(market.marketID, oracle, sdk.MustNewDecFromStr(market.price))
+		}
+		builder.WithAccountBalance(oracle, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(100))))
+	}
+	for _, mm := range devnetMoneyMarkets() {
+		builder.WithHardMarket(mm)
+	}
+	builder.WithCDPCollateral(devnetCollateralParam())
+	for _, addr := range testAccounts {
+		builder.WithAccountBalance(addr, devnetAccountCoins())
+	}
+	return builder.Build()
+}
@@ -321,7 +321,13 @@ func MigrateCommittee(oldGenState v0_9committee.GenesisState) v0_11committee.Gen
 					oldMarketParams := subPermission.AllowedMarkets
 					var newMarketParams v0_11committee.AllowedMarkets
 					for _, oldMarketParam := range oldMarketParams {
-						newMarketParam := v0_11committee.AllowedMarket(oldMarketParam)
+						newMarketParam := v0_11committee.AllowedMarket{
+							MarketID:   oldMarketParam.MarketID,
+							BaseAsset:  oldMarketParam.BaseAsset,
+							QuoteAsset: oldMarketParam.QuoteAsset,
+							Oracles:    oldMarketParam.Oracles,
+							Active:     oldMarketParam.Active,
+						}
 						newMarketParams = append(newMarketParams, newMarketParam)
 					}
 					// add btc, xrp, busd markets to committee
@@ -454,6 +460,7 @@ func MigrateCommittee(oldGenState v0_9committee.GenesisState) v0_11committee.Gen
 	}
 
 	return v0_11committee.GenesisState{
+		Params:         v0_11committee.DefaultParams(),
 		NextProposalID: oldGenState.NextProposalID,
 		Committees:     newCommittees,
 		Proposals:      newProposals,
@@ -772,7 +779,7 @@ func MigratePricefeed(oldGenState v0_9pricefeed.GenesisState) v0_11pricefeed.Gen
 	}
 	newParams := v0_11pricefeed.NewParams(newMarkets)
 
-	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices)
+	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices, v0_11pricefeed.OracleRewards{}, v0_11pricefeed.PriceCandles{})
 }
 
 func mustAccAddressFromBech32(bech32Addr string) sdk.AccAddress {
@@ -0,0 +1,18 @@
+/*
+Package rosetta models kava specific transactions as typed Rosetta operations.
+
+It is the kava extension layer for a Rosetta data and construction API implementation:
+
+  - **data API** MsgToOperations converts the messages of a committed transaction into operations, one operation per
+    balance change. Coins moved into or out of a module account are paired with a related operation on that module
+    account so balances reconcile. Operations for coins that are minted or burned have no counterpart.
+  - **construction API** OperationsToMsg converts the operations of a construction request back into a message to be
+    signed. Only operations on the signer's account are considered, module account counterparts are ignored.
+
+Amounts are in base units of the coin denom, so currencies use the denom as their symbol and have zero decimals.
+Operation metadata values are always strings so they survive a JSON round trip unchanged.
+
+Supported operations are hard deposits, withdrawals, borrows, and repayments, cdp creation, collateral deposits and
+withdrawals, debt draws and repayments, and bep3 atomic swap creation, claims, and refunds.
+*/
+package rosetta
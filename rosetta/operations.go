@@ -0,0 +1,451 @@
+package rosetta
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	bep3types "github.com/kava-labs/kava/x/bep3/types"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+// Operation types for kava specific messages
+const (
+	OpHardDeposit    = "hard_deposit"
+	OpHardWithdraw   = "hard_withdraw"
+	OpHardBorrow     = "hard_borrow"
+	OpHardRepay      = "hard_repay"
+	OpCdpCreate      = "cdp_create"
+	OpCdpDeposit     = "cdp_deposit"
+	OpCdpWithdraw    = "cdp_withdraw"
+	OpCdpDrawDebt    = "cdp_draw_debt"
+	OpCdpRepayDebt   = "cdp_repay_debt"
+	OpBep3CreateSwap = "bep3_create_swap"
+	OpBep3ClaimSwap  = "bep3_claim_swap"
+	OpBep3RefundSwap = "bep3_refund_swap"
+)
+
+// Operation metadata keys
+const (
+	MetadataOwner               = "owner"
+	MetadataCollateralType      = "collateral_type"
+	MetadataSwapID              = "swap_id"
+	MetadataRandomNumber        = "random_number"
+	MetadataRandomNumberHash    = "random_number_hash"
+	MetadataRecipient           = "recipient"
+	MetadataRecipientOtherChain = "recipient_other_chain"
+	MetadataSenderOtherChain    = "sender_other_chain"
+	MetadataTimestamp           = "timestamp"
+	MetadataHeightSpan          = "height_span"
+	MetadataReferrer            = "referrer"
+)
+
+// OperationTypes returns all supported operation types
+func OperationTypes() []string {
+	return []string{
+		OpHardDeposit, OpHardWithdraw, OpHardBorrow, OpHardRepay,
+		OpCdpCreate, OpCdpDeposit, OpCdpWithdraw, OpCdpDrawDebt, OpCdpRepayDebt,
+		OpBep3CreateSwap, OpBep3ClaimSwap, OpBep3RefundSwap,
+	}
+}
+
+// moduleAccount returns the address of the module account that holds the coins for an operation type
+func moduleAccount(opType string) (sdk.AccAddress, bool) {
+	switch opType {
+	case OpHardDeposit, OpHardWithdraw, OpHardBorrow, OpHardRepay:
+		return supply.NewModuleAddress(hardtypes.ModuleAccountName), true
+	case OpCdpCreate, OpCdpDeposit, OpCdpWithdraw, OpCdpDrawDebt, OpCdpRepayDebt:
+		return supply.NewModuleAddress(cdptypes.ModuleName), true
+	case OpBep3CreateSwap, OpBep3ClaimSwap, OpBep3RefundSwap:
+		return supply.NewModuleAddress(bep3types.ModuleName), true
+	default:
+		return nil, false
+	}
+}
+
+// operationsBuilder appends operations with sequential indexes
+type operationsBuilder struct {
+	ops Operations
+}
+
+func (b *operationsBuilder) add(opType string, addr sdk.AccAddress, amount *Amount, metadata map[string]string, related ...int64) int64 {
+	index := int64(len(b.ops))
+	op := Operation{
+		OperationIdentifier: OperationIdentifier{Index: index},
+		Type:                opType,
+		Account:             NewAccountIdentifier(addr),
+		Amount:              amount,
+		Metadata:            metadata,
+	}
+	for _, r := range related {
+		op.RelatedOperations = append(op.RelatedOperations, OperationIdentifier{Index: r})
+	}
+	b.ops = append(b.ops, op)
+	return index
+}
+
+// transfer adds an operation on the user account for each coin, paired with an opposite operation on the module account
+func (b *operationsBuilder) transfer(opType string, user sdk.AccAddress, coins sdk.Coins, toModule bool, metadata map[string]string) {
+	moduleAddr, _ := moduleAccount(opType)
+	for _, coin := range coins {
+		userIndex := b.add(opType, user, NewAmount(coin, toModule), metadata)
+		b.add(opType, moduleAddr, NewAmount(coin, !toModule), nil, userIndex)
+	}
+}
+
+// supplyChange adds an operation on the user account for each coin minted to or burned from it
+func (b *operationsBuilder) supplyChange(opType string, user sdk.AccAddress, coins sdk.Coins, burn bool, metadata map[string]string) {
+	for _, coin := range coins {
+		b.add(opType, user, NewAmount(coin, burn), metadata)
+	}
+}
+
+// MsgToOperations converts a message into rosetta operations
+func MsgToOperations(msg sdk.Msg) (Operations, error) {
+	b := &operationsBuilder{}
+	switch msg := msg.(type) {
+	case hardtypes.MsgDeposit:
+		var metadata map[string]string
+		if !msg.Referrer.Empty() {
+			metadata = map[string]string{MetadataReferrer: msg.Referrer.String()}
+		}
+		b.transfer(OpHardDeposit, msg.Depositor, msg.Amount, true, metadata)
+	case hardtypes.MsgWithdraw:
+		b.transfer(OpHardWithdraw, msg.Depositor, msg.Amount, false, nil)
+	case hardtypes.MsgBorrow:
+		b.transfer(OpHardBorrow, msg.Borrower, msg.Amount, false, nil)
+	case hardtypes.MsgRepay:
+		b.transfer(OpHardRepay, msg.Sender, msg.Amount, true, map[string]string{MetadataOwner: msg.Owner.String()})
+	case cdptypes.MsgCreateCDP:
+		metadata := map[string]string{MetadataCollateralType: msg.CollateralType}
+		b.transfer(OpCdpCreate, msg.Sender, sdk.NewCoins(msg.Collateral), true, metadata)
+		b.supplyChange(OpCdpCreate, msg.Sender, sdk.NewCoins(msg.Principal), false, metadata)
+	case cdptypes.MsgDeposit:
+		metadata := map[string]string{MetadataCollateralType: msg.CollateralType, MetadataOwner: msg.Owner.String()}
+		b.transfer(OpCdpDeposit, msg.Depositor, sdk.NewCoins(msg.Collateral), true, metadata)
+	case cdptypes.MsgWithdraw:
+		metadata := map[string]string{MetadataCollateralType: msg.CollateralType, MetadataOwner: msg.Owner.String()}
+		b.transfer(OpCdpWithdraw, msg.Depositor, sdk.NewCoins(msg.Collateral), false, metadata)
+	case cdptypes.MsgDrawDebt:
+		b.supplyChange(OpCdpDrawDebt, msg.Sender, sdk.NewCoins(msg.Principal), false, map[string]string{MetadataCollateralType: msg.CollateralType})
+	case cdptypes.MsgRepayDebt:
+		b.supplyChange(OpCdpRepayDebt, msg.Sender, sdk.NewCoins(msg.Payment), true, map[string]string{MetadataCollateralType: msg.CollateralType})
+	case bep3types.MsgCreateAtomicSwap:
+		metadata := map[string]string{
+			MetadataRecipient:           msg.To.String(),
+			MetadataRecipientOtherChain: msg.RecipientOtherChain,
+			MetadataSenderOtherChain:    msg.SenderOtherChain,
+			MetadataRandomNumberHash:    msg.RandomNumberHash.String(),
+			MetadataTimestamp:           strconv.FormatInt(msg.Timestamp, 10),
+			MetadataHeightSpan:          strconv.FormatUint(msg.HeightSpan, 10),
+		}
+		b.transfer(OpBep3CreateSwap, msg.From, msg.Amount, true, metadata)
+	case bep3types.MsgClaimAtomicSwap:
+		// the claimed amount depends on the swap state, so the operation has no amount
+		b.add(OpBep3ClaimSwap, msg.From, nil, map[string]string{MetadataSwapID: msg.SwapID.String(), MetadataRandomNumber: msg.RandomNumber.String()})
+	case bep3types.MsgRefundAtomicSwap:
+		b.add(OpBep3RefundSwap, msg.From, nil, map[string]string{MetadataSwapID: msg.SwapID.String()})
+	default:
+		return nil, fmt.Errorf("unsupported message type: %T", msg)
+	}
+	return b.ops, nil
+}
+
+// signerOperations holds the operations on the signer's account from a construction request
+type signerOperations struct {
+	opType   string
+	signer   sdk.AccAddress
+	debits   sdk.Coins
+	credits  sdk.Coins
+	metadata map[string]string
+}
+
+func parseSignerOperations(ops Operations) (signerOperations, error) {
+	if len(ops) == 0 {
+		return signerOperations{}, fmt.Errorf("no operations")
+	}
+	parsed := signerOperations{opType: ops[0].Type, debits: sdk.NewCoins(), credits: sdk.NewCoins()}
+	moduleAddr, found := moduleAccount(parsed.opType)
+	if !found {
+		return signerOperations{}, fmt.Errorf("unsupported operation type: %s", parsed.opType)
+	}
+
+	for _, op := range ops {
+		if op.Type != parsed.opType {
+			return signerOperations{}, fmt.Errorf("operations must have the same type, found %s and %s", parsed.opType, op.Type)
+		}
+		if op.Account == nil {
+			return signerOperations{}, fmt.Errorf("operation %d has no account", op.OperationIdentifier.Index)
+		}
+		addr, err := sdk.AccAddressFromBech32(op.Account.Address)
+		if err != nil {
+			return signerOperations{}, err
+		}
+		if addr.Equals(moduleAddr) {
+			continue
+		}
+		if parsed.signer == nil {
+			parsed.signer = addr
+			parsed.metadata = op.Metadata
+		} else if !parsed.signer.Equals(addr) {
+			return signerOperations{}, fmt.Errorf("operations must have a single signer, found %s and %s", parsed.signer, addr)
+		}
+
+		if op.Amount == nil {
+			continue
+		}
+		coin, debit, err := op.Amount.Coin()
+		if err != nil {
+			return signerOperations{}, err
+		}
+		if debit {
+			parsed.debits = parsed.debits.Add(coin)
+		} else {
+			parsed.credits = parsed.credits.Add(coin)
+		}
+	}
+	if parsed.signer == nil {
+		return signerOperations{}, fmt.Errorf("no operations on a signer account")
+	}
+	return parsed, nil
+}
+
+// metadataValue returns a required metadata value
+func (p signerOperations) metadataValue(key string) (string, error) {
+	value, found := p.metadata[key]
+	if !found {
+		return "", fmt.Errorf("%s operation missing metadata %s", p.opType, key)
+	}
+	return value, nil
+}
+
+// metadataAddress returns an address from metadata, defaulting to the signer if it is not set
+func (p signerOperations) metadataAddress(key string) (sdk.AccAddress, error) {
+	value, found := p.metadata[key]
+	if !found {
+		return p.signer, nil
+	}
+	return sdk.AccAddressFromBech32(value)
+}
+
+// onlyDebits returns the debited coins, erroring if any coins are credited
+func (p signerOperations) onlyDebits() (sdk.Coins, error) {
+	if !p.credits.Empty() {
+		return nil, fmt.Errorf("%s operation cannot credit the signer", p.opType)
+	}
+	return p.debits, nil
+}
+
+// onlyCredits returns the credited coins, erroring if any coins are debited
+func (p signerOperations) onlyCredits() (sdk.Coins, error) {
+	if !p.debits.Empty() {
+		return nil, fmt.Errorf("%s operation cannot debit the signer", p.opType)
+	}
+	return p.credits, nil
+}
+
+// singleCoin returns the only coin in coins
+func (p signerOperations) singleCoin(coins sdk.Coins, err error) (sdk.Coin, error) {
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if len(coins) != 1 {
+		return sdk.Coin{}, fmt.Errorf("%s operation must move a single coin, found %s", p.opType, coins)
+	}
+	return coins[0], nil
+}
+
+// OperationsToMsg converts the operations from a construction request into a message.
+// The returned message has passed basic validation.
+func OperationsToMsg(ops Operations) (sdk.Msg, error) {
+	p, err := parseSignerOperations(ops)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := p.toMsg()
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func (p signerOperations) toMsg() (sdk.Msg, error) {
+	switch p.opType {
+	case OpHardDeposit:
+		coins, err := p.onlyDebits()
+		if err != nil {
+			return nil, err
+		}
+		msg := hardtypes.NewMsgDeposit(p.signer, coins)
+		if referrer, found := p.metadata[MetadataReferrer]; found {
+			msg.Referrer, err = sdk.AccAddressFromBech32(referrer)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return msg, nil
+	case OpHardWithdraw:
+		coins, err := p.onlyCredits()
+		if err != nil {
+			return nil, err
+		}
+		return hardtypes.NewMsgWithdraw(p.signer, coins), nil
+	case OpHardBorrow:
+		coins, err := p.onlyCredits()
+		if err != nil {
+			return nil, err
+		}
+		return hardtypes.NewMsgBorrow(p.signer, coins), nil
+	case OpHardRepay:
+		coins, err := p.onlyDebits()
+		if err != nil {
+			return nil, err
+		}
+		owner, err := p.metadataAddress(MetadataOwner)
+		if err != nil {
+			return nil, err
+		}
+		return hardtypes.NewMsgRepay(p.signer, owner, coins), nil
+	case OpCdpCreate:
+		collateralType, err := p.metadataValue(MetadataCollateralType)
+		if err != nil {
+			return nil, err
+		}
+		collateral, err := p.singleCoin(p.debits, nil)
+		if err != nil {
+			return nil, err
+		}
+		principal, err := p.singleCoin(p.credits, nil)
+		if err != nil {
+			return nil, err
+		}
+		return cdptypes.NewMsgCreateCDP(p.signer, collateral, principal, collateralType), nil
+	case OpCdpDeposit, OpCdpWithdraw:
+		collateralType, err := p.metadataValue(MetadataCollateralType)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := p.metadataAddress(MetadataOwner)
+		if err != nil {
+			return nil, err
+		}
+		if p.opType == OpCdpDeposit {
+			collateral, err := p.singleCoin(p.onlyDebits())
+			if err != nil {
+				return nil, err
+			}
+			return cdptypes.NewMsgDeposit(owner, p.signer, collateral, collateralType), nil
+		}
+		collateral, err := p.singleCoin(p.onlyCredits())
+		if err != nil {
+			return nil, err
+		}
+		return cdptypes.NewMsgWithdraw(owner, p.signer, collateral, collateralType), nil
+	case OpCdpDrawDebt:
+		collateralType, err := p.metadataValue(MetadataCollateralType)
+		if err != nil {
+			return nil, err
+		}
+		principal, err := p.singleCoin(p.onlyCredits())
+		if err != nil {
+			return nil, err
+		}
+		return cdptypes.NewMsgDrawDebt(p.signer, collateralType, principal), nil
+	case OpCdpRepayDebt:
+		collateralType, err := p.metadataValue(MetadataCollateralType)
+		if err != nil {
+			return nil, err
+		}
+		payment, err := p.singleCoin(p.onlyDebits())
+		if err != nil {
+			return nil, err
+		}
+		return cdptypes.NewMsgRepayDebt(p.signer, collateralType, payment), nil
+	case OpBep3CreateSwap:
+		return p.toMsgCreateAtomicSwap()
+	case OpBep3ClaimSwap:
+		swapID, err := p.metadataHex(MetadataSwapID)
+		if err != nil {
+			return nil, err
+		}
+		randomNumber, err := p.metadataHex(MetadataRandomNumber)
+		if err != nil {
+			return nil, err
+		}
+		return bep3types.NewMsgClaimAtomicSwap(p.signer, swapID, randomNumber), nil
+	case OpBep3RefundSwap:
+		swapID, err := p.metadataHex(MetadataSwapID)
+		if err != nil {
+			return nil, err
+		}
+		return bep3types.NewMsgRefundAtomicSwap(p.signer, swapID), nil
+	default:
+		return nil, fmt.Errorf("unsupported operation type: %s", p.opType)
+	}
+}
+
+func (p signerOperations) toMsgCreateAtomicSwap() (sdk.Msg, error) {
+	amount, err := p.onlyDebits()
+	if err != nil {
+		return nil, err
+	}
+	recipientStr, err := p.metadataValue(MetadataRecipient)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := sdk.AccAddressFromBech32(recipientStr)
+	if err != nil {
+		return nil, err
+	}
+	recipientOtherChain, err := p.metadataValue(MetadataRecipientOtherChain)
+	if err != nil {
+		return nil, err
+	}
+	senderOtherChain, err := p.metadataValue(MetadataSenderOtherChain)
+	if err != nil {
+		return nil, err
+	}
+	randomNumberHash, err := p.metadataHex(MetadataRandomNumberHash)
+	if err != nil {
+		return nil, err
+	}
+	timestampStr, err := p.metadataValue(MetadataTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", MetadataTimestamp, err)
+	}
+	heightSpanStr, err := p.metadataValue(MetadataHeightSpan)
+	if err != nil {
+		return nil, err
+	}
+	heightSpan, err := strconv.ParseUint(heightSpanStr, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", MetadataHeightSpan, err)
+	}
+	return bep3types.NewMsgCreateAtomicSwap(p.signer, recipient, recipientOtherChain, senderOtherChain,
+		randomNumberHash, timestamp, amount, heightSpan), nil
+}
+
+// metadataHex returns a required hex encoded metadata value
+func (p signerOperations) metadataHex(key string) ([]byte, error) {
+	value, err := p.metadataValue(key)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return bz, nil
+}
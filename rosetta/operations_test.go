@@ -0,0 +1,127 @@
+package rosetta_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/rosetta"
+	bep3types "github.com/kava-labs/kava/x/bep3/types"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+type OperationsTestSuite struct {
+	suite.Suite
+
+	user  sdk.AccAddress
+	other sdk.AccAddress
+}
+
+func (suite *OperationsTestSuite) SetupTest() {
+	suite.user = sdk.AccAddress(crypto.AddressHash([]byte("user")))
+	suite.other = sdk.AccAddress(crypto.AddressHash([]byte("other")))
+}
+
+func (suite *OperationsTestSuite) TestRoundTrip() {
+	swapID := bep3types.CalculateSwapID(make([]byte, 32), suite.user, "bnb1sender")
+	testCases := []struct {
+		name string
+		msg  sdk.Msg
+	}{
+		{"hard deposit", hardtypes.NewMsgDeposit(suite.user, cs(c("bnb", 100), c("ukava", 50)))},
+		{"hard deposit with referrer", hardtypes.MsgDeposit{Depositor: suite.user, Amount: cs(c("bnb", 100)), Referrer: suite.other}},
+		{"hard withdraw", hardtypes.NewMsgWithdraw(suite.user, cs(c("bnb", 100)))},
+		{"hard borrow", hardtypes.NewMsgBorrow(suite.user, cs(c("usdx", 100)))},
+		{"hard repay", hardtypes.NewMsgRepay(suite.user, suite.other, cs(c("usdx", 100)))},
+		{"cdp create", cdptypes.NewMsgCreateCDP(suite.user, c("xrp", 1000), c("usdx", 10), "xrp-a")},
+		{"cdp deposit", cdptypes.NewMsgDeposit(suite.other, suite.user, c("xrp", 1000), "xrp-a")},
+		{"cdp withdraw", cdptypes.NewMsgWithdraw(suite.user, suite.user, c("xrp", 1000), "xrp-a")},
+		{"cdp draw debt", cdptypes.NewMsgDrawDebt(suite.user, "xrp-a", c("usdx", 10))},
+		{"cdp repay debt", cdptypes.NewMsgRepayDebt(suite.user, "xrp-a", c("usdx", 10))},
+		{"bep3 create swap", bep3types.NewMsgCreateAtomicSwap(suite.user, suite.other, "bnb1recipient", "bnb1sender",
+			make([]byte, 32), 1600000000, cs(c("bnb", 100)), 250)},
+		{"bep3 claim swap", bep3types.NewMsgClaimAtomicSwap(suite.user, swapID, make([]byte, 32))},
+		{"bep3 refund swap", bep3types.NewMsgRefundAtomicSwap(suite.user, swapID)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ops, err := rosetta.MsgToOperations(tc.msg)
+			suite.Require().NoError(err)
+
+			// operations are sent to and from rosetta clients as json
+			bz, err := json.Marshal(ops)
+			suite.Require().NoError(err)
+			var decodedOps rosetta.Operations
+			suite.Require().NoError(json.Unmarshal(bz, &decodedOps))
+
+			msg, err := rosetta.OperationsToMsg(decodedOps)
+			suite.Require().NoError(err)
+			suite.Equal(tc.msg, msg)
+		})
+	}
+}
+
+func (suite *OperationsTestSuite) TestMsgToOperations_BalancesModuleAccount() {
+	ops, err := rosetta.MsgToOperations(hardtypes.NewMsgDeposit(suite.user, cs(c("bnb", 100))))
+	suite.Require().NoError(err)
+	suite.Require().Len(ops, 2)
+
+	suite.Equal(rosetta.OpHardDeposit, ops[0].Type)
+	suite.Equal(suite.user.String(), ops[0].Account.Address)
+	suite.Equal("-100", ops[0].Amount.Value)
+	suite.Equal("bnb", ops[0].Amount.Currency.Symbol)
+
+	suite.Equal(supply.NewModuleAddress(hardtypes.ModuleAccountName).String(), ops[1].Account.Address)
+	suite.Equal("100", ops[1].Amount.Value)
+	suite.Equal([]rosetta.OperationIdentifier{ops[0].OperationIdentifier}, ops[1].RelatedOperations)
+
+	for _, op := range ops.WithStatus(rosetta.StatusSuccess) {
+		suite.Equal(rosetta.StatusSuccess, op.Status)
+	}
+}
+
+func (suite *OperationsTestSuite) TestOperationsToMsg_Errors() {
+	depositOp := func(addr sdk.AccAddress, value string) rosetta.Operation {
+		return rosetta.Operation{
+			Type:    rosetta.OpHardDeposit,
+			Account: &rosetta.AccountIdentifier{Address: addr.String()},
+			Amount:  &rosetta.Amount{Value: value, Currency: rosetta.NewCurrency("bnb")},
+		}
+	}
+	testCases := []struct {
+		name string
+		ops  rosetta.Operations
+	}{
+		{"no operations", rosetta.Operations{}},
+		{"unsupported type", rosetta.Operations{{Type: "transfer", Account: &rosetta.AccountIdentifier{Address: suite.user.String()}}}},
+		{"mixed types", rosetta.Operations{depositOp(suite.user, "-100"), {Type: rosetta.OpHardBorrow, Account: &rosetta.AccountIdentifier{Address: suite.user.String()}}}},
+		{"multiple signers", rosetta.Operations{depositOp(suite.user, "-100"), depositOp(suite.other, "-100")}},
+		{"wrong direction", rosetta.Operations{depositOp(suite.user, "100")}},
+		{"invalid amount", rosetta.Operations{depositOp(suite.user, "-1.5")}},
+		{"missing metadata", rosetta.Operations{{
+			Type:    rosetta.OpCdpDrawDebt,
+			Account: &rosetta.AccountIdentifier{Address: suite.user.String()},
+			Amount:  &rosetta.Amount{Value: "10", Currency: rosetta.NewCurrency("usdx")},
+		}}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := rosetta.OperationsToMsg(tc.ops)
+			suite.Error(err)
+		})
+	}
+}
+
+func TestOperationsTestSuite(t *testing.T) {
+	suite.Run(t, new(OperationsTestSuite))
+}
+
+func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
+func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
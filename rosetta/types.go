@@ -0,0 +1,100 @@
+package rosetta
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Operation statuses reported by the data API
+const (
+	StatusSuccess = "success"
+	StatusFailure = "failure"
+)
+
+// Currency is a rosetta currency, identified by the coin denom
+type Currency struct {
+	Symbol   string `json:"symbol" yaml:"symbol"`
+	Decimals int32  `json:"decimals" yaml:"decimals"`
+}
+
+// NewCurrency returns the currency for a coin denom
+func NewCurrency(denom string) Currency {
+	return Currency{
+		Symbol:   denom,
+		Decimals: 0,
+	}
+}
+
+// Amount is a signed rosetta amount, positive for credits and negative for debits
+type Amount struct {
+	Value    string   `json:"value" yaml:"value"`
+	Currency Currency `json:"currency" yaml:"currency"`
+}
+
+// NewAmount returns an amount for a coin, negated if the coin is debited
+func NewAmount(coin sdk.Coin, debit bool) *Amount {
+	value := coin.Amount
+	if debit {
+		value = value.Neg()
+	}
+	return &Amount{
+		Value:    value.String(),
+		Currency: NewCurrency(coin.Denom),
+	}
+}
+
+// Coin returns the absolute value of the amount as a coin, and whether the amount was a debit
+func (a Amount) Coin() (sdk.Coin, bool, error) {
+	value, ok := sdk.NewIntFromString(a.Value)
+	if !ok {
+		return sdk.Coin{}, false, fmt.Errorf("invalid amount value %s", a.Value)
+	}
+	debit := value.IsNegative()
+	if debit {
+		value = value.Neg()
+	}
+	if err := sdk.ValidateDenom(a.Currency.Symbol); err != nil {
+		return sdk.Coin{}, false, err
+	}
+	return sdk.NewCoin(a.Currency.Symbol, value), debit, nil
+}
+
+// AccountIdentifier is a rosetta account, identified by its bech32 address
+type AccountIdentifier struct {
+	Address string `json:"address" yaml:"address"`
+}
+
+// NewAccountIdentifier returns the account identifier for an address
+func NewAccountIdentifier(addr sdk.AccAddress) *AccountIdentifier {
+	return &AccountIdentifier{Address: addr.String()}
+}
+
+// OperationIdentifier uniquely identifies an operation within a transaction
+type OperationIdentifier struct {
+	Index int64 `json:"index" yaml:"index"`
+}
+
+// Operation is a rosetta operation, a single balance change or action within a transaction
+type Operation struct {
+	OperationIdentifier OperationIdentifier   `json:"operation_identifier" yaml:"operation_identifier"`
+	RelatedOperations   []OperationIdentifier `json:"related_operations,omitempty" yaml:"related_operations,omitempty"`
+	Type                string                `json:"type" yaml:"type"`
+	Status              string                `json:"status,omitempty" yaml:"status,omitempty"`
+	Account             *AccountIdentifier    `json:"account,omitempty" yaml:"account,omitempty"`
+	Amount              *Amount               `json:"amount,omitempty" yaml:"amount,omitempty"`
+	Metadata            map[string]string     `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+}
+
+// Operations is a slice of Operation
+type Operations []Operation
+
+// WithStatus returns the operations with the status set, as required by the data API
+func (ops Operations) WithStatus(status string) Operations {
+	updated := make(Operations, len(ops))
+	for i, op := range ops {
+		op.Status = status
+		updated[i] = op
+	}
+	return updated
+}
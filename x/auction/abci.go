@@ -15,3 +15,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		panic(err)
 	}
 }
+
+// EndBlocker places auto-bids on the auctions started in the block
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.MatchAutoBids(ctx)
+}
@@ -8,105 +8,165 @@ import (
 )
 
 const (
-	AttributeKeyAuctionID     = types.AttributeKeyAuctionID
-	AttributeKeyAuctionType   = types.AttributeKeyAuctionType
-	AttributeKeyBid           = types.AttributeKeyBid
-	AttributeKeyBidder        = types.AttributeKeyBidder
-	AttributeKeyCloseBlock    = types.AttributeKeyCloseBlock
-	AttributeKeyEndTime       = types.AttributeKeyEndTime
-	AttributeKeyLot           = types.AttributeKeyLot
-	AttributeKeyMaxBid        = types.AttributeKeyMaxBid
-	AttributeValueCategory    = types.AttributeValueCategory
-	CollateralAuctionType     = types.CollateralAuctionType
-	DebtAuctionType           = types.DebtAuctionType
-	DefaultBidDuration        = types.DefaultBidDuration
-	DefaultMaxAuctionDuration = types.DefaultMaxAuctionDuration
-	DefaultNextAuctionID      = types.DefaultNextAuctionID
-	DefaultParamspace         = types.DefaultParamspace
-	EventTypeAuctionBid       = types.EventTypeAuctionBid
-	EventTypeAuctionClose     = types.EventTypeAuctionClose
-	EventTypeAuctionStart     = types.EventTypeAuctionStart
-	ForwardAuctionPhase       = types.ForwardAuctionPhase
-	ModuleName                = types.ModuleName
-	QuerierRoute              = types.QuerierRoute
-	QueryGetAuction           = types.QueryGetAuction
-	QueryGetAuctions          = types.QueryGetAuctions
-	QueryGetParams            = types.QueryGetParams
-	QueryNextAuctionID        = types.QueryNextAuctionID
-	ReverseAuctionPhase       = types.ReverseAuctionPhase
-	RouterKey                 = types.RouterKey
-	StoreKey                  = types.StoreKey
-	SurplusAuctionType        = types.SurplusAuctionType
+	AttributeKeyAmount                   = types.AttributeKeyAmount
+	AttributeKeyAuctionID                = types.AttributeKeyAuctionID
+	AttributeKeyAuctionType              = types.AttributeKeyAuctionType
+	AttributeKeyAutoBidID                = types.AttributeKeyAutoBidID
+	AttributeKeyBid                      = types.AttributeKeyBid
+	AttributeKeyBidder                   = types.AttributeKeyBidder
+	AttributeKeyCloseBlock               = types.AttributeKeyCloseBlock
+	AttributeKeyEndTime                  = types.AttributeKeyEndTime
+	AttributeKeyLot                      = types.AttributeKeyLot
+	AttributeKeyMaxBid                   = types.AttributeKeyMaxBid
+	AttributeKeyOwner                    = types.AttributeKeyOwner
+	AttributeValueCategory               = types.AttributeValueCategory
+	CollateralAuctionType                = types.CollateralAuctionType
+	DebtAuctionType                      = types.DebtAuctionType
+	DefaultBidDuration                   = types.DefaultBidDuration
+	DefaultMaxAuctionDuration            = types.DefaultMaxAuctionDuration
+	DefaultMaxCollateralAuctionsPerBlock = types.DefaultMaxCollateralAuctionsPerBlock
+	DefaultMinAuctionDuration            = types.DefaultMinAuctionDuration
+	DefaultNextAuctionID                 = types.DefaultNextAuctionID
+	DefaultNextAutoBidID                 = types.DefaultNextAutoBidID
+	DefaultParamspace                    = types.DefaultParamspace
+	EventTypeAuctionBid                  = types.EventTypeAuctionBid
+	EventTypeAuctionClose                = types.EventTypeAuctionClose
+	EventTypeAuctionStart                = types.EventTypeAuctionStart
+	EventTypeAutoBid                     = types.EventTypeAutoBid
+	EventTypeAutoBidCancel               = types.EventTypeAutoBidCancel
+	EventTypeAutoBidRegister             = types.EventTypeAutoBidRegister
+	EventTypeEscrowDeposit               = types.EventTypeEscrowDeposit
+	EventTypeEscrowWithdrawal            = types.EventTypeEscrowWithdrawal
+	ForwardAuctionPhase                  = types.ForwardAuctionPhase
+	MetricsSubsystem                     = types.MetricsSubsystem
+	ModuleName                           = types.ModuleName
+	QuerierRoute                         = types.QuerierRoute
+	QueryGetAuction                      = types.QueryGetAuction
+	QueryGetAuctions                     = types.QueryGetAuctions
+	QueryGetAutoBids                     = types.QueryGetAutoBids
+	QueryGetBidInfo                      = types.QueryGetBidInfo
+	QueryGetEscrow                       = types.QueryGetEscrow
+	QueryGetParams                       = types.QueryGetParams
+	QueryNextAuctionID                   = types.QueryNextAuctionID
+	ReverseAuctionPhase                  = types.ReverseAuctionPhase
+	RouterKey                            = types.RouterKey
+	StoreKey                             = types.StoreKey
+	SurplusAuctionType                   = types.SurplusAuctionType
 )
 
 var (
 	// function aliases
-	ModuleAccountInvariants  = keeper.ModuleAccountInvariants
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterInvariants       = keeper.RegisterInvariants
-	ValidAuctionInvariant    = keeper.ValidAuctionInvariant
-	ValidIndexInvariant      = keeper.ValidIndexInvariant
-	DefaultGenesisState      = types.DefaultGenesisState
-	DefaultParams            = types.DefaultParams
-	GetAuctionByTimeKey      = types.GetAuctionByTimeKey
-	GetAuctionKey            = types.GetAuctionKey
-	NewAuctionWithPhase      = types.NewAuctionWithPhase
-	NewCollateralAuction     = types.NewCollateralAuction
-	NewDebtAuction           = types.NewDebtAuction
-	NewGenesisState          = types.NewGenesisState
-	NewMsgPlaceBid           = types.NewMsgPlaceBid
-	NewParams                = types.NewParams
-	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
-	NewQueryAuctionParams    = types.NewQueryAuctionParams
-	NewSurplusAuction        = types.NewSurplusAuction
-	NewWeightedAddresses     = types.NewWeightedAddresses
-	ParamKeyTable            = types.ParamKeyTable
-	RegisterCodec            = types.RegisterCodec
-	Uint64FromBytes          = types.Uint64FromBytes
-	Uint64ToBytes            = types.Uint64ToBytes
+	ModuleAccountInvariants     = keeper.ModuleAccountInvariants
+	NewKeeper                   = keeper.NewKeeper
+	NewQuerier                  = keeper.NewQuerier
+	RegisterInvariants          = keeper.RegisterInvariants
+	ValidAuctionInvariant       = keeper.ValidAuctionInvariant
+	ValidIndexInvariant         = keeper.ValidIndexInvariant
+	DefaultGenesisState         = types.DefaultGenesisState
+	DefaultParams               = types.DefaultParams
+	GetAuctionByTimeKey         = types.GetAuctionByTimeKey
+	GetAuctionKey               = types.GetAuctionKey
+	NewAuctionSettlement        = types.NewAuctionSettlement
+	NewAuctionWithPhase         = types.NewAuctionWithPhase
+	NewAutoBid                  = types.NewAutoBid
+	NewAutoBidDenom             = types.NewAutoBidDenom
+	NewAutoBidEscrow            = types.NewAutoBidEscrow
+	NewBidInfo                  = types.NewBidInfo
+	NewCollateralAuction        = types.NewCollateralAuction
+	NewDebtAuction              = types.NewDebtAuction
+	NewGenesisState             = types.NewGenesisState
+	NewMsgCancelAutoBid         = types.NewMsgCancelAutoBid
+	NewMsgDepositAutoBidEscrow  = types.NewMsgDepositAutoBidEscrow
+	NewMsgPlaceBid              = types.NewMsgPlaceBid
+	NewMsgRegisterAutoBid       = types.NewMsgRegisterAutoBid
+	NewMsgWithdrawAutoBidEscrow = types.NewMsgWithdrawAutoBidEscrow
+	NewMultiAuctionHooks        = types.NewMultiAuctionHooks
+	NewParams                   = types.NewParams
+	NewQueryAllAuctionParams    = types.NewQueryAllAuctionParams
+	NewQueryAuctionParams       = types.NewQueryAuctionParams
+	NewQueryAutoBidsParams      = types.NewQueryAutoBidsParams
+	NewQueryEscrowParams        = types.NewQueryEscrowParams
+	NewSurplusAuction           = types.NewSurplusAuction
+	NewWeightedAddresses        = types.NewWeightedAddresses
+	NopMetrics                  = types.NopMetrics
+	ParamKeyTable               = types.ParamKeyTable
+	PrometheusMetrics           = types.PrometheusMetrics
+	RegisterCodec               = types.RegisterCodec
+	Uint64FromBytes             = types.Uint64FromBytes
+	Uint64ToBytes               = types.Uint64ToBytes
+	ValidateAutoBidAuctionType  = types.ValidateAutoBidAuctionType
 
 	// variable aliases
-	AuctionByTimeKeyPrefix     = types.AuctionByTimeKeyPrefix
-	AuctionKeyPrefix           = types.AuctionKeyPrefix
-	DefaultIncrement           = types.DefaultIncrement
-	DistantFuture              = types.DistantFuture
-	ErrAuctionHasExpired       = types.ErrAuctionHasExpired
-	ErrAuctionHasNotExpired    = types.ErrAuctionHasNotExpired
-	ErrAuctionNotFound         = types.ErrAuctionNotFound
-	ErrBidTooLarge             = types.ErrBidTooLarge
-	ErrBidTooSmall             = types.ErrBidTooSmall
-	ErrInvalidBidDenom         = types.ErrInvalidBidDenom
-	ErrInvalidInitialAuctionID = types.ErrInvalidInitialAuctionID
-	ErrInvalidLotDenom         = types.ErrInvalidLotDenom
-	ErrLotTooLarge             = types.ErrLotTooLarge
-	ErrLotTooSmall             = types.ErrLotTooSmall
-	ErrUnrecognizedAuctionType = types.ErrUnrecognizedAuctionType
-	KeyBidDuration             = types.KeyBidDuration
-	KeyIncrementCollateral     = types.KeyIncrementCollateral
-	KeyIncrementDebt           = types.KeyIncrementDebt
-	KeyIncrementSurplus        = types.KeyIncrementSurplus
-	KeyMaxAuctionDuration      = types.KeyMaxAuctionDuration
-	ModuleCdc                  = types.ModuleCdc
-	NextAuctionIDKey           = types.NextAuctionIDKey
+	AuctionByTimeKeyPrefix           = types.AuctionByTimeKeyPrefix
+	AuctionKeyPrefix                 = types.AuctionKeyPrefix
+	AutoBidCursorKey                 = types.AutoBidCursorKey
+	AutoBidEscrowKeyPrefix           = types.AutoBidEscrowKeyPrefix
+	AutoBidKeyPrefix                 = types.AutoBidKeyPrefix
+	CollateralAuctionCountKey        = types.CollateralAuctionCountKey
+	DefaultAutoBidDenoms             = types.DefaultAutoBidDenoms
+	DefaultIncrement                 = types.DefaultIncrement
+	DistantFuture                    = types.DistantFuture
+	ErrAuctionHasExpired             = types.ErrAuctionHasExpired
+	ErrAuctionHasNotExpired          = types.ErrAuctionHasNotExpired
+	ErrAuctionNotFound               = types.ErrAuctionNotFound
+	ErrAutoBidNotFound               = types.ErrAutoBidNotFound
+	ErrBidTooLarge                   = types.ErrBidTooLarge
+	ErrBidTooSmall                   = types.ErrBidTooSmall
+	ErrInsufficientEscrow            = types.ErrInsufficientEscrow
+	ErrInvalidAutoBidDenom           = types.ErrInvalidAutoBidDenom
+	ErrInvalidBidDenom               = types.ErrInvalidBidDenom
+	ErrInvalidInitialAuctionID       = types.ErrInvalidInitialAuctionID
+	ErrInvalidLotDenom               = types.ErrInvalidLotDenom
+	ErrLotTooLarge                   = types.ErrLotTooLarge
+	ErrLotTooSmall                   = types.ErrLotTooSmall
+	ErrUnrecognizedAuctionType       = types.ErrUnrecognizedAuctionType
+	KeyAutoBidDenoms                 = types.KeyAutoBidDenoms
+	KeyBidDuration                   = types.KeyBidDuration
+	KeyIncrementCollateral           = types.KeyIncrementCollateral
+	KeyIncrementDebt                 = types.KeyIncrementDebt
+	KeyIncrementSurplus              = types.KeyIncrementSurplus
+	KeyMaxAuctionDuration            = types.KeyMaxAuctionDuration
+	KeyMaxCollateralAuctionsPerBlock = types.KeyMaxCollateralAuctionsPerBlock
+	KeyMinAuctionDuration            = types.KeyMinAuctionDuration
+	ModuleCdc                        = types.ModuleCdc
+	NextAuctionIDKey                 = types.NextAuctionIDKey
+	NextAutoBidIDKey                 = types.NextAutoBidIDKey
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Auction               = types.Auction
-	AuctionWithPhase      = types.AuctionWithPhase
-	Auctions              = types.Auctions
-	BaseAuction           = types.BaseAuction
-	CollateralAuction     = types.CollateralAuction
-	DebtAuction           = types.DebtAuction
-	GenesisAuction        = types.GenesisAuction
-	GenesisAuctions       = types.GenesisAuctions
-	GenesisState          = types.GenesisState
-	MsgPlaceBid           = types.MsgPlaceBid
-	Params                = types.Params
-	QueryAllAuctionParams = types.QueryAllAuctionParams
-	QueryAuctionParams    = types.QueryAuctionParams
-	SupplyKeeper          = types.SupplyKeeper
-	SurplusAuction        = types.SurplusAuction
-	WeightedAddresses     = types.WeightedAddresses
+	Keeper                   = keeper.Keeper
+	Auction                  = types.Auction
+	AuctionHooks             = types.AuctionHooks
+	AuctionSettlement        = types.AuctionSettlement
+	AuctionWithPhase         = types.AuctionWithPhase
+	Auctions                 = types.Auctions
+	AutoBid                  = types.AutoBid
+	AutoBidDenom             = types.AutoBidDenom
+	AutoBidDenoms            = types.AutoBidDenoms
+	AutoBidEscrow            = types.AutoBidEscrow
+	AutoBidEscrows           = types.AutoBidEscrows
+	AutoBids                 = types.AutoBids
+	BaseAuction              = types.BaseAuction
+	BidInfo                  = types.BidInfo
+	CollateralAuction        = types.CollateralAuction
+	DebtAuction              = types.DebtAuction
+	GenesisAuction           = types.GenesisAuction
+	GenesisAuctions          = types.GenesisAuctions
+	GenesisState             = types.GenesisState
+	Metrics                  = types.Metrics
+	MsgCancelAutoBid         = types.MsgCancelAutoBid
+	MsgDepositAutoBidEscrow  = types.MsgDepositAutoBidEscrow
+	MsgPlaceBid              = types.MsgPlaceBid
+	MsgRegisterAutoBid       = types.MsgRegisterAutoBid
+	MsgWithdrawAutoBidEscrow = types.MsgWithdrawAutoBidEscrow
+	MultiAuctionHooks        = types.MultiAuctionHooks
+	Params                   = types.Params
+	PricefeedKeeper          = types.PricefeedKeeper
+	QueryAllAuctionParams    = types.QueryAllAuctionParams
+	QueryAuctionParams       = types.QueryAuctionParams
+	QueryAutoBidsParams      = types.QueryAutoBidsParams
+	QueryEscrowParams        = types.QueryEscrowParams
+	SupplyKeeper             = types.SupplyKeeper
+	SurplusAuction           = types.SurplusAuction
+	WeightedAddresses        = types.WeightedAddresses
 )
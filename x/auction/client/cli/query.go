@@ -36,7 +36,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	auctionQueryCmd.AddCommand(flags.GetCommands(
 		QueryGetAuctionCmd(queryRoute, cdc),
 		QueryGetAuctionsCmd(queryRoute, cdc),
+		QueryBidInfoCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QueryAutoBidsCmd(queryRoute, cdc),
+		QueryEscrowCmd(queryRoute, cdc),
 	)...)
 
 	return auctionQueryCmd
@@ -183,6 +186,49 @@ $ kvcli q auction auctions --page=2 --limit=100
 	return cmd
 }
 
+// QueryBidInfoCmd queries the current phase and next valid bid of an auction
+func QueryBidInfoCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid-info [auction-id]",
+		Short: "get the current phase and next valid bid of an auction",
+		Long: strings.TrimSpace(`Get the current phase of an open auction, the price implied by its bid, the time remaining,
+and the next bid it will accept.
+
+In the forward phase next_bid is the smallest bid amount that will be accepted.
+In the reverse phase next_bid is the largest lot that will be accepted.
+
+Example:
+$ kvcli q auction bid-info 34
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAuctionParams(id))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBidInfo), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var bidInfo types.BidInfo
+			cdc.MustUnmarshalJSON(res, &bidInfo)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(bidInfo)
+		},
+	}
+}
+
 // QueryParamsCmd queries the auction module parameters
 func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -208,3 +254,81 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryAutoBidsCmd queries the registered auto-bids
+func QueryAutoBidsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-bids",
+		Short: "query auto-bids with an optional owner filter",
+		Long: strings.TrimSpace(`Query for all registered auto-bids, or only those of an owner:
+Example:
+$ kvcli q auction auto-bids
+$ kvcli q auction auto-bids --owner=kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+			if strOwner := viper.GetString(flagOwner); len(strOwner) != 0 {
+				var err error
+				owner, err = sdk.AccAddressFromBech32(strings.TrimSpace(strOwner))
+				if err != nil {
+					return err
+				}
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAutoBidsParams(owner))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAutoBids), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var autoBids types.AutoBids
+			cdc.MustUnmarshalJSON(res, &autoBids)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(autoBids)
+		},
+	}
+	cmd.Flags().String(flagOwner, "", "(optional) filter by auto-bid owner")
+	return cmd
+}
+
+// QueryEscrowCmd queries the auto-bid escrow of an address
+func QueryEscrowCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "escrow [address]",
+		Short:   "get the coins an address has escrowed for auto-bids",
+		Example: "kvcli q auction escrow kava1hatdq32u5x4wnxrtv5wzjzmq49sxgjgsj0mffm",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryEscrowParams(owner))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetEscrow), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var escrow types.AutoBidEscrow
+			cdc.MustUnmarshalJSON(res, &escrow)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(escrow)
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -28,22 +29,31 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	auctionTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPlaceBid(cdc),
+		GetCmdDepositEscrow(cdc),
+		GetCmdWithdrawEscrow(cdc),
+		GetCmdRegisterAutoBid(cdc),
+		GetCmdCancelAutoBid(cdc),
 	)...)
 
 	return auctionTxCmd
 }
 
+const flagLotRecipient = "lot-recipient"
+
 // GetCmdPlaceBid cli command for placing bids on auctions
 func GetCmdPlaceBid(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "bid [auction-id] [amount]",
 		Short: "place a bid on an auction",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Place a bid on any type of auction, updating the latest bid amount to [amount]. Collateral auctions must be bid up to their maxbid before entering reverse phase.
 
+If the bid wins, the lot is paid to the bidder, or to the --lot-recipient address if one is given.
+
 Example:
 $ %s tx %s bid 34 1000usdx --from myKeyName
-`, version.ClientName, types.ModuleName)),
+$ %s tx %s bid 34 1000usdx --lot-recipient kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from myKeyName
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -61,6 +71,12 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 			}
 
 			msg := types.NewMsgPlaceBid(id, cliCtx.GetFromAddress(), amt)
+			if lotRecipientBech := viper.GetString(flagLotRecipient); len(lotRecipientBech) != 0 {
+				msg.LotRecipient, err = sdk.AccAddressFromBech32(lotRecipientBech)
+				if err != nil {
+					return err
+				}
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -68,4 +84,137 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(flagLotRecipient, "", "(optional) address the lot is paid to if the bid wins, defaults to the bidder")
+	return cmd
+}
+
+// GetCmdDepositEscrow cli command for depositing coins to pay for auto-bids
+func GetCmdDepositEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-escrow [amount]",
+		Short: "deposit coins to pay for auto-bids",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit coins into the sender's auto-bid escrow. Auto-bids are paid from the escrow.
+
+Example:
+$ %s tx %s deposit-escrow 1000000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositAutoBidEscrow(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawEscrow cli command for withdrawing coins from the auto-bid escrow
+func GetCmdWithdrawEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-escrow [amount]",
+		Short: "withdraw coins from the auto-bid escrow",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw coins from the sender's auto-bid escrow.
+
+Example:
+$ %s tx %s withdraw-escrow 1000000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawAutoBidEscrow(cliCtx.GetFromAddress(), amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRegisterAutoBid cli command for registering an auto-bid
+func GetCmdRegisterAutoBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-auto-bid [auction-type] [lot-denom] [max-price-ratio] [max-spend]",
+		Short: "register a standing bid on new auctions",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register an auto-bid that bids on new collateral or surplus auctions of a lot denom.
+Each bid is worth at most [max-price-ratio] times the oracle value of the lot, and the auto-bid spends at most [max-spend] in total.
+Bids are paid from the sender's escrow.
+
+Example:
+$ %s tx %s register-auto-bid collateral bnb 0.95 10000000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			maxPriceRatio, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			maxSpend, err := sdk.ParseCoin(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterAutoBid(cliCtx.GetFromAddress(), args[0], args[1], maxPriceRatio, maxSpend)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelAutoBid cli command for cancelling an auto-bid
+func GetCmdCancelAutoBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-auto-bid [auto-bid-id]",
+		Short: "cancel an auto-bid",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel one of the sender's auto-bids. Bids it has already placed are not withdrawn.
+
+Example:
+$ %s tx %s cancel-auto-bid 3 --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auto-bid-id '%s' not a valid uint", args[0])
+			}
+
+			msg := types.NewMsgCancelAutoBid(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 }
@@ -25,6 +25,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 // placeBidReq defines the properties of a bid request's body
 type placeBidReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Amount  sdk.Coin     `json:"amount"`
+	BaseReq      rest.BaseReq   `json:"base_req"`
+	Amount       sdk.Coin       `json:"amount"`
+	LotRecipient sdk.AccAddress `json:"lot_recipient"`
 }
@@ -43,6 +43,7 @@ func bidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		// Create and return a StdTx
 		msg := types.NewMsgPlaceBid(auctionID, bidderAddr, req.Amount)
+		msg.LotRecipient = req.LotRecipient
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
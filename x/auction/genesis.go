@@ -25,6 +25,16 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 	}
 
+	keeper.SetNextAutoBidID(ctx, gs.NextAutoBidID)
+	for _, ab := range gs.AutoBids {
+		keeper.SetAutoBid(ctx, ab)
+	}
+	for _, e := range gs.Escrows {
+		keeper.SetEscrow(ctx, e)
+	}
+	// escrowed coins are also held by the module account
+	totalAuctionCoins = totalAuctionCoins.Add(gs.Escrows.Total()...)
+
 	// check if the module account exists
 	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleName)
 	if moduleAcc == nil {
@@ -56,5 +66,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(nextAuctionID, params, genAuctions)
+	return NewGenesisState(nextAuctionID, params, genAuctions, keeper.GetNextAutoBidID(ctx), keeper.GetAllAutoBids(ctx), keeper.GetAllEscrows(ctx))
 }
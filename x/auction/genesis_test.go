@@ -43,6 +43,9 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.DefaultNextAutoBidID,
+			auction.AutoBids{},
+			auction.AutoBidEscrows{},
 		)
 
 		// run init
@@ -78,6 +81,9 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.DefaultNextAutoBidID,
+			auction.AutoBids{},
+			auction.AutoBidEscrows{},
 		)
 
 		// check init fails
@@ -95,6 +101,9 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.DefaultNextAutoBidID,
+			auction.AutoBids{},
+			auction.AutoBidEscrows{},
 		)
 		// invalid as there is no module account setup
 
@@ -15,6 +15,14 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPlaceBid:
 			return handleMsgPlaceBid(ctx, keeper, msg)
+		case MsgDepositAutoBidEscrow:
+			return handleMsgDepositAutoBidEscrow(ctx, keeper, msg)
+		case MsgWithdrawAutoBidEscrow:
+			return handleMsgWithdrawAutoBidEscrow(ctx, keeper, msg)
+		case MsgRegisterAutoBid:
+			return handleMsgRegisterAutoBid(ctx, keeper, msg)
+		case MsgCancelAutoBid:
+			return handleMsgCancelAutoBid(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -23,7 +31,7 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) (*sdk.Result, error) {
 
-	err := keeper.PlaceBid(ctx, msg.AuctionID, msg.Bidder, msg.Amount)
+	err := keeper.PlaceBidWithLotRecipient(ctx, msg.AuctionID, msg.Bidder, msg.LotRecipient, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -40,3 +48,79 @@ func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) (*sdk.Re
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgDepositAutoBidEscrow(ctx sdk.Context, keeper Keeper, msg MsgDepositAutoBidEscrow) (*sdk.Result, error) {
+	err := keeper.DepositEscrow(ctx, msg.Owner, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgWithdrawAutoBidEscrow(ctx sdk.Context, keeper Keeper, msg MsgWithdrawAutoBidEscrow) (*sdk.Result, error) {
+	err := keeper.WithdrawEscrow(ctx, msg.Owner, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgRegisterAutoBid(ctx sdk.Context, keeper Keeper, msg MsgRegisterAutoBid) (*sdk.Result, error) {
+	_, err := keeper.RegisterAutoBid(ctx, msg.Owner, msg.AuctionType, msg.LotDenom, msg.MaxPriceRatio, msg.MaxSpend)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgCancelAutoBid(ctx sdk.Context, keeper Keeper, msg MsgCancelAutoBid) (*sdk.Result, error) {
+	err := keeper.CancelAutoBid(ctx, msg.Owner, msg.AutoBidID)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
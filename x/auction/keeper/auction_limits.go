@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// GetCollateralAuctionsStartedInBlock returns the number of collateral auctions started in the current block
+func (k Keeper) GetCollateralAuctionsStartedInBlock(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CollateralAuctionCountKey)
+	if bz == nil || int64(types.Uint64FromBytes(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+	return types.Uint64FromBytes(bz[8:])
+}
+
+// incrementCollateralAuctionsStartedInBlock records a collateral auction started in the current block. The count is
+// stored with the block height, so it resets without any work in the first block it is incremented in.
+func (k Keeper) incrementCollateralAuctionsStartedInBlock(ctx sdk.Context) {
+	count := k.GetCollateralAuctionsStartedInBlock(ctx) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CollateralAuctionCountKey, append(types.Uint64ToBytes(uint64(ctx.BlockHeight())), types.Uint64ToBytes(count)...))
+}
+
+// HasCollateralAuctionCapacity returns true if fewer collateral auctions than the max collateral auctions per block
+// param have been started in the current block. Modules that liquidate positions check it before seizing each one,
+// leaving the remaining positions to be liquidated in later blocks. A position seized while there is capacity starts
+// all of its auctions, so the last position of a block can take the count past the limit.
+func (k Keeper) HasCollateralAuctionCapacity(ctx sdk.Context) bool {
+	limit := k.GetParams(ctx).MaxCollateralAuctionsPerBlock
+	return limit == 0 || k.GetCollateralAuctionsStartedInBlock(ctx) < limit
+}
@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestCollateralAuctionCapacity(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{sellerAcc}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1})
+	keeper := tApp.GetAuctionKeeper()
+	startAuction := func() {
+		_, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 10), c("token2", 10), addrs, is(1), c("debt", 10))
+		require.NoError(t, err)
+	}
+
+	// the default params place no limit on collateral auctions
+	startAuction()
+	startAuction()
+	require.Equal(t, uint64(2), keeper.GetCollateralAuctionsStartedInBlock(ctx))
+	require.True(t, keeper.HasCollateralAuctionCapacity(ctx))
+
+	params := keeper.GetParams(ctx)
+	params.MaxCollateralAuctionsPerBlock = 3
+	keeper.SetParams(ctx, params)
+	startAuction()
+	require.False(t, keeper.HasCollateralAuctionCapacity(ctx))
+
+	// surplus auctions do not count towards the limit
+	_, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 10), "token2")
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), keeper.GetCollateralAuctionsStartedInBlock(ctx))
+
+	// the count resets in the next block
+	ctx = ctx.WithBlockHeight(2)
+	require.Equal(t, uint64(0), keeper.GetCollateralAuctionsStartedInBlock(ctx))
+	require.True(t, keeper.HasCollateralAuctionCapacity(ctx))
+	startAuction()
+	require.Equal(t, uint64(1), keeper.GetCollateralAuctionsStartedInBlock(ctx))
+}
+
+func TestMinAuctionDuration(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := tmtime.Now()
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetAuctionKeeper()
+
+	params := keeper.GetParams(ctx)
+	params.MinAuctionDuration = 6 * time.Hour
+	keeper.SetParams(ctx, params)
+
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+
+	// the first bid keeps the auction open for the min duration rather than one bid duration
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, startTime.Add(params.MinAuctionDuration), auction.GetEndTime())
+
+	// later bids do not shorten the auction
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, startTime.Add(params.MinAuctionDuration), auction.GetEndTime())
+
+	// and extend it by the bid duration once the min duration is nearly over
+	bidTime := startTime.Add(params.MinAuctionDuration - time.Minute)
+	ctx = ctx.WithBlockTime(bidTime)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 30)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, bidTime.Add(params.BidDuration), auction.GetEndTime())
+}
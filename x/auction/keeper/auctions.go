@@ -116,6 +116,7 @@ func (k Keeper) StartCollateralAuction(
 	if err != nil {
 		return 0, err
 	}
+	k.incrementCollateralAuctionsStartedInBlock(ctx)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -132,6 +133,12 @@ func (k Keeper) StartCollateralAuction(
 
 // PlaceBid places a bid on any auction.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) error {
+	return k.PlaceBidWithLotRecipient(ctx, auctionID, bidder, nil, newAmount)
+}
+
+// PlaceBidWithLotRecipient places a bid on any auction. If the bid wins, the lot is paid to the lot recipient
+// instead of the bidder. An empty lot recipient pays the lot to the bidder.
+func (k Keeper) PlaceBidWithLotRecipient(ctx sdk.Context, auctionID uint64, bidder, lotRecipient sdk.AccAddress, newAmount sdk.Coin) error {
 
 	auction, found := k.GetAuction(ctx, auctionID)
 	if !found {
@@ -167,23 +174,35 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		return err
 	}
 
-	k.SetAuction(ctx, updatedAuction)
+	k.SetAuction(ctx, withLotRecipient(updatedAuction, lotRecipient))
 
 	return nil
 }
 
+// withLotRecipient returns the auction with the lot recipient set, replacing the recipient of any previous bid
+func withLotRecipient(auction types.Auction, lotRecipient sdk.AccAddress) types.Auction {
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		a.LotRecipient = lotRecipient
+		return a
+	case types.DebtAuction:
+		a.LotRecipient = lotRecipient
+		return a
+	case types.CollateralAuction:
+		a.LotRecipient = lotRecipient
+		return a
+	default:
+		return auction
+	}
+}
+
 // PlaceBidSurplus places a forward bid on a surplus auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.SurplusAuction, error) {
 	// Validate new bid
 	if bid.Denom != auction.Bid.Denom {
 		return auction, sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s)", bid.Denom, auction.Bid.Denom)
 	}
-	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
-		sdk.MaxInt(
-			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementSurplus).RoundInt(),
-		),
-	)
+	minNewBidAmt := minNewBidAmount(auction.Bid.Amount, k.GetParams(ctx).IncrementSurplus)
 	if bid.Amount.LT(minNewBidAmt) {
 		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "%s < %s%s", bid, minNewBidAmt, auction.Bid.Denom)
 	}
@@ -215,9 +234,10 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).MinAuctionDuration)    // keep the auction open for at least the min duration
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(latestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.EndTime), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -241,12 +261,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	if auction.IsReversePhase() {
 		panic("cannot place forward bid on auction in reverse phase")
 	}
-	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
-		sdk.MaxInt(
-			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
-		),
-	)
+	minNewBidAmt := minNewBidAmount(auction.Bid.Amount, k.GetParams(ctx).IncrementCollateral)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, auction.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
 	if bid.Amount.LT(minNewBidAmt) {
 		return auction, sdkerrors.Wrapf(types.ErrBidTooSmall, "%s < %s%s", bid, minNewBidAmt, auction.Bid.Denom)
@@ -291,9 +306,10 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).MinAuctionDuration)    // keep the auction open for at least the min duration
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(latestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.EndTime), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -317,12 +333,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	if !auction.IsReversePhase() {
 		panic("cannot place reverse bid on auction in forward phase")
 	}
-	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
-		sdk.MaxInt(
-			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
-		),
-	)
+	maxNewLotAmt := maxNewLotAmount(auction.Lot.Amount, k.GetParams(ctx).IncrementCollateral)
 	if lot.Amount.GT(maxNewLotAmt) {
 		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s%s", lot, maxNewLotAmt, auction.Lot.Denom)
 	}
@@ -365,9 +376,10 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).MinAuctionDuration)    // keep the auction open for at least the min duration
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(latestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.EndTime), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -388,12 +400,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	if lot.Denom != auction.Lot.Denom {
 		return auction, sdkerrors.Wrapf(types.ErrInvalidLotDenom, lot.Denom, auction.Lot.Denom)
 	}
-	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
-		sdk.MaxInt(
-			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementDebt).RoundInt(),
-		),
-	)
+	maxNewLotAmt := maxNewLotAmount(auction.Lot.Amount, k.GetParams(ctx).IncrementDebt)
 	if lot.Amount.GT(maxNewLotAmt) {
 		return auction, sdkerrors.Wrapf(types.ErrLotTooLarge, "%s > %s%s", lot, maxNewLotAmt, auction.Lot.Denom)
 	}
@@ -431,9 +438,10 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
 		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.EndTime = ctx.BlockTime().Add(k.GetParams(ctx).MinAuctionDuration)    // keep the auction open for at least the min duration
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(latestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.EndTime), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -476,7 +484,9 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		return err
 	}
 
+	k.recordAuctionDuration(ctx, auction)
 	k.DeleteAuction(ctx, auctionID)
+	k.Logger(ctx).Info("closed auction", "id", auctionID, "type", auction.GetType(), "bidder", auction.GetBidder(), "bid", auction.GetBid(), "lot", auction.GetLot())
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -485,6 +495,9 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 			sdk.NewAttribute(types.AttributeKeyCloseBlock, fmt.Sprintf("%d", ctx.BlockHeight())),
 		),
 	)
+
+	// let the module that started the auction account for its result
+	k.AfterAuctionClosed(ctx, types.NewAuctionSettlement(auction))
 	return nil
 }
 
@@ -496,7 +509,7 @@ func (k Keeper) PayoutDebtAuction(ctx sdk.Context, auction types.DebtAuction) er
 		panic(fmt.Errorf("could not mint coins: %w", err))
 	}
 	// send the new coins from the initiator module to the bidder
-	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, auction.Initiator, auction.Bidder, sdk.NewCoins(auction.Lot))
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, auction.Initiator, auction.GetLotRecipient(), sdk.NewCoins(auction.Lot))
 	if err != nil {
 		return err
 	}
@@ -511,13 +524,13 @@ func (k Keeper) PayoutDebtAuction(ctx sdk.Context, auction types.DebtAuction) er
 // PayoutSurplusAuction pays out the proceeds for a surplus auction.
 func (k Keeper) PayoutSurplusAuction(ctx sdk.Context, auction types.SurplusAuction) error {
 	// Send the tokens from the auction module account where they are being managed to the bidder who won the auction
-	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Lot))
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.GetLotRecipient(), sdk.NewCoins(auction.Lot))
 }
 
 // PayoutCollateralAuction pays out the proceeds for a collateral auction.
 func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction types.CollateralAuction) error {
 	// Send the tokens from the auction module account where they are being managed to the bidder who won the auction
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Bidder, sdk.NewCoins(auction.Lot))
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.GetLotRecipient(), sdk.NewCoins(auction.Lot))
 	if err != nil {
 		return err
 	}
@@ -549,6 +562,14 @@ func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) error {
 	return err
 }
 
+// latestTime returns the latest of two times.
+func latestTime(t1, t2 time.Time) time.Time {
+	if t1.After(t2) {
+		return t1
+	}
+	return t2
+}
+
 // earliestTime returns the earliest of two times.
 func earliestTime(t1, t2 time.Time) time.Time {
 	if t1.Before(t2) {
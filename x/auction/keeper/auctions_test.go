@@ -62,6 +62,44 @@ func TestSurplusAuctionBasic(t *testing.T) {
 	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 80)))
 }
 
+func TestSurplusAuctionLotRecipient(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer, custody, otherBuyer := addrs[0], addrs[1], addrs[2]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner) // forward auctions burn proceeds
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(otherBuyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	require.NoError(t, err)
+
+	// a replaced bid's lot recipient does not carry over to the new bidder
+	require.NoError(t, keeper.PlaceBidWithLotRecipient(ctx, auctionID, otherBuyer, custody, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 15)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Empty(t, auction.(types.SurplusAuction).LotRecipient)
+
+	// the bidder pays for the lot and the lot recipient receives it
+	require.NoError(t, keeper.PlaceBidWithLotRecipient(ctx, auctionID, buyer, custody, c("token2", 20)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 80)))
+	tApp.CheckBalance(t, ctx, custody, cs(c("token1", 20)))
+}
+
 func TestDebtAuctionBasic(t *testing.T) {
 	// Setup
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
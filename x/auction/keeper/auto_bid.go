@@ -0,0 +1,342 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// SetNextAutoBidID stores an ID to be used for the next registered auto-bid
+func (k Keeper) SetNextAutoBidID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.NextAutoBidIDKey, types.Uint64ToBytes(id))
+}
+
+// GetNextAutoBidID returns the ID to be used for the next registered auto-bid
+func (k Keeper) GetNextAutoBidID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NextAutoBidIDKey)
+	if bz == nil {
+		return types.DefaultNextAutoBidID
+	}
+	return types.Uint64FromBytes(bz)
+}
+
+// SetAutoBid puts an auto-bid into the store
+func (k Keeper) SetAutoBid(ctx sdk.Context, autoBid types.AutoBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoBidKeyPrefix)
+	store.Set(types.Uint64ToBytes(autoBid.ID), k.cdc.MustMarshalBinaryBare(autoBid))
+}
+
+// GetAutoBid gets an auto-bid from the store
+func (k Keeper) GetAutoBid(ctx sdk.Context, id uint64) (types.AutoBid, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoBidKeyPrefix)
+	bz := store.Get(types.Uint64ToBytes(id))
+	if bz == nil {
+		return types.AutoBid{}, false
+	}
+	var autoBid types.AutoBid
+	k.cdc.MustUnmarshalBinaryBare(bz, &autoBid)
+	return autoBid, true
+}
+
+// DeleteAutoBid removes an auto-bid from the store
+func (k Keeper) DeleteAutoBid(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoBidKeyPrefix)
+	store.Delete(types.Uint64ToBytes(id))
+}
+
+// IterateAutoBids iterates over all auto-bids in order of ID. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateAutoBids(ctx sdk.Context, cb func(autoBid types.AutoBid) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AutoBidKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var autoBid types.AutoBid
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &autoBid)
+		if cb(autoBid) {
+			break
+		}
+	}
+}
+
+// GetAllAutoBids returns all auto-bids from the store
+func (k Keeper) GetAllAutoBids(ctx sdk.Context) types.AutoBids {
+	autoBids := types.AutoBids{}
+	k.IterateAutoBids(ctx, func(autoBid types.AutoBid) bool {
+		autoBids = append(autoBids, autoBid)
+		return false
+	})
+	return autoBids
+}
+
+// SetEscrow sets the escrowed coins of an address, removing the escrow if it is empty
+func (k Keeper) SetEscrow(ctx sdk.Context, escrow types.AutoBidEscrow) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoBidEscrowKeyPrefix)
+	if escrow.Amount.Empty() {
+		store.Delete(escrow.Owner)
+		return
+	}
+	store.Set(escrow.Owner, k.cdc.MustMarshalBinaryBare(escrow.Amount))
+}
+
+// GetEscrow returns the escrowed coins of an address
+func (k Keeper) GetEscrow(ctx sdk.Context, owner sdk.AccAddress) types.AutoBidEscrow {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoBidEscrowKeyPrefix)
+	bz := store.Get(owner)
+	if bz == nil {
+		return types.NewAutoBidEscrow(owner, sdk.NewCoins())
+	}
+	var amount sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return types.NewAutoBidEscrow(owner, amount)
+}
+
+// IterateEscrows iterates over all escrows. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateEscrows(ctx sdk.Context, cb func(escrow types.AutoBidEscrow) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AutoBidEscrowKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		owner := sdk.AccAddress(iterator.Key()[len(types.AutoBidEscrowKeyPrefix):])
+		if cb(types.NewAutoBidEscrow(owner, amount)) {
+			break
+		}
+	}
+}
+
+// GetAllEscrows returns all escrows from the store
+func (k Keeper) GetAllEscrows(ctx sdk.Context) types.AutoBidEscrows {
+	escrows := types.AutoBidEscrows{}
+	k.IterateEscrows(ctx, func(escrow types.AutoBidEscrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	return escrows
+}
+
+// DepositEscrow moves coins from an address into its escrow, to pay for its auto-bids
+func (k Keeper) DepositEscrow(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, amount); err != nil {
+		return err
+	}
+	escrow := k.GetEscrow(ctx, owner)
+	escrow.Amount = escrow.Amount.Add(amount...)
+	k.SetEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowDeposit,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawEscrow moves coins from an address's escrow back to the address
+func (k Keeper) WithdrawEscrow(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coins) error {
+	escrow := k.GetEscrow(ctx, owner)
+	remaining, isNegative := escrow.Amount.SafeSub(amount)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrInsufficientEscrow, "%s < %s", escrow.Amount, amount)
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, amount); err != nil {
+		return err
+	}
+	escrow.Amount = remaining
+	k.SetEscrow(ctx, escrow)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEscrowWithdrawal,
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// RegisterAutoBid registers a standing bid on new auctions and returns its ID. The lot and bid denoms must have auto-bid
+// prices in the params.
+func (k Keeper) RegisterAutoBid(ctx sdk.Context, owner sdk.AccAddress, auctionType, lotDenom string, maxPriceRatio sdk.Dec, maxSpend sdk.Coin) (uint64, error) {
+	autoBidDenoms := k.GetParams(ctx).AutoBidDenoms
+	for _, denom := range []string{lotDenom, maxSpend.Denom} {
+		if _, found := autoBidDenoms.Get(denom); !found {
+			return 0, sdkerrors.Wrap(types.ErrInvalidAutoBidDenom, denom)
+		}
+	}
+
+	id := k.GetNextAutoBidID(ctx)
+	autoBid := types.NewAutoBid(id, owner, auctionType, lotDenom, maxPriceRatio, maxSpend)
+	if err := autoBid.Validate(); err != nil {
+		return 0, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	k.SetAutoBid(ctx, autoBid)
+	k.SetNextAutoBidID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoBidRegister,
+			sdk.NewAttribute(types.AttributeKeyAutoBidID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auctionType),
+		),
+	)
+	return id, nil
+}
+
+// CancelAutoBid removes one of an address's auto-bids. Bids it has already placed are not affected.
+func (k Keeper) CancelAutoBid(ctx sdk.Context, owner sdk.AccAddress, id uint64) error {
+	autoBid, found := k.GetAutoBid(ctx, id)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAutoBidNotFound, "%d", id)
+	}
+	if !autoBid.Owner.Equals(owner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "auto-bid %d is not owned by %s", id, owner)
+	}
+	k.DeleteAutoBid(ctx, id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoBidCancel,
+			sdk.NewAttribute(types.AttributeKeyAutoBidID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+		),
+	)
+	return nil
+}
+
+// MatchAutoBids places auto-bids on the auctions started since auto-bids were last matched. The first time it runs,
+// it only records the next auction ID, so auctions started before auto-bids existed are not matched.
+func (k Keeper) MatchAutoBids(ctx sdk.Context) {
+	nextAuctionID, err := k.GetNextAuctionID(ctx)
+	if err != nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(types.AutoBidCursorKey); bz != nil {
+		for id := types.Uint64FromBytes(bz); id < nextAuctionID; id++ {
+			auction, found := k.GetAuction(ctx, id)
+			if !found {
+				continue
+			}
+			k.matchAutoBids(ctx, auction)
+		}
+	}
+	store.Set(types.AutoBidCursorKey, types.Uint64ToBytes(nextAuctionID))
+}
+
+// matchAutoBids places the highest bid any auto-bid is willing to make on a forward auction. Ties go to the auto-bid
+// registered first.
+func (k Keeper) matchAutoBids(ctx sdk.Context, auction types.Auction) {
+	params := k.GetParams(ctx)
+
+	var minBid, maxBid sdk.Int
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		minBid = minNewBidAmount(a.Bid.Amount, params.IncrementSurplus)
+	case types.CollateralAuction:
+		if a.IsReversePhase() {
+			return
+		}
+		minBid = sdk.MinInt(minNewBidAmount(a.Bid.Amount, params.IncrementCollateral), a.MaxBid.Amount)
+		maxBid = a.MaxBid.Amount
+	default:
+		return
+	}
+
+	lot := auction.GetLot()
+	bidDenom := auction.GetBid().Denom
+	lotValue, err := k.autoBidValue(ctx, params.AutoBidDenoms, lot.Denom, lot.Amount.ToDec())
+	if err != nil {
+		return
+	}
+	bidUnitValue, err := k.autoBidValue(ctx, params.AutoBidDenoms, bidDenom, sdk.OneDec())
+	if err != nil || !bidUnitValue.IsPositive() {
+		return
+	}
+
+	var (
+		best       types.AutoBid
+		bestAmount sdk.Int
+		found      bool
+	)
+	k.IterateAutoBids(ctx, func(autoBid types.AutoBid) bool {
+		if autoBid.AuctionType != auction.GetType() || autoBid.LotDenom != lot.Denom || autoBid.MaxSpend.Denom != bidDenom {
+			return false
+		}
+		amount := lotValue.Mul(autoBid.MaxPriceRatio).Quo(bidUnitValue).TruncateInt()
+		amount = sdk.MinInt(amount, autoBid.Remaining())
+		amount = sdk.MinInt(amount, k.GetEscrow(ctx, autoBid.Owner).Amount.AmountOf(bidDenom))
+		if !maxBid.IsNil() {
+			amount = sdk.MinInt(amount, maxBid)
+		}
+		if amount.LT(minBid) || !amount.IsPositive() {
+			return false
+		}
+		if !found || amount.GT(bestAmount) {
+			best, bestAmount, found = autoBid, amount, true
+		}
+		return false
+	})
+	if !found {
+		return
+	}
+
+	if err := k.placeAutoBid(ctx, auction, best, sdk.NewCoin(bidDenom, bestAmount)); err != nil {
+		k.Logger(ctx).Error("failed to place auto-bid", "auto_bid_id", best.ID, "auction_id", auction.GetID(), "err", err)
+	}
+}
+
+// placeAutoBid pays for a bid from the owner's escrow and places it on behalf of the owner
+func (k Keeper) placeAutoBid(ctx sdk.Context, auction types.Auction, autoBid types.AutoBid, bid sdk.Coin) error {
+	// a bidder replacing their own bid only pays the increase
+	cost := bid
+	if autoBid.Owner.Equals(auction.GetBidder()) {
+		cost = bid.Sub(auction.GetBid())
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	escrow := k.GetEscrow(cacheCtx, autoBid.Owner)
+	escrow.Amount = escrow.Amount.Sub(sdk.NewCoins(cost))
+	k.SetEscrow(cacheCtx, escrow)
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, autoBid.Owner, sdk.NewCoins(cost)); err != nil {
+		return err
+	}
+	if err := k.PlaceBid(cacheCtx, auction.GetID(), autoBid.Owner, bid); err != nil {
+		return err
+	}
+	autoBid.Spent = autoBid.Spent.Add(cost.Amount)
+	k.SetAutoBid(cacheCtx, autoBid)
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAutoBid,
+			sdk.NewAttribute(types.AttributeKeyAutoBidID, fmt.Sprintf("%d", autoBid.ID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
+			sdk.NewAttribute(types.AttributeKeyOwner, autoBid.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, bid.String()),
+		),
+	)
+	return nil
+}
+
+// autoBidValue returns the oracle value of an amount of a denom, in the quote asset of the auto-bid markets
+func (k Keeper) autoBidValue(ctx sdk.Context, autoBidDenoms types.AutoBidDenoms, denom string, amount sdk.Dec) (sdk.Dec, error) {
+	autoBidDenom, found := autoBidDenoms.Get(denom)
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrap(types.ErrInvalidAutoBidDenom, denom)
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, autoBidDenom.MarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return amount.Quo(autoBidDenom.ConversionFactor.ToDec()).Mul(price.Price), nil
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/auction/keeper"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestAutoBids(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer, secondBuyer, returnAddr := addrs[0], addrs[1], addrs[2]
+
+	tApp := app.NewTestApp()
+	gs := genesis.NewBuilder().
+		WithAccountBalance(buyer, cs(c("usdx", 1000))).
+		WithAccountBalance(secondBuyer, cs(c("usdx", 1000))).
+		WithModuleAccountBalance(cdp.LiquidatorMacc, cs(c("bnb", 20), c("debt", 400))).
+		WithPricefeedPrice("bnb:usd", sdk.MustNewDecFromStr("10.00")).
+		WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+		Build()
+	auctionGS := types.DefaultGenesisState()
+	auctionGS.Params.AutoBidDenoms = types.AutoBidDenoms{
+		types.NewAutoBidDenom("bnb", "bnb:usd", i(1)),
+		types.NewAutoBidDenom("usdx", "usdx:usd", i(1)),
+	}
+	gs[types.ModuleName] = types.ModuleCdc.MustMarshalJSON(auctionGS)
+	tApp.InitializeFromGenesisStates(gs)
+	ctx := tApp.NewContext(true, abci.Header{})
+	k := tApp.GetAuctionKeeper()
+	supplyKeeper := tApp.GetSupplyKeeper()
+
+	// escrow
+	require.NoError(t, k.DepositEscrow(ctx, buyer, cs(c("usdx", 500))))
+	require.NoError(t, k.DepositEscrow(ctx, secondBuyer, cs(c("usdx", 500))))
+	err := k.WithdrawEscrow(ctx, buyer, cs(c("usdx", 501)))
+	require.True(t, errors.Is(err, types.ErrInsufficientEscrow))
+	require.NoError(t, k.WithdrawEscrow(ctx, buyer, cs(c("usdx", 100))))
+	require.Equal(t, cs(c("usdx", 400)), k.GetEscrow(ctx, buyer).Amount)
+	require.Equal(t, cs(c("usdx", 600)), tApp.GetAccountKeeper().GetAccount(ctx, buyer).GetCoins())
+
+	// registration
+	_, err = k.RegisterAutoBid(ctx, buyer, types.CollateralAuctionType, "xrpb", sdk.MustNewDecFromStr("0.9"), c("usdx", 300))
+	require.True(t, errors.Is(err, types.ErrInvalidAutoBidDenom))
+	_, err = k.RegisterAutoBid(ctx, buyer, types.DebtAuctionType, "bnb", sdk.MustNewDecFromStr("0.9"), c("usdx", 300))
+	require.Error(t, err)
+	firstID, err := k.RegisterAutoBid(ctx, buyer, types.CollateralAuctionType, "bnb", sdk.MustNewDecFromStr("0.9"), c("usdx", 300))
+	require.NoError(t, err)
+	secondID, err := k.RegisterAutoBid(ctx, secondBuyer, types.CollateralAuctionType, "bnb", sdk.MustNewDecFromStr("0.95"), c("usdx", 50))
+	require.NoError(t, err)
+	require.Len(t, k.GetAllAutoBids(ctx), 2)
+
+	// the first run only records the cursor
+	auction.EndBlocker(ctx, k)
+
+	// lot is worth 100 usdx, the first auto-bid bids 90, the second is capped by its max spend at 50
+	auctionID, err := k.StartCollateralAuction(ctx, cdp.LiquidatorMacc, c("bnb", 10), c("usdx", 200), []sdk.AccAddress{returnAddr}, is(1), c("debt", 200))
+	require.NoError(t, err)
+	auction.EndBlocker(ctx, k)
+
+	a, found := k.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer, a.GetBidder())
+	require.Equal(t, c("usdx", 90), a.GetBid())
+	require.Equal(t, cs(c("usdx", 310)), k.GetEscrow(ctx, buyer).Amount)
+	require.Equal(t, cs(c("usdx", 600)), tApp.GetAccountKeeper().GetAccount(ctx, buyer).GetCoins())
+	autoBid, found := k.GetAutoBid(ctx, firstID)
+	require.True(t, found)
+	require.Equal(t, i(90), autoBid.Spent)
+
+	// auctions are only matched once
+	auction.EndBlocker(ctx, k)
+	a, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, c("usdx", 90), a.GetBid())
+
+	// only the owner can cancel an auto-bid
+	err = k.CancelAutoBid(ctx, buyer, secondID)
+	require.True(t, errors.Is(err, sdkerrors.ErrUnauthorized))
+	require.NoError(t, k.CancelAutoBid(ctx, buyer, firstID))
+	_, found = k.GetAutoBid(ctx, firstID)
+	require.False(t, found)
+
+	// the remaining auto-bid is capped by its max spend
+	auctionID, err = k.StartCollateralAuction(ctx, cdp.LiquidatorMacc, c("bnb", 10), c("usdx", 200), []sdk.AccAddress{returnAddr}, is(1), c("debt", 200))
+	require.NoError(t, err)
+	auction.EndBlocker(ctx, k)
+	a, _ = k.GetAuction(ctx, auctionID)
+	require.Equal(t, secondBuyer, a.GetBidder())
+	require.Equal(t, c("usdx", 50), a.GetBid())
+
+	_, broken := keeper.ModuleAccountInvariants(k)(ctx)
+	require.False(t, broken)
+	// forward bids return debt to the seller, leaving the lots, the remaining debt, and the escrow
+	require.Equal(t, cs(c("bnb", 20), c("debt", 260)).Add(k.GetEscrow(ctx, buyer).Amount...).Add(k.GetEscrow(ctx, secondBuyer).Amount...),
+		supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins())
+}
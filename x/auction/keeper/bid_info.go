@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// GetBidInfo returns the current phase of an auction, the price implied by its bid, and the next bid it will accept.
+// The next bid is computed with the same increments used to validate bids, so placing it at this block succeeds.
+func (k Keeper) GetBidInfo(ctx sdk.Context, auction types.Auction) (types.BidInfo, error) {
+	params := k.GetParams(ctx)
+	bid, lot := auction.GetBid(), auction.GetLot()
+
+	var nextBid sdk.Coin
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		nextBid = sdk.NewCoin(bid.Denom, minNewBidAmount(bid.Amount, params.IncrementSurplus))
+	case types.DebtAuction:
+		nextBid = sdk.NewCoin(lot.Denom, sdk.MaxInt(sdk.ZeroInt(), maxNewLotAmount(lot.Amount, params.IncrementDebt)))
+	case types.CollateralAuction:
+		if a.IsReversePhase() {
+			nextBid = sdk.NewCoin(lot.Denom, sdk.MaxInt(sdk.ZeroInt(), maxNewLotAmount(lot.Amount, params.IncrementCollateral)))
+		} else {
+			// bids may always reach MaxBid even when it is less than the increment
+			nextBid = sdk.NewCoin(bid.Denom, sdk.MinInt(minNewBidAmount(bid.Amount, params.IncrementCollateral), a.MaxBid.Amount))
+		}
+	default:
+		return types.BidInfo{}, sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
+	}
+
+	price := sdk.ZeroDec()
+	if lot.IsPositive() {
+		price = sdk.NewDecFromInt(bid.Amount).QuoInt(lot.Amount)
+	}
+
+	// auctions have no end time until they receive their first bid, report no time remaining rather than a
+	// duration that overflows
+	var timeRemaining time.Duration
+	if !auction.GetEndTime().Equal(types.DistantFuture) && auction.GetEndTime().After(ctx.BlockTime()) {
+		timeRemaining = auction.GetEndTime().Sub(ctx.BlockTime())
+	}
+
+	return types.NewBidInfo(auction.GetID(), auction.GetType(), auction.GetPhase(), bid, lot, price,
+		auction.GetEndTime(), timeRemaining, nextBid), nil
+}
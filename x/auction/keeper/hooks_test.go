@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestCloseAuctionSettlementHooks(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 30)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+
+	// the settlement passed to the hooks records how far the proceeds fell short of the max bid
+	settlement := types.NewAuctionSettlement(auction)
+	require.Equal(t, c("token2", 30), settlement.Proceeds)
+	require.Equal(t, c("token2", 20), settlement.Shortfall)
+	require.Equal(t, buyer, settlement.Winner)
+
+	ctx = ctx.WithBlockTime(auction.GetEndTime()).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+
+	// the cdp module, which started the auction, is called back with the settlement
+	var settlementEvents sdk.StringEvents
+	for _, event := range ctx.EventManager().Events().ToABCIEvents() {
+		if event.Type == cdp.EventTypeCdpAuctionSettlement {
+			settlementEvents = append(settlementEvents, sdk.StringifyEvent(event))
+		}
+	}
+	require.Len(t, settlementEvents, 1)
+	require.Contains(t, settlementEvents[0].Attributes, sdk.Attribute{Key: cdp.AttributeKeyShortfall, Value: "20token2"})
+	require.Contains(t, settlementEvents[0].Attributes, sdk.Attribute{Key: cdp.AttributeKeyProceeds, Value: "30token2"})
+}
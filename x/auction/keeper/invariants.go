@@ -20,7 +20,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidIndexInvariant(k))
 }
 
-// ModuleAccountInvariants checks that the module account's coins matches those stored in auctions
+// ModuleAccountInvariants checks that the module account's coins matches those stored in auctions and escrows
 func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 
@@ -33,6 +33,8 @@ func ModuleAccountInvariants(k Keeper) sdk.Invariant {
 			totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins()...)
 			return false
 		})
+		// escrowed coins are also held by the module account
+		totalAuctionCoins = totalAuctionCoins.Add(k.GetAllEscrows(ctx).Total()...)
 
 		moduleAccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
 		broken := !moduleAccCoins.IsEqual(totalAuctionCoins)
@@ -15,14 +15,17 @@ import (
 )
 
 type Keeper struct {
-	supplyKeeper  types.SupplyKeeper
-	storeKey      sdk.StoreKey
-	cdc           *codec.Codec
-	paramSubspace subspace.Subspace
+	supplyKeeper    types.SupplyKeeper
+	pricefeedKeeper types.PricefeedKeeper
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	paramSubspace   subspace.Subspace
+	metrics         *types.Metrics
+	hooks           types.AuctionHooks
 }
 
 // NewKeeper returns a new auction keeper.
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, paramstore subspace.Subspace) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.SupplyKeeper, pricefeedKeeper types.PricefeedKeeper, paramstore subspace.Subspace) Keeper {
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
@@ -32,13 +35,29 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.Suppl
 	}
 
 	return Keeper{
-		supplyKeeper:  supplyKeeper,
-		storeKey:      storeKey,
-		cdc:           cdc,
-		paramSubspace: paramstore,
+		supplyKeeper:    supplyKeeper,
+		pricefeedKeeper: pricefeedKeeper,
+		storeKey:        storeKey,
+		cdc:             cdc,
+		paramSubspace:   paramstore,
+		metrics:         types.NopMetrics(),
 	}
 }
 
+// SetHooks sets the auction keeper hooks
+func (k *Keeper) SetHooks(hooks types.AuctionHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set auction hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// SetMetrics sets the metrics the keeper reports to
+func (k *Keeper) SetMetrics(metrics *types.Metrics) {
+	k.metrics = metrics
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -79,11 +98,14 @@ func (k Keeper) StoreNewAuction(ctx sdk.Context, auction types.Auction) (uint64,
 	auction = auction.WithID(newAuctionID)
 
 	k.SetAuction(ctx, auction)
+	k.SetAuctionStartTime(ctx, newAuctionID, ctx.BlockTime())
 
 	err = k.IncrementNextAuctionID(ctx)
 	if err != nil {
 		return 0, err
 	}
+
+	k.Logger(ctx).Info("started auction", "id", newAuctionID, "type", auction.GetType(), "initiator", auction.GetInitiator(), "lot", auction.GetLot(), "end_time", auction.GetEndTime())
 	return newAuctionID, nil
 }
 
@@ -125,6 +147,28 @@ func (k Keeper) DeleteAuction(ctx sdk.Context, auctionID uint64) {
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionKeyPrefix)
 	store.Delete(types.GetAuctionKey(auctionID))
+
+	startTimeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStartTimeKeyPrefix)
+	startTimeStore.Delete(types.GetAuctionKey(auctionID))
+}
+
+// SetAuctionStartTime stores the time an auction was started
+func (k Keeper) SetAuctionStartTime(ctx sdk.Context, auctionID uint64, startTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStartTimeKeyPrefix)
+	store.Set(types.GetAuctionKey(auctionID), k.cdc.MustMarshalBinaryBare(startTime))
+}
+
+// GetAuctionStartTime returns the time an auction was started.
+// Start times are not recorded for auctions imported from genesis.
+func (k Keeper) GetAuctionStartTime(ctx sdk.Context, auctionID uint64) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionStartTimeKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var startTime time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &startTime)
+	return startTime, true
 }
 
 // InsertIntoByTimeIndex adds an auction ID and end time into the byTime index.
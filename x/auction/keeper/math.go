@@ -6,6 +6,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// minNewBidAmount returns the smallest bid that can replace the current bid in a forward auction.
+// New bids must be some % greater than the old bid, and at least 1 larger to avoid replacing an old bid at no cost.
+func minNewBidAmount(bid sdk.Int, increment sdk.Dec) sdk.Int {
+	return bid.Add(sdk.MaxInt(sdk.NewInt(1), sdk.NewDecFromInt(bid).Mul(increment).RoundInt()))
+}
+
+// maxNewLotAmount returns the largest lot that can replace the current lot in a reverse auction.
+// New lots must be some % less than the old lot, and at least 1 smaller to avoid replacing an old bid at no cost.
+func maxNewLotAmount(lot sdk.Int, increment sdk.Dec) sdk.Int {
+	return lot.Sub(sdk.MaxInt(sdk.NewInt(1), sdk.NewDecFromInt(lot).Mul(increment).RoundInt()))
+}
+
 // splitIntIntoWeightedBuckets divides an initial +ve integer among several buckets in proportion to the buckets' weights
 // It uses the largest remainder method: https://en.wikipedia.org/wiki/Largest_remainder_method
 // See also: https://stackoverflow.com/questions/13483430/how-to-make-rounded-percentages-add-up-to-100
@@ -106,6 +106,18 @@ func TestSplitIntIntoWeightedBuckets(t *testing.T) {
 	}
 }
 
+func TestNewBidAndLotAmounts(t *testing.T) {
+	increment := sdk.MustNewDecFromStr("0.05")
+
+	require.Equal(t, i(1), minNewBidAmount(i(0), increment))
+	require.Equal(t, i(11), minNewBidAmount(i(10), increment))
+	require.Equal(t, i(105), minNewBidAmount(i(100), increment))
+
+	require.Equal(t, i(-1), maxNewLotAmount(i(0), increment))
+	require.Equal(t, i(9), maxNewLotAmount(i(10), increment))
+	require.Equal(t, i(95), maxNewLotAmount(i(100), increment))
+}
+
 func i(n int64) sdk.Int { return sdk.NewInt(n) }
 func is(ns ...int64) (is []sdk.Int) {
 	for _, n := range ns {
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// recordAuctionDuration reports the time between the start and close of an auction.
+// Auctions without a recorded start time are skipped.
+func (k Keeper) recordAuctionDuration(ctx sdk.Context, auction types.Auction) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	startTime, found := k.GetAuctionStartTime(ctx, auction.GetID())
+	if !found {
+		return
+	}
+	duration := ctx.BlockTime().Sub(startTime)
+	k.metrics.AuctionDuration.With("auction_type", auction.GetType()).Observe(duration.Seconds())
+}
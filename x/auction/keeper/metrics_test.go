@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+// recordingHistogram records all observations along with the label values they were made with
+type recordingHistogram struct {
+	labelValues  []string
+	observations []float64
+}
+
+func (h *recordingHistogram) With(labelValues ...string) metrics.Histogram {
+	h.labelValues = labelValues
+	return h
+}
+
+func (h *recordingHistogram) Observe(value float64) {
+	h.observations = append(h.observations, value)
+}
+
+func TestAuctionDurationMetrics(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100))))
+
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	startTime := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(false, abci.Header{Time: startTime})
+
+	keeper := tApp.GetAuctionKeeper()
+	histogram := &recordingHistogram{}
+	keeper.SetMetrics(&types.Metrics{AuctionDuration: histogram})
+
+	// Start an auction and check the start time is recorded
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	require.NoError(t, err)
+	storedStartTime, found := keeper.GetAuctionStartTime(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, startTime, storedStartTime)
+
+	// Close the auction and check the duration is reported and the start time removed
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	require.Equal(t, []string{"auction_type", types.SurplusAuctionType}, histogram.labelValues)
+	require.Equal(t, []float64{types.DefaultBidDuration.Seconds()}, histogram.observations)
+	_, found = keeper.GetAuctionStartTime(ctx, auctionID)
+	require.False(t, found)
+
+	// Auctions without a recorded start time are not reported
+	auction := types.NewSurplusAuction(sellerModName, c("token1", 0), "token2", ctx.BlockTime()).WithID(auctionID + 1)
+	keeper.SetAuction(ctx, auction)
+	require.NoError(t, keeper.CloseAuction(ctx, auction.GetID()))
+	require.Len(t, histogram.observations, 1)
+}
@@ -14,3 +14,22 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// InitializeAutoBidParams sets the auto-bid denoms param to its default if it is not set, so that params can be read
+// on chains that started before auto-bids were added
+func (k Keeper) InitializeAutoBidParams(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeyAutoBidDenoms) {
+		k.paramSubspace.Set(ctx, types.KeyAutoBidDenoms, types.DefaultAutoBidDenoms)
+	}
+}
+
+// InitializeAuctionLimitParams sets the min auction duration and max collateral auctions per block params to their
+// defaults if they are not set, so that params can be read on chains that started before they were added
+func (k Keeper) InitializeAuctionLimitParams(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeyMinAuctionDuration) {
+		k.paramSubspace.Set(ctx, types.KeyMinAuctionDuration, types.DefaultMinAuctionDuration)
+	}
+	if !k.paramSubspace.Has(ctx, types.KeyMaxCollateralAuctionsPerBlock) {
+		k.paramSubspace.Set(ctx, types.KeyMaxCollateralAuctionsPerBlock, types.DefaultMaxCollateralAuctionsPerBlock)
+	}
+}
@@ -22,6 +22,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryNextAuctionID:
 			return queryNextAuctionID(ctx, req, keeper)
+		case types.QueryGetBidInfo:
+			return queryBidInfo(ctx, req, keeper)
+		case types.QueryGetAutoBids:
+			return queryAutoBids(ctx, req, keeper)
+		case types.QueryGetEscrow:
+			return queryEscrow(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -51,6 +57,31 @@ func queryAuction(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte
 	return bz, nil
 }
 
+func queryBidInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAuctionParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	auction, found := keeper.GetAuction(ctx, requestParams.AuctionID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", requestParams.AuctionID)
+	}
+
+	bidInfo, err := keeper.GetBidInfo(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, bidInfo)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params types.QueryAllAuctionParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -149,3 +180,39 @@ func queryNextAuctionID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	}
 	return bz, nil
 }
+
+func queryAutoBids(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAutoBidsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	autoBids := types.AutoBids{}
+	keeper.IterateAutoBids(ctx, func(autoBid types.AutoBid) bool {
+		if requestParams.Owner.Empty() || autoBid.Owner.Equals(requestParams.Owner) {
+			autoBids = append(autoBids, autoBid)
+		}
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, autoBids)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryEscrow(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryEscrowParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetEscrow(ctx, requestParams.Owner))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
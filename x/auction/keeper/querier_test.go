@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -142,6 +143,56 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryBidInfo() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	_, addrs := app.GeneratePrivKeyAddressPairs(10)
+	buyer := addrs[0]
+
+	queryBidInfo := func(id uint64) (types.BidInfo, error) {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetBidInfo}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAuctionParams(id)),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetBidInfo}, query)
+		if err != nil {
+			return types.BidInfo{}, err
+		}
+		var bidInfo types.BidInfo
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &bidInfo))
+		return bidInfo, nil
+	}
+
+	var auction types.Auction
+	for _, a := range suite.auctions {
+		if a.GetType() == types.SurplusAuctionType {
+			auction = a
+			break
+		}
+	}
+	suite.Require().NotNil(auction)
+
+	bidInfo, err := queryBidInfo(auction.GetID())
+	suite.Require().NoError(err)
+	suite.Equal(types.ForwardAuctionPhase, bidInfo.Phase)
+	suite.Equal(c("token2", 1), bidInfo.NextBid)
+	suite.Equal(sdk.ZeroDec(), bidInfo.Price)
+	suite.Equal(time.Duration(0), bidInfo.TimeRemaining)
+
+	suite.Require().NoError(suite.keeper.PlaceBid(ctx, auction.GetID(), buyer, c("token2", 100)))
+	bidInfo, err = queryBidInfo(auction.GetID())
+	suite.Require().NoError(err)
+	suite.Equal(c("token2", 105), bidInfo.NextBid)
+	suite.Equal(sdk.NewDec(100).QuoInt(auction.GetLot().Amount), bidInfo.Price)
+	suite.Equal(types.DefaultBidDuration, bidInfo.TimeRemaining)
+
+	// the next bid is exactly the smallest bid accepted
+	suite.Error(suite.keeper.PlaceBid(ctx, auction.GetID(), buyer, c("token2", 104)))
+	suite.NoError(suite.keeper.PlaceBid(ctx, auction.GetID(), buyer, bidInfo.NextBid))
+
+	_, err = queryBidInfo(1000)
+	suite.Error(err)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
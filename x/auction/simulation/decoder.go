@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/kv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
@@ -22,11 +24,31 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		return fmt.Sprintf("%v\n%v", auctionA, auctionB)
 
 	case bytes.Equal(kvA.Key[:1], types.AuctionByTimeKeyPrefix),
-		bytes.Equal(kvA.Key[:1], types.NextAuctionIDKey):
+		bytes.Equal(kvA.Key[:1], types.NextAuctionIDKey),
+		bytes.Equal(kvA.Key[:1], types.NextAutoBidIDKey),
+		bytes.Equal(kvA.Key[:1], types.AutoBidCursorKey):
 		auctionIDA := binary.BigEndian.Uint64(kvA.Value)
 		auctionIDB := binary.BigEndian.Uint64(kvB.Value)
 		return fmt.Sprintf("%d\n%d", auctionIDA, auctionIDB)
 
+	case bytes.Equal(kvA.Key[:1], types.AuctionStartTimeKeyPrefix):
+		var startTimeA, startTimeB time.Time
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &startTimeA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &startTimeB)
+		return fmt.Sprintf("%s\n%s", startTimeA, startTimeB)
+
+	case bytes.Equal(kvA.Key[:1], types.AutoBidKeyPrefix):
+		var autoBidA, autoBidB types.AutoBid
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &autoBidA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &autoBidB)
+		return fmt.Sprintf("%v\n%v", autoBidA, autoBidB)
+
+	case bytes.Equal(kvA.Key[:1], types.AutoBidEscrowKeyPrefix):
+		var escrowA, escrowB sdk.Coins
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &escrowA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &escrowB)
+		return fmt.Sprintf("%v\n%v", escrowA, escrowB)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -27,11 +27,18 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
 	auction := types.NewSurplusAuction("me", oneCoin, "coin", time.Now().UTC())
+	owner := sdk.AccAddress("owner")
+	autoBid := types.NewAutoBid(1, owner, types.SurplusAuctionType, "lot", sdk.MustNewDecFromStr("0.95"), oneCoin)
+	escrow := sdk.NewCoins(oneCoin)
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AuctionKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&auction)},
 		kv.Pair{Key: types.AuctionByTimeKeyPrefix, Value: sdk.Uint64ToBigEndian(2)},
 		kv.Pair{Key: types.NextAuctionIDKey, Value: sdk.Uint64ToBigEndian(10)},
+		kv.Pair{Key: types.AutoBidKeyPrefix, Value: cdc.MustMarshalBinaryBare(autoBid)},
+		kv.Pair{Key: types.NextAutoBidIDKey, Value: sdk.Uint64ToBigEndian(3)},
+		kv.Pair{Key: types.AutoBidEscrowKeyPrefix, Value: cdc.MustMarshalBinaryBare(escrow)},
+		kv.Pair{Key: types.AutoBidCursorKey, Value: sdk.Uint64ToBigEndian(7)},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -42,6 +49,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"Auction", fmt.Sprintf("%v\n%v", auction, auction)},
 		{"AuctionByTime", "2\n2"},
 		{"NextAuctionI", "10\n10"},
+		{"AutoBid", fmt.Sprintf("%v\n%v", autoBid, autoBid)},
+		{"NextAutoBidID", "3\n3"},
+		{"AutoBidEscrow", fmt.Sprintf("%v\n%v", escrow, escrow)},
+		{"AutoBidCursor", "7\n7"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -68,6 +68,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		types.DefaultNextAuctionID,
 		p,
 		nil,
+		types.DefaultNextAutoBidID,
+		nil,
+		nil,
 	)
 
 	// Add auctions
@@ -44,13 +44,14 @@ type Auctions []Auction
 // BaseAuction is a common type shared by all Auctions.
 type BaseAuction struct {
 	ID              uint64         `json:"id" yaml:"id"`
-	Initiator       string         `json:"initiator" yaml:"initiator"`                 // Module name that starts the auction. Pays out Lot.
-	Lot             sdk.Coin       `json:"lot" yaml:"lot"`                             // Coins that will paid out by Initiator to the winning bidder.
-	Bidder          sdk.AccAddress `json:"bidder" yaml:"bidder"`                       // Latest bidder. Receiver of Lot.
-	Bid             sdk.Coin       `json:"bid" yaml:"bid"`                             // Coins paid into the auction the bidder.
-	HasReceivedBids bool           `json:"has_received_bids" yaml:"has_received_bids"` // Whether the auction has received any bids or not.
-	EndTime         time.Time      `json:"end_time" yaml:"end_time"`                   // Current auction closing time. Triggers at the end of the block with time ≥ EndTime.
-	MaxEndTime      time.Time      `json:"max_end_time" yaml:"max_end_time"`           // Maximum closing time. Auctions can close before this but never after.
+	Initiator       string         `json:"initiator" yaml:"initiator"`                             // Module name that starts the auction. Pays out Lot.
+	Lot             sdk.Coin       `json:"lot" yaml:"lot"`                                         // Coins that will paid out by Initiator to the winning bidder.
+	Bidder          sdk.AccAddress `json:"bidder" yaml:"bidder"`                                   // Latest bidder. Receiver of Lot.
+	Bid             sdk.Coin       `json:"bid" yaml:"bid"`                                         // Coins paid into the auction the bidder.
+	HasReceivedBids bool           `json:"has_received_bids" yaml:"has_received_bids"`             // Whether the auction has received any bids or not.
+	EndTime         time.Time      `json:"end_time" yaml:"end_time"`                               // Current auction closing time. Triggers at the end of the block with time ≥ EndTime.
+	MaxEndTime      time.Time      `json:"max_end_time" yaml:"max_end_time"`                       // Maximum closing time. Auctions can close before this but never after.
+	LotRecipient    sdk.AccAddress `json:"lot_recipient,omitempty" yaml:"lot_recipient,omitempty"` // Receiver of Lot in place of the latest bidder, if set.
 }
 
 // GetID is a getter for auction ID.
@@ -65,6 +66,14 @@ func (a BaseAuction) GetLot() sdk.Coin { return a.Lot }
 // GetBidder is a getter for auction Bidder.
 func (a BaseAuction) GetBidder() sdk.AccAddress { return a.Bidder }
 
+// GetLotRecipient returns the address the lot is paid out to, the lot recipient if set and otherwise the latest bidder.
+func (a BaseAuction) GetLotRecipient() sdk.AccAddress {
+	if !a.LotRecipient.Empty() {
+		return a.LotRecipient
+	}
+	return a.Bidder
+}
+
 // GetBid is a getter for auction Bid.
 func (a BaseAuction) GetBid() sdk.Coin { return a.Bid }
 
@@ -87,6 +96,9 @@ func (a BaseAuction) Validate() error {
 	if !a.Bidder.Empty() && len(a.Bidder) != sdk.AddrLen {
 		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(a.Bidder))
 	}
+	if !a.LotRecipient.Empty() && len(a.LotRecipient) != sdk.AddrLen {
+		return fmt.Errorf("the expected lot recipient address length is %d, actual length is %d", sdk.AddrLen, len(a.LotRecipient))
+	}
 	if !a.Bid.IsValid() {
 		return fmt.Errorf("invalid bid: %s", a.Bid)
 	}
@@ -467,3 +467,30 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestNewAuctionSettlement(t *testing.T) {
+	addr := sdk.AccAddress([]byte("bidder"))
+	auction := CollateralAuction{
+		BaseAuction: BaseAuction{
+			ID:        1,
+			Initiator: "liquidator",
+			Lot:       sdk.NewInt64Coin("bnb", 100),
+			Bidder:    addr,
+			Bid:       sdk.NewInt64Coin("usdx", 60),
+		},
+		CorrespondingDebt: sdk.NewInt64Coin("debt", 40),
+		MaxBid:            sdk.NewInt64Coin("usdx", 100),
+	}
+	settlement := NewAuctionSettlement(auction)
+	require.Equal(t, CollateralAuctionType, settlement.AuctionType)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 60), settlement.Proceeds)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 40), settlement.Shortfall)
+
+	// auctions in the reverse phase raised their max bid
+	auction.Bid = auction.MaxBid
+	require.True(t, NewAuctionSettlement(auction).Shortfall.IsZero())
+
+	// only collateral auctions have a shortfall
+	surplus := SurplusAuction{BaseAuction: auction.BaseAuction}
+	require.True(t, NewAuctionSettlement(surplus).Shortfall.IsZero())
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AutoBid is a standing intent to bid on new auctions of a type and lot denom, paying up to a maximum price relative to
+// the oracle price of the lot. Bids are paid from the owner's escrow, and the lots of winning bids are paid to the owner.
+type AutoBid struct {
+	ID          uint64         `json:"id" yaml:"id"`
+	Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+	AuctionType string         `json:"auction_type" yaml:"auction_type"`
+	LotDenom    string         `json:"lot_denom" yaml:"lot_denom"`
+	// MaxPriceRatio is the largest value of a bid as a fraction of the oracle value of the lot, for example 0.95 bids up
+	// to 95% of the lot's value
+	MaxPriceRatio sdk.Dec `json:"max_price_ratio" yaml:"max_price_ratio"`
+	// MaxSpend is the most the auto-bid pays in total across all auctions. Its denom is the bid denom.
+	MaxSpend sdk.Coin `json:"max_spend" yaml:"max_spend"`
+	// Spent is the total of all bids placed, including bids that were outbid and refunded to the owner
+	Spent sdk.Int `json:"spent" yaml:"spent"`
+}
+
+// NewAutoBid returns a new AutoBid that has not spent anything
+func NewAutoBid(id uint64, owner sdk.AccAddress, auctionType, lotDenom string, maxPriceRatio sdk.Dec, maxSpend sdk.Coin) AutoBid {
+	return AutoBid{
+		ID:            id,
+		Owner:         owner,
+		AuctionType:   auctionType,
+		LotDenom:      lotDenom,
+		MaxPriceRatio: maxPriceRatio,
+		MaxSpend:      maxSpend,
+		Spent:         sdk.ZeroInt(),
+	}
+}
+
+// Remaining returns the amount the auto-bid can still spend
+func (ab AutoBid) Remaining() sdk.Int {
+	return sdk.MaxInt(ab.MaxSpend.Amount.Sub(ab.Spent), sdk.ZeroInt())
+}
+
+// Validate performs a basic validation of an AutoBid
+func (ab AutoBid) Validate() error {
+	if ab.ID == 0 {
+		return errors.New("auto-bid id cannot be zero")
+	}
+	if ab.Owner.Empty() {
+		return errors.New("auto-bid owner cannot be empty")
+	}
+	if err := ValidateAutoBidAuctionType(ab.AuctionType); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(ab.LotDenom); err != nil {
+		return err
+	}
+	if ab.MaxPriceRatio.IsNil() || !ab.MaxPriceRatio.IsPositive() {
+		return fmt.Errorf("auto-bid max price ratio must be positive: %s", ab.MaxPriceRatio)
+	}
+	if !ab.MaxSpend.IsValid() || !ab.MaxSpend.IsPositive() {
+		return fmt.Errorf("auto-bid max spend must be positive: %s", ab.MaxSpend)
+	}
+	if ab.MaxSpend.Denom == ab.LotDenom {
+		return fmt.Errorf("auto-bid lot and bid denoms must differ: %s", ab.LotDenom)
+	}
+	if ab.Spent.IsNil() || ab.Spent.IsNegative() {
+		return fmt.Errorf("auto-bid spent cannot be negative: %s", ab.Spent)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ab AutoBid) String() string {
+	return fmt.Sprintf(`Auto-Bid %d:
+	Owner: %s
+	Auction Type: %s
+	Lot Denom: %s
+	Max Price Ratio: %s
+	Max Spend: %s
+	Spent: %s`,
+		ab.ID, ab.Owner, ab.AuctionType, ab.LotDenom, ab.MaxPriceRatio, ab.MaxSpend, ab.Spent)
+}
+
+// AutoBids is a slice of AutoBid
+type AutoBids []AutoBid
+
+// ValidateAutoBidAuctionType returns an error if auto-bids can not be placed on an auction type. Only forward bids are
+// placed automatically, so debt auctions, which only have a reverse phase, are not supported.
+func ValidateAutoBidAuctionType(auctionType string) error {
+	switch auctionType {
+	case CollateralAuctionType, SurplusAuctionType:
+		return nil
+	default:
+		return fmt.Errorf("auto-bids can not be placed on %s auctions", auctionType)
+	}
+}
+
+// AutoBidEscrow is the coins an address has deposited to pay for its auto-bids
+type AutoBidEscrow struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewAutoBidEscrow returns a new AutoBidEscrow
+func NewAutoBidEscrow(owner sdk.AccAddress, amount sdk.Coins) AutoBidEscrow {
+	return AutoBidEscrow{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of an AutoBidEscrow
+func (e AutoBidEscrow) Validate() error {
+	if e.Owner.Empty() {
+		return errors.New("escrow owner cannot be empty")
+	}
+	if !e.Amount.IsValid() || e.Amount.Empty() {
+		return fmt.Errorf("invalid escrow amount for %s: %s", e.Owner, e.Amount)
+	}
+	return nil
+}
+
+// AutoBidEscrows is a slice of AutoBidEscrow
+type AutoBidEscrows []AutoBidEscrow
+
+// Total returns the sum of all escrowed coins
+func (es AutoBidEscrows) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, e := range es {
+		total = total.Add(e.Amount...)
+	}
+	return total
+}
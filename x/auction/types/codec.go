@@ -14,6 +14,10 @@ func init() {
 // RegisterCodec registers concrete types on the codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(MsgDepositAutoBidEscrow{}, "auction/MsgDepositAutoBidEscrow", nil)
+	cdc.RegisterConcrete(MsgWithdrawAutoBidEscrow{}, "auction/MsgWithdrawAutoBidEscrow", nil)
+	cdc.RegisterConcrete(MsgRegisterAutoBid{}, "auction/MsgRegisterAutoBid", nil)
+	cdc.RegisterConcrete(MsgCancelAutoBid{}, "auction/MsgCancelAutoBid", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
@@ -27,4 +27,10 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrAutoBidNotFound error for when an auto-bid is not found
+	ErrAutoBidNotFound = sdkerrors.Register(ModuleName, 13, "auto-bid not found")
+	// ErrInsufficientEscrow error for when an escrow does not hold enough coins
+	ErrInsufficientEscrow = sdkerrors.Register(ModuleName, 14, "insufficient escrow")
+	// ErrInvalidAutoBidDenom error for when a denom has no oracle price for auto-bids
+	ErrInvalidAutoBidDenom = sdkerrors.Register(ModuleName, 15, "denom has no auto-bid price")
 )
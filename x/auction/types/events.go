@@ -6,6 +6,12 @@ const (
 	EventTypeAuctionBid   = "auction_bid"
 	EventTypeAuctionClose = "auction_close"
 
+	EventTypeAutoBidRegister  = "auction_auto_bid_register"
+	EventTypeAutoBidCancel    = "auction_auto_bid_cancel"
+	EventTypeAutoBid          = "auction_auto_bid"
+	EventTypeEscrowDeposit    = "auction_escrow_deposit"
+	EventTypeEscrowWithdrawal = "auction_escrow_withdrawal"
+
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
 	AttributeKeyAuctionType = "auction_type"
@@ -15,4 +21,7 @@ const (
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyAutoBidID   = "auto_bid_id"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyAmount      = "amount"
 )
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // SupplyKeeper defines the expected supply Keeper
@@ -17,3 +19,13 @@ type SupplyKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
+
+// PricefeedKeeper defines the expected interface for the pricefeed
+type PricefeedKeeper interface {
+	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+}
+
+// AuctionHooks event hooks for modules that start auctions
+type AuctionHooks interface {
+	AfterAuctionClosed(ctx sdk.Context, settlement AuctionSettlement)
+}
@@ -10,6 +10,9 @@ import (
 // DefaultNextAuctionID is the starting poiint for auction IDs.
 const DefaultNextAuctionID uint64 = 1
 
+// DefaultNextAutoBidID is the starting point for auto-bid IDs.
+const DefaultNextAutoBidID uint64 = 1
+
 // GenesisAuction is an interface that extends the auction interface to add functionality needed for initializing auctions from genesis.
 type GenesisAuction interface {
 	Auction
@@ -25,14 +28,20 @@ type GenesisState struct {
 	NextAuctionID uint64          `json:"next_auction_id" yaml:"next_auction_id"`
 	Params        Params          `json:"params" yaml:"params"`
 	Auctions      GenesisAuctions `json:"auctions" yaml:"auctions"`
+	NextAutoBidID uint64          `json:"next_auto_bid_id" yaml:"next_auto_bid_id"`
+	AutoBids      AutoBids        `json:"auto_bids" yaml:"auto_bids"`
+	Escrows       AutoBidEscrows  `json:"escrows" yaml:"escrows"`
 }
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions) GenesisState {
+func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions, nextAutoBidID uint64, abs AutoBids, escrows AutoBidEscrows) GenesisState {
 	return GenesisState{
 		NextAuctionID: nextID,
 		Params:        ap,
 		Auctions:      ga,
+		NextAutoBidID: nextAutoBidID,
+		AutoBids:      abs,
+		Escrows:       escrows,
 	}
 }
 
@@ -42,6 +51,9 @@ func DefaultGenesisState() GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		GenesisAuctions{},
+		DefaultNextAutoBidID,
+		AutoBids{},
+		AutoBidEscrows{},
 	)
 }
 
@@ -79,5 +91,30 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID ≥ the nextAuctionID (%d ≥ %d)", a.GetID(), gs.NextAuctionID)
 		}
 	}
+
+	autoBidIDs := map[uint64]bool{}
+	for _, ab := range gs.AutoBids {
+		if err := ab.Validate(); err != nil {
+			return fmt.Errorf("found invalid auto-bid: %w", err)
+		}
+		if autoBidIDs[ab.ID] {
+			return fmt.Errorf("found duplicate auto-bid ID (%d)", ab.ID)
+		}
+		autoBidIDs[ab.ID] = true
+		if ab.ID >= gs.NextAutoBidID {
+			return fmt.Errorf("found auto-bid ID ≥ the nextAutoBidID (%d ≥ %d)", ab.ID, gs.NextAutoBidID)
+		}
+	}
+
+	owners := map[string]bool{}
+	for _, e := range gs.Escrows {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+		if owners[e.Owner.String()] {
+			return fmt.Errorf("found duplicate escrow for %s", e.Owner)
+		}
+		owners[e.Owner.String()] = true
+	}
 	return nil
 }
@@ -32,7 +32,7 @@ func TestGenesisState_Validate(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions)
+			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions, DefaultNextAutoBidID, AutoBids{}, AutoBidEscrows{})
 
 			err := gs.Validate()
 
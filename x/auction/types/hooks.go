@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuctionSettlement is the final result of a closed auction, passed to the module that started it
+type AuctionSettlement struct {
+	AuctionID   uint64         `json:"auction_id" yaml:"auction_id"`
+	AuctionType string         `json:"auction_type" yaml:"auction_type"`
+	Initiator   string         `json:"initiator" yaml:"initiator"`
+	Winner      sdk.AccAddress `json:"winner" yaml:"winner"`
+	Lot         sdk.Coin       `json:"lot" yaml:"lot"`             // coins paid out to the winner
+	Proceeds    sdk.Coin       `json:"proceeds" yaml:"proceeds"`   // coins raised by the winning bid
+	Shortfall   sdk.Coin       `json:"shortfall" yaml:"shortfall"` // amount a collateral auction's proceeds fell short of its max bid
+}
+
+// NewAuctionSettlement returns the settlement of an auction that has closed
+func NewAuctionSettlement(auction Auction) AuctionSettlement {
+	shortfall := sdk.NewCoin(auction.GetBid().Denom, sdk.ZeroInt())
+	if auc, ok := auction.(CollateralAuction); ok && auc.Bid.IsLT(auc.MaxBid) {
+		shortfall = auc.MaxBid.Sub(auc.Bid)
+	}
+	return AuctionSettlement{
+		AuctionID:   auction.GetID(),
+		AuctionType: auction.GetType(),
+		Initiator:   auction.GetInitiator(),
+		Winner:      auction.GetBidder(),
+		Lot:         auction.GetLot(),
+		Proceeds:    auction.GetBid(),
+		Shortfall:   shortfall,
+	}
+}
+
+// String implements fmt.Stringer
+func (s AuctionSettlement) String() string {
+	return fmt.Sprintf(`Auction Settlement %d:
+	Type: %s
+	Initiator: %s
+	Winner: %s
+	Lot: %s
+	Proceeds: %s
+	Shortfall: %s`, s.AuctionID, s.AuctionType, s.Initiator, s.Winner, s.Lot, s.Proceeds, s.Shortfall)
+}
+
+// MultiAuctionHooks combine multiple auction hooks, all hook functions are run in array sequence
+type MultiAuctionHooks []AuctionHooks
+
+// NewMultiAuctionHooks returns a new MultiAuctionHooks
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+// AfterAuctionClosed runs after an auction is closed and paid out
+func (h MultiAuctionHooks) AfterAuctionClosed(ctx sdk.Context, settlement AuctionSettlement) {
+	for i := range h {
+		h[i].AfterAuctionClosed(ctx, settlement)
+	}
+}
@@ -30,6 +30,15 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	AuctionStartTimeKeyPrefix = []byte{0x03} // prefix for keys that store auction start times
+
+	AutoBidKeyPrefix       = []byte{0x04} // prefix for keys that store auto-bids
+	NextAutoBidIDKey       = []byte{0x05} // key for the next auto-bid id
+	AutoBidEscrowKeyPrefix = []byte{0x06} // prefix for keys that store the escrowed coins of auto-bid owners
+	AutoBidCursorKey       = []byte{0x07} // key for the id of the first auction auto-bids have not been matched against
+
+	CollateralAuctionCountKey = []byte{0x08} // key for the block height and number of collateral auctions started in that block
 )
 
 // GetAuctionKey returns the bytes of an auction key
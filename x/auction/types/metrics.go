@@ -0,0 +1,37 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem is the subsystem of all metrics exposed by the auction module
+const MetricsSubsystem = ModuleName
+
+// Metrics contains the metrics exposed by the auction module
+type Metrics struct {
+	// Time in seconds between the start and close of an auction, labeled by auction type
+	AuctionDuration metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics registered with the default Prometheus registry
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		AuctionDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "duration_seconds",
+			Help:      "Time between the start and close of an auction.",
+			Buckets:   stdprometheus.ExponentialBuckets(60, 2, 12),
+		}, []string{"auction_type"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics
+func NopMetrics() *Metrics {
+	return &Metrics{
+		AuctionDuration: discard.NewHistogram(),
+	}
+}
@@ -12,10 +12,12 @@ import (
 var _ sdk.Msg = &MsgPlaceBid{}
 
 // MsgPlaceBid is the message type used to place a bid on any type of auction.
+// If the bid wins, the lot is paid to the lot recipient when one is set, otherwise to the bidder.
 type MsgPlaceBid struct {
-	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
-	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
-	Amount    sdk.Coin       `json:"amount" yaml:"amount"` // The new bid or lot to be set on the auction.
+	AuctionID    uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder       sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount       sdk.Coin       `json:"amount" yaml:"amount"` // The new bid or lot to be set on the auction.
+	LotRecipient sdk.AccAddress `json:"lot_recipient,omitempty" yaml:"lot_recipient,omitempty"`
 }
 
 // NewMsgPlaceBid returns a new MsgPlaceBid.
@@ -47,6 +49,9 @@ func (msg MsgPlaceBid) ValidateBasic() error {
 	if !msg.Amount.IsValid() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", msg.Amount)
 	}
+	if !msg.LotRecipient.Empty() && len(msg.LotRecipient) != sdk.AddrLen {
+		return fmt.Errorf("the expected lot recipient address length is %d, actual length is %d", sdk.AddrLen, len(msg.LotRecipient))
+	}
 	return nil
 }
 
@@ -67,5 +72,190 @@ func (msg MsgPlaceBid) String() string {
 	Auction ID:         %d
 	Bidder: %s
 	Amount: %s
-`, msg.AuctionID, msg.Bidder, msg.Amount)
+	Lot Recipient: %s
+`, msg.AuctionID, msg.Bidder, msg.Amount, msg.LotRecipient)
+}
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgDepositAutoBidEscrow{}
+	_ sdk.Msg = &MsgWithdrawAutoBidEscrow{}
+	_ sdk.Msg = &MsgRegisterAutoBid{}
+	_ sdk.Msg = &MsgCancelAutoBid{}
+)
+
+// MsgDepositAutoBidEscrow is the message type used to deposit coins that pay for the sender's auto-bids.
+type MsgDepositAutoBidEscrow struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositAutoBidEscrow returns a new MsgDepositAutoBidEscrow.
+func NewMsgDepositAutoBidEscrow(owner sdk.AccAddress, amount sdk.Coins) MsgDepositAutoBidEscrow {
+	return MsgDepositAutoBidEscrow{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositAutoBidEscrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositAutoBidEscrow) Type() string { return "deposit_auto_bid_escrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgDepositAutoBidEscrow) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "escrow amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositAutoBidEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositAutoBidEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgWithdrawAutoBidEscrow is the message type used to withdraw coins from the sender's auto-bid escrow.
+type MsgWithdrawAutoBidEscrow struct {
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawAutoBidEscrow returns a new MsgWithdrawAutoBidEscrow.
+func NewMsgWithdrawAutoBidEscrow(owner sdk.AccAddress, amount sdk.Coins) MsgWithdrawAutoBidEscrow {
+	return MsgWithdrawAutoBidEscrow{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawAutoBidEscrow) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawAutoBidEscrow) Type() string { return "withdraw_auto_bid_escrow" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgWithdrawAutoBidEscrow) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "escrow amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawAutoBidEscrow) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawAutoBidEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRegisterAutoBid is the message type used to register a standing bid on new auctions.
+type MsgRegisterAutoBid struct {
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
+	AuctionType   string         `json:"auction_type" yaml:"auction_type"`
+	LotDenom      string         `json:"lot_denom" yaml:"lot_denom"`
+	MaxPriceRatio sdk.Dec        `json:"max_price_ratio" yaml:"max_price_ratio"`
+	MaxSpend      sdk.Coin       `json:"max_spend" yaml:"max_spend"`
+}
+
+// NewMsgRegisterAutoBid returns a new MsgRegisterAutoBid.
+func NewMsgRegisterAutoBid(owner sdk.AccAddress, auctionType, lotDenom string, maxPriceRatio sdk.Dec, maxSpend sdk.Coin) MsgRegisterAutoBid {
+	return MsgRegisterAutoBid{
+		Owner:         owner,
+		AuctionType:   auctionType,
+		LotDenom:      lotDenom,
+		MaxPriceRatio: maxPriceRatio,
+		MaxSpend:      maxSpend,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRegisterAutoBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRegisterAutoBid) Type() string { return "register_auto_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRegisterAutoBid) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	// the auto-bid id is assigned when it is registered
+	autoBid := NewAutoBid(1, msg.Owner, msg.AuctionType, msg.LotDenom, msg.MaxPriceRatio, msg.MaxSpend)
+	if err := autoBid.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRegisterAutoBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRegisterAutoBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgCancelAutoBid is the message type used to cancel one of the sender's auto-bids.
+type MsgCancelAutoBid struct {
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	AutoBidID uint64         `json:"auto_bid_id" yaml:"auto_bid_id"`
+}
+
+// NewMsgCancelAutoBid returns a new MsgCancelAutoBid.
+func NewMsgCancelAutoBid(owner sdk.AccAddress, autoBidID uint64) MsgCancelAutoBid {
+	return MsgCancelAutoBid{
+		Owner:     owner,
+		AutoBidID: autoBidID,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCancelAutoBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCancelAutoBid) Type() string { return "cancel_auto_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCancelAutoBid) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if msg.AutoBidID == 0 {
+		return errors.New("auto-bid id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCancelAutoBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCancelAutoBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
 }
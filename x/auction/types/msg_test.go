@@ -47,6 +47,16 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 			NewMsgPlaceBid(1, addr, c("token", 0)),
 			true,
 		},
+		{
+			"lot recipient",
+			MsgPlaceBid{AuctionID: 1, Bidder: addr, Amount: c("token", 10), LotRecipient: addr},
+			true,
+		},
+		{
+			"invalid lot recipient",
+			MsgPlaceBid{AuctionID: 1, Bidder: addr, Amount: c("token", 10), LotRecipient: addr[:10]},
+			false,
+		},
 	}
 
 	for _, tc := range tests {
@@ -57,3 +67,71 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRegisterAutoBid_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		msg        MsgRegisterAutoBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgRegisterAutoBid(addr, CollateralAuctionType, "bnb", d("0.95"), c("usdx", 1000)),
+			true,
+		},
+		{
+			"empty address",
+			NewMsgRegisterAutoBid(nil, CollateralAuctionType, "bnb", d("0.95"), c("usdx", 1000)),
+			false,
+		},
+		{
+			"debt auction",
+			NewMsgRegisterAutoBid(addr, DebtAuctionType, "ukava", d("0.95"), c("usdx", 1000)),
+			false,
+		},
+		{
+			"invalid lot denom",
+			NewMsgRegisterAutoBid(addr, SurplusAuctionType, "", d("0.95"), c("ukava", 1000)),
+			false,
+		},
+		{
+			"zero price ratio",
+			NewMsgRegisterAutoBid(addr, CollateralAuctionType, "bnb", d("0"), c("usdx", 1000)),
+			false,
+		},
+		{
+			"zero max spend",
+			NewMsgRegisterAutoBid(addr, CollateralAuctionType, "bnb", d("0.95"), c("usdx", 0)),
+			false,
+		},
+		{
+			"same lot and bid denom",
+			NewMsgRegisterAutoBid(addr, CollateralAuctionType, "usdx", d("0.95"), c("usdx", 1000)),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgAutoBidEscrow_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+
+	require.NoError(t, NewMsgDepositAutoBidEscrow(addr, sdk.NewCoins(c("usdx", 10))).ValidateBasic())
+	require.Error(t, NewMsgDepositAutoBidEscrow(nil, sdk.NewCoins(c("usdx", 10))).ValidateBasic())
+	require.Error(t, NewMsgDepositAutoBidEscrow(addr, sdk.NewCoins()).ValidateBasic())
+	require.NoError(t, NewMsgWithdrawAutoBidEscrow(addr, sdk.NewCoins(c("usdx", 10))).ValidateBasic())
+	require.Error(t, NewMsgWithdrawAutoBidEscrow(addr, sdk.Coins{sdk.Coin{Denom: "usdx", Amount: sdk.NewInt(-10)}}).ValidateBasic())
+	require.NoError(t, NewMsgCancelAutoBid(addr, 1).ValidateBasic())
+	require.Error(t, NewMsgCancelAutoBid(addr, 0).ValidateBasic())
+}
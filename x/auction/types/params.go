@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,38 +20,53 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultMinAuctionDuration is zero, so auctions can close one bid duration after their first bid
+	DefaultMinAuctionDuration time.Duration = 0
+	// DefaultMaxCollateralAuctionsPerBlock is zero, which places no limit on the collateral auctions started in a block
+	DefaultMaxCollateralAuctionsPerBlock uint64 = 0
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
 	// ParamStoreKeyParams Param store key for auction params
-	KeyBidDuration         = []byte("BidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyBidDuration                   = []byte("BidDuration")
+	KeyMaxAuctionDuration            = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus              = []byte("IncrementSurplus")
+	KeyIncrementDebt                 = []byte("IncrementDebt")
+	KeyIncrementCollateral           = []byte("IncrementCollateral")
+	KeyAutoBidDenoms                 = []byte("AutoBidDenoms")
+	KeyMinAuctionDuration            = []byte("MinAuctionDuration")
+	KeyMaxCollateralAuctionsPerBlock = []byte("MaxCollateralAuctionsPerBlock")
+	// DefaultAutoBidDenoms is empty, so auto-bids can not be placed until governance prices denoms for them
+	DefaultAutoBidDenoms AutoBidDenoms
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDuration            time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"`                           // max length of auction
+	BidDuration                   time.Duration `json:"bid_duration" yaml:"bid_duration"`                                           // additional time added to the auction end time after each bid, capped by the expiry.
+	IncrementSurplus              sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`                                 // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	IncrementDebt                 sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`                                       // percentage change (of auc.Lot) required for a new bid on a debt auction
+	IncrementCollateral           sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"`                           // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	AutoBidDenoms                 AutoBidDenoms `json:"auto_bid_denoms" yaml:"auto_bid_denoms"`                                     // the oracle prices of the lot and bid denoms auto-bids can be placed for
+	MinAuctionDuration            time.Duration `json:"min_auction_duration" yaml:"min_auction_duration"`                           // shortest time an auction stays open after its first bid
+	MaxCollateralAuctionsPerBlock uint64        `json:"max_collateral_auctions_per_block" yaml:"max_collateral_auctions_per_block"` // collateral auctions the cdp and hard modules may start in a block, zero for no limit
 }
 
 // NewParams returns a new Params object.
 func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		BidDuration:         bidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:            maxAuctionDuration,
+		BidDuration:                   bidDuration,
+		IncrementSurplus:              incrementSurplus,
+		IncrementDebt:                 incrementDebt,
+		IncrementCollateral:           incrementCollateral,
+		AutoBidDenoms:                 DefaultAutoBidDenoms,
+		MinAuctionDuration:            DefaultMinAuctionDuration,
+		MaxCollateralAuctionsPerBlock: DefaultMaxCollateralAuctionsPerBlock,
 	}
 }
 
@@ -78,6 +94,9 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		params.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		params.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		params.NewParamSetPair(KeyAutoBidDenoms, &p.AutoBidDenoms, validateAutoBidDenomsParam),
+		params.NewParamSetPair(KeyMinAuctionDuration, &p.MinAuctionDuration, validateMinAuctionDurationParam),
+		params.NewParamSetPair(KeyMaxCollateralAuctionsPerBlock, &p.MaxCollateralAuctionsPerBlock, validateMaxCollateralAuctionsPerBlockParam),
 	}
 }
 
@@ -95,8 +114,12 @@ func (p Params) String() string {
 	Bid Duration: %s
 	Increment Surplus: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Increment Collateral: %s
+	Auto-Bid Denoms: %s
+	Min Auction Duration: %s
+	Max Collateral Auctions Per Block: %d`,
+		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral, p.AutoBidDenoms,
+		p.MinAuctionDuration, p.MaxCollateralAuctionsPerBlock)
 }
 
 // Validate checks that the parameters have valid values.
@@ -113,6 +136,18 @@ func (p Params) Validate() error {
 		return errors.New("bid duration param cannot be larger than max auction duration")
 	}
 
+	if err := validateMinAuctionDurationParam(p.MinAuctionDuration); err != nil {
+		return err
+	}
+
+	if p.MinAuctionDuration > p.MaxAuctionDuration {
+		return errors.New("min auction duration param cannot be larger than max auction duration")
+	}
+
+	if err := validateMaxCollateralAuctionsPerBlockParam(p.MaxCollateralAuctionsPerBlock); err != nil {
+		return err
+	}
+
 	if err := validateIncrementSurplusParam(p.IncrementSurplus); err != nil {
 		return err
 	}
@@ -121,7 +156,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	return validateAutoBidDenomsParam(p.AutoBidDenoms)
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -150,6 +189,28 @@ func validateMaxAuctionDurationParam(i interface{}) error {
 	return nil
 }
 
+func validateMinAuctionDurationParam(i interface{}) error {
+	minAuctionDuration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if minAuctionDuration < 0 {
+		return fmt.Errorf("min auction duration cannot be negative %d", minAuctionDuration)
+	}
+
+	return nil
+}
+
+func validateMaxCollateralAuctionsPerBlockParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateIncrementSurplusParam(i interface{}) error {
 	incrementSurplus, ok := i.(sdk.Dec)
 	if !ok {
@@ -200,3 +261,75 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateAutoBidDenomsParam(i interface{}) error {
+	autoBidDenoms, ok := i.(AutoBidDenoms)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return autoBidDenoms.Validate()
+}
+
+// AutoBidDenom is the oracle price of a denom that auto-bids can be placed for, as a lot or bid denom
+type AutoBidDenom struct {
+	Denom            string  `json:"denom" yaml:"denom"`
+	MarketID         string  `json:"market_id" yaml:"market_id"`                 // pricefeed market of the denom, quoted in the same asset as all other auto-bid denoms
+	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"` // number of base units in one unit of the denom
+}
+
+// NewAutoBidDenom returns a new AutoBidDenom
+func NewAutoBidDenom(denom, marketID string, conversionFactor sdk.Int) AutoBidDenom {
+	return AutoBidDenom{
+		Denom:            denom,
+		MarketID:         marketID,
+		ConversionFactor: conversionFactor,
+	}
+}
+
+// Validate performs a basic validation of an AutoBidDenom
+func (abd AutoBidDenom) Validate() error {
+	if err := sdk.ValidateDenom(abd.Denom); err != nil {
+		return err
+	}
+	if strings.TrimSpace(abd.MarketID) == "" {
+		return fmt.Errorf("auto-bid market id for %s cannot be blank", abd.Denom)
+	}
+	if abd.ConversionFactor.IsNil() || !abd.ConversionFactor.IsPositive() {
+		return fmt.Errorf("auto-bid conversion factor for %s must be positive: %s", abd.Denom, abd.ConversionFactor)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (abd AutoBidDenom) String() string {
+	return fmt.Sprintf("%s (%s, %s)", abd.Denom, abd.MarketID, abd.ConversionFactor)
+}
+
+// AutoBidDenoms is a slice of AutoBidDenom
+type AutoBidDenoms []AutoBidDenom
+
+// Validate validates each auto-bid denom and checks that no denom is priced twice
+func (abds AutoBidDenoms) Validate() error {
+	seen := make(map[string]bool)
+	for _, abd := range abds {
+		if err := abd.Validate(); err != nil {
+			return err
+		}
+		if seen[abd.Denom] {
+			return fmt.Errorf("duplicate auto-bid denom %s", abd.Denom)
+		}
+		seen[abd.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the auto-bid denom of a denom
+func (abds AutoBidDenoms) Get(denom string) (AutoBidDenom, bool) {
+	for _, abd := range abds {
+		if abd.Denom == denom {
+			return abd, true
+		}
+	}
+	return AutoBidDenom{}, false
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParams_Validate(t *testing.T) {
@@ -86,6 +88,44 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"min auction duration",
+			DefaultParams().withMinAuctionDuration(6 * time.Hour),
+			false,
+		},
+		{
+			"negative min auction duration",
+			DefaultParams().withMinAuctionDuration(-1 * time.Hour),
+			true,
+		},
+		{
+			"min auction duration>max auction duration",
+			DefaultParams().withMinAuctionDuration(DefaultMaxAuctionDuration + time.Hour),
+			true,
+		},
+		{
+			"auto-bid denoms",
+			DefaultParams().withAutoBidDenoms(AutoBidDenoms{
+				NewAutoBidDenom("bnb", "bnb:usd", sdk.NewInt(100000000)),
+				NewAutoBidDenom("usdx", "usdx:usd", sdk.NewInt(1000000)),
+			}),
+			false,
+		},
+		{
+			"duplicate auto-bid denom",
+			DefaultParams().withAutoBidDenoms(AutoBidDenoms{
+				NewAutoBidDenom("bnb", "bnb:usd", sdk.NewInt(100000000)),
+				NewAutoBidDenom("bnb", "bnb:usd:30", sdk.NewInt(100000000)),
+			}),
+			true,
+		},
+		{
+			"invalid auto-bid conversion factor",
+			DefaultParams().withAutoBidDenoms(AutoBidDenoms{
+				NewAutoBidDenom("bnb", "bnb:usd", sdk.ZeroInt()),
+			}),
+			true,
+		},
 		{
 			"zero value",
 			Params{},
@@ -103,3 +143,13 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func (p Params) withAutoBidDenoms(autoBidDenoms AutoBidDenoms) Params {
+	p.AutoBidDenoms = autoBidDenoms
+	return p
+}
+
+func (p Params) withMinAuctionDuration(minAuctionDuration time.Duration) Params {
+	p.MinAuctionDuration = minAuctionDuration
+	return p
+}
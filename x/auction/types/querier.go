@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +15,12 @@ const (
 	QueryGetParams = "params"
 	// QueryNextAuctionID is the query path for querying the id of the next auction
 	QueryNextAuctionID = "next-auction-id"
+	// QueryGetBidInfo is the query path for querying the current phase and next valid bid of an auction
+	QueryGetBidInfo = "bid-info"
+	// QueryGetAutoBids is the query path for querying auto-bids
+	QueryGetAutoBids = "auto-bids"
+	// QueryGetEscrow is the query path for querying the auto-bid escrow of an address
+	QueryGetEscrow = "escrow"
 )
 
 // QueryAuctionParams params for query /auction/auction
@@ -49,6 +57,30 @@ func NewQueryAllAuctionParams(page, limit int, aucType, aucDenom, aucPhase strin
 	}
 }
 
+// QueryAutoBidsParams is the params for an auto-bids query. An empty owner returns the auto-bids of all owners.
+type QueryAutoBidsParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryAutoBidsParams returns a new QueryAutoBidsParams
+func NewQueryAutoBidsParams(owner sdk.AccAddress) QueryAutoBidsParams {
+	return QueryAutoBidsParams{
+		Owner: owner,
+	}
+}
+
+// QueryEscrowParams is the params for an escrow query
+type QueryEscrowParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryEscrowParams returns a new QueryEscrowParams
+func NewQueryEscrowParams(owner sdk.AccAddress) QueryEscrowParams {
+	return QueryEscrowParams{
+		Owner: owner,
+	}
+}
+
 // AuctionWithPhase augmented type for collateral auctions which includes auction phase for querying
 type AuctionWithPhase struct {
 	Auction Auction `json:"auction" yaml:"auction"`
@@ -65,3 +97,36 @@ func NewAuctionWithPhase(a Auction) AuctionWithPhase {
 		Phase:   a.GetPhase(),
 	}
 }
+
+// BidInfo describes the current state of bidding on an auction and the next bid it will accept.
+//
+// In the forward phase the amount of a new bid must be at least NextBid, collateral auctions also limit it to MaxBid.
+// In the reverse phase the bid is fixed and the amount of a new bid is the lot, which must be at most NextBid.
+// Auctions that have not received a bid have no end time yet, so their TimeRemaining is zero.
+type BidInfo struct {
+	AuctionID     uint64        `json:"auction_id" yaml:"auction_id"`
+	Type          string        `json:"type" yaml:"type"`
+	Phase         string        `json:"phase" yaml:"phase"`
+	Bid           sdk.Coin      `json:"bid" yaml:"bid"`
+	Lot           sdk.Coin      `json:"lot" yaml:"lot"`
+	Price         sdk.Dec       `json:"price" yaml:"price"`
+	EndTime       time.Time     `json:"end_time" yaml:"end_time"`
+	TimeRemaining time.Duration `json:"time_remaining" yaml:"time_remaining"`
+	NextBid       sdk.Coin      `json:"next_bid" yaml:"next_bid"`
+}
+
+// NewBidInfo returns a new BidInfo
+func NewBidInfo(auctionID uint64, auctionType, phase string, bid, lot sdk.Coin, price sdk.Dec, endTime time.Time,
+	timeRemaining time.Duration, nextBid sdk.Coin) BidInfo {
+	return BidInfo{
+		AuctionID:     auctionID,
+		Type:          auctionType,
+		Phase:         phase,
+		Bid:           bid,
+		Lot:           lot,
+		Price:         price,
+		EndTime:       endTime,
+		TimeRemaining: timeRemaining,
+		NextBid:       nextBid,
+	}
+}
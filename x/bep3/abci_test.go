@@ -239,6 +239,49 @@ func (suite *ABCITestSuite) TestBeginBlocker_DeleteClosedAtomicSwapsFromLongterm
 	}
 }
 
+func (suite *ABCITestSuite) TestBeginBlocker_PruneClosedAtomicSwapsInBatches() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.LongtermStorageDuration = 10
+	params.MaxPrunedSwapsPerBlock = 3
+	suite.keeper.SetParams(suite.ctx, params)
+
+	for i, swapID := range suite.swapIDs {
+		err := suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[5], swapID, suite.randomNumbers[i])
+		suite.Require().NoError(err)
+	}
+
+	// changing the retention window only applies to swaps closed afterwards
+	params.LongtermStorageDuration = bep3.DefaultLongtermStorageDuration
+	suite.keeper.SetParams(suite.ctx, params)
+
+	countStoredSwaps := func(ctx sdk.Context) int {
+		count := 0
+		for _, swapID := range suite.swapIDs {
+			if _, found := suite.keeper.GetAtomicSwap(ctx, swapID); found {
+				count++
+			}
+		}
+		return count
+	}
+
+	ctx := suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 9)
+	bep3.BeginBlocker(ctx, suite.keeper)
+	suite.Equal(len(suite.swapIDs), countStoredSwaps(ctx))
+
+	for _, expectedRemaining := range []int{7, 4, 1, 0} {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		bep3.BeginBlocker(ctx, suite.keeper)
+		suite.Equal(expectedRemaining, countStoredSwaps(ctx))
+	}
+
+	var remainingIDs [][]byte
+	suite.keeper.IterateAtomicSwapsLongtermStorage(ctx, uint64(ctx.BlockHeight())+bep3.DefaultLongtermStorageDuration, func(id []byte) bool {
+		remainingIDs = append(remainingIDs, id)
+		return false
+	})
+	suite.Empty(remainingIDs)
+}
+
 func TestABCITestSuite(t *testing.T) {
 	suite.Run(t, new(ABCITestSuite))
 }
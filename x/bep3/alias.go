@@ -12,10 +12,14 @@ import (
 // ALIASGEN: github.com/kava-labs/kava/x/bep3/types
 
 const (
+	AttestRandomNumber             = types.AttestRandomNumber
+	AttributeKeyRelayer            = types.AttributeKeyRelayer
+	EventTypeAttestRandomNumber    = types.EventTypeAttestRandomNumber
 	EventTypeCreateAtomicSwap      = types.EventTypeCreateAtomicSwap
 	EventTypeClaimAtomicSwap       = types.EventTypeClaimAtomicSwap
 	EventTypeRefundAtomicSwap      = types.EventTypeRefundAtomicSwap
 	EventTypeSwapsExpired          = types.EventTypeSwapsExpired
+	EventTypeSwapFee               = types.EventTypeSwapFee
 	AttributeValueCategory         = types.AttributeValueCategory
 	AttributeKeySender             = types.AttributeKeySender
 	AttributeKeyRecipient          = types.AttributeKeyRecipient
@@ -30,8 +34,13 @@ const (
 	AttributeKeyRandomNumber       = types.AttributeKeyRandomNumber
 	AttributeKeyRefundSender       = types.AttributeKeyRefundSender
 	AttributeKeyAtomicSwapIDs      = types.AttributeKeyAtomicSwapIDs
+	AttributeKeyFee                = types.AttributeKeyFee
 	AttributeExpirationBlock       = types.AttributeExpirationBlock
+	AttributeKeyMemo               = types.AttributeKeyMemo
 	ModuleName                     = types.ModuleName
+	QueryGetAssetFloat             = types.QueryGetAssetFloat
+	QueryGetAssetFloats            = types.QueryGetAssetFloats
+	QueryGetClaimableSwaps         = types.QueryGetClaimableSwaps
 	StoreKey                       = types.StoreKey
 	RouterKey                      = types.RouterKey
 	QuerierRoute                   = types.QuerierRoute
@@ -48,11 +57,13 @@ const (
 	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
 	SwapIDLength                   = types.SwapIDLength
 	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
+	MaxSwapMemoLength              = types.MaxSwapMemoLength
 	QueryGetAssetSupply            = types.QueryGetAssetSupply
 	QueryGetAssetSupplies          = types.QueryGetAssetSupplies
 	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
 	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
 	QueryGetParams                 = types.QueryGetParams
+	QueryGetSwapFee                = types.QueryGetSwapFee
 	NULL                           = types.NULL
 	Open                           = types.Open
 	Completed                      = types.Completed
@@ -64,9 +75,19 @@ const (
 
 var (
 	// functions aliases
+	AssetFloatInvariants       = keeper.AssetFloatInvariants
 	NewKeeper                  = keeper.NewKeeper
 	NewQuerier                 = keeper.NewQuerier
+	AssetSupplyInvariants      = keeper.AssetSupplyInvariants
+	ModuleAccountInvariants    = keeper.ModuleAccountInvariants
+	RegisterInvariants         = keeper.RegisterInvariants
+	NewAssetFloat              = types.NewAssetFloat
 	NewAssetSupply             = types.NewAssetSupply
+	NewClaimableSwap           = types.NewClaimableSwap
+	NewMsgAttestRandomNumber   = types.NewMsgAttestRandomNumber
+	NewQueryAssetFloat         = types.NewQueryAssetFloat
+	NewQueryClaimableSwaps     = types.NewQueryClaimableSwaps
+	NewSwapAttestation         = types.NewSwapAttestation
 	RegisterCodec              = types.RegisterCodec
 	NewGenesisState            = types.NewGenesisState
 	DefaultGenesisState        = types.DefaultGenesisState
@@ -74,23 +95,31 @@ var (
 	CalculateRandomHash        = types.CalculateRandomHash
 	CalculateSwapID            = types.CalculateSwapID
 	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByHeightKey
+	GetAddressInboundKey       = types.GetAddressInboundKey
 	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
 	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
 	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
 	NewParams                  = types.NewParams
 	DefaultParams              = types.DefaultParams
 	NewAssetParam              = types.NewAssetParam
+	NewSwapFee                 = types.NewSwapFee
+	NewAddressLimit            = types.NewAddressLimit
+	NewAddressInbound          = types.NewAddressInbound
 	ParamKeyTable              = types.ParamKeyTable
 	NewQueryAssetSupply        = types.NewQueryAssetSupply
 	NewQueryAssetSupplies      = types.NewQueryAssetSupplies
 	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
 	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
+	NewQuerySwapFee            = types.NewQuerySwapFee
 	NewAtomicSwap              = types.NewAtomicSwap
 	NewSwapStatusFromString    = types.NewSwapStatusFromString
 	NewSwapDirectionFromString = types.NewSwapDirectionFromString
 	NewAugmentedAtomicSwap     = types.NewAugmentedAtomicSwap
 
 	// variable aliases
+	AssetFloatPrefix                = types.AssetFloatPrefix
+	ErrAssetFloatNotFound           = types.ErrAssetFloatNotFound
+	ErrSwapAlreadyAttested          = types.ErrSwapAlreadyAttested
 	ModuleCdc                       = types.ModuleCdc
 	ErrInvalidTimestamp             = types.ErrInvalidTimestamp
 	ErrInvalidHeightSpan            = types.ErrInvalidHeightSpan
@@ -110,40 +139,68 @@ var (
 	ErrSwapNotClaimable             = types.ErrSwapNotClaimable
 	ErrInvalidAmount                = types.ErrInvalidAmount
 	ErrInvalidSwapAccount           = types.ErrInvalidSwapAccount
+	ErrExceedsTimeBasedSupplyLimit  = types.ErrExceedsTimeBasedSupplyLimit
+	ErrExceedsAddressHoldingLimit   = types.ErrExceedsAddressHoldingLimit
+	ErrExceedsAddressInboundLimit   = types.ErrExceedsAddressInboundLimit
 	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
 	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
 	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
 	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
+	AddressInboundPrefix            = types.AddressInboundPrefix
 	KeyAssetParams                  = types.KeyAssetParams
+	KeyLongtermStorageDuration      = types.KeyLongtermStorageDuration
+	KeyMaxPrunedSwapsPerBlock       = types.KeyMaxPrunedSwapsPerBlock
+	KeySwapFees                     = types.KeySwapFees
+	KeyAddressLimits                = types.KeyAddressLimits
 	DefaultBnbDeputyFixedFee        = types.DefaultBnbDeputyFixedFee
 	DefaultMinAmount                = types.DefaultMinAmount
 	DefaultMaxAmount                = types.DefaultMaxAmount
 	DefaultMinBlockLock             = types.DefaultMinBlockLock
 	DefaultMaxBlockLock             = types.DefaultMaxBlockLock
 	DefaultPreviousBlockTime        = types.DefaultPreviousBlockTime
+	DefaultMaxPrunedSwapsPerBlock   = types.DefaultMaxPrunedSwapsPerBlock
+	DefaultSwapFees                 = types.DefaultSwapFees
+	DefaultAddressLimits            = types.DefaultAddressLimits
 	ModulePermissionsUpgradeTime    = types.ModulePermissionsUpgradeTime
+	SwapAttestationPrefix           = types.SwapAttestationPrefix
 )
 
 type (
-	Keeper               = keeper.Keeper
-	AssetSupply          = types.AssetSupply
-	AssetSupplies        = types.AssetSupplies
-	GenesisState         = types.GenesisState
-	MsgCreateAtomicSwap  = types.MsgCreateAtomicSwap
-	MsgClaimAtomicSwap   = types.MsgClaimAtomicSwap
-	MsgRefundAtomicSwap  = types.MsgRefundAtomicSwap
-	Params               = types.Params
-	AssetParam           = types.AssetParam
-	AssetParams          = types.AssetParams
-	QueryAssetSupply     = types.QueryAssetSupply
-	QueryAssetSupplies   = types.QueryAssetSupplies
-	QueryAtomicSwapByID  = types.QueryAtomicSwapByID
-	QueryAtomicSwaps     = types.QueryAtomicSwaps
-	AtomicSwap           = types.AtomicSwap
-	AtomicSwaps          = types.AtomicSwaps
-	SwapStatus           = types.SwapStatus
-	SwapDirection        = types.SwapDirection
-	SupplyLimit          = types.SupplyLimit
-	AugmentedAtomicSwap  = types.AugmentedAtomicSwap
-	AugmentedAtomicSwaps = types.AugmentedAtomicSwaps
+	Keeper                = keeper.Keeper
+	AssetFloat            = types.AssetFloat
+	AssetFloats           = types.AssetFloats
+	AssetSupply           = types.AssetSupply
+	AssetSupplies         = types.AssetSupplies
+	ClaimableSwap         = types.ClaimableSwap
+	ClaimableSwaps        = types.ClaimableSwaps
+	GenesisState          = types.GenesisState
+	MsgAttestRandomNumber = types.MsgAttestRandomNumber
+	MsgCreateAtomicSwap   = types.MsgCreateAtomicSwap
+	MsgClaimAtomicSwap    = types.MsgClaimAtomicSwap
+	MsgRefundAtomicSwap   = types.MsgRefundAtomicSwap
+	Params                = types.Params
+	AssetParam            = types.AssetParam
+	AssetParams           = types.AssetParams
+	QueryAssetFloat       = types.QueryAssetFloat
+	QueryClaimableSwaps   = types.QueryClaimableSwaps
+	SwapAttestation       = types.SwapAttestation
+	SwapAttestations      = types.SwapAttestations
+	SwapFee               = types.SwapFee
+	SwapFees              = types.SwapFees
+	AddressLimit          = types.AddressLimit
+	AddressLimits         = types.AddressLimits
+	AddressInbound        = types.AddressInbound
+	AddressInbounds       = types.AddressInbounds
+	QueryAssetSupply      = types.QueryAssetSupply
+	QuerySwapFee          = types.QuerySwapFee
+	QueryAssetSupplies    = types.QueryAssetSupplies
+	QueryAtomicSwapByID   = types.QueryAtomicSwapByID
+	QueryAtomicSwaps      = types.QueryAtomicSwaps
+	AtomicSwap            = types.AtomicSwap
+	AtomicSwaps           = types.AtomicSwaps
+	SwapStatus            = types.SwapStatus
+	SwapDirection         = types.SwapDirection
+	SupplyLimit           = types.SupplyLimit
+	AugmentedAtomicSwap   = types.AugmentedAtomicSwap
+	AugmentedAtomicSwaps  = types.AugmentedAtomicSwaps
 )
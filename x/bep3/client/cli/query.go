@@ -44,6 +44,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAssetSuppliesCmd(queryRoute, cdc),
 		QueryGetAtomicSwapCmd(queryRoute, cdc),
 		QueryGetAtomicSwapsCmd(queryRoute, cdc),
+		QuerySwapFeeCmd(queryRoute, cdc),
+		QueryGetAssetFloatCmd(queryRoute, cdc),
+		QueryGetAssetFloatsCmd(queryRoute, cdc),
+		QueryGetClaimableSwapsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 	)...)
 
@@ -152,6 +156,41 @@ func QueryGetAssetSupplyCmd(queryRoute string, cdc *codec.Codec) *cobra.Command
 	}
 }
 
+// QuerySwapFeeCmd queries the fee charged on an incoming swap of an amount
+func QuerySwapFeeCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "swap-fee [amount]",
+		Short:   "get the fee charged on a completed incoming swap of an amount",
+		Example: "bep3 swap-fee 100000000bnb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare query params
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySwapFee(amount))
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSwapFee), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var fee sdk.Coin
+			cdc.MustUnmarshalJSON(res, &fee)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(fee)
+		},
+	}
+}
+
 // QueryGetAssetSuppliesCmd queries AssetSupplies in the store
 func QueryGetAssetSuppliesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -179,6 +218,56 @@ func QueryGetAssetSuppliesCmd(queryRoute string, cdc *codec.Codec) *cobra.Comman
 	}
 }
 
+// QueryGetAssetFloatCmd queries the amount of an asset minted and burned by swaps
+func QueryGetAssetFloatCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "float [denom]",
+		Short:   "get the amount of an asset minted and burned by swaps, and the float on this chain",
+		Example: "bep3 float bnb",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAssetFloat(args[0]))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAssetFloat), bz)
+			if err != nil {
+				return err
+			}
+
+			var float types.AssetFloat
+			cdc.MustUnmarshalJSON(res, &float)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(float)
+		},
+	}
+}
+
+// QueryGetAssetFloatsCmd queries the amount of each asset minted and burned by swaps
+func QueryGetAssetFloatsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "floats",
+		Short:   "get the amount of each asset minted and burned by swaps",
+		Example: "bep3 floats",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAssetFloats), nil)
+			if err != nil {
+				return err
+			}
+
+			var floats types.AssetFloats
+			cdc.MustUnmarshalJSON(res, &floats)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(floats)
+		},
+	}
+}
+
 // QueryGetAtomicSwapCmd queries an AtomicSwap by swapID
 func QueryGetAtomicSwapCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -311,6 +400,38 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 	return cmd
 }
 
+// QueryGetClaimableSwapsCmd queries open atomic swaps with a random number attested by a relayer
+func QueryGetClaimableSwapsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claimable-swaps",
+		Short:   "get open atomic swaps with a random number attested by a relayer, along with the random number",
+		Example: "bep3 claimable-swaps --page=1 --limit=100",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryClaimableSwaps(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetClaimableSwaps), bz)
+			if err != nil {
+				return err
+			}
+
+			var claimable types.ClaimableSwaps
+			cdc.MustUnmarshalJSON(res, &claimable)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(claimable)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of claimable swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of claimable swaps to query for")
+
+	return cmd
+}
+
 // QueryParamsCmd queries the bep3 module parameters
 func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -37,14 +38,17 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdCreateAtomicSwap(cdc),
 		GetCmdClaimAtomicSwap(cdc),
 		GetCmdRefundAtomicSwap(cdc),
+		GetCmdAttestRandomNumber(cdc),
 	)...)
 
 	return bep3TxCmd
 }
 
+const flagSwapMemo = "swap-memo"
+
 // GetCmdCreateAtomicSwap cli command for creating atomic swaps
 func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create [to] [recipient-other-chain] [sender-other-chain] [timestamp] [coins] [height-span]",
 		Short: "create a new atomic swap",
 		Example: fmt.Sprintf("%s tx %s create kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7 bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 now 100bnb 270 --from validator",
@@ -102,6 +106,7 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 				from, to, recipientOtherChain, senderOtherChain,
 				randomNumberHash, timestamp, coins, heightSpan,
 			)
+			msg.Memo = viper.GetString(flagSwapMemo)
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -111,6 +116,8 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(flagSwapMemo, "", "(optional) memo stored with the swap and included in its create, claim, and refund events")
+	return cmd
 }
 
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
@@ -182,3 +189,35 @@ func GetCmdRefundAtomicSwap(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdAttestRandomNumber cli command for attesting the random number of an atomic swap revealed on the other chain
+func GetCmdAttestRandomNumber(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "attest [swap-id] [random-number]",
+		Short:   "attest the random number of an open atomic swap after it was revealed on the other chain",
+		Example: fmt.Sprintf("%s tx %s attest 6682c03cc3856879c8fb98c9733c6b0c30758299138166b6523fe94628b1d3af 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --from relayer", version.ClientName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			swapID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return err
+			}
+			randomNumber, err := hex.DecodeString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAttestRandomNumber(cliCtx.GetFromAddress(), swapID, randomNumber)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
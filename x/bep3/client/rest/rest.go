@@ -37,6 +37,7 @@ type PostCreateSwapReq struct {
 	Amount              sdk.Coins        `json:"amount" yaml:"amount"`
 	HeightSpan          uint64           `json:"height_span" yaml:"height_span"`
 	CrossChain          bool             `json:"cross_chain" yaml:"cross_chain"`
+	Memo                string           `json:"memo" yaml:"memo"`
 }
 
 // PostClaimSwapReq defines the properties of a swap claim request's body
@@ -53,3 +54,11 @@ type PostRefundSwapReq struct {
 	From    sdk.AccAddress   `json:"from" yaml:"from"`
 	SwapID  tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
 }
+
+// PostAttestRandomNumberReq defines the properties of a random number attestation request's body
+type PostAttestRandomNumberReq struct {
+	BaseReq      rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	From         sdk.AccAddress   `json:"from" yaml:"from"`
+	SwapID       tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	RandomNumber tmbytes.HexBytes `json:"random_number" yaml:"random_number"`
+}
@@ -26,6 +26,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, supply := range gs.Supplies {
 		keeper.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
+	for _, inbound := range gs.AddressInbounds {
+		keeper.SetAddressInbound(ctx, inbound)
+	}
+	for _, float := range gs.AssetFloats {
+		keeper.SetAssetFloat(ctx, float)
+	}
+	// genesis states exported before mints and burns were tracked have no floats
+	keeper.InitializeAssetFloats(ctx)
 
 	var incomingSupplies sdk.Coins
 	var outgoingSupplies sdk.Coins
@@ -76,6 +84,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		}
 	}
 
+	// Attested random numbers are only kept for swaps that can still be claimed
+	for _, attestation := range gs.SwapAttestations {
+		swap, found := keeper.GetAtomicSwap(ctx, attestation.SwapID)
+		if !found || swap.Status == Completed {
+			panic(fmt.Sprintf("swap attestation for swap %s without an open or expired swap", attestation.SwapID))
+		}
+		keeper.SetSwapAttestation(ctx, attestation)
+	}
+
 	// Asset's given incoming/outgoing supply much match the amount of coins in incoming/outgoing atomic swaps
 	supplies := keeper.GetAllAssetSupplies(ctx)
 	for _, supply := range supplies {
@@ -105,6 +122,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		if supply.OutgoingSupply.Amount.GT(limit.Limit) {
 			panic(fmt.Sprintf("asset's outgoing supply %s is over the supply limit %s", supply.OutgoingSupply, limit.Limit))
 		}
+		float, _ := keeper.GetAssetFloat(ctx, supply.GetDenom())
+		if !supply.CurrentSupply.Amount.Equal(float.Float()) {
+			panic(fmt.Sprintf("asset's current supply %s does not match minted %s less burned %s", supply.CurrentSupply, float.Minted, float.Burned))
+		}
 
 	}
 }
@@ -118,5 +139,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, swaps, supplies, previousBlockTime)
+	gs := NewGenesisState(params, swaps, supplies, previousBlockTime)
+	gs.AddressInbounds = k.GetAllAddressInbounds(ctx)
+	gs.AssetFloats = k.GetAllAssetFloats(ctx)
+	gs.SwapAttestations = k.GetAllSwapAttestations(ctx)
+	return gs
 }
@@ -271,6 +271,30 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			},
 			expectPass: false,
 		},
+		{
+			name: "import address inbounds",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				gs.AddressInbounds = bep3.AddressInbounds{
+					bep3.NewAddressInbound(suite.addrs[1], c("bnb", 1000), tmtime.Now()),
+					bep3.NewAddressInbound(suite.addrs[2], c("bnb", 1000), tmtime.Now()),
+				}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate address inbound",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				gs.AddressInbounds = bep3.AddressInbounds{
+					bep3.NewAddressInbound(suite.addrs[1], c("bnb", 1000), tmtime.Now()),
+					bep3.NewAddressInbound(suite.addrs[1], c("bnb", 2000), tmtime.Now()),
+				}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+			},
+			expectPass: false,
+		},
 		{
 			name: "duplicate supported asset denom",
 			genState: func() app.GenesisState {
@@ -284,6 +308,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
+			suite.SetupTest()
 			if tc.expectPass {
 				suite.NotPanics(func() {
 					suite.app.InitializeFromGenesisStates(tc.genState())
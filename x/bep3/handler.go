@@ -16,6 +16,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgClaimAtomicSwap(ctx, k, msg)
 		case MsgRefundAtomicSwap:
 			return handleMsgRefundAtomicSwap(ctx, k, msg)
+		case MsgAttestRandomNumber:
+			return handleMsgAttestRandomNumber(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -24,8 +26,8 @@ func NewHandler(k Keeper) sdk.Handler {
 
 // handleMsgCreateAtomicSwap handles requests to create a new AtomicSwap
 func handleMsgCreateAtomicSwap(ctx sdk.Context, k Keeper, msg MsgCreateAtomicSwap) (*sdk.Result, error) {
-	err := k.CreateAtomicSwap(ctx, msg.RandomNumberHash, msg.Timestamp, msg.HeightSpan,
-		msg.From, msg.To, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, true)
+	err := k.CreateAtomicSwapWithMemo(ctx, msg.RandomNumberHash, msg.Timestamp, msg.HeightSpan,
+		msg.From, msg.To, msg.SenderOtherChain, msg.RecipientOtherChain, msg.Amount, true, msg.Memo)
 	if err != nil {
 		return nil, err
 	}
@@ -84,3 +86,23 @@ func handleMsgRefundAtomicSwap(ctx sdk.Context, k Keeper, msg MsgRefundAtomicSwa
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+// handleMsgAttestRandomNumber handles requests to attest the random number of an open AtomicSwap
+func handleMsgAttestRandomNumber(ctx sdk.Context, k Keeper, msg MsgAttestRandomNumber) (*sdk.Result, error) {
+	err := k.AttestRandomNumber(ctx, msg.From, msg.SwapID, msg.RandomNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -79,6 +79,50 @@ func (suite *HandlerTestSuite) TestMsgCreateAtomicSwap() {
 	suite.Require().NotNil(res)
 }
 
+func (suite *HandlerTestSuite) TestMsgCreateAtomicSwapWithMemo() {
+	amount := cs(c("bnb", int64(10000)))
+	timestamp := ts(0)
+	randomNumber, _ := bep3.GenerateSecureRandomNumber()
+	randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
+
+	msg := bep3.NewMsgCreateAtomicSwap(
+		suite.addrs[0], suite.addrs[2], TestRecipientOtherChain,
+		TestSenderOtherChain, randomNumberHash, timestamp, amount,
+		bep3.DefaultMinBlockLock)
+	msg.Memo = "withdrawal-1234"
+
+	res, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.requireEventAttribute(res, bep3.EventTypeCreateAtomicSwap, bep3.AttributeKeyMemo, msg.Memo)
+
+	swapID := bep3.CalculateSwapID(randomNumberHash, msg.From, TestSenderOtherChain)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Require().True(found)
+	suite.Require().Equal(msg.Memo, swap.Memo)
+
+	// the memo is carried through to the claim
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	res, err = suite.handler(suite.ctx, bep3.NewMsgClaimAtomicSwap(suite.addrs[2], swapID, randomNumber[:]))
+	suite.Require().NoError(err)
+	suite.requireEventAttribute(res, bep3.EventTypeClaimAtomicSwap, bep3.AttributeKeyMemo, msg.Memo)
+}
+
+// requireEventAttribute checks a result contains an event of a type with an attribute
+func (suite *HandlerTestSuite) requireEventAttribute(res *sdk.Result, eventType, key, value string) {
+	for _, event := range res.Events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				suite.Require().Equal(value, string(attr.Value))
+				return
+			}
+		}
+	}
+	suite.Failf("attribute not found", "%s event has no %s attribute", eventType, key)
+}
+
 func (suite *HandlerTestSuite) TestMsgClaimAtomicSwap() {
 	// Attempt claim msg on fake atomic swap
 	badRandomNumber, _ := bep3.GenerateSecureRandomNumber()
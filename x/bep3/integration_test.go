@@ -74,6 +74,8 @@ func baseGenState(deputy sdk.AccAddress) bep3.GenesisState {
 					MaxBlockLock:  bep3.DefaultMaxBlockLock,
 				},
 			},
+			LongtermStorageDuration: bep3.DefaultLongtermStorageDuration,
+			MaxPrunedSwapsPerBlock:  bep3.DefaultMaxPrunedSwapsPerBlock,
 		},
 		Supplies: bep3.AssetSupplies{
 			bep3.NewAssetSupply(
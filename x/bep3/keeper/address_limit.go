@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// GetAddressLimit returns the address limit for the input denom
+func (k Keeper) GetAddressLimit(ctx sdk.Context, denom string) (types.AddressLimit, bool) {
+	var addressLimits types.AddressLimits
+	k.paramSubspace.Get(ctx, types.KeyAddressLimits, &addressLimits)
+	return addressLimits.Get(denom)
+}
+
+// InitializeAddressLimitParams sets the address limits to their default if they are not in the param store, which is
+// the case for chains started before address limits were added.
+func (k Keeper) InitializeAddressLimitParams(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeyAddressLimits) {
+		k.paramSubspace.Set(ctx, types.KeyAddressLimits, types.DefaultAddressLimits)
+	}
+}
+
+// ApplyAddressLimits checks that an address can receive coins from an incoming swap without going over the address
+// limits for the asset, and adds the coins to the amount the address has claimed in the current inbound period.
+func (k Keeper) ApplyAddressLimits(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	limit, found := k.GetAddressLimit(ctx, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	if limit.MaxHolding.IsPositive() {
+		balance := sdk.ZeroInt()
+		if acc := k.accountKeeper.GetAccount(ctx, addr); acc != nil {
+			balance = acc.GetCoins().AmountOf(coin.Denom)
+		}
+		if balance.Add(coin.Amount).GT(limit.MaxHolding) {
+			return sdkerrors.Wrapf(types.ErrExceedsAddressHoldingLimit, "balance %s%s + claim %s > limit %s%s",
+				balance, coin.Denom, coin, limit.MaxHolding, coin.Denom)
+		}
+	}
+
+	if limit.MaxInbound.IsPositive() {
+		inbound, found := k.GetAddressInbound(ctx, addr, coin.Denom)
+		if !found || !ctx.BlockTime().Before(inbound.PeriodStart.Add(limit.InboundPeriod)) {
+			inbound = types.NewAddressInbound(addr, sdk.NewCoin(coin.Denom, sdk.ZeroInt()), ctx.BlockTime())
+		}
+		if inbound.Amount.Add(coin).Amount.GT(limit.MaxInbound) {
+			return sdkerrors.Wrapf(types.ErrExceedsAddressInboundLimit, "claimed %s + claim %s > limit %s%s",
+				inbound.Amount, coin, limit.MaxInbound, coin.Denom)
+		}
+		inbound.Amount = inbound.Amount.Add(coin)
+		k.SetAddressInbound(ctx, inbound)
+	}
+	return nil
+}
+
+// GetAddressInbound returns the amount of a denom an address has claimed from incoming swaps in the current period
+func (k Keeper) GetAddressInbound(ctx sdk.Context, addr sdk.AccAddress, denom string) (types.AddressInbound, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AddressInboundPrefix)
+	bz := store.Get(types.GetAddressInboundKey(addr, denom))
+	if bz == nil {
+		return types.AddressInbound{}, false
+	}
+	var inbound types.AddressInbound
+	k.cdc.MustUnmarshalBinaryBare(bz, &inbound)
+	return inbound, true
+}
+
+// SetAddressInbound sets the amount of a denom an address has claimed from incoming swaps in the current period
+func (k Keeper) SetAddressInbound(ctx sdk.Context, inbound types.AddressInbound) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AddressInboundPrefix)
+	store.Set(types.GetAddressInboundKey(inbound.Address, inbound.Amount.Denom), k.cdc.MustMarshalBinaryBare(inbound))
+}
+
+// IterateAddressInbounds provides an iterator over all stored address inbound amounts
+func (k Keeper) IterateAddressInbounds(ctx sdk.Context, cb func(inbound types.AddressInbound) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.AddressInboundPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var inbound types.AddressInbound
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &inbound)
+
+		if cb(inbound) {
+			break
+		}
+	}
+}
+
+// GetAllAddressInbounds returns all address inbound amounts from the store
+func (k Keeper) GetAllAddressInbounds(ctx sdk.Context) (inbounds types.AddressInbounds) {
+	k.IterateAddressInbounds(ctx, func(inbound types.AddressInbound) bool {
+		inbounds = append(inbounds, inbound)
+		return false
+	})
+	return
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// InitializeAssetFloats sets the float of each asset without one to its current supply, which is the case for chains
+// started before mints and burns were tracked. Coins minted before then are counted as minted when the float is set.
+func (k Keeper) InitializeAssetFloats(ctx sdk.Context) {
+	k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+		if _, found := k.GetAssetFloat(ctx, supply.GetDenom()); !found {
+			k.SetAssetFloat(ctx, types.NewAssetFloat(supply.GetDenom(), supply.CurrentSupply.Amount, sdk.ZeroInt()))
+		}
+		return false
+	})
+}
+
+// recordMint adds coins minted by an incoming swap to the asset's float
+func (k Keeper) recordMint(ctx sdk.Context, coin sdk.Coin) {
+	float := k.getAssetFloatOrZero(ctx, coin.Denom)
+	float.Minted = float.Minted.Add(coin.Amount)
+	k.SetAssetFloat(ctx, float)
+}
+
+// recordBurn adds coins burned by an outgoing swap to the asset's float
+func (k Keeper) recordBurn(ctx sdk.Context, coin sdk.Coin) {
+	float := k.getAssetFloatOrZero(ctx, coin.Denom)
+	float.Burned = float.Burned.Add(coin.Amount)
+	k.SetAssetFloat(ctx, float)
+}
+
+func (k Keeper) getAssetFloatOrZero(ctx sdk.Context, denom string) types.AssetFloat {
+	float, found := k.GetAssetFloat(ctx, denom)
+	if !found {
+		return types.NewAssetFloat(denom, sdk.ZeroInt(), sdk.ZeroInt())
+	}
+	return float
+}
+
+// GetAssetFloat returns the amount of a denom minted and burned by swaps
+func (k Keeper) GetAssetFloat(ctx sdk.Context, denom string) (types.AssetFloat, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetFloatPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.AssetFloat{}, false
+	}
+	var float types.AssetFloat
+	k.cdc.MustUnmarshalBinaryBare(bz, &float)
+	return float, true
+}
+
+// SetAssetFloat sets the amount of a denom minted and burned by swaps
+func (k Keeper) SetAssetFloat(ctx sdk.Context, float types.AssetFloat) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AssetFloatPrefix)
+	store.Set([]byte(float.Denom), k.cdc.MustMarshalBinaryBare(float))
+}
+
+// IterateAssetFloats provides an iterator over all stored asset floats
+func (k Keeper) IterateAssetFloats(ctx sdk.Context, cb func(float types.AssetFloat) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.AssetFloatPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var float types.AssetFloat
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &float)
+
+		if cb(float) {
+			break
+		}
+	}
+}
+
+// GetAllAssetFloats returns all asset floats from the store
+func (k Keeper) GetAllAssetFloats(ctx sdk.Context) (floats types.AssetFloats) {
+	k.IterateAssetFloats(ctx, func(float types.AssetFloat) bool {
+		floats = append(floats, float)
+		return false
+	})
+	return
+}
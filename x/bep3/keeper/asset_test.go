@@ -683,6 +683,8 @@ func (suite *AssetTestSuite) TestUpdateTimeBasedSupplyLimits() {
 						MaxBlockLock:  types.DefaultMaxBlockLock,
 					},
 				},
+				LongtermStorageDuration: types.DefaultLongtermStorageDuration,
+				MaxPrunedSwapsPerBlock:  types.DefaultMaxPrunedSwapsPerBlock,
 			}
 			suite.keeper.SetParams(suite.ctx, newParams)
 			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.args.duration))
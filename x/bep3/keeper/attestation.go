@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// AttestRandomNumber stores the random number of an open swap posted by a relayer after it was revealed on the
+// counterpart chain, so the swap can be found and claimed by automated relayers
+func (k Keeper) AttestRandomNumber(ctx sdk.Context, relayer sdk.AccAddress, swapID []byte, randomNumber []byte) error {
+	atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAtomicSwapNotFound, "%s", swapID)
+	}
+	// Only open atomic swaps can be claimed, so there is no need to attest others
+	if atomicSwap.Status != types.Open {
+		return sdkerrors.Wrapf(types.ErrSwapNotClaimable, "status %s", atomicSwap.Status.String())
+	}
+	if !unlocksAtomicSwap(atomicSwap, randomNumber) {
+		return sdkerrors.Wrapf(types.ErrInvalidClaimSecret, "the submitted random number is incorrect")
+	}
+	if _, found := k.GetSwapAttestation(ctx, swapID); found {
+		return sdkerrors.Wrapf(types.ErrSwapAlreadyAttested, "%s", hex.EncodeToString(swapID))
+	}
+
+	k.SetSwapAttestation(ctx, types.NewSwapAttestation(swapID, randomNumber, relayer, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestRandomNumber,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swapID)),
+			sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+		),
+	)
+	return nil
+}
+
+// GetClaimableSwaps returns a page of open swaps with an attested random number
+func (k Keeper) GetClaimableSwaps(ctx sdk.Context, page, limit int) types.ClaimableSwaps {
+	claimable := types.ClaimableSwaps{}
+	k.IterateSwapAttestations(ctx, func(attestation types.SwapAttestation) bool {
+		atomicSwap, found := k.GetAtomicSwap(ctx, attestation.SwapID)
+		if found && atomicSwap.Status == types.Open {
+			claimable = append(claimable, types.NewClaimableSwap(atomicSwap, attestation))
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(claimable), page, limit, 100)
+	if start < 0 || end < 0 {
+		return types.ClaimableSwaps{}
+	}
+	return claimable[start:end]
+}
+
+// unlocksAtomicSwap returns true if the hash of a random number matches the swap's random number hash
+func unlocksAtomicSwap(atomicSwap types.AtomicSwap, randomNumber []byte) bool {
+	hashedSubmittedNumber := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
+	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain)
+	return bytes.Equal(hashedSecret, atomicSwap.GetSwapID())
+}
+
+// GetSwapAttestation returns the random number attested for a swap
+func (k Keeper) GetSwapAttestation(ctx sdk.Context, swapID []byte) (types.SwapAttestation, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapAttestationPrefix)
+	bz := store.Get(swapID)
+	if bz == nil {
+		return types.SwapAttestation{}, false
+	}
+	var attestation types.SwapAttestation
+	k.cdc.MustUnmarshalBinaryBare(bz, &attestation)
+	return attestation, true
+}
+
+// SetSwapAttestation sets the random number attested for a swap
+func (k Keeper) SetSwapAttestation(ctx sdk.Context, attestation types.SwapAttestation) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapAttestationPrefix)
+	store.Set(attestation.SwapID, k.cdc.MustMarshalBinaryBare(attestation))
+}
+
+// DeleteSwapAttestation deletes the random number attested for a swap
+func (k Keeper) DeleteSwapAttestation(ctx sdk.Context, swapID []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SwapAttestationPrefix)
+	store.Delete(swapID)
+}
+
+// IterateSwapAttestations provides an iterator over all stored swap attestations
+func (k Keeper) IterateSwapAttestations(ctx sdk.Context, cb func(attestation types.SwapAttestation) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.SwapAttestationPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.SwapAttestation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &attestation)
+
+		if cb(attestation) {
+			break
+		}
+	}
+}
+
+// GetAllSwapAttestations returns all swap attestations from the store
+func (k Keeper) GetAllSwapAttestations(ctx sdk.Context) (attestations types.SwapAttestations) {
+	k.IterateSwapAttestations(ctx, func(attestation types.SwapAttestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	return
+}
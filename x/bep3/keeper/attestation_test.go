@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestAttestRandomNumber() {
+	suite.SetupTest()
+	suite.GenerateSwapDetails()
+
+	recipient := suite.addrs[5]
+	relayer := suite.addrs[6]
+	for i := 0; i < 2; i++ {
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
+			types.DefaultMinBlockLock, suite.deputy, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(c(BNB_DENOM, 50000)), true)
+		suite.Require().NoError(err)
+	}
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+
+	// random numbers that do not unlock the swap are rejected
+	err := suite.keeper.AttestRandomNumber(suite.ctx, relayer, swapID, suite.randomNumbers[1])
+	suite.True(errors.Is(err, types.ErrInvalidClaimSecret))
+	suite.Empty(suite.keeper.GetClaimableSwaps(suite.ctx, 1, 100))
+
+	suite.Require().NoError(suite.keeper.AttestRandomNumber(suite.ctx, relayer, swapID, suite.randomNumbers[0]))
+	err = suite.keeper.AttestRandomNumber(suite.ctx, relayer, swapID, suite.randomNumbers[0])
+	suite.True(errors.Is(err, types.ErrSwapAlreadyAttested))
+
+	// only the attested swap is claimable, with the random number needed to claim it
+	claimable := suite.keeper.GetClaimableSwaps(suite.ctx, 1, 100)
+	suite.Require().Len(claimable, 1)
+	atomicSwap, _ := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+	suite.Equal(types.NewClaimableSwap(atomicSwap, types.NewSwapAttestation(swapID, suite.randomNumbers[0], relayer, suite.ctx.BlockHeight())), claimable[0])
+
+	// claiming the swap removes the attestation
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, claimable[0].RandomNumber))
+	_, found := suite.keeper.GetSwapAttestation(suite.ctx, swapID)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetClaimableSwaps(suite.ctx, 1, 100))
+
+	// completed swaps cannot be attested
+	err = suite.keeper.AttestRandomNumber(suite.ctx, relayer, swapID, suite.randomNumbers[0])
+	suite.True(errors.Is(err, types.ErrSwapNotClaimable))
+}
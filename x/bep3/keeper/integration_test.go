@@ -75,6 +75,8 @@ func NewBep3GenStateMulti(deputyAddress sdk.AccAddress) app.GenesisState {
 					MaxBlockLock:  types.DefaultMaxBlockLock,
 				},
 			},
+			LongtermStorageDuration: types.DefaultLongtermStorageDuration,
+			MaxPrunedSwapsPerBlock:  types.DefaultMaxPrunedSwapsPerBlock,
 		},
 		Supplies: types.AssetSupplies{
 			types.NewAssetSupply(
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// RegisterInvariants registers all bep3 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariants(k))
+	ir.RegisterRoute(types.ModuleName, "asset-supplies",
+		AssetSupplyInvariants(k))
+	ir.RegisterRoute(types.ModuleName, "asset-floats",
+		AssetFloatInvariants(k))
+}
+
+// ModuleAccountInvariants checks that the module account's coins match the coins locked in open and expired outgoing swaps
+func ModuleAccountInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		_, outgoingCoins := sumActiveSwaps(ctx, k)
+
+		moduleAccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !moduleAccCoins.IsEqual(outgoingCoins)
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"module account",
+			fmt.Sprintf(
+				"\texpected ModuleAccount coins: %s\n"+
+					"\tactual ModuleAccount coins:   %s\n",
+				outgoingCoins, moduleAccCoins),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// AssetSupplyInvariants checks that each asset's incoming and outgoing supply matches the coins in open and expired swaps
+func AssetSupplyInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		incomingCoins, outgoingCoins := sumActiveSwaps(ctx, k)
+
+		var msg string
+		broken := false
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			denom := supply.GetDenom()
+			if !supply.IncomingSupply.Amount.Equal(incomingCoins.AmountOf(denom)) {
+				msg += fmt.Sprintf("\t%s incoming supply %s does not match amount %s in incoming swaps\n",
+					denom, supply.IncomingSupply, incomingCoins.AmountOf(denom))
+				broken = true
+			}
+			if !supply.OutgoingSupply.Amount.Equal(outgoingCoins.AmountOf(denom)) {
+				msg += fmt.Sprintf("\t%s outgoing supply %s does not match amount %s in outgoing swaps\n",
+					denom, supply.OutgoingSupply, outgoingCoins.AmountOf(denom))
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "asset supplies", msg), broken
+	}
+}
+
+// AssetFloatInvariants checks that each asset's current supply equals the amount minted by incoming swaps less the
+// amount burned by outgoing swaps
+func AssetFloatInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateAssetSupplies(ctx, func(supply types.AssetSupply) bool {
+			float, found := k.GetAssetFloat(ctx, supply.GetDenom())
+			if !found {
+				float = types.NewAssetFloat(supply.GetDenom(), sdk.ZeroInt(), sdk.ZeroInt())
+			}
+			if !supply.CurrentSupply.Amount.Equal(float.Float()) {
+				msg += fmt.Sprintf("\t%s current supply %s does not match minted %s less burned %s\n",
+					supply.GetDenom(), supply.CurrentSupply, float.Minted, float.Burned)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "asset floats", msg), broken
+	}
+}
+
+// sumActiveSwaps returns the total coins in open and expired incoming and outgoing swaps
+func sumActiveSwaps(ctx sdk.Context, k Keeper) (incoming, outgoing sdk.Coins) {
+	incoming, outgoing = sdk.NewCoins(), sdk.NewCoins()
+	k.IterateAtomicSwaps(ctx, func(swap types.AtomicSwap) bool {
+		if swap.Status != types.Open && swap.Status != types.Expired {
+			return false
+		}
+		switch swap.Direction {
+		case types.Incoming:
+			incoming = incoming.Add(swap.Amount...)
+		case types.Outgoing:
+			outgoing = outgoing.Add(swap.Amount...)
+		}
+		return false
+	})
+	return incoming, outgoing
+}
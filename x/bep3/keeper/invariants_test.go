@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/kava-labs/kava/x/bep3/keeper"
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+func (suite *AtomicSwapTestSuite) TestInvariants() {
+	suite.SetupTest()
+	suite.GenerateSwapDetails()
+
+	amount := cs(c(BNB_DENOM, 50000))
+
+	// incoming swap from the deputy
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0],
+		types.DefaultMinBlockLock, suite.deputy, suite.addrs[9], TestSenderOtherChain, TestRecipientOtherChain,
+		amount, true)
+	suite.Require().NoError(err)
+
+	// outgoing swap to the deputy, locking coins in the module account
+	err = suite.keeper.IncrementCurrentAssetSupply(suite.ctx, amount[0])
+	suite.Require().NoError(err)
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1],
+		types.DefaultMinBlockLock, suite.addrs[6], suite.deputy, TestSenderOtherChain, TestRecipientOtherChain,
+		amount, true)
+	suite.Require().NoError(err)
+
+	_, broken := keeper.ModuleAccountInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+	_, broken = keeper.AssetSupplyInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// coins leaving the module account without a matching swap update break the module account invariant
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.addrs[6], cs(c(BNB_DENOM, 1)))
+	suite.Require().NoError(err)
+	_, broken = keeper.ModuleAccountInvariants(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// supply changes without a matching swap break the asset supply invariant
+	err = suite.keeper.IncrementIncomingAssetSupply(suite.ctx, c(BNB_DENOM, 1))
+	suite.Require().NoError(err)
+	_, broken = keeper.AssetSupplyInvariants(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
+
+func (suite *AtomicSwapTestSuite) TestAssetFloatInvariants() {
+	suite.SetupTest()
+	suite.GenerateSwapDetails()
+
+	// claimed incoming swaps mint coins and add to the float
+	incoming := c(BNB_DENOM, 50000)
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0],
+		types.DefaultMinBlockLock, suite.deputy, suite.addrs[5], TestSenderOtherChain, TestRecipientOtherChain,
+		cs(incoming), true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[5], swapID, suite.randomNumbers[0]))
+
+	// claimed outgoing swaps burn coins and subtract from the float
+	outgoing := c(BNB_DENOM, 20000)
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[1], suite.timestamps[1],
+		types.DefaultMinBlockLock, suite.addrs[5], suite.deputy, TestSenderOtherChain, TestRecipientOtherChain,
+		cs(outgoing), true)
+	suite.Require().NoError(err)
+	swapID = types.CalculateSwapID(suite.randomNumberHashes[1], suite.addrs[5], TestSenderOtherChain)
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.deputy, swapID, suite.randomNumbers[1]))
+
+	float, found := suite.keeper.GetAssetFloat(suite.ctx, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Equal(types.NewAssetFloat(BNB_DENOM, incoming.Amount, outgoing.Amount), float)
+	_, broken := keeper.AssetFloatInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// supply changes without a matching mint or burn break the asset float invariant
+	err = suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1))
+	suite.Require().NoError(err)
+	_, broken = keeper.AssetFloatInvariants(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
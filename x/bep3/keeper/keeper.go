@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -157,24 +158,38 @@ func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime u
 // ------------------------------------------
 
 // InsertIntoLongtermStorage adds a swap ID and deletion time into the longterm storage index.
-// Completed swaps are stored for 1 week.
+// Completed swaps are stored for the longterm storage duration set in params.
 func (k Keeper) InsertIntoLongtermStorage(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
-	deletionHeight := uint64(atomicSwap.ClosedBlock) + types.DefaultLongtermStorageDuration
+	deletionHeight := uint64(atomicSwap.ClosedBlock) + k.GetLongtermStorageDuration(ctx)
 	store.Set(types.GetAtomicSwapByHeightKey(deletionHeight, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
 }
 
-// RemoveFromLongtermStorage removes a swap from the into the longterm storage index
+// RemoveFromLongtermStorage removes a swap from the into the longterm storage index.
+// The swap must have been inserted while the current longterm storage duration was in effect.
 func (k Keeper) RemoveFromLongtermStorage(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	deletionHeight := uint64(atomicSwap.ClosedBlock) + k.GetLongtermStorageDuration(ctx)
+	k.removeFromLongtermStorageAtHeight(ctx, deletionHeight, atomicSwap.GetSwapID())
+}
+
+func (k Keeper) removeFromLongtermStorageAtHeight(ctx sdk.Context, deletionHeight uint64, swapID []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
-	deletionHeight := uint64(atomicSwap.ClosedBlock) + types.DefaultLongtermStorageDuration
-	store.Delete(types.GetAtomicSwapByHeightKey(deletionHeight, atomicSwap.GetSwapID()))
+	store.Delete(types.GetAtomicSwapByHeightKey(deletionHeight, swapID))
 }
 
 // IterateAtomicSwapsLongtermStorage provides an iterator over AtomicSwaps ordered by deletion height.
 // For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
 func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCutoffTime uint64,
 	cb func(swapID []byte) (stop bool)) {
+	k.iterateLongtermStorageByDeletionHeight(ctx, inclusiveCutoffTime, func(_ uint64, swapID []byte) bool {
+		return cb(swapID)
+	})
+}
+
+// iterateLongtermStorageByDeletionHeight iterates over the longterm storage index, passing each entry's
+// deletion height along with the swap ID so that entries can be removed regardless of the current params.
+func (k Keeper) iterateLongtermStorageByDeletionHeight(ctx sdk.Context, inclusiveCutoffTime uint64,
+	cb func(deletionHeight uint64, swapID []byte) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapLongtermStoragePrefix)
 	iterator := store.Iterator(
 		nil, // start at the very start of the prefix store
@@ -184,9 +199,10 @@ func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCuto
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 
+		deletionHeight := binary.BigEndian.Uint64(iterator.Key()[:8])
 		id := iterator.Value()
 
-		if cb(id) {
+		if cb(deletionHeight, id) {
 			break
 		}
 	}
@@ -243,6 +243,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsByBlock() {
 
 func (suite *KeeperTestSuite) TestInsertIntoLongtermStorage() {
 	suite.ResetChain()
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 
 	// Set atomic swap in longterm storage
 	atomicSwap := atomicSwap(suite.ctx, 1)
@@ -267,6 +268,7 @@ func (suite *KeeperTestSuite) TestInsertIntoLongtermStorage() {
 
 func (suite *KeeperTestSuite) TestRemoveFromLongtermStorage() {
 	suite.ResetChain()
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 
 	// Set atomic swap in longterm storage
 	atomicSwap := atomicSwap(suite.ctx, 1)
@@ -294,6 +296,7 @@ func (suite *KeeperTestSuite) TestRemoveFromLongtermStorage() {
 
 func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 	suite.ResetChain()
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 
 	// Set up atomic swaps with stagged closed blocks
 	var swaps types.AtomicSwaps
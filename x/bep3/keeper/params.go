@@ -18,6 +18,51 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// GetLongtermStorageDuration returns the number of blocks closed swaps are retained for
+func (k Keeper) GetLongtermStorageDuration(ctx sdk.Context) uint64 {
+	var duration uint64
+	k.paramSubspace.Get(ctx, types.KeyLongtermStorageDuration, &duration)
+	return duration
+}
+
+// GetMaxPrunedSwapsPerBlock returns the maximum number of closed swaps deleted in a single block
+func (k Keeper) GetMaxPrunedSwapsPerBlock(ctx sdk.Context) uint64 {
+	var maxPruned uint64
+	k.paramSubspace.Get(ctx, types.KeyMaxPrunedSwapsPerBlock, &maxPruned)
+	return maxPruned
+}
+
+// InitializeSwapPruningParams sets the swap pruning parameters to their defaults if they are not in the param store,
+// which is the case for chains started before the parameters were added.
+func (k Keeper) InitializeSwapPruningParams(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeyLongtermStorageDuration) {
+		k.paramSubspace.Set(ctx, types.KeyLongtermStorageDuration, types.DefaultLongtermStorageDuration)
+	}
+	if !k.paramSubspace.Has(ctx, types.KeyMaxPrunedSwapsPerBlock) {
+		k.paramSubspace.Set(ctx, types.KeyMaxPrunedSwapsPerBlock, types.DefaultMaxPrunedSwapsPerBlock)
+	}
+}
+
+// GetSwapFee returns the fee charged on a completed incoming swap of the input amount. Assets without a swap fee are
+// charged nothing.
+func (k Keeper) GetSwapFee(ctx sdk.Context, amount sdk.Coin) sdk.Coin {
+	var swapFees types.SwapFees
+	k.paramSubspace.Get(ctx, types.KeySwapFees, &swapFees)
+	swapFee, found := swapFees.Get(amount.Denom)
+	if !found {
+		return sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(amount.Denom, swapFee.Compute(amount.Amount))
+}
+
+// InitializeSwapFeeParams sets the swap fees to their default if they are not in the param store, which is the case
+// for chains started before swap fees were added.
+func (k Keeper) InitializeSwapFeeParams(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeySwapFees) {
+		k.paramSubspace.Set(ctx, types.KeySwapFees, types.DefaultSwapFees)
+	}
+}
+
 // ------------------------------------------
 //				Asset
 // ------------------------------------------
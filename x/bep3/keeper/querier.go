@@ -25,6 +25,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAtomicSwaps(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryGetSwapFee:
+			return querySwapFee(ctx, req, keeper)
+		case types.QueryGetAssetFloat:
+			return queryAssetFloat(ctx, req, keeper)
+		case types.QueryGetAssetFloats:
+			return queryAssetFloats(ctx, req, keeper)
+		case types.QueryGetClaimableSwaps:
+			return queryClaimableSwaps(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -67,6 +75,62 @@ func queryAssetSupplies(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	return bz, nil
 }
 
+func queryAssetFloat(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAssetFloat
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	float, found := keeper.GetAssetFloat(ctx, requestParams.Denom)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAssetFloatNotFound, requestParams.Denom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, float)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryAssetFloats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	floats := keeper.GetAllAssetFloats(ctx)
+	if floats == nil {
+		floats = types.AssetFloats{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, floats)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func querySwapFee(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	// Decode request
+	var requestParams types.QuerySwapFee
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, err := keeper.GetAsset(ctx, requestParams.Amount.Denom); err != nil {
+		return nil, err
+	}
+	fee := keeper.GetSwapFee(ctx, requestParams.Amount)
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, fee)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryAtomicSwap(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Decode request
 	var requestParams types.QueryAtomicSwapByID
@@ -119,6 +183,23 @@ func queryAtomicSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
+func queryClaimableSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryClaimableSwaps
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	claimable := keeper.GetClaimableSwaps(ctx, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, claimable)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // query params in the bep3 store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Get params
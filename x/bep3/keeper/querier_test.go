@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"encoding/hex"
+	"errors"
 	"strings"
 	"testing"
 	"time"
@@ -150,6 +151,35 @@ func (suite *QuerierTestSuite) TestQueryAssetSupplies() {
 	suite.Equal(supplies, storeSupplies)
 }
 
+func (suite *QuerierTestSuite) TestQueryAssetFloat() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAssetFloat}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAssetFloat("bnb")),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetAssetFloat}, query)
+	suite.Nil(err)
+
+	// the float of assets supplied at genesis starts at their current supply
+	var float types.AssetFloat
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &float))
+	supply, _ := suite.keeper.GetAssetSupply(ctx, "bnb")
+	suite.Equal("bnb", float.Denom)
+	suite.True(float.Float().Equal(supply.CurrentSupply.Amount))
+	suite.True(float.Burned.IsZero())
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryAssetFloat("xyz"))
+	_, err = suite.querier(ctx, []string{types.QueryGetAssetFloat}, query)
+	suite.True(errors.Is(err, types.ErrAssetFloatNotFound))
+
+	bz, err = suite.querier(ctx, []string{types.QueryGetAssetFloats}, abci.RequestQuery{})
+	suite.Nil(err)
+	var floats types.AssetFloats
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &floats))
+	suite.Equal(suite.keeper.GetAllAssetFloats(ctx), floats)
+}
+
 func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	// Set up request query
@@ -187,6 +217,38 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 	suite.Equal(gs.Params, p)
 }
 
+func (suite *QuerierTestSuite) TestQuerySwapFee() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	params := suite.keeper.GetParams(ctx)
+	params.SwapFees = types.SwapFees{types.NewSwapFee("bnb", sdk.NewInt(100), sdk.MustNewDecFromStr("0.01"), sdk.ZeroInt(), sdk.NewInt(1000))}
+	suite.keeper.SetParams(ctx, params)
+
+	querySwapFee := func(amount sdk.Coin) (sdk.Coin, error) {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSwapFee}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySwapFee(amount)),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetSwapFee}, query)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		var fee sdk.Coin
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &fee))
+		return fee, nil
+	}
+
+	fee, err := querySwapFee(c("bnb", 50000))
+	suite.Nil(err)
+	suite.Equal(c("bnb", 600), fee)
+
+	fee, err = querySwapFee(c("bnb", 1000000))
+	suite.Nil(err)
+	suite.Equal(c("bnb", 1000), fee)
+
+	_, err = querySwapFee(c("unsupported", 1000))
+	suite.Error(err)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
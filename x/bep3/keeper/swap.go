@@ -1,13 +1,13 @@
 package keeper
 
 import (
-	"bytes"
 	"encoding/hex"
 	"fmt"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/kava-labs/kava/x/bep3/types"
 )
@@ -16,6 +16,15 @@ import (
 func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
 	sender sdk.AccAddress, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
 	amount sdk.Coins, crossChain bool) error {
+	return k.CreateAtomicSwapWithMemo(ctx, randomNumberHash, timestamp, heightSpan, sender, recipient,
+		senderOtherChain, recipientOtherChain, amount, crossChain, "")
+}
+
+// CreateAtomicSwapWithMemo creates a new atomic swap with a memo. The memo is stored with the swap and included in
+// its create, claim, and refund events, so the swap can be matched to off-chain records.
+func (k Keeper) CreateAtomicSwapWithMemo(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
+	sender sdk.AccAddress, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
+	amount sdk.Coins, crossChain bool, memo string) error {
 	// Confirm that this is not a duplicate swap
 	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
 	_, found := k.GetAtomicSwap(ctx, swapID)
@@ -104,6 +113,7 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
 	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
 		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction)
+	atomicSwap.Memo = memo
 
 	// Insert the atomic swap under both keys
 	k.SetAtomicSwap(ctx, atomicSwap)
@@ -122,6 +132,7 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
 			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyMemo, atomicSwap.Memo),
 		),
 	)
 
@@ -140,12 +151,8 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		return sdkerrors.Wrapf(types.ErrSwapNotClaimable, "status %s", atomicSwap.Status.String())
 	}
 
-	//  Calculate hashed secret using submitted number
-	hashedSubmittedNumber := types.CalculateRandomHash(randomNumber, atomicSwap.Timestamp)
-	hashedSecret := types.CalculateSwapID(hashedSubmittedNumber, atomicSwap.Sender, atomicSwap.SenderOtherChain)
-
 	// Confirm that secret unlocks the atomic swap
-	if !bytes.Equal(hashedSecret, atomicSwap.GetSwapID()) {
+	if !unlocksAtomicSwap(atomicSwap, randomNumber) {
 		return sdkerrors.Wrapf(types.ErrInvalidClaimSecret, "the submitted random number is incorrect")
 	}
 
@@ -160,15 +167,39 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
+		fee := k.GetSwapFee(ctx, atomicSwap.Amount[0])
+		payout := atomicSwap.Amount.Sub(sdk.NewCoins(fee))
+		if !payout.IsZero() {
+			err = k.ApplyAddressLimits(ctx, atomicSwap.Recipient, payout[0])
+			if err != nil {
+				return err
+			}
+		}
 		// incoming case - coins should be MINTED, then sent to user
 		err = k.supplyKeeper.MintCoins(ctx, types.ModuleName, atomicSwap.Amount)
 		if err != nil {
 			return err
 		}
-		// Send intended recipient coins
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, atomicSwap.Amount)
-		if err != nil {
-			return err
+		k.recordMint(ctx, atomicSwap.Amount[0])
+		// Send intended recipient coins, less the swap fee which is sent to the fee collector
+		if fee.IsPositive() {
+			err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, sdk.NewCoins(fee))
+			if err != nil {
+				return err
+			}
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSwapFee,
+					sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+					sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+				),
+			)
+		}
+		if !payout.IsZero() {
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Recipient, payout)
+			if err != nil {
+				return err
+			}
 		}
 	case types.Outgoing:
 		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
@@ -184,6 +215,7 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
+		k.recordBurn(ctx, atomicSwap.Amount[0])
 	default:
 		return fmt.Errorf("invalid swap direction: %s", atomicSwap.Direction.String())
 	}
@@ -196,6 +228,7 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	// Remove from byBlock index and transition to longterm storage
 	k.RemoveFromByBlockIndex(ctx, atomicSwap)
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
+	k.DeleteSwapAttestation(ctx, atomicSwap.GetSwapID())
 
 	// Emit 'claim_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -206,6 +239,7 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
 			sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+			sdk.NewAttribute(types.AttributeKeyMemo, atomicSwap.Memo),
 		),
 	)
 
@@ -249,6 +283,7 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 
 	// Transition to longterm storage
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
+	k.DeleteSwapAttestation(ctx, atomicSwap.GetSwapID())
 
 	// Emit 'refund_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -258,6 +293,7 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
 			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyMemo, atomicSwap.Memo),
 		),
 	)
 
@@ -292,16 +328,25 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	)
 }
 
-// DeleteClosedAtomicSwapsFromLongtermStorage removes swaps one week after completion.
+// DeleteClosedAtomicSwapsFromLongtermStorage removes swaps once their longterm storage duration has passed.
+// At most MaxPrunedSwapsPerBlock swaps are removed per block, any remaining swaps are removed in later blocks.
 func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
-	k.IterateAtomicSwapsLongtermStorage(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
-		swap, found := k.GetAtomicSwap(ctx, id)
-		if !found {
-			// NOTE: shouldn't happen. Continue to next item.
-			return false
+	maxPruned := k.GetMaxPrunedSwapsPerBlock(ctx)
+
+	var deletionHeights []uint64
+	var swapIDs [][]byte
+	k.iterateLongtermStorageByDeletionHeight(ctx, uint64(ctx.BlockHeight()), func(deletionHeight uint64, id []byte) bool {
+		if uint64(len(swapIDs)) >= maxPruned {
+			return true
 		}
-		k.RemoveAtomicSwap(ctx, swap.GetSwapID())
-		k.RemoveFromLongtermStorage(ctx, swap)
+		deletionHeights = append(deletionHeights, deletionHeight)
+		swapIDs = append(swapIDs, id)
 		return false
 	})
+
+	for i, id := range swapIDs {
+		// NOTE: the swap should always be found, but the index entry is removed regardless so it can't block pruning
+		k.RemoveAtomicSwap(ctx, id)
+		k.removeFromLongtermStorageAtHeight(ctx, deletionHeights[i], id)
+	}
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/bep3"
@@ -688,6 +690,86 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestClaimAtomicSwapWithFee() {
+	suite.SetupTest()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SwapFees = types.SwapFees{types.NewSwapFee(BNB_DENOM, sdk.NewInt(100), sdk.MustNewDecFromStr("0.01"), sdk.ZeroInt(), sdk.ZeroInt())}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	amount := c(BNB_DENOM, 50000)
+	expectedFee := c(BNB_DENOM, 600)
+	suite.Equal(expectedFee, suite.keeper.GetSwapFee(suite.ctx, amount))
+
+	recipient := suite.addrs[5]
+	err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.timestamps[0],
+		types.DefaultMinBlockLock, suite.deputy, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+		cs(amount), true)
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+
+	ak := suite.app.GetAccountKeeper()
+	sk := suite.app.GetSupplyKeeper()
+	recipientBalancePre := ak.GetAccount(suite.ctx, recipient).GetCoins().AmountOf(BNB_DENOM)
+	feeCollectorBalancePre := sk.GetModuleAccount(suite.ctx, auth.FeeCollectorName).GetCoins().AmountOf(BNB_DENOM)
+
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, recipient, swapID, suite.randomNumbers[0]))
+
+	// the recipient is paid the swap amount less the fee, the fee goes to the fee collector
+	recipientBalancePost := ak.GetAccount(suite.ctx, recipient).GetCoins().AmountOf(BNB_DENOM)
+	feeCollectorBalancePost := sk.GetModuleAccount(suite.ctx, auth.FeeCollectorName).GetCoins().AmountOf(BNB_DENOM)
+	suite.Equal(recipientBalancePre.Add(amount.Amount).Sub(expectedFee.Amount), recipientBalancePost)
+	suite.Equal(feeCollectorBalancePre.Add(expectedFee.Amount), feeCollectorBalancePost)
+
+	// the full amount is still counted in the current supply
+	assetSupply, _ := suite.keeper.GetAssetSupply(suite.ctx, BNB_DENOM)
+	suite.Equal(amount, assetSupply.CurrentSupply)
+}
+
+func (suite *AtomicSwapTestSuite) TestClaimAtomicSwapAddressLimits() {
+	recipient := suite.addrs[5]
+	claim := func(i int, amount sdk.Coin, blockTime time.Time) error {
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
+			types.DefaultMinBlockLock, suite.deputy, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(amount), true)
+		suite.Require().NoError(err)
+		swapID := types.CalculateSwapID(suite.randomNumberHashes[i], suite.deputy, TestSenderOtherChain)
+		return suite.keeper.ClaimAtomicSwap(suite.ctx.WithBlockTime(blockTime), recipient, swapID, suite.randomNumbers[i])
+	}
+	setLimit := func(limit types.AddressLimit) {
+		params := suite.keeper.GetParams(suite.ctx)
+		params.AddressLimits = types.AddressLimits{limit}
+		suite.keeper.SetParams(suite.ctx, params)
+	}
+	now := suite.ctx.BlockTime()
+
+	suite.Run("holding limit", func() {
+		suite.SetupTest()
+		balance := suite.app.GetAccountKeeper().GetAccount(suite.ctx, recipient).GetCoins().AmountOf(BNB_DENOM)
+		setLimit(types.NewAddressLimit(BNB_DENOM, balance.Add(sdk.NewInt(60000)), sdk.ZeroInt(), 0))
+
+		suite.NoError(claim(0, c(BNB_DENOM, 50000), now))
+		err := claim(1, c(BNB_DENOM, 50000), now)
+		suite.Error(err)
+		suite.True(errors.Is(err, types.ErrExceedsAddressHoldingLimit))
+	})
+
+	suite.Run("inbound limit resets each period", func() {
+		suite.SetupTest()
+		setLimit(types.NewAddressLimit(BNB_DENOM, sdk.ZeroInt(), sdk.NewInt(60000), time.Hour))
+
+		suite.NoError(claim(0, c(BNB_DENOM, 50000), now))
+		err := claim(1, c(BNB_DENOM, 50000), now.Add(time.Minute))
+		suite.Error(err)
+		suite.True(errors.Is(err, types.ErrExceedsAddressInboundLimit))
+
+		suite.NoError(claim(2, c(BNB_DENOM, 50000), now.Add(time.Hour)))
+		inbound, found := suite.keeper.GetAddressInbound(suite.ctx, recipient, BNB_DENOM)
+		suite.True(found)
+		suite.Equal(c(BNB_DENOM, 50000), inbound.Amount)
+		suite.Equal(now.Add(time.Hour), inbound.PeriodStart)
+	})
+}
+
 func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 	suite.SetupTest()
 
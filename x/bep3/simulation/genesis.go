@@ -155,9 +155,7 @@ func loadRandomBep3GenState(simState *module.SimulationState) types.GenesisState
 	}
 
 	bep3Genesis := types.GenesisState{
-		Params: types.Params{
-			AssetParams: supportedAssets,
-		},
+		Params:            types.NewParams(supportedAssets),
 		Supplies:          supplies,
 		PreviousBlockTime: types.DefaultPreviousBlockTime,
 	}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddressLimit limits how much of an asset a single address can receive from incoming swaps, so that one address
+// cannot absorb the whole supply limit of an asset. Limits are checked when an incoming swap is claimed.
+type AddressLimit struct {
+	Denom         string        `json:"denom" yaml:"denom"`
+	MaxHolding    sdk.Int       `json:"max_holding" yaml:"max_holding"`       // largest balance an address can hold after claiming a swap, zero for no limit
+	MaxInbound    sdk.Int       `json:"max_inbound" yaml:"max_inbound"`       // largest amount an address can claim in each inbound period, zero for no limit
+	InboundPeriod time.Duration `json:"inbound_period" yaml:"inbound_period"` // the duration the inbound limit applies for
+}
+
+// NewAddressLimit returns a new AddressLimit
+func NewAddressLimit(denom string, maxHolding, maxInbound sdk.Int, inboundPeriod time.Duration) AddressLimit {
+	return AddressLimit{
+		Denom:         denom,
+		MaxHolding:    maxHolding,
+		MaxInbound:    maxInbound,
+		InboundPeriod: inboundPeriod,
+	}
+}
+
+// Validate performs basic validation of an AddressLimit
+func (al AddressLimit) Validate() error {
+	if err := sdk.ValidateDenom(al.Denom); err != nil {
+		return fmt.Errorf("address limit denom invalid: %s", al.Denom)
+	}
+	if al.MaxHolding.IsNil() || al.MaxHolding.IsNegative() {
+		return fmt.Errorf("address limit for %s cannot have a negative max holding %s", al.Denom, al.MaxHolding)
+	}
+	if al.MaxInbound.IsNil() || al.MaxInbound.IsNegative() {
+		return fmt.Errorf("address limit for %s cannot have a negative max inbound %s", al.Denom, al.MaxInbound)
+	}
+	if al.MaxInbound.IsPositive() && al.InboundPeriod <= 0 {
+		return fmt.Errorf("address limit for %s must have a positive inbound period, got %s", al.Denom, al.InboundPeriod)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (al AddressLimit) String() string {
+	return fmt.Sprintf(`Address Limit:
+	Denom: %s
+	Max Holding: %s
+	Max Inbound: %s
+	Inbound Period: %s`,
+		al.Denom, al.MaxHolding, al.MaxInbound, al.InboundPeriod)
+}
+
+// AddressLimits slice of AddressLimit
+type AddressLimits []AddressLimit
+
+// Validate performs basic validation of each address limit and checks that no denom has more than one limit
+func (als AddressLimits) Validate() error {
+	denoms := make(map[string]bool)
+	for _, al := range als {
+		if err := al.Validate(); err != nil {
+			return err
+		}
+		if denoms[al.Denom] {
+			return fmt.Errorf("address limit for %s cannot have duplicate denom", al.Denom)
+		}
+		denoms[al.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the address limit for the input denom
+func (als AddressLimits) Get(denom string) (AddressLimit, bool) {
+	for _, al := range als {
+		if al.Denom == denom {
+			return al, true
+		}
+	}
+	return AddressLimit{}, false
+}
+
+// String implements fmt.Stringer
+func (als AddressLimits) String() string {
+	out := "Address Limits\n"
+	for _, al := range als {
+		out += fmt.Sprintf("%s\n", al)
+	}
+	return out
+}
+
+// AddressInbound is the amount of an asset an address has claimed from incoming swaps in the current inbound period
+type AddressInbound struct {
+	Address     sdk.AccAddress `json:"address" yaml:"address"`
+	Amount      sdk.Coin       `json:"amount" yaml:"amount"`
+	PeriodStart time.Time      `json:"period_start" yaml:"period_start"`
+}
+
+// NewAddressInbound returns a new AddressInbound
+func NewAddressInbound(address sdk.AccAddress, amount sdk.Coin, periodStart time.Time) AddressInbound {
+	return AddressInbound{
+		Address:     address,
+		Amount:      amount,
+		PeriodStart: periodStart,
+	}
+}
+
+// Validate performs basic validation of an AddressInbound
+func (ai AddressInbound) Validate() error {
+	if ai.Address.Empty() {
+		return errors.New("address inbound address cannot be empty")
+	}
+	if !ai.Amount.IsValid() {
+		return fmt.Errorf("invalid address inbound amount: %s", ai.Amount)
+	}
+	return nil
+}
+
+// AddressInbounds slice of AddressInbound
+type AddressInbounds []AddressInbound
+
+// Validate performs basic validation of each address inbound and checks that each address has one per denom
+func (ais AddressInbounds) Validate() error {
+	seen := make(map[string]bool)
+	for _, ai := range ais {
+		if err := ai.Validate(); err != nil {
+			return err
+		}
+		key := ai.Address.String() + ai.Amount.Denom
+		if seen[key] {
+			return fmt.Errorf("duplicate address inbound for %s %s", ai.Address, ai.Amount.Denom)
+		}
+		seen[key] = true
+	}
+	return nil
+}
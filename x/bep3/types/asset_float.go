@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AssetFloat is the cumulative amount of a bridged asset minted by claimed incoming swaps and burned by claimed
+// outgoing swaps. The difference between them is the bridge float, the amount of the asset that exists on this chain,
+// which must always equal the asset's current supply.
+type AssetFloat struct {
+	Denom  string  `json:"denom" yaml:"denom"`
+	Minted sdk.Int `json:"minted" yaml:"minted"`
+	Burned sdk.Int `json:"burned" yaml:"burned"`
+}
+
+// NewAssetFloat returns a new AssetFloat
+func NewAssetFloat(denom string, minted, burned sdk.Int) AssetFloat {
+	return AssetFloat{
+		Denom:  denom,
+		Minted: minted,
+		Burned: burned,
+	}
+}
+
+// Float returns the amount minted less the amount burned
+func (af AssetFloat) Float() sdk.Int {
+	return af.Minted.Sub(af.Burned)
+}
+
+// Validate performs basic validation of an AssetFloat
+func (af AssetFloat) Validate() error {
+	if err := sdk.ValidateDenom(af.Denom); err != nil {
+		return fmt.Errorf("asset float denom invalid: %s", af.Denom)
+	}
+	if af.Minted.IsNil() || af.Minted.IsNegative() {
+		return fmt.Errorf("asset float for %s cannot have a negative minted amount %s", af.Denom, af.Minted)
+	}
+	if af.Burned.IsNil() || af.Burned.IsNegative() {
+		return fmt.Errorf("asset float for %s cannot have a negative burned amount %s", af.Denom, af.Burned)
+	}
+	if af.Burned.GT(af.Minted) {
+		return fmt.Errorf("asset float for %s cannot have burned %s more than minted %s", af.Denom, af.Burned, af.Minted)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (af AssetFloat) String() string {
+	return fmt.Sprintf(`Asset Float:
+	Denom: %s
+	Minted: %s
+	Burned: %s
+	Float: %s`,
+		af.Denom, af.Minted, af.Burned, af.Float())
+}
+
+// AssetFloats slice of AssetFloat
+type AssetFloats []AssetFloat
+
+// Validate performs basic validation of each asset float and checks that no denom has more than one float
+func (afs AssetFloats) Validate() error {
+	denoms := make(map[string]bool)
+	for _, af := range afs {
+		if err := af.Validate(); err != nil {
+			return err
+		}
+		if denoms[af.Denom] {
+			return fmt.Errorf("found duplicate denom in asset floats %s", af.Denom)
+		}
+		denoms[af.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetFloatValidate(t *testing.T) {
+	testCases := []struct {
+		msg     string
+		float   AssetFloat
+		expPass bool
+	}{
+		{
+			msg:     "valid float",
+			float:   NewAssetFloat("bnb", sdk.NewInt(100), sdk.NewInt(40)),
+			expPass: true,
+		},
+		{
+			msg:     "fully burned",
+			float:   NewAssetFloat("bnb", sdk.NewInt(100), sdk.NewInt(100)),
+			expPass: true,
+		},
+		{
+			msg:     "invalid denom",
+			float:   NewAssetFloat("Invalid Denom", sdk.NewInt(100), sdk.ZeroInt()),
+			expPass: false,
+		},
+		{
+			msg:     "nil minted",
+			float:   AssetFloat{Denom: "bnb", Burned: sdk.ZeroInt()},
+			expPass: false,
+		},
+		{
+			msg:     "negative burned",
+			float:   NewAssetFloat("bnb", sdk.NewInt(100), sdk.NewInt(-1)),
+			expPass: false,
+		},
+		{
+			msg:     "burned more than minted",
+			float:   NewAssetFloat("bnb", sdk.NewInt(100), sdk.NewInt(101)),
+			expPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.float.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestAssetFloatFloat(t *testing.T) {
+	require.Equal(t, sdk.NewInt(60), NewAssetFloat("bnb", sdk.NewInt(100), sdk.NewInt(40)).Float())
+}
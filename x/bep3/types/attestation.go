@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+)
+
+// SwapAttestation is a random number posted by a relayer after it was revealed on the counterpart chain. The random
+// number is checked against the swap's random number hash when it is posted, so anyone can use it to claim the swap.
+type SwapAttestation struct {
+	SwapID       tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	RandomNumber tmbytes.HexBytes `json:"random_number" yaml:"random_number"`
+	Relayer      sdk.AccAddress   `json:"relayer" yaml:"relayer"`
+	Height       int64            `json:"height" yaml:"height"`
+}
+
+// NewSwapAttestation returns a new SwapAttestation
+func NewSwapAttestation(swapID, randomNumber tmbytes.HexBytes, relayer sdk.AccAddress, height int64) SwapAttestation {
+	return SwapAttestation{
+		SwapID:       swapID,
+		RandomNumber: randomNumber,
+		Relayer:      relayer,
+		Height:       height,
+	}
+}
+
+// Validate performs basic validation of a SwapAttestation
+func (sa SwapAttestation) Validate() error {
+	if len(sa.SwapID) != SwapIDLength {
+		return fmt.Errorf("swap attestation swap id length must be %d, got %d", SwapIDLength, len(sa.SwapID))
+	}
+	if len(sa.RandomNumber) != RandomNumberLength {
+		return fmt.Errorf("swap attestation random number length must be %d, got %d", RandomNumberLength, len(sa.RandomNumber))
+	}
+	if sa.Relayer.Empty() {
+		return fmt.Errorf("swap attestation relayer cannot be empty")
+	}
+	if sa.Height < 0 {
+		return fmt.Errorf("swap attestation height cannot be negative: %d", sa.Height)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sa SwapAttestation) String() string {
+	return fmt.Sprintf(`Swap Attestation:
+	Swap ID: %s
+	Random Number: %s
+	Relayer: %s
+	Height: %d`,
+		hex.EncodeToString(sa.SwapID), hex.EncodeToString(sa.RandomNumber), sa.Relayer, sa.Height)
+}
+
+// SwapAttestations slice of SwapAttestation
+type SwapAttestations []SwapAttestation
+
+// Validate performs basic validation of each swap attestation and checks that no swap has more than one
+func (sas SwapAttestations) Validate() error {
+	ids := make(map[string]bool)
+	for _, sa := range sas {
+		if err := sa.Validate(); err != nil {
+			return err
+		}
+		id := hex.EncodeToString(sa.SwapID)
+		if ids[id] {
+			return fmt.Errorf("found duplicate swap attestation for swap %s", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
+
+// ClaimableSwap is an open swap with an attested random number, along with the random number needed to claim it
+type ClaimableSwap struct {
+	AtomicSwap   AugmentedAtomicSwap `json:"atomic_swap" yaml:"atomic_swap"`
+	RandomNumber tmbytes.HexBytes    `json:"random_number" yaml:"random_number"`
+	Relayer      sdk.AccAddress      `json:"relayer" yaml:"relayer"`
+}
+
+// NewClaimableSwap returns a new ClaimableSwap
+func NewClaimableSwap(swap AtomicSwap, attestation SwapAttestation) ClaimableSwap {
+	return ClaimableSwap{
+		AtomicSwap:   NewAugmentedAtomicSwap(swap),
+		RandomNumber: attestation.RandomNumber,
+		Relayer:      attestation.Relayer,
+	}
+}
+
+// ClaimableSwaps slice of ClaimableSwap
+type ClaimableSwaps []ClaimableSwap
@@ -45,4 +45,12 @@ var (
 	ErrInvalidSwapAccount = sdkerrors.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = sdkerrors.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrExceedsAddressHoldingLimit error for when a swap claim would put an address's balance above the holding limit
+	ErrExceedsAddressHoldingLimit = sdkerrors.Register(ModuleName, 21, "address balance over holding limit")
+	// ErrExceedsAddressInboundLimit error for when a swap claim would put an address above the inbound limit for the current period
+	ErrExceedsAddressInboundLimit = sdkerrors.Register(ModuleName, 22, "address inbound amount over limit for current period")
+	// ErrAssetFloatNotFound error for when no asset has been minted or burned for a denom
+	ErrAssetFloatNotFound = sdkerrors.Register(ModuleName, 23, "asset float not found")
+	// ErrSwapAlreadyAttested error for when a random number has already been attested for a swap
+	ErrSwapAlreadyAttested = sdkerrors.Register(ModuleName, 24, "random number already attested for swap")
 )
@@ -2,10 +2,12 @@ package types
 
 // Events for bep3 module
 const (
-	EventTypeCreateAtomicSwap = "create_atomic_swap"
-	EventTypeClaimAtomicSwap  = "claim_atomic_swap"
-	EventTypeRefundAtomicSwap = "refund_atomic_swap"
-	EventTypeSwapsExpired     = "swaps_expired"
+	EventTypeCreateAtomicSwap   = "create_atomic_swap"
+	EventTypeClaimAtomicSwap    = "claim_atomic_swap"
+	EventTypeRefundAtomicSwap   = "refund_atomic_swap"
+	EventTypeSwapsExpired       = "swaps_expired"
+	EventTypeSwapFee            = "swap_fee"
+	EventTypeAttestRandomNumber = "attest_random_number"
 
 	AttributeValueCategory       = ModuleName
 	AttributeKeySender           = "sender"
@@ -21,5 +23,8 @@ const (
 	AttributeKeyRandomNumber     = "random_number"
 	AttributeKeyRefundSender     = "refund_sender"
 	AttributeKeyAtomicSwapIDs    = "atomic_swap_ids"
+	AttributeKeyFee              = "fee"
 	AttributeExpirationBlock     = "expiration_block"
+	AttributeKeyMemo             = "memo"
+	AttributeKeyRelayer          = "relayer"
 )
@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapFee is the fee schedule for completed incoming swaps of an asset. The fee is a flat amount plus a proportion of
+// the swap amount, bounded by a minimum and an optional maximum, and is deducted from the coins paid to the recipient.
+type SwapFee struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	FlatFee sdk.Int `json:"flat_fee" yaml:"flat_fee"` // amount charged on every swap
+	Rate    sdk.Dec `json:"rate" yaml:"rate"`         // proportion of the swap amount charged in addition to the flat fee
+	MinFee  sdk.Int `json:"min_fee" yaml:"min_fee"`   // smallest fee charged on a swap
+	MaxFee  sdk.Int `json:"max_fee" yaml:"max_fee"`   // largest fee charged on a swap, zero for no maximum
+}
+
+// NewSwapFee returns a new SwapFee
+func NewSwapFee(denom string, flatFee sdk.Int, rate sdk.Dec, minFee, maxFee sdk.Int) SwapFee {
+	return SwapFee{
+		Denom:   denom,
+		FlatFee: flatFee,
+		Rate:    rate,
+		MinFee:  minFee,
+		MaxFee:  maxFee,
+	}
+}
+
+// Validate performs basic validation of a SwapFee
+func (sf SwapFee) Validate() error {
+	if err := sdk.ValidateDenom(sf.Denom); err != nil {
+		return fmt.Errorf("swap fee denom invalid: %s", sf.Denom)
+	}
+	if sf.FlatFee.IsNil() || sf.FlatFee.IsNegative() {
+		return fmt.Errorf("swap fee for %s cannot have a negative flat fee %s", sf.Denom, sf.FlatFee)
+	}
+	if sf.Rate.IsNil() || sf.Rate.IsNegative() || sf.Rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("swap fee for %s must have a rate between 0 and 1, got %s", sf.Denom, sf.Rate)
+	}
+	if sf.MinFee.IsNil() || sf.MinFee.IsNegative() {
+		return fmt.Errorf("swap fee for %s cannot have a negative minimum fee %s", sf.Denom, sf.MinFee)
+	}
+	if sf.MaxFee.IsNil() || sf.MaxFee.IsNegative() {
+		return fmt.Errorf("swap fee for %s cannot have a negative maximum fee %s", sf.Denom, sf.MaxFee)
+	}
+	if sf.MaxFee.IsPositive() && sf.MinFee.GT(sf.MaxFee) {
+		return fmt.Errorf("swap fee for %s has minimum fee > maximum fee %s > %s", sf.Denom, sf.MinFee, sf.MaxFee)
+	}
+	return nil
+}
+
+// Compute returns the fee charged on a swap of the input amount. The fee never exceeds the swap amount.
+func (sf SwapFee) Compute(amount sdk.Int) sdk.Int {
+	fee := sf.FlatFee.Add(sf.Rate.MulInt(amount).TruncateInt())
+	fee = sdk.MaxInt(fee, sf.MinFee)
+	if sf.MaxFee.IsPositive() {
+		fee = sdk.MinInt(fee, sf.MaxFee)
+	}
+	return sdk.MinInt(fee, amount)
+}
+
+// String implements fmt.Stringer
+func (sf SwapFee) String() string {
+	return fmt.Sprintf(`Swap Fee:
+	Denom: %s
+	Flat Fee: %s
+	Rate: %s
+	Min Fee: %s
+	Max Fee: %s`,
+		sf.Denom, sf.FlatFee, sf.Rate, sf.MinFee, sf.MaxFee)
+}
+
+// SwapFees slice of SwapFee
+type SwapFees []SwapFee
+
+// Validate performs basic validation of each swap fee and checks that no denom has more than one fee schedule
+func (sfs SwapFees) Validate() error {
+	denoms := make(map[string]bool)
+	for _, sf := range sfs {
+		if err := sf.Validate(); err != nil {
+			return err
+		}
+		if denoms[sf.Denom] {
+			return fmt.Errorf("swap fee for %s cannot have duplicate denom", sf.Denom)
+		}
+		denoms[sf.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the swap fee for the input denom
+func (sfs SwapFees) Get(denom string) (SwapFee, bool) {
+	for _, sf := range sfs {
+		if sf.Denom == denom {
+			return sf, true
+		}
+	}
+	return SwapFee{}, false
+}
+
+// String implements fmt.Stringer
+func (sfs SwapFees) String() string {
+	out := "Swap Fees\n"
+	for _, sf := range sfs {
+		out += fmt.Sprintf("%s\n", sf)
+	}
+	return out
+}
@@ -9,10 +9,13 @@ import (
 
 // GenesisState - all bep3 state that must be provided at genesis
 type GenesisState struct {
-	Params            Params        `json:"params" yaml:"params"`
-	AtomicSwaps       AtomicSwaps   `json:"atomic_swaps" yaml:"atomic_swaps"`
-	Supplies          AssetSupplies `json:"supplies" yaml:"supplies"`
-	PreviousBlockTime time.Time     `json:"previous_block_time" yaml:"previous_block_time"`
+	Params            Params           `json:"params" yaml:"params"`
+	AtomicSwaps       AtomicSwaps      `json:"atomic_swaps" yaml:"atomic_swaps"`
+	Supplies          AssetSupplies    `json:"supplies" yaml:"supplies"`
+	PreviousBlockTime time.Time        `json:"previous_block_time" yaml:"previous_block_time"`
+	AddressInbounds   AddressInbounds  `json:"address_inbounds" yaml:"address_inbounds"`
+	AssetFloats       AssetFloats      `json:"asset_floats" yaml:"asset_floats"`
+	SwapAttestations  SwapAttestations `json:"swap_attestations" yaml:"swap_attestations"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -76,5 +79,11 @@ func (gs GenesisState) Validate() error {
 		}
 		supplyDenoms[supply.GetDenom()] = true
 	}
-	return nil
+	if err := gs.AddressInbounds.Validate(); err != nil {
+		return err
+	}
+	if err := gs.AssetFloats.Validate(); err != nil {
+		return err
+	}
+	return gs.SwapAttestations.Validate()
 }
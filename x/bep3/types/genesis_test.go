@@ -33,6 +33,7 @@ func (suite *GenesisTestSuite) TestValidate() {
 	type args struct {
 		swaps             types.AtomicSwaps
 		supplies          types.AssetSupplies
+		floats            types.AssetFloats
 		previousBlockTime time.Time
 	}
 	testCases := []struct {
@@ -74,6 +75,36 @@ func (suite *GenesisTestSuite) TestValidate() {
 			},
 			false,
 		},
+		{
+			"with asset floats",
+			args{
+				swaps:             types.AtomicSwaps{},
+				floats:            types.AssetFloats{types.NewAssetFloat("kava", sdk.NewInt(2), sdk.OneInt())},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			true,
+		},
+		{
+			"invalid asset float",
+			args{
+				swaps:             types.AtomicSwaps{},
+				floats:            types.AssetFloats{types.NewAssetFloat("kava", sdk.OneInt(), sdk.NewInt(2))},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
+		{
+			"duplicate asset floats",
+			args{
+				swaps: types.AtomicSwaps{},
+				floats: types.AssetFloats{
+					types.NewAssetFloat("kava", sdk.OneInt(), sdk.ZeroInt()),
+					types.NewAssetFloat("kava", sdk.NewInt(2), sdk.ZeroInt()),
+				},
+				previousBlockTime: types.DefaultPreviousBlockTime,
+			},
+			false,
+		},
 		{
 			"duplicate swaps",
 			args{
@@ -106,6 +137,7 @@ func (suite *GenesisTestSuite) TestValidate() {
 				gs = types.DefaultGenesisState()
 			} else {
 				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime)
+				gs.AssetFloats = tc.args.floats
 			}
 
 			err := gs.Validate()
@@ -36,9 +36,17 @@ var (
 	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
 	AssetSupplyPrefix               = []byte{0x03}
 	PreviousBlockTimeKey            = []byte{0x04}
+	AddressInboundPrefix            = []byte{0x05} // prefix for keys that store the amount each address has claimed from incoming swaps
+	AssetFloatPrefix                = []byte{0x06} // prefix for keys that store the amount of each asset minted and burned by swaps
+	SwapAttestationPrefix           = []byte{0x07} // prefix for keys that store random numbers attested by relayers
 )
 
 // GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetAddressInboundKey is used to store the amount of a denom an address has claimed from incoming swaps
+func GetAddressInboundKey(addr sdk.AccAddress, denom string) []byte {
+	return append(append([]byte{}, addr...), []byte(denom)...)
+}
@@ -13,10 +13,11 @@ import (
 )
 
 const (
-	CreateAtomicSwap = "createAtomicSwap"
-	ClaimAtomicSwap  = "claimAtomicSwap"
-	RefundAtomicSwap = "refundAtomicSwap"
-	CalcSwapID       = "calcSwapID"
+	CreateAtomicSwap   = "createAtomicSwap"
+	ClaimAtomicSwap    = "claimAtomicSwap"
+	RefundAtomicSwap   = "refundAtomicSwap"
+	AttestRandomNumber = "attestRandomNumber"
+	CalcSwapID         = "calcSwapID"
 
 	Int64Size               = 8
 	RandomNumberHashLength  = 32
@@ -25,6 +26,7 @@ const (
 	MaxOtherChainAddrLength = 64
 	SwapIDLength            = 32
 	MaxExpectedIncomeLength = 64
+	MaxSwapMemoLength       = 128
 )
 
 // ensure Msg interface compliance at compile time
@@ -47,6 +49,8 @@ type MsgCreateAtomicSwap struct {
 	Timestamp           int64            `json:"timestamp"  yaml:"timestamp"`
 	Amount              sdk.Coins        `json:"amount"  yaml:"amount"`
 	HeightSpan          uint64           `json:"height_span"  yaml:"height_span"`
+	// Memo is optional, and is included in the swap's create, claim, and refund events
+	Memo string `json:"memo,omitempty"  yaml:"memo,omitempty"`
 }
 
 // NewMsgCreateAtomicSwap initializes a new MsgCreateAtomicSwap
@@ -73,9 +77,9 @@ func (msg MsgCreateAtomicSwap) Type() string { return CreateAtomicSwap }
 
 // String prints the MsgCreateAtomicSwap
 func (msg MsgCreateAtomicSwap) String() string {
-	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v}",
+	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v#%v}",
 		msg.From, msg.To, msg.RecipientOtherChain, msg.SenderOtherChain,
-		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan)
+		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan, msg.Memo)
 }
 
 // GetInvolvedAddresses gets the addresses involved in a MsgCreateAtomicSwap
@@ -126,6 +130,9 @@ func (msg MsgCreateAtomicSwap) ValidateBasic() error {
 	if msg.HeightSpan <= 0 {
 		return errors.New("height span must be positive")
 	}
+	if len(msg.Memo) > MaxSwapMemoLength {
+		return fmt.Errorf("the length of the memo should be less than %d", MaxSwapMemoLength)
+	}
 	return nil
 }
 
@@ -249,3 +256,63 @@ func (msg MsgRefundAtomicSwap) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
 	return sdk.MustSortJSON(bz)
 }
+
+// MsgAttestRandomNumber defines a relayer posting a swap's random number after it was revealed on the counterpart chain
+type MsgAttestRandomNumber struct {
+	From         sdk.AccAddress   `json:"from" yaml:"from"`
+	SwapID       tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	RandomNumber tmbytes.HexBytes `json:"random_number" yaml:"random_number"`
+}
+
+// NewMsgAttestRandomNumber initializes a new MsgAttestRandomNumber
+func NewMsgAttestRandomNumber(from sdk.AccAddress, swapID, randomNumber []byte) MsgAttestRandomNumber {
+	return MsgAttestRandomNumber{
+		From:         from,
+		SwapID:       swapID,
+		RandomNumber: randomNumber,
+	}
+}
+
+// Route establishes the route for the MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) Route() string { return RouterKey }
+
+// Type is the name of MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) Type() string { return AttestRandomNumber }
+
+// String prints the MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) String() string {
+	return fmt.Sprintf("attestRandomNumber{%v#%v#%v}", msg.From, msg.SwapID, msg.RandomNumber)
+}
+
+// GetInvolvedAddresses gets the addresses involved in a MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) GetInvolvedAddresses() []sdk.AccAddress {
+	return msg.GetSigners()
+}
+
+// GetSigners gets the signers of a MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic validates the MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.From) != AddrByteCount {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "actual address length ≠ expected length (%d ≠ %d)", len(msg.From), AddrByteCount)
+	}
+	if len(msg.SwapID) != SwapIDLength {
+		return fmt.Errorf("the length of swapID should be %d", SwapIDLength)
+	}
+	if len(msg.RandomNumber) != RandomNumberLength {
+		return fmt.Errorf("the length of random number should be %d", RandomNumberLength)
+	}
+	return nil
+}
+
+// GetSignBytes gets the sign bytes of a MsgAttestRandomNumber
+func (msg MsgAttestRandomNumber) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -40,11 +41,14 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 		timestamp           int64
 		amount              sdk.Coins
 		heightSpan          uint64
+		memo                string
 		expectPass          bool
 	}{
-		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, true},
-		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsSingle, 500, false},
-		{"invalid amount", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsZero, 500, false},
+		{"normal cross-chain", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, "", true},
+		{"without other chain fields", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsSingle, 500, "", false},
+		{"invalid amount", binanceAddrs[0], kavaAddrs[0], "", "", randomNumberHash, timestampInt64, coinsZero, 500, "", false},
+		{"with memo", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, "withdrawal-1234", true},
+		{"memo too long", binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(), randomNumberHash, timestampInt64, coinsSingle, 500, strings.Repeat("a", types.MaxSwapMemoLength+1), false},
 	}
 
 	for i, tc := range tests {
@@ -58,6 +62,7 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 			tc.amount,
 			tc.heightSpan,
 		)
+		msg.Memo = tc.memo
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -117,3 +122,33 @@ func TestMsgRefundAtomicSwap(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgAttestRandomNumber(t *testing.T) {
+	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
+
+	tests := []struct {
+		description  string
+		from         sdk.AccAddress
+		swapID       tmbytes.HexBytes
+		randomNumber tmbytes.HexBytes
+		expectPass   bool
+	}{
+		{"normal", kavaAddrs[0], swapID, randomNumberHash, true},
+		{"empty relayer", sdk.AccAddress{}, swapID, randomNumberHash, false},
+		{"invalid swap id", kavaAddrs[0], swapID[1:], randomNumberHash, false},
+		{"invalid random number", kavaAddrs[0], swapID, randomNumberBytes, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAttestRandomNumber(
+			tc.from,
+			tc.swapID,
+			tc.randomNumber,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -16,33 +16,52 @@ const (
 
 // Parameter keys
 var (
-	KeyAssetParams = []byte("AssetParams")
-
-	DefaultBnbDeputyFixedFee sdk.Int = sdk.NewInt(1000) // 0.00001 BNB
-	DefaultMinAmount         sdk.Int = sdk.ZeroInt()
-	DefaultMaxAmount         sdk.Int = sdk.NewInt(1000000000000) // 10,000 BNB
-	DefaultMinBlockLock      uint64  = 220
-	DefaultMaxBlockLock      uint64  = 270
-	DefaultPreviousBlockTime         = tmtime.Canonical(time.Unix(1, 0))
+	KeyAssetParams             = []byte("AssetParams")
+	KeyLongtermStorageDuration = []byte("LongtermStorageDuration")
+	KeyMaxPrunedSwapsPerBlock  = []byte("MaxPrunedSwapsPerBlock")
+	KeySwapFees                = []byte("SwapFees")
+	KeyAddressLimits           = []byte("AddressLimits")
+
+	DefaultBnbDeputyFixedFee      sdk.Int = sdk.NewInt(1000) // 0.00001 BNB
+	DefaultMinAmount              sdk.Int = sdk.ZeroInt()
+	DefaultMaxAmount              sdk.Int = sdk.NewInt(1000000000000) // 10,000 BNB
+	DefaultMinBlockLock           uint64  = 220
+	DefaultMaxBlockLock           uint64  = 270
+	DefaultPreviousBlockTime              = tmtime.Canonical(time.Unix(1, 0))
+	DefaultMaxPrunedSwapsPerBlock uint64  = 100
+	DefaultSwapFees                       = SwapFees{}
+	DefaultAddressLimits                  = AddressLimits{}
 )
 
 // Params governance parameters for bep3 module
 type Params struct {
-	AssetParams AssetParams `json:"asset_params" yaml:"asset_params"`
+	AssetParams             AssetParams   `json:"asset_params" yaml:"asset_params"`
+	LongtermStorageDuration uint64        `json:"longterm_storage_duration" yaml:"longterm_storage_duration"`   // number of blocks closed swaps are retained for
+	MaxPrunedSwapsPerBlock  uint64        `json:"max_pruned_swaps_per_block" yaml:"max_pruned_swaps_per_block"` // maximum number of closed swaps deleted in a single block
+	SwapFees                SwapFees      `json:"swap_fees" yaml:"swap_fees"`                                   // fees charged on completed incoming swaps
+	AddressLimits           AddressLimits `json:"address_limits" yaml:"address_limits"`                         // limits on the amount of an asset a single address can receive from incoming swaps
 }
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-	AssetParams: %s`,
-		p.AssetParams)
+	AssetParams: %s
+	LongtermStorageDuration: %d
+	MaxPrunedSwapsPerBlock: %d
+	SwapFees: %s
+	AddressLimits: %s`,
+		p.AssetParams, p.LongtermStorageDuration, p.MaxPrunedSwapsPerBlock, p.SwapFees, p.AddressLimits)
 }
 
-// NewParams returns a new params object
+// NewParams returns a new params object with the default swap pruning parameters, no swap fees, and no address limits
 func NewParams(ap AssetParams,
 ) Params {
 	return Params{
-		AssetParams: ap,
+		AssetParams:             ap,
+		LongtermStorageDuration: DefaultLongtermStorageDuration,
+		MaxPrunedSwapsPerBlock:  DefaultMaxPrunedSwapsPerBlock,
+		SwapFees:                DefaultSwapFees,
+		AddressLimits:           DefaultAddressLimits,
 	}
 }
 
@@ -114,6 +133,16 @@ func (aps AssetParams) String() string {
 	return out
 }
 
+// hasDenom returns true if there is an asset param for the input denom
+func (aps AssetParams) hasDenom(denom string) bool {
+	for _, ap := range aps {
+		if ap.Denom == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // SupplyLimit parameters that control the absolute and time-based limits for an assets's supply
 type SupplyLimit struct {
 	Limit          sdk.Int       `json:"limit" yaml:"limit"`                       // the absolute supply limit for an asset
@@ -147,12 +176,81 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyAssetParams, &p.AssetParams, validateAssetParams),
+		params.NewParamSetPair(KeyLongtermStorageDuration, &p.LongtermStorageDuration, validateLongtermStorageDuration),
+		params.NewParamSetPair(KeyMaxPrunedSwapsPerBlock, &p.MaxPrunedSwapsPerBlock, validateMaxPrunedSwapsPerBlock),
+		params.NewParamSetPair(KeySwapFees, &p.SwapFees, validateSwapFees),
+		params.NewParamSetPair(KeyAddressLimits, &p.AddressLimits, validateAddressLimits),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateAssetParams(p.AssetParams)
+	if err := validateLongtermStorageDuration(p.LongtermStorageDuration); err != nil {
+		return err
+	}
+	if err := validateMaxPrunedSwapsPerBlock(p.MaxPrunedSwapsPerBlock); err != nil {
+		return err
+	}
+	if err := validateAssetParams(p.AssetParams); err != nil {
+		return err
+	}
+	if err := validateSwapFees(p.SwapFees); err != nil {
+		return err
+	}
+	for _, sf := range p.SwapFees {
+		if !p.AssetParams.hasDenom(sf.Denom) {
+			return fmt.Errorf("swap fee denom %s does not match any asset", sf.Denom)
+		}
+	}
+	if err := validateAddressLimits(p.AddressLimits); err != nil {
+		return err
+	}
+	for _, al := range p.AddressLimits {
+		if !p.AssetParams.hasDenom(al.Denom) {
+			return fmt.Errorf("address limit denom %s does not match any asset", al.Denom)
+		}
+	}
+	return nil
+}
+
+func validateLongtermStorageDuration(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxPrunedSwapsPerBlock(i interface{}) error {
+	maxPruned, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxPruned == 0 {
+		return fmt.Errorf("max pruned swaps per block must be positive")
+	}
+
+	return nil
+}
+
+func validateSwapFees(i interface{}) error {
+	swapFees, ok := i.(SwapFees)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return swapFees.Validate()
+}
+
+func validateAddressLimits(i interface{}) error {
+	addressLimits, ok := i.(AddressLimits)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return addressLimits.Validate()
 }
 
 func validateAssetParams(i interface{}) error {
@@ -254,6 +254,97 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestPruningParamValidation() {
+	params := types.DefaultParams()
+	suite.Require().NoError(params.Validate())
+	suite.Equal(types.DefaultLongtermStorageDuration, params.LongtermStorageDuration)
+	suite.Equal(types.DefaultMaxPrunedSwapsPerBlock, params.MaxPrunedSwapsPerBlock)
+
+	params.LongtermStorageDuration = 0
+	suite.Require().NoError(params.Validate())
+
+	params.MaxPrunedSwapsPerBlock = 0
+	err := params.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "max pruned swaps per block must be positive")
+}
+
+func (suite *ParamsTestSuite) TestSwapFeeValidation() {
+	params := types.NewParams(types.AssetParams{
+		types.NewAssetParam("bnb", 714, suite.supply[0], true, suite.addr, sdk.NewInt(1000),
+			sdk.NewInt(100000000), sdk.NewInt(100000000000), types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+	})
+	params.SwapFees = types.SwapFees{types.NewSwapFee("bnb", sdk.NewInt(1000), sdk.MustNewDecFromStr("0.001"), sdk.NewInt(2000), sdk.NewInt(100000))}
+	suite.Require().NoError(params.Validate())
+
+	testCases := []struct {
+		name        string
+		swapFees    types.SwapFees
+		expectedErr string
+	}{
+		{"negative flat fee", types.SwapFees{types.NewSwapFee("bnb", sdk.NewInt(-1), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt())}, "negative flat fee"},
+		{"rate above one", types.SwapFees{types.NewSwapFee("bnb", sdk.ZeroInt(), sdk.MustNewDecFromStr("1.01"), sdk.ZeroInt(), sdk.ZeroInt())}, "rate between 0 and 1"},
+		{"min above max", types.SwapFees{types.NewSwapFee("bnb", sdk.ZeroInt(), sdk.ZeroDec(), sdk.NewInt(10), sdk.NewInt(5))}, "minimum fee > maximum fee"},
+		{"duplicate denom", types.SwapFees{
+			types.NewSwapFee("bnb", sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()),
+			types.NewSwapFee("bnb", sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()),
+		}, "duplicate denom"},
+		{"unknown asset", types.SwapFees{types.NewSwapFee("btcb", sdk.ZeroInt(), sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt())}, "does not match any asset"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params.SwapFees = tc.swapFees
+			err := params.Validate()
+			suite.Require().Error(err)
+			suite.Contains(err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestSwapFeeCompute() {
+	swapFee := types.NewSwapFee("bnb", sdk.NewInt(1000), sdk.MustNewDecFromStr("0.001"), sdk.NewInt(2000), sdk.NewInt(100000))
+
+	suite.Equal(sdk.NewInt(2000), swapFee.Compute(sdk.NewInt(500000)))       // 1000 + 500 raised to the minimum
+	suite.Equal(sdk.NewInt(11000), swapFee.Compute(sdk.NewInt(10000000)))    // 1000 + 10000
+	suite.Equal(sdk.NewInt(100000), swapFee.Compute(sdk.NewInt(1000000000))) // 1000 + 1000000 capped at the maximum
+	suite.Equal(sdk.NewInt(1500), swapFee.Compute(sdk.NewInt(1500)))         // never more than the swap amount
+
+	swapFee.MaxFee = sdk.ZeroInt()
+	suite.Equal(sdk.NewInt(1001000), swapFee.Compute(sdk.NewInt(1000000000)))
+}
+
+func (suite *ParamsTestSuite) TestAddressLimitValidation() {
+	params := types.NewParams(types.AssetParams{
+		types.NewAssetParam("bnb", 714, suite.supply[0], true, suite.addr, sdk.NewInt(1000),
+			sdk.NewInt(100000000), sdk.NewInt(100000000000), types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+	})
+	params.AddressLimits = types.AddressLimits{types.NewAddressLimit("bnb", sdk.NewInt(1000000000), sdk.NewInt(100000000), time.Hour)}
+	suite.Require().NoError(params.Validate())
+
+	testCases := []struct {
+		name          string
+		addressLimits types.AddressLimits
+		expectedErr   string
+	}{
+		{"negative max holding", types.AddressLimits{types.NewAddressLimit("bnb", sdk.NewInt(-1), sdk.ZeroInt(), 0)}, "negative max holding"},
+		{"negative max inbound", types.AddressLimits{types.NewAddressLimit("bnb", sdk.ZeroInt(), sdk.NewInt(-1), time.Hour)}, "negative max inbound"},
+		{"inbound limit without period", types.AddressLimits{types.NewAddressLimit("bnb", sdk.ZeroInt(), sdk.NewInt(100), 0)}, "positive inbound period"},
+		{"duplicate denom", types.AddressLimits{
+			types.NewAddressLimit("bnb", sdk.NewInt(100), sdk.ZeroInt(), 0),
+			types.NewAddressLimit("bnb", sdk.NewInt(100), sdk.ZeroInt(), 0),
+		}, "duplicate denom"},
+		{"unknown asset", types.AddressLimits{types.NewAddressLimit("btcb", sdk.NewInt(100), sdk.ZeroInt(), 0)}, "does not match any asset"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params.AddressLimits = tc.addressLimits
+			err := params.Validate()
+			suite.Require().Error(err)
+			suite.Contains(err.Error(), tc.expectedErr)
+		})
+	}
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
@@ -16,6 +16,14 @@ const (
 	QueryGetAtomicSwaps = "swaps"
 	// QueryGetParams command for getting module params
 	QueryGetParams = "parameters"
+	// QueryGetSwapFee command for getting the fee charged on an incoming swap
+	QueryGetSwapFee = "swap-fee"
+	// QueryGetAssetFloat command for getting the amount of an asset minted and burned by swaps
+	QueryGetAssetFloat = "float"
+	// QueryGetAssetFloats command for getting the amount of each asset minted and burned by swaps
+	QueryGetAssetFloats = "floats"
+	// QueryGetClaimableSwaps command for getting open swaps with a random number attested by a relayer
+	QueryGetClaimableSwaps = "claimable-swaps"
 )
 
 // QueryAssetSupply contains the params for query 'custom/bep3/supply'
@@ -30,6 +38,30 @@ func NewQueryAssetSupply(denom string) QueryAssetSupply {
 	}
 }
 
+// QueryAssetFloat contains the params for query 'custom/bep3/float'
+type QueryAssetFloat struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryAssetFloat creates a new QueryAssetFloat
+func NewQueryAssetFloat(denom string) QueryAssetFloat {
+	return QueryAssetFloat{
+		Denom: denom,
+	}
+}
+
+// QuerySwapFee contains the params for query 'custom/bep3/swap-fee'
+type QuerySwapFee struct {
+	Amount sdk.Coin `json:"amount" yaml:"amount"`
+}
+
+// NewQuerySwapFee creates a new QuerySwapFee
+func NewQuerySwapFee(amount sdk.Coin) QuerySwapFee {
+	return QuerySwapFee{
+		Amount: amount,
+	}
+}
+
 // QueryAssetSupplies contains the params for an AssetSupplies query
 type QueryAssetSupplies struct {
 	Page  int `json:"page" yaml:"page"`
@@ -44,6 +76,20 @@ func NewQueryAssetSupplies(page int, limit int) QueryAssetSupplies {
 	}
 }
 
+// QueryClaimableSwaps contains the params for query 'custom/bep3/claimable-swaps'
+type QueryClaimableSwaps struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+}
+
+// NewQueryClaimableSwaps creates a new QueryClaimableSwaps
+func NewQueryClaimableSwaps(page int, limit int) QueryClaimableSwaps {
+	return QueryClaimableSwaps{
+		Page:  page,
+		Limit: limit,
+	}
+}
+
 // QueryAtomicSwapByID contains the params for query 'custom/bep3/swap'
 type QueryAtomicSwapByID struct {
 	SwapID tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
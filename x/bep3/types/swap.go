@@ -27,6 +27,7 @@ type AtomicSwap struct {
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
 	Direction           SwapDirection    `json:"direction"  yaml:"direction"`
+	Memo                string           `json:"memo,omitempty"  yaml:"memo,omitempty"`
 }
 
 // NewAtomicSwap returns a new AtomicSwap
@@ -104,6 +105,9 @@ func (a AtomicSwap) Validate() error {
 	if a.Direction == INVALID || a.Direction > 2 {
 		return errors.New("invalid swap direction")
 	}
+	if len(a.Memo) > MaxSwapMemoLength {
+		return fmt.Errorf("the length of the memo should be less than %d", MaxSwapMemoLength)
+	}
 	return nil
 }
 
@@ -122,12 +126,13 @@ func (a AtomicSwap) String() string {
 		"\n    Recipient other chain:    %s"+
 		"\n    Closed block:             %d"+
 		"\n    Cross chain:              %t"+
-		"\n    Direction:                %s",
+		"\n    Direction:                %s"+
+		"\n    Memo:                     %s",
 		a.GetSwapID(), a.Status.String(), a.Amount.String(),
 		hex.EncodeToString(a.RandomNumberHash), a.ExpireHeight,
 		a.Timestamp, a.Sender.String(), a.Recipient.String(),
 		a.SenderOtherChain, a.RecipientOtherChain, a.ClosedBlock,
-		a.CrossChain, a.Direction)
+		a.CrossChain, a.Direction, a.Memo)
 }
 
 // AtomicSwaps is a slice of AtomicSwap
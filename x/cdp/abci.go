@@ -35,7 +35,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 			panic(err)
 		}
 
-		err = k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.LiquidationRatio)
+		err = k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.GetLiquidationRatio(ctx.BlockHeight()))
 		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
 			panic(err)
 		}
@@ -46,3 +46,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 		panic(err)
 	}
 }
+
+// EndBlocker pays out liquidation refunds owed to the owners of liquidated cdps
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k Keeper) {
+	k.ProcessLiquidationRefunds(ctx)
+}
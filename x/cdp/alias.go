@@ -8,34 +8,67 @@ import (
 )
 
 const (
-	AttributeKeyCdpID               = types.AttributeKeyCdpID
-	AttributeKeyDeposit             = types.AttributeKeyDeposit
-	AttributeKeyError               = types.AttributeKeyError
-	AttributeValueCategory          = types.AttributeValueCategory
-	DefaultParamspace               = types.DefaultParamspace
-	EventTypeBeginBlockerFatal      = types.EventTypeBeginBlockerFatal
-	EventTypeCdpClose               = types.EventTypeCdpClose
-	EventTypeCdpDeposit             = types.EventTypeCdpDeposit
-	EventTypeCdpDraw                = types.EventTypeCdpDraw
-	EventTypeCdpLiquidation         = types.EventTypeCdpLiquidation
-	EventTypeCdpRepay               = types.EventTypeCdpRepay
-	EventTypeCdpWithdrawal          = types.EventTypeCdpWithdrawal
-	EventTypeCreateCdp              = types.EventTypeCreateCdp
-	LiquidatorMacc                  = types.LiquidatorMacc
-	ModuleName                      = types.ModuleName
-	QuerierRoute                    = types.QuerierRoute
-	QueryGetAccounts                = types.QueryGetAccounts
-	QueryGetCdp                     = types.QueryGetCdp
-	QueryGetCdpDeposits             = types.QueryGetCdpDeposits
-	QueryGetCdps                    = types.QueryGetCdps
-	QueryGetCdpsByCollateralType    = types.QueryGetCdpsByCollateralType
-	QueryGetCdpsByCollateralization = types.QueryGetCdpsByCollateralization
-	QueryGetParams                  = types.QueryGetParams
-	RestCollateralType              = types.RestCollateralType
-	RestOwner                       = types.RestOwner
-	RestRatio                       = types.RestRatio
-	RouterKey                       = types.RouterKey
-	StoreKey                        = types.StoreKey
+	AttributeKeyAttempts              = types.AttributeKeyAttempts
+	AttributeKeyAuctionID             = types.AttributeKeyAuctionID
+	AttributeKeyCdpID                 = types.AttributeKeyCdpID
+	AttributeKeyCollateral            = types.AttributeKeyCollateral
+	AttributeKeyCollateralType        = types.AttributeKeyCollateralType
+	AttributeKeyContributor           = types.AttributeKeyContributor
+	AttributeKeyDebt                  = types.AttributeKeyDebt
+	AttributeKeyDeposit               = types.AttributeKeyDeposit
+	AttributeKeyError                 = types.AttributeKeyError
+	AttributeKeyFeesAccrued           = types.AttributeKeyFeesAccrued
+	AttributeKeyInterestFactor        = types.AttributeKeyInterestFactor
+	AttributeKeyOwner                 = types.AttributeKeyOwner
+	AttributeKeyProceeds              = types.AttributeKeyProceeds
+	AttributeKeyRedeemer              = types.AttributeKeyRedeemer
+	AttributeKeyShortfall             = types.AttributeKeyShortfall
+	AttributeKeySwapInput             = types.AttributeKeySwapInput
+	AttributeKeySwapOutput            = types.AttributeKeySwapOutput
+	AttributeKeyTotalPrincipal        = types.AttributeKeyTotalPrincipal
+	AttributeValueCategory            = types.AttributeValueCategory
+	DefaultParamspace                 = types.DefaultParamspace
+	EventTypeBeginBlockerFatal        = types.EventTypeBeginBlockerFatal
+	EventTypeCdpAuctionSettlement     = types.EventTypeCdpAuctionSettlement
+	EventTypeCdpClose                 = types.EventTypeCdpClose
+	EventTypeCdpDeposit               = types.EventTypeCdpDeposit
+	EventTypeCdpDraw                  = types.EventTypeCdpDraw
+	EventTypeCdpInterestAccrual       = types.EventTypeCdpInterestAccrual
+	EventTypeCdpLiquidation           = types.EventTypeCdpLiquidation
+	EventTypeCdpLiquidationRefund     = types.EventTypeCdpLiquidationRefund
+	EventTypeCdpLiquidationSettlement = types.EventTypeCdpLiquidationSettlement
+	EventTypeCdpLiquidationSwap       = types.EventTypeCdpLiquidationSwap
+	EventTypeCdpRedemption            = types.EventTypeCdpRedemption
+	EventTypeCdpRefundSuspended       = types.EventTypeCdpRefundSuspended
+	EventTypeCdpRepay                 = types.EventTypeCdpRepay
+	EventTypeCdpTopUp                 = types.EventTypeCdpTopUp
+	EventTypeCdpWithdrawal            = types.EventTypeCdpWithdrawal
+	EventTypeCreateCdp                = types.EventTypeCreateCdp
+	LiquidatorMacc                    = types.LiquidatorMacc
+	MaxLiquidationRefundAttempts      = types.MaxLiquidationRefundAttempts
+	MaxLiquidationRefundsPerBlock     = types.MaxLiquidationRefundsPerBlock
+	MetricsSubsystem                  = types.MetricsSubsystem
+	ModuleName                        = types.ModuleName
+	QuerierRoute                      = types.QuerierRoute
+	QueryGetAccounts                  = types.QueryGetAccounts
+	QueryGetCdp                       = types.QueryGetCdp
+	QueryGetCdpDeposits               = types.QueryGetCdpDeposits
+	QueryGetCdps                      = types.QueryGetCdps
+	QueryGetCdpsByCollateralType      = types.QueryGetCdpsByCollateralType
+	QueryGetCdpsByCollateralization   = types.QueryGetCdpsByCollateralization
+	QueryGetCdpsByRatioRange          = types.QueryGetCdpsByRatioRange
+	QueryGetLiquidationRefunds        = types.QueryGetLiquidationRefunds
+	QueryGetParams                    = types.QueryGetParams
+	QueryGetSimulatedCdp              = types.QueryGetSimulatedCdp
+	QueryGetTotals                    = types.QueryGetTotals
+	RestCollateralType                = types.RestCollateralType
+	RestDescending                    = types.RestDescending
+	RestMaxRatio                      = types.RestMaxRatio
+	RestMinRatio                      = types.RestMinRatio
+	RestOwner                         = types.RestOwner
+	RestRatio                         = types.RestRatio
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
 )
 
 var (
@@ -45,6 +78,10 @@ var (
 	FindIntersection                   = keeper.FindIntersection
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
+	DepositsInvariant                  = keeper.DepositsInvariant
+	ModuleAccountInvariants            = keeper.ModuleAccountInvariants
+	RegisterInvariants                 = keeper.RegisterInvariants
+	TotalCollateralInvariant           = keeper.TotalCollateralInvariant
 	CdpKey                             = types.CdpKey
 	CollateralRatioBytes               = types.CollateralRatioBytes
 	CollateralRatioIterKey             = types.CollateralRatioIterKey
@@ -56,30 +93,44 @@ var (
 	DepositKey                         = types.DepositKey
 	GetCdpIDBytes                      = types.GetCdpIDBytes
 	GetCdpIDFromBytes                  = types.GetCdpIDFromBytes
+	LiquidationRefundKey               = types.LiquidationRefundKey
 	NewAugmentedCDP                    = types.NewAugmentedCDP
 	NewCDP                             = types.NewCDP
 	NewCDPWithFees                     = types.NewCDPWithFees
 	NewCollateralParam                 = types.NewCollateralParam
+	NewCollateralTypeTotals            = types.NewCollateralTypeTotals
 	NewDebtParam                       = types.NewDebtParam
 	NewDeposit                         = types.NewDeposit
 	NewGenesisAccumulationTime         = types.NewGenesisAccumulationTime
 	NewGenesisState                    = types.NewGenesisState
 	NewGenesisTotalPrincipal           = types.NewGenesisTotalPrincipal
+	NewLiquidationRefund               = types.NewLiquidationRefund
+	NewMsgClaimLiquidationRefund       = types.NewMsgClaimLiquidationRefund
 	NewMsgCreateCDP                    = types.NewMsgCreateCDP
 	NewMsgDeposit                      = types.NewMsgDeposit
 	NewMsgDrawDebt                     = types.NewMsgDrawDebt
 	NewMsgLiquidate                    = types.NewMsgLiquidate
+	NewMsgRedeemDebt                   = types.NewMsgRedeemDebt
 	NewMsgRepayDebt                    = types.NewMsgRepayDebt
+	NewMsgTopUpCollateral              = types.NewMsgTopUpCollateral
 	NewMsgWithdraw                     = types.NewMsgWithdraw
 	NewMultiCDPHooks                   = types.NewMultiCDPHooks
+	NewParamRamp                       = types.NewParamRamp
 	NewParams                          = types.NewParams
 	NewQueryCdpDeposits                = types.NewQueryCdpDeposits
 	NewQueryCdpParams                  = types.NewQueryCdpParams
 	NewQueryCdpsByCollateralTypeParams = types.NewQueryCdpsByCollateralTypeParams
 	NewQueryCdpsByRatioParams          = types.NewQueryCdpsByRatioParams
+	NewQueryCdpsByRatioRangeParams     = types.NewQueryCdpsByRatioRangeParams
 	NewQueryCdpsParams                 = types.NewQueryCdpsParams
+	NewQueryLiquidationRefundsParams   = types.NewQueryLiquidationRefundsParams
+	NewQuerySimulatedCdpParams         = types.NewQuerySimulatedCdpParams
+	NewRedemptionParam                 = types.NewRedemptionParam
+	NewSwapLiquidation                 = types.NewSwapLiquidation
+	NopMetrics                         = types.NopMetrics
 	ParamKeyTable                      = types.ParamKeyTable
 	ParseDecBytes                      = types.ParseDecBytes
+	PrometheusMetrics                  = types.PrometheusMetrics
 	RegisterCodec                      = types.RegisterCodec
 	RelativePow                        = types.RelativePow
 	SortableDecBytes                   = types.SortableDecBytes
@@ -106,6 +157,7 @@ var (
 	DefaultDebtThreshold       = types.DefaultDebtThreshold
 	DefaultGlobalDebt          = types.DefaultGlobalDebt
 	DefaultGovDenom            = types.DefaultGovDenom
+	DefaultRedemption          = types.DefaultRedemption
 	DefaultStableDenom         = types.DefaultStableDenom
 	DefaultSurplusLot          = types.DefaultSurplusLot
 	DefaultSurplusThreshold    = types.DefaultSurplusThreshold
@@ -130,8 +182,10 @@ var (
 	ErrInvalidPayment          = types.ErrInvalidPayment
 	ErrInvalidWithdrawAmount   = types.ErrInvalidWithdrawAmount
 	ErrLoadingAugmentedCDP     = types.ErrLoadingAugmentedCDP
+	ErrNoRedeemableCdps        = types.ErrNoRedeemableCdps
 	ErrNotLiquidatable         = types.ErrNotLiquidatable
 	ErrPricefeedDown           = types.ErrPricefeedDown
+	ErrRedemptionsInactive     = types.ErrRedemptionsInactive
 	GovDenomKey                = types.GovDenomKey
 	InterestFactorPrefix       = types.InterestFactorPrefix
 	KeyCircuitBreaker          = types.KeyCircuitBreaker
@@ -140,16 +194,24 @@ var (
 	KeyDebtParam               = types.KeyDebtParam
 	KeyDebtThreshold           = types.KeyDebtThreshold
 	KeyGlobalDebtLimit         = types.KeyGlobalDebtLimit
+	KeyRedemption              = types.KeyRedemption
 	KeySurplusLot              = types.KeySurplusLot
 	KeySurplusThreshold        = types.KeySurplusThreshold
+	KeySwapLiquidations        = types.KeySwapLiquidations
+	LiquidationRefundPrefix    = types.LiquidationRefundPrefix
 	MaxSortableDec             = types.MaxSortableDec
 	ModuleCdc                  = types.ModuleCdc
 	PreviousAccrualTimePrefix  = types.PreviousAccrualTimePrefix
 	PricefeedStatusKeyPrefix   = types.PricefeedStatusKeyPrefix
 	PrincipalKeyPrefix         = types.PrincipalKeyPrefix
+	TotalCollateralKeyPrefix   = types.TotalCollateralKeyPrefix
+	TotalRefundsKey            = types.TotalRefundsKey
+
+	ErrLiquidationRefundNotFound = types.ErrLiquidationRefundNotFound
 )
 
 type (
+	AuctionHooks                    = keeper.AuctionHooks
 	Keeper                          = keeper.Keeper
 	AccountKeeper                   = types.AccountKeeper
 	AuctionKeeper                   = types.AuctionKeeper
@@ -157,9 +219,12 @@ type (
 	AugmentedCDPs                   = types.AugmentedCDPs
 	CDP                             = types.CDP
 	CDPHooks                        = types.CDPHooks
+	CDPTotals                       = types.CDPTotals
 	CDPs                            = types.CDPs
 	CollateralParam                 = types.CollateralParam
 	CollateralParams                = types.CollateralParams
+	CollateralTypeTotals            = types.CollateralTypeTotals
+	CollateralTypeTotalsList        = types.CollateralTypeTotalsList
 	DebtParam                       = types.DebtParam
 	DebtParams                      = types.DebtParams
 	Deposit                         = types.Deposit
@@ -169,19 +234,34 @@ type (
 	GenesisState                    = types.GenesisState
 	GenesisTotalPrincipal           = types.GenesisTotalPrincipal
 	GenesisTotalPrincipals          = types.GenesisTotalPrincipals
+	LiquidationRefund               = types.LiquidationRefund
+	LiquidationRefunds              = types.LiquidationRefunds
+	Metrics                         = types.Metrics
+	MsgClaimLiquidationRefund       = types.MsgClaimLiquidationRefund
 	MsgCreateCDP                    = types.MsgCreateCDP
 	MsgDeposit                      = types.MsgDeposit
 	MsgDrawDebt                     = types.MsgDrawDebt
 	MsgLiquidate                    = types.MsgLiquidate
+	MsgRedeemDebt                   = types.MsgRedeemDebt
 	MsgRepayDebt                    = types.MsgRepayDebt
+	MsgTopUpCollateral              = types.MsgTopUpCollateral
 	MsgWithdraw                     = types.MsgWithdraw
 	MultiCDPHooks                   = types.MultiCDPHooks
+	ParamRamp                       = types.ParamRamp
 	Params                          = types.Params
 	PricefeedKeeper                 = types.PricefeedKeeper
 	QueryCdpDeposits                = types.QueryCdpDeposits
 	QueryCdpParams                  = types.QueryCdpParams
 	QueryCdpsByCollateralTypeParams = types.QueryCdpsByCollateralTypeParams
 	QueryCdpsByRatioParams          = types.QueryCdpsByRatioParams
+	QueryCdpsByRatioRangeParams     = types.QueryCdpsByRatioRangeParams
 	QueryCdpsParams                 = types.QueryCdpsParams
+	QueryLiquidationRefundsParams   = types.QueryLiquidationRefundsParams
+	QuerySimulatedCdpParams         = types.QuerySimulatedCdpParams
+	RedemptionParam                 = types.RedemptionParam
+	SimulatedCDP                    = types.SimulatedCDP
 	SupplyKeeper                    = types.SupplyKeeper
+	SwapKeeper                      = types.SwapKeeper
+	SwapLiquidation                 = types.SwapLiquidation
+	SwapLiquidations                = types.SwapLiquidations
 )
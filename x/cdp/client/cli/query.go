@@ -20,10 +20,15 @@ import (
 
 // Query CDP flags
 const (
-	flagCollateralType = "collateral-type"
-	flagOwner          = "owner"
-	flagID             = "id"
-	flagRatio          = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagCollateralType   = "collateral-type"
+	flagOwner            = "owner"
+	flagID               = "id"
+	flagRatio            = "ratio" // returns CDPs under the given collateralization ratio threshold
+	flagCollateralChange = "collateral-change"
+	flagPrincipalChange  = "principal-change"
+	flagMinRatio         = "min-ratio"
+	flagMaxRatio         = "max-ratio"
+	flagDescending       = "descending"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -37,7 +42,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cdpQueryCmd.AddCommand(flags.GetCommands(
 		QueryCdpCmd(queryRoute, cdc),
 		QueryGetCdpsCmd(queryRoute, cdc),
+		QueryCdpsByRatioRangeCmd(queryRoute, cdc),
 		QueryCdpDepositsCmd(queryRoute, cdc),
+		QuerySimulatedCdpCmd(queryRoute, cdc),
+		QueryLiquidationRefundsCmd(queryRoute, cdc),
+		QueryTotalsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
 		QueryGetAccounts(queryRoute, cdc),
 	)...)
@@ -187,6 +196,65 @@ $ kvcli q cdp cdps --page=2 --limit=100
 	return cmd
 }
 
+// QueryCdpsByRatioRangeCmd returns the command handler for querying cdps of a collateral type by collateralization ratio
+func QueryCdpsByRatioRangeCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cdps-by-ratio [collateral-type]",
+		Short: "query cdps of a collateral type sorted by collateralization ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for paginated cdps of a collateral type with a collateralization ratio at or above the min ratio and below
+the max ratio, sorted from the lowest ratio to the highest. Omitted bounds leave the range open.
+
+Example:
+$ %s query %s cdps-by-ratio bnb-a --max-ratio=1.75
+$ %s query %s cdps-by-ratio bnb-a --min-ratio=1.5 --max-ratio=2 --page=2 --limit=50
+$ %s query %s cdps-by-ratio bnb-a --descending
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			minRatio, err := sdk.NewDecFromStr(viper.GetString(flagMinRatio))
+			if err != nil {
+				return fmt.Errorf("cannot parse min ratio %s", viper.GetString(flagMinRatio))
+			}
+			maxRatio, err := sdk.NewDecFromStr(viper.GetString(flagMaxRatio))
+			if err != nil {
+				return fmt.Errorf("cannot parse max ratio %s", viper.GetString(flagMaxRatio))
+			}
+			params := types.NewQueryCdpsByRatioRangeParams(
+				viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), args[0], minRatio, maxRatio, viper.GetBool(flagDescending),
+			)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCdpsByRatioRange)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var matchingCDPs types.AugmentedCDPs
+			cdc.MustUnmarshalJSON(res, &matchingCDPs)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(matchingCDPs)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of CDPs to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of CDPs to query for")
+	cmd.Flags().String(flagMinRatio, "0", "(optional) lowest collateralization ratio to include")
+	cmd.Flags().String(flagMaxRatio, "0", "(optional) collateralization ratio to include cdps below, unbounded if zero")
+	cmd.Flags().Bool(flagDescending, false, "(optional) sort from the highest collateralization ratio to the lowest")
+
+	return cmd
+}
+
 // QueryCdpDepositsCmd returns the command handler for querying the deposits of a particular cdp
 func QueryCdpDepositsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -230,6 +298,109 @@ $ %s query %s deposits kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw atom-a
 	}
 }
 
+// QuerySimulatedCdpCmd returns the command handler for simulating a change to a particular cdp
+func QuerySimulatedCdpCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate [collateral-type] [cdp-id]",
+		Short: "simulate a collateral or principal change to a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the collateralization ratio and fees of a CDP after a hypothetical change, and whether the change would succeed.
+A positive collateral change is a deposit and a negative one a withdrawal. A positive principal change draws debt and a negative one repays it.
+
+Example:
+$ %s query %s simulate atom-a 21 --principal-change=100000000
+$ %s query %s simulate atom-a 21 --collateral-change=-5000000 --principal-change=-100000000
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("cannot parse cdp ID %s", args[1])
+			}
+			collateralChange, ok := sdk.NewIntFromString(viper.GetString(flagCollateralChange))
+			if !ok {
+				return fmt.Errorf("cannot parse collateral change %s", viper.GetString(flagCollateralChange))
+			}
+			principalChange, ok := sdk.NewIntFromString(viper.GetString(flagPrincipalChange))
+			if !ok {
+				return fmt.Errorf("cannot parse principal change %s", viper.GetString(flagPrincipalChange))
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySimulatedCdpParams(args[0], id, collateralChange, principalChange))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSimulatedCdp)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var simulatedCDP types.SimulatedCDP
+			cdc.MustUnmarshalJSON(res, &simulatedCDP)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(simulatedCDP)
+		},
+	}
+
+	cmd.Flags().String(flagCollateralChange, "0", "(optional) collateral to deposit, or withdraw if negative")
+	cmd.Flags().String(flagPrincipalChange, "0", "(optional) principal to draw, or repay if negative")
+
+	return cmd
+}
+
+// QueryLiquidationRefundsCmd returns the command handler for querying liquidation refunds that have not been paid out
+func QueryLiquidationRefundsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidation-refunds [owner-addr]",
+		Short: "get liquidation refunds that have not been paid out",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get liquidation surplus owed to cdp owners that is still held by the liquidator module account.
+Refunds are normally paid out at the end of the block they are created in, so any refund listed here has failed to pay out.
+
+Example:
+$ %s query %s liquidation-refunds
+$ %s query %s liquidation-refunds kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			var owner sdk.AccAddress
+			if len(args) > 0 {
+				var err error
+				owner, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryLiquidationRefundsParams(owner))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetLiquidationRefunds)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var refunds types.LiquidationRefunds
+			cdc.MustUnmarshalJSON(res, &refunds)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(refunds)
+		},
+	}
+}
+
 // QueryParamsCmd returns the command handler for cdp parameter querying
 func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -255,6 +426,32 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// QueryTotalsCmd returns the command handler for querying the total collateral and principal of all cdps
+func QueryTotalsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "totals",
+		Short: "get the total collateral and principal of all cdps",
+		Long:  "get the total collateral and principal of all cdps by collateral type, with their collateralization ratios at spot prices.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetTotals)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var out types.CDPTotals
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 // QueryGetAccounts queries CDP module accounts
 func QueryGetAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -28,6 +28,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	cdpTxCmd.AddCommand(flags.PostCommands(
 		GetCmdCreateCdp(cdc),
 		GetCmdDeposit(cdc),
+		GetCmdTopUp(cdc),
+		GetCmdRedeemDebt(cdc),
+		GetCmdClaimLiquidationRefund(cdc),
 		GetCmdWithdraw(cdc),
 		GetCmdDraw(cdc),
 		GetCmdRepay(cdc),
@@ -107,6 +110,102 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom ato
 	}
 }
 
+// GetCmdTopUp cli command for adding collateral to another account's cdp.
+func GetCmdTopUp(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "top-up [owner-addr] [collateral] [collateral-type]",
+		Short: "add collateral to another account's cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add collateral to the cdp of another account, for example to protect it from liquidation.
+The collateral is credited to the owner of the cdp and cannot be withdrawn by the sender.
+
+Example:
+$ %s tx %s top-up kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom atom-a --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			collateral, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTopUpCollateral(cliCtx.GetFromAddress(), owner, collateral, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeemDebt cli command for redeeming debt for collateral.
+func GetCmdRedeemDebt(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem [amount] [collateral-type]",
+		Short: "redeem debt for collateral",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Repay the debt of the most collateralized cdps of a collateral type in exchange for their collateral
+at the spot price less the redemption fee. Redemptions are only possible while they are activated by governance.
+
+Example:
+$ %s tx %s redeem 1000000000usdx bnb-a --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemDebt(cliCtx.GetFromAddress(), amount, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdClaimLiquidationRefund cli command for claiming a liquidation refund.
+func GetCmdClaimLiquidationRefund(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-refund",
+		Short: "claim a liquidation refund",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim the liquidation refund owed to the sender. Refunds are paid out at the end of the block they are
+created in, but a refund that could not be paid out after repeated attempts is kept until it is claimed.
+
+Example:
+$ %s tx %s claim-refund --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgClaimLiquidationRefund(cliCtx.GetFromAddress())
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -11,10 +11,12 @@ import (
 // REST Variable names
 // nolint
 const (
-	RestOwner          = "owner"
-	RestCollateralType = "collateral-type"
-	RestID             = "id"
-	RestRatio          = "ratio"
+	RestOwner            = "owner"
+	RestCollateralType   = "collateral-type"
+	RestID               = "id"
+	RestRatio            = "ratio"
+	RestCollateralChange = "collateral_change"
+	RestPrincipalChange  = "principal_change"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
@@ -41,6 +43,29 @@ type PostDepositReq struct {
 	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
 }
 
+// PostTopUpReq defines the properties of cdp request's body.
+type PostTopUpReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Contributor    sdk.AccAddress `json:"contributor" yaml:"contributor"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Collateral     sdk.Coin       `json:"collateral" yaml:"collateral"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// PostRedeemDebtReq defines the properties of a debt redemption request's body.
+type PostRedeemDebtReq struct {
+	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Redeemer       sdk.AccAddress `json:"redeemer" yaml:"redeemer"`
+	Amount         sdk.Coin       `json:"amount" yaml:"amount"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// PostClaimLiquidationRefundReq defines the properties of a liquidation refund claim request's body.
+type PostClaimLiquidationRefundReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
 // PostWithdrawalReq defines the properties of cdp request's body.
 type PostWithdrawalReq struct {
 	BaseReq        rest.BaseReq   `json:"base_req" yaml:"base_req"`
@@ -85,6 +85,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk types.PricefeedKeeper, sk types.S
 		k.SetDeposit(ctx, d)
 	}
 
+	for _, refund := range gs.LiquidationRefunds {
+		k.SetLiquidationRefund(ctx, refund)
+	}
+
 }
 
 // ExportGenesis export genesis state for cdp module
@@ -123,5 +127,7 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		totalPrincipals = append(totalPrincipals, genTotalPrincipal)
 	}
 
-	return NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	gs := NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousAccumTimes, totalPrincipals)
+	gs.LiquidationRefunds = k.GetAllLiquidationRefunds(ctx)
+	return gs
 }
@@ -8,6 +8,9 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
@@ -140,9 +143,20 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	cdp.ModuleCdc.UnmarshalJSON(cdpGS["cdp"], &gs)
 	gs.CDPs = cdps()
 	gs.StartingCdpID = uint64(5)
+	// the module account must hold the collateral of the imported cdps
+	cdpMacc := supply.NewEmptyModuleAccount(cdp.ModuleName, supply.Minter, supply.Burner)
+	for _, c := range gs.CDPs {
+		gs.Deposits = append(gs.Deposits, cdp.NewDeposit(c.ID, c.Owner, c.Collateral))
+		cdpMacc.Coins = cdpMacc.Coins.Add(c.Collateral)
+	}
 	appGS := app.GenesisState{"cdp": cdp.ModuleCdc.MustMarshalJSON(gs)}
+	authGS := app.GenesisState{auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(
+		auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{cdpMacc}),
+	)}
+	tApp = app.NewTestApp()
 	suite.NotPanics(func() {
 		tApp.InitializeFromGenesisStates(
+			authGS,
 			NewPricefeedGenStateMulti(),
 			appGS,
 		)
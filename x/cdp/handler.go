@@ -22,6 +22,12 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRepayDebt(ctx, k, msg)
 		case MsgLiquidate:
 			return handleMsgLiquidate(ctx, k, msg)
+		case MsgTopUpCollateral:
+			return handleMsgTopUpCollateral(ctx, k, msg)
+		case MsgRedeemDebt:
+			return handleMsgRedeemDebt(ctx, k, msg)
+		case MsgClaimLiquidationRefund:
+			return handleMsgClaimLiquidationRefund(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -128,3 +134,51 @@ func handleMsgLiquidate(ctx sdk.Context, k Keeper, msg MsgLiquidate) (*sdk.Resul
 	)
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgTopUpCollateral(ctx sdk.Context, k Keeper, msg MsgTopUpCollateral) (*sdk.Result, error) {
+	err := k.TopUpCollateral(ctx, msg.Owner, msg.Contributor, msg.Collateral, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Contributor.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRedeemDebt(ctx sdk.Context, k Keeper, msg MsgRedeemDebt) (*sdk.Result, error) {
+	err := k.RedeemDebt(ctx, msg.Redeemer, msg.Amount, msg.CollateralType)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Redeemer.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimLiquidationRefund(ctx sdk.Context, k Keeper, msg MsgClaimLiquidationRefund) (*sdk.Result, error) {
+	err := k.ClaimLiquidationRefund(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// AuctionHooks wrapper struct for the hooks the cdp module receives from the auction module
+type AuctionHooks struct {
+	k Keeper
+}
+
+var _ auctiontypes.AuctionHooks = AuctionHooks{}
+
+// AuctionHooks returns the cdp module's auction hooks
+func (k Keeper) AuctionHooks() AuctionHooks { return AuctionHooks{k} }
+
+// AfterAuctionClosed records the result of a collateral auction started by the liquidator as soon as it closes, so a
+// shortfall is visible as bad debt in the same block rather than when the debt is next checked in the BeginBlocker
+func (h AuctionHooks) AfterAuctionClosed(ctx sdk.Context, settlement auctiontypes.AuctionSettlement) {
+	if settlement.Initiator != types.LiquidatorMacc || settlement.AuctionType != auctiontypes.CollateralAuctionType {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpAuctionSettlement,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", settlement.AuctionID)),
+			sdk.NewAttribute(types.AttributeKeyCollateral, settlement.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyProceeds, settlement.Proceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyShortfall, settlement.Shortfall.String()),
+		),
+	)
+	if settlement.Shortfall.IsPositive() {
+		h.k.Logger(ctx).Info("collateral auction settled with a shortfall", "auction_id", settlement.AuctionID, "proceeds", settlement.Proceeds, "shortfall", settlement.Shortfall)
+	}
+}
@@ -11,14 +11,24 @@ const (
 	dump = 100
 )
 
-// AuctionCollateral creates auctions from the input deposits which attempt to raise the corresponding amount of debt
+// AuctionCollateral creates auctions from the input deposits which attempt to raise the corresponding amount of debt.
+// Deposits eligible for swap liquidation are sold directly through the swap module instead.
+// If the total collateral is below the auction threshold of the collateral type the deposits are settled without an auction.
 func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, collateralType string, debt sdk.Int, bidDenom string) error {
 
 	auctionSize := k.getAuctionSize(ctx, collateralType)
 	totalCollateral := deposits.SumCollateral()
+	belowThreshold := totalCollateral.LT(k.getAuctionThreshold(ctx, collateralType))
 	for _, deposit := range deposits {
 
 		debtCoveredByDeposit := (sdk.NewDecFromInt(deposit.Amount.Amount).Quo(sdk.NewDecFromInt(totalCollateral))).Mul(sdk.NewDecFromInt(debt)).RoundInt()
+		if k.SwapLiquidatedDeposit(ctx, deposit.Amount, collateralType, deposit.Depositor, debtCoveredByDeposit, bidDenom) {
+			continue
+		}
+		if belowThreshold {
+			k.settleLiquidatedDeposit(ctx, deposit.Amount, debtCoveredByDeposit)
+			continue
+		}
 		err := k.CreateAuctionsFromDeposit(ctx, deposit.Amount, collateralType, deposit.Depositor, debtCoveredByDeposit, auctionSize, bidDenom)
 		if err != nil {
 			return err
@@ -27,6 +37,20 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, coll
 	return nil
 }
 
+// settleLiquidatedDeposit settles a liquidated deposit that is too small to be worth auctioning.
+// The collateral is kept by the liquidator module account as protocol reserves, and the debt it covered stays
+// in the liquidator's debt pool, where it is netted against surplus or covered by debt auctions.
+func (k Keeper) settleLiquidatedDeposit(ctx sdk.Context, collateral sdk.Coin, debt sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpLiquidationSettlement,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCollateral, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyDebt, sdk.NewCoin(k.GetDebtDenom(ctx), debt).String()),
+		),
+	)
+}
+
 // CreateAuctionsFromDeposit creates auctions from the input deposit
 func (k Keeper) CreateAuctionsFromDeposit(
 	ctx sdk.Context, collateral sdk.Coin, collateralType string, returnAddr sdk.AccAddress, debt, auctionSize sdk.Int,
@@ -124,16 +148,21 @@ func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) error {
 
 	// burn stable coins equal to min(balance, netAmount)
 	dp := k.GetParams(ctx).DebtParam
-	balance := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
+	balance := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
 	burnAmount := sdk.MinInt(balance, netAmount)
 	return k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, burnAmount)))
 }
 
-// GetTotalSurplus returns the total amount of surplus tokens held by the liquidator module account
+// GetTotalSurplus returns the total amount of surplus tokens held by the liquidator module account.
+// Liquidation refunds waiting to be paid out are owed to cdp owners and are not counted as surplus.
 func (k Keeper) GetTotalSurplus(ctx sdk.Context, accountName string) sdk.Int {
 	acc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
 	dp := k.GetParams(ctx).DebtParam
-	return acc.GetCoins().AmountOf(dp.Denom)
+	surplus := acc.GetCoins().AmountOf(dp.Denom)
+	if accountName == types.LiquidatorMacc {
+		surplus = surplus.Sub(k.GetTotalLiquidationRefunds(ctx).AmountOf(dp.Denom))
+	}
+	return sdk.MaxInt(surplus, sdk.ZeroInt())
 }
 
 // GetTotalDebt returns the total amount of debt tokens held by the liquidator module account
@@ -161,7 +190,7 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) error {
 		}
 	}
 
-	surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(params.DebtParam.Denom)
+	surplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
 	if !surplus.GTE(params.SurplusAuctionThreshold) {
 		return nil
 	}
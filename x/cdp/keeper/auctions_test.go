@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -152,6 +154,97 @@ func (suite *AuctionTestSuite) TestGetTotalDebt() {
 	suite.Require().Equal(sdk.NewInt(250e6), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
 }
 
+func (suite *AuctionTestSuite) TestLiquidationRefunds() {
+	sk := suite.app.GetSupplyKeeper()
+	ak := suite.app.GetAccountKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 300e6)))
+	suite.Require().NoError(err)
+
+	// pending refunds are not counted as surplus
+	suite.keeper.AddLiquidationRefund(suite.ctx, suite.addrs[0], cs(c("usdx", 50e6)))
+	suite.keeper.AddLiquidationRefund(suite.ctx, suite.addrs[0], cs(c("usdx", 50e6)))
+	suite.Require().Equal(cs(c("usdx", 100e6)), suite.keeper.GetTotalLiquidationRefunds(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(200e6), suite.keeper.GetTotalSurplus(suite.ctx, types.LiquidatorMacc))
+
+	// netting does not burn refunds
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 250e6)))
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.NetSurplusAndDebt(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Require().Equal(cs(c("debt", 50e6), c("usdx", 100e6)), acc.GetCoins())
+
+	// refunds are paid out to their owner
+	balanceBefore := ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+	suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	balanceAfter := ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+	suite.Require().Equal(i(100e6), balanceAfter.AmountOf("usdx").Sub(balanceBefore.AmountOf("usdx")))
+	_, found := suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Require().Equal(cs(c("debt", 50e6)), acc.GetCoins())
+
+	// refunds that cannot be paid out are kept so they can be recovered
+	suite.keeper.AddLiquidationRefund(suite.ctx, suite.addrs[0], cs(c("usdx", 10e6)))
+	suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	refund, found := suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("usdx", 10e6)), refund.Amount)
+	suite.Require().Equal(uint64(1), refund.Attempts)
+	suite.Require().Equal(cs(c("usdx", 10e6)), suite.keeper.GetTotalLiquidationRefunds(suite.ctx))
+
+	// refunds are no longer paid out after too many failed payouts
+	for i := 1; i < types.MaxLiquidationRefundAttempts; i++ {
+		suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	}
+	refund, found = suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(types.MaxLiquidationRefundAttempts), refund.Attempts)
+	suite.Require().Equal(cs(c("usdx", 10e6)), suite.keeper.GetTotalLiquidationRefunds(suite.ctx))
+	suspended := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		suspended = suspended || event.Type == types.EventTypeCdpRefundSuspended
+	}
+	suite.Require().True(suspended)
+
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10e6)))
+	suite.Require().NoError(err)
+	suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	refund, found = suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(types.MaxLiquidationRefundAttempts), refund.Attempts)
+
+	// the owner can claim a refund that is no longer paid out
+	balanceBefore = ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+	suite.Require().NoError(suite.keeper.ClaimLiquidationRefund(suite.ctx, suite.addrs[0]))
+	balanceAfter = ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+	suite.Require().Equal(i(10e6), balanceAfter.AmountOf("usdx").Sub(balanceBefore.AmountOf("usdx")))
+	_, found = suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().False(found)
+	suite.Require().True(suite.keeper.GetTotalLiquidationRefunds(suite.ctx).IsZero())
+
+	err = suite.keeper.ClaimLiquidationRefund(suite.ctx, suite.addrs[0])
+	suite.Require().True(errors.Is(err, types.ErrLiquidationRefundNotFound))
+}
+
+func (suite *AuctionTestSuite) TestLiquidationRefundsPerBlock() {
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", (types.MaxLiquidationRefundsPerBlock+1)*1e6)))
+	suite.Require().NoError(err)
+	for i := 0; i <= types.MaxLiquidationRefundsPerBlock; i++ {
+		owner := sdk.AccAddress(crypto.AddressHash([]byte(fmt.Sprintf("owner%d", i))))
+		suite.keeper.AddLiquidationRefund(suite.ctx, owner, cs(c("usdx", 1e6)))
+	}
+
+	// only a limited number of refunds are paid out in a block
+	suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	suite.Require().Len(suite.keeper.GetAllLiquidationRefunds(suite.ctx), 1)
+	suite.Require().Equal(cs(c("usdx", 1e6)), suite.keeper.GetTotalLiquidationRefunds(suite.ctx))
+
+	suite.keeper.ProcessLiquidationRefunds(suite.ctx)
+	suite.Require().Empty(suite.keeper.GetAllLiquidationRefunds(suite.ctx))
+	suite.Require().True(suite.keeper.GetTotalLiquidationRefunds(suite.ctx).IsZero())
+}
+
 func TestAuctionTestSuite(t *testing.T) {
 	suite.Run(t, new(AuctionTestSuite))
 }
@@ -241,8 +241,13 @@ func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
+	previousCollateral := sdk.ZeroInt()
+	if storedCDP, found := k.getStoredCDP(store, db, cdp.ID); found {
+		previousCollateral = storedCDP.Collateral.Amount
+	}
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cdp)
 	store.Set(types.CdpKey(db, cdp.ID), bz)
+	k.updateTotalCollateral(ctx, cdp.Type, cdp.Collateral.Amount.Sub(previousCollateral))
 	return nil
 }
 
@@ -253,9 +258,23 @@ func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDenomPrefixNotFound, "%s", cdp.Collateral.Denom)
 	}
+	if storedCDP, found := k.getStoredCDP(store, db, cdp.ID); found {
+		k.updateTotalCollateral(ctx, cdp.Type, storedCDP.Collateral.Amount.Neg())
+	}
 	store.Delete(types.CdpKey(db, cdp.ID))
 	return nil
+}
 
+// getStoredCDP returns the cdp currently in the store, which is read when a cdp is set or deleted to keep the total
+// collateral of its collateral type up to date
+func (k Keeper) getStoredCDP(store prefix.Store, denomByte byte, cdpID uint64) (types.CDP, bool) {
+	bz := store.Get(types.CdpKey(denomByte, cdpID))
+	if bz == nil {
+		return types.CDP{}, false
+	}
+	var cdp types.CDP
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &cdp)
+	return cdp, true
 }
 
 // GetAllCdps returns all cdps from the store
@@ -285,6 +304,26 @@ func (k Keeper) GetAllCdpsByCollateralTypeAndRatio(ctx sdk.Context, collateralTy
 	return
 }
 
+// GetPaginatedCdpsByCollateralTypeAndRatioRange returns a page of the cdps with matching collateral type and
+// collateral:debt ratio in the range [minRatio, maxRatio), sorted by ratio. Only the cdps up to the end of the page are
+// read from the store.
+func (k Keeper) GetPaginatedCdpsByCollateralTypeAndRatioRange(ctx sdk.Context, collateralType string, minRatio, maxRatio sdk.Dec, descending bool, page, limit int) types.CDPs {
+	cdps := types.CDPs{}
+	if page < 1 || limit < 1 {
+		return cdps
+	}
+	skip := (page - 1) * limit
+	k.IterateCdpsByCollateralRatioRange(ctx, collateralType, minRatio, maxRatio, descending, func(cdp types.CDP) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		cdps = append(cdps, cdp)
+		return len(cdps) >= limit
+	})
+	return cdps
+}
+
 // SetNextCdpID sets the highest cdp id in the store
 func (k Keeper) SetNextCdpID(ctx sdk.Context, id uint64) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpIDKey)
@@ -511,6 +550,79 @@ func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) types.Augmented
 	return augmentedCDP
 }
 
+// SimulateCDPChange returns the outcome of a hypothetical change to the collateral and principal of a cdp without
+// modifying state. Changes are made by the cdp owner, with deposits and repayments applied before draws and withdrawals.
+// The collateralization ratio is calculated using the spot price, as when validating the change.
+func (k Keeper) SimulateCDPChange(ctx sdk.Context, collateralType string, id uint64, collateralChange, principalChange sdk.Int) (types.SimulatedCDP, error) {
+	cdp, found := k.GetCDP(ctx, collateralType, id)
+	if !found {
+		return types.SimulatedCDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "id %d, collateral type %s", id, collateralType)
+	}
+	collateral := cdp.Collateral.Amount.Add(collateralChange)
+	if collateral.IsNegative() {
+		return types.SimulatedCDP{}, sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "collateral %s, withdrawal %s", cdp.Collateral, collateralChange.Neg())
+	}
+
+	fees := cdp.AccumulatedFees.Add(k.CalculateNewInterest(ctx, cdp))
+	principal := cdp.Principal
+	remainingFees := fees
+	if principalChange.IsPositive() {
+		principal = principal.Add(sdk.NewCoin(principal.Denom, principalChange))
+	} else if principalChange.IsNegative() {
+		feePayment, principalPayment := k.calculatePayment(ctx, principal.Add(fees), fees, sdk.NewCoin(principal.Denom, principalChange.Neg()))
+		principal = principal.Sub(principalPayment)
+		remainingFees = fees.Sub(feePayment)
+	}
+
+	simulated := types.SimulatedCDP{
+		ID:                     cdp.ID,
+		Type:                   cdp.Type,
+		Collateral:             sdk.NewCoin(cdp.Collateral.Denom, collateral),
+		Principal:              principal,
+		AccumulatedFees:        fees,
+		CollateralizationRatio: sdk.ZeroDec(),
+	}
+
+	// run the change against a cached copy of the store so that nothing is written
+	cacheCtx, _ := ctx.CacheContext()
+	err := k.applyCDPChange(cacheCtx, cdp, collateralChange, principalChange)
+	if err == nil && collateral.IsPositive() && principal.Add(remainingFees).IsPositive() {
+		simulated.CollateralizationRatio, err = k.CalculateCollateralizationRatio(ctx, simulated.Collateral, cdp.Type, principal, remainingFees, spot)
+	}
+	if err != nil {
+		simulated.Error = err.Error()
+		return simulated, nil
+	}
+	simulated.Success = true
+	return simulated, nil
+}
+
+// applyCDPChange deposits, repays, draws, and withdraws from a cdp on behalf of its owner, in that order
+func (k Keeper) applyCDPChange(ctx sdk.Context, cdp types.CDP, collateralChange, principalChange sdk.Int) error {
+	if collateralChange.IsPositive() {
+		err := k.DepositCollateral(ctx, cdp.Owner, cdp.Owner, sdk.NewCoin(cdp.Collateral.Denom, collateralChange), cdp.Type)
+		if err != nil {
+			return err
+		}
+	}
+	if principalChange.IsNegative() {
+		err := k.RepayPrincipal(ctx, cdp.Owner, cdp.Type, sdk.NewCoin(cdp.Principal.Denom, principalChange.Neg()))
+		if err != nil {
+			return err
+		}
+	}
+	if principalChange.IsPositive() {
+		err := k.AddPrincipal(ctx, cdp.Owner, cdp.Type, sdk.NewCoin(cdp.Principal.Denom, principalChange))
+		if err != nil {
+			return err
+		}
+	}
+	if collateralChange.IsNegative() {
+		return k.WithdrawCollateral(ctx, cdp.Owner, cdp.Owner, sdk.NewCoin(cdp.Collateral.Denom, collateralChange.Neg()), cdp.Type)
+	}
+	return nil
+}
+
 // CalculateCollateralizationRatio returns the collateralization ratio of the input collateral to the input debt plus fees
 func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coin, collateralType string, principal sdk.Coin, fees sdk.Coin, pfType pricefeedType) (sdk.Dec, error) {
 	if collateral.IsZero() {
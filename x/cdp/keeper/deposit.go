@@ -12,47 +12,75 @@ import (
 
 // DepositCollateral adds collateral to a cdp
 func (k Keeper) DepositCollateral(ctx sdk.Context, owner, depositor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	cdp, err := k.addCollateral(ctx, owner, depositor, depositor, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	return nil
+}
+
+// TopUpCollateral adds collateral from any account to a cdp. Unlike DepositCollateral, the collateral is credited
+// to the owner's deposit, so the contributor has no claim on it and cannot withdraw it.
+func (k Keeper) TopUpCollateral(ctx sdk.Context, owner, contributor sdk.AccAddress, collateral sdk.Coin, collateralType string) error {
+	cdp, err := k.addCollateral(ctx, owner, contributor, owner, collateral, collateralType)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpTopUp,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyContributor, contributor.String()),
+		),
+	)
+	return nil
+}
+
+// addCollateral moves collateral from the sender to the cdp of the owner, crediting it to the deposit of the beneficiary
+func (k Keeper) addCollateral(ctx sdk.Context, owner, sender, beneficiary sdk.AccAddress, collateral sdk.Coin, collateralType string) (types.CDP, error) {
 	// check that collateral exists and has a functioning pricefeed
 	err := k.ValidateCollateral(ctx, collateral, collateralType)
 	if err != nil {
-		return err
+		return types.CDP{}, err
 	}
 	cdp, found := k.GetCdpByOwnerAndCollateralType(ctx, owner, collateralType)
 	if !found {
-		return sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
+		return types.CDP{}, sdkerrors.Wrapf(types.ErrCdpNotFound, "owner %s, collateral %s", owner, collateralType)
 	}
-	err = k.ValidateBalance(ctx, collateral, depositor)
+	err = k.ValidateBalance(ctx, collateral, sender)
 	if err != nil {
-		return err
+		return types.CDP{}, err
 	}
 	k.hooks.BeforeCDPModified(ctx, cdp)
 	cdp = k.SynchronizeInterest(ctx, cdp)
 
-	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
+	deposit, found := k.GetDeposit(ctx, cdp.ID, beneficiary)
 	if found {
 		deposit.Amount = deposit.Amount.Add(collateral)
 	} else {
-		deposit = types.NewDeposit(cdp.ID, depositor, collateral)
+		deposit = types.NewDeposit(cdp.ID, beneficiary, collateral)
 	}
-	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, sdk.NewCoins(collateral))
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(collateral))
 	if err != nil {
-		return err
+		return types.CDP{}, err
 	}
 
 	k.SetDeposit(ctx, deposit)
 
 	cdp.Collateral = cdp.Collateral.Add(collateral)
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCdpDeposit,
-			sdk.NewAttribute(sdk.AttributeKeyAmount, collateral.String()),
-			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
-		),
-	)
-
-	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+	return cdp, k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 }
 
 // WithdrawCollateral removes collateral from a cdp if it does not put the cdp below the liquidation ratio
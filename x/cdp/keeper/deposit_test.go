@@ -96,6 +96,29 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 	suite.True(ds[1].Equals(td))
 }
 
+func (suite *DepositTestSuite) TestTopUpCollateral() {
+	err := suite.keeper.TopUpCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	suite.NoError(err)
+
+	// the collateral is credited to the owner, the contributor has no deposit to withdraw
+	d, found := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
+	suite.True(found)
+	suite.True(d.Equals(types.NewDeposit(uint64(1), suite.addrs[0], c("xrp", 410000000))))
+	_, found = suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[1])
+	suite.False(found)
+	suite.Equal(1, len(suite.keeper.GetDeposits(suite.ctx, uint64(1))))
+	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp-a", uint64(1))
+	suite.Equal(c("xrp", 410000000), cd.Collateral)
+	acc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[1])
+	suite.Equal(i(190000000), acc.GetCoins().AmountOf("xrp"))
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+
+	err = suite.keeper.TopUpCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], c("xrp", 1), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrCdpNotFound))
+}
+
 func (suite *DepositTestSuite) TestWithdrawCollateral() {
 	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 400000000), "xrp-a")
 	suite.Require().True(errors.Is(err, types.ErrInvalidCollateralRatio))
@@ -82,6 +82,17 @@ func (k Keeper) AccumulateInterest(ctx sdk.Context, ctype string) error {
 	k.SetInterestFactor(ctx, ctype, interestFactorNew)
 	k.SetPreviousAccrualTime(ctx, ctype, ctx.BlockTime())
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpInterestAccrual,
+			sdk.NewAttribute(types.AttributeKeyCollateralType, ctype),
+			sdk.NewAttribute(types.AttributeKeyFeesAccrued, sdk.NewCoin(types.DefaultStableDenom, interestAccumulated).String()),
+			sdk.NewAttribute(types.AttributeKeyInterestFactor, interestFactorNew.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalPrincipal, sdk.NewCoin(types.DefaultStableDenom, totalPrincipalNew).String()),
+		),
+	)
+
+	k.Logger(ctx).Debug("accumulated interest", "collateral_type", ctype, "interest", interestAccumulated, "interest_factor", interestFactorNew)
 	return nil
 }
 
@@ -278,7 +278,7 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 			suite.keeper.SetInterestFactor(suite.ctx, tc.args.ctype, sdk.OneDec())
 
 			updatedBlockTime := suite.ctx.BlockTime().Add(time.Duration(int(time.Second) * tc.args.timeElapsed))
-			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
+			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime).WithEventManager(sdk.NewEventManager())
 			err := suite.keeper.AccumulateInterest(suite.ctx, tc.args.ctype)
 			suite.Require().NoError(err)
 
@@ -286,6 +286,22 @@ func (suite *InterestTestSuite) TestAccumulateInterest() {
 			suite.Require().Equal(tc.args.expectedTotalPrincipal, actualTotalPrincipal)
 			actualAccrualTime, _ := suite.keeper.GetPreviousAccrualTime(suite.ctx, tc.args.ctype)
 			suite.Require().Equal(tc.args.expectedLastAccrualTime, actualAccrualTime)
+
+			// an accrual event is emitted only when interest accrues
+			feesAccrued := tc.args.expectedTotalPrincipal.Sub(tc.args.totalPrincipal)
+			events := suite.ctx.EventManager().Events()
+			if feesAccrued.IsZero() {
+				suite.Require().Empty(events)
+				return
+			}
+			interestFactor, _ := suite.keeper.GetInterestFactor(suite.ctx, tc.args.ctype)
+			suite.Require().Equal(sdk.Events{sdk.NewEvent(
+				types.EventTypeCdpInterestAccrual,
+				sdk.NewAttribute(types.AttributeKeyCollateralType, tc.args.ctype),
+				sdk.NewAttribute(types.AttributeKeyFeesAccrued, sdk.NewCoin(types.DefaultStableDenom, feesAccrued).String()),
+				sdk.NewAttribute(types.AttributeKeyInterestFactor, interestFactor.String()),
+				sdk.NewAttribute(types.AttributeKeyTotalPrincipal, sdk.NewCoin(types.DefaultStableDenom, tc.args.expectedTotalPrincipal).String()),
+			)}, events)
 		})
 	}
 }
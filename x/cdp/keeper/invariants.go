@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers all cdp invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariants(k))
+	ir.RegisterRoute(types.ModuleName, "deposits",
+		DepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-collateral",
+		TotalCollateralInvariant(k))
+}
+
+// ModuleAccountInvariants checks that the module account's collateral coins match the collateral stored in cdps
+func ModuleAccountInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totalCollateral := sdk.NewCoins()
+		k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+			totalCollateral = totalCollateral.Add(cdp.Collateral)
+			return false
+		})
+
+		denoms := make(map[string]bool)
+		for _, coin := range totalCollateral {
+			denoms[coin.Denom] = true
+		}
+		for _, cp := range k.GetParams(ctx).CollateralParams {
+			denoms[cp.Denom] = true
+		}
+
+		// the module account also holds debt coins, which are not compared
+		moduleAccCollateral := sdk.NewCoins()
+		for _, coin := range k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins() {
+			if denoms[coin.Denom] {
+				moduleAccCollateral = moduleAccCollateral.Add(coin)
+			}
+		}
+		broken := !moduleAccCollateral.IsEqual(totalCollateral)
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"module account",
+			fmt.Sprintf(
+				"\texpected ModuleAccount collateral: %s\n"+
+					"\tactual ModuleAccount collateral:   %s\n",
+				totalCollateral, moduleAccCollateral),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// DepositsInvariant checks that the deposits of each cdp sum to the cdp's collateral
+func DepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+			total := sdk.NewCoin(cdp.Collateral.Denom, sdk.ZeroInt())
+			for _, dep := range k.GetDeposits(ctx, cdp.ID) {
+				total = total.Add(dep.Amount)
+			}
+			if !total.IsEqual(cdp.Collateral) {
+				msg += fmt.Sprintf("\tcdp %d collateral %s does not match deposits %s\n", cdp.ID, cdp.Collateral, total)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "deposits", msg), broken
+	}
+}
+
+// TotalCollateralInvariant checks that the total collateral of each collateral type matches the collateral of its cdps
+func TotalCollateralInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		totals := make(map[string]sdk.Int)
+		k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+			total, found := totals[cdp.Type]
+			if !found {
+				total = sdk.ZeroInt()
+			}
+			totals[cdp.Type] = total.Add(cdp.Collateral.Amount)
+			return false
+		})
+
+		var msg string
+		broken := false
+		for _, collateralType := range k.GetCollateralTypes(ctx) {
+			expected, found := totals[collateralType]
+			if !found {
+				expected = sdk.ZeroInt()
+			}
+			if actual := k.GetTotalCollateral(ctx, collateralType); !actual.Equal(expected) {
+				msg += fmt.Sprintf("\t%s total collateral %s does not match cdp collateral %s\n", collateralType, actual, expected)
+				broken = true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total collateral", msg), broken
+	}
+}
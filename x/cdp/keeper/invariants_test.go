@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func (suite *DepositTestSuite) TestInvariants() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], c("xrp", 10000000), "xrp-a")
+	suite.Require().NoError(err)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], c("xrp", 5000000), "xrp-a")
+	suite.Require().NoError(err)
+
+	_, broken := keeper.ModuleAccountInvariants(suite.keeper)(suite.ctx)
+	suite.False(broken)
+	_, broken = keeper.DepositsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	// a deposit that does not match the cdp's collateral breaks the deposits invariant
+	suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(uint64(1), suite.addrs[1], c("xrp", 1)))
+	_, broken = keeper.DepositsInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+
+	// collateral leaving the module account without a matching cdp update breaks the module account invariant
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, suite.addrs[1], cs(c("xrp", 1)))
+	suite.Require().NoError(err)
+	_, broken = keeper.ModuleAccountInvariants(suite.keeper)(suite.ctx)
+	suite.True(broken)
+}
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
@@ -21,13 +23,15 @@ type Keeper struct {
 	supplyKeeper    types.SupplyKeeper
 	auctionKeeper   types.AuctionKeeper
 	accountKeeper   types.AccountKeeper
+	swapKeeper      types.SwapKeeper
 	hooks           types.CDPHooks
 	maccPerms       map[string][]string
+	metrics         *types.Metrics
 }
 
 // NewKeeper creates a new keeper
 func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, pfk types.PricefeedKeeper,
-	ak types.AuctionKeeper, sk types.SupplyKeeper, ack types.AccountKeeper, maccs map[string][]string) Keeper {
+	ak types.AuctionKeeper, sk types.SupplyKeeper, ack types.AccountKeeper, swk types.SwapKeeper, maccs map[string][]string) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
@@ -40,8 +44,10 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		auctionKeeper:   ak,
 		supplyKeeper:    sk,
 		accountKeeper:   ack,
+		swapKeeper:      swk,
 		hooks:           nil,
 		maccPerms:       maccs,
+		metrics:         types.NopMetrics(),
 	}
 }
 
@@ -54,6 +60,16 @@ func (k *Keeper) SetHooks(hooks types.CDPHooks) *Keeper {
 	return k
 }
 
+// SetMetrics sets the metrics the keeper reports to
+func (k *Keeper) SetMetrics(metrics *types.Metrics) {
+	k.metrics = metrics
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // CdpDenomIndexIterator returns an sdk.Iterator for all cdps with matching collateral denom
 func (k Keeper) CdpDenomIndexIterator(ctx sdk.Context, collateralType string) sdk.Iterator {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -75,6 +91,26 @@ func (k Keeper) CdpCollateralRatioIndexIterator(ctx sdk.Context, collateralType
 	return store.Iterator(types.CollateralRatioIterKey(db, sdk.ZeroDec()), types.CollateralRatioIterKey(db, targetRatio))
 }
 
+// CdpCollateralRatioRangeIndexIterator returns an sdk.Iterator for all cdps that have collateral denom matching denom
+// and collateral:debt ratio GREATER THAN OR EQUAL TO minRatio and LESS THAN maxRatio. A zero maxRatio leaves the range
+// unbounded above.
+func (k Keeper) CdpCollateralRatioRangeIndexIterator(ctx sdk.Context, collateralType string, minRatio, maxRatio sdk.Dec, descending bool) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralRatioIndexPrefix)
+	db, found := k.GetCollateralTypePrefix(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("denom %s prefix not found", collateralType))
+	}
+	start := types.CollateralRatioIterKey(db, minRatio)
+	end := sdk.PrefixEndBytes(types.DenomIterKey(db))
+	if maxRatio.IsPositive() {
+		end = types.CollateralRatioIterKey(db, maxRatio)
+	}
+	if descending {
+		return store.ReverseIterator(start, end)
+	}
+	return store.Iterator(start, end)
+}
+
 // IterateAllCdps iterates over all cdps and performs a callback function
 func (k Keeper) IterateAllCdps(ctx sdk.Context, cb func(cdp types.CDP) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
@@ -123,6 +159,24 @@ func (k Keeper) IterateCdpsByCollateralRatio(ctx sdk.Context, collateralType str
 	}
 }
 
+// IterateCdpsByCollateralRatioRange iterates over cdps with collateral type equal to collateralType and collateral:debt
+// ratio in the range [minRatio, maxRatio), in ascending or descending order of ratio, and performs a callback function
+func (k Keeper) IterateCdpsByCollateralRatioRange(ctx sdk.Context, collateralType string, minRatio, maxRatio sdk.Dec, descending bool, cb func(cdp types.CDP) (stop bool)) {
+	iterator := k.CdpCollateralRatioRangeIndexIterator(ctx, collateralType, minRatio, maxRatio, descending)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, id, _ := types.SplitCollateralRatioKey(iterator.Key())
+		cdp, found := k.GetCDP(ctx, collateralType, id)
+		if !found {
+			panic(fmt.Sprintf("cdp %d does not exist", id))
+		}
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
 // GetSliceOfCDPsByRatioAndType returns a slice of cdps of size equal to the input cutoffCount
 // sorted by target ratio in ascending order (ie, the lowest collateral:debt ratio cdps are returned first)
 func (k Keeper) GetSliceOfCDPsByRatioAndType(ctx sdk.Context, cutoffCount sdk.Int, targetRatio sdk.Dec, collateralType string) (cdps types.CDPs) {
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// recordLiquidation counts a liquidated cdp of the given collateral type
+func (k Keeper) recordLiquidation(ctx sdk.Context, collateralType string) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	k.metrics.Liquidations.With("collateral_type", collateralType).Add(1)
+}
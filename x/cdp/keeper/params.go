@@ -20,6 +20,26 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
 }
 
+// InitializeParamDefaults sets the params added since launch to their defaults if they are missing from the param
+// store, which is the case for chains started before they were added: swap liquidations are initialized empty,
+// redemptions are initialized inactive, and collateral params without an auction threshold get a threshold of zero so
+// every liquidation is auctioned.
+func (k Keeper) InitializeParamDefaults(ctx sdk.Context) {
+	if !k.paramSubspace.Has(ctx, types.KeySwapLiquidations) {
+		k.paramSubspace.Set(ctx, types.KeySwapLiquidations, types.SwapLiquidations{})
+	}
+	if !k.paramSubspace.Has(ctx, types.KeyRedemption) {
+		k.paramSubspace.Set(ctx, types.KeyRedemption, types.DefaultRedemption)
+	}
+
+	var collateralParams types.CollateralParams
+	k.paramSubspace.Get(ctx, types.KeyCollateralParams, &collateralParams)
+	for i, cp := range collateralParams {
+		collateralParams[i].AuctionThreshold = cp.GetAuctionThreshold()
+	}
+	k.paramSubspace.Set(ctx, types.KeyCollateralParams, collateralParams)
+}
+
 // GetCollateral returns the collateral param with corresponding denom
 func (k Keeper) GetCollateral(ctx sdk.Context, collateralType string) (types.CollateralParam, bool) {
 	params := k.GetParams(ctx)
@@ -50,6 +70,17 @@ func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bo
 	return types.DebtParam{}, false
 }
 
+// GetSwapLiquidation returns the swap liquidation param for the collateral type
+func (k Keeper) GetSwapLiquidation(ctx sdk.Context, collateralType string) (types.SwapLiquidation, bool) {
+	params := k.GetParams(ctx)
+	for _, sl := range params.SwapLiquidations {
+		if sl.CollateralType == collateralType {
+			return sl, true
+		}
+	}
+	return types.SwapLiquidation{}, false
+}
+
 // GetCollateralTypePrefix returns the prefix of the matching denom
 func (k Keeper) GetCollateralTypePrefix(ctx sdk.Context, collateralType string) (byte, bool) {
 	params := k.GetParams(ctx)
@@ -93,7 +124,7 @@ func (k Keeper) getLiquidationRatio(ctx sdk.Context, collateralType string) sdk.
 	if !found {
 		panic(fmt.Sprintf("collateral not found: %s", collateralType))
 	}
-	return cp.LiquidationRatio
+	return cp.GetLiquidationRatio(ctx.BlockHeight())
 }
 
 func (k Keeper) getLiquidationPenalty(ctx sdk.Context, collateralType string) sdk.Dec {
@@ -112,11 +143,19 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, collateralType string) sdk.Int {
 	return cp.AuctionSize
 }
 
+func (k Keeper) getAuctionThreshold(ctx sdk.Context, collateralType string) sdk.Int {
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralType))
+	}
+	return cp.GetAuctionThreshold()
+}
+
 // GetFeeRate returns the per second fee rate for the input denom
 func (k Keeper) getFeeRate(ctx sdk.Context, collateralType string) (fee sdk.Dec) {
 	collalateralParam, found := k.GetCollateral(ctx, collateralType)
 	if !found {
 		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralType))
 	}
-	return collalateralParam.StabilityFee
+	return collalateralParam.GetStabilityFee(ctx.BlockHeight())
 }
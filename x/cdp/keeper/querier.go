@@ -30,6 +30,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryGetAccounts:
 			return queryGetAccounts(ctx, req, keeper)
+		case types.QueryGetSimulatedCdp:
+			return queryGetSimulatedCdp(ctx, req, keeper)
+		case types.QueryGetLiquidationRefunds:
+			return queryGetLiquidationRefunds(ctx, req, keeper)
+		case types.QueryGetCdpsByRatioRange:
+			return queryGetCdpsByRatioRange(ctx, req, keeper)
+		case types.QueryGetTotals:
+			return queryGetTotals(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint %s", types.ModuleName, path[0])
 		}
@@ -64,6 +72,34 @@ func queryGetCdp(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 }
 
+// query the outcome of a hypothetical change to a cdp
+func queryGetSimulatedCdp(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QuerySimulatedCdpParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	// omitted changes are treated as zero
+	collateralChange, principalChange := sdk.ZeroInt(), sdk.ZeroInt()
+	if !requestParams.CollateralChange.IsNil() {
+		collateralChange = requestParams.CollateralChange
+	}
+	if !requestParams.PrincipalChange.IsNil() {
+		principalChange = requestParams.PrincipalChange
+	}
+
+	simulatedCDP, err := keeper.SimulateCDPChange(ctx, requestParams.CollateralType, requestParams.ID, collateralChange, principalChange)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, simulatedCDP)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // query deposits on a particular cdp
 func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var requestParams types.QueryCdpDeposits
@@ -123,6 +159,63 @@ func queryGetCdpsByRatio(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 	return bz, nil
 }
 
+func queryGetCdpsByRatioRange(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryCdpsByRatioRangeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, valid := keeper.GetCollateralTypePrefix(ctx, requestParams.CollateralType)
+	if !valid {
+		return nil, sdkerrors.Wrap(types.ErrInvalidCollateral, requestParams.CollateralType)
+	}
+	// omitted bounds leave the range open
+	minRatio, maxRatio := sdk.ZeroDec(), sdk.ZeroDec()
+	if !requestParams.MinRatio.IsNil() {
+		minRatio = requestParams.MinRatio
+	}
+	if !requestParams.MaxRatio.IsNil() {
+		maxRatio = requestParams.MaxRatio
+	}
+	if minRatio.IsNegative() || maxRatio.IsNegative() {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ratio bounds must not be negative: min %s, max %s", minRatio, maxRatio)
+	}
+	if maxRatio.IsPositive() && maxRatio.LTE(minRatio) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max ratio %s must be greater than min ratio %s", maxRatio, minRatio)
+	}
+
+	// the collateral ratio index is ordered by collateral:debt ratio, so the bounds are converted from collateralization ratios
+	if minRatio.IsPositive() {
+		minRatio, err = keeper.CalculateCollateralizationRatioFromAbsoluteRatio(ctx, requestParams.CollateralType, minRatio, "liquidation")
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "couldn't get collateralization ratio from absolute ratio")
+		}
+	}
+	if maxRatio.IsPositive() {
+		maxRatio, err = keeper.CalculateCollateralizationRatioFromAbsoluteRatio(ctx, requestParams.CollateralType, maxRatio, "liquidation")
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "couldn't get collateralization ratio from absolute ratio")
+		}
+	}
+
+	limit := requestParams.Limit
+	if limit == 0 {
+		limit = 100
+	}
+	cdps := keeper.GetPaginatedCdpsByCollateralTypeAndRatioRange(ctx, requestParams.CollateralType, minRatio, maxRatio, requestParams.Descending, requestParams.Page, limit)
+	// augment CDPs by adding collateral value and collateralization ratio
+	augmentedCDPs := types.AugmentedCDPs{}
+	for _, cdp := range cdps {
+		augmentedCDP := keeper.LoadAugmentedCDP(ctx, cdp)
+		augmentedCDPs = append(augmentedCDPs, augmentedCDP)
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, augmentedCDPs)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // query all cdps with matching collateral type
 func queryGetCdpsByCollateralType(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var requestParams types.QueryCdpsByCollateralTypeParams
@@ -163,6 +256,19 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 }
 
 // query cdp module accounts
+func queryGetTotals(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	totals, err := keeper.GetCDPTotals(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, totals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryGetAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	cdpAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
 	liquidatorAccAccount := keeper.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc)
@@ -180,6 +286,31 @@ func queryGetAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
+// query liquidation refunds that have not been paid out, optionally filtered by owner
+func queryGetLiquidationRefunds(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryLiquidationRefundsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	refunds := types.LiquidationRefunds{}
+	if len(params.Owner) > 0 {
+		refund, found := keeper.GetLiquidationRefund(ctx, params.Owner)
+		if found {
+			refunds = append(refunds, refund)
+		}
+	} else {
+		refunds = keeper.GetAllLiquidationRefunds(ctx)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, refunds)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // query cdps in store and filter by request params
 func queryGetCdps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	var params types.QueryCdpsParams
@@ -246,6 +246,135 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	suite.Equal(0, len(c))
 }
 
+func (suite *QuerierTestSuite) TestQueryCdpsByRatioRange() {
+	minRatio, maxRatio := d("5"), d("10")
+	expectedIds := []int{}
+	for _, cdp := range suite.augmentedCDPs {
+		if cdp.Type == "xrp-a" && cdp.CollateralizationRatio.GTE(minRatio) && cdp.CollateralizationRatio.LT(maxRatio) {
+			expectedIds = append(expectedIds, int(cdp.ID))
+		}
+	}
+	suite.Require().True(len(expectedIds) > 4)
+
+	ctx := suite.ctx.WithIsCheckTx(false)
+	queryCdps := func(params types.QueryCdpsByRatioRangeParams) (types.AugmentedCDPs, error) {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByRatioRange}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetCdpsByRatioRange}, query)
+		if err != nil {
+			return nil, err
+		}
+		var c types.AugmentedCDPs
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &c))
+		return c, nil
+	}
+
+	// cdps in the range are returned sorted by ratio
+	c, err := queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 100, "xrp-a", minRatio, maxRatio, false))
+	suite.Nil(err)
+	actualIds := []int{}
+	for i, k := range c {
+		actualIds = append(actualIds, int(k.ID))
+		if i > 0 {
+			suite.True(k.CollateralizationRatio.GTE(c[i-1].CollateralizationRatio))
+		}
+	}
+	sort.Ints(actualIds)
+	suite.Equal(expectedIds, actualIds)
+
+	// pages continue from where the previous page ended
+	page1, err := queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 2, "xrp-a", minRatio, maxRatio, false))
+	suite.Nil(err)
+	page2, err := queryCdps(types.NewQueryCdpsByRatioRangeParams(2, 2, "xrp-a", minRatio, maxRatio, false))
+	suite.Nil(err)
+	suite.Equal(c[:4], append(page1, page2...))
+
+	descending, err := queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 2, "xrp-a", minRatio, maxRatio, true))
+	suite.Nil(err)
+	suite.Equal(types.AugmentedCDPs{c[len(c)-1], c[len(c)-2]}, descending)
+
+	// omitted bounds leave the range open
+	all, err := queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 100, "xrp-a", sdk.Dec{}, sdk.Dec{}, false))
+	suite.Nil(err)
+	suite.Equal(len(suite.keeper.GetAllCdpsByCollateralType(suite.ctx, "xrp-a")), len(all))
+
+	_, err = queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 100, "xrp-a", maxRatio, minRatio, false))
+	suite.Error(err)
+	_, err = queryCdps(types.NewQueryCdpsByRatioRangeParams(1, 100, "lol-a", minRatio, maxRatio, false))
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestQuerySimulatedCdp() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	cdp := suite.cdps[1]
+	ownerCoins := suite.app.GetAccountKeeper().GetAccount(ctx, cdp.Owner).GetCoins()
+
+	type errArgs struct {
+		expectPass bool
+		success    bool
+	}
+	testCases := []struct {
+		name             string
+		collateralChange sdk.Int
+		principalChange  sdk.Int
+		expectCollateral sdk.Coin
+		expectPrincipal  sdk.Coin
+		errArgs          errArgs
+	}{
+		{"draw", sdk.ZeroInt(), i(10000000), cdp.Collateral, cdp.Principal.Add(c("usdx", 10000000)), errArgs{true, true}},
+		{"deposit and draw", i(1000000), i(10000000), cdp.Collateral.Add(c("xrp", 1000000)), cdp.Principal.Add(c("usdx", 10000000)), errArgs{true, true}},
+		{"repay", sdk.ZeroInt(), i(-10000000), cdp.Collateral, cdp.Principal.Sub(c("usdx", 10000000)), errArgs{true, true}},
+		{"draw above liquidation ratio", sdk.ZeroInt(), i(10000000000), cdp.Collateral, cdp.Principal.Add(c("usdx", 10000000000)), errArgs{true, false}},
+		{"withdraw all collateral", cdp.Collateral.Amount.Neg(), sdk.ZeroInt(), c("xrp", 0), cdp.Principal, errArgs{true, false}},
+		{"withdraw more than collateral", cdp.Collateral.Amount.Neg().SubRaw(1), sdk.ZeroInt(), sdk.Coin{}, sdk.Coin{}, errArgs{false, false}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSimulatedCdp}, "/"),
+				Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySimulatedCdpParams(cdp.Type, cdp.ID, tc.collateralChange, tc.principalChange)),
+			}
+			bz, err := suite.querier(ctx, []string{types.QueryGetSimulatedCdp}, query)
+			if !tc.errArgs.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			var simulated types.SimulatedCDP
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &simulated))
+			suite.Equal(cdp.ID, simulated.ID)
+			suite.Equal(tc.expectCollateral, simulated.Collateral)
+			suite.Equal(tc.expectPrincipal, simulated.Principal)
+			suite.Equal(suite.augmentedCDPs[1].AccumulatedFees, simulated.AccumulatedFees)
+			suite.Equal(tc.errArgs.success, simulated.Success)
+			if tc.errArgs.success {
+				suite.Empty(simulated.Error)
+				ratio, err := suite.keeper.CalculateCollateralizationRatio(ctx, tc.expectCollateral, cdp.Type, tc.expectPrincipal, simulated.AccumulatedFees, "spot")
+				suite.Require().NoError(err)
+				suite.Equal(ratio, simulated.CollateralizationRatio)
+			} else {
+				suite.NotEmpty(simulated.Error)
+			}
+
+			// the simulation does not modify state
+			storedCDP, found := suite.keeper.GetCDP(ctx, cdp.Type, cdp.ID)
+			suite.Require().True(found)
+			suite.Equal(cdp, storedCDP)
+			suite.Equal(ownerCoins, suite.app.GetAccountKeeper().GetAccount(ctx, cdp.Owner).GetCoins())
+		})
+	}
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSimulatedCdp}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySimulatedCdpParams(cdp.Type, 1000, sdk.ZeroInt(), i(10000000))),
+	}
+	_, err := suite.querier(ctx, []string{types.QueryGetSimulatedCdp}, query)
+	suite.Error(err)
+}
+
 func (suite *QuerierTestSuite) TestQueryParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	bz, err := suite.querier(ctx, []string{types.QueryGetParams}, abci.RequestQuery{})
@@ -261,6 +390,25 @@ func (suite *QuerierTestSuite) TestQueryParams() {
 	suite.Equal(gs.Params, p)
 }
 
+func (suite *QuerierTestSuite) TestQueryTotals() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	bz, err := suite.querier(ctx, []string{types.QueryGetTotals}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+
+	var totals types.CDPTotals
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &totals))
+	expected, err := suite.keeper.GetCDPTotals(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, totals)
+
+	collateral := sdk.NewCoins()
+	for _, cdp := range suite.keeper.GetAllCdps(ctx) {
+		collateral = collateral.Add(cdp.Collateral)
+	}
+	suite.Require().Equal(collateral, totals.Collateral)
+	suite.Require().True(totals.CollateralizationRatio.IsPositive())
+}
+
 func (suite *QuerierTestSuite) TestQueryDeposits() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	query := abci.RequestQuery{
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RedeemDebt repays the debt of the most collateralized cdps of a collateral type with the redeemer's debt coins, in
+// exchange for their collateral at the spot price less the redemption fee. CDPs are redeemed in descending order of
+// collateral:debt ratio until the amount is used up or the next cdp's collateral is worth less than its debt. A cdp
+// whose debt is redeemed in full is closed, and a partial redemption never leaves a cdp below the debt floor.
+// Only the amount that could be redeemed is taken from the redeemer.
+func (k Keeper) RedeemDebt(ctx sdk.Context, redeemer sdk.AccAddress, amount sdk.Coin, collateralType string) error {
+	redemption := k.GetParams(ctx).Redemption
+	if !redemption.Active {
+		return types.ErrRedemptionsInactive
+	}
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrCollateralNotSupported, collateralType)
+	}
+	dp, found := k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidPayment, "payment denom %s not found", amount.Denom)
+	}
+	if !k.GetMarketStatus(ctx, cp.SpotMarketID) {
+		return sdkerrors.Wrap(types.ErrPricefeedDown, collateralType)
+	}
+	if err := k.ValidateBalance(ctx, amount, redeemer); err != nil {
+		return err
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+	if err != nil {
+		return err
+	}
+	// collateral paid out per unit of debt redeemed, in the smallest units of each
+	rate := sdk.OneDec().Sub(redemption.GetFee()).
+		Mul(sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())).
+		Quo(price.Price).
+		Quo(sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64()))
+
+	// cdps are collected before they are changed, as changing them updates the index being iterated over
+	var cdps types.CDPs
+	planned := amount.Amount
+	k.IterateCdpsByCollateralRatioRange(ctx, collateralType, sdk.ZeroDec(), sdk.ZeroDec(), true, func(cdp types.CDP) bool {
+		if cdp.Collateral.Amount.ToDec().LT(cdp.GetTotalPrincipal().Amount.ToDec().Mul(rate)) {
+			return true
+		}
+		debt := redeemableDebt(cdp, planned, dp.DebtFloor)
+		if debt.IsPositive() {
+			cdps = append(cdps, cdp)
+			planned = planned.Sub(debt)
+		}
+		return !planned.IsPositive()
+	})
+
+	redeemed := sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	collateralOut := sdk.NewCoin(cp.Denom, sdk.ZeroInt())
+	for _, cdp := range cdps {
+		remaining := amount.Amount.Sub(redeemed.Amount)
+		if !remaining.IsPositive() {
+			break
+		}
+		k.hooks.BeforeCDPModified(ctx, cdp)
+		cdp = k.SynchronizeInterest(ctx, cdp)
+
+		debt := redeemableDebt(cdp, remaining, dp.DebtFloor)
+		collateral := sdk.NewCoin(cp.Denom, debt.ToDec().Mul(rate).TruncateInt())
+		if !debt.IsPositive() || collateral.Amount.GT(cdp.Collateral.Amount) {
+			continue
+		}
+		if err := k.redeemCdp(ctx, redeemer, cdp, sdk.NewCoin(amount.Denom, debt), collateral); err != nil {
+			return err
+		}
+		redeemed = redeemed.Add(sdk.NewCoin(amount.Denom, debt))
+		collateralOut = collateralOut.Add(collateral)
+	}
+	if redeemed.IsZero() {
+		return sdkerrors.Wrapf(types.ErrNoRedeemableCdps, "collateral type %s", collateralType)
+	}
+
+	// burn the redeemed debt coins and the matching internal debt, and pay out the collateral
+	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(redeemed))
+	if err != nil {
+		return err
+	}
+	err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(redeemed))
+	if err != nil {
+		return err
+	}
+	debtDenom := k.GetDebtDenom(ctx)
+	debtToBurn := sdk.NewCoin(debtDenom, sdk.MinInt(redeemed.Amount, k.getModAccountDebt(ctx, types.ModuleName)))
+	err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, debtToBurn)
+	if err != nil {
+		return err
+	}
+	if collateralOut.IsPositive() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, sdk.NewCoins(collateralOut))
+		if err != nil {
+			return err
+		}
+	}
+	k.Logger(ctx).Info("redeemed debt", "redeemer", redeemer, "collateral_type", collateralType, "debt", redeemed, "collateral", collateralOut)
+	return nil
+}
+
+// redeemCdp repays debt of a synced cdp, fees first, and removes the redeemed collateral from its deposits. The cdp is
+// closed and its remaining collateral returned to depositors once its debt is fully repaid.
+func (k Keeper) redeemCdp(ctx sdk.Context, redeemer sdk.AccAddress, cdp types.CDP, debt, collateral sdk.Coin) error {
+	feePayment, principalPayment := k.calculatePayment(ctx, cdp.GetTotalPrincipal(), cdp.AccumulatedFees, debt)
+	k.removeRedeemedCollateral(ctx, cdp, collateral.Amount)
+
+	cdp.Collateral = cdp.Collateral.Sub(collateral)
+	cdp.Principal = cdp.Principal.Sub(principalPayment)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Sub(feePayment)
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRedemption,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, redeemer.String()),
+			sdk.NewAttribute(types.AttributeKeyDebt, debt.String()),
+			sdk.NewAttribute(types.AttributeKeyCollateral, collateral.String()),
+		),
+	)
+
+	if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
+		k.ReturnCollateral(ctx, cdp)
+		k.RemoveCdpOwnerIndex(ctx, cdp)
+		if err := k.DeleteCdpAndCollateralRatioIndex(ctx, cdp); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCdpClose,
+				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			),
+		)
+		return nil
+	}
+
+	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Type, cdp.GetTotalPrincipal())
+	return k.UpdateCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+}
+
+// removeRedeemedCollateral removes redeemed collateral from a cdp's deposits in proportion to their size, taking any
+// remainder from rounding from the deposits in order
+func (k Keeper) removeRedeemedCollateral(ctx sdk.Context, cdp types.CDP, amount sdk.Int) {
+	deposits := k.GetDeposits(ctx, cdp.ID)
+	shares := make([]sdk.Int, len(deposits))
+	removed := sdk.ZeroInt()
+	for i, deposit := range deposits {
+		shares[i] = amount.Mul(deposit.Amount.Amount).Quo(cdp.Collateral.Amount)
+		removed = removed.Add(shares[i])
+	}
+	for i, deposit := range deposits {
+		if removed.GTE(amount) {
+			break
+		}
+		extra := sdk.MinInt(amount.Sub(removed), deposit.Amount.Amount.Sub(shares[i]))
+		shares[i] = shares[i].Add(extra)
+		removed = removed.Add(extra)
+	}
+	for i, deposit := range deposits {
+		deposit.Amount = deposit.Amount.Sub(sdk.NewCoin(deposit.Amount.Denom, shares[i]))
+		if deposit.Amount.IsZero() {
+			k.DeleteDeposit(ctx, deposit.CdpID, deposit.Depositor)
+		} else {
+			k.SetDeposit(ctx, deposit)
+		}
+	}
+}
+
+// redeemableDebt returns how much of a cdp's debt can be redeemed from the remaining amount. A cdp is redeemed in full
+// if the remaining amount covers its debt, otherwise fees are redeemed first and principal only down to the debt floor.
+func redeemableDebt(cdp types.CDP, remaining, debtFloor sdk.Int) sdk.Int {
+	debt := cdp.GetTotalPrincipal().Amount
+	if remaining.GTE(debt) {
+		return debt
+	}
+	maxPartial := cdp.AccumulatedFees.Amount.Add(sdk.MaxInt(cdp.Principal.Amount.Sub(debtFloor), sdk.ZeroInt()))
+	return sdk.MinInt(remaining, maxPartial)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func (suite *CdpTestSuite) TestRedeemDebt() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	ak := suite.app.GetAccountKeeper()
+	for i, coins := range []sdk.Coins{cs(c("xrp", 1000000000)), cs(c("xrp", 500000000)), cs(c("usdx", 100000000))} {
+		acc := ak.NewAccountWithAddress(suite.ctx, addrs[i])
+		acc.SetCoins(coins)
+		ak.SetAccount(suite.ctx, acc)
+	}
+	redeemer := addrs[2]
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 1000000000), c("usdx", 50000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[1], c("xrp", 500000000), c("usdx", 50000000), "xrp-a"))
+
+	err := suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 60000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrRedemptionsInactive))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Redemption = types.NewRedemptionParam(true, sdk.MustNewDecFromStr("0.005"))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err = suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 60000000), "btc-a")
+	suite.Require().True(errors.Is(err, types.ErrNoRedeemableCdps))
+	err = suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 200000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrInsufficientBalance))
+
+	// xrp is worth $0.25, so each usdx redeems 0.995 / 0.25 = 3.98 xrp. The most collateralized cdp is redeemed in full
+	// and closed, then the next is redeemed in part.
+	suite.Require().NoError(suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 60000000), "xrp-a"))
+	suite.Require().Equal(cs(c("usdx", 40000000), c("xrp", 238800000)), ak.GetAccount(suite.ctx, redeemer).GetCoins())
+	_, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, addrs[0], "xrp-a")
+	suite.Require().False(found)
+	suite.Require().Equal(cs(c("usdx", 50000000), c("xrp", 801000000)), ak.GetAccount(suite.ctx, addrs[0]).GetCoins())
+	cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, addrs[1], "xrp-a")
+	suite.Require().True(found)
+	suite.Require().Equal(c("usdx", 40000000), cdp.Principal)
+	suite.Require().Equal(c("xrp", 460200000), cdp.Collateral)
+	suite.Require().Equal(sdk.NewInt(40000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp-a", "usdx"))
+
+	for _, invariant := range []sdk.Invariant{
+		keeper.ModuleAccountInvariants(suite.keeper),
+		keeper.DepositsInvariant(suite.keeper),
+		keeper.TotalCollateralInvariant(suite.keeper),
+	} {
+		_, broken := invariant(suite.ctx)
+		suite.Require().False(broken)
+	}
+
+	// a partial redemption cannot leave a cdp below the debt floor
+	err = suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 35000000), "xrp-a")
+	suite.Require().NoError(err)
+	cdp, _ = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, addrs[1], "xrp-a")
+	suite.Require().Equal(c("usdx", 10000000), cdp.Principal)
+	suite.Require().Equal(cs(c("usdx", 10000000), c("xrp", 358200000)), ak.GetAccount(suite.ctx, redeemer).GetCoins())
+	err = suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 5000000), "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrNoRedeemableCdps))
+
+	// redeeming the remaining debt closes the cdp
+	suite.Require().NoError(suite.keeper.RedeemDebt(suite.ctx, redeemer, c("usdx", 10000000), "xrp-a"))
+	_, found = suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, addrs[1], "xrp-a")
+	suite.Require().False(found)
+}
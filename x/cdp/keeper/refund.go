@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// AddLiquidationRefund records liquidation surplus held by the liquidator module account as owed to an owner
+func (k Keeper) AddLiquidationRefund(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coins) {
+	if amount.IsZero() {
+		return
+	}
+	refund, found := k.GetLiquidationRefund(ctx, owner)
+	if !found {
+		refund = types.NewLiquidationRefund(owner, sdk.NewCoins())
+	}
+	refund.Amount = refund.Amount.Add(amount...)
+	k.SetLiquidationRefund(ctx, refund)
+}
+
+// GetLiquidationRefund returns the liquidation refund owed to an owner
+func (k Keeper) GetLiquidationRefund(ctx sdk.Context, owner sdk.AccAddress) (types.LiquidationRefund, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationRefundPrefix)
+	bz := store.Get(types.LiquidationRefundKey(owner))
+	if bz == nil {
+		return types.LiquidationRefund{}, false
+	}
+	var refund types.LiquidationRefund
+	k.cdc.MustUnmarshalBinaryBare(bz, &refund)
+	return refund, true
+}
+
+// SetLiquidationRefund sets a liquidation refund in the store and updates the total of liquidation refunds
+func (k Keeper) SetLiquidationRefund(ctx sdk.Context, refund types.LiquidationRefund) {
+	existing, _ := k.GetLiquidationRefund(ctx, refund.Owner)
+	k.setTotalLiquidationRefunds(ctx, k.GetTotalLiquidationRefunds(ctx).Add(refund.Amount...).Sub(existing.Amount))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationRefundPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(refund)
+	store.Set(types.LiquidationRefundKey(refund.Owner), bz)
+}
+
+// DeleteLiquidationRefund deletes a liquidation refund from the store and removes it from the total of liquidation refunds
+func (k Keeper) DeleteLiquidationRefund(ctx sdk.Context, owner sdk.AccAddress) {
+	existing, found := k.GetLiquidationRefund(ctx, owner)
+	if !found {
+		return
+	}
+	k.setTotalLiquidationRefunds(ctx, k.GetTotalLiquidationRefunds(ctx).Sub(existing.Amount))
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationRefundPrefix)
+	store.Delete(types.LiquidationRefundKey(owner))
+}
+
+// IterateLiquidationRefunds iterates over all liquidation refunds and performs a callback function
+func (k Keeper) IterateLiquidationRefunds(ctx sdk.Context, cb func(refund types.LiquidationRefund) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.LiquidationRefundPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.LiquidationRefund
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &refund)
+		if cb(refund) {
+			break
+		}
+	}
+}
+
+// GetAllLiquidationRefunds returns all liquidation refunds that have not been paid out
+func (k Keeper) GetAllLiquidationRefunds(ctx sdk.Context) types.LiquidationRefunds {
+	refunds := types.LiquidationRefunds{}
+	k.IterateLiquidationRefunds(ctx, func(refund types.LiquidationRefund) (stop bool) {
+		refunds = append(refunds, refund)
+		return false
+	})
+	return refunds
+}
+
+// GetTotalLiquidationRefunds returns the total amount of liquidation refunds held by the liquidator module account
+func (k Keeper) GetTotalLiquidationRefunds(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.TotalRefundsKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var total sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &total)
+	return total
+}
+
+// setTotalLiquidationRefunds sets the total amount of liquidation refunds held by the liquidator module account
+func (k Keeper) setTotalLiquidationRefunds(ctx sdk.Context, total sdk.Coins) {
+	store := ctx.KVStore(k.key)
+	if total.Empty() {
+		store.Delete(types.TotalRefundsKey)
+		return
+	}
+	store.Set(types.TotalRefundsKey, k.cdc.MustMarshalBinaryBare(total))
+}
+
+// ProcessLiquidationRefunds pays out liquidation refunds from the liquidator module account to their owners, up to
+// MaxLiquidationRefundsPerBlock refunds per block. Refunds that cannot be paid out, for example because the owner's
+// account cannot receive funds, are left in the store and retried in later blocks. A refund that fails
+// MaxLiquidationRefundAttempts times is no longer retried, and is kept in the store until its owner claims it.
+func (k Keeper) ProcessLiquidationRefunds(ctx sdk.Context) {
+	// collect before paying out so the store is not modified while iterating
+	var refunds types.LiquidationRefunds
+	k.IterateLiquidationRefunds(ctx, func(refund types.LiquidationRefund) (stop bool) {
+		if refund.Attempts >= types.MaxLiquidationRefundAttempts {
+			return false
+		}
+		refunds = append(refunds, refund)
+		return len(refunds) >= types.MaxLiquidationRefundsPerBlock
+	})
+
+	for _, refund := range refunds {
+		// pay out each refund in a cached context so a failed transfer leaves no trace
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.payLiquidationRefund(cacheCtx, refund); err != nil {
+			k.Logger(ctx).Error("failed to pay out liquidation refund", "owner", refund.Owner, "amount", refund.Amount, "error", err)
+			k.recordFailedLiquidationRefund(ctx, refund)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// ClaimLiquidationRefund pays out the liquidation refund owed to an owner, including a refund that is no longer paid
+// out at the end of the block because it failed MaxLiquidationRefundAttempts times
+func (k Keeper) ClaimLiquidationRefund(ctx sdk.Context, owner sdk.AccAddress) error {
+	refund, found := k.GetLiquidationRefund(ctx, owner)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrLiquidationRefundNotFound, "%s", owner)
+	}
+	return k.payLiquidationRefund(ctx, refund)
+}
+
+// payLiquidationRefund sends a liquidation refund from the liquidator module account to its owner and deletes it
+func (k Keeper) payLiquidationRefund(ctx sdk.Context, refund types.LiquidationRefund) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, refund.Owner, refund.Amount)
+	if err != nil {
+		return err
+	}
+	k.DeleteLiquidationRefund(ctx, refund.Owner)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpLiquidationRefund,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOwner, refund.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.Amount.String()),
+		),
+	)
+	return nil
+}
+
+// recordFailedLiquidationRefund counts a failed payout of a liquidation refund. Once the refund has failed
+// MaxLiquidationRefundAttempts times it is no longer paid out at the end of the block, and must be claimed by its owner.
+func (k Keeper) recordFailedLiquidationRefund(ctx sdk.Context, refund types.LiquidationRefund) {
+	refund.Attempts++
+	k.SetLiquidationRefund(ctx, refund)
+	if refund.Attempts < types.MaxLiquidationRefundAttempts {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpRefundSuspended,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyOwner, refund.Owner.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, refund.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAttempts, fmt.Sprintf("%d", refund.Attempts)),
+		),
+	)
+}
@@ -24,6 +24,9 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper, owner sdk.AccA
 	if err != nil {
 		return err
 	}
+	if !k.auctionKeeper.HasCollateralAuctionCapacity(ctx) {
+		return sdkerrors.Wrapf(types.ErrAuctionLimitReached, "cdp %d", cdp.ID)
+	}
 	cdp, err = k.payoutKeeperLiquidationReward(ctx, keeper, cdp)
 	if err != nil {
 		return err
@@ -83,10 +86,18 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) error {
 	// Delete CDP from state
 	k.RemoveCdpOwnerIndex(ctx, cdp)
 	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
-	return k.DeleteCDP(ctx, cdp)
+	err = k.DeleteCDP(ctx, cdp)
+	if err != nil {
+		return err
+	}
+
+	k.recordLiquidation(ctx, cdp.Type)
+	k.Logger(ctx).Info("liquidated cdp", "id", cdp.ID, "collateral_type", cdp.Type, "owner", cdp.Owner, "collateral", cdp.Collateral, "debt", debtCoin)
+	return nil
 }
 
-// LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
+// LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio. Once the collateral auctions that can
+// be started in a block have been started the remaining CDPs are left open, to be liquidated in later blocks.
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType string, liquidationRatio sdk.Dec) error {
 	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
 	if err != nil {
@@ -102,6 +113,10 @@ func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, collateralType s
 	normalizedRatio := sdk.OneDec().Quo(priceDivLiqRatio)
 	cdpsToLiquidate := k.GetAllCdpsByCollateralTypeAndRatio(ctx, collateralType, normalizedRatio)
 	for _, c := range cdpsToLiquidate {
+		if !k.auctionKeeper.HasCollateralAuctionCapacity(ctx) {
+			k.Logger(ctx).Info("collateral auction limit reached, deferring liquidations", "collateral_type", collateralType)
+			break
+		}
 		k.hooks.BeforeCDPModified(ctx, c)
 		err := k.SeizeCollateral(ctx, c)
 		if err != nil {
@@ -19,6 +19,7 @@ import (
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/swap"
 )
 
 type SeizeTestSuite struct {
@@ -196,6 +197,79 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsAuctionLimit() {
+	suite.createCdps()
+	suite.Require().True(len(suite.liquidations.xrp) > 1)
+	ak := suite.app.GetAuctionKeeper()
+	auctionParams := ak.GetParams(suite.ctx)
+	auctionParams.MaxCollateralAuctionsPerBlock = 1
+	ak.SetParams(suite.ctx, auctionParams)
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+	suite.True(found)
+	before := len(suite.keeper.GetAllCdpsByCollateralType(suite.ctx, "xrp-a"))
+
+	// only the first cdp is liquidated, the rest wait for the next block
+	err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.Require().NoError(err)
+	remaining := len(suite.keeper.GetAllCdpsByCollateralType(suite.ctx, "xrp-a"))
+	suite.Equal(before-1, remaining)
+	suite.False(ak.HasCollateralAuctionCapacity(suite.ctx))
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, suite.addrs[0], suite.addrs[suite.liquidations.xrp[1]-1], "xrp-a")
+	suite.Require().True(errors.Is(err, types.ErrAuctionLimitReached))
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", p.LiquidationRatio)
+	suite.Require().NoError(err)
+	suite.Equal(remaining-1, len(suite.keeper.GetAllCdpsByCollateralType(suite.ctx, "xrp-a")))
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsRampedLiquidationRatio() {
+	suite.createCdps()
+
+	// raise the xrp liquidation ratio from 2.0 to 2.6 over 10 blocks
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].LiquidationRatio = d("2.6")
+	params.CollateralParams[0].LiquidationRatioRamp = types.NewParamRamp(d("2.0"), 2, 10)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	countBelow := func(ratio sdk.Dec) (count int) {
+		for _, cdp := range suite.cdps {
+			if cdp.Type != "xrp-a" {
+				continue
+			}
+			collateralValue := sdk.NewDecFromInt(cdp.Collateral.Amount).Mul(d("0.25")).QuoInt64(1000000)
+			if collateralValue.Quo(sdk.NewDecFromInt(cdp.Principal.Amount).QuoInt64(1000000)).LT(ratio) {
+				count++
+			}
+		}
+		return count
+	}
+	suite.Require().Equal(0, countBelow(d("2.0")))
+	suite.Require().True(countBelow(d("2.3")) > 0)
+	suite.Require().True(countBelow(d("2.6")) > countBelow(d("2.3")))
+
+	for _, tc := range []struct {
+		height   int64
+		expected sdk.Dec
+	}{
+		{2, d("2.0")},
+		{7, d("2.3")},
+		{12, d("2.6")},
+	} {
+		suite.ctx = suite.ctx.WithBlockHeight(tc.height)
+		cp, found := suite.keeper.GetCollateral(suite.ctx, "xrp-a")
+		suite.Require().True(found)
+		suite.Require().Equal(tc.expected, cp.GetLiquidationRatio(suite.ctx.BlockHeight()))
+
+		err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp-a", cp.GetLiquidationRatio(suite.ctx.BlockHeight()))
+		suite.Require().NoError(err)
+		remaining := len(suite.keeper.GetAllCdpsByCollateralType(suite.ctx, "xrp-a"))
+		suite.Require().Equal(50-countBelow(tc.expected), remaining)
+	}
+}
+
 func (suite *SeizeTestSuite) TestApplyLiquidationPenalty() {
 	penalty := suite.keeper.ApplyLiquidationPenalty(suite.ctx, "xrp-a", i(1000))
 	suite.Equal(i(50), penalty)
@@ -458,6 +532,215 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 	}
 }
 
+func (suite *SeizeTestSuite) TestSeizeCollateralSwapLiquidation() {
+	type args struct {
+		swapLiquidation     types.SwapLiquidation
+		poolReserves        sdk.Coins
+		finalPrice          sdk.Dec
+		expectSwap          bool
+		expectedReturned    sdk.Int // xrp returned to the depositor if the collateral was sold through the swap module
+		expectedLiquidator  sdk.Coins
+		expectedRefund      sdk.Coins // usdx owed to the depositor if the sale raised more than the debt and penalty
+		expectedNumAuctions int
+	}
+	type test struct {
+		name string
+		args args
+	}
+
+	testCases := []test{
+		{
+			"valid swap liquidation",
+			args{
+				types.NewSwapLiquidation("xrp-a", i(1000000000), d("0.05")),
+				cs(c("xrp", 4000000000), c("usdx", 1000000000)),
+				d("0.15"),
+				true,
+				i(529314198),
+				cs(c("debt", 100000000), c("usdx", 105000000)),
+				nil,
+				0,
+			},
+		},
+		{
+			"swap proceeds above debt and penalty - refund",
+			args{
+				types.NewSwapLiquidation("xrp-a", i(1000000000), d("0.05")),
+				cs(c("xrp", 4000000000), c("usdx", 1000000000)),
+				d("0.10"),
+				true,
+				sdk.ZeroInt(),
+				cs(c("debt", 100000000), c("usdx", 199519711)),
+				cs(c("usdx", 94519711)),
+				0,
+			},
+		},
+		{
+			"lot above max lot size - auction",
+			args{
+				types.NewSwapLiquidation("xrp-a", i(999999999), d("0.05")),
+				cs(c("xrp", 4000000000), c("usdx", 1000000000)),
+				d("0.15"),
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				1,
+			},
+		},
+		{
+			"slippage exceeded - auction",
+			args{
+				types.NewSwapLiquidation("xrp-a", i(1000000000), d("0.05")),
+				cs(c("xrp", 8000000000), c("usdx", 1000000000)),
+				d("0.15"),
+				false,
+				sdk.ZeroInt(),
+				nil,
+				nil,
+				1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.keeper.GetParams(suite.ctx)
+			params.SwapLiquidations = types.SwapLiquidations{tc.args.swapLiquidation}
+			suite.keeper.SetParams(suite.ctx, params)
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "xrp-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "xrp-a", sdk.OneDec())
+
+			// provide liquidity from a cdp owner's drawn usdx
+			swapKeeper := suite.app.GetSwapKeeper()
+			swapKeeper.SetParams(suite.ctx, swap.NewParams(swap.AllowedPools{swap.NewAllowedPool("usdx", "xrp")}, d("0.003"), sdk.ZeroDec()))
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "btc-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "btc-a", sdk.OneDec())
+			err := suite.keeper.AddCdp(suite.ctx, suite.addrs[1], c("btc", 100000000), c("usdx", tc.args.poolReserves.AmountOf("usdx").Int64()), "btc-a")
+			suite.Require().NoError(err)
+			err = swapKeeper.Deposit(suite.ctx, suite.addrs[1], c("xrp", tc.args.poolReserves.AmountOf("xrp").Int64()), c("usdx", tc.args.poolReserves.AmountOf("usdx").Int64()), d("0.01"))
+			suite.Require().NoError(err)
+
+			err = suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 1000000000), c("usdx", 100000000), "xrp-a")
+			suite.Require().NoError(err)
+			cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+			suite.Require().True(found)
+			suite.setPrice(tc.args.finalPrice, "xrp:usd")
+
+			ak := suite.app.GetAccountKeeper()
+			balanceBefore := ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+
+			err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+			suite.Require().NoError(err)
+
+			auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+			suite.Require().Equal(tc.args.expectedNumAuctions, len(auctions))
+
+			liquidator := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+			suite.Require().Equal(tc.args.expectedLiquidator, liquidator.GetCoins())
+
+			balanceAfter := ak.GetAccount(suite.ctx, suite.addrs[0]).GetCoins()
+			suite.Require().True(tc.args.expectedReturned.Equal(balanceAfter.AmountOf("xrp").Sub(balanceBefore.AmountOf("xrp"))))
+
+			pool, found := swapKeeper.GetPool(suite.ctx, swap.PoolID("usdx", "xrp"))
+			suite.Require().True(found)
+			refund, found := suite.keeper.GetLiquidationRefund(suite.ctx, suite.addrs[0])
+			suite.Require().Equal(tc.args.expectedRefund != nil, found)
+			if found {
+				suite.Require().Equal(tc.args.expectedRefund, refund.Amount)
+			}
+
+			if tc.args.expectSwap {
+				suite.Require().Equal(tc.args.poolReserves.AmountOf("usdx").Sub(i(105000000)).Sub(tc.args.expectedRefund.AmountOf("usdx")), pool.Reserves().AmountOf("usdx"))
+			} else {
+				suite.Require().Equal(tc.args.poolReserves, pool.Reserves())
+			}
+		})
+	}
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralAuctionThreshold() {
+	type args struct {
+		auctionThreshold    sdk.Int
+		expectedNumAuctions int
+		expectedLiquidator  sdk.Coins
+		expectSettlement    bool
+	}
+	type test struct {
+		name string
+		args args
+	}
+
+	testCases := []test{
+		{
+			"collateral below threshold - settled",
+			args{
+				i(1000000001),
+				0,
+				cs(c("debt", 100000000), c("xrp", 1000000000)),
+				true,
+			},
+		},
+		{
+			"collateral equal to threshold - auction",
+			args{
+				i(1000000000),
+				1,
+				nil,
+				false,
+			},
+		},
+		{
+			"no threshold - auction",
+			args{
+				sdk.ZeroInt(),
+				1,
+				nil,
+				false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.keeper.GetParams(suite.ctx)
+			for j := range params.CollateralParams {
+				if params.CollateralParams[j].Type == "xrp-a" {
+					params.CollateralParams[j].AuctionThreshold = tc.args.auctionThreshold
+				}
+			}
+			suite.keeper.SetParams(suite.ctx, params)
+			suite.keeper.SetPreviousAccrualTime(suite.ctx, "xrp-a", suite.ctx.BlockTime())
+			suite.keeper.SetInterestFactor(suite.ctx, "xrp-a", sdk.OneDec())
+
+			err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], c("xrp", 1000000000), c("usdx", 100000000), "xrp-a")
+			suite.Require().NoError(err)
+			cdp, found := suite.keeper.GetCdpByOwnerAndCollateralType(suite.ctx, suite.addrs[0], "xrp-a")
+			suite.Require().True(found)
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+			suite.Require().NoError(err)
+
+			auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+			suite.Require().Equal(tc.args.expectedNumAuctions, len(auctions))
+
+			liquidator := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+			suite.Require().Equal(tc.args.expectedLiquidator, liquidator.GetCoins())
+
+			settled := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeCdpLiquidationSettlement {
+					settled = true
+				}
+			}
+			suite.Require().Equal(tc.args.expectSettlement, settled)
+		})
+	}
+}
+
 func TestSeizeTestSuite(t *testing.T) {
 	suite.Run(t, new(SeizeTestSuite))
 }
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// SwapLiquidatedDeposit attempts to sell a liquidated deposit held by the liquidator module account through the swap
+// module. The sale targets the debt covered by the deposit plus the liquidation penalty. Any collateral that is not
+// needed is returned to the depositor, and any proceeds above the target are recorded as a liquidation refund for the
+// depositor. If the deposit is not eligible for swap liquidation, or the sale cannot be made within the max slippage,
+// no state is changed and false is returned so the caller can fall back to auctioning the deposit.
+func (k Keeper) SwapLiquidatedDeposit(ctx sdk.Context, collateral sdk.Coin, collateralType string, returnAddr sdk.AccAddress, debt sdk.Int, principalDenom string) bool {
+	sl, found := k.GetSwapLiquidation(ctx, collateralType)
+	if !found || collateral.Amount.GT(sl.MaxLotSize) || !debt.IsPositive() {
+		return false
+	}
+	cp, found := k.GetCollateral(ctx, collateralType)
+	if !found {
+		return false
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.LiquidationMarketID)
+	if err != nil || !price.Price.IsPositive() {
+		return false
+	}
+
+	// run the sale in a cached context with a separate event manager so failed swaps leave no trace
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	liquidator := k.supplyKeeper.GetModuleAddress(types.LiquidatorMacc)
+	balanceBefore := k.supplyKeeper.GetModuleAccount(cacheCtx, types.LiquidatorMacc).GetCoins()
+
+	target := sdk.NewCoin(principalDenom, debt.Add(k.ApplyLiquidationPenalty(ctx, collateralType, debt)))
+	collateralFactor := sdk.NewDecFromIntWithPrec(sdk.OneInt(), cp.ConversionFactor.Int64())
+	expectedInput := k.convertDebtToBaseUnits(ctx, target).Quo(price.Price).Quo(collateralFactor).Ceil().TruncateInt()
+
+	if expectedInput.IsPositive() && expectedInput.LTE(collateral.Amount) {
+		// sell only as much collateral as is needed to cover the debt, never spending more than the deposit
+		slippage := sdk.MinDec(sl.MaxSlippage, collateral.Amount.ToDec().Quo(expectedInput.ToDec()).Sub(sdk.OneDec()))
+		err = k.swapKeeper.SwapForExactTokens(cacheCtx, liquidator, sdk.NewCoin(collateral.Denom, expectedInput), target, slippage)
+	} else {
+		// the deposit is worth less than the debt, so sell all of it
+		dp, _ := k.GetDebtParam(ctx, principalDenom)
+		debtFactor := sdk.NewDecFromIntWithPrec(sdk.OneInt(), dp.ConversionFactor.Int64())
+		expectedOutput := k.convertCollateralToBaseUnits(ctx, collateral, collateralType).Mul(price.Price).Quo(debtFactor).TruncateInt()
+		if !expectedOutput.IsPositive() {
+			return false
+		}
+		err = k.swapKeeper.SwapExactForTokens(cacheCtx, liquidator, collateral, sdk.NewCoin(principalDenom, expectedOutput), sl.MaxSlippage)
+	}
+	if err != nil {
+		return false
+	}
+
+	balanceAfter := k.supplyKeeper.GetModuleAccount(cacheCtx, types.LiquidatorMacc).GetCoins()
+	sold := sdk.NewCoin(collateral.Denom, balanceBefore.AmountOf(collateral.Denom).Sub(balanceAfter.AmountOf(collateral.Denom)))
+	proceeds := sdk.NewCoin(principalDenom, balanceAfter.AmountOf(principalDenom).Sub(balanceBefore.AmountOf(principalDenom)))
+	if sold.Amount.GT(collateral.Amount) {
+		return false
+	}
+
+	remaining := collateral.Sub(sold)
+	if remaining.IsPositive() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.LiquidatorMacc, returnAddr, sdk.NewCoins(remaining))
+		if err != nil {
+			return false
+		}
+	}
+
+	// proceeds above the debt and penalty are owed to the depositor and are paid out at the end of the block
+	if proceeds.Amount.GT(target.Amount) {
+		k.AddLiquidationRefund(cacheCtx, returnAddr, sdk.NewCoins(proceeds.Sub(target)))
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpLiquidationSwap,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySwapInput, sold.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, proceeds.String()),
+		),
+	)
+	return true
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GetTotalCollateral returns the total amount of collateral locked in cdps of a collateral type
+func (k Keeper) GetTotalCollateral(ctx sdk.Context, collateralType string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalCollateralKeyPrefix)
+	bz := store.Get([]byte(collateralType))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var total sdk.Int
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &total)
+	return total
+}
+
+// SetTotalCollateral sets the total amount of collateral locked in cdps of a collateral type
+func (k Keeper) SetTotalCollateral(ctx sdk.Context, collateralType string, total sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalCollateralKeyPrefix)
+	store.Set([]byte(collateralType), k.cdc.MustMarshalBinaryLengthPrefixed(total))
+}
+
+// updateTotalCollateral adds the change in a cdp's collateral to the total collateral of its collateral type
+func (k Keeper) updateTotalCollateral(ctx sdk.Context, collateralType string, change sdk.Int) {
+	if change.IsZero() {
+		return
+	}
+	total := sdk.MaxInt(k.GetTotalCollateral(ctx, collateralType).Add(change), sdk.ZeroInt())
+	k.SetTotalCollateral(ctx, collateralType, total)
+}
+
+// InitializeTotalCollateral sets the total collateral of each collateral type from the cdps in the store, which is
+// needed for chains started before the totals were maintained as cdps are updated
+func (k Keeper) InitializeTotalCollateral(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalCollateralKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var staleKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		staleKeys = append(staleKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	totals := make(map[string]sdk.Int)
+	for _, collateralType := range k.GetCollateralTypes(ctx) {
+		totals[collateralType] = sdk.ZeroInt()
+	}
+	k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+		total, found := totals[cdp.Type]
+		if !found {
+			total = sdk.ZeroInt()
+		}
+		totals[cdp.Type] = total.Add(cdp.Collateral.Amount)
+		return false
+	})
+	var collateralTypes []string
+	for collateralType := range totals {
+		collateralTypes = append(collateralTypes, collateralType)
+	}
+	// map iteration order is random, so totals are written in a sorted order to keep the store writes deterministic
+	sort.Strings(collateralTypes)
+	for _, collateralType := range collateralTypes {
+		k.SetTotalCollateral(ctx, collateralType, totals[collateralType])
+	}
+}
+
+// GetCDPTotals returns the total collateral and principal of each collateral type along with their value and
+// collateralization ratio at spot prices
+func (k Keeper) GetCDPTotals(ctx sdk.Context) (types.CDPTotals, error) {
+	debtParam := k.GetParams(ctx).DebtParam
+	totals := types.CDPTotals{
+		CollateralTypes:        types.CollateralTypeTotalsList{},
+		Collateral:             sdk.NewCoins(),
+		Principal:              sdk.NewCoins(),
+		CollateralValue:        sdk.ZeroDec(),
+		CollateralizationRatio: sdk.ZeroDec(),
+	}
+	totalPrincipalValue := sdk.ZeroDec()
+	for _, cp := range k.GetParams(ctx).CollateralParams {
+		collateral := sdk.NewCoin(cp.Denom, k.GetTotalCollateral(ctx, cp.Type))
+		principal := sdk.NewCoin(debtParam.Denom, k.GetTotalPrincipal(ctx, cp.Type, debtParam.Denom))
+
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.SpotMarketID)
+		if err != nil {
+			return types.CDPTotals{}, fmt.Errorf("failed to get price for %s: %w", cp.Type, err)
+		}
+		collateralValue := k.convertCollateralToBaseUnits(ctx, collateral, cp.Type).Mul(price.Price)
+		principalValue := k.convertDebtToBaseUnits(ctx, principal)
+
+		totals.CollateralTypes = append(totals.CollateralTypes, types.NewCollateralTypeTotals(
+			cp.Type, collateral, principal, collateralValue, calculateRatio(collateralValue, principalValue),
+		))
+		totals.Collateral = totals.Collateral.Add(collateral)
+		totals.Principal = totals.Principal.Add(principal)
+		totals.CollateralValue = totals.CollateralValue.Add(collateralValue)
+		totalPrincipalValue = totalPrincipalValue.Add(principalValue)
+	}
+	totals.CollateralizationRatio = calculateRatio(totals.CollateralValue, totalPrincipalValue)
+	return totals, nil
+}
+
+// GetSystemCollateralizationRatio returns the value of the collateral in all cdps divided by their total principal at
+// spot prices, or zero if no principal has been drawn
+func (k Keeper) GetSystemCollateralizationRatio(ctx sdk.Context) (sdk.Dec, error) {
+	totals, err := k.GetCDPTotals(ctx)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return totals.CollateralizationRatio, nil
+}
+
+// calculateRatio returns the collateral value divided by the principal value, or zero if there is no principal
+func calculateRatio(collateralValue, principalValue sdk.Dec) sdk.Dec {
+	if !principalValue.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return collateralValue.Quo(principalValue)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+func (suite *CdpTestSuite) TestTotals() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 500000000)))
+	ak.SetAccount(suite.ctx, acc)
+	acc = ak.NewAccountWithAddress(suite.ctx, addrs[1])
+	acc.SetCoins(cs(c("btc", 100000000)))
+	ak.SetAccount(suite.ctx, acc)
+
+	// no cdps have been opened
+	totals, err := suite.keeper.GetCDPTotals(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(totals.Collateral)
+	suite.Require().Empty(totals.Principal)
+	suite.Require().Equal(sdk.ZeroDec(), totals.CollateralizationRatio)
+
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], c("xrp", 200000000), c("usdx", 10000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.AddCdp(suite.ctx, addrs[1], c("btc", 100000000), c("usdx", 4000000000), "btc-a"))
+	suite.Require().NoError(suite.keeper.DepositCollateral(suite.ctx, addrs[0], addrs[0], c("xrp", 100000000), "xrp-a"))
+	suite.Require().NoError(suite.keeper.WithdrawCollateral(suite.ctx, addrs[0], addrs[0], c("xrp", 50000000), "xrp-a"))
+	suite.Require().Equal(sdk.NewInt(250000000), suite.keeper.GetTotalCollateral(suite.ctx, "xrp-a"))
+	suite.Require().Equal(sdk.NewInt(100000000), suite.keeper.GetTotalCollateral(suite.ctx, "btc-a"))
+
+	// xrp is worth $0.25 and btc $8000
+	totals, err = suite.keeper.GetCDPTotals(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(cs(c("btc", 100000000), c("xrp", 250000000)), totals.Collateral)
+	suite.Require().Equal(cs(c("usdx", 4010000000)), totals.Principal)
+	suite.Require().Equal(sdk.MustNewDecFromStr("8062.5"), totals.CollateralValue)
+	suite.Require().Equal(sdk.MustNewDecFromStr("8062.5").Quo(sdk.NewDec(4010)), totals.CollateralizationRatio)
+	suite.Require().Len(totals.CollateralTypes, len(suite.keeper.GetParams(suite.ctx).CollateralParams))
+	for _, ct := range totals.CollateralTypes {
+		switch ct.CollateralType {
+		case "xrp-a":
+			suite.Require().Equal(types.NewCollateralTypeTotals("xrp-a", c("xrp", 250000000), c("usdx", 10000000), sdk.MustNewDecFromStr("62.5"), sdk.MustNewDecFromStr("6.25")), ct)
+		case "btc-a":
+			suite.Require().Equal(types.NewCollateralTypeTotals("btc-a", c("btc", 100000000), c("usdx", 4000000000), sdk.NewDec(8000), sdk.NewDec(2)), ct)
+		default:
+			suite.Require().True(ct.Collateral.IsZero())
+			suite.Require().True(ct.Principal.IsZero())
+		}
+	}
+	ratio, err := suite.keeper.GetSystemCollateralizationRatio(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(totals.CollateralizationRatio, ratio)
+
+	// repaying a cdp in full removes its collateral from the totals
+	suite.Require().NoError(suite.keeper.RepayPrincipal(suite.ctx, addrs[1], "btc-a", c("usdx", 4000000000)))
+	suite.Require().Equal(sdk.ZeroInt(), suite.keeper.GetTotalCollateral(suite.ctx, "btc-a"))
+	_, broken := keeper.TotalCollateralInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+
+	// recalculating the totals from the cdps in the store leaves them unchanged
+	suite.keeper.SetTotalCollateral(suite.ctx, "xrp-a", sdk.NewInt(1))
+	_, broken = keeper.TotalCollateralInvariant(suite.keeper)(suite.ctx)
+	suite.Require().True(broken)
+	suite.keeper.InitializeTotalCollateral(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(250000000), suite.keeper.GetTotalCollateral(suite.ctx, "xrp-a"))
+	_, broken = keeper.TotalCollateralInvariant(suite.keeper)(suite.ctx)
+	suite.Require().False(broken)
+}
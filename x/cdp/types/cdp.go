@@ -189,3 +189,37 @@ func (augcdps AugmentedCDPs) String() string {
 	}
 	return out
 }
+
+// SimulatedCDP is the outcome of a hypothetical collateral or principal change to a cdp
+type SimulatedCDP struct {
+	ID                     uint64   `json:"id" yaml:"id"`
+	Type                   string   `json:"type" yaml:"type"`
+	Collateral             sdk.Coin `json:"collateral" yaml:"collateral"`                           // collateral after the change
+	Principal              sdk.Coin `json:"principal" yaml:"principal"`                             // principal after the change
+	AccumulatedFees        sdk.Coin `json:"accumulated_fees" yaml:"accumulated_fees"`               // fees accrued to date, before the change
+	CollateralizationRatio sdk.Dec  `json:"collateralization_ratio" yaml:"collateralization_ratio"` // collateralization ratio after the change
+	Success                bool     `json:"success" yaml:"success"`                                 // whether the change would succeed
+	Error                  string   `json:"error,omitempty" yaml:"error,omitempty"`                 // reason the change would fail
+}
+
+// String implements fmt.stringer
+func (simCDP SimulatedCDP) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`SimulatedCDP:
+	ID: %d
+	Collateral Type: %s
+	Collateral: %s
+	Principal: %s
+	Fees: %s
+	Collateralization ratio: %s
+	Success: %t
+	Error: %s`,
+		simCDP.ID,
+		simCDP.Type,
+		simCDP.Collateral,
+		simCDP.Principal,
+		simCDP.AccumulatedFees,
+		simCDP.CollateralizationRatio,
+		simCDP.Success,
+		simCDP.Error,
+	))
+}
@@ -20,4 +20,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
 	cdc.RegisterConcrete(MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(MsgTopUpCollateral{}, "cdp/MsgTopUpCollateral", nil)
+	cdc.RegisterConcrete(MsgRedeemDebt{}, "cdp/MsgRedeemDebt", nil)
+	cdc.RegisterConcrete(MsgClaimLiquidationRefund{}, "cdp/MsgClaimLiquidationRefund", nil)
 }
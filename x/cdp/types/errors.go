@@ -51,4 +51,12 @@ var (
 	ErrInsufficientBalance = sdkerrors.Register(ModuleName, 22, "insufficient balance")
 	// ErrNotLiquidatable error for when an cdp is not liquidatable
 	ErrNotLiquidatable = sdkerrors.Register(ModuleName, 23, "cdp collateral ratio not below liquidation ratio")
+	// ErrAuctionLimitReached error for when the collateral auctions that can be started in a block have all been started
+	ErrAuctionLimitReached = sdkerrors.Register(ModuleName, 24, "collateral auction limit reached for this block")
+	// ErrRedemptionsInactive error for when debt is redeemed while redemptions are not active
+	ErrRedemptionsInactive = sdkerrors.Register(ModuleName, 25, "redemptions are not active")
+	// ErrNoRedeemableCdps error for when no cdps of a collateral type can be redeemed against
+	ErrNoRedeemableCdps = sdkerrors.Register(ModuleName, 26, "no redeemable cdps")
+	// ErrLiquidationRefundNotFound error for when no liquidation refund is owed to an address
+	ErrLiquidationRefundNotFound = sdkerrors.Register(ModuleName, 27, "liquidation refund not found")
 )
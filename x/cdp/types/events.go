@@ -2,17 +2,41 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp                = "create_cdp"
+	EventTypeCdpDeposit               = "cdp_deposit"
+	EventTypeCdpTopUp                 = "cdp_top_up"
+	EventTypeCdpDraw                  = "cdp_draw"
+	EventTypeCdpRepay                 = "cdp_repayment"
+	EventTypeCdpClose                 = "cdp_close"
+	EventTypeCdpWithdrawal            = "cdp_withdrawal"
+	EventTypeCdpLiquidation           = "cdp_liquidation"
+	EventTypeCdpLiquidationSwap       = "cdp_liquidation_swap"
+	EventTypeCdpLiquidationSettlement = "cdp_liquidation_settlement"
+	EventTypeCdpLiquidationRefund     = "cdp_liquidation_refund"
+	EventTypeCdpRefundSuspended       = "cdp_liquidation_refund_suspended"
+	EventTypeCdpAuctionSettlement     = "cdp_auction_settlement"
+	EventTypeCdpInterestAccrual       = "cdp_interest_accrual"
+	EventTypeCdpRedemption            = "cdp_redemption"
+	EventTypeBeginBlockerFatal        = "cdp_begin_block_error"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDeposit    = "deposit"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID       = "cdp_id"
+	AttributeKeyDeposit     = "deposit"
+	AttributeValueCategory  = "cdp"
+	AttributeKeyError       = "error_message"
+	AttributeKeySwapInput   = "swap_input"
+	AttributeKeySwapOutput  = "swap_output"
+	AttributeKeyCollateral  = "collateral"
+	AttributeKeyDebt        = "debt"
+	AttributeKeyOwner       = "owner"
+	AttributeKeyContributor = "contributor"
+	AttributeKeyAuctionID   = "auction_id"
+	AttributeKeyProceeds    = "proceeds"
+	AttributeKeyShortfall   = "shortfall"
+	AttributeKeyRedeemer    = "redeemer"
+	AttributeKeyAttempts    = "attempts"
+
+	AttributeKeyCollateralType = "collateral_type"
+	AttributeKeyFeesAccrued    = "fees_accrued"
+	AttributeKeyInterestFactor = "interest_factor"
+	AttributeKeyTotalPrincipal = "total_principal"
 )
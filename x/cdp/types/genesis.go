@@ -18,6 +18,7 @@ type GenesisState struct {
 	GovDenom                  string                   `json:"gov_denom" yaml:"gov_denom"`
 	PreviousAccumulationTimes GenesisAccumulationTimes `json:"previous_accumulation_times" yaml:"previous_accumulation_times"`
 	TotalPrincipals           GenesisTotalPrincipals   `json:"total_principals" yaml:"total_principals"`
+	LiquidationRefunds        LiquidationRefunds       `json:"liquidation_refunds" yaml:"liquidation_refunds"`
 }
 
 // NewGenesisState returns a new genesis state
@@ -74,6 +75,10 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := gs.LiquidationRefunds.Validate(); err != nil {
+		return err
+	}
+
 	if err := sdk.ValidateDenom(gs.DebtDenom); err != nil {
 		return fmt.Errorf(fmt.Sprintf("debt denom invalid: %v", err))
 	}
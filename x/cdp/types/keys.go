@@ -45,6 +45,8 @@ var sep = []byte(":")
 // - 0x08:previousDistributionTime
 // - 0x09<marketID>:downTime
 // - 0x10:totalDistributed
+// - 0x15<collateralType>:totalCollateral
+// - 0x16:totalRefunds
 
 // KVStore key prefixes
 var (
@@ -59,6 +61,9 @@ var (
 	PricefeedStatusKeyPrefix   = []byte{0x10}
 	PreviousAccrualTimePrefix  = []byte{0x12}
 	InterestFactorPrefix       = []byte{0x13}
+	LiquidationRefundPrefix    = []byte{0x14}
+	TotalCollateralKeyPrefix   = []byte{0x15}
+	TotalRefundsKey            = []byte{0x16}
 )
 
 // GetCdpIDBytes returns the byte representation of the cdpID
@@ -167,6 +172,11 @@ func SplitCollateralRatioIterKey(key []byte) (denom byte, ratio sdk.Dec) {
 	return
 }
 
+// LiquidationRefundKey returns the key of the liquidation refund owed to an owner
+func LiquidationRefundKey(owner sdk.AccAddress) []byte {
+	return owner.Bytes()
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem is the subsystem of all metrics exposed by the cdp module
+const MetricsSubsystem = ModuleName
+
+// Metrics contains the metrics exposed by the cdp module
+type Metrics struct {
+	// Number of liquidated cdps, labeled by collateral type
+	Liquidations metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics registered with the default Prometheus registry
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		Liquidations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "liquidations",
+			Help:      "Number of liquidated cdps.",
+		}, []string{"collateral_type"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Liquidations: discard.NewCounter(),
+	}
+}
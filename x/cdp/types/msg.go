@@ -17,6 +17,9 @@ var (
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
 	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgTopUpCollateral{}
+	_ sdk.Msg = &MsgRedeemDebt{}
+	_ sdk.Msg = &MsgClaimLiquidationRefund{}
 )
 
 // MsgCreateCDP creates a cdp
@@ -371,3 +374,166 @@ func (msg MsgLiquidate) String() string {
 	Collateral Type %s
 `, msg.Keeper, msg.Borrower, msg.CollateralType)
 }
+
+// MsgTopUpCollateral adds collateral to another account's cdp. The collateral is credited to the owner's deposit,
+// so the contributor cannot withdraw it.
+type MsgTopUpCollateral struct {
+	Contributor    sdk.AccAddress `json:"contributor" yaml:"contributor"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Collateral     sdk.Coin       `json:"collateral" yaml:"collateral"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewMsgTopUpCollateral returns a new MsgTopUpCollateral
+func NewMsgTopUpCollateral(contributor, owner sdk.AccAddress, collateral sdk.Coin, collateralType string) MsgTopUpCollateral {
+	return MsgTopUpCollateral{
+		Contributor:    contributor,
+		Owner:          owner,
+		Collateral:     collateral,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgTopUpCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgTopUpCollateral) Type() string { return "top_up_cdp" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgTopUpCollateral) ValidateBasic() error {
+	if msg.Contributor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "contributor address cannot be empty")
+	}
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	if !msg.Collateral.IsValid() || msg.Collateral.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "collateral amount %s", msg.Collateral)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return sdkerrors.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgTopUpCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgTopUpCollateral) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Contributor}
+}
+
+// String implements the Stringer interface
+func (msg MsgTopUpCollateral) String() string {
+	return fmt.Sprintf(`Top Up CDP Message:
+	Contributor:     %s
+	Owner:           %s
+	Collateral:      %s
+	Collateral Type: %s
+`, msg.Contributor, msg.Owner, msg.Collateral, msg.CollateralType)
+}
+
+// MsgRedeemDebt repays the debt of the most collateralized cdps of a collateral type in exchange for their collateral
+type MsgRedeemDebt struct {
+	Redeemer       sdk.AccAddress `json:"redeemer" yaml:"redeemer"`
+	Amount         sdk.Coin       `json:"amount" yaml:"amount"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+}
+
+// NewMsgRedeemDebt returns a new MsgRedeemDebt
+func NewMsgRedeemDebt(redeemer sdk.AccAddress, amount sdk.Coin, collateralType string) MsgRedeemDebt {
+	return MsgRedeemDebt{
+		Redeemer:       redeemer,
+		Amount:         amount,
+		CollateralType: collateralType,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemDebt) Type() string { return "redeem_debt" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemDebt) ValidateBasic() error {
+	if msg.Redeemer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "redeemer address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "redemption amount %s", msg.Amount)
+	}
+	if strings.TrimSpace(msg.CollateralType) == "" {
+		return sdkerrors.Wrap(ErrInvalidCollateral, "collateral type cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemDebt) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Redeemer}
+}
+
+// String implements the Stringer interface
+func (msg MsgRedeemDebt) String() string {
+	return fmt.Sprintf(`Redeem Debt Message:
+	Redeemer:        %s
+	Amount:          %s
+	Collateral Type: %s
+`, msg.Redeemer, msg.Amount, msg.CollateralType)
+}
+
+// MsgClaimLiquidationRefund pays out the liquidation refund owed to the owner
+type MsgClaimLiquidationRefund struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewMsgClaimLiquidationRefund returns a new MsgClaimLiquidationRefund
+func NewMsgClaimLiquidationRefund(owner sdk.AccAddress) MsgClaimLiquidationRefund {
+	return MsgClaimLiquidationRefund{
+		Owner: owner,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgClaimLiquidationRefund) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgClaimLiquidationRefund) Type() string { return "claim_liquidation_refund" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgClaimLiquidationRefund) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgClaimLiquidationRefund) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgClaimLiquidationRefund) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// String implements the Stringer interface
+func (msg MsgClaimLiquidationRefund) String() string {
+	return fmt.Sprintf(`Claim Liquidation Refund Message:
+	Owner: %s
+`, msg.Owner)
+}
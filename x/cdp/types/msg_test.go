@@ -80,6 +80,37 @@ func TestMsgDeposit(t *testing.T) {
 	}
 }
 
+func TestMsgTopUpCollateral(t *testing.T) {
+	tests := []struct {
+		description    string
+		contributor    sdk.AccAddress
+		owner          sdk.AccAddress
+		collateral     sdk.Coin
+		collateralType string
+		expectPass     bool
+	}{
+		{"top up", addrs[1], addrs[0], coinsSingle, "type-a", true},
+		{"top up no collateral", addrs[1], addrs[0], coinsZero, "type-a", false},
+		{"top up empty contributor", sdk.AccAddress{}, addrs[0], coinsSingle, "type-a", false},
+		{"top up empty owner", addrs[1], sdk.AccAddress{}, coinsSingle, "type-a", false},
+		{"top up empty type", addrs[1], addrs[0], coinsSingle, "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgTopUpCollateral(
+			tc.contributor,
+			tc.owner,
+			tc.collateral,
+			tc.collateralType,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
 func TestMsgWithdraw(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -167,3 +198,47 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRedeemDebt(t *testing.T) {
+	tests := []struct {
+		description    string
+		redeemer       sdk.AccAddress
+		amount         sdk.Coin
+		collateralType string
+		expectPass     bool
+	}{
+		{"redeem debt", addrs[0], coinsSingle, "bnb-a", true},
+		{"redeem debt no amount", addrs[0], coinsZero, "bnb-a", false},
+		{"redeem debt empty redeemer", sdk.AccAddress{}, coinsSingle, "bnb-a", false},
+		{"redeem debt empty collateral type", addrs[0], coinsSingle, "", false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgRedeemDebt(tc.redeemer, tc.amount, tc.collateralType)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
+
+func TestMsgClaimLiquidationRefund(t *testing.T) {
+	tests := []struct {
+		description string
+		owner       sdk.AccAddress
+		expectPass  bool
+	}{
+		{"claim liquidation refund", addrs[0], true},
+		{"claim liquidation refund empty owner", sdk.AccAddress{}, false},
+	}
+
+	for _, tc := range tests {
+		msg := NewMsgClaimLiquidationRefund(tc.owner)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", tc.description)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", tc.description)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -19,6 +20,8 @@ var (
 	KeyDebtLot              = []byte("DebtLot")
 	KeySurplusThreshold     = []byte("SurplusThreshold")
 	KeySurplusLot           = []byte("SurplusLot")
+	KeySwapLiquidations     = []byte("SwapLiquidations")
+	KeyRedemption           = []byte("Redemption")
 	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker   = false
 	DefaultCollateralParams = CollateralParams{}
@@ -36,6 +39,7 @@ var (
 	DefaultDebtThreshold    = sdk.NewInt(100000000000)
 	DefaultSurplusLot       = sdk.NewInt(10000000000)
 	DefaultDebtLot          = sdk.NewInt(10000000000)
+	DefaultRedemption       = NewRedemptionParam(false, sdk.MustNewDecFromStr("0.005"))
 	minCollateralPrefix     = 0
 	maxCollateralPrefix     = 255
 	stabilityFeeMax         = sdk.MustNewDecFromStr("1.000000051034942716") // 500% APR
@@ -51,6 +55,8 @@ type Params struct {
 	DebtAuctionThreshold    sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
 	DebtAuctionLot          sdk.Int          `json:"debt_auction_lot" yaml:"debt_auction_lot"`
 	CircuitBreaker          bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	SwapLiquidations        SwapLiquidations `json:"swap_liquidations" yaml:"swap_liquidations"`
+	Redemption              RedemptionParam  `json:"redemption" yaml:"redemption"`
 }
 
 // String implements fmt.Stringer
@@ -63,9 +69,11 @@ func (p Params) String() string {
 	Surplus Auction Lot: %s
 	Debt Auction Threshold: %s
 	Debt Auction Lot: %s
-	Circuit Breaker: %t`,
+	Circuit Breaker: %t
+	Swap Liquidations: %s
+	Redemption: %s`,
 		p.GlobalDebtLimit, p.CollateralParams, p.DebtParam, p.SurplusAuctionThreshold, p.SurplusAuctionLot,
-		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker,
+		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker, p.SwapLiquidations, p.Redemption,
 	)
 }
 
@@ -83,6 +91,7 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		Redemption:              DefaultRedemption,
 	}
 }
 
@@ -97,19 +106,22 @@ func DefaultParams() Params {
 
 // CollateralParam governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
-	Denom                            string   `json:"denom" yaml:"denom"` // Coin name of collateral type
-	Type                             string   `json:"type" yaml:"type"`
-	LiquidationRatio                 sdk.Dec  `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
-	DebtLimit                        sdk.Coin `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
-	StabilityFee                     sdk.Dec  `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
-	AuctionSize                      sdk.Int  `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
-	LiquidationPenalty               sdk.Dec  `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
-	Prefix                           byte     `json:"prefix" yaml:"prefix"`
-	SpotMarketID                     string   `json:"spot_market_id" yaml:"spot_market_id"`                                           // marketID of the spot price of the asset from the pricefeed - used for opening CDPs, depositing, withdrawing
-	LiquidationMarketID              string   `json:"liquidation_market_id" yaml:"liquidation_market_id"`                             // marketID of the pricefeed used for liquidation
-	KeeperRewardPercentage           sdk.Dec  `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`                       // the percentage of a CDPs collateral that gets rewarded to a keeper that liquidates the position
-	CheckCollateralizationIndexCount sdk.Int  `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"` // the number of cdps that will be checked for liquidation in the begin blocker
-	ConversionFactor                 sdk.Int  `json:"conversion_factor" yaml:"conversion_factor"`                                     // factor for converting internal units to one base unit of collateral
+	Denom                            string    `json:"denom" yaml:"denom"` // Coin name of collateral type
+	Type                             string    `json:"type" yaml:"type"`
+	LiquidationRatio                 sdk.Dec   `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
+	DebtLimit                        sdk.Coin  `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
+	StabilityFee                     sdk.Dec   `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
+	AuctionSize                      sdk.Int   `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
+	LiquidationPenalty               sdk.Dec   `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
+	Prefix                           byte      `json:"prefix" yaml:"prefix"`
+	SpotMarketID                     string    `json:"spot_market_id" yaml:"spot_market_id"`                                           // marketID of the spot price of the asset from the pricefeed - used for opening CDPs, depositing, withdrawing
+	LiquidationMarketID              string    `json:"liquidation_market_id" yaml:"liquidation_market_id"`                             // marketID of the pricefeed used for liquidation
+	KeeperRewardPercentage           sdk.Dec   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`                       // the percentage of a CDPs collateral that gets rewarded to a keeper that liquidates the position
+	CheckCollateralizationIndexCount sdk.Int   `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"` // the number of cdps that will be checked for liquidation in the begin blocker
+	ConversionFactor                 sdk.Int   `json:"conversion_factor" yaml:"conversion_factor"`                                     // factor for converting internal units to one base unit of collateral
+	AuctionThreshold                 sdk.Int   `json:"auction_threshold" yaml:"auction_threshold"`                                     // liquidated cdps with less collateral than this are settled without an auction, zero to always auction
+	LiquidationRatioRamp             ParamRamp `json:"liquidation_ratio_ramp" yaml:"liquidation_ratio_ramp"`                           // schedules a gradual change to the liquidation ratio
+	StabilityFeeRamp                 ParamRamp `json:"stability_fee_ramp" yaml:"stability_fee_ramp"`                                   // schedules a gradual change to the stability fee
 }
 
 // NewCollateralParam returns a new CollateralParam
@@ -130,9 +142,28 @@ func NewCollateralParam(
 		KeeperRewardPercentage:           keeperReward,
 		CheckCollateralizationIndexCount: checkIndexCount,
 		ConversionFactor:                 conversionFactor,
+		AuctionThreshold:                 sdk.ZeroInt(),
 	}
 }
 
+// GetAuctionThreshold returns the auction threshold, treating an unset threshold as zero
+func (cp CollateralParam) GetAuctionThreshold() sdk.Int {
+	if cp.AuctionThreshold.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return cp.AuctionThreshold
+}
+
+// GetLiquidationRatio returns the liquidation ratio in effect at the input block height
+func (cp CollateralParam) GetLiquidationRatio(height int64) sdk.Dec {
+	return cp.LiquidationRatioRamp.ValueAt(cp.LiquidationRatio, height)
+}
+
+// GetStabilityFee returns the stability fee in effect at the input block height
+func (cp CollateralParam) GetStabilityFee(height int64) sdk.Dec {
+	return cp.StabilityFeeRamp.ValueAt(cp.StabilityFee, height)
+}
+
 // String implements fmt.Stringer
 func (cp CollateralParam) String() string {
 	return fmt.Sprintf(`Collateral:
@@ -148,10 +179,88 @@ func (cp CollateralParam) String() string {
 	Liquidation Market ID: %s
 	Keeper Reward Percentage: %s
 	Check Collateralization Count: %s
-	Conversion Factor: %s`,
+	Conversion Factor: %s
+	Auction Threshold: %s
+	Liquidation Ratio Ramp: %s
+	Stability Fee Ramp: %s`,
 		cp.Denom, cp.Type, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty,
 		cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.SpotMarketID, cp.LiquidationMarketID,
-		cp.KeeperRewardPercentage, cp.CheckCollateralizationIndexCount, cp.ConversionFactor)
+		cp.KeeperRewardPercentage, cp.CheckCollateralizationIndexCount, cp.ConversionFactor, cp.GetAuctionThreshold(),
+		cp.LiquidationRatioRamp, cp.StabilityFeeRamp)
+}
+
+// ParamRamp schedules a linear change to a collateral parameter, so that tightening risk parameters does not liquidate
+// every cdp near the old limit in the same block. The parameter moves from InitialValue to the value set in params over
+// Blocks blocks, starting at StartHeight. A ramp of zero blocks is unset.
+type ParamRamp struct {
+	InitialValue sdk.Dec `json:"initial_value" yaml:"initial_value"`
+	StartHeight  int64   `json:"start_height" yaml:"start_height"`
+	Blocks       int64   `json:"blocks" yaml:"blocks"`
+}
+
+// NewParamRamp returns a new ParamRamp
+func NewParamRamp(initialValue sdk.Dec, startHeight, blocks int64) ParamRamp {
+	return ParamRamp{
+		InitialValue: initialValue,
+		StartHeight:  startHeight,
+		Blocks:       blocks,
+	}
+}
+
+// IsSet returns true if the ramp schedules a change
+func (r ParamRamp) IsSet() bool {
+	return r.Blocks != 0
+}
+
+// ValueAt returns the value of a parameter at the input block height, linearly interpolated from the ramp's initial value
+// to the target value. The initial value applies up to the start height and the target from the end of the ramp.
+func (r ParamRamp) ValueAt(target sdk.Dec, height int64) sdk.Dec {
+	if !r.IsSet() || height >= r.StartHeight+r.Blocks {
+		return target
+	}
+	if height <= r.StartHeight {
+		return r.InitialValue
+	}
+	return r.InitialValue.Add(target.Sub(r.InitialValue).MulInt64(height - r.StartHeight).QuoInt64(r.Blocks))
+}
+
+// Validate performs basic validation of a ramp
+func (r ParamRamp) Validate() error {
+	if !r.IsSet() {
+		return nil
+	}
+	if r.Blocks < 0 {
+		return fmt.Errorf("ramp blocks cannot be negative: %d", r.Blocks)
+	}
+	if r.StartHeight <= 0 {
+		return fmt.Errorf("ramp start height must be positive: %d", r.StartHeight)
+	}
+	if r.InitialValue.IsNil() {
+		return errors.New("ramp initial value cannot be nil")
+	}
+	return nil
+}
+
+// Equal returns true if two ramps are equal, treating all unset ramps as equal
+func (r ParamRamp) Equal(other ParamRamp) bool {
+	if !r.IsSet() || !other.IsSet() {
+		return r.IsSet() == other.IsSet()
+	}
+	if r.StartHeight != other.StartHeight || r.Blocks != other.Blocks {
+		return false
+	}
+	if r.InitialValue.IsNil() || other.InitialValue.IsNil() {
+		return r.InitialValue.IsNil() == other.InitialValue.IsNil()
+	}
+	return r.InitialValue.Equal(other.InitialValue)
+}
+
+// String implements fmt.Stringer
+func (r ParamRamp) String() string {
+	if !r.IsSet() {
+		return "none"
+	}
+	return fmt.Sprintf("from %s over %d blocks starting at height %d", r.InitialValue, r.Blocks, r.StartHeight)
 }
 
 // CollateralParams array of CollateralParam
@@ -205,6 +314,103 @@ func (dps DebtParams) String() string {
 	return out
 }
 
+// SwapLiquidation governance parameters for selling liquidated collateral through the swap module.
+// Liquidated deposits of the collateral type that are no larger than MaxLotSize are sold directly into
+// the collateral:principal swap pool instead of being auctioned, provided the sale can be made within
+// MaxSlippage of the liquidation market price. Deposits that cannot be sold fall back to auctions.
+type SwapLiquidation struct {
+	CollateralType string  `json:"collateral_type" yaml:"collateral_type"`
+	MaxLotSize     sdk.Int `json:"max_lot_size" yaml:"max_lot_size"` // largest deposit (in collateral units) that is sold through the swap module
+	MaxSlippage    sdk.Dec `json:"max_slippage" yaml:"max_slippage"` // maximum slippage from the liquidation market price, between [0, 1)
+}
+
+// NewSwapLiquidation returns a new SwapLiquidation
+func NewSwapLiquidation(collateralType string, maxLotSize sdk.Int, maxSlippage sdk.Dec) SwapLiquidation {
+	return SwapLiquidation{
+		CollateralType: collateralType,
+		MaxLotSize:     maxLotSize,
+		MaxSlippage:    maxSlippage,
+	}
+}
+
+// String implements fmt.Stringer
+func (sl SwapLiquidation) String() string {
+	return fmt.Sprintf(`Swap Liquidation:
+	Collateral Type: %s
+	Max Lot Size: %s
+	Max Slippage: %s`,
+		sl.CollateralType, sl.MaxLotSize, sl.MaxSlippage)
+}
+
+// Validate performs a basic validation of swap liquidation parameters
+func (sl SwapLiquidation) Validate() error {
+	if strings.TrimSpace(sl.CollateralType) == "" {
+		return fmt.Errorf("swap liquidation collateral type cannot be blank %s", sl)
+	}
+	if sl.MaxLotSize.IsNil() || !sl.MaxLotSize.IsPositive() {
+		return fmt.Errorf("swap liquidation max lot size should be positive, is %s for %s", sl.MaxLotSize, sl.CollateralType)
+	}
+	if sl.MaxSlippage.IsNil() || sl.MaxSlippage.IsNegative() || sl.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("swap liquidation max slippage should be between 0 and 1, is %s for %s", sl.MaxSlippage, sl.CollateralType)
+	}
+	return nil
+}
+
+// SwapLiquidations array of SwapLiquidation
+type SwapLiquidations []SwapLiquidation
+
+// String implements fmt.Stringer
+func (sls SwapLiquidations) String() string {
+	out := "Swap Liquidations\n"
+	for _, sl := range sls {
+		out += fmt.Sprintf("%s\n", sl)
+	}
+	return out
+}
+
+// RedemptionParam governance parameters for redeeming debt. While redemptions are active, holders of the debt denom
+// can repay the most collateralized cdps of a collateral type in exchange for their collateral at the spot price less
+// the fee, which sets a floor under the price of the debt denom when it trades below its reference asset.
+type RedemptionParam struct {
+	Active bool    `json:"active" yaml:"active"`
+	Fee    sdk.Dec `json:"fee" yaml:"fee"` // share of the redeemed value left with the redeemed cdps, between [0, 1)
+}
+
+// NewRedemptionParam returns a new RedemptionParam
+func NewRedemptionParam(active bool, fee sdk.Dec) RedemptionParam {
+	return RedemptionParam{
+		Active: active,
+		Fee:    fee,
+	}
+}
+
+// GetFee returns the redemption fee, treating an unset fee as zero
+func (rp RedemptionParam) GetFee() sdk.Dec {
+	if rp.Fee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return rp.Fee
+}
+
+// String implements fmt.Stringer
+func (rp RedemptionParam) String() string {
+	return fmt.Sprintf(`Redemption:
+	Active: %t
+	Fee: %s`,
+		rp.Active, rp.GetFee())
+}
+
+// Validate performs a basic validation of redemption parameters
+func (rp RedemptionParam) Validate() error {
+	if rp.Fee.IsNil() {
+		return nil
+	}
+	if rp.Fee.IsNegative() || rp.Fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("redemption fee should be between 0 and 1, is %s", rp.Fee)
+	}
+	return nil
+}
+
 // ParamKeyTable Key declaration for parameters
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -223,6 +429,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		params.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		params.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		params.NewParamSetPair(KeySwapLiquidations, &p.SwapLiquidations, validateSwapLiquidationsParam),
+		params.NewParamSetPair(KeyRedemption, &p.Redemption, validateRedemptionParam),
 	}
 }
 
@@ -260,6 +468,24 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSwapLiquidationsParam(p.SwapLiquidations); err != nil {
+		return err
+	}
+
+	if err := validateRedemptionParam(p.Redemption); err != nil {
+		return err
+	}
+
+	collateralTypes := make(map[string]bool)
+	for _, cp := range p.CollateralParams {
+		collateralTypes[cp.Type] = true
+	}
+	for _, sl := range p.SwapLiquidations {
+		if !collateralTypes[sl.CollateralType] {
+			return fmt.Errorf("swap liquidation collateral type %s not found in collateral params", sl.CollateralType)
+		}
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -371,12 +597,27 @@ func validateCollateralParams(i interface{}) error {
 		if cp.StabilityFee.LT(sdk.OneDec()) || cp.StabilityFee.GT(stabilityFeeMax) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFee, cp.Denom)
 		}
+		if err := cp.LiquidationRatioRamp.Validate(); err != nil {
+			return fmt.Errorf("invalid liquidation ratio ramp for %s: %w", cp.Type, err)
+		}
+		if cp.LiquidationRatioRamp.IsSet() && !cp.LiquidationRatioRamp.InitialValue.IsPositive() {
+			return fmt.Errorf("liquidation ratio ramp initial value should be positive, is %s for %s", cp.LiquidationRatioRamp.InitialValue, cp.Type)
+		}
+		if err := cp.StabilityFeeRamp.Validate(); err != nil {
+			return fmt.Errorf("invalid stability fee ramp for %s: %w", cp.Type, err)
+		}
+		if cp.StabilityFeeRamp.IsSet() && (cp.StabilityFeeRamp.InitialValue.LT(sdk.OneDec()) || cp.StabilityFeeRamp.InitialValue.GT(stabilityFeeMax)) {
+			return fmt.Errorf("stability fee ramp initial value must be ≥ 1.0, ≤ %s, is %s for %s", stabilityFeeMax, cp.StabilityFeeRamp.InitialValue, cp.Type)
+		}
 		if cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(sdk.OneDec()) {
 			return fmt.Errorf("keeper reward percentage should be between 0 and 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
 		}
 		if cp.CheckCollateralizationIndexCount.IsNegative() {
 			return fmt.Errorf("keeper reward percentage should be positive, is %s for %s", cp.CheckCollateralizationIndexCount, cp.Denom)
 		}
+		if cp.GetAuctionThreshold().IsNegative() {
+			return fmt.Errorf("auction threshold should not be negative, is %s for %s", cp.AuctionThreshold, cp.Denom)
+		}
 	}
 
 	return nil
@@ -454,3 +695,32 @@ func validateDebtAuctionLotParam(i interface{}) error {
 
 	return nil
 }
+
+func validateSwapLiquidationsParam(i interface{}) error {
+	swapLiquidations, ok := i.(SwapLiquidations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	typeDupMap := make(map[string]bool)
+	for _, sl := range swapLiquidations {
+		if err := sl.Validate(); err != nil {
+			return err
+		}
+		if typeDupMap[sl.CollateralType] {
+			return fmt.Errorf("duplicate swap liquidation collateral type: %s", sl.CollateralType)
+		}
+		typeDupMap[sl.CollateralType] = true
+	}
+
+	return nil
+}
+
+func validateRedemptionParam(i interface{}) error {
+	redemption, ok := i.(RedemptionParam)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return redemption.Validate()
+}
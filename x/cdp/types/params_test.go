@@ -715,6 +715,45 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				contains:   "stability fee must be ≥ 1.0",
 			},
 		},
+		{
+			name: "invalid collateral params negative auction threshold",
+			args: args{
+				globalDebtLimit: sdk.NewInt64Coin("usdx", 2000000000000),
+				collateralParams: types.CollateralParams{
+					{
+						Denom:                            "bnb",
+						Type:                             "bnb-a",
+						LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+						DebtLimit:                        sdk.NewInt64Coin("usdx", 1000000000000),
+						StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+						LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+						AuctionSize:                      sdk.NewInt(50000000000),
+						Prefix:                           0x20,
+						SpotMarketID:                     "bnb:usd",
+						LiquidationMarketID:              "bnb:usd",
+						KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+						ConversionFactor:                 sdk.NewInt(8),
+						CheckCollateralizationIndexCount: sdk.NewInt(10),
+						AuctionThreshold:                 sdk.NewInt(-1),
+					},
+				},
+				debtParam: types.DebtParam{
+					Denom:            "usdx",
+					ReferenceAsset:   "usd",
+					ConversionFactor: sdk.NewInt(6),
+					DebtFloor:        sdk.NewInt(10000000),
+				},
+				surplusThreshold: types.DefaultSurplusThreshold,
+				surplusLot:       types.DefaultSurplusLot,
+				debtThreshold:    types.DefaultDebtThreshold,
+				debtLot:          types.DefaultDebtLot,
+				breaker:          types.DefaultCircuitBreaker,
+			},
+			errArgs: errArgs{
+				expectPass: false,
+				contains:   "auction threshold should not be negative",
+			},
+		},
 		{
 			name: "invalid debt param empty denom",
 			args: args{
@@ -853,6 +892,202 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestSwapLiquidationValidation() {
+	collateralParams := types.CollateralParams{
+		{
+			Denom:                            "bnb",
+			Type:                             "bnb-a",
+			LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+			DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+			StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+			LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+			AuctionSize:                      sdk.NewInt(50000000000),
+			Prefix:                           0x20,
+			SpotMarketID:                     "bnb:usd",
+			LiquidationMarketID:              "bnb:usd",
+			KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+			ConversionFactor:                 sdk.NewInt(8),
+			CheckCollateralizationIndexCount: sdk.NewInt(10),
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		swapLiquidations types.SwapLiquidations
+		expectPass       bool
+		contains         string
+	}{
+		{
+			name:             "valid",
+			swapLiquidations: types.SwapLiquidations{types.NewSwapLiquidation("bnb-a", sdk.NewInt(1000000000), sdk.MustNewDecFromStr("0.05"))},
+			expectPass:       true,
+		},
+		{
+			name:             "unknown collateral type",
+			swapLiquidations: types.SwapLiquidations{types.NewSwapLiquidation("xrp-a", sdk.NewInt(1000000000), sdk.MustNewDecFromStr("0.05"))},
+			expectPass:       false,
+			contains:         "not found in collateral params",
+		},
+		{
+			name: "duplicate collateral type",
+			swapLiquidations: types.SwapLiquidations{
+				types.NewSwapLiquidation("bnb-a", sdk.NewInt(1000000000), sdk.MustNewDecFromStr("0.05")),
+				types.NewSwapLiquidation("bnb-a", sdk.NewInt(2000000000), sdk.MustNewDecFromStr("0.05")),
+			},
+			expectPass: false,
+			contains:   "duplicate swap liquidation collateral type",
+		},
+		{
+			name:             "zero max lot size",
+			swapLiquidations: types.SwapLiquidations{types.NewSwapLiquidation("bnb-a", sdk.ZeroInt(), sdk.MustNewDecFromStr("0.05"))},
+			expectPass:       false,
+			contains:         "max lot size should be positive",
+		},
+		{
+			name:             "max slippage out of range",
+			swapLiquidations: types.SwapLiquidations{types.NewSwapLiquidation("bnb-a", sdk.NewInt(1000000000), sdk.OneDec())},
+			expectPass:       false,
+			contains:         "max slippage should be between 0 and 1",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(sdk.NewInt64Coin("usdx", 4000000000000), collateralParams, types.DefaultDebtParam, types.DefaultSurplusThreshold,
+				types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, types.DefaultCircuitBreaker)
+			params.SwapLiquidations = tc.swapLiquidations
+			err := params.Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.contains))
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamRamp() {
+	ramp := types.NewParamRamp(sdk.MustNewDecFromStr("1.5"), 100, 10)
+	target := sdk.MustNewDecFromStr("2.0")
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.5"), ramp.ValueAt(target, 50))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.5"), ramp.ValueAt(target, 100))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.65"), ramp.ValueAt(target, 103))
+	suite.Require().Equal(target, ramp.ValueAt(target, 110))
+	suite.Require().Equal(target, types.ParamRamp{}.ValueAt(target, 50))
+	suite.Require().True(types.ParamRamp{}.Equal(types.NewParamRamp(sdk.Dec{}, 0, 0)))
+	suite.Require().False(types.ParamRamp{}.Equal(ramp))
+
+	collateralParam := types.CollateralParam{
+		Denom:                            "bnb",
+		Type:                             "bnb-a",
+		LiquidationRatio:                 sdk.MustNewDecFromStr("1.5"),
+		DebtLimit:                        sdk.NewInt64Coin("usdx", 2000000000000),
+		StabilityFee:                     sdk.MustNewDecFromStr("1.000000001547125958"),
+		LiquidationPenalty:               sdk.MustNewDecFromStr("0.05"),
+		AuctionSize:                      sdk.NewInt(50000000000),
+		Prefix:                           0x20,
+		SpotMarketID:                     "bnb:usd",
+		LiquidationMarketID:              "bnb:usd",
+		KeeperRewardPercentage:           sdk.MustNewDecFromStr("0.01"),
+		ConversionFactor:                 sdk.NewInt(8),
+		CheckCollateralizationIndexCount: sdk.NewInt(10),
+	}
+
+	testCases := []struct {
+		name                 string
+		liquidationRatioRamp types.ParamRamp
+		stabilityFeeRamp     types.ParamRamp
+		expectPass           bool
+		contains             string
+	}{
+		{
+			name:       "no ramps",
+			expectPass: true,
+		},
+		{
+			name:                 "valid ramps",
+			liquidationRatioRamp: types.NewParamRamp(sdk.MustNewDecFromStr("1.25"), 100, 14400),
+			stabilityFeeRamp:     types.NewParamRamp(sdk.OneDec(), 100, 14400),
+			expectPass:           true,
+		},
+		{
+			name:                 "negative blocks",
+			liquidationRatioRamp: types.NewParamRamp(sdk.MustNewDecFromStr("1.25"), 100, -1),
+			expectPass:           false,
+			contains:             "ramp blocks cannot be negative",
+		},
+		{
+			name:                 "zero start height",
+			liquidationRatioRamp: types.NewParamRamp(sdk.MustNewDecFromStr("1.25"), 0, 14400),
+			expectPass:           false,
+			contains:             "ramp start height must be positive",
+		},
+		{
+			name:                 "nil initial value",
+			liquidationRatioRamp: types.NewParamRamp(sdk.Dec{}, 100, 14400),
+			expectPass:           false,
+			contains:             "ramp initial value cannot be nil",
+		},
+		{
+			name:                 "zero initial liquidation ratio",
+			liquidationRatioRamp: types.NewParamRamp(sdk.ZeroDec(), 100, 14400),
+			expectPass:           false,
+			contains:             "liquidation ratio ramp initial value should be positive",
+		},
+		{
+			name:             "initial stability fee below one",
+			stabilityFeeRamp: types.NewParamRamp(sdk.MustNewDecFromStr("0.9"), 100, 14400),
+			expectPass:       false,
+			contains:         "stability fee ramp initial value must be ≥ 1.0",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cp := collateralParam
+			cp.LiquidationRatioRamp = tc.liquidationRatioRamp
+			cp.StabilityFeeRamp = tc.stabilityFeeRamp
+			params := types.NewParams(sdk.NewInt64Coin("usdx", 4000000000000), types.CollateralParams{cp}, types.DefaultDebtParam, types.DefaultSurplusThreshold,
+				types.DefaultSurplusLot, types.DefaultDebtThreshold, types.DefaultDebtLot, types.DefaultCircuitBreaker)
+			err := params.Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.contains))
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestRedemptionValidation() {
+	testCases := []struct {
+		name       string
+		redemption types.RedemptionParam
+		expectPass bool
+	}{
+		{"default", types.DefaultRedemption, true},
+		{"active", types.NewRedemptionParam(true, sdk.MustNewDecFromStr("0.01")), true},
+		{"zero fee", types.NewRedemptionParam(true, sdk.ZeroDec()), true},
+		{"unset fee", types.RedemptionParam{}, true},
+		{"negative fee", types.NewRedemptionParam(true, sdk.MustNewDecFromStr("-0.01")), false},
+		{"fee of one", types.NewRedemptionParam(true, sdk.OneDec()), false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.Redemption = tc.redemption
+			err := params.Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), "redemption fee")
+			}
+		})
+	}
+	suite.Require().Equal(sdk.ZeroDec(), types.RedemptionParam{}.GetFee())
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
@@ -13,9 +13,16 @@ const (
 	QueryGetCdpsByCollateralType    = "collateralType" // legacy query, maintained for REST API
 	QueryGetParams                  = "params"
 	QueryGetAccounts                = "accounts"
+	QueryGetSimulatedCdp            = "simulate"
+	QueryGetLiquidationRefunds      = "liquidation-refunds"
+	QueryGetCdpsByRatioRange        = "ratio-range"
+	QueryGetTotals                  = "totals"
 	RestOwner                       = "owner"
 	RestCollateralType              = "collateral-type"
 	RestRatio                       = "ratio"
+	RestMinRatio                    = "min-ratio"
+	RestMaxRatio                    = "max-ratio"
+	RestDescending                  = "descending"
 )
 
 // QueryCdpParams params for query /cdp/cdp
@@ -93,3 +100,55 @@ func NewQueryCdpsByRatioParams(collateralType string, ratio sdk.Dec) QueryCdpsBy
 		Ratio:          ratio,
 	}
 }
+
+// QueryCdpsByRatioRangeParams params for query /cdp/cdps/ratio-range
+type QueryCdpsByRatioRangeParams struct {
+	Page           int     `json:"page" yaml:"page"`
+	Limit          int     `json:"limit" yaml:"limit"`
+	CollateralType string  `json:"collateral_type" yaml:"collateral_type"`
+	MinRatio       sdk.Dec `json:"min_ratio" yaml:"min_ratio"`   // get CDPs at or above this collateralization ratio
+	MaxRatio       sdk.Dec `json:"max_ratio" yaml:"max_ratio"`   // get CDPs below this collateralization ratio, unbounded if zero
+	Descending     bool    `json:"descending" yaml:"descending"` // sort CDPs from the highest ratio to the lowest
+}
+
+// NewQueryCdpsByRatioRangeParams returns QueryCdpsByRatioRangeParams
+func NewQueryCdpsByRatioRangeParams(page, limit int, collateralType string, minRatio, maxRatio sdk.Dec, descending bool) QueryCdpsByRatioRangeParams {
+	return QueryCdpsByRatioRangeParams{
+		Page:           page,
+		Limit:          limit,
+		CollateralType: collateralType,
+		MinRatio:       minRatio,
+		MaxRatio:       maxRatio,
+		Descending:     descending,
+	}
+}
+
+// QuerySimulatedCdpParams params for query /cdp/simulate
+type QuerySimulatedCdpParams struct {
+	CollateralType   string  `json:"collateral_type" yaml:"collateral_type"`
+	ID               uint64  `json:"id" yaml:"id"`
+	CollateralChange sdk.Int `json:"collateral_change" yaml:"collateral_change"` // positive to deposit, negative to withdraw
+	PrincipalChange  sdk.Int `json:"principal_change" yaml:"principal_change"`   // positive to draw, negative to repay
+}
+
+// NewQuerySimulatedCdpParams returns QuerySimulatedCdpParams
+func NewQuerySimulatedCdpParams(collateralType string, id uint64, collateralChange, principalChange sdk.Int) QuerySimulatedCdpParams {
+	return QuerySimulatedCdpParams{
+		CollateralType:   collateralType,
+		ID:               id,
+		CollateralChange: collateralChange,
+		PrincipalChange:  principalChange,
+	}
+}
+
+// QueryLiquidationRefundsParams params for query /cdp/liquidation-refunds
+type QueryLiquidationRefundsParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"` // optional, returns all refunds if empty
+}
+
+// NewQueryLiquidationRefundsParams returns QueryLiquidationRefundsParams
+func NewQueryLiquidationRefundsParams(owner sdk.AccAddress) QueryLiquidationRefundsParams {
+	return QueryLiquidationRefundsParams{
+		Owner: owner,
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxLiquidationRefundsPerBlock is the most liquidation refund payouts attempted in a block
+	MaxLiquidationRefundsPerBlock = 100
+	// MaxLiquidationRefundAttempts is the number of failed payouts after which a liquidation refund is no longer paid out
+	// at the end of the block, and is kept until its owner claims it
+	MaxLiquidationRefundAttempts = 10
+)
+
+// LiquidationRefund is surplus from a liquidation that is owed back to the owner of the liquidated deposit.
+// Refunds are held by the liquidator module account until they are paid out at the end of the block or claimed by the
+// owner.
+type LiquidationRefund struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+	Attempts uint64         `json:"attempts" yaml:"attempts"`
+}
+
+// NewLiquidationRefund returns a new LiquidationRefund
+func NewLiquidationRefund(owner sdk.AccAddress, amount sdk.Coins) LiquidationRefund {
+	return LiquidationRefund{
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of liquidation refund fields.
+func (lr LiquidationRefund) Validate() error {
+	if lr.Owner.Empty() {
+		return errors.New("liquidation refund owner cannot be empty")
+	}
+	if !lr.Amount.IsValid() || lr.Amount.IsZero() {
+		return fmt.Errorf("invalid liquidation refund amount: %s", lr.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (lr LiquidationRefund) String() string {
+	return fmt.Sprintf(`Liquidation Refund:
+  Owner: %s
+  Amount: %s
+  Attempts: %d`, lr.Owner, lr.Amount, lr.Attempts)
+}
+
+// LiquidationRefunds is a slice of LiquidationRefund
+type LiquidationRefunds []LiquidationRefund
+
+// Validate validates each refund and checks that no owner appears more than once
+func (lrs LiquidationRefunds) Validate() error {
+	owners := make(map[string]bool)
+	for _, lr := range lrs {
+		if err := lr.Validate(); err != nil {
+			return err
+		}
+		if owners[lr.Owner.String()] {
+			return fmt.Errorf("duplicate liquidation refund for owner %s", lr.Owner)
+		}
+		owners[lr.Owner.String()] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (lrs LiquidationRefunds) String() string {
+	out := ""
+	for _, lr := range lrs {
+		out += lr.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CollateralTypeTotals is the total collateral locked in and principal drawn from the cdps of one collateral type
+type CollateralTypeTotals struct {
+	CollateralType         string   `json:"collateral_type" yaml:"collateral_type"`
+	Collateral             sdk.Coin `json:"collateral" yaml:"collateral"`
+	Principal              sdk.Coin `json:"principal" yaml:"principal"`
+	CollateralValue        sdk.Dec  `json:"collateral_value" yaml:"collateral_value"`
+	CollateralizationRatio sdk.Dec  `json:"collateralization_ratio" yaml:"collateralization_ratio"`
+}
+
+// NewCollateralTypeTotals returns a new CollateralTypeTotals
+func NewCollateralTypeTotals(collateralType string, collateral, principal sdk.Coin, collateralValue, ratio sdk.Dec) CollateralTypeTotals {
+	return CollateralTypeTotals{
+		CollateralType:         collateralType,
+		Collateral:             collateral,
+		Principal:              principal,
+		CollateralValue:        collateralValue,
+		CollateralizationRatio: ratio,
+	}
+}
+
+// String implements fmt.Stringer
+func (ct CollateralTypeTotals) String() string {
+	return fmt.Sprintf(`%s:
+    Collateral: %s
+    Principal: %s
+    Collateral Value: %s
+    Collateralization Ratio: %s`, ct.CollateralType, ct.Collateral, ct.Principal, ct.CollateralValue, ct.CollateralizationRatio)
+}
+
+// CollateralTypeTotalsList is a slice of CollateralTypeTotals
+type CollateralTypeTotalsList []CollateralTypeTotals
+
+// CDPTotals is the total collateral and principal of all cdps, broken down by collateral type. Collateral values and
+// collateralization ratios are calculated at spot prices.
+type CDPTotals struct {
+	CollateralTypes        CollateralTypeTotalsList `json:"collateral_types" yaml:"collateral_types"`
+	Collateral             sdk.Coins                `json:"collateral" yaml:"collateral"`
+	Principal              sdk.Coins                `json:"principal" yaml:"principal"`
+	CollateralValue        sdk.Dec                  `json:"collateral_value" yaml:"collateral_value"`
+	CollateralizationRatio sdk.Dec                  `json:"collateralization_ratio" yaml:"collateralization_ratio"`
+}
+
+// String implements fmt.Stringer
+func (t CDPTotals) String() string {
+	var collateralTypes []string
+	for _, ct := range t.CollateralTypes {
+		collateralTypes = append(collateralTypes, "  "+ct.String())
+	}
+	return fmt.Sprintf(`CDP Totals:
+  Collateral: %s
+  Principal: %s
+  Collateral Value: %s
+  Collateralization Ratio: %s
+%s`, t.Collateral, t.Principal, t.CollateralValue, t.CollateralizationRatio, strings.Join(collateralTypes, "\n"))
+}
@@ -0,0 +1,8 @@
+/*
+Package circuit implements a circuit breaker that rejects transactions containing disabled message types.
+
+Disabled messages are listed in the module params, so they can be changed by a committee with permission to change
+the circuit params. Checks happen in the ante handler, so a vulnerable message type can be switched off without a
+chain halt or software upgrade. Governance and committee messages can never be disabled.
+*/
+package circuit
@@ -0,0 +1,21 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+}
+
+// ExportGenesis export genesis state for circuit module
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return NewGenesisState(k.GetParams(ctx))
+}
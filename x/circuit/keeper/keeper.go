@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/kava-labs/kava/x/circuit/types"
+)
+
+// Keeper keeper for the circuit module
+type Keeper struct {
+	cdc           *codec.Codec
+	paramSubspace subspace.Subspace
+}
+
+// NewKeeper creates a new keeper
+func NewKeeper(cdc *codec.Codec, paramstore subspace.Subspace) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		paramSubspace: paramstore,
+	}
+}
+
+// IsMsgDisabled returns true if the message matches a disabled msg entry in the params.
+// Nothing is disabled before the params have been set.
+func (k Keeper) IsMsgDisabled(ctx sdk.Context, msg sdk.Msg) bool {
+	var disabledMsgs types.DisabledMsgs
+	k.paramSubspace.GetIfExists(ctx, types.KeyDisabledMsgs, &disabledMsgs)
+	return disabledMsgs.Disables(msg)
+}
@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DONTCOVER
+
+// Errors used by the circuit module
+var (
+	ErrMsgDisabled = sdkerrors.Register(ModuleName, 2, "message type is disabled")
+)
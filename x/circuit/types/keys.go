@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName name that will be used throughout the module
+	ModuleName = "circuit"
+
+	// RouterKey Top level router key
+	RouterKey = ModuleName
+
+	// QuerierRoute Top level query string
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
@@ -0,0 +1,150 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyDisabledMsgs     = []byte("DisabledMsgs")
+	DefaultDisabledMsgs = DisabledMsgs{}
+
+	// ProtectedRoutes are message routes that cannot be disabled, so that governance and committees can always vote to
+	// switch a disabled message back on
+	ProtectedRoutes = []string{"gov", "committee"}
+)
+
+// Params governance parameters for the circuit module
+type Params struct {
+	DisabledMsgs DisabledMsgs `json:"disabled_msgs" yaml:"disabled_msgs"`
+}
+
+// NewParams returns a new params object
+func NewParams(disabledMsgs DisabledMsgs) Params {
+	return Params{
+		DisabledMsgs: disabledMsgs,
+	}
+}
+
+// DefaultParams returns default params for circuit module
+func DefaultParams() Params {
+	return NewParams(DefaultDisabledMsgs)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Disabled Msgs: %s`, p.DisabledMsgs)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyDisabledMsgs, &p.DisabledMsgs, validateDisabledMsgsParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validateDisabledMsgsParam(p.DisabledMsgs)
+}
+
+func validateDisabledMsgsParam(i interface{}) error {
+	disabledMsgs, ok := i.(DisabledMsgs)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return disabledMsgs.Validate()
+}
+
+// DisabledMsg identifies a message type that is rejected before it reaches its handler.
+// An empty MsgType disables every message on the route.
+type DisabledMsg struct {
+	Route   string `json:"route" yaml:"route"`
+	MsgType string `json:"msg_type" yaml:"msg_type"`
+}
+
+// NewDisabledMsg returns a new DisabledMsg
+func NewDisabledMsg(route, msgType string) DisabledMsg {
+	return DisabledMsg{
+		Route:   route,
+		MsgType: msgType,
+	}
+}
+
+// String implements fmt.Stringer
+func (dm DisabledMsg) String() string {
+	if dm.MsgType == "" {
+		return fmt.Sprintf("%s/*", dm.Route)
+	}
+	return fmt.Sprintf("%s/%s", dm.Route, dm.MsgType)
+}
+
+// Validate performs basic validation of a DisabledMsg
+func (dm DisabledMsg) Validate() error {
+	if strings.TrimSpace(dm.Route) == "" {
+		return errors.New("disabled msg route cannot be blank")
+	}
+	for _, route := range ProtectedRoutes {
+		if dm.Route == route {
+			return fmt.Errorf("messages on route %s cannot be disabled", route)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the message is disabled by this entry
+func (dm DisabledMsg) Matches(msg sdk.Msg) bool {
+	if msg.Route() != dm.Route {
+		return false
+	}
+	return dm.MsgType == "" || msg.Type() == dm.MsgType
+}
+
+// DisabledMsgs slice of DisabledMsg
+type DisabledMsgs []DisabledMsg
+
+// Validate performs basic validation of each entry and checks that no entry is repeated
+func (dms DisabledMsgs) Validate() error {
+	seen := make(map[string]bool)
+	for _, dm := range dms {
+		if err := dm.Validate(); err != nil {
+			return err
+		}
+		if seen[dm.String()] {
+			return fmt.Errorf("duplicate disabled msg: %s", dm)
+		}
+		seen[dm.String()] = true
+	}
+	return nil
+}
+
+// Disables returns true if any entry matches the message
+func (dms DisabledMsgs) Disables(msg sdk.Msg) bool {
+	for _, dm := range dms {
+		if dm.Matches(msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// String implements fmt.Stringer
+func (dms DisabledMsgs) String() string {
+	out := "Disabled Msgs\n"
+	for _, dm := range dms {
+		out += fmt.Sprintf("%s\n", dm)
+	}
+	return out
+}
@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/circuit/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ParamsTestSuite) TestParamValidation() {
+	testCases := []struct {
+		name         string
+		disabledMsgs types.DisabledMsgs
+		expectPass   bool
+	}{
+		{
+			name:         "default",
+			disabledMsgs: types.DefaultDisabledMsgs,
+			expectPass:   true,
+		},
+		{
+			name: "valid",
+			disabledMsgs: types.DisabledMsgs{
+				types.NewDisabledMsg("hard", "liquidate"),
+				types.NewDisabledMsg("hard", "withdraw"),
+				types.NewDisabledMsg("bep3", ""),
+			},
+			expectPass: true,
+		},
+		{
+			name:         "blank route",
+			disabledMsgs: types.DisabledMsgs{types.NewDisabledMsg(" ", "liquidate")},
+			expectPass:   false,
+		},
+		{
+			name: "duplicate",
+			disabledMsgs: types.DisabledMsgs{
+				types.NewDisabledMsg("hard", "liquidate"),
+				types.NewDisabledMsg("hard", "liquidate"),
+			},
+			expectPass: false,
+		},
+		{
+			name:         "protected committee route",
+			disabledMsgs: types.DisabledMsgs{types.NewDisabledMsg("committee", "")},
+			expectPass:   false,
+		},
+		{
+			name:         "protected gov route",
+			disabledMsgs: types.DisabledMsgs{types.NewDisabledMsg("gov", "vote")},
+			expectPass:   false,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.NewParams(tc.disabledMsgs).Validate()
+			if tc.expectPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
@@ -0,0 +1,6 @@
+package types
+
+// Querier routes for the circuit module
+const (
+	QueryGetParams = "params"
+)
@@ -10,6 +10,8 @@ import (
 
 const (
 	AttributeKeyCommitteeID         = types.AttributeKeyCommitteeID
+	AttributeKeyMemberAdded         = types.AttributeKeyMemberAdded
+	AttributeKeyMemberRemoved       = types.AttributeKeyMemberRemoved
 	AttributeKeyProposalCloseStatus = types.AttributeKeyProposalCloseStatus
 	AttributeKeyProposalID          = types.AttributeKeyProposalID
 	AttributeKeyVoter               = types.AttributeKeyVoter
@@ -19,6 +21,7 @@ const (
 	AttributeValueProposalTimeout   = types.AttributeValueProposalTimeout
 	DefaultNextProposalID           = types.DefaultNextProposalID
 	DefaultParamspace               = types.DefaultParamspace
+	EventTypeMemberRotation         = types.EventTypeMemberRotation
 	EventTypeProposalClose          = types.EventTypeProposalClose
 	EventTypeProposalSubmit         = types.EventTypeProposalSubmit
 	EventTypeProposalVote           = types.EventTypeProposalVote
@@ -26,9 +29,12 @@ const (
 	ModuleName                      = types.ModuleName
 	ProposalTypeCommitteeChange     = types.ProposalTypeCommitteeChange
 	ProposalTypeCommitteeDelete     = types.ProposalTypeCommitteeDelete
+	ProposalTypeMemberRotation      = types.ProposalTypeMemberRotation
 	QuerierRoute                    = types.QuerierRoute
 	QueryCommittee                  = types.QueryCommittee
 	QueryCommittees                 = types.QueryCommittees
+	QueryDryRun                     = types.QueryDryRun
+	QueryGetParams                  = types.QueryGetParams
 	QueryNextProposalID             = types.QueryNextProposalID
 	QueryProposal                   = types.QueryProposal
 	QueryProposals                  = types.QueryProposals
@@ -51,6 +57,7 @@ var (
 	ValidProposalsInvariant     = keeper.ValidProposalsInvariant
 	ValidVotesInvariant         = keeper.ValidVotesInvariant
 	DefaultGenesisState         = types.DefaultGenesisState
+	DefaultParams               = types.DefaultParams
 	GetKeyFromID                = types.GetKeyFromID
 	GetVoteKey                  = types.GetVoteKey
 	NewAllowedCollateralParam   = types.NewAllowedCollateralParam
@@ -58,66 +65,87 @@ var (
 	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
 	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
 	NewGenesisState             = types.NewGenesisState
+	NewMemberRotationProposal   = types.NewMemberRotationProposal
 	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
 	NewMsgVote                  = types.NewMsgVote
+	NewParamChangeResult        = types.NewParamChangeResult
+	NewParams                   = types.NewParams
 	NewProposal                 = types.NewProposal
 	NewQueryCommitteeParams     = types.NewQueryCommitteeParams
+	NewQueryDryRunParams        = types.NewQueryDryRunParams
 	NewQueryProposalParams      = types.NewQueryProposalParams
 	NewQueryRawParamsParams     = types.NewQueryRawParamsParams
 	NewQueryVoteParams          = types.NewQueryVoteParams
 	NewVote                     = types.NewVote
+	ParamKeyTable               = types.ParamKeyTable
 	RegisterCodec               = types.RegisterCodec
 	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
 	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
 	Uint64FromBytes             = types.Uint64FromBytes
 
 	// variable aliases
-	ProposalHandler            = client.ProposalHandler
-	CommitteeKeyPrefix         = types.CommitteeKeyPrefix
-	ErrInvalidCommittee        = types.ErrInvalidCommittee
-	ErrInvalidGenesis          = types.ErrInvalidGenesis
-	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
-	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
-	ErrProposalExpired         = types.ErrProposalExpired
-	ErrUnknownCommittee        = types.ErrUnknownCommittee
-	ErrUnknownProposal         = types.ErrUnknownProposal
-	ErrUnknownSubspace         = types.ErrUnknownSubspace
-	ErrUnknownVote             = types.ErrUnknownVote
-	ModuleCdc                  = types.ModuleCdc
-	NextProposalIDKey          = types.NextProposalIDKey
-	ProposalKeyPrefix          = types.ProposalKeyPrefix
-	VoteKeyPrefix              = types.VoteKeyPrefix
+	ProposalHandler                    = client.ProposalHandler
+	CommitteeKeyPrefix                 = types.CommitteeKeyPrefix
+	DefaultMaxCommitteeSize            = types.DefaultMaxCommitteeSize
+	DefaultMemberRotationVoteThreshold = types.DefaultMemberRotationVoteThreshold
+	DefaultMinCommitteeSize            = types.DefaultMinCommitteeSize
+	ErrInvalidCommittee                = types.ErrInvalidCommittee
+	ErrInvalidGenesis                  = types.ErrInvalidGenesis
+	ErrInvalidMemberRotation           = types.ErrInvalidMemberRotation
+	ErrInvalidPubProposal              = types.ErrInvalidPubProposal
+	ErrNoProposalHandlerExists         = types.ErrNoProposalHandlerExists
+	ErrProposalExpired                 = types.ErrProposalExpired
+	ErrUnknownCommittee                = types.ErrUnknownCommittee
+	ErrUnknownProposal                 = types.ErrUnknownProposal
+	ErrUnknownSubspace                 = types.ErrUnknownSubspace
+	ErrUnknownVote                     = types.ErrUnknownVote
+	KeyMaxCommitteeSize                = types.KeyMaxCommitteeSize
+	KeyMemberRotationVoteThreshold     = types.KeyMemberRotationVoteThreshold
+	KeyMinCommitteeSize                = types.KeyMinCommitteeSize
+	ModuleCdc                          = types.ModuleCdc
+	NextProposalIDKey                  = types.NextProposalIDKey
+	ProposalKeyPrefix                  = types.ProposalKeyPrefix
+	VoteKeyPrefix                      = types.VoteKeyPrefix
 )
 
 type (
-	Keeper                      = keeper.Keeper
-	AllowedAssetParam           = types.AllowedAssetParam
-	AllowedAssetParams          = types.AllowedAssetParams
-	AllowedCollateralParam      = types.AllowedCollateralParam
-	AllowedCollateralParams     = types.AllowedCollateralParams
-	AllowedDebtParam            = types.AllowedDebtParam
-	AllowedMarket               = types.AllowedMarket
-	AllowedMarkets              = types.AllowedMarkets
-	AllowedParam                = types.AllowedParam
-	AllowedParams               = types.AllowedParams
-	Committee                   = types.Committee
-	CommitteeChangeProposal     = types.CommitteeChangeProposal
-	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
-	GenesisState                = types.GenesisState
-	GodPermission               = types.GodPermission
-	MsgSubmitProposal           = types.MsgSubmitProposal
-	MsgVote                     = types.MsgVote
-	ParamKeeper                 = types.ParamKeeper
-	Permission                  = types.Permission
-	Proposal                    = types.Proposal
-	PubProposal                 = types.PubProposal
-	QueryCommitteeParams        = types.QueryCommitteeParams
-	QueryProposalParams         = types.QueryProposalParams
-	QueryRawParamsParams        = types.QueryRawParamsParams
-	QueryVoteParams             = types.QueryVoteParams
-	SimpleParamChangePermission = types.SimpleParamChangePermission
-	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
-	SubParamChangePermission    = types.SubParamChangePermission
-	TextPermission              = types.TextPermission
-	Vote                        = types.Vote
+	Keeper                          = keeper.Keeper
+	AllowedAssetParam               = types.AllowedAssetParam
+	AllowedAssetParams              = types.AllowedAssetParams
+	AllowedCollateralParam          = types.AllowedCollateralParam
+	AllowedCollateralParams         = types.AllowedCollateralParams
+	AllowedDebtParam                = types.AllowedDebtParam
+	AllowedMarket                   = types.AllowedMarket
+	AllowedMarkets                  = types.AllowedMarkets
+	AllowedParam                    = types.AllowedParam
+	AllowedParams                   = types.AllowedParams
+	Committee                       = types.Committee
+	CommitteeChangeProposal         = types.CommitteeChangeProposal
+	CommitteeDeleteProposal         = types.CommitteeDeleteProposal
+	GenesisState                    = types.GenesisState
+	GodPermission                   = types.GodPermission
+	HardAddMoneyMarketPermission    = types.HardAddMoneyMarketPermission
+	MemberRotationProposal          = types.MemberRotationProposal
+	MsgSubmitProposal               = types.MsgSubmitProposal
+	MsgVote                         = types.MsgVote
+	ParamChangeResult               = types.ParamChangeResult
+	ParamChangeResults              = types.ParamChangeResults
+	ParamKeeper                     = types.ParamKeeper
+	Params                          = types.Params
+	Permission                      = types.Permission
+	PricefeedMarketStatusPermission = types.PricefeedMarketStatusPermission
+	ProposalDryRun                  = types.ProposalDryRun
+	QueryDryRunParams               = types.QueryDryRunParams
+	SoftwareUpgradeWindowPermission = types.SoftwareUpgradeWindowPermission
+	Proposal                        = types.Proposal
+	PubProposal                     = types.PubProposal
+	QueryCommitteeParams            = types.QueryCommitteeParams
+	QueryProposalParams             = types.QueryProposalParams
+	QueryRawParamsParams            = types.QueryRawParamsParams
+	QueryVoteParams                 = types.QueryVoteParams
+	SimpleParamChangePermission     = types.SimpleParamChangePermission
+	SoftwareUpgradePermission       = types.SoftwareUpgradePermission
+	SubParamChangePermission        = types.SubParamChangePermission
+	TextPermission                  = types.TextPermission
+	Vote                            = types.Vote
 )
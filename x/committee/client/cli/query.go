@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
 	"strconv"
 
 	"github.com/spf13/cobra"
@@ -33,12 +34,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		// proposals
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
+		GetCmdQueryDryRun(queryRoute, cdc),
 		// votes
 		GetCmdQueryVotes(queryRoute, cdc),
 		// other
 		GetCmdQueryProposer(queryRoute, cdc),
 		GetCmdQueryTally(queryRoute, cdc),
-		GetCmdQueryRawParams(queryRoute, cdc))...)
+		GetCmdQueryRawParams(queryRoute, cdc),
+		GetCmdQueryParams(queryRoute, cdc))...)
 
 	return queryCmd
 }
@@ -179,6 +182,53 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDryRun enacts a proposal on a cached copy of state to check it before it is submitted or voted on
+func GetCmdQueryDryRun(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dry-run [committee-id] [proposal-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Check what a proposal would do if a committee passed it",
+		Long: `Enact a proposal on a cached copy of state as if the committee had just passed it, without keeping any changes.
+Prints whether the proposal would be enacted, the param values it would change, and the events it would emit.
+The proposal file has the same format as for submit-proposal.`,
+		Example: fmt.Sprintf("%s query %s dry-run 1 your-proposal.json", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid uint", args[0])
+			}
+			proposalBz, err := ioutil.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var pubProposal types.PubProposal
+			if err := cdc.UnmarshalJSON(proposalBz, &pubProposal); err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryDryRunParams(committeeID, pubProposal))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDryRun), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var result types.ProposalDryRun
+			if err := cdc.UnmarshalJSON(res, &result); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(result)
+		},
+	}
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -319,3 +369,29 @@ func GetCmdQueryRawParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryParams queries the committee module parameters
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "params",
+		Args:    cobra.NoArgs,
+		Short:   "Query the committee module parameters",
+		Example: fmt.Sprintf("%s query %s params", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetParams), nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
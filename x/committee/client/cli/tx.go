@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -25,6 +27,13 @@ import (
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
+const (
+	flagAddMembers    = "add"
+	flagRemoveMembers = "remove"
+	flagTitle         = "title"
+	flagDescription   = "description"
+)
+
 func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -37,6 +46,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(flags.PostCommands(
 		GetCmdVote(cdc),
 		GetCmdSubmitProposal(cdc),
+		GetCmdRotateMembers(cdc),
 	)...)
 
 	return txCmd
@@ -97,6 +107,70 @@ For example:
 	return cmd
 }
 
+// GetCmdRotateMembers returns the command to submit a proposal for a committee to add or remove its own members
+func GetCmdRotateMembers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-members [committee-id]",
+		Short: "Submit a proposal for a committee to add or remove its own members",
+		Long: `Submit a member rotation proposal to a committee. Members are comma separated addresses.
+The proposal passes when the larger of the committee's vote threshold and the member rotation vote threshold param is reached.`,
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s tx %s rotate-members 1 --add kava1abc...,kava1def... --remove kava1ghi... --title \"Rotate members\"", version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Get committee ID
+			committeeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("committee-id %s not a valid int", args[0])
+			}
+
+			addMembers, err := parseMembers(viper.GetString(flagAddMembers))
+			if err != nil {
+				return err
+			}
+			removeMembers, err := parseMembers(viper.GetString(flagRemoveMembers))
+			if err != nil {
+				return err
+			}
+			pubProposal := types.NewMemberRotationProposal(viper.GetString(flagTitle), viper.GetString(flagDescription), committeeID, addMembers, removeMembers)
+
+			// Build message and run basic validation
+			msg := types.NewMsgSubmitProposal(pubProposal, cliCtx.GetFromAddress(), committeeID)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Sign and broadcast message
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagAddMembers, "", "comma separated addresses to add to the committee")
+	cmd.Flags().String(flagRemoveMembers, "", "comma separated addresses to remove from the committee")
+	cmd.Flags().String(flagTitle, "", "title of the proposal")
+	cmd.Flags().String(flagDescription, "", "description of the proposal")
+	return cmd
+}
+
+// parseMembers parses a comma separated list of addresses
+func parseMembers(membersStr string) ([]sdk.AccAddress, error) {
+	var members []sdk.AccAddress
+	for _, bech := range strings.Split(membersStr, ",") {
+		if strings.TrimSpace(bech) == "" {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(bech))
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, addr)
+	}
+	return members, nil
+}
+
 // GetCmdVote returns the command to vote on a proposal.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
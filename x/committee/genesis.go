@@ -14,6 +14,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
 	}
 
+	keeper.SetParams(ctx, gs.Params)
 	keeper.SetNextProposalID(ctx, gs.NextProposalID)
 
 	for _, com := range gs.Committees {
@@ -38,10 +39,12 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
 
-	return types.NewGenesisState(
+	gs := types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
 	)
+	gs.Params = keeper.GetParams(ctx)
+	return gs
 }
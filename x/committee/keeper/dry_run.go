@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// DryRunProposal enacts a proposal on a cached copy of state as if the committee had just passed it, so that members
+// can check a proposal before voting on it. The result records whether it would be enacted, the param values it would
+// change, and the events it would emit. No state changes are kept.
+func (k Keeper) DryRunProposal(ctx sdk.Context, committeeID uint64, pubProposal types.PubProposal) types.ProposalDryRun {
+	result := types.ProposalDryRun{CommitteeID: committeeID}
+
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.dryRunEnactProposal(cacheCtx, committeeID, pubProposal); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Passes = true
+	result.ParamChanges = k.getParamChangeResults(ctx, cacheCtx, pubProposal)
+	result.Events = sdk.StringifyEvents(cacheCtx.EventManager().ABCIEvents())
+	return result
+}
+
+// dryRunEnactProposal enacts a proposal, converting any panic in the proposal handler into an error
+func (k Keeper) dryRunEnactProposal(ctx sdk.Context, committeeID uint64, pubProposal types.PubProposal) (returnErr error) {
+	defer func() {
+		if r := recover(); r != nil {
+			returnErr = sdkerrors.Wrapf(types.ErrInvalidPubProposal, "proposal handler panicked: %s", r)
+		}
+	}()
+	if pubProposal == nil {
+		return sdkerrors.Wrap(types.ErrInvalidPubProposal, "pub proposal cannot be nil")
+	}
+	return k.EnactProposal(ctx, types.NewProposal(pubProposal, 0, committeeID, ctx.BlockTime()))
+}
+
+// getParamChangeResults returns the raw values of the params changed by a param change proposal before and after it is enacted
+func (k Keeper) getParamChangeResults(ctx, enactedCtx sdk.Context, pubProposal types.PubProposal) types.ParamChangeResults {
+	proposal, ok := pubProposal.(paramstypes.ParameterChangeProposal)
+	if !ok {
+		return nil
+	}
+	results := types.ParamChangeResults{}
+	for _, change := range proposal.Changes {
+		subspace, found := k.ParamKeeper.GetSubspace(change.Subspace)
+		if !found {
+			continue
+		}
+		current := subspace.GetRaw(ctx, []byte(change.Key))
+		proposed := subspace.GetRaw(enactedCtx, []byte(change.Key))
+		results = append(results, types.NewParamChangeResult(change.Subspace, change.Key, string(current), string(proposed)))
+	}
+	return results
+}
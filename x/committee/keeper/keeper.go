@@ -8,13 +8,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
 
 type Keeper struct {
-	cdc      *codec.Codec
-	storeKey sdk.StoreKey
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSubspace subspace.Subspace
 
 	ParamKeeper types.ParamKeeper // TODO ideally don't export, only sims need it exported
 
@@ -22,16 +24,21 @@ type Keeper struct {
 	router govtypes.Router
 }
 
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramstore subspace.Subspace, router govtypes.Router, paramKeeper types.ParamKeeper) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
 	router.Seal()
 
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		ParamKeeper: paramKeeper,
-		router:      router,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramstore,
+		ParamKeeper:   paramKeeper,
+		router:        router,
 	}
 }
 
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// ValidateMemberRotation checks a member rotation can be applied to its committee, and that the committee's size is
+// within the limits set in the params afterwards.
+func (k Keeper) ValidateMemberRotation(ctx sdk.Context, rotation types.MemberRotationProposal) error {
+	com, found := k.GetCommittee(ctx, rotation.CommitteeID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", rotation.CommitteeID)
+	}
+	for _, m := range rotation.AddMembers {
+		if com.HasMember(m) {
+			return sdkerrors.Wrapf(types.ErrInvalidMemberRotation, "%s is already a member of committee %d", m, com.ID)
+		}
+	}
+	for _, m := range rotation.RemoveMembers {
+		if !com.HasMember(m) {
+			return sdkerrors.Wrapf(types.ErrInvalidMemberRotation, "%s is not a member of committee %d", m, com.ID)
+		}
+	}
+
+	params := k.GetParams(ctx)
+	newCom := rotation.Apply(com)
+	size := uint64(len(newCom.Members))
+	if size < params.MinCommitteeSize || size > params.MaxCommitteeSize {
+		return sdkerrors.Wrapf(types.ErrInvalidMemberRotation, "committee size %d outside of the allowed range [%d, %d]", size, params.MinCommitteeSize, params.MaxCommitteeSize)
+	}
+	if err := newCom.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidMemberRotation, err.Error())
+	}
+	return nil
+}
+
+// RotateMembers adds and removes committee members.
+// Votes from removed members are deleted from the committee's other proposals, so that they are tallied against the
+// new members.
+func (k Keeper) RotateMembers(ctx sdk.Context, rotation types.MemberRotationProposal) error {
+	if err := k.ValidateMemberRotation(ctx, rotation); err != nil {
+		return err
+	}
+	com, _ := k.GetCommittee(ctx, rotation.CommitteeID)
+	k.SetCommittee(ctx, rotation.Apply(com))
+
+	for _, p := range k.GetProposalsByCommittee(ctx, com.ID) {
+		for _, m := range rotation.RemoveMembers {
+			k.DeleteVote(ctx, p.ID, m)
+		}
+	}
+
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID))}
+	for _, m := range rotation.AddMembers {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMemberAdded, m.String()))
+	}
+	for _, m := range rotation.RemoveMembers {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyMemberRemoved, m.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMemberRotation, attributes...))
+	return nil
+}
+
+// hasPermissionsFor returns whether a committee is authorized to enact a proposal.
+// Committees can always rotate their own members, but not the members of other committees.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	if rotation, ok := pubProposal.(types.MemberRotationProposal); ok {
+		return rotation.CommitteeID == com.ID
+	}
+	return com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal)
+}
+
+// getVoteThreshold returns the percentage of committee members that must vote for a proposal to pass.
+// Member rotations need at least the supermajority set in the params.
+func (k Keeper) getVoteThreshold(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) sdk.Dec {
+	if _, ok := pubProposal.(types.MemberRotationProposal); ok {
+		return sdk.MaxDec(com.VoteThreshold, k.GetParams(ctx).MemberRotationVoteThreshold)
+	}
+	return com.VoteThreshold
+}
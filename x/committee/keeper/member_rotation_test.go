@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func (suite *KeeperTestSuite) TestMemberRotation() {
+	members := suite.addresses[:4]
+	newMember := suite.addresses[4]
+	com := types.NewCommittee(1, "This committee is for testing.", members, []types.Permission{types.TextPermission{}}, d("0.5"), time.Hour*24*7)
+	otherCom := types.NewCommittee(2, "This committee is for testing.", suite.addresses[4:], []types.Permission{types.GodPermission{}}, d("0.5"), time.Hour*24*7)
+	suite.keeper.SetCommittee(suite.ctx, com)
+	suite.keeper.SetCommittee(suite.ctx, otherCom)
+	suite.keeper.SetNextProposalID(suite.ctx, 1)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(2, 4, d("0.7")))
+
+	rotation := func(add, remove []sdk.AccAddress) types.MemberRotationProposal {
+		return types.NewMemberRotationProposal("A Title", "A description of this proposal.", com.ID, add, remove)
+	}
+
+	// committees can't rotate the members of other committees, even with god permissions
+	_, err := suite.keeper.SubmitProposal(suite.ctx, newMember, otherCom.ID, rotation(nil, members[:1]))
+	suite.True(errors.Is(err, sdkerrors.ErrUnauthorized))
+
+	// rotations must match the committee and stay within the size limits
+	for _, invalid := range []types.MemberRotationProposal{
+		rotation([]sdk.AccAddress{members[1]}, nil),
+		rotation(nil, []sdk.AccAddress{newMember}),
+		rotation([]sdk.AccAddress{newMember}, nil),
+		rotation(nil, members[:3]),
+	} {
+		_, err = suite.keeper.SubmitProposal(suite.ctx, members[0], com.ID, invalid)
+		suite.True(errors.Is(err, types.ErrInvalidMemberRotation), invalid.String())
+	}
+
+	textID, err := suite.keeper.SubmitProposal(suite.ctx, members[0], com.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, textID, members[0]))
+
+	rotationID, err := suite.keeper.SubmitProposal(suite.ctx, members[1], com.ID, rotation([]sdk.AccAddress{newMember}, members[:1]))
+	suite.NoError(err)
+
+	// 2 votes reaches the committee threshold but not the member rotation threshold
+	suite.NoError(suite.keeper.AddVote(suite.ctx, rotationID, members[1]))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, rotationID, members[2]))
+	passes, err := suite.keeper.GetProposalResult(suite.ctx, rotationID)
+	suite.NoError(err)
+	suite.False(passes)
+
+	suite.NoError(suite.keeper.AddVote(suite.ctx, rotationID, members[3]))
+	passes, err = suite.keeper.GetProposalResult(suite.ctx, rotationID)
+	suite.NoError(err)
+	suite.True(passes)
+
+	suite.keeper.EnactPassedProposals(suite.ctx)
+	_, found := suite.keeper.GetProposal(suite.ctx, rotationID)
+	suite.False(found)
+	rotated, found := suite.keeper.GetCommittee(suite.ctx, com.ID)
+	suite.True(found)
+	suite.Equal([]sdk.AccAddress{members[1], members[2], members[3], newMember}, rotated.Members)
+
+	// the removed member's votes no longer count towards the committee's other proposals
+	_, found = suite.keeper.GetProposal(suite.ctx, textID)
+	suite.True(found)
+	suite.Equal(int64(0), suite.keeper.TallyVotes(suite.ctx, textID))
+	err = suite.keeper.AddVote(suite.ctx, textID, members[0])
+	suite.True(errors.Is(err, sdkerrors.ErrUnauthorized))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, textID, newMember))
+}
@@ -21,7 +21,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal) {
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -93,7 +93,8 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, err
 
 	numVotes := k.TallyVotes(ctx, proposalID)
 
-	proposalResult := sdk.NewDec(numVotes).GTE(com.VoteThreshold.MulInt64(int64(len(com.Members))))
+	threshold := k.getVoteThreshold(ctx, com, pr.PubProposal)
+	proposalResult := sdk.NewDec(numVotes).GTE(threshold.MulInt64(int64(len(com.Members))))
 
 	return proposalResult, nil
 }
@@ -114,7 +115,7 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, proposal.PubProposal) {
+	if !k.hasPermissionsFor(ctx, com, proposal.PubProposal) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -122,6 +123,11 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 		return err
 	}
 
+	// member rotations are enacted by this module as the committee proposal handler is not on the router
+	if rotation, ok := proposal.PubProposal.(types.MemberRotationProposal); ok {
+		return k.RotateMembers(ctx, rotation)
+	}
+
 	// enact the proposal
 	handler := k.router.GetRoute(proposal.ProposalRoute())
 	if err := handler(ctx, proposal.PubProposal); err != nil {
@@ -193,13 +199,18 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 	if err := pubProposal.ValidateBasic(); err != nil {
 		return err
 	}
+	if rotation, ok := pubProposal.(types.MemberRotationProposal); ok {
+		return k.ValidateMemberRotation(ctx, rotation)
+	}
 
 	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
 		return sdkerrors.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
+	// Events are discarded along with the changes, as they describe changes that are not made.
 	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
 	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
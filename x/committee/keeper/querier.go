@@ -32,6 +32,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryNextProposalID(ctx, req, keeper)
 		case types.QueryRawParams:
 			return queryRawParams(ctx, path[1:], req, keeper)
+		case types.QueryGetParams:
+			return queryGetParams(ctx, req, keeper)
+		case types.QueryDryRun:
+			return queryDryRun(ctx, req, keeper)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
@@ -210,3 +214,37 @@ func queryRawParams(ctx sdk.Context, path []string, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+// ------------------------------------------
+//				Params
+// ------------------------------------------
+
+func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// ------------------------------------------
+//				Dry Run
+// ------------------------------------------
+
+func queryDryRun(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryDryRunParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	result := keeper.DryRunProposal(ctx, params.CommitteeID, params.PubProposal)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, result)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
@@ -302,6 +302,72 @@ func (suite *QuerierTestSuite) TestQueryRawParams() {
 	suite.Equal(suite.cdc.MustMarshalJSON(paramValue), returnedParamValue)
 }
 
+func (suite *QuerierTestSuite) TestQueryDryRun() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	originalParams := suite.keeper.GetParams(ctx)
+
+	queryDryRun := func(committeeID uint64, pubProposal types.PubProposal) types.ProposalDryRun {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryDryRun}, "/"),
+			Data: suite.cdc.MustMarshalJSON(types.NewQueryDryRunParams(committeeID, pubProposal)),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryDryRun}, query)
+		suite.Require().NoError(err)
+		var result types.ProposalDryRun
+		suite.Require().NoError(suite.cdc.UnmarshalJSON(bz, &result))
+		return result
+	}
+	paramChange := func(key []byte, value interface{}) types.PubProposal {
+		return params.NewParameterChangeProposal(
+			"A Title", "A description of this proposal.",
+			[]params.ParamChange{params.NewParamChange(types.ModuleName, string(key), string(suite.cdc.MustMarshalJSON(value)))},
+		)
+	}
+
+	// a valid param change reports the current and proposed values without changing them
+	result := queryDryRun(1, paramChange(types.KeyMaxCommitteeSize, uint64(20)))
+	suite.True(result.Passes)
+	suite.Empty(result.Error)
+	suite.Equal(uint64(1), result.CommitteeID)
+	suite.Equal(types.ParamChangeResults{
+		types.NewParamChangeResult(
+			types.ModuleName, string(types.KeyMaxCommitteeSize),
+			string(suite.cdc.MustMarshalJSON(originalParams.MaxCommitteeSize)), string(suite.cdc.MustMarshalJSON(uint64(20))),
+		),
+	}, result.ParamChanges)
+	suite.Equal(originalParams, suite.keeper.GetParams(ctx))
+
+	// a member rotation reports the events it would emit
+	result = queryDryRun(1, types.NewMemberRotationProposal("A Title", "A description of this proposal.", 1, suite.addresses[3:4], nil))
+	suite.True(result.Passes)
+	suite.Empty(result.ParamChanges)
+	suite.Len(result.Events, 1)
+	suite.Equal(types.EventTypeMemberRotation, result.Events[0].Type)
+	com, _ := suite.keeper.GetCommittee(ctx, 1)
+	suite.Equal(suite.addresses[:3], com.Members)
+
+	// proposals that would not be enacted report why
+	testcases := []struct {
+		name        string
+		committeeID uint64
+		pubProposal types.PubProposal
+	}{
+		{"committee without permissions", 2, gov.NewTextProposal("A Title", "A description of this proposal.")},
+		{"unknown committee", 3, gov.NewTextProposal("A Title", "A description of this proposal.")},
+		{"invalid param value", 1, paramChange(types.KeyMaxCommitteeSize, "not a number")},
+		{"unregistered param key", 1, paramChange([]byte("UnknownKey"), uint64(20))},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			result := queryDryRun(tc.committeeID, tc.pubProposal)
+			suite.False(result.Passes)
+			suite.NotEmpty(result.Error)
+			suite.Empty(result.ParamChanges)
+			suite.Empty(result.Events)
+		})
+	}
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
@@ -6,6 +6,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // ModuleCdc is a generic codec to be used throughout module
@@ -24,6 +27,12 @@ func init() {
 	RegisterProposalTypeCodec(govtypes.TextProposal{}, "cosmos-sdk/TextProposal")
 	RegisterProposalTypeCodec(upgrade.SoftwareUpgradeProposal{}, "cosmos-sdk/SoftwareUpgradeProposal")
 	RegisterProposalTypeCodec(upgrade.CancelSoftwareUpgradeProposal{}, "cosmos-sdk/CancelSoftwareUpgradeProposal")
+	RegisterProposalTypeCodec(hardtypes.ReservePayoutProposal{}, "hard/ReservePayoutProposal")
+	RegisterProposalTypeCodec(hardtypes.AddMoneyMarketProposal{}, "hard/AddMoneyMarketProposal")
+	RegisterProposalTypeCodec(hardtypes.DelistMoneyMarketProposal{}, "hard/DelistMoneyMarketProposal")
+	RegisterProposalTypeCodec(hardtypes.SeedProtocolLiquidityProposal{}, "hard/SeedProtocolLiquidityProposal")
+	RegisterProposalTypeCodec(hardtypes.WithdrawProtocolLiquidityProposal{}, "hard/WithdrawProtocolLiquidityProposal")
+	RegisterProposalTypeCodec(pricefeedtypes.MarketStatusProposal{}, "pricefeed/MarketStatusProposal")
 }
 
 // RegisterCodec registers the necessary types for the module
@@ -33,6 +42,7 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(MemberRotationProposal{}, "kava/MemberRotationProposal", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -41,6 +51,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TextPermission{}, "kava/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(SubParamChangePermission{}, "kava/SubParamChangePermission", nil)
+	cdc.RegisterConcrete(HardReservePayoutPermission{}, "kava/HardReservePayoutPermission", nil)
+	cdc.RegisterConcrete(PricefeedMarketStatusPermission{}, "kava/PricefeedMarketStatusPermission", nil)
+	cdc.RegisterConcrete(SoftwareUpgradeWindowPermission{}, "kava/SoftwareUpgradeWindowPermission", nil)
+	cdc.RegisterConcrete(HardAddMoneyMarketPermission{}, "kava/HardAddMoneyMarketPermission", nil)
 
 	// Msgs
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "kava/MsgSubmitProposal", nil)
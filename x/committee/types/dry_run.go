@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParamChangeResult is the raw json value of a param before and after a proposal changes it
+type ParamChangeResult struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+	Current  string `json:"current" yaml:"current"`
+	Proposed string `json:"proposed" yaml:"proposed"`
+}
+
+// NewParamChangeResult returns a new ParamChangeResult
+func NewParamChangeResult(subspace, key, current, proposed string) ParamChangeResult {
+	return ParamChangeResult{
+		Subspace: subspace,
+		Key:      key,
+		Current:  current,
+		Proposed: proposed,
+	}
+}
+
+// String implements fmt.Stringer
+func (r ParamChangeResult) String() string {
+	return fmt.Sprintf("%s/%s: %s -> %s", r.Subspace, r.Key, r.Current, r.Proposed)
+}
+
+// ParamChangeResults slice of ParamChangeResult
+type ParamChangeResults []ParamChangeResult
+
+// ProposalDryRun is the result of enacting a proposal on a cached copy of state as if a committee had just passed it.
+// Passes is false if the committee could not submit the proposal or if enacting it would fail, with the reason in Error.
+// Events are the events the proposal's handler would emit, describing the state changes it would make.
+type ProposalDryRun struct {
+	CommitteeID  uint64             `json:"committee_id" yaml:"committee_id"`
+	Passes       bool               `json:"passes" yaml:"passes"`
+	Error        string             `json:"error" yaml:"error"`
+	ParamChanges ParamChangeResults `json:"param_changes" yaml:"param_changes"`
+	Events       sdk.StringEvents   `json:"events" yaml:"events"`
+}
+
+// String implements fmt.Stringer
+func (dr ProposalDryRun) String() string {
+	var paramChanges strings.Builder
+	for _, change := range dr.ParamChanges {
+		paramChanges.WriteString(fmt.Sprintf("\n\t\t%s", change))
+	}
+	return strings.TrimSpace(fmt.Sprintf(`Proposal Dry Run:
+	Committee ID: %d
+	Passes: %t
+	Error: %s
+	Param Changes: %s
+	Events: %d`,
+		dr.CommitteeID, dr.Passes, dr.Error, paramChanges.String(), len(dr.Events),
+	))
+}
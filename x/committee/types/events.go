@@ -5,12 +5,15 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeMemberRotation = "member_rotation"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
+	AttributeKeyMemberAdded         = "member_added"
+	AttributeKeyMemberRemoved       = "member_removed"
 	AttributeValueProposalPassed    = "proposal_passed"
 	AttributeValueProposalTimeout   = "proposal_timeout"
 	AttributeValueProposalFailed    = "proposal_failed"
@@ -10,15 +10,17 @@ const DefaultNextProposalID uint64 = 1
 
 // GenesisState is state that must be provided at chain genesis.
 type GenesisState struct {
+	Params         Params      `json:"params" yaml:"params"`
 	NextProposalID uint64      `json:"next_proposal_id" yaml:"next_proposal_id"`
 	Committees     []Committee `json:"committees" yaml:"committees"`
 	Proposals      []Proposal  `json:"proposals" yaml:"proposals"`
 	Votes          []Vote      `json:"votes" yaml:"votes"`
 }
 
-// NewGenesisState returns a new genesis state object for the module.
+// NewGenesisState returns a new genesis state object for the module with the default params.
 func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote) GenesisState {
 	return GenesisState{
+		Params:         DefaultParams(),
 		NextProposalID: nextProposalID,
 		Committees:     committees,
 		Proposals:      proposals,
@@ -50,6 +52,10 @@ func (data GenesisState) IsEmpty() bool {
 
 // Validate performs basic validation of genesis data.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
 	// validate committees
 	committeeMap := make(map[uint64]bool, len(gs.Committees))
 	for _, com := range gs.Committees {
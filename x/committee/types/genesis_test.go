@@ -22,6 +22,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest5"))),
 	}
 	testGenesis := GenesisState{
+		Params:         DefaultParams(),
 		NextProposalID: 2,
 		Committees: []Committee{
 			{
@@ -68,6 +69,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "duplicate committee IDs",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     append(testGenesis.Committees, testGenesis.Committees[0]),
 				Proposals:      testGenesis.Proposals,
@@ -78,6 +80,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "invalid committee",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     append(testGenesis.Committees, Committee{}),
 				Proposals:      testGenesis.Proposals,
@@ -88,6 +91,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "duplicate proposal IDs",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     testGenesis.Committees,
 				Proposals:      append(testGenesis.Proposals, testGenesis.Proposals[0]),
@@ -98,6 +102,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "invalid NextProposalID",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: 0,
 				Committees:     testGenesis.Committees,
 				Proposals:      testGenesis.Proposals,
@@ -108,6 +113,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "proposal without committee",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID + 1,
 				Committees:     testGenesis.Committees,
 				Proposals: append(
@@ -124,6 +130,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "invalid proposal",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     testGenesis.Committees,
 				Proposals:      append(testGenesis.Proposals, Proposal{}),
@@ -134,6 +141,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "vote without proposal",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     testGenesis.Committees,
 				Proposals:      nil,
@@ -144,6 +152,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			name: "invalid vote",
 			genState: GenesisState{
+				Params:         testGenesis.Params,
 				NextProposalID: testGenesis.NextProposalID,
 				Committees:     testGenesis.Committees,
 				Proposals:      testGenesis.Proposals,
@@ -151,6 +160,17 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "invalid params",
+			genState: GenesisState{
+				Params:         NewParams(10, 1, d("0.667")),
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -733,6 +733,13 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newRewardM := testM
+	newRewardM.OracleRewardPerPost = sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))
+
+	newHeartbeatM := testM
+	newHeartbeatM.HeartbeatInterval = time.Hour
+	newHeartbeatM.DeactivateOnStale = true
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -780,6 +787,47 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed oracle reward change",
+			allowed: AllowedMarket{
+				MarketID:            "bnb:usd",
+				OracleRewardPerPost: true,
+			},
+			current:       testM,
+			incoming:      newRewardM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed oracle reward change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newRewardM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed heartbeat change",
+			allowed: AllowedMarket{
+				MarketID:          "bnb:usd",
+				HeartbeatInterval: true,
+				DeactivateOnStale: true,
+			},
+			current:       testM,
+			incoming:      newHeartbeatM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed deactivate on stale change",
+			allowed: AllowedMarket{
+				MarketID:          "bnb:usd",
+				HeartbeatInterval: true,
+			},
+			current:       testM,
+			incoming:      newHeartbeatM,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
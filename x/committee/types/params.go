@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyMinCommitteeSize                = []byte("MinCommitteeSize")
+	KeyMaxCommitteeSize                = []byte("MaxCommitteeSize")
+	KeyMemberRotationVoteThreshold     = []byte("MemberRotationVoteThreshold")
+	DefaultMinCommitteeSize            = uint64(1)
+	DefaultMaxCommitteeSize            = uint64(100)
+	DefaultMemberRotationVoteThreshold = sdk.MustNewDecFromStr("0.667")
+)
+
+// Params governance parameters for the committee module. They only apply to committees changing their own members,
+// committees created by the gov module are not restricted by them.
+type Params struct {
+	MinCommitteeSize uint64 `json:"min_committee_size" yaml:"min_committee_size"` // Fewest members a committee can have after a member rotation.
+	MaxCommitteeSize uint64 `json:"max_committee_size" yaml:"max_committee_size"` // Most members a committee can have after a member rotation.
+	// MemberRotationVoteThreshold is the smallest percentage of members that must vote for a member rotation proposal to
+	// pass. Committees with a higher vote threshold use their own threshold instead.
+	MemberRotationVoteThreshold sdk.Dec `json:"member_rotation_vote_threshold" yaml:"member_rotation_vote_threshold"`
+}
+
+// NewParams returns a new params object
+func NewParams(minCommitteeSize, maxCommitteeSize uint64, memberRotationVoteThreshold sdk.Dec) Params {
+	return Params{
+		MinCommitteeSize:            minCommitteeSize,
+		MaxCommitteeSize:            maxCommitteeSize,
+		MemberRotationVoteThreshold: memberRotationVoteThreshold,
+	}
+}
+
+// DefaultParams returns default params for committee module
+func DefaultParams() Params {
+	return NewParams(DefaultMinCommitteeSize, DefaultMaxCommitteeSize, DefaultMemberRotationVoteThreshold)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Min Committee Size: %d
+	Max Committee Size: %d
+	Member Rotation Vote Threshold: %s`, p.MinCommitteeSize, p.MaxCommitteeSize, p.MemberRotationVoteThreshold)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyMinCommitteeSize, &p.MinCommitteeSize, validateCommitteeSizeParam),
+		params.NewParamSetPair(KeyMaxCommitteeSize, &p.MaxCommitteeSize, validateCommitteeSizeParam),
+		params.NewParamSetPair(KeyMemberRotationVoteThreshold, &p.MemberRotationVoteThreshold, validateMemberRotationVoteThresholdParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateCommitteeSizeParam(p.MinCommitteeSize); err != nil {
+		return err
+	}
+	if err := validateCommitteeSizeParam(p.MaxCommitteeSize); err != nil {
+		return err
+	}
+	if p.MinCommitteeSize > p.MaxCommitteeSize {
+		return fmt.Errorf("min committee size %d cannot be greater than max committee size %d", p.MinCommitteeSize, p.MaxCommitteeSize)
+	}
+	return validateMemberRotationVoteThresholdParam(p.MemberRotationVoteThreshold)
+}
+
+func validateCommitteeSizeParam(i interface{}) error {
+	size, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if size == 0 {
+		return fmt.Errorf("committee size must be positive")
+	}
+	return nil
+}
+
+func validateMemberRotationVoteThresholdParam(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a rotation must be supported by a majority of members, so that a minority can't replace the other members
+	if threshold.IsNil() || threshold.LTE(sdk.MustNewDecFromStr("0.5")) || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("member rotation vote threshold must be in the range (0.5, 1]: %s", threshold)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParams_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     Params
+		expectPass bool
+	}{
+		{
+			name:       "default",
+			params:     DefaultParams(),
+			expectPass: true,
+		},
+		{
+			name:       "zero min size",
+			params:     NewParams(0, 10, d("0.667")),
+			expectPass: false,
+		},
+		{
+			name:       "min size greater than max size",
+			params:     NewParams(11, 10, d("0.667")),
+			expectPass: false,
+		},
+		{
+			name:       "threshold not a majority",
+			params:     NewParams(1, 10, d("0.5")),
+			expectPass: false,
+		},
+		{
+			name:       "threshold greater than one",
+			params:     NewParams(1, 10, d("1.01")),
+			expectPass: false,
+		},
+		{
+			name:       "nil threshold",
+			params:     NewParams(1, 10, sdk.Dec{}),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMemberRotationProposal_ValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("someName1"))
+	addr2 := sdk.AccAddress([]byte("someName2"))
+	tests := []struct {
+		name       string
+		proposal   MemberRotationProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   NewMemberRotationProposal("A Title", "A proposal description.", 1, []sdk.AccAddress{addr1}, []sdk.AccAddress{addr2}),
+			expectPass: true,
+		},
+		{
+			name:       "no changes",
+			proposal:   NewMemberRotationProposal("A Title", "A proposal description.", 1, nil, nil),
+			expectPass: false,
+		},
+		{
+			name:       "address added and removed",
+			proposal:   NewMemberRotationProposal("A Title", "A proposal description.", 1, []sdk.AccAddress{addr1}, []sdk.AccAddress{addr1}),
+			expectPass: false,
+		},
+		{
+			name:       "empty address",
+			proposal:   NewMemberRotationProposal("A Title", "A proposal description.", 1, []sdk.AccAddress{nil}, nil),
+			expectPass: false,
+		},
+		{
+			name:       "missing title",
+			proposal:   NewMemberRotationProposal("", "A proposal description.", 1, []sdk.AccAddress{addr1}, nil),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -22,6 +23,10 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "kava/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission")
 	govtypes.RegisterProposalTypeCodec(SubParamChangePermission{}, "kava/SubParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(HardReservePayoutPermission{}, "kava/HardReservePayoutPermission")
+	govtypes.RegisterProposalTypeCodec(PricefeedMarketStatusPermission{}, "kava/PricefeedMarketStatusPermission")
+	govtypes.RegisterProposalTypeCodec(SoftwareUpgradeWindowPermission{}, "kava/SoftwareUpgradeWindowPermission")
+	govtypes.RegisterProposalTypeCodec(HardAddMoneyMarketPermission{}, "kava/HardAddMoneyMarketPermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -144,6 +149,159 @@ func (SoftwareUpgradePermission) MarshalYAML() (interface{}, error) {
 	return valueToMarshal, nil
 }
 
+// ------------------------------------------
+//				SoftwareUpgradeWindowPermission
+// ------------------------------------------
+
+// SoftwareUpgradeWindowPermission allows software upgrade proposals with a plan height between MinHeightDelay and
+// MaxHeightDelay blocks after the current block, so upgrades such as security patches can be coordinated faster than
+// through governance. Time based plans are not allowed. Upgrades can be cancelled if AllowCancel is set.
+// The window is checked both when a proposal is submitted and when it is enacted.
+type SoftwareUpgradeWindowPermission struct {
+	MinHeightDelay int64 `json:"min_height_delay" yaml:"min_height_delay"`
+	MaxHeightDelay int64 `json:"max_height_delay" yaml:"max_height_delay"`
+	AllowCancel    bool  `json:"allow_cancel" yaml:"allow_cancel"`
+}
+
+var _ Permission = SoftwareUpgradeWindowPermission{}
+
+func (perm SoftwareUpgradeWindowPermission) Allows(ctx sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	switch proposal := p.(type) {
+	case upgrade.SoftwareUpgradeProposal:
+		if !proposal.Plan.Time.IsZero() || proposal.Plan.Height <= 0 {
+			return false
+		}
+		delay := proposal.Plan.Height - ctx.BlockHeight()
+		return delay >= perm.MinHeightDelay && delay <= perm.MaxHeightDelay
+	case upgrade.CancelSoftwareUpgradeProposal:
+		return perm.AllowCancel
+	default:
+		return false
+	}
+}
+
+func (perm SoftwareUpgradeWindowPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type           string `yaml:"type"`
+		MinHeightDelay int64  `yaml:"min_height_delay"`
+		MaxHeightDelay int64  `yaml:"max_height_delay"`
+		AllowCancel    bool   `yaml:"allow_cancel"`
+	}{
+		Type:           "software_upgrade_window_permission",
+		MinHeightDelay: perm.MinHeightDelay,
+		MaxHeightDelay: perm.MaxHeightDelay,
+		AllowCancel:    perm.AllowCancel,
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				HardReservePayoutPermission
+// ------------------------------------------
+
+// HardReservePayoutPermission allows hard reserve payout proposals with a total payout no larger than MaxPayout
+type HardReservePayoutPermission struct {
+	MaxPayout sdk.Coins `json:"max_payout" yaml:"max_payout"`
+}
+
+var _ Permission = HardReservePayoutPermission{}
+
+func (perm HardReservePayoutPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(hardtypes.ReservePayoutProposal)
+	if !ok {
+		return false
+	}
+	return proposal.Payouts.Total().IsAllLTE(perm.MaxPayout)
+}
+
+func (perm HardReservePayoutPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type      string    `yaml:"type"`
+		MaxPayout sdk.Coins `yaml:"max_payout"`
+	}{
+		Type:      "hard_reserve_payout_permission",
+		MaxPayout: perm.MaxPayout,
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				HardAddMoneyMarketPermission
+// ------------------------------------------
+
+// HardAddMoneyMarketPermission allows hard money market listing proposals for the listed denoms
+type HardAddMoneyMarketPermission struct {
+	Denoms []string `json:"denoms" yaml:"denoms"`
+}
+
+var _ Permission = HardAddMoneyMarketPermission{}
+
+func (perm HardAddMoneyMarketPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(hardtypes.AddMoneyMarketProposal)
+	if !ok {
+		return false
+	}
+	for _, denom := range perm.Denoms {
+		if denom == proposal.MoneyMarket.Denom {
+			return true
+		}
+	}
+	return false
+}
+
+func (perm HardAddMoneyMarketPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type   string   `yaml:"type"`
+		Denoms []string `yaml:"denoms"`
+	}{
+		Type:   "hard_add_money_market_permission",
+		Denoms: perm.Denoms,
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				PricefeedMarketStatusPermission
+// ------------------------------------------
+
+// PricefeedMarketStatusPermission allows pricefeed market status proposals for the listed markets. Markets can always
+// be deactivated, but reactivating them is only allowed if AllowActivation is set.
+type PricefeedMarketStatusPermission struct {
+	MarketIDs       []string `json:"market_ids" yaml:"market_ids"`
+	AllowActivation bool     `json:"allow_activation" yaml:"allow_activation"`
+}
+
+var _ Permission = PricefeedMarketStatusPermission{}
+
+func (perm PricefeedMarketStatusPermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(pricefeedtypes.MarketStatusProposal)
+	if !ok {
+		return false
+	}
+	if proposal.Active && !perm.AllowActivation {
+		return false
+	}
+	for _, id := range perm.MarketIDs {
+		if id == proposal.MarketID {
+			return true
+		}
+	}
+	return false
+}
+
+func (perm PricefeedMarketStatusPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type            string   `yaml:"type"`
+		MarketIDs       []string `yaml:"market_ids"`
+		AllowActivation bool     `yaml:"allow_activation"`
+	}{
+		Type:            "pricefeed_market_status_permission",
+		MarketIDs:       perm.MarketIDs,
+		AllowActivation: perm.AllowActivation,
+	}
+	return valueToMarshal, nil
+}
+
 // ------------------------------------------
 //				SubParamChangePermission
 // ------------------------------------------
@@ -383,9 +541,10 @@ type AllowedCollateralParam struct {
 	SpotMarketID        bool   `json:"spot_market_id" yaml:"spot_market_id"`
 	LiquidationMarketID bool   `json:"liquidation_market_id" yaml:"liquidation_market_id"`
 	ConversionFactor    bool   `json:"conversion_factor" yaml:"conversion_factor"`
+	AuctionThreshold    bool   `json:"auction_threshold" yaml:"auction_threshold"`
 }
 
-// NewAllowedCollateralParam return a new AllowedCollateralParam
+// NewAllowedCollateralParam return a new AllowedCollateralParam that does not allow auction threshold changes
 func NewAllowedCollateralParam(
 	ctype string, denom, liqRatio, debtLimit,
 	stabilityFee, auctionSize, liquidationPenalty,
@@ -409,14 +568,17 @@ func (acp AllowedCollateralParam) Allows(current, incoming cdptypes.CollateralPa
 	allowed := ((acp.Type == current.Type) && (acp.Type == incoming.Type)) && // require collateral types to be all equal
 		(current.Denom == incoming.Denom || acp.Denom) &&
 		(current.LiquidationRatio.Equal(incoming.LiquidationRatio) || acp.LiquidationRatio) &&
+		(current.LiquidationRatioRamp.Equal(incoming.LiquidationRatioRamp) || acp.LiquidationRatio) &&
 		(current.DebtLimit.IsEqual(incoming.DebtLimit) || acp.DebtLimit) &&
 		(current.StabilityFee.Equal(incoming.StabilityFee) || acp.StabilityFee) &&
+		(current.StabilityFeeRamp.Equal(incoming.StabilityFeeRamp) || acp.StabilityFee) &&
 		(current.AuctionSize.Equal(incoming.AuctionSize) || acp.AuctionSize) &&
 		(current.LiquidationPenalty.Equal(incoming.LiquidationPenalty) || acp.LiquidationPenalty) &&
 		((current.Prefix == incoming.Prefix) || acp.Prefix) &&
 		((current.SpotMarketID == incoming.SpotMarketID) || acp.SpotMarketID) &&
 		((current.LiquidationMarketID == incoming.LiquidationMarketID) || acp.LiquidationMarketID) &&
-		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor)
+		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor) &&
+		(current.GetAuctionThreshold().Equal(incoming.GetAuctionThreshold()) || acp.AuctionThreshold)
 	return allowed
 }
 
@@ -558,11 +720,14 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 }
 
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID            string `json:"market_id" yaml:"market_id"`
+	BaseAsset           bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset          bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles             bool   `json:"oracles" yaml:"oracles"`
+	Active              bool   `json:"active" yaml:"active"`
+	OracleRewardPerPost bool   `json:"oracle_reward_per_post" yaml:"oracle_reward_per_post"`
+	HeartbeatInterval   bool   `json:"heartbeat_interval" yaml:"heartbeat_interval"`
+	DeactivateOnStale   bool   `json:"deactivate_on_stale" yaml:"deactivate_on_stale"`
 }
 
 func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
@@ -570,10 +735,18 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		(coinsEqual(current.OracleRewardPerPost, incoming.OracleRewardPerPost) || am.OracleRewardPerPost) &&
+		((current.HeartbeatInterval == incoming.HeartbeatInterval) || am.HeartbeatInterval) &&
+		((current.DeactivateOnStale == incoming.DeactivateOnStale) || am.DeactivateOnStale)
 	return allowed
 }
 
+// coinsEqual checks if two sets of coins are equal, treating nil and empty coins as equal
+func coinsEqual(coins1, coins2 sdk.Coins) bool {
+	return coins1.IsAllLTE(coins2) && coins2.IsAllLTE(coins1)
+}
+
 // addressesEqual check if slices of addresses are equal, the order matters
 func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	if len(addrs1) != len(addrs2) {
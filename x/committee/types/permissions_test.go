@@ -10,6 +10,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type PermissionsTestSuite struct {
@@ -352,6 +355,246 @@ func (suite *PermissionsTestSuite) TestSoftwareUpgradePermission_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestHardReservePayoutPermission_Allows() {
+	recipient := sdk.AccAddress("recipient1")
+	payoutProposal := func(amount sdk.Coins) PubProposal {
+		return hardtypes.NewReservePayoutProposal(
+			"A Title",
+			"A description for this proposal.",
+			"bnb oracle failure",
+			hardtypes.ReservePayouts{hardtypes.NewReservePayout(recipient, amount)},
+		)
+	}
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "normal",
+			pubProposal:   payoutProposal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (exceeds max payout)",
+			pubProposal:   payoutProposal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1001))),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (denom without max payout)",
+			pubProposal:   payoutProposal(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1))),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (wrong pubproposal type)",
+			pubProposal: govtypes.NewTextProposal(
+				"A Title",
+				"A description for this proposal.",
+			),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := HardReservePayoutPermission{MaxPayout: sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestHardAddMoneyMarketPermission_Allows() {
+	listingProposal := func(denom string) PubProposal {
+		return hardtypes.NewAddMoneyMarketProposal(
+			"A Title",
+			"A description for this proposal.",
+			hardtypes.MoneyMarket{Denom: denom},
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		)
+	}
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "normal",
+			pubProposal:   listingProposal("bnb"),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (unlisted denom)",
+			pubProposal:   listingProposal("btcb"),
+			expectAllowed: false,
+		},
+		{
+			name: "not allowed (wrong pubproposal type)",
+			pubProposal: govtypes.NewTextProposal(
+				"A Title",
+				"A description for this proposal.",
+			),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			permission := HardAddMoneyMarketPermission{Denoms: []string{"bnb", "ukava"}}
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestPricefeedMarketStatusPermission_Allows() {
+	testcases := []struct {
+		name          string
+		permission    PricefeedMarketStatusPermission
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "deactivate",
+			permission:    PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd", "btc:usd"}},
+			pubProposal:   pricefeedtypes.NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", false),
+			expectAllowed: true,
+		},
+		{
+			name:          "activate",
+			permission:    PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd", "btc:usd"}, AllowActivation: true},
+			pubProposal:   pricefeedtypes.NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", true),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (activation not permitted)",
+			permission:    PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd", "btc:usd"}},
+			pubProposal:   pricefeedtypes.NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", true),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (market not listed)",
+			permission:    PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd"}},
+			pubProposal:   pricefeedtypes.NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", false),
+			expectAllowed: false,
+		},
+		{
+			name:       "not allowed (wrong pubproposal type)",
+			permission: PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd"}},
+			pubProposal: govtypes.NewTextProposal(
+				"A Title",
+				"A description for this proposal.",
+			),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			permission:    PricefeedMarketStatusPermission{MarketIDs: []string{"bnb:usd"}},
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Equal(
+				tc.expectAllowed,
+				tc.permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
 func TestPermissionsTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionsTestSuite))
 }
+
+func (suite *PermissionsTestSuite) TestSoftwareUpgradeWindowPermission_Allows() {
+	ctx := sdk.Context{}.WithBlockHeight(100)
+	permission := SoftwareUpgradeWindowPermission{MinHeightDelay: 10, MaxHeightDelay: 1000}
+
+	testcases := []struct {
+		name          string
+		permission    SoftwareUpgradeWindowPermission
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "upgrade at start of window",
+			permission:    permission,
+			pubProposal:   upgrade.NewSoftwareUpgradeProposal("A Title", "A description for this proposal.", upgrade.Plan{Name: "patch", Height: 110}),
+			expectAllowed: true,
+		},
+		{
+			name:          "upgrade at end of window",
+			permission:    permission,
+			pubProposal:   upgrade.NewSoftwareUpgradeProposal("A Title", "A description for this proposal.", upgrade.Plan{Name: "patch", Height: 1100}),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (upgrade too soon)",
+			permission:    permission,
+			pubProposal:   upgrade.NewSoftwareUpgradeProposal("A Title", "A description for this proposal.", upgrade.Plan{Name: "patch", Height: 109}),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (upgrade too late)",
+			permission:    permission,
+			pubProposal:   upgrade.NewSoftwareUpgradeProposal("A Title", "A description for this proposal.", upgrade.Plan{Name: "patch", Height: 1101}),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (time based upgrade)",
+			permission:    permission,
+			pubProposal:   upgrade.NewSoftwareUpgradeProposal("A Title", "A description for this proposal.", upgrade.Plan{Name: "patch", Time: time.Unix(1e9, 0)}),
+			expectAllowed: false,
+		},
+		{
+			name:          "cancel",
+			permission:    SoftwareUpgradeWindowPermission{MinHeightDelay: 10, MaxHeightDelay: 1000, AllowCancel: true},
+			pubProposal:   upgrade.NewCancelSoftwareUpgradeProposal("A Title", "A description for this proposal."),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (cancel not permitted)",
+			permission:    permission,
+			pubProposal:   upgrade.NewCancelSoftwareUpgradeProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			permission:    permission,
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			permission:    permission,
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Equal(
+				tc.expectAllowed,
+				tc.permission.Allows(ctx, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
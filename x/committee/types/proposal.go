@@ -3,6 +3,7 @@ package types
 import (
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -10,11 +11,12 @@ import (
 const (
 	ProposalTypeCommitteeChange = "CommitteeChange"
 	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeMemberRotation  = "MemberRotation"
 )
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
-var _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
+var _, _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, MemberRotationProposal{}
+var _, _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, MemberRotationProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
@@ -106,3 +108,83 @@ func (cdp CommitteeDeleteProposal) String() string {
 	bz, _ := yaml.Marshal(cdp)
 	return string(bz)
 }
+
+// MemberRotationProposal is a proposal for a committee to add or remove its own members.
+// It can only be submitted to the committee it changes, and is enacted by the committee module rather than a gov handler.
+// Note: it is not registered on the gov codec as the gov module cannot enact it.
+type MemberRotationProposal struct {
+	Title         string           `json:"title" yaml:"title"`
+	Description   string           `json:"description" yaml:"description"`
+	CommitteeID   uint64           `json:"committee_id" yaml:"committee_id"`
+	AddMembers    []sdk.AccAddress `json:"add_members" yaml:"add_members"`
+	RemoveMembers []sdk.AccAddress `json:"remove_members" yaml:"remove_members"`
+}
+
+func NewMemberRotationProposal(title string, description string, committeeID uint64, addMembers, removeMembers []sdk.AccAddress) MemberRotationProposal {
+	return MemberRotationProposal{
+		Title:         title,
+		Description:   description,
+		CommitteeID:   committeeID,
+		AddMembers:    addMembers,
+		RemoveMembers: removeMembers,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (mrp MemberRotationProposal) GetTitle() string { return mrp.Title }
+
+// GetDescription returns the description of the proposal.
+func (mrp MemberRotationProposal) GetDescription() string { return mrp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (mrp MemberRotationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (mrp MemberRotationProposal) ProposalType() string { return ProposalTypeMemberRotation }
+
+// ValidateBasic runs basic stateless validity checks
+func (mrp MemberRotationProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(mrp); err != nil {
+		return err
+	}
+	if len(mrp.AddMembers) == 0 && len(mrp.RemoveMembers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "member rotation must add or remove at least one member")
+	}
+
+	// an address can only appear once across both lists
+	addressMap := make(map[string]bool, len(mrp.AddMembers)+len(mrp.RemoveMembers))
+	for _, m := range append(append([]sdk.AccAddress{}, mrp.AddMembers...), mrp.RemoveMembers...) {
+		if m.Empty() {
+			return sdkerrors.Wrap(ErrInvalidPubProposal, "member rotation cannot contain an empty address")
+		}
+		if addressMap[m.String()] {
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "member rotation contains duplicate address %s", m)
+		}
+		addressMap[m.String()] = true
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (mrp MemberRotationProposal) String() string {
+	bz, _ := yaml.Marshal(mrp)
+	return string(bz)
+}
+
+// Apply returns the committee with the proposal's members added and removed.
+// Removed members that are not in the committee are ignored, validation of the result is left to the caller.
+func (mrp MemberRotationProposal) Apply(com Committee) Committee {
+	removeMap := make(map[string]bool, len(mrp.RemoveMembers))
+	for _, m := range mrp.RemoveMembers {
+		removeMap[m.String()] = true
+	}
+
+	members := make([]sdk.AccAddress, 0, len(com.Members)+len(mrp.AddMembers))
+	for _, m := range com.Members {
+		if !removeMap[m.String()] {
+			members = append(members, m)
+		}
+	}
+	com.Members = append(members, mrp.AddMembers...)
+	return com
+}
@@ -15,6 +15,8 @@ const (
 	QueryVote           = "vote"
 	QueryTally          = "tally"
 	QueryRawParams      = "raw_params"
+	QueryGetParams      = "params"
+	QueryDryRun         = "dry-run"
 )
 
 type QueryCommitteeParams struct {
@@ -60,3 +62,15 @@ func NewQueryRawParamsParams(subspace, key string) QueryRawParamsParams {
 		Key:      key,
 	}
 }
+
+type QueryDryRunParams struct {
+	CommitteeID uint64      `json:"committee_id" yaml:"committee_id"`
+	PubProposal PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
+}
+
+func NewQueryDryRunParams(committeeID uint64, pubProposal PubProposal) QueryDryRunParams {
+	return QueryDryRunParams{
+		CommitteeID: committeeID,
+		PubProposal: pubProposal,
+	}
+}
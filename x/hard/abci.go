@@ -4,7 +4,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// BeginBlocker updates interest rates and attempts liquidations
+// BeginBlocker activates scheduled money markets, updates interest rates, attempts liquidations, winds down deprecated
+// money markets, and rebalances yield strategies
 func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.ActivateScheduledMoneyMarkets(ctx)
 	k.ApplyInterestRateUpdates(ctx)
+	k.AttemptBudgetedLiquidations(ctx)
+	k.ProcessMoneyMarketWindDowns(ctx)
+	k.RebalanceStrategies(ctx)
+	k.UpdateMarketMetrics(ctx)
+}
+
+// EndBlocker applies triggered stop losses and emits a summary of the deposits, withdrawals, borrows, and repays made
+// in the block
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ProcessStopLosses(ctx)
+	k.EmitBlockStats(ctx)
 }
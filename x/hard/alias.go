@@ -8,91 +8,250 @@ import (
 )
 
 const (
+	AttributeKeyActivationTime         = types.AttributeKeyActivationTime
+	AttributeKeyAuctionID              = types.AttributeKeyAuctionID
 	AttributeKeyBlockHeight            = types.AttributeKeyBlockHeight
 	AttributeKeyBorrow                 = types.AttributeKeyBorrow
 	AttributeKeyBorrowCoins            = types.AttributeKeyBorrowCoins
+	AttributeKeyBorrowCount            = types.AttributeKeyBorrowCount
+	AttributeKeyBorrowVolume           = types.AttributeKeyBorrowVolume
 	AttributeKeyBorrower               = types.AttributeKeyBorrower
+	AttributeKeyDebtCovered            = types.AttributeKeyDebtCovered
+	AttributeKeyDenom                  = types.AttributeKeyDenom
 	AttributeKeyDeposit                = types.AttributeKeyDeposit
 	AttributeKeyDepositCoins           = types.AttributeKeyDepositCoins
+	AttributeKeyDepositCount           = types.AttributeKeyDepositCount
 	AttributeKeyDepositDenom           = types.AttributeKeyDepositDenom
 	AttributeKeyDepositor              = types.AttributeKeyDepositor
+	AttributeKeyDepositVolume          = types.AttributeKeyDepositVolume
+	AttributeKeyHealthFactor           = types.AttributeKeyHealthFactor
+	AttributeKeyHealthFactorWarning    = types.AttributeKeyHealthFactorWarning
+	AttributeKeyIncident               = types.AttributeKeyIncident
+	AttributeKeyLiquidationOrder       = types.AttributeKeyLiquidationOrder
+	AttributeKeyLot                    = types.AttributeKeyLot
+	AttributeKeyLtv                    = types.AttributeKeyLtv
+	AttributeKeyNewModel               = types.AttributeKeyNewModel
+	AttributeKeyPayoutCoins            = types.AttributeKeyPayoutCoins
+	AttributeKeyPreviousModel          = types.AttributeKeyPreviousModel
+	AttributeKeyProceeds               = types.AttributeKeyProceeds
+	AttributeKeyRecipient              = types.AttributeKeyRecipient
+	AttributeKeyReferrer               = types.AttributeKeyReferrer
 	AttributeKeyRepayCoins             = types.AttributeKeyRepayCoins
+	AttributeKeyRepayCount             = types.AttributeKeyRepayCount
+	AttributeKeyRepayVolume            = types.AttributeKeyRepayVolume
+	AttributeKeyResidualDebt           = types.AttributeKeyResidualDebt
+	AttributeKeyResultingHealthFactor  = types.AttributeKeyResultingHealthFactor
+	AttributeKeyResultingLtv           = types.AttributeKeyResultingLtv
 	AttributeKeyRewardsDistribution    = types.AttributeKeyRewardsDistribution
+	AttributeKeySeizedCoins            = types.AttributeKeySeizedCoins
+	AttributeKeySeizureOrder           = types.AttributeKeySeizureOrder
 	AttributeKeySender                 = types.AttributeKeySender
+	AttributeKeyShortfall              = types.AttributeKeyShortfall
+	AttributeKeySwapInput              = types.AttributeKeySwapInput
+	AttributeKeySwapOutput             = types.AttributeKeySwapOutput
+	AttributeKeyWindDownDeadline       = types.AttributeKeyWindDownDeadline
+	AttributeKeyWithdrawalCount        = types.AttributeKeyWithdrawalCount
+	AttributeKeyWithdrawalVolume       = types.AttributeKeyWithdrawalVolume
 	AttributeValueCategory             = types.AttributeValueCategory
+	BorrowHistoryOrigination           = types.BorrowHistoryOrigination
+	BorrowHistoryRepayment             = types.BorrowHistoryRepayment
+	DefaultInterestRateCurvePoints     = types.DefaultInterestRateCurvePoints
 	DefaultParamspace                  = types.DefaultParamspace
 	EventTypeDeleteHardDeposit         = types.EventTypeDeleteHardDeposit
+	EventTypeHardAuctionSettlement     = types.EventTypeHardAuctionSettlement
+	EventTypeHardDepositReferral       = types.EventTypeHardDepositReferral
+	EventTypeHardForcedWithdrawal      = types.EventTypeHardForcedWithdrawal
+	EventTypeHardHealthFactorWarning   = types.EventTypeHardHealthFactorWarning
 	EventTypeHardLiquidation           = types.EventTypeHardLiquidation
+	EventTypeHardLiquidationSwap       = types.EventTypeHardLiquidationSwap
+	EventTypeHardBlockStats            = types.EventTypeHardBlockStats
 	EventTypeHardBorrow                = types.EventTypeHardBorrow
 	EventTypeHardDelegatorDistribution = types.EventTypeHardDelegatorDistribution
 	EventTypeHardDeposit               = types.EventTypeHardDeposit
 	EventTypeHardLPDistribution        = types.EventTypeHardLPDistribution
+	EventTypeHardMoneyMarketActivation = types.EventTypeHardMoneyMarketActivation
+	EventTypeHardMoneyMarketDelisted   = types.EventTypeHardMoneyMarketDelisted
+	EventTypeHardMoneyMarketDeprecated = types.EventTypeHardMoneyMarketDeprecated
+	EventTypeHardMoneyMarketScheduled  = types.EventTypeHardMoneyMarketScheduled
 	EventTypeHardRepay                 = types.EventTypeHardRepay
+	EventTypeHardReservePayout         = types.EventTypeHardReservePayout
+	EventTypeHardStopLoss              = types.EventTypeHardStopLoss
+	EventTypeHardStrategyRebalance     = types.EventTypeHardStrategyRebalance
 	EventTypeHardWithdrawal            = types.EventTypeHardWithdrawal
+	EventTypeInterestRateModelChange   = types.EventTypeInterestRateModelChange
+	LiquidationOrderHighestValue       = types.LiquidationOrderHighestValue
+	LiquidationOrderMostLiquid         = types.LiquidationOrderMostLiquid
+	LiquidationOrderProportional       = types.LiquidationOrderProportional
+	MaxBorrowHistoryLength             = types.MaxBorrowHistoryLength
+	MaxIncidentLength                  = types.MaxIncidentLength
+	MaxInterestRateCurvePoints         = types.MaxInterestRateCurvePoints
+	MetricsSubsystem                   = types.MetricsSubsystem
 	ModuleAccountName                  = types.ModuleAccountName
 	ModuleName                         = types.ModuleName
+	PriceSourceConservative            = types.PriceSourceConservative
+	PriceSourceSpot                    = types.PriceSourceSpot
+	PriceSourceTwap                    = types.PriceSourceTwap
+	ProposalTypeAddMoneyMarket         = types.ProposalTypeAddMoneyMarket
+	ProposalTypeDelistMoneyMarket      = types.ProposalTypeDelistMoneyMarket
+	ProposalTypeReservePayout          = types.ProposalTypeReservePayout
 	QuerierRoute                       = types.QuerierRoute
+	QueryGetAccrualTimes               = types.QueryGetAccrualTimes
+	QueryGetBorrowHistory              = types.QueryGetBorrowHistory
 	QueryGetBorrows                    = types.QueryGetBorrows
 	QueryGetDeposits                   = types.QueryGetDeposits
+	QueryGetHealthFactor               = types.QueryGetHealthFactor
+	QueryGetInterestAudits             = types.QueryGetInterestAudits
+	QueryGetInterestRateCurve          = types.QueryGetInterestRateCurve
+	QueryGetMaxBorrow                  = types.QueryGetMaxBorrow
+	QueryGetMaxWithdraw                = types.QueryGetMaxWithdraw
 	QueryGetModuleAccounts             = types.QueryGetModuleAccounts
 	QueryGetParams                     = types.QueryGetParams
+	QueryGetReferralVolumes            = types.QueryGetReferralVolumes
+	QueryGetSimulation                 = types.QueryGetSimulation
+	QueryGetStopLosses                 = types.QueryGetStopLosses
 	QueryGetTotalBorrowed              = types.QueryGetTotalBorrowed
 	QueryGetTotalDeposited             = types.QueryGetTotalDeposited
+	QueryGetWindDowns                  = types.QueryGetWindDowns
 	RouterKey                          = types.RouterKey
+	SimulationBorrow                   = types.SimulationBorrow
+	SimulationDeposit                  = types.SimulationDeposit
+	SimulationLiquidation              = types.SimulationLiquidation
+	SimulationRepay                    = types.SimulationRepay
+	SimulationWithdraw                 = types.SimulationWithdraw
 	StoreKey                           = types.StoreKey
+	StoreVersion                       = types.StoreVersion
+	TStoreKey                          = types.TStoreKey
+
+	EventTypeHardProtocolLiquiditySeed       = types.EventTypeHardProtocolLiquiditySeed
+	EventTypeHardProtocolLiquidityWithdrawal = types.EventTypeHardProtocolLiquidityWithdrawal
+	ProposalTypeSeedProtocolLiquidity        = types.ProposalTypeSeedProtocolLiquidity
+	ProposalTypeWithdrawProtocolLiquidity    = types.ProposalTypeWithdrawProtocolLiquidity
+	ProtocolLiquidityName                    = types.ProtocolLiquidityName
+	QueryGetProtocolLiquidity                = types.QueryGetProtocolLiquidity
 )
 
 var (
 	// function aliases
-	APYToSPY                      = keeper.APYToSPY
-	SPYToEstimatedAPY             = keeper.SPYToEstimatedAPY
-	CalculateBorrowInterestFactor = keeper.CalculateBorrowInterestFactor
-	CalculateBorrowRate           = keeper.CalculateBorrowRate
-	CalculateSupplyInterestFactor = keeper.CalculateSupplyInterestFactor
-	CalculateUtilizationRatio     = keeper.CalculateUtilizationRatio
-	NewKeeper                     = keeper.NewKeeper
-	NewQuerier                    = keeper.NewQuerier
-	DefaultGenesisState           = types.DefaultGenesisState
-	DefaultParams                 = types.DefaultParams
-	DepositTypeIteratorKey        = types.DepositTypeIteratorKey
-	GetTotalVestingPeriodLength   = types.GetTotalVestingPeriodLength
-	NewBorrow                     = types.NewBorrow
-	NewBorrowInterestFactor       = types.NewBorrowInterestFactor
-	NewBorrowLimit                = types.NewBorrowLimit
-	NewDeposit                    = types.NewDeposit
-	NewGenesisAccumulationTime    = types.NewGenesisAccumulationTime
-	NewGenesisState               = types.NewGenesisState
-	NewInterestRateModel          = types.NewInterestRateModel
-	NewMoneyMarket                = types.NewMoneyMarket
-	NewMsgBorrow                  = types.NewMsgBorrow
-	NewMsgDeposit                 = types.NewMsgDeposit
-	NewMsgLiquidate               = types.NewMsgLiquidate
-	NewMsgRepay                   = types.NewMsgRepay
-	NewMsgWithdraw                = types.NewMsgWithdraw
-	NewMultiHARDHooks             = types.NewMultiHARDHooks
-	NewParams                     = types.NewParams
-	NewPeriod                     = types.NewPeriod
-	NewQueryAccountParams         = types.NewQueryAccountParams
-	NewQueryBorrowsParams         = types.NewQueryBorrowsParams
-	NewQueryDepositsParams        = types.NewQueryDepositsParams
-	NewQueryTotalBorrowedParams   = types.NewQueryTotalBorrowedParams
-	NewQueryTotalDepositedParams  = types.NewQueryTotalDepositedParams
-	NewSupplyInterestFactor       = types.NewSupplyInterestFactor
-	NewValuationMap               = types.NewValuationMap
-	ParamKeyTable                 = types.ParamKeyTable
-	RegisterCodec                 = types.RegisterCodec
+	APYToSPY                        = keeper.APYToSPY
+	SPYToEstimatedAPY               = keeper.SPYToEstimatedAPY
+	CalculateBorrowInterestFactor   = keeper.CalculateBorrowInterestFactor
+	CalculateBorrowRate             = keeper.CalculateBorrowRate
+	CalculateSupplyInterestFactor   = keeper.CalculateSupplyInterestFactor
+	CalculateUtilizationRatio       = keeper.CalculateUtilizationRatio
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	RegisterInvariants              = keeper.RegisterInvariants
+	ValidPositionsInvariant         = keeper.ValidPositionsInvariant
+	ValidTotalsInvariant            = keeper.ValidTotalsInvariant
+	BorrowsByDenomIteratorKey       = types.BorrowsByDenomIteratorKey
+	BorrowsByDenomKey               = types.BorrowsByDenomKey
+	CalculateHealthFactor           = types.CalculateHealthFactor
+	DefaultGenesisState             = types.DefaultGenesisState
+	DefaultParams                   = types.DefaultParams
+	DepositTypeIteratorKey          = types.DepositTypeIteratorKey
+	GetTotalVestingPeriodLength     = types.GetTotalVestingPeriodLength
+	IsLiquidatable                  = types.IsLiquidatable
+	NewActivityStats                = types.NewActivityStats
+	NewAddMoneyMarketProposal       = types.NewAddMoneyMarketProposal
+	NewBlockStats                   = types.NewBlockStats
+	NewBorrow                       = types.NewBorrow
+	NewBorrowHistory                = types.NewBorrowHistory
+	NewBorrowHistoryEntry           = types.NewBorrowHistoryEntry
+	NewBorrowInterestFactor         = types.NewBorrowInterestFactor
+	NewBorrowLimit                  = types.NewBorrowLimit
+	NewDelistMoneyMarketProposal    = types.NewDelistMoneyMarketProposal
+	NewDeposit                      = types.NewDeposit
+	NewEmptyInterestAudit           = types.NewEmptyInterestAudit
+	NewGenesisAccumulationTime      = types.NewGenesisAccumulationTime
+	NewGenesisState                 = types.NewGenesisState
+	NewInterestAudit                = types.NewInterestAudit
+	NewInterestRateCurvePoint       = types.NewInterestRateCurvePoint
+	NewInterestRateModel            = types.NewInterestRateModel
+	NewInterestRateModelChange      = types.NewInterestRateModelChange
+	NewMoneyMarket                  = types.NewMoneyMarket
+	NewMoneyMarketWindDown          = types.NewMoneyMarketWindDown
+	NewMsgAccrueInterest            = types.NewMsgAccrueInterest
+	NewMsgBorrow                    = types.NewMsgBorrow
+	NewMsgDeposit                   = types.NewMsgDeposit
+	NewMsgLiquidate                 = types.NewMsgLiquidate
+	NewMsgRepay                     = types.NewMsgRepay
+	NewMsgSetRepayFirst             = types.NewMsgSetRepayFirst
+	NewMsgSetStopLoss               = types.NewMsgSetStopLoss
+	NewMsgWithdraw                  = types.NewMsgWithdraw
+	NewMultiHARDHooks               = types.NewMultiHARDHooks
+	NewParams                       = types.NewParams
+	NewPeriod                       = types.NewPeriod
+	NewPositionHealth               = types.NewPositionHealth
+	NewQueryAccountParams           = types.NewQueryAccountParams
+	NewQueryAccrualTimesParams      = types.NewQueryAccrualTimesParams
+	NewQueryBorrowHistoryParams     = types.NewQueryBorrowHistoryParams
+	NewQueryBorrowsParams           = types.NewQueryBorrowsParams
+	NewQueryDepositsParams          = types.NewQueryDepositsParams
+	NewQueryHealthFactorParams      = types.NewQueryHealthFactorParams
+	NewQueryInterestAuditsParams    = types.NewQueryInterestAuditsParams
+	NewQueryInterestRateCurveParams = types.NewQueryInterestRateCurveParams
+	NewQueryMaxAmountParams         = types.NewQueryMaxAmountParams
+	NewQueryReferralVolumesParams   = types.NewQueryReferralVolumesParams
+	NewQuerySimulationParams        = types.NewQuerySimulationParams
+	NewQueryStopLossesParams        = types.NewQueryStopLossesParams
+	NewQueryTotalBorrowedParams     = types.NewQueryTotalBorrowedParams
+	NewQueryTotalDepositedParams    = types.NewQueryTotalDepositedParams
+	NewQueryWindDownsParams         = types.NewQueryWindDownsParams
+	NewReferralVolume               = types.NewReferralVolume
+	NewReservePayout                = types.NewReservePayout
+	NewReservePayoutProposal        = types.NewReservePayoutProposal
+	NewScheduledMoneyMarket         = types.NewScheduledMoneyMarket
+	NewStopLoss                     = types.NewStopLoss
+	NewSupplyInterestFactor         = types.NewSupplyInterestFactor
+	NewSwapLiquidation              = types.NewSwapLiquidation
+	NewTierBorrowRule               = types.NewTierBorrowRule
+	NewValuationMap                 = types.NewValuationMap
+	NewWindDownProgress             = types.NewWindDownProgress
+	NopMetrics                      = types.NopMetrics
+	ParamKeyTable                   = types.ParamKeyTable
+	PrometheusMetrics               = types.PrometheusMetrics
+	RegisterCodec                   = types.RegisterCodec
+	ValidateRiskTier                = types.ValidateRiskTier
+
+	NewSeedProtocolLiquidityProposal     = types.NewSeedProtocolLiquidityProposal
+	NewVestingDeposit                    = types.NewVestingDeposit
+	NewWithdrawProtocolLiquidityProposal = types.NewWithdrawProtocolLiquidityProposal
 
 	// variable aliases
+	BlockStatsKey                    = types.BlockStatsKey
+	BorrowHistoriesPrefix            = types.BorrowHistoriesPrefix
 	BorrowInterestFactorPrefix       = types.BorrowInterestFactorPrefix
 	BorrowedCoinsPrefix              = types.BorrowedCoinsPrefix
+	BorrowsByDenomPrefix             = types.BorrowsByDenomPrefix
 	BorrowsKeyPrefix                 = types.BorrowsKeyPrefix
 	DefaultAccumulationTimes         = types.DefaultAccumulationTimes
+	DefaultBorrowHistories           = types.DefaultBorrowHistories
+	DefaultBorrowHistoryLength       = types.DefaultBorrowHistoryLength
 	DefaultBorrows                   = types.DefaultBorrows
+	DefaultCloseFactor               = types.DefaultCloseFactor
 	DefaultDeposits                  = types.DefaultDeposits
+	DefaultHealthFactorWarning       = types.DefaultHealthFactorWarning
+	DefaultInterestAudits            = types.DefaultInterestAudits
+	DefaultLiquidationGasBudget      = types.DefaultLiquidationGasBudget
+	DefaultLiquidationOrder          = types.DefaultLiquidationOrder
+	DefaultMaxAnnualRate             = types.DefaultMaxAnnualRate
+	DefaultMaxBorrowAPY              = types.DefaultMaxBorrowAPY
+	DefaultMaxStrategyAllocation     = types.DefaultMaxStrategyAllocation
+	DefaultMinBorrowAPY              = types.DefaultMinBorrowAPY
+	DefaultMinimumAccrualInterval    = types.DefaultMinimumAccrualInterval
 	DefaultMoneyMarkets              = types.DefaultMoneyMarkets
+	DefaultMoneyMarketWindDowns      = types.DefaultMoneyMarketWindDowns
+	DefaultReferralVolumes           = types.DefaultReferralVolumes
+	DefaultRepayFirstAddresses       = types.DefaultRepayFirstAddresses
+	DefaultScheduledMoneyMarkets     = types.DefaultScheduledMoneyMarkets
+	DefaultStopLosses                = types.DefaultStopLosses
+	DefaultStrategyAllocations       = types.DefaultStrategyAllocations
+	DefaultSupplyLimit               = types.DefaultSupplyLimit
+	DefaultTierBorrowRules           = types.DefaultTierBorrowRules
 	DefaultTotalBorrowed             = types.DefaultTotalBorrowed
 	DefaultTotalReserves             = types.DefaultTotalReserves
 	DefaultTotalSupplied             = types.DefaultTotalSupplied
+	DefaultWithdrawFee               = types.DefaultWithdrawFee
 	DepositsKeyPrefix                = types.DepositsKeyPrefix
 	ErrAccountNotFound               = types.ErrAccountNotFound
 	ErrBorrowEmptyCoins              = types.ErrBorrowEmptyCoins
@@ -100,73 +259,177 @@ var (
 	ErrBorrowNotFound                = types.ErrBorrowNotFound
 	ErrBorrowNotLiquidatable         = types.ErrBorrowNotLiquidatable
 	ErrBorrowedCoinsNotFound         = types.ErrBorrowedCoinsNotFound
+	ErrConversionFactorMismatch      = types.ErrConversionFactorMismatch
 	ErrDepositNotFound               = types.ErrDepositNotFound
 	ErrDepositsNotFound              = types.ErrDepositsNotFound
+	ErrExceedsMaxAnnualRate          = types.ErrExceedsMaxAnnualRate
+	ErrExceedsSupplyLimit            = types.ErrExceedsSupplyLimit
 	ErrGreaterThanAssetBorrowLimit   = types.ErrGreaterThanAssetBorrowLimit
+	ErrInsufficientBalanceForDeposit = types.ErrInsufficientBalanceForDeposit
 	ErrInsufficientBalanceForBorrow  = types.ErrInsufficientBalanceForBorrow
 	ErrInsufficientBalanceForRepay   = types.ErrInsufficientBalanceForRepay
 	ErrInsufficientCoins             = types.ErrInsufficientCoins
 	ErrInsufficientLoanToValue       = types.ErrInsufficientLoanToValue
 	ErrInsufficientModAccountBalance = types.ErrInsufficientModAccountBalance
+	ErrInsufficientReserves          = types.ErrInsufficientReserves
+	ErrInsufficientTierCollateral    = types.ErrInsufficientTierCollateral
 	ErrInvalidAccountType            = types.ErrInvalidAccountType
+	ErrInvalidActivationTime         = types.ErrInvalidActivationTime
+	ErrInvalidCurvePoints            = types.ErrInvalidCurvePoints
 	ErrInvalidDepositDenom           = types.ErrInvalidDepositDenom
 	ErrInvalidReceiver               = types.ErrInvalidReceiver
 	ErrInvalidRepaymentDenom         = types.ErrInvalidRepaymentDenom
+	ErrInvalidSafetyMargin           = types.ErrInvalidSafetyMargin
+	ErrInvalidSimulationAction       = types.ErrInvalidSimulationAction
+	ErrInvalidStopLoss               = types.ErrInvalidStopLoss
 	ErrInvalidWithdrawAmount         = types.ErrInvalidWithdrawAmount
 	ErrInvalidWithdrawDenom          = types.ErrInvalidWithdrawDenom
+	ErrInvalidWindDownDeadline       = types.ErrInvalidWindDownDeadline
+	ErrLiquidatorNotPermitted        = types.ErrLiquidatorNotPermitted
 	ErrMarketNotFound                = types.ErrMarketNotFound
+	ErrMoneyMarketExists             = types.ErrMoneyMarketExists
+	ErrMoneyMarketDeprecated         = types.ErrMoneyMarketDeprecated
 	ErrMoneyMarketNotFound           = types.ErrMoneyMarketNotFound
 	ErrNegativeBorrowedCoins         = types.ErrNegativeBorrowedCoins
 	ErrNegativeSuppliedCoins         = types.ErrNegativeSuppliedCoins
 	ErrPreviousAccrualTimeNotFound   = types.ErrPreviousAccrualTimeNotFound
+	ErrPricefeedMarketInactive       = types.ErrPricefeedMarketInactive
 	ErrPriceNotFound                 = types.ErrPriceNotFound
+	ErrStalePrice                    = types.ErrStalePrice
+	ErrStopLossNotFound              = types.ErrStopLossNotFound
+	ErrStrategyNotFound              = types.ErrStrategyNotFound
+	ErrStrategyWithdrawal            = types.ErrStrategyWithdrawal
 	ErrSuppliedCoinsNotFound         = types.ErrSuppliedCoinsNotFound
 	GovDenom                         = types.GovDenom
+	InterestAuditPrefix              = types.InterestAuditPrefix
+	InterestRateModelChangePrefix    = types.InterestRateModelChangePrefix
+	KeyBorrowHistoryLength           = types.KeyBorrowHistoryLength
+	KeyHealthFactorWarning           = types.KeyHealthFactorWarning
+	KeyLiquidationGasBudget          = types.KeyLiquidationGasBudget
+	KeyLiquidationOrder              = types.KeyLiquidationOrder
+	KeyMaxAnnualRate                 = types.KeyMaxAnnualRate
+	KeyMinimumAccrualInterval        = types.KeyMinimumAccrualInterval
 	KeyMoneyMarkets                  = types.KeyMoneyMarkets
+	KeySwapLiquidations              = types.KeySwapLiquidations
+	KeyTierBorrowRules               = types.KeyTierBorrowRules
+	LiquidationCursorKey             = types.LiquidationCursorKey
+	LiquidationHealthFactor          = types.LiquidationHealthFactor
+	MaxHealthFactor                  = types.MaxHealthFactor
 	ModuleCdc                        = types.ModuleCdc
+	MoneyMarketWindDownsPrefix       = types.MoneyMarketWindDownsPrefix
 	MoneyMarketsPrefix               = types.MoneyMarketsPrefix
 	PreviousAccrualTimePrefix        = types.PreviousAccrualTimePrefix
+	ReferralVolumePrefix             = types.ReferralVolumePrefix
+	RepayFirstPrefix                 = types.RepayFirstPrefix
+	ScheduledMoneyMarketsPrefix      = types.ScheduledMoneyMarketsPrefix
+	StopLossesPrefix                 = types.StopLossesPrefix
+	StoreVersionKey                  = types.StoreVersionKey
+	StrategyAllocationsPrefix        = types.StrategyAllocationsPrefix
 	SuppliedCoinsPrefix              = types.SuppliedCoinsPrefix
 	SupplyInterestFactorPrefix       = types.SupplyInterestFactorPrefix
 	TotalReservesPrefix              = types.TotalReservesPrefix
+
+	DefaultVestingDeposits   = types.DefaultVestingDeposits
+	ErrInvalidVestingDeposit = types.ErrInvalidVestingDeposit
+	ProtocolLiquidityAddress = types.ProtocolLiquidityAddress
+	VestingDepositsPrefix    = types.VestingDepositsPrefix
 )
 
 type (
-	Keeper                    = keeper.Keeper
-	LiqData                   = keeper.LiqData
-	AccountKeeper             = types.AccountKeeper
-	AuctionKeeper             = types.AuctionKeeper
-	Borrow                    = types.Borrow
-	BorrowInterestFactor      = types.BorrowInterestFactor
-	BorrowInterestFactors     = types.BorrowInterestFactors
-	BorrowLimit               = types.BorrowLimit
-	Borrows                   = types.Borrows
-	Deposit                   = types.Deposit
-	Deposits                  = types.Deposits
-	GenesisAccumulationTime   = types.GenesisAccumulationTime
-	GenesisAccumulationTimes  = types.GenesisAccumulationTimes
-	GenesisState              = types.GenesisState
-	HARDHooks                 = types.HARDHooks
-	InterestRateModel         = types.InterestRateModel
-	InterestRateModels        = types.InterestRateModels
-	MoneyMarket               = types.MoneyMarket
-	MoneyMarkets              = types.MoneyMarkets
-	MsgBorrow                 = types.MsgBorrow
-	MsgDeposit                = types.MsgDeposit
-	MsgLiquidate              = types.MsgLiquidate
-	MsgRepay                  = types.MsgRepay
-	MsgWithdraw               = types.MsgWithdraw
-	MultiHARDHooks            = types.MultiHARDHooks
-	Params                    = types.Params
-	PricefeedKeeper           = types.PricefeedKeeper
-	QueryAccountParams        = types.QueryAccountParams
-	QueryBorrowsParams        = types.QueryBorrowsParams
-	QueryDepositsParams       = types.QueryDepositsParams
-	QueryTotalBorrowedParams  = types.QueryTotalBorrowedParams
-	QueryTotalDepositedParams = types.QueryTotalDepositedParams
-	StakingKeeper             = types.StakingKeeper
-	SupplyInterestFactor      = types.SupplyInterestFactor
-	SupplyInterestFactors     = types.SupplyInterestFactors
-	SupplyKeeper              = types.SupplyKeeper
-	ValuationMap              = types.ValuationMap
+	AuctionHooks                 = keeper.AuctionHooks
+	IndexKeeper                  = keeper.IndexKeeper
+	InterestKeeper               = keeper.InterestKeeper
+	Keeper                       = keeper.Keeper
+	LiqData                      = keeper.LiqData
+	LiquidationKeeper            = keeper.LiquidationKeeper
+	LiquidationResult            = keeper.LiquidationResult
+	PositionKeeper               = keeper.PositionKeeper
+	AccountKeeper                = types.AccountKeeper
+	ActivityStats                = types.ActivityStats
+	AddMoneyMarketProposal       = types.AddMoneyMarketProposal
+	AuctionKeeper                = types.AuctionKeeper
+	BlockStats                   = types.BlockStats
+	Borrow                       = types.Borrow
+	BorrowHistories              = types.BorrowHistories
+	BorrowHistory                = types.BorrowHistory
+	BorrowHistoryEntries         = types.BorrowHistoryEntries
+	BorrowHistoryEntry           = types.BorrowHistoryEntry
+	BorrowInterestFactor         = types.BorrowInterestFactor
+	BorrowInterestFactors        = types.BorrowInterestFactors
+	BorrowLimit                  = types.BorrowLimit
+	Borrows                      = types.Borrows
+	DelistMoneyMarketProposal    = types.DelistMoneyMarketProposal
+	Deposit                      = types.Deposit
+	Deposits                     = types.Deposits
+	GenesisAccumulationTime      = types.GenesisAccumulationTime
+	GenesisAccumulationTimes     = types.GenesisAccumulationTimes
+	GenesisState                 = types.GenesisState
+	HARDHooks                    = types.HARDHooks
+	InterestAudit                = types.InterestAudit
+	InterestAudits               = types.InterestAudits
+	InterestRateCurve            = types.InterestRateCurve
+	InterestRateCurvePoint       = types.InterestRateCurvePoint
+	InterestRateModel            = types.InterestRateModel
+	InterestRateModelChange      = types.InterestRateModelChange
+	InterestRateModels           = types.InterestRateModels
+	Metrics                      = types.Metrics
+	MoneyMarket                  = types.MoneyMarket
+	MoneyMarkets                 = types.MoneyMarkets
+	MoneyMarketWindDown          = types.MoneyMarketWindDown
+	MoneyMarketWindDowns         = types.MoneyMarketWindDowns
+	MsgAccrueInterest            = types.MsgAccrueInterest
+	MsgBorrow                    = types.MsgBorrow
+	MsgDeposit                   = types.MsgDeposit
+	MsgLiquidate                 = types.MsgLiquidate
+	MsgRepay                     = types.MsgRepay
+	MsgSetRepayFirst             = types.MsgSetRepayFirst
+	MsgSetStopLoss               = types.MsgSetStopLoss
+	MsgWithdraw                  = types.MsgWithdraw
+	MultiHARDHooks               = types.MultiHARDHooks
+	Params                       = types.Params
+	PositionHealth               = types.PositionHealth
+	PriceSource                  = types.PriceSource
+	PricefeedKeeper              = types.PricefeedKeeper
+	QueryAccountParams           = types.QueryAccountParams
+	QueryAccrualTimesParams      = types.QueryAccrualTimesParams
+	QueryBorrowHistoryParams     = types.QueryBorrowHistoryParams
+	QueryBorrowsParams           = types.QueryBorrowsParams
+	QueryDepositsParams          = types.QueryDepositsParams
+	QueryHealthFactorParams      = types.QueryHealthFactorParams
+	QueryInterestAuditsParams    = types.QueryInterestAuditsParams
+	QueryInterestRateCurveParams = types.QueryInterestRateCurveParams
+	QueryMaxAmountParams         = types.QueryMaxAmountParams
+	QueryReferralVolumesParams   = types.QueryReferralVolumesParams
+	QuerySimulationParams        = types.QuerySimulationParams
+	QueryStopLossesParams        = types.QueryStopLossesParams
+	QueryTotalBorrowedParams     = types.QueryTotalBorrowedParams
+	QueryTotalDepositedParams    = types.QueryTotalDepositedParams
+	QueryWindDownsParams         = types.QueryWindDownsParams
+	ReferralVolume               = types.ReferralVolume
+	ReferralVolumes              = types.ReferralVolumes
+	ReservePayout                = types.ReservePayout
+	ReservePayoutProposal        = types.ReservePayoutProposal
+	ReservePayouts               = types.ReservePayouts
+	ScheduledMoneyMarket         = types.ScheduledMoneyMarket
+	ScheduledMoneyMarkets        = types.ScheduledMoneyMarkets
+	SimulatedPosition            = types.SimulatedPosition
+	StakingKeeper                = types.StakingKeeper
+	StopLoss                     = types.StopLoss
+	StopLosses                   = types.StopLosses
+	SupplyInterestFactor         = types.SupplyInterestFactor
+	SupplyInterestFactors        = types.SupplyInterestFactors
+	SupplyKeeper                 = types.SupplyKeeper
+	SwapKeeper                   = types.SwapKeeper
+	SwapLiquidation              = types.SwapLiquidation
+	SwapLiquidations             = types.SwapLiquidations
+	TierBorrowRule               = types.TierBorrowRule
+	TierBorrowRules              = types.TierBorrowRules
+	ValuationMap                 = types.ValuationMap
+	WindDownProgress             = types.WindDownProgress
+	WindDownProgresses           = types.WindDownProgresses
+
+	SeedProtocolLiquidityProposal     = types.SeedProtocolLiquidityProposal
+	VestingDeposit                    = types.VestingDeposit
+	VestingDeposits                   = types.VestingDeposits
+	WithdrawProtocolLiquidityProposal = types.WithdrawProtocolLiquidityProposal
 )
@@ -19,9 +19,13 @@ import (
 
 // flags for cli queries
 const (
-	flagName  = "name"
-	flagDenom = "denom"
-	flagOwner = "owner"
+	flagName         = "name"
+	flagDenom        = "denom"
+	flagOwner        = "owner"
+	flagReferrer     = "referrer"
+	flagBorrower     = "borrower"
+	flagSafetyMargin = "safety-margin"
+	flagPoints       = "points"
 )
 
 // GetQueryCmd returns the cli query commands for the  module
@@ -42,7 +46,19 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		queryBorrowsCmd(queryRoute, cdc),
 		queryTotalBorrowedCmd(queryRoute, cdc),
 		queryInterestRateCmd(queryRoute, cdc),
+		queryInterestRateCurveCmd(queryRoute, cdc),
+		queryAccrualTimesCmd(queryRoute, cdc),
+		queryReferralVolumesCmd(queryRoute, cdc),
+		queryStopLossesCmd(queryRoute, cdc),
+		queryBorrowHistoryCmd(queryRoute, cdc),
+		queryHealthFactorCmd(queryRoute, cdc),
+		queryInterestAuditsCmd(queryRoute, cdc),
+		queryWindDownsCmd(queryRoute, cdc),
+		queryMaxWithdrawCmd(queryRoute, cdc),
+		queryMaxBorrowCmd(queryRoute, cdc),
+		queryProtocolLiquidityCmd(queryRoute, cdc),
 	)...)
+	hardQueryCmd.AddCommand(querySimulateCmd(queryRoute, cdc))
 
 	return hardQueryCmd
 }
@@ -232,6 +248,93 @@ func queryBorrowsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func queryBorrowHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "borrow-history [owner]",
+		Short: "get an account's recent borrow originations and repayments",
+		Long: strings.TrimSpace(`get an account's recent borrow originations and repayments, newest first:
+
+		Example:
+		$ kvcli q hard borrow-history kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+		$ kvcli q hard borrow-history kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --page 2 --limit 10`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			params := types.NewQueryBorrowHistoryParams(page, limit, owner)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBorrowHistory)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var entries types.BorrowHistoryEntries
+			if err := cdc.UnmarshalJSON(res, &entries); err != nil {
+				return fmt.Errorf("failed to unmarshal borrow history: %w", err)
+			}
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+	return cmd
+}
+
+func queryHealthFactorCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "health-factor [owner]",
+		Short: "get the health factor of an account's position",
+		Long: strings.TrimSpace(`get the health factor of an account's position, the value of its borrows divided by the value it can borrow.
+		Positions with a health factor above 1 can be liquidated:
+
+		Example:
+		$ kvcli q hard health-factor kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHealthFactorParams(owner))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetHealthFactor)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var health types.PositionHealth
+			if err := cdc.UnmarshalJSON(res, &health); err != nil {
+				return fmt.Errorf("failed to unmarshal position health: %w", err)
+			}
+			return cliCtx.PrintOutput(health)
+		},
+	}
+}
+
 func queryTotalBorrowedCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "total-borrowed",
@@ -360,3 +463,499 @@ func queryInterestRateCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagDenom, "", "(optional) filter interest rates by denom")
 	return cmd
 }
+
+func queryInterestRateCurveCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-rate-curve [denom]",
+		Short: "get a money market's borrow and supply interest rates across utilization ratios",
+		Long: strings.TrimSpace(`get a money market's borrow and supply interest rates at evenly spaced utilization ratios from 0 to 1:
+
+		Example:
+		$ kvcli q hard interest-rate-curve bnb
+		$ kvcli q hard interest-rate-curve bnb --points 21`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Construct query with params
+			params := types.NewQueryInterestRateCurveParams(args[0], viper.GetInt(flagPoints))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetInterestRateCurve)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var curve types.InterestRateCurve
+			if err := cdc.UnmarshalJSON(res, &curve); err != nil {
+				return fmt.Errorf("failed to unmarshal interest rate curve: %w", err)
+			}
+			return cliCtx.PrintOutput(curve)
+		},
+	}
+	cmd.Flags().Int(flagPoints, types.DefaultInterestRateCurvePoints, fmt.Sprintf("number of utilization ratios sampled, between 2 and %d", types.MaxInterestRateCurvePoints))
+	return cmd
+}
+
+func queryAccrualTimesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accrual-times",
+		Short: "get the previous interest accrual time and interest factors of money markets",
+		Long: strings.TrimSpace(`get the previous interest accrual time and interest factors of money markets:
+
+		Example:
+		$ kvcli q hard accrual-times
+		$ kvcli q hard accrual-times --denom bnb`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+
+			// Construct query with params
+			params := types.NewQueryAccrualTimesParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAccrualTimes)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var accrualTimes types.GenesisAccumulationTimes
+			if err := cdc.UnmarshalJSON(res, &accrualTimes); err != nil {
+				return fmt.Errorf("failed to unmarshal accrual times: %w", err)
+			}
+			return cliCtx.PrintOutput(accrualTimes)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter accrual times by denom")
+	return cmd
+}
+
+func queryReferralVolumesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-volumes",
+		Short: "get the total volume of deposits referred by each referrer",
+		Long: strings.TrimSpace(`get the total volume of deposits referred by each referrer:
+
+		Example:
+		$ kvcli q hard referral-volumes
+		$ kvcli q hard referral-volumes --referrer kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var referrer sdk.AccAddress
+			referrerBech := viper.GetString(flagReferrer)
+			if len(referrerBech) != 0 {
+				referrerAccAddress, err := sdk.AccAddressFromBech32(referrerBech)
+				if err != nil {
+					return err
+				}
+				referrer = referrerAccAddress
+			}
+
+			// Construct query with params
+			params := types.NewQueryReferralVolumesParams(referrer)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetReferralVolumes)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var volumes types.ReferralVolumes
+			if err := cdc.UnmarshalJSON(res, &volumes); err != nil {
+				return fmt.Errorf("failed to unmarshal referral volumes: %w", err)
+			}
+			return cliCtx.PrintOutput(volumes)
+		},
+	}
+	cmd.Flags().String(flagReferrer, "", "(optional) filter referral volumes by referrer address")
+	return cmd
+}
+
+func queryStopLossesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stop-losses",
+		Short: "get the stop losses registered by borrowers",
+		Long: strings.TrimSpace(`get the stop losses registered by borrowers:
+
+		Example:
+		$ kvcli q hard stop-losses
+		$ kvcli q hard stop-losses --borrower kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var borrower sdk.AccAddress
+			borrowerBech := viper.GetString(flagBorrower)
+			if len(borrowerBech) != 0 {
+				borrowerAccAddress, err := sdk.AccAddressFromBech32(borrowerBech)
+				if err != nil {
+					return err
+				}
+				borrower = borrowerAccAddress
+			}
+
+			// Construct query with params
+			params := types.NewQueryStopLossesParams(borrower)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetStopLosses)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var stopLosses types.StopLosses
+			if err := cdc.UnmarshalJSON(res, &stopLosses); err != nil {
+				return fmt.Errorf("failed to unmarshal stop losses: %w", err)
+			}
+			return cliCtx.PrintOutput(stopLosses)
+		},
+	}
+	cmd.Flags().String(flagBorrower, "", "(optional) filter stop losses by borrower address")
+	return cmd
+}
+
+func queryInterestAuditsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "interest-audits",
+		Short: "get the interest charged to borrowers, credited to suppliers, and reserved by money markets",
+		Long: strings.TrimSpace(`get the interest charged to borrowers, credited to suppliers, and reserved by money markets since genesis,
+along with the residual and the rounding dust, to verify that interest is conserved:
+
+		Example:
+		$ kvcli q hard interest-audits
+		$ kvcli q hard interest-audits --denom bnb`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+
+			// Construct query with params
+			params := types.NewQueryInterestAuditsParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetInterestAudits)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var audits types.InterestAudits
+			if err := cdc.UnmarshalJSON(res, &audits); err != nil {
+				return fmt.Errorf("failed to unmarshal interest audits: %w", err)
+			}
+			return cliCtx.PrintOutput(audits)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter interest audits by denom")
+	return cmd
+}
+
+func queryWindDownsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wind-downs",
+		Short: "get the wind down progress of deprecated money markets",
+		Long: strings.TrimSpace(`get the wind down deadline of deprecated money markets, along with the supplied and borrowed
+amounts and the number of depositors and borrowers that remain open in each market:
+
+		Example:
+		$ kvcli q hard wind-downs
+		$ kvcli q hard wind-downs --denom bnb`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			denom := viper.GetString(flagDenom)
+
+			// Construct query with params
+			params := types.NewQueryWindDownsParams(denom)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetWindDowns)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var progresses types.WindDownProgresses
+			if err := cdc.UnmarshalJSON(res, &progresses); err != nil {
+				return fmt.Errorf("failed to unmarshal wind downs: %w", err)
+			}
+			return cliCtx.PrintOutput(progresses)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter wind downs by denom")
+	return cmd
+}
+
+func queryMaxWithdrawCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-withdraw [address] [denom]",
+		Short: "get the largest amount of a denom an account can withdraw while staying within its loan-to-value limit",
+		Long: strings.TrimSpace(`get the largest amount of a denom an account can withdraw while staying within its loan-to-value limit,
+including interest that has not been synced. The safety margin is the fraction of the account's borrowable value left unused:
+
+		Example:
+		$ kvcli q hard max-withdraw kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny bnb
+		$ kvcli q hard max-withdraw kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny bnb --safety-margin 0.1`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryMaxAmount(cdc, queryRoute, types.QueryGetMaxWithdraw, args[0], args[1])
+		},
+	}
+	cmd.Flags().String(flagSafetyMargin, "0", "(optional) fraction of the borrowable value to leave unused, in the range [0, 1)")
+	return cmd
+}
+
+func queryMaxBorrowCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "max-borrow [address] [denom]",
+		Short: "get the largest amount of a denom an account can borrow while staying within its loan-to-value limit",
+		Long: strings.TrimSpace(`get the largest amount of a denom an account can borrow while staying within its loan-to-value limit,
+including interest that has not been synced. The amount is also limited by the money market's borrow limit and the coins
+available to borrow. The safety margin is the fraction of the account's borrowable value left unused:
+
+		Example:
+		$ kvcli q hard max-borrow kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny usdx
+		$ kvcli q hard max-borrow kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny usdx --safety-margin 0.1`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryMaxAmount(cdc, queryRoute, types.QueryGetMaxBorrow, args[0], args[1])
+		},
+	}
+	cmd.Flags().String(flagSafetyMargin, "0", "(optional) fraction of the borrowable value to leave unused, in the range [0, 1)")
+	return cmd
+}
+
+// queryMaxAmount executes a max withdraw or max borrow query and prints the result
+func queryMaxAmount(cdc *codec.Codec, queryRoute, path, ownerBech, denom string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	owner, err := sdk.AccAddressFromBech32(ownerBech)
+	if err != nil {
+		return err
+	}
+	safetyMargin, err := sdk.NewDecFromStr(viper.GetString(flagSafetyMargin))
+	if err != nil {
+		return err
+	}
+
+	// Construct query with params
+	params := types.NewQueryMaxAmountParams(owner, denom, safetyMargin)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	// Execute query
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, path)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+	cliCtx = cliCtx.WithHeight(height)
+
+	// Decode and print results
+	var amount sdk.Coin
+	if err := cdc.UnmarshalJSON(res, &amount); err != nil {
+		return fmt.Errorf("failed to unmarshal amount: %w", err)
+	}
+	return cliCtx.PrintOutput(amount)
+}
+
+func querySimulateCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	simulateCmd := &cobra.Command{
+		Use:                        "simulate",
+		Short:                      "dry run a change to a hard position and print the projected outcome",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	simulateCmd.AddCommand(flags.GetCommands(
+		querySimulatePositionCmd(queryRoute, cdc, types.SimulationDeposit),
+		querySimulatePositionCmd(queryRoute, cdc, types.SimulationWithdraw),
+		querySimulatePositionCmd(queryRoute, cdc, types.SimulationBorrow),
+		querySimulateRepayCmd(queryRoute, cdc),
+		querySimulateLiquidationCmd(queryRoute, cdc),
+	)...)
+
+	return simulateCmd
+}
+
+func querySimulatePositionCmd(queryRoute string, cdc *codec.Codec, action string) *cobra.Command {
+	return &cobra.Command{
+		Use:   fmt.Sprintf("%s [address] [amount]", action),
+		Short: fmt.Sprintf("simulate a %s and print the resulting position, loan-to-value ratio, and accrued interest", action),
+		Long: strings.TrimSpace(fmt.Sprintf(`simulate a %[1]s against the current state and print the resulting position, its loan-to-value ratio,
+the interest accrued since the position was last synced, and whether the %[1]s would succeed, with the reason if not:
+
+		Example:
+		$ kvcli q hard simulate %[1]s kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 10000000ukava`, action),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySimulation(cdc, queryRoute, action, args[0], args[0], args[1])
+		},
+	}
+}
+
+func querySimulateRepayCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "repay [address] [amount]",
+		Short: "simulate a repayment and print the resulting position, loan-to-value ratio, and accrued interest",
+		Long: strings.TrimSpace(`simulate a repayment against the current state and print the resulting position, its loan-to-value ratio,
+the interest accrued since the position was last synced, and whether the repayment would succeed, with the reason if not.
+The owner flag simulates repaying another account's borrow:
+
+		Example:
+		$ kvcli q hard simulate repay kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000usdx
+		$ kvcli q hard simulate repay kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny 1000000usdx --owner kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner := viper.GetString(flagOwner)
+			if len(owner) == 0 {
+				owner = args[0]
+			}
+			return querySimulation(cdc, queryRoute, types.SimulationRepay, args[0], owner, args[1])
+		},
+	}
+	cmd.Flags().String(flagOwner, "", "(optional) account whose borrow is repaid, defaults to the sender")
+	return cmd
+}
+
+func querySimulateLiquidationCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate [keeper] [borrower]",
+		Short: "simulate a keeper liquidating a borrower and print the resulting position and loan-to-value ratio",
+		Long: strings.TrimSpace(`simulate a keeper liquidating a borrower against the current state and print the borrower's resulting position,
+its loan-to-value ratio, the interest accrued since the position was last synced, and whether the liquidation would succeed,
+with the reason if not:
+
+		Example:
+		$ kvcli q hard simulate liquidate kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj`,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return querySimulation(cdc, queryRoute, types.SimulationLiquidation, args[0], args[1], "")
+		},
+	}
+}
+
+// querySimulation executes a simulate query and prints the simulated position
+func querySimulation(cdc *codec.Codec, queryRoute, action, senderBech, ownerBech, amountStr string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	sender, err := sdk.AccAddressFromBech32(senderBech)
+	if err != nil {
+		return err
+	}
+	owner, err := sdk.AccAddressFromBech32(ownerBech)
+	if err != nil {
+		return err
+	}
+	amount, err := sdk.ParseCoins(amountStr)
+	if err != nil {
+		return err
+	}
+
+	// Construct query with params
+	params := types.NewQuerySimulationParams(action, sender, owner, amount)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	// Execute query
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSimulation)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+	cliCtx = cliCtx.WithHeight(height)
+
+	// Decode and print results
+	var simulated types.SimulatedPosition
+	if err := cdc.UnmarshalJSON(res, &simulated); err != nil {
+		return fmt.Errorf("failed to unmarshal simulated position: %w", err)
+	}
+	return cliCtx.PrintOutput(simulated)
+}
+
+func queryProtocolLiquidityCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "protocol-liquidity",
+		Short: "get the protocol owned liquidity supplied to hard",
+		Long:  "Get the coins supplied to hard from the community pool by governance, including their supply interest.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetProtocolLiquidity)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var coins sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &coins); err != nil {
+				return fmt.Errorf("failed to unmarshal protocol liquidity: %w", err)
+			}
+			return cliCtx.PrintOutput(coins)
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -31,11 +32,14 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 
 	hardTxCmd.AddCommand(flags.PostCommands(
-		getCmdDeposit(cdc),
+		addOptionalFlag(getCmdDeposit(cdc), flagReferrer, "", "address of the referrer credited with the deposit"),
 		getCmdWithdraw(cdc),
 		getCmdBorrow(cdc),
 		addOptionalFlag(getCmdRepay(cdc), flagOwner, "", "original borrower's address whose loan will be repaid"),
 		getCmdLiquidate(cdc),
+		getCmdAccrueInterest(cdc),
+		getCmdSetRepayFirst(cdc),
+		getCmdSetStopLoss(cdc),
 	)...)
 
 	return hardTxCmd
@@ -53,7 +57,9 @@ func getCmdDeposit(cdc *codec.Codec) *cobra.Command {
 		Use:   "deposit [amount]",
 		Short: "deposit coins to hard",
 		Example: fmt.Sprintf(
-			`%s tx %s deposit 10000000bnb --from <key>`, version.ClientName, types.ModuleName,
+			`%s tx %s deposit 10000000bnb --from <key>
+%s tx %s deposit 10000000bnb --referrer kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --from <key>`,
+			version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -64,6 +70,13 @@ func getCmdDeposit(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 			msg := types.NewMsgDeposit(cliCtx.GetFromAddress(), amount)
+			if referrerBech := viper.GetString(flagReferrer); len(referrerBech) != 0 {
+				referrer, err := sdk.AccAddressFromBech32(referrerBech)
+				if err != nil {
+					return err
+				}
+				msg.Referrer = referrer
+			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -196,3 +209,86 @@ func getCmdLiquidate(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdAccrueInterest(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "accrue-interest [denom]",
+		Short: "accrue interest for a money market, bringing its interest factors up to date",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s accrue-interest bnb --from <key>`, version.ClientName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgAccrueInterest(cliCtx.GetFromAddress(), args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSetRepayFirst(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-repay-first [true|false]",
+		Short: "set whether deposits of a borrowed denom repay the borrow before being deposited",
+		Args:  cobra.ExactArgs(1),
+		Example: fmt.Sprintf(
+			`%s tx %s set-repay-first true --from <key>`, version.ClientName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			enabled, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetRepayFirst(cliCtx.GetFromAddress(), enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSetStopLoss(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-stop-loss [ltv-threshold] [max-repay-value]",
+		Short: "repay up to a USD value of your borrow from your deposits when your LTV rises above a threshold",
+		Long: strings.TrimSpace(`Set a stop loss that reduces your borrow at the end of any block in which the LTV of your position, the USD
+value of your borrows divided by the USD value of your deposits, is above the threshold. Deposits of a borrowed denom
+repay it directly, then other deposits with a swap liquidation configured are sold for the borrowed denoms, until up
+to the max repay value in USD is repaid. A threshold of zero removes your stop loss.`),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(
+			`%s tx %s set-stop-loss 0.65 500 --from <key>
+%s tx %s set-stop-loss 0 0 --from <key>`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			ltvThreshold, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			maxRepayValue, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSetStopLoss(cliCtx.GetFromAddress(), ltvThreshold, maxRepayValue)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
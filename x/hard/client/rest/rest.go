@@ -11,9 +11,13 @@ import (
 // REST variable names
 // nolint
 const (
-	RestOwner = "owner"
-	RestDenom = "denom"
-	RestName  = "name"
+	RestOwner        = "owner"
+	RestDenom        = "denom"
+	RestReferrer     = "referrer"
+	RestBorrower     = "borrower"
+	RestName         = "name"
+	RestSafetyMargin = "safety_margin"
+	RestPoints       = "points"
 )
 
 // RegisterRoutes registers hard-related REST handlers to a router
@@ -24,9 +28,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 // PostCreateDepositReq defines the properties of a deposit create request's body
 type PostCreateDepositReq struct {
-	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
-	From    sdk.AccAddress `json:"from" yaml:"from"`
-	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From     sdk.AccAddress `json:"from" yaml:"from"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
 }
 
 // PostCreateWithdrawReq defines the properties of a deposit withdraw request's body
@@ -51,6 +56,28 @@ type PostRepayReq struct {
 	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
 }
 
+// PostAccrueInterestReq defines the properties of an accrue interest request's body
+type PostAccrueInterestReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Denom   string         `json:"denom" yaml:"denom"`
+}
+
+// PostSetRepayFirstReq defines the properties of a set repay first request's body
+type PostSetRepayFirstReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Enabled bool           `json:"enabled" yaml:"enabled"`
+}
+
+// PostSetStopLossReq defines the properties of a set stop loss request's body
+type PostSetStopLossReq struct {
+	BaseReq       rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From          sdk.AccAddress `json:"from" yaml:"from"`
+	LtvThreshold  sdk.Dec        `json:"ltv_threshold" yaml:"ltv_threshold"`
+	MaxRepayValue sdk.Dec        `json:"max_repay_value" yaml:"max_repay_value"`
+}
+
 // PostLiquidateReq defines the properties of a liquidate request's body
 type PostLiquidateReq struct {
 	BaseReq  rest.BaseReq   `json:"base_req" yaml:"base_req"`
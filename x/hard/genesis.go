@@ -9,11 +9,23 @@ import (
 )
 
 // InitGenesis initializes the store state from a genesis state.
-func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
+// The pricefeed and auth genesis MUST be initialized before hard, as the state is checked against them.
+func InitGenesis(ctx sdk.Context, k Keeper, pricefeedKeeper types.PricefeedKeeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
 	if err := gs.Validate(); err != nil {
 		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
 	}
 
+	// check if the module account exists
+	DepositModuleAccount := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
+	if DepositModuleAccount == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleAccountName))
+	}
+
+	if err := validateGenesisConsistency(ctx, pricefeedKeeper, DepositModuleAccount.GetCoins(), gs); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetStoreVersion(ctx, types.StoreVersion)
 	k.SetParams(ctx, gs.Params)
 
 	for _, mm := range gs.Params.MoneyMarkets {
@@ -21,7 +33,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 	}
 
 	for _, gat := range gs.PreviousAccumulationTimes {
-		k.SetPreviousAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+		// a zero accrual time means the market starts accruing interest from the first block
+		if !gat.PreviousAccumulationTime.IsZero() {
+			k.SetPreviousAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+		}
 		k.SetSupplyInterestFactor(ctx, gat.CollateralType, gat.SupplyInterestFactor)
 		k.SetBorrowInterestFactor(ctx, gat.CollateralType, gat.BorrowInterestFactor)
 	}
@@ -34,15 +49,85 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 		k.SetBorrow(ctx, borrow)
 	}
 
+	for _, addr := range gs.RepayFirstAddresses {
+		k.SetRepayFirst(ctx, addr, true)
+	}
+
+	for _, rv := range gs.ReferralVolumes {
+		k.SetReferralVolume(ctx, rv.Referrer, rv.Volume)
+	}
+
+	for _, vd := range gs.VestingDeposits {
+		k.SetVestingDeposit(ctx, vd.Depositor, vd.Amount)
+	}
+
+	for _, ia := range gs.InterestAudits {
+		k.SetInterestAudit(ctx, ia)
+	}
+
+	for _, smm := range gs.ScheduledMoneyMarkets {
+		k.SetScheduledMoneyMarket(ctx, smm)
+	}
+
+	for _, wd := range gs.MoneyMarketWindDowns {
+		k.SetMoneyMarketWindDown(ctx, wd)
+	}
+
+	for _, sl := range gs.StopLosses {
+		k.SetStopLoss(ctx, sl)
+	}
+
+	for _, bh := range gs.BorrowHistories {
+		k.SetBorrowHistory(ctx, bh)
+	}
+
 	k.SetSuppliedCoins(ctx, gs.TotalSupplied)
 	k.SetBorrowedCoins(ctx, gs.TotalBorrowed)
 	k.SetTotalReserves(ctx, gs.TotalReserves)
+	k.SetStrategyAllocations(ctx, gs.StrategyAllocations)
+}
 
-	// check if the module account exists
-	DepositModuleAccount := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
-	if DepositModuleAccount == nil {
-		panic(fmt.Sprintf("%s module account has not been set", DepositModuleAccount))
+// validateGenesisConsistency checks the genesis state against the state of the modules hard depends on, so that
+// inconsistent state is rejected at genesis rather than failing later in the BeginBlocker
+func validateGenesisConsistency(ctx sdk.Context, pricefeedKeeper types.PricefeedKeeper, moduleBalance sdk.Coins, gs GenesisState) error {
+	listed := make(map[string]bool)
+	for _, mm := range gs.Params.MoneyMarkets {
+		listed[mm.Denom] = true
+		if _, found := pricefeedKeeper.GetMarket(ctx, mm.SpotMarketID); !found {
+			return fmt.Errorf("spot market %s of money market %s not found in pricefeed", mm.SpotMarketID, mm.Denom)
+		}
+		if mm.PriceSource == types.PriceSourceTwap || mm.PriceSource == types.PriceSourceConservative {
+			if _, found := pricefeedKeeper.GetMarket(ctx, mm.TwapMarketID); !found {
+				return fmt.Errorf("twap market %s of money market %s not found in pricefeed", mm.TwapMarketID, mm.Denom)
+			}
+		}
+	}
+
+	for _, deposit := range gs.Deposits {
+		for _, coin := range deposit.Amount {
+			if !listed[coin.Denom] {
+				return fmt.Errorf("deposit of %s has no money market for denom %s", deposit.Depositor, coin.Denom)
+			}
+		}
 	}
+	for _, borrow := range gs.Borrows {
+		for _, coin := range borrow.Amount {
+			if !listed[coin.Denom] {
+				return fmt.Errorf("borrow of %s has no money market for denom %s", borrow.Borrower, coin.Denom)
+			}
+		}
+	}
+
+	// the module account and yield strategies must hold the coins that were supplied and not borrowed, plus reserves
+	cash := moduleBalance.Add(gs.StrategyAllocations...)
+	owed := gs.TotalSupplied.Add(gs.TotalReserves...)
+	for _, coin := range owed {
+		net := coin.Amount.Sub(gs.TotalBorrowed.AmountOf(coin.Denom))
+		if cash.AmountOf(coin.Denom).LT(net) {
+			return fmt.Errorf("module account holds %s%s, less than the %s%s owed to suppliers and reserves", cash.AmountOf(coin.Denom), coin.Denom, net, coin.Denom)
+		}
+	}
+	return nil
 }
 
 // ExportGenesis export genesis state for hard module
@@ -87,22 +172,37 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	for _, mm := range params.MoneyMarkets {
 		supplyFactor, f := k.GetSupplyInterestFactor(ctx, mm.Denom)
 		if !f {
-			supplyFactor = sdk.ZeroDec()
+			supplyFactor = sdk.OneDec()
 		}
 		borrowFactor, f := k.GetBorrowInterestFactor(ctx, mm.Denom)
 		if !f {
-			borrowFactor = sdk.ZeroDec()
-		}
-		previousAccrualTime, f := k.GetPreviousAccrualTime(ctx, mm.Denom)
-		if !f {
-			previousAccrualTime = ctx.BlockTime()
+			borrowFactor = sdk.OneDec()
 		}
+		// markets that have not accrued interest yet are exported with a zero accrual time
+		previousAccrualTime, _ := k.GetPreviousAccrualTime(ctx, mm.Denom)
 		gat := types.NewGenesisAccumulationTime(mm.Denom, previousAccrualTime, supplyFactor, borrowFactor)
 		gats = append(gats, gat)
 
 	}
-	return NewGenesisState(
+
+	repayFirstAddresses := []sdk.AccAddress{}
+	k.IterateRepayFirst(ctx, func(addr sdk.AccAddress) bool {
+		repayFirstAddresses = append(repayFirstAddresses, addr)
+		return false
+	})
+
+	gs := NewGenesisState(
 		params, gats, deposits, borrows,
 		totalSupplied, totalBorrowed, totalReserves,
 	)
+	gs.RepayFirstAddresses = repayFirstAddresses
+	gs.ReferralVolumes = k.GetAllReferralVolumes(ctx)
+	gs.InterestAudits = k.GetAllInterestAudits(ctx)
+	gs.StrategyAllocations, _ = k.GetStrategyAllocations(ctx)
+	gs.ScheduledMoneyMarkets = k.GetAllScheduledMoneyMarkets(ctx)
+	gs.MoneyMarketWindDowns = k.GetAllMoneyMarketWindDowns(ctx)
+	gs.StopLosses = k.GetAllStopLosses(ctx)
+	gs.BorrowHistories = k.GetAllBorrowHistories(ctx)
+	gs.VestingDeposits = k.GetAllVestingDeposits(ctx)
+	return gs
 }
@@ -0,0 +1,107 @@
+package hard_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestInitGenesis_Consistency(t *testing.T) {
+	addr := sdk.AccAddress("test_depositor______")
+	model := hard.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	newMoneyMarket := func(denom, spotMarketID string) hard.MoneyMarket {
+		return hard.NewMoneyMarket(denom, hard.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), spotMarketID,
+			sdk.NewInt(1000000), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	}
+
+	testCases := []struct {
+		name          string
+		moneyMarkets  hard.MoneyMarkets
+		deposits      hard.Deposits
+		totalSupplied sdk.Coins
+		moduleBalance sdk.Coins
+		contains      string
+	}{
+		{
+			name:         "valid",
+			moneyMarkets: hard.MoneyMarkets{newMoneyMarket("ukava", "kava:usd")},
+			deposits: hard.Deposits{
+				hard.NewDeposit(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), hard.SupplyInterestFactors{hard.NewSupplyInterestFactor("ukava", sdk.OneDec())}),
+			},
+			totalSupplied: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)),
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)),
+		},
+		{
+			name:         "spot market not in pricefeed",
+			moneyMarkets: hard.MoneyMarkets{newMoneyMarket("ukava", "ukava:usd")},
+			contains:     "spot market ukava:usd of money market ukava not found in pricefeed",
+		},
+		{
+			name:         "deposit of unlisted denom",
+			moneyMarkets: hard.MoneyMarkets{newMoneyMarket("ukava", "kava:usd")},
+			deposits: hard.Deposits{
+				hard.NewDeposit(addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), hard.SupplyInterestFactors{hard.NewSupplyInterestFactor("bnb", sdk.OneDec())}),
+			},
+			totalSupplied: sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)),
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)),
+			contains:      "has no money market for denom bnb",
+		},
+		{
+			name:         "module account does not cover supplied coins",
+			moneyMarkets: hard.MoneyMarkets{newMoneyMarket("ukava", "kava:usd")},
+			deposits: hard.Deposits{
+				hard.NewDeposit(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), hard.SupplyInterestFactors{hard.NewSupplyInterestFactor("ukava", sdk.OneDec())}),
+			},
+			totalSupplied: sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)),
+			moduleBalance: sdk.NewCoins(sdk.NewInt64Coin("ukava", 99)),
+			contains:      "module account holds 99ukava, less than the 100ukava owed to suppliers and reserves",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hardGS := hard.NewGenesisState(hard.NewParams(tc.moneyMarkets), hard.DefaultAccumulationTimes, tc.deposits,
+				hard.DefaultBorrows, tc.totalSupplied, hard.DefaultTotalBorrowed, hard.DefaultTotalReserves)
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+			}
+			hardMacc := supply.NewEmptyModuleAccount(hard.ModuleAccountName, supply.Minter, supply.Burner)
+			hardMacc.Coins = tc.moduleBalance
+			authGS := auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{hardMacc})
+
+			tApp := app.NewTestApp()
+			init := func() {
+				tApp.InitializeFromGenesisStatesWithTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					app.GenesisState{auth.ModuleName: auth.ModuleCdc.MustMarshalJSON(authGS)},
+					app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+					app.GenesisState{hard.ModuleName: hard.ModuleCdc.MustMarshalJSON(hardGS)},
+				)
+			}
+			if tc.contains == "" {
+				require.NotPanics(t, init)
+				return
+			}
+			defer func() {
+				r := recover()
+				require.NotNil(t, r)
+				require.True(t, strings.Contains(fmt.Sprint(r), tc.contains), fmt.Sprint(r))
+			}()
+			init()
+		})
+	}
+}
@@ -23,6 +23,12 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgRepay(ctx, k, msg)
 		case types.MsgLiquidate:
 			return handleMsgLiquidate(ctx, k, msg)
+		case types.MsgAccrueInterest:
+			return handleMsgAccrueInterest(ctx, k, msg)
+		case types.MsgSetRepayFirst:
+			return handleMsgSetRepayFirst(ctx, k, msg)
+		case types.MsgSetStopLoss:
+			return handleMsgSetStopLoss(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -30,7 +36,7 @@ func NewHandler(k Keeper) sdk.Handler {
 }
 
 func handleMsgDeposit(ctx sdk.Context, k keeper.Keeper, msg types.MsgDeposit) (*sdk.Result, error) {
-	err := k.Deposit(ctx, msg.Depositor, msg.Amount)
+	err := k.DepositWithReferrer(ctx, msg.Depositor, msg.Referrer, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
@@ -118,3 +124,59 @@ func handleMsgLiquidate(ctx sdk.Context, k keeper.Keeper, msg types.MsgLiquidate
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgAccrueInterest(ctx sdk.Context, k keeper.Keeper, msg types.MsgAccrueInterest) (*sdk.Result, error) {
+	err := k.AccrueMoneyMarketInterest(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgSetRepayFirst(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetRepayFirst) (*sdk.Result, error) {
+	k.SetRepayFirst(ctx, msg.Sender, msg.Enabled)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgSetStopLoss(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetStopLoss) (*sdk.Result, error) {
+	var err error
+	if msg.IsRemoval() {
+		err = k.RemoveStopLoss(ctx, msg.Sender)
+	} else {
+		err = k.RegisterStopLoss(ctx, types.NewStopLoss(msg.Sender, msg.LtvThreshold, msg.MaxRepayValue))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// AuctionHooks wrapper struct for the hooks the hard module receives from the auction module
+type AuctionHooks struct {
+	k Keeper
+}
+
+var _ auctiontypes.AuctionHooks = AuctionHooks{}
+
+// AuctionHooks returns the hard module's auction hooks
+func (k Keeper) AuctionHooks() AuctionHooks { return AuctionHooks{k} }
+
+// AfterAuctionClosed records the result of a liquidation auction as soon as it closes. A shortfall is the part of the
+// liquidated borrow the auction did not raise, which is bad debt carried by the money market's suppliers.
+func (h AuctionHooks) AfterAuctionClosed(ctx sdk.Context, settlement auctiontypes.AuctionSettlement) {
+	if settlement.Initiator != types.ModuleAccountName || settlement.AuctionType != auctiontypes.CollateralAuctionType {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardAuctionSettlement,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", settlement.AuctionID)),
+			sdk.NewAttribute(types.AttributeKeyLot, settlement.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyProceeds, settlement.Proceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyShortfall, settlement.Shortfall.String()),
+		),
+	)
+	if settlement.Shortfall.IsPositive() {
+		h.k.Logger(ctx).Info("liquidation auction settled with a shortfall", "auction_id", settlement.AuctionID, "proceeds", settlement.Proceeds, "shortfall", settlement.Shortfall)
+	}
+}
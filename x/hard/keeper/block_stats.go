@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetBlockStats returns the stats of the deposits, withdrawals, borrows, and repays made so far in the current block.
+// Queries have no block stats.
+func (k Keeper) GetBlockStats(ctx sdk.Context) types.BlockStats {
+	if k.readOnly {
+		return types.NewBlockStats()
+	}
+	store := ctx.TransientStore(k.tkey)
+	bz := store.Get(types.BlockStatsKey)
+	if bz == nil {
+		return types.NewBlockStats()
+	}
+	var stats types.BlockStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats
+}
+
+// setBlockStats sets the stats of the current block. They are discarded at the end of the block.
+func (k Keeper) setBlockStats(ctx sdk.Context, stats types.BlockStats) {
+	if k.readOnly {
+		return
+	}
+	store := ctx.TransientStore(k.tkey)
+	store.Set(types.BlockStatsKey, k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// recordDepositStats adds a deposit to the current block's stats
+func (k Keeper) recordDepositStats(ctx sdk.Context, coins sdk.Coins) {
+	stats := k.GetBlockStats(ctx)
+	stats.Deposits = stats.Deposits.Add(k.usdValue(ctx, coins))
+	k.setBlockStats(ctx, stats)
+}
+
+// recordWithdrawalStats adds a withdrawal to the current block's stats
+func (k Keeper) recordWithdrawalStats(ctx sdk.Context, coins sdk.Coins) {
+	stats := k.GetBlockStats(ctx)
+	stats.Withdrawals = stats.Withdrawals.Add(k.usdValue(ctx, coins))
+	k.setBlockStats(ctx, stats)
+}
+
+// recordBorrowStats adds a borrow to the current block's stats
+func (k Keeper) recordBorrowStats(ctx sdk.Context, coins sdk.Coins) {
+	stats := k.GetBlockStats(ctx)
+	stats.Borrows = stats.Borrows.Add(k.usdValue(ctx, coins))
+	k.setBlockStats(ctx, stats)
+}
+
+// recordRepayStats adds a repay to the current block's stats
+func (k Keeper) recordRepayStats(ctx sdk.Context, coins sdk.Coins) {
+	stats := k.GetBlockStats(ctx)
+	stats.Repays = stats.Repays.Add(k.usdValue(ctx, coins))
+	k.setBlockStats(ctx, stats)
+}
+
+// usdValue returns the value of coins at the spot price of their money markets. Coins without a money market or a
+// price are not counted, as the stats are informational and must not cause the activity to fail.
+func (k Keeper) usdValue(ctx sdk.Context, coins sdk.Coins) sdk.Dec {
+	value := sdk.ZeroDec()
+	for _, coin := range coins {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		price, err := k.getPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			continue
+		}
+		value = value.Add(coin.Amount.ToDec().Quo(mm.ConversionFactor.ToDec()).Mul(price))
+	}
+	return value
+}
+
+// EmitBlockStats emits the stats of the current block as a single event, if there was any activity in the block
+func (k Keeper) EmitBlockStats(ctx sdk.Context) {
+	stats := k.GetBlockStats(ctx)
+	if stats.Empty() {
+		return
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardBlockStats,
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositCount, strconv.FormatUint(stats.Deposits.Count, 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositVolume, stats.Deposits.Volume.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalCount, strconv.FormatUint(stats.Withdrawals.Count, 10)),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalVolume, stats.Withdrawals.Volume.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrowCount, strconv.FormatUint(stats.Borrows.Count, 10)),
+			sdk.NewAttribute(types.AttributeKeyBorrowVolume, stats.Borrows.Volume.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCount, strconv.FormatUint(stats.Repays.Count, 10)),
+			sdk.NewAttribute(types.AttributeKeyRepayVolume, stats.Repays.Volume.String()),
+		),
+	)
+}
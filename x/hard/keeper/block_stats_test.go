@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestBlockStats() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates(genesis.NewBuilder().
+		WithAccountBalance(user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))).
+		WithModuleAccountBalance(types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF)))).
+		WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+		WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+		WithHardMarket(types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		WithHardMarket(types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		Build(),
+	)
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// no activity, no event
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	hard.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Empty(suite.ctx.EventManager().Events())
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Repay(suite.ctx, user, user, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)))))
+
+	suite.Require().Equal(types.BlockStats{
+		Deposits:    types.ActivityStats{Count: 2, Volume: sdk.NewDec(120)},
+		Withdrawals: types.ActivityStats{Count: 1, Volume: sdk.NewDec(10)},
+		Borrows:     types.ActivityStats{Count: 1, Volume: sdk.NewDec(20)},
+		Repays:      types.ActivityStats{Count: 1, Volume: sdk.NewDec(5)},
+	}, suite.keeper.GetBlockStats(suite.ctx))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	hard.EndBlocker(suite.ctx, suite.keeper)
+	suite.Require().Equal(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeHardBlockStats,
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, "1"),
+			sdk.NewAttribute(types.AttributeKeyDepositCount, "2"),
+			sdk.NewAttribute(types.AttributeKeyDepositVolume, "120.000000000000000000"),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalCount, "1"),
+			sdk.NewAttribute(types.AttributeKeyWithdrawalVolume, "10.000000000000000000"),
+			sdk.NewAttribute(types.AttributeKeyBorrowCount, "1"),
+			sdk.NewAttribute(types.AttributeKeyBorrowVolume, "20.000000000000000000"),
+			sdk.NewAttribute(types.AttributeKeyRepayCount, "1"),
+			sdk.NewAttribute(types.AttributeKeyRepayVolume, "5.000000000000000000"),
+		),
+	}, suite.ctx.EventManager().Events())
+}
@@ -22,6 +22,11 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 		}
 	}
 
+	// Accrue interest on the markets being modified
+	if err := k.SyncMoneyMarketInterest(ctx, coins); err != nil {
+		return err
+	}
+
 	// Call incentive hooks
 	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, borrower)
 	if hasExistingDeposit {
@@ -42,6 +47,9 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 	}
 
 	// Sends coins from Hard module account to user
+	if err := k.recallStrategyAllocations(ctx, coins); err != nil {
+		return err
+	}
 	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, coins)
 	if err != nil {
 		if strings.Contains(err.Error(), "insufficient account funds") {
@@ -58,6 +66,8 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 		}
 	}
 
+	k.recordBorrowHistory(ctx, borrower, types.BorrowHistoryOrigination, coins)
+
 	interestFactors := types.BorrowInterestFactors{}
 	currBorrow, foundBorrow := k.GetBorrow(ctx, borrower)
 	if foundBorrow {
@@ -103,8 +113,10 @@ func (k Keeper) Borrow(ctx sdk.Context, borrower sdk.AccAddress, coins sdk.Coins
 			sdk.NewAttribute(types.AttributeKeyBorrowCoins, coins.String()),
 		),
 	)
+	k.recordBorrowStats(ctx, coins)
 
-	return nil
+	deposit, _ := k.GetDeposit(ctx, borrower)
+	return k.emitHealthFactorWarning(ctx, deposit, borrow)
 }
 
 // ValidateBorrow validates a borrow request against borrower and protocol requirements
@@ -124,16 +136,19 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			if !found {
 				return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
 			}
+			if k.IsMoneyMarketDeprecated(ctx, coin.Denom) {
+				return sdkerrors.Wrapf(types.ErrMoneyMarketDeprecated, "cannot borrow %s", coin.Denom)
+			}
 			moneyMarketCache[coin.Denom] = newMoneyMarket
 			moneyMarket = newMoneyMarket
 		}
 
 		// Calculate this coin's USD value and add it borrow's total USD value
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		assetPrice, err := k.GetBorrowPrice(ctx, moneyMarket)
 		if err != nil {
-			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+			return err
 		}
-		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+		coinUSDValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 
 		// Validate the requested borrow value for the asset against the money market's global borrow limit
 		if moneyMarket.BorrowLimit.HasMaxLimit {
@@ -145,10 +160,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 				assetTotalBorrowedAmount = totalBorrowedCoins.AmountOf(coin.Denom)
 			}
 			newProposedAssetTotalBorrowedAmount := sdk.NewDecFromInt(assetTotalBorrowedAmount.Add(coin.Amount))
-			if newProposedAssetTotalBorrowedAmount.GT(moneyMarket.BorrowLimit.MaximumLimit) {
+			maximumLimit := moneyMarket.BorrowLimit.MaximumLimitAt(ctx.BlockTime())
+			if newProposedAssetTotalBorrowedAmount.GT(maximumLimit) {
 				return sdkerrors.Wrapf(types.ErrGreaterThanAssetBorrowLimit,
 					"proposed borrow would result in %s borrowed, but the maximum global asset borrow limit is %s",
-					newProposedAssetTotalBorrowedAmount, moneyMarket.BorrowLimit.MaximumLimit)
+					newProposedAssetTotalBorrowedAmount, maximumLimit)
 			}
 		}
 		proprosedBorrowUSDValue = proprosedBorrowUSDValue.Add(coinUSDValue)
@@ -173,11 +189,11 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 		}
 
 		// Calculate the borrowable amount and add it to the user's total borrowable amount
-		assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+		assetPrice, err := k.GetDepositPrice(ctx, moneyMarket)
 		if err != nil {
-			return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+			return err
 		}
-		depositUSDValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+		depositUSDValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 		borrowableAmountForDeposit := depositUSDValue.Mul(moneyMarket.BorrowLimit.LoanToValue)
 		totalBorrowableAmount = totalBorrowableAmount.Add(borrowableAmountForDeposit)
 	}
@@ -199,20 +215,26 @@ func (k Keeper) ValidateBorrow(ctx sdk.Context, borrower sdk.AccAddress, amount
 			}
 
 			// Calculate this borrow coin's USD value and add it to the total previous borrowed USD value
-			assetPriceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, moneyMarket.SpotMarketID)
+			assetPrice, err := k.GetBorrowPrice(ctx, moneyMarket)
 			if err != nil {
-				return sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", moneyMarket.SpotMarketID)
+				return err
 			}
-			coinUSDValue := sdk.NewDecFromInt(borrowedCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPriceInfo.Price)
+			coinUSDValue := sdk.NewDecFromInt(borrowedCoin.Amount).Quo(sdk.NewDecFromInt(moneyMarket.ConversionFactor)).Mul(assetPrice)
 			existingBorrowUSDValue = existingBorrowUSDValue.Add(coinUSDValue)
 		}
 	}
 
-	// Validate that the proposed borrow's USD value is within user's borrowable limit
-	if proprosedBorrowUSDValue.GT(totalBorrowableAmount.Sub(existingBorrowUSDValue)) {
-		return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue, "requested borrow %s exceeds the allowable amount as determined by the collateralization ratio", amount)
+	// Validate that the position would not be liquidatable after the proposed borrow
+	healthFactor := types.CalculateHealthFactor(existingBorrowUSDValue.Add(proprosedBorrowUSDValue), totalBorrowableAmount)
+	if types.IsLiquidatable(healthFactor) {
+		return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue,
+			"requested borrow %s would result in health factor %s: borrow value %s USD, existing borrows %s USD, borrowable %s USD",
+			amount, healthFactor, proprosedBorrowUSDValue, existingBorrowUSDValue, totalBorrowableAmount)
 	}
-	return nil
+
+	// Validate that the borrows of each risk tier would be backed by collateral the tier borrow rules allow
+	proposedBorrow := types.NewBorrow(borrower, existingBorrow.Amount.Add(amount...), types.BorrowInterestFactors{})
+	return k.validateRiskTiers(ctx, deposit, proposedBorrow)
 }
 
 // IncrementBorrowedCoins increments the total amount of borrowed coins by the newCoins parameter
@@ -236,7 +258,7 @@ func (k Keeper) DecrementBorrowedCoins(ctx sdk.Context, coins sdk.Coins) error {
 
 	updatedBorrowedCoins, isAnyNegative := borrowedCoins.SafeSub(coins)
 	if isAnyNegative {
-		return types.ErrNegativeBorrowedCoins
+		return sdkerrors.Wrapf(types.ErrNegativeBorrowedCoins, "cannot subtract %s from total borrowed %s", coins, borrowedCoins)
 	}
 
 	k.SetBorrowedCoins(ctx, updatedBorrowedCoins)
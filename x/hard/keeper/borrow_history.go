@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// recordBorrowHistory adds an entry for each coin borrowed or repaid to the borrower's borrow history. It must be
+// called before the borrow is modified, while the stored borrow holds the synced amount owed. The portion of a
+// repayment above the borrower's outstanding principal is interest.
+func (k Keeper) recordBorrowHistory(ctx sdk.Context, borrower sdk.AccAddress, entryType string, coins sdk.Coins) {
+	length := k.GetParams(ctx).BorrowHistoryLength
+	if length == 0 {
+		return
+	}
+
+	owed := sdk.NewCoins()
+	if borrow, found := k.GetBorrow(ctx, borrower); found {
+		owed = borrow.Amount
+	}
+	history, found := k.GetBorrowHistory(ctx, borrower)
+	if !found {
+		history = types.NewBorrowHistory(borrower, sdk.NewCoins(), types.BorrowHistoryEntries{})
+	}
+
+	principal := sdk.NewCoins()
+	for _, coin := range owed {
+		// liquidations reduce borrows without a repayment, so principal never exceeds the amount owed
+		principal = principal.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(history.Principal.AmountOf(coin.Denom), coin.Amount)))
+	}
+
+	for _, coin := range coins {
+		interest := sdk.ZeroInt()
+		switch entryType {
+		case types.BorrowHistoryOrigination:
+			principal = principal.Add(coin)
+		case types.BorrowHistoryRepayment:
+			// repayments pay the interest owed before the principal
+			outstandingInterest := owed.AmountOf(coin.Denom).Sub(principal.AmountOf(coin.Denom))
+			interest = sdk.MinInt(coin.Amount, outstandingInterest)
+			principalRepaid := sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount.Sub(interest), principal.AmountOf(coin.Denom)))
+			principal = principal.Sub(sdk.NewCoins(principalRepaid))
+		}
+		entry := types.NewBorrowHistoryEntry(entryType, coin, interest, ctx.BlockHeight(), ctx.BlockTime())
+		history = history.AddEntry(entry, length)
+	}
+	history.Principal = principal
+	k.SetBorrowHistory(ctx, history)
+}
+
+// GetBorrowHistory returns a borrower's borrow history
+func (k Keeper) GetBorrowHistory(ctx sdk.Context, borrower sdk.AccAddress) (types.BorrowHistory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowHistoriesPrefix)
+	bz := store.Get(borrower)
+	if bz == nil {
+		return types.BorrowHistory{}, false
+	}
+	var history types.BorrowHistory
+	k.cdc.MustUnmarshalBinaryBare(bz, &history)
+	return history, true
+}
+
+// SetBorrowHistory sets a borrower's borrow history in the store
+func (k Keeper) SetBorrowHistory(ctx sdk.Context, history types.BorrowHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowHistoriesPrefix)
+	store.Set(history.Borrower, k.cdc.MustMarshalBinaryBare(history))
+}
+
+// DeleteBorrowHistory deletes a borrower's borrow history from the store
+func (k Keeper) DeleteBorrowHistory(ctx sdk.Context, borrower sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowHistoriesPrefix)
+	store.Delete(borrower)
+}
+
+// IterateBorrowHistories iterates over all borrow histories and performs a callback function
+func (k Keeper) IterateBorrowHistories(ctx sdk.Context, cb func(history types.BorrowHistory) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowHistoriesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.BorrowHistory
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &history)
+		if cb(history) {
+			break
+		}
+	}
+}
+
+// GetAllBorrowHistories returns all borrow histories
+func (k Keeper) GetAllBorrowHistories(ctx sdk.Context) types.BorrowHistories {
+	histories := types.BorrowHistories{}
+	k.IterateBorrowHistories(ctx, func(history types.BorrowHistory) bool {
+		histories = append(histories, history)
+		return false
+	})
+	return histories
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestBorrowHistory() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	startTime := tmtime.Now()
+	tApp, ctx := suite.setupUkavaMarket(user, moneyMarket, startTime)
+	hard.BeginBlocker(ctx, suite.keeper)
+
+	err := suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(err)
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	history, found := suite.keeper.GetBorrowHistory(ctx, user)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))), history.Principal)
+	suite.Require().Len(history.Entries, 1)
+	suite.Require().Equal(types.NewBorrowHistoryEntry(types.BorrowHistoryOrigination, sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF)), sdk.ZeroInt(), 1, startTime), history.Entries[0])
+
+	// accrue interest for two days, then repay part of the borrow
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(48 * time.Hour))
+	hard.BeginBlocker(ctx, suite.keeper)
+	borrow, found := suite.keeper.GetSyncedBorrow(ctx, user)
+	suite.Require().True(found)
+	interestOwed := borrow.Amount.AmountOf("ukava").Sub(sdk.NewInt(50 * KAVA_CF))
+	suite.Require().True(interestOwed.IsPositive())
+
+	err = suite.keeper.Repay(ctx, user, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+	suite.Require().NoError(err)
+
+	history, _ = suite.keeper.GetBorrowHistory(ctx, user)
+	suite.Require().Len(history.Entries, 2)
+	repayment := history.Entries[1]
+	suite.Require().Equal(types.BorrowHistoryRepayment, repayment.Type)
+	suite.Require().Equal(int64(2), repayment.Height)
+	suite.Require().Equal(interestOwed, repayment.Interest)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(40*KAVA_CF).Add(interestOwed))), history.Principal)
+
+	// the query returns entries newest first
+	querier := keeper.NewQuerier(suite.keeper)
+	queryHistory := func(page, limit int) types.BorrowHistoryEntries {
+		bz, err := querier(ctx, []string{types.QueryGetBorrowHistory}, abci.RequestQuery{
+			Data: tApp.Codec().MustMarshalJSON(types.NewQueryBorrowHistoryParams(page, limit, user)),
+		})
+		suite.Require().NoError(err)
+		var entries types.BorrowHistoryEntries
+		suite.Require().NoError(tApp.Codec().UnmarshalJSON(bz, &entries))
+		return entries
+	}
+	suite.Require().Equal(types.BorrowHistoryEntries{history.Entries[1], history.Entries[0]}, queryHistory(1, 10))
+	suite.Require().Equal(types.BorrowHistoryEntries{history.Entries[0]}, queryHistory(2, 1))
+	suite.Require().Empty(queryHistory(3, 1))
+
+	// the oldest entries are pruned beyond the history length
+	params := suite.keeper.GetParams(ctx)
+	params.BorrowHistoryLength = 2
+	suite.keeper.SetParams(ctx, params)
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().NoError(err)
+	history, _ = suite.keeper.GetBorrowHistory(ctx, user)
+	suite.Require().Len(history.Entries, 2)
+	suite.Require().Equal(repayment, history.Entries[0])
+	suite.Require().Equal(types.BorrowHistoryOrigination, history.Entries[1].Type)
+
+	// no entries are recorded while the history length is zero
+	params.BorrowHistoryLength = 0
+	suite.keeper.SetParams(ctx, params)
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().NoError(err)
+	unchanged, _ := suite.keeper.GetBorrowHistory(ctx, user)
+	suite.Require().Equal(history, unchanged)
+
+	_, err = querier(ctx, []string{types.QueryGetBorrowHistory}, abci.RequestQuery{
+		Data: tApp.Codec().MustMarshalJSON(types.NewQueryBorrowHistoryParams(1, 10, nil)),
+	})
+	suite.Require().Error(err)
+}
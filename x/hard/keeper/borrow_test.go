@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"strings"
 	"time"
 
@@ -41,8 +42,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 		expectedModAccountBalance sdk.Coins
 	}
 	type errArgs struct {
-		expectPass bool
-		contains   string
+		expectPass  bool
+		expectedErr error
 	}
 	type borrowTest struct {
 		name    string
@@ -68,8 +69,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1080*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF)), sdk.NewCoin("busd", sdk.NewInt(100*BUSD_CF))),
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -89,8 +90,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+				expectPass:  false,
+				expectedErr: types.ErrInsufficientLoanToValue,
 			},
 		},
 		{
@@ -110,8 +111,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1050*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)), sdk.NewCoin("btcb", sdk.NewInt(0.1*BTCB_CF)), sdk.NewCoin("busd", sdk.NewInt(100*BUSD_CF))),
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -131,8 +132,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+				expectPass:  false,
+				expectedErr: types.ErrInsufficientLoanToValue,
 			},
 		},
 		{
@@ -153,8 +154,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1050*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(30*BUSD_CF)), sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))),
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -175,8 +176,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "exceeds the allowable amount as determined by the collateralization ratio",
+				expectPass:  false,
+				expectedErr: types.ErrInsufficientLoanToValue,
 			},
 		},
 		{
@@ -197,8 +198,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1080*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF)), sdk.NewCoin("busd", sdk.NewInt(100*BUSD_CF))),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "no price found for market",
+				expectPass:  false,
+				expectedErr: types.ErrStalePrice,
 			},
 		},
 		{
@@ -219,8 +220,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "exceeds module account balance:",
+				expectPass:  false,
+				expectedErr: types.ErrBorrowExceedsAvailableBalance,
 			},
 		},
 		{
@@ -241,8 +242,8 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				expectedModAccountBalance: sdk.NewCoins(),
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "fails global asset borrow limit validation",
+				expectPass:  false,
+				expectedErr: types.ErrGreaterThanAssetBorrowLimit,
 			},
 		},
 	}
@@ -370,7 +371,7 @@ func (suite *KeeperTestSuite) TestBorrow() {
 				suite.Require().True(f)
 			} else {
 				suite.Require().Error(err)
-				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 			}
 		})
 	}
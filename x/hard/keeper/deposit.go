@@ -5,13 +5,59 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	supplyExported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-// Deposit deposit
+// Deposit deposit. If the depositor has repay first enabled, coins of a denom they have borrowed repay the
+// borrow first and only the remainder is deposited.
 func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	_, err := k.deposit(ctx, depositor, coins)
+	return err
+}
+
+// DepositWithReferrer deposits on behalf of the depositor and credits the coins deposited to the referrer's
+// referral volume. Coins used to repay borrows when the depositor has repay first enabled are not credited, and
+// depositors cannot refer themselves.
+func (k Keeper) DepositWithReferrer(ctx sdk.Context, depositor, referrer sdk.AccAddress, coins sdk.Coins) error {
+	if referrer.Equals(depositor) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor cannot refer their own deposit")
+	}
+	deposited, err := k.deposit(ctx, depositor, coins)
+	if err != nil {
+		return err
+	}
+	if referrer.Empty() || deposited.Empty() {
+		return nil
+	}
+
+	volume, _ := k.GetReferralVolume(ctx, referrer)
+	k.SetReferralVolume(ctx, referrer, volume.Add(deposited...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardDepositReferral,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposited.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyReferrer, referrer.String()),
+		),
+	)
+	return nil
+}
+
+// deposit performs a deposit and returns the coins added to the depositor's deposit
+func (k Keeper) deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	if k.GetRepayFirst(ctx, depositor) {
+		repaid, err := k.repayFromDeposit(ctx, depositor, coins)
+		if err != nil {
+			return nil, err
+		}
+		coins = coins.Sub(repaid)
+		if coins.Empty() {
+			return coins, nil
+		}
+	}
+
 	// Set any new denoms' global supply index to 1.0
 	for _, coin := range coins {
 		_, foundInterestFactor := k.GetSupplyInterestFactor(ctx, coin.Denom)
@@ -23,6 +69,11 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		}
 	}
 
+	// Accrue interest on the markets being modified
+	if err := k.SyncMoneyMarketInterest(ctx, coins); err != nil {
+		return nil, err
+	}
+
 	// Call incentive hooks
 	existingDeposit, hasExistingDeposit := k.GetDeposit(ctx, depositor)
 	if hasExistingDeposit {
@@ -39,9 +90,14 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 
 	err := k.ValidateDeposit(ctx, coins)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	vestingCoins, err := k.ValidateVestingDeposit(ctx, depositor, coins)
+	if err != nil {
+		return nil, err
 	}
 
+	k.trackVestingDeposit(ctx, depositor, vestingCoins)
 	err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
 	if err != nil {
 		if strings.Contains(err.Error(), "insufficient account funds") {
@@ -49,7 +105,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 			for _, coin := range coins {
 				_, isNegative := accCoins.SafeSub(sdk.NewCoins(coin))
 				if isNegative {
-					return sdkerrors.Wrapf(types.ErrBorrowExceedsAvailableBalance,
+					return nil, sdkerrors.Wrapf(types.ErrInsufficientBalanceForDeposit,
 						"insufficient funds: the requested deposit amount of %s exceeds the total available account funds of %s%s",
 						coin, accCoins.AmountOf(coin.Denom), coin.Denom,
 					)
@@ -58,7 +114,7 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 		}
 	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	interestFactors := types.SupplyInterestFactors{}
@@ -103,27 +159,67 @@ func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coi
 			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor.String()),
 		),
 	)
+	k.recordDepositStats(ctx, coins)
 
-	return nil
+	return coins, nil
+}
+
+// repayFromDeposit repays the depositor's borrows with the deposited coins of the same denoms, up to the amount owed
+// including interest, and returns the coins used for repayment
+func (k Keeper) repayFromDeposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	// Accrue interest first so the full amount owed is repaid
+	if err := k.SyncMoneyMarketInterest(ctx, coins); err != nil {
+		return nil, err
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, depositor)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	repayment := sdk.NewCoins()
+	for _, coin := range coins {
+		owed := borrow.Amount.AmountOf(coin.Denom)
+		if owed.IsPositive() {
+			repayment = repayment.Add(sdk.NewCoin(coin.Denom, sdk.MinInt(owed, coin.Amount)))
+		}
+	}
+	if repayment.Empty() {
+		return repayment, nil
+	}
+	if err := k.Repay(ctx, depositor, depositor, repayment); err != nil {
+		return nil, err
+	}
+	return repayment, nil
 }
 
 // ValidateDeposit validates a deposit
 func (k Keeper) ValidateDeposit(ctx sdk.Context, coins sdk.Coins) error {
+	suppliedCoins, _ := k.GetSuppliedCoins(ctx)
 	for _, depCoin := range coins {
-		_, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
+		moneyMarket, foundMm := k.GetMoneyMarket(ctx, depCoin.Denom)
 		if !foundMm {
 			return sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "money market denom %s not found", depCoin.Denom)
 		}
+		if k.IsMoneyMarketDeprecated(ctx, depCoin.Denom) {
+			return sdkerrors.Wrapf(types.ErrMoneyMarketDeprecated, "cannot deposit %s", depCoin.Denom)
+		}
+		// a supply limit of zero means the money market has no limit
+		if moneyMarket.SupplyLimit.IsPositive() {
+			newSupply := suppliedCoins.AmountOf(depCoin.Denom).Add(depCoin.Amount)
+			if newSupply.GT(moneyMarket.SupplyLimit) {
+				return sdkerrors.Wrapf(types.ErrExceedsSupplyLimit, "total supply of %s%s would exceed the supply limit of %s%s",
+					newSupply, depCoin.Denom, moneyMarket.SupplyLimit, depCoin.Denom)
+			}
+		}
 	}
 
 	return nil
 }
 
-// GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom
+// GetTotalDeposited returns the total amount deposited for the input deposit type and deposit denom, including
+// coins allocated to yield strategies
 func (k Keeper) GetTotalDeposited(ctx sdk.Context, depositDenom string) (total sdk.Int) {
-	var macc supplyExported.ModuleAccountI
-	macc = k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
-	return macc.GetCoins().AmountOf(depositDenom)
+	return k.GetCash(ctx).AmountOf(depositDenom)
 }
 
 // IncrementSuppliedCoins increments the total amount of supplied coins by the newCoins parameter
@@ -147,7 +243,7 @@ func (k Keeper) DecrementSuppliedCoins(ctx sdk.Context, coins sdk.Coins) error {
 
 	updatedSuppliedCoins, isAnyNegative := suppliedCoins.SafeSub(coins)
 	if isAnyNegative {
-		return types.ErrNegativeSuppliedCoins
+		return sdkerrors.Wrapf(types.ErrNegativeSuppliedCoins, "cannot subtract %s from total supplied %s", coins, suppliedCoins)
 	}
 
 	k.SetSuppliedCoins(ctx, updatedSuppliedCoins)
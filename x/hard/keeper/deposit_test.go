@@ -1,15 +1,17 @@
 package keeper_test
 
 import (
-	"strings"
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/pricefeed"
@@ -23,10 +25,11 @@ func (suite *KeeperTestSuite) TestDeposit() {
 		expectedAccountBalance    sdk.Coins
 		expectedModAccountBalance sdk.Coins
 		expectedDepositCoins      sdk.Coins
+		bnbSupplyLimit            sdk.Int
 	}
 	type errArgs struct {
-		expectPass bool
-		contains   string
+		expectPass  bool
+		expectedErr error
 	}
 	type depositTest struct {
 		name    string
@@ -45,8 +48,8 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				expectedDepositCoins:      sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))),
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -60,8 +63,40 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				expectedDepositCoins:      sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(200))),
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
+			},
+		},
+		{
+			"valid within supply limit",
+			args{
+				depositor:                 sdk.AccAddress(crypto.AddressHash([]byte("test"))),
+				amount:                    sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))),
+				numberDeposits:            2,
+				expectedAccountBalance:    sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(800)), sdk.NewCoin("btcb", sdk.NewInt(1000))),
+				expectedModAccountBalance: sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(200))),
+				expectedDepositCoins:      sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(200))),
+				bnbSupplyLimit:            sdk.NewInt(200),
+			},
+			errArgs{
+				expectPass:  true,
+				expectedErr: nil,
+			},
+		},
+		{
+			"exceeds supply limit",
+			args{
+				depositor:                 sdk.AccAddress(crypto.AddressHash([]byte("test"))),
+				amount:                    sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))),
+				numberDeposits:            2,
+				expectedAccountBalance:    sdk.Coins{},
+				expectedModAccountBalance: sdk.Coins{},
+				expectedDepositCoins:      sdk.Coins{},
+				bnbSupplyLimit:            sdk.NewInt(150),
+			},
+			errArgs{
+				expectPass:  false,
+				expectedErr: types.ErrExceedsSupplyLimit,
 			},
 		},
 		{
@@ -75,8 +110,8 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				expectedDepositCoins:      sdk.Coins{},
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "invalid deposit denom",
+				expectPass:  false,
+				expectedErr: types.ErrInvalidDepositDenom,
 			},
 		},
 		{
@@ -90,8 +125,8 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				expectedDepositCoins:      sdk.Coins{},
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "insufficient funds",
+				expectPass:  false,
+				expectedErr: types.ErrInsufficientBalanceForDeposit,
 			},
 		},
 	}
@@ -104,11 +139,15 @@ func (suite *KeeperTestSuite) TestDeposit() {
 			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
 			authGS := app.NewAuthGenState([]sdk.AccAddress{tc.args.depositor}, []sdk.Coins{sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000)), sdk.NewCoin("btcb", sdk.NewInt(1000)))})
 			loanToValue, _ := sdk.NewDecFromStr("0.6")
+			bnbMarket := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "bnb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+			if !tc.args.bnbSupplyLimit.IsNil() {
+				bnbMarket.SupplyLimit = tc.args.bnbSupplyLimit
+			}
 			hardGS := types.NewGenesisState(types.NewParams(
 				types.MoneyMarkets{
 					types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "usdx:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 					types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
-					types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "bnb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+					bnbMarket,
 					types.NewMoneyMarket("btcb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), loanToValue), "btcb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
 				},
 			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
@@ -183,8 +222,76 @@ func (suite *KeeperTestSuite) TestDeposit() {
 				suite.Require().Equal(tc.args.expectedDepositCoins, dep.Amount)
 			} else {
 				suite.Require().Error(err)
-				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 			}
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDepositWithReferrer() {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	referrer := sdk.AccAddress(crypto.AddressHash([]byte("referrer")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates(genesis.NewBuilder().
+		WithAccountBalance(depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(200*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(200*BNB_CF)))).
+		WithHardMarket(types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		WithHardMarket(types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+			"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		Build(),
+	)
+	keeper := tApp.GetHardKeeper()
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = keeper
+
+	// deposits without a referrer are not tracked
+	suite.Require().NoError(keeper.DepositWithReferrer(ctx, depositor, nil, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	suite.Require().Empty(keeper.GetAllReferralVolumes(ctx))
+
+	// referred deposits accumulate in the referrer's volume
+	suite.Require().NoError(keeper.DepositWithReferrer(ctx, depositor, referrer, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(20*KAVA_CF)))))
+	suite.Require().NoError(keeper.DepositWithReferrer(ctx, depositor, referrer, sdk.NewCoins(
+		sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)),
+		sdk.NewCoin("bnb", sdk.NewInt(15*BNB_CF)),
+	)))
+	expectedVolume := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(25*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(15*BNB_CF)))
+	volume, found := keeper.GetReferralVolume(ctx, referrer)
+	suite.Require().True(found)
+	suite.Require().Equal(expectedVolume, volume)
+	suite.Require().Equal(types.ReferralVolumes{types.NewReferralVolume(referrer, expectedVolume)}, keeper.GetAllReferralVolumes(ctx))
+
+	suite.Require().True(hasEventWithAttribute(ctx.EventManager().Events(), types.EventTypeHardDepositReferral, types.AttributeKeyReferrer, referrer.String()))
+
+	// a failed deposit does not credit the referrer
+	err := keeper.DepositWithReferrer(ctx, depositor, referrer, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000*KAVA_CF))))
+	suite.Require().Error(err)
+	volume, _ = keeper.GetReferralVolume(ctx, referrer)
+	suite.Require().Equal(expectedVolume, volume)
+
+	// depositors cannot refer their own deposits
+	deposit, _ := keeper.GetDeposit(ctx, depositor)
+	err = keeper.DepositWithReferrer(ctx, depositor, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().True(errors.Is(err, sdkerrors.ErrInvalidAddress))
+	_, found = keeper.GetReferralVolume(ctx, depositor)
+	suite.Require().False(found)
+	depositAfter, _ := keeper.GetDeposit(ctx, depositor)
+	suite.Require().Equal(deposit, depositAfter)
+}
+
+func hasEventWithAttribute(events sdk.Events, eventType, key, value string) bool {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key && string(attr.Value) == value {
+				return true
+			}
+		}
+	}
+	return false
+}
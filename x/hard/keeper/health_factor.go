@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// CalculateHealthFactor returns the health factor of a deposit and borrow at current prices. It is the single measure
+// used to decide whether a borrow or withdrawal is allowed and whether a position can be liquidated.
+func (k Keeper) CalculateHealthFactor(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error) {
+	borrowableUSD, borrowedUSD, err := k.getLtvUSDValues(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return types.CalculateHealthFactor(borrowedUSD, borrowableUSD), nil
+}
+
+// GetPositionHealth returns the health of an account's position, including interest that has not been synced
+func (k Keeper) GetPositionHealth(ctx sdk.Context, owner sdk.AccAddress) (types.PositionHealth, error) {
+	deposit, _ := k.GetSyncedDeposit(ctx, owner)
+	borrow, _ := k.GetSyncedBorrow(ctx, owner)
+	borrowableUSD, borrowedUSD, err := k.getLtvUSDValues(ctx, deposit, borrow)
+	if err != nil {
+		return types.PositionHealth{}, err
+	}
+	return types.NewPositionHealth(owner, borrowedUSD, borrowableUSD), nil
+}
+
+// emitHealthFactorWarning emits a health factor warning event if a position's health factor is at or above the
+// health factor warning param, so borrowers and interfaces can act before the position becomes liquidatable
+func (k Keeper) emitHealthFactorWarning(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) error {
+	warning := k.GetParams(ctx).HealthFactorWarning
+	if !warning.IsPositive() || borrow.Amount.Empty() {
+		return nil
+	}
+	healthFactor, err := k.CalculateHealthFactor(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	if healthFactor.LT(warning) {
+		return nil
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardHealthFactorWarning,
+			sdk.NewAttribute(types.AttributeKeyBorrower, borrow.Borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyHealthFactor, healthFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyHealthFactorWarning, warning.String()),
+		),
+	)
+	return nil
+}
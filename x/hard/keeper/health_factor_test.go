@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestHealthFactor() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	tApp, ctx := suite.setupUkavaMarket(user, moneyMarket, tmtime.Now())
+	hard.BeginBlocker(ctx, suite.keeper)
+
+	querier := keeper.NewQuerier(suite.keeper)
+	queryHealth := func() types.PositionHealth {
+		bz, err := querier(ctx, []string{types.QueryGetHealthFactor}, abci.RequestQuery{
+			Data: tApp.Codec().MustMarshalJSON(types.NewQueryHealthFactorParams(user)),
+		})
+		suite.Require().NoError(err)
+		var health types.PositionHealth
+		suite.Require().NoError(tApp.Codec().UnmarshalJSON(bz, &health))
+		return health
+	}
+	hasWarning := func() bool {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeHardHealthFactorWarning {
+				return true
+			}
+		}
+		return false
+	}
+
+	// a position without borrows has a health factor of zero
+	err := suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))))
+	suite.Require().NoError(err)
+	health := queryHealth()
+	suite.Require().Equal(sdk.ZeroDec(), health.HealthFactor)
+	suite.Require().Equal(sdk.NewDec(160), health.BorrowableUSD)
+
+	// $100 borrowed against $160 borrowable is below the warning threshold
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))))
+	suite.Require().NoError(err)
+	suite.Require().False(hasWarning())
+	health = queryHealth()
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.625"), health.HealthFactor)
+	suite.Require().False(health.Liquidatable)
+
+	// $150 borrowed against $160 borrowable emits a warning
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(25*KAVA_CF))))
+	suite.Require().NoError(err)
+	suite.Require().True(hasWarning())
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.9375"), queryHealth().HealthFactor)
+
+	// borrows that would make the position liquidatable are rejected
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(6*KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientLoanToValue))
+
+	// no warning is emitted while the param is zero
+	params := suite.keeper.GetParams(ctx)
+	params.HealthFactorWarning = sdk.ZeroDec()
+	suite.keeper.SetParams(ctx, params)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err = suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().NoError(err)
+	suite.Require().False(hasWarning())
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// IterateDepositsByDenom iterates over the deposits that hold a positive amount of a denom and performs a callback function
+func (k Keeper) IterateDepositsByDenom(ctx sdk.Context, denom string, cb func(deposit types.Deposit) (stop bool)) {
+	k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+		if !deposit.Amount.AmountOf(denom).IsPositive() {
+			return false
+		}
+		return cb(deposit)
+	})
+}
+
+// updateBorrowsByDenomIndex updates the index of borrowers by denom when a borrower's borrowed coins change
+func (k Keeper) updateBorrowsByDenomIndex(ctx sdk.Context, borrower sdk.AccAddress, previous, current sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsByDenomPrefix)
+	for _, coin := range previous {
+		if !current.AmountOf(coin.Denom).IsPositive() {
+			store.Delete(types.BorrowsByDenomKey(coin.Denom, borrower))
+		}
+	}
+	for _, coin := range current {
+		if coin.IsPositive() && !previous.AmountOf(coin.Denom).IsPositive() {
+			store.Set(types.BorrowsByDenomKey(coin.Denom, borrower), []byte{})
+		}
+	}
+}
+
+// IterateBorrowersByDenom iterates over the addresses with a borrow of a denom and performs a callback function
+func (k Keeper) IterateBorrowersByDenom(ctx sdk.Context, denom string, cb func(borrower sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsByDenomPrefix)
+	iteratorKey := types.BorrowsByDenomIteratorKey(denom)
+	iterator := sdk.KVStorePrefixIterator(store, iteratorKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key()[len(iteratorKey):])) {
+			break
+		}
+	}
+}
+
+// IterateBorrowsByDenom iterates over the borrows that hold a positive amount of a denom and performs a callback function.
+// Only the borrows of the denom are read, using the index of borrowers by denom.
+func (k Keeper) IterateBorrowsByDenom(ctx sdk.Context, denom string, cb func(borrow types.Borrow) (stop bool)) {
+	var borrowers []sdk.AccAddress
+	k.IterateBorrowersByDenom(ctx, denom, func(borrower sdk.AccAddress) bool {
+		borrowers = append(borrowers, borrower)
+		return false
+	})
+	for _, borrower := range borrowers {
+		borrow, found := k.GetBorrow(ctx, borrower)
+		if !found {
+			continue
+		}
+		if cb(borrow) {
+			break
+		}
+	}
+}
+
+// GetBorrowInterestFactor returns the current borrow interest factor for an individual market
+func (k Keeper) GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var borrowInterestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &borrowInterestFactor)
+	return borrowInterestFactor, true
+}
+
+// SetBorrowInterestFactor sets the current borrow interest factor for an individual market
+func (k Keeper) SetBorrowInterestFactor(ctx sdk.Context, denom string, borrowInterestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(borrowInterestFactor)
+	store.Set([]byte(denom), bz)
+}
+
+// IterateBorrowInterestFactors iterates over the borrow interest factors of all markets and performs a callback function
+func (k Keeper) IterateBorrowInterestFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool)) {
+	k.iterateInterestFactors(ctx, types.BorrowInterestFactorPrefix, cb)
+}
+
+// GetSupplyInterestFactor returns the current supply interest factor for an individual market
+func (k Keeper) GetSupplyInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var supplyInterestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &supplyInterestFactor)
+	return supplyInterestFactor, true
+}
+
+// SetSupplyInterestFactor sets the current supply interest factor for an individual market
+func (k Keeper) SetSupplyInterestFactor(ctx sdk.Context, denom string, supplyInterestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(supplyInterestFactor)
+	store.Set([]byte(denom), bz)
+}
+
+// IterateSupplyInterestFactors iterates over the supply interest factors of all markets and performs a callback function
+func (k Keeper) IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool)) {
+	k.iterateInterestFactors(ctx, types.SupplyInterestFactorPrefix, cb)
+}
+
+func (k Keeper) iterateInterestFactors(ctx sdk.Context, keyPrefix []byte, cb func(denom string, factor sdk.Dec) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var factor sdk.Dec
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &factor)
+		if cb(string(iterator.Key()), factor) {
+			break
+		}
+	}
+}
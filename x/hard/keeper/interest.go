@@ -1,14 +1,24 @@
 package keeper
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	hardmath "github.com/kava-labs/kava/x/hard/math"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
+// The interest math is implemented in the hard math package, these are kept so existing callers of the keeper
+// package continue to work
 var (
-	scalingFactor  = 1e18
-	secondsPerYear = 31536000
+	APYToSPY                      = hardmath.APYToSPY
+	SPYToEstimatedAPY             = hardmath.SPYToEstimatedAPY
+	CalculateBorrowRate           = hardmath.CalculateBorrowRate
+	CalculateUtilizationRatio     = hardmath.CalculateUtilizationRatio
+	CalculateBorrowInterestFactor = hardmath.CalculateBorrowInterestFactor
+	CalculateSupplyInterestFactor = hardmath.CalculateSupplyInterestFactor
 )
 
 // ApplyInterestRateUpdates translates the current interest rate models from the params to the store,
@@ -25,16 +35,25 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 			k.SetMoneyMarket(ctx, mm.Denom, moneyMarket)
 		}
 
-		// Accrue interest according to the current money markets in the store
-		err := k.AccrueInterest(ctx, mm.Denom)
-		if err != nil {
-			panic(err)
+		// Accrue interest according to the current money markets in the store.
+		// When the interest rate model changes the accrual period is always closed, so the new
+		// model never applies to time elapsed before the change.
+		// Accrual is skipped until the minimum accrual interval has elapsed, unless the money market changed.
+		modelChanged := !moneyMarket.InterestRateModel.Equal(mm.InterestRateModel)
+		if !moneyMarket.Equal(mm) || k.accrualIntervalElapsed(ctx, mm.Denom, params.MinimumAccrualInterval) {
+			err := k.accrueInterest(ctx, mm.Denom, modelChanged)
+			if err != nil {
+				panic(err)
+			}
 		}
 
 		// Update the interest rate in the store if the params have changed
 		if !moneyMarket.Equal(mm) {
 			k.SetMoneyMarket(ctx, mm.Denom, mm)
 		}
+		if modelChanged {
+			k.recordInterestRateModelChange(ctx, mm.Denom, moneyMarket.InterestRateModel, mm.InterestRateModel)
+		}
 		denomSet[mm.Denom] = true
 	}
 
@@ -57,7 +76,50 @@ func (k Keeper) ApplyInterestRateUpdates(ctx sdk.Context) {
 // AccrueInterest applies accrued interest to total borrows and reserves by calculating
 // interest from the last checkpoint time and writing the updated values to the store.
 func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
+	return k.accrueInterest(ctx, denom, false)
+}
+
+// AccrueMoneyMarketInterest accrues interest for a money market that exists in the store
+func (k Keeper) AccrueMoneyMarketInterest(ctx sdk.Context, denom string) error {
+	_, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", denom)
+	}
+	return k.AccrueInterest(ctx, denom)
+}
+
+// SyncMoneyMarketInterest accrues interest for the money markets of the coins' denoms. It is called before
+// positions in those markets are modified, so interest that has not yet been accrued because of the minimum
+// accrual interval is attributed to the positions that existed while it was earned.
+func (k Keeper) SyncMoneyMarketInterest(ctx sdk.Context, coins sdk.Coins) error {
+	if k.GetParams(ctx).MinimumAccrualInterval == 0 {
+		// interest has already been accrued in the begin blocker
+		return nil
+	}
+	for _, coin := range coins {
+		_, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		if err := k.AccrueInterest(ctx, coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accrualIntervalElapsed returns true if at least the minimum accrual interval has passed since a market last accrued
+func (k Keeper) accrualIntervalElapsed(ctx sdk.Context, denom string, interval time.Duration) bool {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
+	if !found {
+		return true
+	}
+	return !ctx.BlockTime().Before(previousAccrualTime.Add(interval))
+}
 
+// accrueInterest accrues interest for a market. If closePeriod is true the accrual time is updated
+// even when the accrued interest rounds to zero, instead of carrying the elapsed time into the next accrual.
+func (k Keeper) accrueInterest(ctx sdk.Context, denom string, closePeriod bool) error {
 	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
 	if !found {
 		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
@@ -70,7 +132,7 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// Get current protocol state and hold in memory as 'prior'
-	cashPrior := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(denom)
+	cashPrior := k.GetCash(ctx).AmountOf(denom)
 
 	borrowedPrior := sdk.NewCoin(denom, sdk.ZeroInt())
 	borrowedCoinsPrior, foundBorrowedCoinsPrior := k.GetBorrowedCoins(ctx)
@@ -106,35 +168,39 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	}
 
 	// GetBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-	borrowRateApy, err := CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
+	borrowRateApy, err := hardmath.CalculateBorrowRate(mm.InterestRateModel, sdk.NewDecFromInt(cashPrior), sdk.NewDecFromInt(borrowedPrior.Amount), sdk.NewDecFromInt(reservesPrior.AmountOf(denom)))
 	if err != nil {
 		return err
 	}
+	borrowRateApy = mm.BoundBorrowRate(borrowRateApy)
 
 	// Convert from APY to SPY, expressed as (1 + borrow rate)
-	borrowRateSpy, err := APYToSPY(sdk.OneDec().Add(borrowRateApy))
+	borrowRateSpy, err := hardmath.APYToSPY(sdk.OneDec().Add(borrowRateApy))
 	if err != nil {
 		return err
 	}
 
 	// Calculate borrow interest factor and update
-	borrowInterestFactor := CalculateBorrowInterestFactor(borrowRateSpy, sdk.NewInt(timeElapsed))
+	borrowInterestFactor := hardmath.CalculateBorrowInterestFactor(borrowRateSpy, sdk.NewInt(timeElapsed))
 	interestBorrowAccumulated := (borrowInterestFactor.Mul(sdk.NewDecFromInt(borrowedPrior.Amount)).TruncateInt()).Sub(borrowedPrior.Amount)
 
 	if interestBorrowAccumulated.IsZero() && borrowRateApy.IsPositive() {
 		// don't accumulate if borrow interest is rounding to zero
+		if closePeriod {
+			k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		}
 		return nil
 	}
 
 	totalBorrowInterestAccumulated := sdk.NewCoins(sdk.NewCoin(denom, interestBorrowAccumulated))
 	reservesNew := interestBorrowAccumulated.ToDec().Mul(mm.ReserveFactor).TruncateInt()
-	borrowInterestFactorNew := borrowInterestFactorPrior.Mul(borrowInterestFactor)
+	borrowInterestFactorNew := hardmath.CompoundInterestFactor(borrowInterestFactorPrior, borrowInterestFactor)
 	k.SetBorrowInterestFactor(ctx, denom, borrowInterestFactorNew)
 
 	// Calculate supply interest factor and update
 	supplyInterestNew := interestBorrowAccumulated.Sub(reservesNew)
-	supplyInterestFactor := CalculateSupplyInterestFactor(supplyInterestNew.ToDec(), cashPrior.ToDec(), borrowedPrior.Amount.ToDec(), reservesPrior.AmountOf(denom).ToDec())
-	supplyInterestFactorNew := supplyInterestFactorPrior.Mul(supplyInterestFactor)
+	supplyInterestFactor := hardmath.CalculateSupplyInterestFactor(supplyInterestNew.ToDec(), cashPrior.ToDec(), borrowedPrior.Amount.ToDec(), reservesPrior.AmountOf(denom).ToDec())
+	supplyInterestFactorNew := hardmath.CompoundInterestFactor(supplyInterestFactorPrior, supplyInterestFactor)
 	k.SetSupplyInterestFactor(ctx, denom, supplyInterestFactorNew)
 
 	// Update accural keys in store
@@ -142,70 +208,12 @@ func (k Keeper) AccrueInterest(ctx sdk.Context, denom string) error {
 	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(sdk.NewCoin(denom, supplyInterestNew)))
 	k.SetTotalReserves(ctx, reservesPrior.Add(sdk.NewCoin(denom, reservesNew)))
 	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+	k.recordAccruedInterest(ctx, denom, interestBorrowAccumulated, supplyInterestNew, reservesNew)
 
+	k.Logger(ctx).Debug("accrued interest", "denom", denom, "borrow_interest", interestBorrowAccumulated, "supply_interest", supplyInterestNew, "reserves", reservesNew)
 	return nil
 }
 
-// CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
-// based on the current utilization.
-func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
-	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
-
-	// Calculate normal borrow rate (under kink)
-	if utilRatio.LTE(model.Kink) {
-		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY), nil
-	}
-
-	// Calculate jump borrow rate (over kink)
-	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
-	excessUtil := utilRatio.Sub(model.Kink)
-	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate), nil
-}
-
-// CalculateUtilizationRatio calculates an asset's current utilization rate
-func CalculateUtilizationRatio(cash, borrows, reserves sdk.Dec) sdk.Dec {
-	// Utilization rate is 0 when there are no borrows
-	if borrows.Equal(sdk.ZeroDec()) {
-		return sdk.ZeroDec()
-	}
-
-	totalSupply := cash.Add(borrows).Sub(reserves)
-	if totalSupply.IsNegative() {
-		return sdk.OneDec()
-	}
-
-	return sdk.MinDec(sdk.OneDec(), borrows.Quo(totalSupply))
-}
-
-// CalculateBorrowInterestFactor calculates the simple interest scaling factor,
-// which is equal to: (per-second interest rate * number of seconds elapsed)
-// Will return 1.000x, multiply by principal to get new principal with added interest
-func CalculateBorrowInterestFactor(perSecondInterestRate sdk.Dec, secondsElapsed sdk.Int) sdk.Dec {
-	scalingFactorUint := sdk.NewUint(uint64(scalingFactor))
-	scalingFactorInt := sdk.NewInt(int64(scalingFactor))
-
-	// Convert per-second interest rate to a uint scaled by 1e18
-	interestMantissa := sdk.NewUint(perSecondInterestRate.MulInt(scalingFactorInt).RoundInt().Uint64())
-	// Convert seconds elapsed to uint (*not scaled*)
-	secondsElapsedUint := sdk.NewUint(secondsElapsed.Uint64())
-	// Calculate the interest factor as a uint scaled by 1e18
-	interestFactorMantissa := sdk.RelativePow(interestMantissa, secondsElapsedUint, scalingFactorUint)
-
-	// Convert interest factor to an unscaled sdk.Dec
-	return sdk.NewDecFromBigInt(interestFactorMantissa.BigInt()).QuoInt(scalingFactorInt)
-}
-
-// CalculateSupplyInterestFactor calculates the supply interest factor, which is the percentage of borrow interest
-// that flows to each unit of supply, i.e. at 50% utilization and 0% reserve factor, a 5% borrow interest will
-// correspond to a 2.5% supply interest.
-func CalculateSupplyInterestFactor(newInterest, cash, borrows, reserves sdk.Dec) sdk.Dec {
-	totalSupply := cash.Add(borrows).Sub(reserves)
-	if totalSupply.IsZero() {
-		return sdk.OneDec()
-	}
-	return (newInterest.Quo(totalSupply)).Add(sdk.OneDec())
-}
-
 // SyncBorrowInterest updates the user's owed interest on newly borrowed coins to the latest global state
 func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	totalNewInterest := sdk.Coins{}
@@ -231,10 +239,9 @@ func (k Keeper) SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress) {
 			borrow.Index = append(borrow.Index, types.NewBorrowInterestFactor(coin.Denom, interestFactorValue))
 		} else { // User has an existing borrow index for this denom
 			// Calculate interest owed by user since asset's last borrow index update
-			storedAmount := sdk.NewDecFromInt(borrow.Amount.AmountOf(coin.Denom))
-			userLastInterestFactor := borrow.Index[foundAtIndex].Value
-			interest := (storedAmount.Quo(userLastInterestFactor).Mul(interestFactorValue)).Sub(storedAmount)
+			interest := hardmath.CalculateBorrowInterest(borrow.Amount.AmountOf(coin.Denom), borrow.Index[foundAtIndex].Value, interestFactorValue)
 			totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, interest.TruncateInt()))
+			k.recordInterestDust(ctx, coin.Denom, interest)
 			// We're synced up, so update user's borrow index value to match the current global borrow index value
 			borrow.Index[foundAtIndex].Value = interestFactorValue
 		}
@@ -272,12 +279,11 @@ func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
 			deposit.Index = append(deposit.Index, types.NewSupplyInterestFactor(coin.Denom, interestFactorValue))
 		} else { // User has an existing supply index for this denom
 			// Calculate interest earned by user since asset's last deposit index update
-			storedAmount := sdk.NewDecFromInt(deposit.Amount.AmountOf(coin.Denom))
-			userLastInterestFactor := deposit.Index[foundAtIndex].Value
-			interest := (storedAmount.Mul(interestFactorValue).Quo(userLastInterestFactor)).Sub(storedAmount)
+			interest := hardmath.CalculateSupplyInterest(deposit.Amount.AmountOf(coin.Denom), deposit.Index[foundAtIndex].Value, interestFactorValue)
 			if interest.TruncateInt().GT(sdk.ZeroInt()) {
 				totalNewInterest = totalNewInterest.Add(sdk.NewCoin(coin.Denom, interest.TruncateInt()))
 			}
+			k.recordInterestDust(ctx, coin.Denom, interest)
 			// We're synced up, so update user's deposit index value to match the current global deposit index value
 			deposit.Index[foundAtIndex].Value = interestFactorValue
 		}
@@ -289,21 +295,25 @@ func (k Keeper) SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress) {
 	k.SetDeposit(ctx, deposit)
 }
 
-// APYToSPY converts the input annual interest rate. For example, 10% apy would be passed as 1.10.
-// SPY = Per second compounded interest rate is how cosmos mathematically represents APY.
-func APYToSPY(apy sdk.Dec) (sdk.Dec, error) {
-	// Note: any APY 179 or greater will cause an out-of-bounds error
-	root, err := apy.ApproxRoot(uint64(secondsPerYear))
-	if err != nil {
-		return sdk.ZeroDec(), err
-	}
-	return root, nil
+// recordAccruedInterest adds the interest accrued by a market to its interest audit
+func (k Keeper) recordAccruedInterest(ctx sdk.Context, denom string, borrowInterest, supplyInterest, reserves sdk.Int) {
+	audit, _ := k.GetInterestAudit(ctx, denom)
+	audit.BorrowInterest = audit.BorrowInterest.Add(borrowInterest)
+	audit.SupplyInterest = audit.SupplyInterest.Add(supplyInterest)
+	audit.Reserves = audit.Reserves.Add(reserves)
+	k.SetInterestAudit(ctx, audit)
 }
 
-// SPYToEstimatedAPY converts the internal per second compounded interest rate into an estimated annual
-// interest rate. The returned value is an estimate  and should not be used for financial calculations.
-func SPYToEstimatedAPY(apy sdk.Dec) sdk.Dec {
-	return apy.Power(uint64(secondsPerYear))
+// recordInterestDust adds the fractional part of interest truncated when syncing a position to the market's
+// interest audit
+func (k Keeper) recordInterestDust(ctx sdk.Context, denom string, interest sdk.Dec) {
+	dust := interest.Sub(interest.TruncateDec())
+	if !dust.IsPositive() {
+		return
+	}
+	audit, _ := k.GetInterestAudit(ctx, denom)
+	audit.Dust = audit.Dust.Add(dust)
+	k.SetInterestAudit(ctx, audit)
 }
 
 // minInt64 returns the smaller of x or y
@@ -313,3 +323,19 @@ func minDec(x, y sdk.Dec) sdk.Dec {
 	}
 	return x
 }
+
+// recordInterestRateModelChange stores the changeover between two interest rate models for a market
+func (k Keeper) recordInterestRateModelChange(ctx sdk.Context, denom string, previousModel, newModel types.InterestRateModel) {
+	k.SetInterestRateModelChange(ctx, types.NewInterestRateModelChange(denom, previousModel, newModel, ctx.BlockHeight(), ctx.BlockTime()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInterestRateModelChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyPreviousModel, previousModel.String()),
+			sdk.NewAttribute(types.AttributeKeyNewModel, newModel.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
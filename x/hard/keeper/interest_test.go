@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"strconv"
 	"testing"
 	"time"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/keeper"
 	"github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 )
@@ -1406,3 +1408,288 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 func TestInterestTestSuite(t *testing.T) {
 	suite.Run(t, new(InterestTestSuite))
 }
+
+// setupUkavaMarket initializes an app with a single ukava money market and a funded user
+func (suite *KeeperTestSuite) setupUkavaMarket(user sdk.AccAddress, moneyMarket types.MoneyMarket, startTime time.Time) (app.TestApp, sdk.Context) {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	authGS := app.NewAuthGenState([]sdk.AccAddress{user}, []sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000*KAVA_CF)))})
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{moneyMarket}),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        startTime.Add(100 * time.Hour),
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	return tApp, ctx
+}
+
+func (suite *KeeperTestSuite) TestInterestRateModelChange() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	oldModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	newModel := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("5"))
+	moneyMarket := func(model types.InterestRateModel) types.MoneyMarket {
+		return types.NewMoneyMarket("ukava",
+			types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	}
+
+	startTime := tmtime.Now()
+	_, ctx := suite.setupUkavaMarket(user, moneyMarket(oldModel), startTime)
+	keeper := suite.keeper
+
+	hard.BeginBlocker(ctx, keeper)
+	suite.Require().NoError(keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1)))))
+
+	// interest on a tiny borrow rounds to zero, so the accrual period stays open
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(time.Second))
+	hard.BeginBlocker(ctx, keeper)
+	accrualTime, _ := keeper.GetPreviousAccrualTime(ctx, "ukava")
+	suite.Require().Equal(startTime.Unix(), accrualTime.Unix())
+	_, found := keeper.GetInterestRateModelChange(ctx, "ukava")
+	suite.Require().False(found)
+
+	// changing the model closes the open period under the old model
+	keeper.SetParams(ctx, types.NewParams(types.MoneyMarkets{moneyMarket(newModel)}))
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(2 * time.Second))
+	hard.BeginBlocker(ctx, keeper)
+
+	accrualTime, _ = keeper.GetPreviousAccrualTime(ctx, "ukava")
+	suite.Require().Equal(ctx.BlockTime().Unix(), accrualTime.Unix())
+	borrowInterestFactor, _ := keeper.GetBorrowInterestFactor(ctx, "ukava")
+	suite.Require().Equal(sdk.OneDec(), borrowInterestFactor)
+
+	storedMoneyMarket, _ := keeper.GetMoneyMarket(ctx, "ukava")
+	suite.Require().True(storedMoneyMarket.InterestRateModel.Equal(newModel))
+	change, found := keeper.GetInterestRateModelChange(ctx, "ukava")
+	suite.Require().True(found)
+	suite.Require().True(change.PreviousModel.Equal(oldModel))
+	suite.Require().True(change.NewModel.Equal(newModel))
+	suite.Require().Equal(int64(3), change.Height)
+	suite.Require().Equal(ctx.BlockTime().Unix(), change.Time.Unix())
+
+	// later accruals use the new model without recording another change
+	suite.Require().NoError(keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(365 * 24 * time.Hour))
+	hard.BeginBlocker(ctx, keeper)
+	borrowInterestFactor, _ = keeper.GetBorrowInterestFactor(ctx, "ukava")
+	suite.Require().True(borrowInterestFactor.GT(sdk.MustNewDecFromStr("1.5")))
+	change, _ = keeper.GetInterestRateModelChange(ctx, "ukava")
+	suite.Require().Equal(int64(3), change.Height)
+}
+
+func (suite *KeeperTestSuite) TestAccrueMoneyMarketInterest() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	startTime := tmtime.Now()
+	tApp, ctx := suite.setupUkavaMarket(user, moneyMarket, startTime)
+	querier := keeper.NewQuerier(suite.keeper)
+
+	hard.BeginBlocker(ctx, suite.keeper)
+	suite.Require().NoError(suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+
+	// force accrual part way through a block without running the begin blocker
+	ctx = ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+	msg := types.NewMsgAccrueInterest(user, "ukava")
+	_, err := hard.NewHandler(suite.keeper)(ctx, msg)
+	suite.Require().NoError(err)
+
+	borrowInterestFactor, _ := suite.keeper.GetBorrowInterestFactor(ctx, "ukava")
+	suite.Require().True(borrowInterestFactor.GT(sdk.OneDec()))
+
+	bz, err := querier(ctx, []string{types.QueryGetAccrualTimes}, abci.RequestQuery{
+		Data: tApp.Codec().MustMarshalJSON(types.NewQueryAccrualTimesParams("ukava")),
+	})
+	suite.Require().NoError(err)
+	var accrualTimes types.GenesisAccumulationTimes
+	suite.Require().NoError(tApp.Codec().UnmarshalJSON(bz, &accrualTimes))
+	suite.Require().Len(accrualTimes, 1)
+	suite.Require().Equal(ctx.BlockTime().Unix(), accrualTimes[0].PreviousAccumulationTime.Unix())
+	suite.Require().Equal(borrowInterestFactor, accrualTimes[0].BorrowInterestFactor)
+
+	// unknown markets are rejected
+	_, err = hard.NewHandler(suite.keeper)(ctx, types.NewMsgAccrueInterest(user, "bnb"))
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketNotFound))
+	_, err = querier(ctx, []string{types.QueryGetAccrualTimes}, abci.RequestQuery{
+		Data: tApp.Codec().MustMarshalJSON(types.NewQueryAccrualTimesParams("bnb")),
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryInterestRateCurve() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	tApp, ctx := suite.setupUkavaMarket(user, moneyMarket, tmtime.Now())
+	querier := keeper.NewQuerier(suite.keeper)
+	hard.BeginBlocker(ctx, suite.keeper)
+
+	queryCurve := func(denom string, points int) (types.InterestRateCurve, error) {
+		var curve types.InterestRateCurve
+		bz, err := querier(ctx, []string{types.QueryGetInterestRateCurve}, abci.RequestQuery{
+			Data: tApp.Codec().MustMarshalJSON(types.NewQueryInterestRateCurveParams(denom, points)),
+		})
+		if err != nil {
+			return curve, err
+		}
+		suite.Require().NoError(tApp.Codec().UnmarshalJSON(bz, &curve))
+		return curve, nil
+	}
+
+	curve, err := queryCurve("ukava", 5)
+	suite.Require().NoError(err)
+	suite.Require().Equal("ukava", curve.Denom)
+	expectedBorrowRates := []string{"0.05", "0.075", "0.1", "0.125", "0.23"}
+	suite.Require().Len(curve.Points, len(expectedBorrowRates))
+	for i, rate := range expectedBorrowRates {
+		point := curve.Points[i]
+		suite.Require().Equal(sdk.NewDec(int64(i)).QuoInt64(4), point.Utilization)
+		suite.Require().Equal(sdk.MustNewDecFromStr(rate), point.BorrowInterestRate)
+		suite.Require().Equal(point.BorrowInterestRate.Mul(point.Utilization).Mul(sdk.MustNewDecFromStr("0.95")), point.SupplyInterestRate)
+	}
+
+	// the default number of points is used when none is given
+	curve, err = queryCurve("ukava", 0)
+	suite.Require().NoError(err)
+	suite.Require().Len(curve.Points, types.DefaultInterestRateCurvePoints)
+
+	_, err = queryCurve("ukava", 1)
+	suite.Require().True(errors.Is(err, types.ErrInvalidCurvePoints))
+	_, err = queryCurve("ukava", types.MaxInterestRateCurvePoints+1)
+	suite.Require().True(errors.Is(err, types.ErrInvalidCurvePoints))
+	_, err = queryCurve("bnb", 5)
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketNotFound))
+}
+
+func (suite *KeeperTestSuite) TestMinimumAccrualInterval() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	startTime := tmtime.Now()
+	blockDuration := 10 * time.Minute
+
+	// runBlocks accrues interest over an hour of blocks and returns the final borrow interest factor
+	runBlocks := func(interval time.Duration) (sdk.Dec, sdk.Context) {
+		_, ctx := suite.setupUkavaMarket(user, moneyMarket, startTime)
+		params := suite.keeper.GetParams(ctx)
+		params.MinimumAccrualInterval = interval
+		suite.keeper.SetParams(ctx, params)
+
+		hard.BeginBlocker(ctx, suite.keeper)
+		suite.Require().NoError(suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+		suite.Require().NoError(suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF)))))
+		for i := 1; i <= 6; i++ {
+			ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * blockDuration))
+			hard.BeginBlocker(ctx, suite.keeper)
+		}
+		factor, _ := suite.keeper.GetBorrowInterestFactor(ctx, "ukava")
+		return factor, ctx
+	}
+
+	everyBlockFactor, _ := runBlocks(0)
+	suite.Require().True(everyBlockFactor.GT(sdk.OneDec()))
+
+	intervalFactor, ctx := runBlocks(time.Hour)
+	previousAccrualTime, _ := suite.keeper.GetPreviousAccrualTime(ctx, "ukava")
+	suite.Require().Equal(startTime.Add(time.Hour).Unix(), previousAccrualTime.Unix())
+	// compounding over the elapsed time gives the same factor as accruing every block, up to the small change
+	// in utilization caused by interest accrued within the interval
+	suite.Require().True(everyBlockFactor.Sub(intervalFactor).Abs().LTE(sdk.MustNewDecFromStr("0.000000001")))
+
+	// blocks within the interval do not accrue interest
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour + blockDuration))
+	hard.BeginBlocker(ctx, suite.keeper)
+	previousAccrualTime, _ = suite.keeper.GetPreviousAccrualTime(ctx, "ukava")
+	suite.Require().Equal(startTime.Add(time.Hour).Unix(), previousAccrualTime.Unix())
+
+	// modifying a position accrues interest on its markets first
+	suite.Require().NoError(suite.keeper.Repay(ctx, user, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))))
+	previousAccrualTime, _ = suite.keeper.GetPreviousAccrualTime(ctx, "ukava")
+	suite.Require().Equal(ctx.BlockTime().Unix(), previousAccrualTime.Unix())
+}
+
+func (suite *KeeperTestSuite) TestInterestAudit() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	startTime := tmtime.Now()
+	tApp, ctx := suite.setupUkavaMarket(user, moneyMarket, startTime)
+	querier := keeper.NewQuerier(suite.keeper)
+
+	queryAudits := func(ctx sdk.Context, denom string) types.InterestAudits {
+		bz, err := querier(ctx, []string{types.QueryGetInterestAudits}, abci.RequestQuery{
+			Data: tApp.Codec().MustMarshalJSON(types.NewQueryInterestAuditsParams(denom)),
+		})
+		suite.Require().NoError(err)
+		var audits types.InterestAudits
+		suite.Require().NoError(tApp.Codec().UnmarshalJSON(bz, &audits))
+		return audits
+	}
+
+	// markets that have not accrued interest report zero totals
+	hard.BeginBlocker(ctx, suite.keeper)
+	audits := queryAudits(ctx, "")
+	suite.Require().Equal(types.InterestAudits{types.NewEmptyInterestAudit("ukava")}, audits)
+
+	suite.Require().NoError(suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF)))))
+	for i := 1; i <= 6; i++ {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(i) * time.Hour))
+		hard.BeginBlocker(ctx, suite.keeper)
+	}
+
+	// interest charged to borrowers is split between suppliers and reserves
+	audit, found := suite.keeper.GetInterestAudit(ctx, "ukava")
+	suite.Require().True(found)
+	borrowed, _ := suite.keeper.GetBorrowedCoins(ctx)
+	suite.Require().Equal(borrowed.AmountOf("ukava").Sub(sdk.NewInt(50*KAVA_CF)), audit.BorrowInterest)
+	reserves, _ := suite.keeper.GetTotalReserves(ctx)
+	suite.Require().Equal(reserves.AmountOf("ukava"), audit.Reserves)
+	suite.Require().True(audit.SupplyInterest.IsPositive())
+	suite.Require().True(audit.Residual().IsZero())
+	suite.Require().True(audit.Dust.IsZero())
+
+	// syncing positions records the interest dropped by rounding
+	suite.Require().NoError(suite.keeper.Repay(ctx, user, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))))
+	audits = queryAudits(ctx, "ukava")
+	suite.Require().Len(audits, 1)
+	suite.Require().True(audits[0].Dust.IsPositive())
+	suite.Require().True(audits[0].Dust.LT(sdk.NewDec(2)))
+	suite.Require().True(audits[0].Residual().IsZero())
+
+	// unknown markets are rejected
+	_, err := querier(ctx, []string{types.QueryGetInterestAudits}, abci.RequestQuery{
+		Data: tApp.Codec().MustMarshalJSON(types.NewQueryInterestAuditsParams("bnb")),
+	})
+	suite.Require().Error(err)
+}
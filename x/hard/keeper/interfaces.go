@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// The hard Keeper is made up of the sub-keepers below. Code that only needs part of the keeper, such as
+// another module's hooks or a test, can depend on the narrowest interface and be given a mock instead
+// of a keeper backed by the full app.
+var (
+	_ PositionKeeper    = Keeper{}
+	_ IndexKeeper       = Keeper{}
+	_ InterestKeeper    = Keeper{}
+	_ LiquidationKeeper = Keeper{}
+)
+
+// PositionKeeper stores the deposits and borrows of users and the module wide totals of supplied and borrowed coins
+type PositionKeeper interface {
+	GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool)
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool)
+	SetDeposit(ctx sdk.Context, deposit types.Deposit)
+	DeleteDeposit(ctx sdk.Context, deposit types.Deposit)
+	IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool))
+
+	GetBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types.Borrow, bool)
+	GetSyncedBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types.Borrow, bool)
+	SetBorrow(ctx sdk.Context, borrow types.Borrow)
+	DeleteBorrow(ctx sdk.Context, borrow types.Borrow)
+	IterateBorrows(ctx sdk.Context, cb func(borrow types.Borrow) (stop bool))
+
+	GetSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool)
+	GetBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool)
+}
+
+// IndexKeeper stores the indexes used to look up positions by denom, and the interest factor indexes that
+// positions are synchronized against
+type IndexKeeper interface {
+	IterateDepositsByDenom(ctx sdk.Context, denom string, cb func(deposit types.Deposit) (stop bool))
+	IterateBorrowersByDenom(ctx sdk.Context, denom string, cb func(borrower sdk.AccAddress) (stop bool))
+	IterateBorrowsByDenom(ctx sdk.Context, denom string, cb func(borrow types.Borrow) (stop bool))
+
+	GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool)
+	SetBorrowInterestFactor(ctx sdk.Context, denom string, borrowInterestFactor sdk.Dec)
+	IterateBorrowInterestFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool))
+	GetSupplyInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool)
+	SetSupplyInterestFactor(ctx sdk.Context, denom string, supplyInterestFactor sdk.Dec)
+	IterateSupplyInterestFactors(ctx sdk.Context, cb func(denom string, factor sdk.Dec) (stop bool))
+}
+
+// InterestKeeper accrues interest on money markets and synchronizes positions with the accrued interest
+type InterestKeeper interface {
+	ApplyInterestRateUpdates(ctx sdk.Context)
+	AccrueInterest(ctx sdk.Context, denom string) error
+	SyncMoneyMarketInterest(ctx sdk.Context, coins sdk.Coins) error
+	SyncBorrowInterest(ctx sdk.Context, addr sdk.AccAddress)
+	SyncSupplyInterest(ctx sdk.Context, addr sdk.AccAddress)
+
+	GetPreviousAccrualTime(ctx sdk.Context, denom string) (time.Time, bool)
+	SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAccrualTime time.Time)
+}
+
+// LiquidationKeeper values positions and liquidates those that are above their loan-to-value limit
+type LiquidationKeeper interface {
+	AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress, borrower sdk.AccAddress) error
+	AttemptBudgetedLiquidations(ctx sdk.Context)
+	IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error)
+	CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error)
+	CalculateHealthFactor(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, error)
+	GetDepositPrice(ctx sdk.Context, mm types.MoneyMarket) (sdk.Dec, error)
+	GetBorrowPrice(ctx sdk.Context, mm types.MoneyMarket) (sdk.Dec, error)
+}
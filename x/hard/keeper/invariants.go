@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// RegisterInvariants registers all hard invariants.
+// The module account balance is not compared against the stored totals because liquidations
+// adjust the totals without moving an equal amount of coins in or out of the module account.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-totals",
+		ValidTotalsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-positions",
+		ValidPositionsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-interest-factors",
+		ValidInterestFactorsInvariant(k))
+}
+
+// ValidTotalsInvariant checks that the total supplied, borrowed, and reserve coins are valid
+func ValidTotalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		suppliedCoins, _ := k.GetSuppliedCoins(ctx)
+		if !suppliedCoins.IsValid() {
+			msg += fmt.Sprintf("\tinvalid total supplied coins: %s\n", suppliedCoins)
+			broken = true
+		}
+		borrowedCoins, _ := k.GetBorrowedCoins(ctx)
+		if !borrowedCoins.IsValid() {
+			msg += fmt.Sprintf("\tinvalid total borrowed coins: %s\n", borrowedCoins)
+			broken = true
+		}
+		reserves, _ := k.GetTotalReserves(ctx)
+		if !reserves.IsValid() {
+			msg += fmt.Sprintf("\tinvalid total reserves: %s\n", reserves)
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "valid totals", msg), broken
+	}
+}
+
+// ValidPositionsInvariant checks that all deposits and borrows in the store hold valid, non-empty amounts
+func ValidPositionsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+			if deposit.Amount.Empty() || !deposit.Amount.IsValid() {
+				msg += fmt.Sprintf("\tinvalid deposit amount %s for %s\n", deposit.Amount, deposit.Depositor)
+				broken = true
+			}
+			return false
+		})
+		k.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+			if borrow.Amount.Empty() || !borrow.Amount.IsValid() {
+				msg += fmt.Sprintf("\tinvalid borrow amount %s for %s\n", borrow.Amount, borrow.Borrower)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "valid positions", msg), broken
+	}
+}
+
+// ValidInterestFactorsInvariant checks that no supply or borrow interest factor has fallen below one, as interest
+// factors start at one and only grow as interest accrues
+func ValidInterestFactorsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+
+		k.IterateSupplyInterestFactors(ctx, func(denom string, factor sdk.Dec) bool {
+			if factor.LT(sdk.OneDec()) {
+				msg += fmt.Sprintf("\tsupply interest factor %s for %s is less than one\n", factor, denom)
+				broken = true
+			}
+			return false
+		})
+		k.IterateBorrowInterestFactors(ctx, func(denom string, factor sdk.Dec) bool {
+			if factor.LT(sdk.OneDec()) {
+				msg += fmt.Sprintf("\tborrow interest factor %s for %s is less than one\n", factor, denom)
+				broken = true
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "valid interest factors", msg), broken
+	}
+}
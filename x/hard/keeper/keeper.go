@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -8,12 +9,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
 // Keeper keeper for the hard module
 type Keeper struct {
 	key             sdk.StoreKey
+	tkey            sdk.StoreKey
 	cdc             *codec.Codec
 	paramSubspace   subspace.Subspace
 	accountKeeper   types.AccountKeeper
@@ -21,19 +25,27 @@ type Keeper struct {
 	stakingKeeper   types.StakingKeeper
 	pricefeedKeeper types.PricefeedKeeper
 	auctionKeeper   types.AuctionKeeper
+	swapKeeper      types.SwapKeeper
+	distrKeeper     types.DistributionKeeper
 	hooks           types.HARDHooks
+	strategies      map[string]types.YieldStrategy
+	metrics         *types.Metrics
+	denomMetadata   types.DenomMetadataRegistry
+	readOnly        bool // set on the copy of the keeper used by the querier
 }
 
 // NewKeeper creates a new keeper
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+func NewKeeper(cdc *codec.Codec, key, tkey sdk.StoreKey, paramstore subspace.Subspace,
 	ak types.AccountKeeper, sk types.SupplyKeeper, stk types.StakingKeeper,
-	pfk types.PricefeedKeeper, auk types.AuctionKeeper) Keeper {
+	pfk types.PricefeedKeeper, auk types.AuctionKeeper, swk types.SwapKeeper,
+	dk types.DistributionKeeper) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
 		key:             key,
+		tkey:            tkey,
 		cdc:             cdc,
 		paramSubspace:   paramstore,
 		accountKeeper:   ak,
@@ -41,7 +53,11 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		stakingKeeper:   stk,
 		pricefeedKeeper: pfk,
 		auctionKeeper:   auk,
+		swapKeeper:      swk,
+		distrKeeper:     dk,
 		hooks:           nil,
+		strategies:      make(map[string]types.YieldStrategy),
+		metrics:         types.NopMetrics(),
 	}
 }
 
@@ -54,55 +70,28 @@ func (k *Keeper) SetHooks(hooks types.HARDHooks) *Keeper {
 	return k
 }
 
-// GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
-func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
-	bz := store.Get(depositor.Bytes())
-	if bz == nil {
-		return types.Deposit{}, false
+// SetStrategy registers the yield strategy that idle liquidity of a denom is allocated to
+func (k *Keeper) SetStrategy(denom string, strategy types.YieldStrategy) *Keeper {
+	if _, found := k.strategies[denom]; found {
+		panic(fmt.Sprintf("cannot set yield strategy for %s twice", denom))
 	}
-	var deposit types.Deposit
-	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
-	return deposit, true
+	k.strategies[denom] = strategy
+	return k
 }
 
-// SetDeposit sets the input deposit in the store, prefixed by the deposit type, deposit denom, and depositor address, in that order
-func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
-	bz := k.cdc.MustMarshalBinaryBare(deposit)
-	store.Set(deposit.Depositor.Bytes(), bz)
+// SetMetrics sets the metrics the keeper reports to
+func (k *Keeper) SetMetrics(metrics *types.Metrics) {
+	k.metrics = metrics
 }
 
-// DeleteDeposit deletes a deposit from the store
-func (k Keeper) DeleteDeposit(ctx sdk.Context, deposit types.Deposit) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
-	store.Delete(deposit.Depositor.Bytes())
+// SetDenomMetadata sets the registry that money market conversion factors are derived from
+func (k *Keeper) SetDenomMetadata(registry types.DenomMetadataRegistry) {
+	k.denomMetadata = registry
 }
 
-// IterateDeposits iterates over all deposit objects in the store and performs a callback function
-func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var deposit types.Deposit
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
-		if cb(deposit) {
-			break
-		}
-	}
-}
-
-// GetDepositsByUser gets all deposits for an individual user
-func (k Keeper) GetDepositsByUser(ctx sdk.Context, user sdk.AccAddress) []types.Deposit {
-	var deposits []types.Deposit
-	k.IterateDeposits(ctx, func(deposit types.Deposit) (stop bool) {
-		if deposit.Depositor.Equals(user) {
-			deposits = append(deposits, deposit)
-		}
-		return false
-	})
-	return deposits
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
 // BondDenom returns the bond denom from the staking keeper
@@ -110,91 +99,28 @@ func (k Keeper) BondDenom(ctx sdk.Context) string {
 	return k.stakingKeeper.BondDenom(ctx)
 }
 
-// GetBorrow returns a Borrow from the store for a particular borrower address and borrow denom
-func (k Keeper) GetBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types.Borrow, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
-	bz := store.Get(borrower)
-	if bz == nil {
-		return types.Borrow{}, false
-	}
-	var borrow types.Borrow
-	k.cdc.MustUnmarshalBinaryBare(bz, &borrow)
-	return borrow, true
-}
-
-// SetBorrow sets the input borrow in the store, prefixed by the borrower address and borrow denom
-func (k Keeper) SetBorrow(ctx sdk.Context, borrow types.Borrow) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
-	bz := k.cdc.MustMarshalBinaryBare(borrow)
-	store.Set(borrow.Borrower, bz)
-}
-
-// DeleteBorrow deletes a borrow from the store
-func (k Keeper) DeleteBorrow(ctx sdk.Context, borrow types.Borrow) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
-	store.Delete(borrow.Borrower)
-}
-
-// IterateBorrows iterates over all borrow objects in the store and performs a callback function
-func (k Keeper) IterateBorrows(ctx sdk.Context, cb func(borrow types.Borrow) (stop bool)) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
-	iterator := sdk.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
-	for ; iterator.Valid(); iterator.Next() {
-		var borrow types.Borrow
-		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &borrow)
-		if cb(borrow) {
-			break
-		}
-	}
-}
-
-// SetBorrowedCoins sets the total amount of coins currently borrowed in the store
-func (k Keeper) SetBorrowedCoins(ctx sdk.Context, borrowedCoins sdk.Coins) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowedCoinsPrefix)
-	if borrowedCoins.Empty() {
-		store.Set([]byte{}, []byte{})
-	} else {
-		bz := k.cdc.MustMarshalBinaryBare(borrowedCoins)
-		store.Set([]byte{}, bz)
-	}
-}
-
-// GetBorrowedCoins returns an sdk.Coins object from the store representing all currently borrowed coins
-func (k Keeper) GetBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowedCoinsPrefix)
+// GetStrategyAllocations returns the coins allocated to yield strategies
+func (k Keeper) GetStrategyAllocations(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StrategyAllocationsPrefix)
 	bz := store.Get([]byte{})
 	if bz == nil {
 		return sdk.Coins{}, false
 	}
-	var borrowedCoins sdk.Coins
-	k.cdc.MustUnmarshalBinaryBare(bz, &borrowedCoins)
-	return borrowedCoins, true
+	var allocations sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &allocations)
+	return allocations, true
 }
 
-// SetSuppliedCoins sets the total amount of coins currently supplied in the store
-func (k Keeper) SetSuppliedCoins(ctx sdk.Context, suppliedCoins sdk.Coins) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
-	if suppliedCoins.Empty() {
+// SetStrategyAllocations sets the coins allocated to yield strategies
+func (k Keeper) SetStrategyAllocations(ctx sdk.Context, allocations sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StrategyAllocationsPrefix)
+	if allocations.Empty() {
 		store.Set([]byte{}, []byte{})
 	} else {
-		bz := k.cdc.MustMarshalBinaryBare(suppliedCoins)
-		store.Set([]byte{}, bz)
+		store.Set([]byte{}, k.cdc.MustMarshalBinaryBare(allocations))
 	}
 }
 
-// GetSuppliedCoins returns an sdk.Coins object from the store representing all currently supplied coins
-func (k Keeper) GetSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
-	bz := store.Get([]byte{})
-	if bz == nil {
-		return sdk.Coins{}, false
-	}
-	var suppliedCoins sdk.Coins
-	k.cdc.MustUnmarshalBinaryBare(bz, &suppliedCoins)
-	return suppliedCoins, true
-}
-
 // GetMoneyMarket returns a money market from the store for a denom
 func (k Keeper) GetMoneyMarket(ctx sdk.Context, denom string) (types.MoneyMarket, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketsPrefix)
@@ -221,7 +147,8 @@ func (k Keeper) DeleteMoneyMarket(ctx sdk.Context, denom string) {
 }
 
 // IterateMoneyMarkets iterates over all money markets objects in the store and performs a callback function
-// 		that returns both the money market and the key (denom) it's stored under
+//
+//	that returns both the money market and the key (denom) it's stored under
 func (k Keeper) IterateMoneyMarkets(ctx sdk.Context, cb func(denom string, moneyMarket types.MoneyMarket) (stop bool)) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketsPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, []byte{})
@@ -263,6 +190,31 @@ func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAc
 	store.Set([]byte(denom), bz)
 }
 
+// DeletePreviousAccrualTime deletes the most recent accrual time for a particular market
+func (k Keeper) DeletePreviousAccrualTime(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	store.Delete([]byte(denom))
+}
+
+// GetInterestRateModelChange returns the most recent interest rate model change for a market
+func (k Keeper) GetInterestRateModelChange(ctx sdk.Context, denom string) (types.InterestRateModelChange, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestRateModelChangePrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.InterestRateModelChange{}, false
+	}
+	var change types.InterestRateModelChange
+	k.cdc.MustUnmarshalBinaryBare(bz, &change)
+	return change, true
+}
+
+// SetInterestRateModelChange sets the most recent interest rate model change for a market
+func (k Keeper) SetInterestRateModelChange(ctx sdk.Context, change types.InterestRateModelChange) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestRateModelChangePrefix)
+	bz := k.cdc.MustMarshalBinaryBare(change)
+	store.Set([]byte(change.Denom), bz)
+}
+
 // GetTotalReserves returns the total reserves for an individual market
 func (k Keeper) GetTotalReserves(ctx sdk.Context) (sdk.Coins, bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalReservesPrefix)
@@ -287,40 +239,137 @@ func (k Keeper) SetTotalReserves(ctx sdk.Context, coins sdk.Coins) {
 	store.Set([]byte{}, bz)
 }
 
-// GetBorrowInterestFactor returns the current borrow interest factor for an individual market
-func (k Keeper) GetBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
-	bz := store.Get([]byte(denom))
+// GetStoreVersion returns the version of the store layout, stores written before versioning was introduced are version 1
+func (k Keeper) GetStoreVersion(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.StoreVersionKey)
 	if bz == nil {
-		return sdk.ZeroDec(), false
+		return 1
 	}
-	var borrowInterestFactor sdk.Dec
-	k.cdc.MustUnmarshalBinaryBare(bz, &borrowInterestFactor)
-	return borrowInterestFactor, true
+	var version uint64
+	k.cdc.MustUnmarshalBinaryBare(bz, &version)
+	return version
 }
 
-// SetBorrowInterestFactor sets the current borrow interest factor for an individual market
-func (k Keeper) SetBorrowInterestFactor(ctx sdk.Context, denom string, borrowInterestFactor sdk.Dec) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowInterestFactorPrefix)
-	bz := k.cdc.MustMarshalBinaryBare(borrowInterestFactor)
-	store.Set([]byte(denom), bz)
+// SetStoreVersion sets the version of the store layout
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(version)
+	store.Set(types.StoreVersionKey, bz)
+}
+
+// GetRepayFirst returns true if deposits by an address are used to repay its borrows of the same denom first
+func (k Keeper) GetRepayFirst(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RepayFirstPrefix)
+	return store.Has(addr)
+}
+
+// SetRepayFirst sets whether deposits by an address are used to repay its borrows of the same denom first
+func (k Keeper) SetRepayFirst(ctx sdk.Context, addr sdk.AccAddress, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RepayFirstPrefix)
+	if enabled {
+		store.Set(addr, []byte{0x01})
+	} else {
+		store.Delete(addr)
+	}
+}
+
+// IterateRepayFirst iterates over all addresses that have repay first enabled and performs a callback function
+func (k Keeper) IterateRepayFirst(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RepayFirstPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetReferralVolume returns the total volume of deposits referred by an address
+func (k Keeper) GetReferralVolume(ctx sdk.Context, referrer sdk.AccAddress) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ReferralVolumePrefix)
+	bz := store.Get(referrer)
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var volume sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &volume)
+	return volume, true
+}
+
+// SetReferralVolume sets the total volume of deposits referred by an address
+func (k Keeper) SetReferralVolume(ctx sdk.Context, referrer sdk.AccAddress, volume sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ReferralVolumePrefix)
+	if volume.Empty() {
+		store.Delete(referrer)
+		return
+	}
+	store.Set(referrer, k.cdc.MustMarshalBinaryBare(volume))
 }
 
-// GetSupplyInterestFactor returns the current supply interest factor for an individual market
-func (k Keeper) GetSupplyInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
+// IterateReferralVolumes iterates over all referral volumes and performs a callback function
+func (k Keeper) IterateReferralVolumes(ctx sdk.Context, cb func(referrer sdk.AccAddress, volume sdk.Coins) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ReferralVolumePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var volume sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &volume)
+		if cb(sdk.AccAddress(iterator.Key()), volume) {
+			break
+		}
+	}
+}
+
+// GetAllReferralVolumes returns the referral volumes of all referrers
+func (k Keeper) GetAllReferralVolumes(ctx sdk.Context) types.ReferralVolumes {
+	volumes := types.ReferralVolumes{}
+	k.IterateReferralVolumes(ctx, func(referrer sdk.AccAddress, volume sdk.Coins) bool {
+		volumes = append(volumes, types.NewReferralVolume(referrer, volume))
+		return false
+	})
+	return volumes
+}
+
+// GetInterestAudit returns the interest accrued by a market since genesis
+func (k Keeper) GetInterestAudit(ctx sdk.Context, denom string) (types.InterestAudit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestAuditPrefix)
 	bz := store.Get([]byte(denom))
 	if bz == nil {
-		return sdk.ZeroDec(), false
+		return types.NewEmptyInterestAudit(denom), false
 	}
-	var supplyInterestFactor sdk.Dec
-	k.cdc.MustUnmarshalBinaryBare(bz, &supplyInterestFactor)
-	return supplyInterestFactor, true
+	var audit types.InterestAudit
+	k.cdc.MustUnmarshalBinaryBare(bz, &audit)
+	return audit, true
 }
 
-// SetSupplyInterestFactor sets the current supply interest factor for an individual market
-func (k Keeper) SetSupplyInterestFactor(ctx sdk.Context, denom string, supplyInterestFactor sdk.Dec) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.SupplyInterestFactorPrefix)
-	bz := k.cdc.MustMarshalBinaryBare(supplyInterestFactor)
-	store.Set([]byte(denom), bz)
+// SetInterestAudit sets the interest accrued by a market since genesis
+func (k Keeper) SetInterestAudit(ctx sdk.Context, audit types.InterestAudit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestAuditPrefix)
+	store.Set([]byte(audit.Denom), k.cdc.MustMarshalBinaryBare(audit))
+}
+
+// IterateInterestAudits iterates over the interest audits of all markets and performs a callback function
+func (k Keeper) IterateInterestAudits(ctx sdk.Context, cb func(audit types.InterestAudit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestAuditPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var audit types.InterestAudit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &audit)
+		if cb(audit) {
+			break
+		}
+	}
+}
+
+// GetAllInterestAudits returns the interest audits of all markets
+func (k Keeper) GetAllInterestAudits(ctx sdk.Context) types.InterestAudits {
+	audits := types.InterestAudits{}
+	k.IterateInterestAudits(ctx, func(audit types.InterestAudit) bool {
+		audits = append(audits, audit)
+		return false
+	})
+	return audits
 }
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// saving the result to a module level variable ensures the compiler doesn't optimize the test away
+var ltvResult sdk.Dec
+
+// createPositions returns an app with n healthy positions that deposit kava and bnb and borrow usdx
+func createPositions(n int) (sdk.Context, keeper.Keeper, []sdk.AccAddress) {
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	_, addrs := app.GeneratePrivKeyAddressPairs(n)
+	coins := make([]sdk.Coins, n)
+	for i := range coins {
+		coins[i] = sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(100*BNB_CF)))
+	}
+	authGS := app.NewAuthGenState(addrs, coins)
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	params := types.NewParams(types.MoneyMarkets{
+		types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")),
+			"bnb:usd", sdk.NewInt(BNB_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+	})
+	params.LiquidationGasBudget = 1e12
+	hardGS := types.NewGenesisState(params, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "bnb:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("10.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	if err := tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(int64(n)*100*USDX_CF)))); err != nil {
+		panic(err)
+	}
+
+	hardKeeper := tApp.GetHardKeeper()
+	for i := range addrs {
+		if err := hardKeeper.Deposit(ctx, addrs[i], coins[i]); err != nil {
+			panic(err)
+		}
+		if err := hardKeeper.Borrow(ctx, addrs[i], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)))); err != nil {
+			panic(err)
+		}
+	}
+	return ctx, hardKeeper, addrs
+}
+
+// BenchmarkLtvCalculation values each position once per iteration. Cached lookups are made within a single block,
+// as in a begin blocker, while uncached lookups move each position to a new block so every price is read from
+// pricefeed.
+func BenchmarkLtvCalculation(b *testing.B) {
+	benchmarks := []struct {
+		name         string
+		numPositions int
+		cachedPrices bool
+	}{
+		{"100 Positions, Uncached", 100, false},
+		{"100 Positions, Cached", 100, true},
+		{"1000 Positions, Uncached", 1000, false},
+		{"1000 Positions, Cached", 1000, true},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx, hardKeeper, addrs := createPositions(bm.numPositions)
+			height := ctx.BlockHeight()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				height++
+				for _, addr := range addrs {
+					if !bm.cachedPrices {
+						height++
+					}
+					blockCtx := ctx.WithBlockHeight(height)
+					deposit, _ := hardKeeper.GetDeposit(blockCtx, addr)
+					borrow, _ := hardKeeper.GetBorrow(blockCtx, addr)
+					ltv, err := hardKeeper.CalculateLtv(blockCtx, deposit, borrow)
+					if err != nil {
+						b.Fatal(err)
+					}
+					ltvResult = ltv
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkBeginBlocker runs the begin blocker once per block, checking every position for liquidation
+func BenchmarkBeginBlocker(b *testing.B) {
+	benchmarks := []struct {
+		name         string
+		numPositions int
+	}{
+		{"100 Positions", 100},
+		{"1000 Positions", 1000},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			ctx, hardKeeper, _ := createPositions(bm.numPositions)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				hard.BeginBlocker(ctx.WithBlockHeight(ctx.BlockHeight()+int64(i)+1), hardKeeper)
+			}
+		})
+	}
+}
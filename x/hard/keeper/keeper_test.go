@@ -82,6 +82,78 @@ func (suite *KeeperTestSuite) TestIterateDeposits() {
 	suite.Require().Equal(5, len(deposits))
 }
 
+func (suite *KeeperTestSuite) TestIterateDepositsAndBorrowsByDenom() {
+	for i := 0; i < 4; i++ {
+		denom := "bnb"
+		if i%2 == 1 {
+			denom = "xrp"
+		}
+		addr := sdk.AccAddress("test" + fmt.Sprint(i))
+		suite.keeper.SetDeposit(suite.ctx, types.NewDeposit(addr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))), types.SupplyInterestFactors{}))
+		suite.keeper.SetBorrow(suite.ctx, types.NewBorrow(addr, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(100))), types.BorrowInterestFactors{}))
+	}
+
+	var deposits []types.Deposit
+	suite.keeper.IterateDepositsByDenom(suite.ctx, "bnb", func(d types.Deposit) bool {
+		deposits = append(deposits, d)
+		return false
+	})
+	suite.Require().Equal(2, len(deposits))
+
+	var borrows []types.Borrow
+	suite.keeper.IterateBorrowsByDenom(suite.ctx, "xrp", func(b types.Borrow) bool {
+		borrows = append(borrows, b)
+		return true
+	})
+	suite.Require().Equal(1, len(borrows))
+}
+
+func (suite *KeeperTestSuite) TestBorrowsByDenomIndex() {
+	borrower := sdk.AccAddress("test")
+	borrowers := func(denom string) []sdk.AccAddress {
+		var addrs []sdk.AccAddress
+		suite.keeper.IterateBorrowersByDenom(suite.ctx, denom, func(addr sdk.AccAddress) bool {
+			addrs = append(addrs, addr)
+			return false
+		})
+		return addrs
+	}
+
+	borrow := types.NewBorrow(borrower, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.NewCoin("xrp", sdk.NewInt(100))), types.BorrowInterestFactors{})
+	suite.keeper.SetBorrow(suite.ctx, borrow)
+	suite.Require().Equal([]sdk.AccAddress{borrower}, borrowers("bnb"))
+	suite.Require().Equal([]sdk.AccAddress{borrower}, borrowers("xrp"))
+
+	// repaying a denom in full removes the borrower from that denom's index
+	borrow.Amount = sdk.NewCoins(sdk.NewCoin("xrp", sdk.NewInt(50)))
+	suite.keeper.SetBorrow(suite.ctx, borrow)
+	suite.Require().Empty(borrowers("bnb"))
+	suite.Require().Equal([]sdk.AccAddress{borrower}, borrowers("xrp"))
+
+	suite.keeper.DeleteBorrow(suite.ctx, borrow)
+	suite.Require().Empty(borrowers("xrp"))
+}
+
+func (suite *KeeperTestSuite) TestIterateInterestFactors() {
+	suite.keeper.SetSupplyInterestFactor(suite.ctx, "bnb", sdk.MustNewDecFromStr("1.1"))
+	suite.keeper.SetSupplyInterestFactor(suite.ctx, "xrp", sdk.MustNewDecFromStr("1.2"))
+	suite.keeper.SetBorrowInterestFactor(suite.ctx, "bnb", sdk.MustNewDecFromStr("1.3"))
+
+	supplyFactors := make(map[string]sdk.Dec)
+	suite.keeper.IterateSupplyInterestFactors(suite.ctx, func(denom string, factor sdk.Dec) bool {
+		supplyFactors[denom] = factor
+		return false
+	})
+	suite.Require().Equal(map[string]sdk.Dec{"bnb": sdk.MustNewDecFromStr("1.1"), "xrp": sdk.MustNewDecFromStr("1.2")}, supplyFactors)
+
+	borrowFactors := make(map[string]sdk.Dec)
+	suite.keeper.IterateBorrowInterestFactors(suite.ctx, func(denom string, factor sdk.Dec) bool {
+		borrowFactors[denom] = factor
+		return false
+	})
+	suite.Require().Equal(map[string]sdk.Dec{"bnb": sdk.MustNewDecFromStr("1.3")}, borrowFactors)
+}
+
 func (suite *KeeperTestSuite) TestGetSetDeleteInterestRateModel() {
 	denom := "test"
 	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
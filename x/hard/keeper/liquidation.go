@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,21 +11,46 @@ import (
 
 // LiqData holds liquidation-related data
 type LiqData struct {
-	price            sdk.Dec
+	depositPrice     sdk.Dec
+	borrowPrice      sdk.Dec
 	ltv              sdk.Dec
 	conversionFactor sdk.Int
 }
 
+// LiquidationResult is the accounting of the coins moved by seizing a position
+type LiquidationResult struct {
+	// KeeperReward is the deposit coins paid to the keeper
+	KeeperReward sdk.Coins
+	// Auctioned is the deposit coins sent to auction or sold through the swap module
+	Auctioned sdk.Coins
+	// DebtCovered is the borrow coins bid for by the auctions and swaps
+	DebtCovered sdk.Coins
+	// SeizureOrder is the order in which the deposit denoms were auctioned against the borrows
+	SeizureOrder []string
+}
+
 // AttemptKeeperLiquidation enables a keeper to liquidate an individual borrower's position
 func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress, borrower sdk.AccAddress) error {
 	deposit, found := k.GetDeposit(ctx, borrower)
 	if !found {
-		return types.ErrDepositNotFound
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", borrower)
 	}
 
 	borrow, found := k.GetBorrow(ctx, borrower)
 	if !found {
-		return types.ErrBorrowNotFound
+		return sdkerrors.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s", borrower)
+	}
+
+	// Liquidations started by the begin blocker have no keeper and are not restricted
+	if !keeper.Empty() {
+		if err := k.validateLiquidator(ctx, keeper, deposit.Amount.Add(borrow.Amount...)); err != nil {
+			return err
+		}
+	}
+
+	// Accrue interest on the markets of the position so the LTV is up to date
+	if err := k.SyncMoneyMarketInterest(ctx, deposit.Amount.Add(borrow.Amount...)); err != nil {
+		return err
 	}
 
 	// Call incentive hooks
@@ -35,49 +62,217 @@ func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress,
 
 	deposit, found = k.GetDeposit(ctx, borrower)
 	if !found {
-		return types.ErrDepositNotFound
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", borrower)
 	}
 
 	borrow, found = k.GetBorrow(ctx, borrower)
 	if !found {
-		return types.ErrBorrowNotFound
+		return sdkerrors.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s", borrower)
 	}
 
-	isWithinRange, err := k.IsWithinValidLtvRange(ctx, deposit, borrow)
+	borrowableUSD, borrowedUSD, err := k.getLtvUSDValues(ctx, deposit, borrow)
 	if err != nil {
 		return err
 	}
-	if isWithinRange {
-		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position is within valid LTV range")
+	healthFactor := types.CalculateHealthFactor(borrowedUSD, borrowableUSD)
+	if !types.IsLiquidatable(healthFactor) {
+		return sdkerrors.Wrapf(types.ErrBorrowNotLiquidatable, "position health factor %s does not exceed %s: borrows of %s USD, borrowable %s USD",
+			healthFactor, types.LiquidationHealthFactor, borrowedUSD, borrowableUSD)
+	}
+
+	// Positions that would start auctions past the per-block limit wait for a later block
+	if !k.auctionKeeper.HasCollateralAuctionCapacity(ctx) {
+		return sdkerrors.Wrapf(types.ErrAuctionLimitReached, "borrower %s", borrower)
+	}
+
+	// Only the close factor share of the position is liquidated, the rest remains open
+	seizedDeposit, seizedBorrow, err := k.splitPositionByCloseFactor(ctx, deposit, borrow)
+	if err != nil {
+		return err
 	}
 
 	// Sending coins to auction module with keeper address getting % of the profits
-	borrowDenoms := getDenoms(borrow.Amount)
-	depositDenoms := getDenoms(deposit.Amount)
-	err = k.SeizeDeposits(ctx, keeper, deposit, borrow, depositDenoms, borrowDenoms)
+	borrowDenoms := getDenoms(seizedBorrow.Amount)
+	depositDenoms := getDenoms(seizedDeposit.Amount)
+	result, err := k.SeizeDeposits(ctx, keeper, seizedDeposit, seizedBorrow, depositDenoms, borrowDenoms)
 	if err != nil {
 		return err
 	}
+	k.recordLiquidation(ctx)
+	k.Logger(ctx).Info("liquidated position", "borrower", borrower, "keeper", keeper, "seized_deposit", seizedDeposit.Amount, "seized_borrow", seizedBorrow.Amount)
+
+	deposit.Amount = deposit.Amount.Sub(seizedDeposit.Amount)
+	borrow.Amount = borrow.Amount.Sub(seizedBorrow.Amount)
+	if deposit.Amount.Empty() || borrow.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+		k.DeleteBorrow(ctx, borrow)
+		k.emitLiquidationEvent(ctx, keeper, healthFactor, seizedDeposit, result, types.Deposit{}, types.Borrow{})
+		return nil
+	}
 
-	k.DeleteDeposit(ctx, deposit)
-	k.DeleteBorrow(ctx, borrow)
+	k.SetDeposit(ctx, deposit)
+	k.SetBorrow(ctx, borrow)
+	k.AfterDepositModified(ctx, deposit)
+	k.AfterBorrowModified(ctx, borrow)
+	k.emitLiquidationEvent(ctx, keeper, healthFactor, seizedDeposit, result, deposit, borrow)
 	return nil
 }
 
+// emitLiquidationEvent emits the full accounting of a liquidation, including the health factor of the position before
+// it and the position that remains open after it
+func (k Keeper) emitLiquidationEvent(ctx sdk.Context, keeper sdk.AccAddress, healthFactor sdk.Dec, seizedDeposit types.Deposit, result LiquidationResult,
+	remainingDeposit types.Deposit, remainingBorrow types.Borrow) {
+	resultingLtv := sdk.ZeroDec()
+	resultingHealthFactor := sdk.ZeroDec()
+	if !remainingDeposit.Amount.Empty() && !remainingBorrow.Amount.Empty() {
+		resultingLtv, _ = k.CalculateLtv(ctx, remainingDeposit, remainingBorrow)
+		resultingHealthFactor, _ = k.CalculateHealthFactor(ctx, remainingDeposit, remainingBorrow)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardLiquidation,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, seizedDeposit.Depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyBorrower, seizedDeposit.Depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeySeizedCoins, seizedDeposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, result.KeeperReward.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, result.Auctioned.String()),
+			sdk.NewAttribute(types.AttributeKeyDebtCovered, result.DebtCovered.String()),
+			sdk.NewAttribute(types.AttributeKeyResidualDebt, remainingBorrow.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyResultingLtv, resultingLtv.String()),
+			sdk.NewAttribute(types.AttributeKeyHealthFactor, healthFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyResultingHealthFactor, resultingHealthFactor.String()),
+			sdk.NewAttribute(types.AttributeKeyLiquidationOrder, k.GetParams(ctx).LiquidationOrder),
+			sdk.NewAttribute(types.AttributeKeySeizureOrder, strings.Join(result.SeizureOrder, ",")),
+		),
+	)
+}
+
+// validateLiquidator checks that the keeper is permitted to liquidate positions in the money market of each coin
+func (k Keeper) validateLiquidator(ctx sdk.Context, keeper sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", coin.Denom)
+		}
+		if !mm.PermitsLiquidator(keeper) {
+			return sdkerrors.Wrapf(types.ErrLiquidatorNotPermitted, "%s cannot liquidate positions in the %s market", keeper, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// splitPositionByCloseFactor returns the part of a position that is seized in a single liquidation, which is
+// the smallest close factor of the borrowed money markets applied to every borrow coin. Under the proportional
+// liquidation order the close factor is applied to every deposit coin, otherwise the same share of the deposit's
+// USD value is taken from the deposit denoms in liquidation order.
+// The full position is returned if the close factor is 1.0 or if the partial amounts round down to zero.
+func (k Keeper) splitPositionByCloseFactor(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (types.Deposit, types.Borrow, error) {
+	closeFactor := sdk.OneDec()
+	for _, coin := range borrow.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if found && mm.CloseFactor.LT(closeFactor) {
+			closeFactor = mm.CloseFactor
+		}
+	}
+	if closeFactor.GTE(sdk.OneDec()) {
+		return deposit, borrow, nil
+	}
+
+	seizedDepositCoins := scaleCoins(deposit.Amount, closeFactor)
+	if order := k.GetParams(ctx).LiquidationOrder; order != types.LiquidationOrderProportional {
+		liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+		if err != nil {
+			return types.Deposit{}, types.Borrow{}, err
+		}
+		depositCoinValues := depositValuations(deposit.Amount, liqMap)
+		seizedDepositCoins = seizeInOrder(deposit.Amount, depositCoinValues, k.orderDepositDenoms(ctx, order, depositCoinValues, liqMap),
+			depositCoinValues.Sum().Mul(closeFactor), liqMap)
+	}
+	seizedBorrowCoins := scaleCoins(borrow.Amount, closeFactor)
+	if seizedDepositCoins.Empty() || seizedBorrowCoins.Empty() {
+		return deposit, borrow, nil
+	}
+	return types.NewDeposit(deposit.Depositor, seizedDepositCoins, deposit.Index),
+		types.NewBorrow(borrow.Borrower, seizedBorrowCoins, borrow.Index), nil
+}
+
+// seizeInOrder takes deposit coins worth up to target USD, taking whole denoms in order until the last denom needed
+// is partially taken
+func seizeInOrder(deposits sdk.Coins, depositCoinValues types.ValuationMap, order []string, target sdk.Dec, liqMap map[string]LiqData) sdk.Coins {
+	seized := sdk.NewCoins()
+	for _, denom := range order {
+		if !target.IsPositive() {
+			break
+		}
+		value := depositCoinValues.Get(denom)
+		if value.LTE(target) {
+			seized = seized.Add(sdk.NewCoin(denom, deposits.AmountOf(denom)))
+			target = target.Sub(value)
+			continue
+		}
+		amount := target.MulInt(liqMap[denom].conversionFactor).Quo(liqMap[denom].depositPrice).TruncateInt()
+		seized = seized.Add(sdk.NewCoin(denom, amount))
+		target = sdk.ZeroDec()
+	}
+	return seized
+}
+
+// orderDepositDenoms returns the denoms of the deposit valuations in the order they are seized under a liquidation order
+func (k Keeper) orderDepositDenoms(ctx sdk.Context, order string, depositCoinValues types.ValuationMap, liqMap map[string]LiqData) []string {
+	switch order {
+	case types.LiquidationOrderHighestValue:
+		return depositCoinValues.GetKeysByValue()
+	case types.LiquidationOrderMostLiquid:
+		macc := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+		maccCoins := macc.SpendableCoins(ctx.BlockTime())
+		available := types.NewValuationMap()
+		for _, denom := range depositCoinValues.GetSortedKeys() {
+			available.Increment(denom, depositValuations(sdk.NewCoins(sdk.NewCoin(denom, maccCoins.AmountOf(denom))), liqMap).Get(denom))
+		}
+		return available.GetKeysByValue()
+	default:
+		return depositCoinValues.GetSortedKeys()
+	}
+}
+
+// depositValuations returns the USD value of each deposit coin
+func depositValuations(deposits sdk.Coins, liqMap map[string]LiqData) types.ValuationMap {
+	depositCoinValues := types.NewValuationMap()
+	for _, deposit := range deposits {
+		dData := liqMap[deposit.Denom]
+		dCoinUsdValue := sdk.NewDecFromInt(deposit.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.depositPrice)
+		depositCoinValues.Increment(deposit.Denom, dCoinUsdValue)
+	}
+	return depositCoinValues
+}
+
+// scaleCoins multiplies each coin amount by factor, truncating and dropping zero amounts
+func scaleCoins(coins sdk.Coins, factor sdk.Dec) sdk.Coins {
+	scaled := sdk.NewCoins()
+	for _, coin := range coins {
+		scaled = scaled.Add(sdk.NewCoin(coin.Denom, factor.MulInt(coin.Amount).TruncateInt()))
+	}
+	return scaled
+}
+
 // SeizeDeposits seizes a list of deposits and sends them to auction
 func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit types.Deposit,
-	borrow types.Borrow, dDenoms, bDenoms []string) error {
+	borrow types.Borrow, dDenoms, bDenoms []string) (LiquidationResult, error) {
 	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
 	if err != nil {
-		return err
+		return LiquidationResult{}, err
+	}
+	if err := k.recallStrategyAllocations(ctx, deposit.Amount); err != nil {
+		return LiquidationResult{}, err
 	}
 
-	// Seize % of every deposit and send to the keeper
+	// Seize % of every deposit and send to the keeper, liquidations without a keeper pay no reward
 	keeperRewardCoins := sdk.Coins{}
 	for _, depCoin := range deposit.Amount {
 		mm, _ := k.GetMoneyMarket(ctx, depCoin.Denom)
 		keeperReward := mm.KeeperRewardPercentage.MulInt(depCoin.Amount).TruncateInt()
-		if keeperReward.GT(sdk.ZeroInt()) {
+		if keeperReward.GT(sdk.ZeroInt()) && !keeper.Empty() {
 			// Send keeper their reward
 			keeperCoin := sdk.NewCoin(depCoin.Denom, keeperReward)
 			keeperRewardCoins = append(keeperRewardCoins, keeperCoin)
@@ -86,7 +281,7 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	if !keeperRewardCoins.Empty() {
 		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, keeper, keeperRewardCoins)
 		if err != nil {
-			return err
+			return LiquidationResult{}, err
 		}
 	}
 
@@ -94,47 +289,35 @@ func (k Keeper) SeizeDeposits(ctx sdk.Context, keeper sdk.AccAddress, deposit ty
 	aucDeposits := deposit.Amount.Sub(keeperRewardCoins)
 
 	// Build valuation map to hold deposit coin USD valuations
-	depositCoinValues := types.NewValuationMap()
-	for _, deposit := range aucDeposits {
-		dData := liqMap[deposit.Denom]
-		dCoinUsdValue := sdk.NewDecFromInt(deposit.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
-		depositCoinValues.Increment(deposit.Denom, dCoinUsdValue)
-	}
+	depositCoinValues := depositValuations(aucDeposits, liqMap)
 
 	// Build valuation map to hold borrow coin USD valuations
 	borrowCoinValues := types.NewValuationMap()
 	for _, bCoin := range borrow.Amount {
 		bData := liqMap[bCoin.Denom]
-		bCoinUsdValue := sdk.NewDecFromInt(bCoin.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price)
+		bCoinUsdValue := sdk.NewDecFromInt(bCoin.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.borrowPrice)
 		borrowCoinValues.Increment(bCoin.Denom, bCoinUsdValue)
 	}
 
 	// Loan-to-Value ratio after sending keeper their reward
 	ltv := borrowCoinValues.Sum().Quo(depositCoinValues.Sum())
 
-	liquidatedCoins, err := k.StartAuctions(ctx, deposit.Depositor, borrow.Amount, aucDeposits, depositCoinValues, borrowCoinValues, ltv, liqMap)
-	// If some coins were liquidated and sent to auction prior to error, still need to emit liquidation event
-	if !liquidatedCoins.Empty() {
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeHardLiquidation,
-				sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, deposit.Depositor.String()),
-				sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, liquidatedCoins.String()),
-				sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
-				sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, keeperRewardCoins.String()),
-			),
-		)
-	}
-	// Returns nil if there's no error
-	return err
+	// Deposit denoms are auctioned against the borrows in liquidation order
+	seizureOrder := k.orderDepositDenoms(ctx, k.GetParams(ctx).LiquidationOrder, depositCoinValues, liqMap)
+
+	liquidatedCoins, debtCovered, err := k.StartAuctions(ctx, deposit.Depositor, borrow.Amount, aucDeposits, depositCoinValues, borrowCoinValues, seizureOrder, ltv, liqMap)
+	if err != nil {
+		return LiquidationResult{}, err
+	}
+	return LiquidationResult{KeeperReward: keeperRewardCoins, Auctioned: liquidatedCoins, DebtCovered: debtCovered, SeizureOrder: seizureOrder}, nil
 }
 
-// StartAuctions attempts to start auctions for seized assets
+// StartAuctions attempts to start auctions for seized assets, taking lots from the deposit denoms in the order of dKeys.
+// It returns the lots sent to auction and the debt they cover.
 func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows, deposits sdk.Coins,
-	depositCoinValues, borrowCoinValues types.ValuationMap, ltv sdk.Dec, liqMap map[string]LiqData) (sdk.Coins, error) {
+	depositCoinValues, borrowCoinValues types.ValuationMap, dKeys []string, ltv sdk.Dec, liqMap map[string]LiqData) (sdk.Coins, sdk.Coins, error) {
 	// Sort keys to ensure deterministic behavior
 	bKeys := borrowCoinValues.GetSortedKeys()
-	dKeys := depositCoinValues.GetSortedKeys()
 
 	// Set up auction constants
 	returnAddrs := []sdk.AccAddress{borrower}
@@ -144,7 +327,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 	macc := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
 	maccCoins := macc.SpendableCoins(ctx.BlockTime())
 
-	var liquidatedCoins sdk.Coins
+	var liquidatedCoins, debtCovered sdk.Coins
 	for _, bKey := range bKeys {
 		bValue := borrowCoinValues.Get(bKey)
 		maxLotSize := bValue.Quo(ltv)
@@ -158,7 +341,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 			if dValue.GTE(maxLotSize) { // We can start an auction for the whole borrow amount]
 				bid := sdk.NewCoin(bKey, borrows.AmountOf(bKey))
 
-				lotSize := maxLotSize.MulInt(liqMap[dKey].conversionFactor).Quo(liqMap[dKey].price)
+				lotSize := maxLotSize.MulInt(liqMap[dKey].conversionFactor).Quo(liqMap[dKey].depositPrice)
 				if lotSize.TruncateInt().Equal(sdk.ZeroInt()) {
 					continue
 				}
@@ -172,13 +355,15 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 				// Sanity check that we can deliver coins to the liquidator account
 				if deposits.AmountOf(dKey).LT(lot.Amount) {
-					return liquidatedCoins, types.ErrInsufficientCoins
+					return liquidatedCoins, debtCovered, sdkerrors.Wrapf(types.ErrInsufficientCoins, "lot %s exceeds seized deposits of %s%s", lot, deposits.AmountOf(dKey), dKey)
 				}
 
-				// Start auction: bid = full borrow amount, lot = maxLotSize
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
-				if err != nil {
-					return liquidatedCoins, err
+				// Sell lot through the swap module if eligible, otherwise start auction: bid = full borrow amount, lot = maxLotSize
+				if !k.swapSeizedDeposit(ctx, borrower, lot, bid, liqMap) {
+					_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+					if err != nil {
+						return liquidatedCoins, debtCovered, err
+					}
 				}
 				// Decrement supplied coins and increment borrowed coins optimistically
 				k.DecrementSuppliedCoins(ctx, sdk.Coins{lot})
@@ -186,6 +371,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 				// Add lot to liquidated coins
 				liquidatedCoins = liquidatedCoins.Add(lot)
+				debtCovered = debtCovered.Add(bid)
 
 				// Update USD valuation maps
 				borrowCoinValues.SetZero(bKey)
@@ -201,7 +387,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 				maxLotSize = sdk.ZeroDec()
 			} else { // We can only start an auction for the partial borrow amount
 				maxBid := dValue.Mul(ltv)
-				bidSize := maxBid.MulInt(liqMap[bKey].conversionFactor).Quo(liqMap[bKey].price)
+				bidSize := maxBid.MulInt(liqMap[bKey].conversionFactor).Quo(liqMap[bKey].borrowPrice)
 				bid := sdk.NewCoin(bKey, bidSize.TruncateInt())
 				lot := sdk.NewCoin(dKey, deposits.AmountOf(dKey))
 
@@ -217,13 +403,15 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 				// Sanity check that we can deliver coins to the liquidator account
 				if deposits.AmountOf(dKey).LT(lot.Amount) {
-					return liquidatedCoins, types.ErrInsufficientCoins
+					return liquidatedCoins, debtCovered, sdkerrors.Wrapf(types.ErrInsufficientCoins, "lot %s exceeds seized deposits of %s%s", lot, deposits.AmountOf(dKey), dKey)
 				}
 
-				// Start auction: bid = maxBid, lot = whole deposit amount
-				_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
-				if err != nil {
-					return liquidatedCoins, err
+				// Sell lot through the swap module if eligible, otherwise start auction: bid = maxBid, lot = whole deposit amount
+				if !k.swapSeizedDeposit(ctx, borrower, lot, bid, liqMap) {
+					_, err := k.auctionKeeper.StartCollateralAuction(ctx, types.ModuleAccountName, lot, bid, returnAddrs, weights, debt)
+					if err != nil {
+						return liquidatedCoins, debtCovered, err
+					}
 				}
 				// Decrement supplied coins and increment borrowed coins optimistically
 				k.DecrementSuppliedCoins(ctx, sdk.Coins{lot})
@@ -231,6 +419,7 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 
 				// Add lot to liquidated coins
 				liquidatedCoins = liquidatedCoins.Add(lot)
+				debtCovered = debtCovered.Add(bid)
 
 				// Update variables to account for partial auction
 				borrowCoinValues.Decrement(bKey, maxBid)
@@ -256,44 +445,44 @@ func (k Keeper) StartAuctions(ctx sdk.Context, borrower sdk.AccAddress, borrows,
 			returnCoin := sdk.NewCoins(sdk.NewCoin(dKey, remaining))
 			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, borrower, returnCoin)
 			if err != nil {
-				return liquidatedCoins, err
+				return liquidatedCoins, debtCovered, err
 			}
 		}
 	}
 
-	return liquidatedCoins, nil
+	return liquidatedCoins, debtCovered, nil
 }
 
 // IsWithinValidLtvRange compares a borrow and deposit to see if it's within a valid LTV range at current prices
 func (k Keeper) IsWithinValidLtvRange(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (bool, error) {
-	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	healthFactor, err := k.CalculateHealthFactor(ctx, deposit, borrow)
 	if err != nil {
 		return false, err
 	}
+	return !types.IsLiquidatable(healthFactor), nil
+}
+
+// getLtvUSDValues returns the USD value a position can borrow against its deposits and the USD value of its borrows
+func (k Keeper) getLtvUSDValues(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) (sdk.Dec, sdk.Dec, error) {
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
 
 	totalBorrowableUSDAmount := sdk.ZeroDec()
-	totalDepositedUSDAmount := sdk.ZeroDec()
 	for _, depCoin := range deposit.Amount {
 		lData := liqMap[depCoin.Denom]
-		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
-		totalDepositedUSDAmount = totalDepositedUSDAmount.Add(usdValue)
-		borrowableUSDAmountForDeposit := usdValue.Mul(lData.ltv)
-		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(borrowableUSDAmountForDeposit)
+		usdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.depositPrice)
+		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(usdValue.Mul(lData.ltv))
 	}
 
 	totalBorrowedUSDAmount := sdk.ZeroDec()
 	for _, coin := range borrow.Amount {
 		lData := liqMap[coin.Denom]
-		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.price)
+		usdValue := sdk.NewDecFromInt(coin.Amount).Quo(sdk.NewDecFromInt(lData.conversionFactor)).Mul(lData.borrowPrice)
 		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
 	}
-
-	// Check if the user's has borrowed more than they're allowed to
-	if totalBorrowedUSDAmount.GT(totalBorrowableUSDAmount) {
-		return false, nil
-	}
-
-	return true, nil
+	return totalBorrowableUSDAmount, totalBorrowedUSDAmount, nil
 }
 
 // GetStoreLTV calculates the user's current LTV based on their deposits/borrows in the store
@@ -327,7 +516,7 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 	depositCoinValues := types.NewValuationMap()
 	for _, depCoin := range deposit.Amount {
 		dData := liqMap[depCoin.Denom]
-		dCoinUsdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.price)
+		dCoinUsdValue := sdk.NewDecFromInt(depCoin.Amount).Quo(sdk.NewDecFromInt(dData.conversionFactor)).Mul(dData.depositPrice)
 		depositCoinValues.Increment(depCoin.Denom, dCoinUsdValue)
 	}
 
@@ -335,7 +524,7 @@ func (k Keeper) CalculateLtv(ctx sdk.Context, deposit types.Deposit, borrow type
 	borrowCoinValues := types.NewValuationMap()
 	for _, bCoin := range borrow.Amount {
 		bData := liqMap[bCoin.Denom]
-		bCoinUsdValue := sdk.NewDecFromInt(bCoin.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.price)
+		bCoinUsdValue := sdk.NewDecFromInt(bCoin.Amount).Quo(sdk.NewDecFromInt(bData.conversionFactor)).Mul(bData.borrowPrice)
 		borrowCoinValues.Increment(bCoin.Denom, bCoinUsdValue)
 	}
 
@@ -365,12 +554,16 @@ func (k Keeper) LoadLiquidationData(ctx sdk.Context, deposit types.Deposit, borr
 
 		}
 
-		priceData, err := k.pricefeedKeeper.GetCurrentPrice(ctx, mm.SpotMarketID)
+		depositPrice, err := k.GetDepositPrice(ctx, mm)
+		if err != nil {
+			return liqMap, err
+		}
+		borrowPrice, err := k.GetBorrowPrice(ctx, mm)
 		if err != nil {
 			return liqMap, err
 		}
 
-		liqMap[denom] = LiqData{priceData.Price, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
+		liqMap[denom] = LiqData{depositPrice, borrowPrice, mm.BorrowLimit.LoanToValue, mm.ConversionFactor}
 	}
 
 	return liqMap, nil
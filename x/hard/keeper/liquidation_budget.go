@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// AttemptBudgetedLiquidations checks borrows in address order, continuing from where the previous block stopped, and
+// liquidates any position outside the valid LTV range. Work stops once the liquidation gas budget is used, so the
+// time spent in a block is bounded even when many positions are liquidatable. Positions liquidated here pay no keeper
+// reward. Once every borrow has been checked the sweep starts again from the first borrow in the next block.
+func (k Keeper) AttemptBudgetedLiquidations(ctx sdk.Context) {
+	budget := k.GetParams(ctx).LiquidationGasBudget
+	if budget == 0 {
+		return
+	}
+	gasMeter := sdk.NewGasMeter(budget)
+	budgetCtx := ctx.WithGasMeter(gasMeter)
+
+	cursor, _ := k.GetLiquidationCursor(ctx)
+	checked := 0
+	for !gasMeter.IsOutOfGas() {
+		borrower, found, completed := k.liquidateNextBorrower(budgetCtx, cursor)
+		if !completed {
+			// a position that cannot be checked within a full budget is skipped so it does not stall the sweep
+			if checked == 0 && borrower != nil {
+				k.Logger(ctx).Error("liquidation check exceeded gas budget", "borrower", borrower, "budget", budget)
+				cursor = borrower
+			}
+			break
+		}
+		if !found {
+			cursor = nil
+			break
+		}
+		cursor = borrower
+		checked++
+	}
+	k.SetLiquidationCursor(ctx, cursor)
+}
+
+// liquidateNextBorrower attempts to liquidate the first borrower after the cursor. It returns completed as false if
+// the gas meter ran out before the attempt finished, in which case no state from the attempt is written.
+func (k Keeper) liquidateNextBorrower(ctx sdk.Context, cursor sdk.AccAddress) (borrower sdk.AccAddress, found, completed bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			completed = false
+		}
+	}()
+
+	borrower, found = k.nextBorrower(ctx, cursor)
+	if !found {
+		return nil, false, true
+	}
+
+	// failed attempts, including those on healthy positions, are discarded along with their events
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.AttemptKeeperLiquidation(cacheCtx, nil, borrower); err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+	return borrower, true, true
+}
+
+// nextBorrower returns the first borrower stored after the cursor, or the first borrower if the cursor is empty
+func (k Keeper) nextBorrower(ctx sdk.Context, cursor sdk.AccAddress) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+	var start []byte
+	if !cursor.Empty() {
+		start = append(append([]byte{}, cursor...), 0x00)
+	}
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	return sdk.AccAddress(iterator.Key()), true
+}
+
+// GetLiquidationCursor returns the last borrower checked by begin blocker liquidations
+func (k Keeper) GetLiquidationCursor(ctx sdk.Context) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LiquidationCursorKey)
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetLiquidationCursor sets the last borrower checked by begin blocker liquidations, an empty cursor restarts the
+// sweep from the first borrower
+func (k Keeper) SetLiquidationCursor(ctx sdk.Context, cursor sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	if cursor.Empty() {
+		store.Delete(types.LiquidationCursorKey)
+		return
+	}
+	store.Set(types.LiquidationCursorKey, cursor)
+}
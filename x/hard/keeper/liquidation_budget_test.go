@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestAttemptBudgetedLiquidations() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	borrowers := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("borrower1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("borrower2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("borrower3"))),
+	}
+	sort.Slice(borrowers, func(i, j int) bool { return bytes.Compare(borrowers[i], borrowers[j]) < 0 })
+
+	setup := func(budget uint64) {
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+		coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+		authGS := app.NewAuthGenState(borrowers, []sdk.Coins{coins, coins, coins})
+
+		params := types.NewParams(types.MoneyMarkets{
+			types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+			types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+				"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		})
+		params.LiquidationGasBudget = budget
+		hardGS := types.NewGenesisState(params, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+			types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+		)
+		pricefeedGS := pricefeed.GenesisState{
+			Params: pricefeed.Params{
+				Markets: []pricefeed.Market{
+					{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				},
+			},
+			PostedPrices: []pricefeed.PostedPrice{
+				{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+				{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			},
+		}
+		tApp.InitializeFromGenesisStates(authGS,
+			app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+			app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+		tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+
+		suite.app = tApp
+		suite.ctx = ctx
+		suite.keeper = tApp.GetHardKeeper()
+		suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+		// each borrower deposits $20 of kava, the first two borrow the maximum $16 of usdx and the last borrows $8
+		for i, borrower := range borrowers {
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+			borrowAmount := sdk.NewInt(16 * USDX_CF)
+			if i == len(borrowers)-1 {
+				borrowAmount = sdk.NewInt(8 * USDX_CF)
+			}
+			suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", borrowAmount))))
+		}
+
+		// drop the kava price so the first two positions are liquidatable
+		pricefeedKeeper := tApp.GetPriceFeedKeeper()
+		_, err := pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.90"), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd"))
+		// current prices are updated at the end of a block, so hard uses the new price from the next block
+		suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	}
+	hasBorrow := func(borrower sdk.AccAddress) bool {
+		_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+		return found
+	}
+
+	suite.Run("disabled without a budget", func() {
+		setup(0)
+		suite.keeper.AttemptBudgetedLiquidations(suite.ctx)
+		suite.Require().Empty(suite.auctionKeeper.GetAllAuctions(suite.ctx))
+		suite.Require().True(hasBorrow(borrowers[0]))
+		suite.Require().True(hasBorrow(borrowers[1]))
+	})
+
+	suite.Run("liquidates positions outside the valid ltv range", func() {
+		setup(10000000)
+		suite.keeper.AttemptBudgetedLiquidations(suite.ctx)
+		suite.Require().Len(suite.auctionKeeper.GetAllAuctions(suite.ctx), 2)
+		suite.Require().False(hasBorrow(borrowers[0]))
+		suite.Require().False(hasBorrow(borrowers[1]))
+		suite.Require().True(hasBorrow(borrowers[2]))
+
+		// the sweep reached the last borrow so the next block starts from the beginning
+		_, found := suite.keeper.GetLiquidationCursor(suite.ctx)
+		suite.Require().False(found)
+	})
+
+	suite.Run("continues from the cursor", func() {
+		setup(10000000)
+		suite.keeper.SetLiquidationCursor(suite.ctx, borrowers[0])
+
+		suite.keeper.AttemptBudgetedLiquidations(suite.ctx)
+		suite.Require().True(hasBorrow(borrowers[0]))
+		suite.Require().False(hasBorrow(borrowers[1]))
+
+		suite.keeper.AttemptBudgetedLiquidations(suite.ctx)
+		suite.Require().False(hasBorrow(borrowers[0]))
+		suite.Require().Len(suite.auctionKeeper.GetAllAuctions(suite.ctx), 2)
+	})
+
+	suite.Run("stops when the budget is used", func() {
+		setup(1000)
+		suite.keeper.AttemptBudgetedLiquidations(suite.ctx)
+		suite.Require().Empty(suite.auctionKeeper.GetAllAuctions(suite.ctx))
+		suite.Require().True(hasBorrow(borrowers[0]))
+	})
+}
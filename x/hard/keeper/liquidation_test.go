@@ -1,7 +1,7 @@
 package keeper_test
 
 import (
-	"strings"
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +14,7 @@ import (
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/hard/types"
 	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/swap"
 )
 
 func (suite *KeeperTestSuite) TestKeeperLiquidation() {
@@ -35,8 +36,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 	}
 
 	type errArgs struct {
-		expectPass bool
-		contains   string
+		expectPass  bool
+		expectedErr error
 	}
 
 	type liqTest struct {
@@ -95,8 +96,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -179,8 +180,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -248,8 +249,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -348,8 +349,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -417,8 +418,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				},
 			},
 			errArgs{
-				expectPass: true,
-				contains:   "",
+				expectPass:  true,
+				expectedErr: nil,
 			},
 		},
 		{
@@ -440,8 +441,8 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				expectedAuctions:        auctypes.Auctions{},
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "borrow not liquidatable",
+				expectPass:  false,
+				expectedErr: types.ErrBorrowNotLiquidatable,
 			},
 		},
 	}
@@ -655,7 +656,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 				suite.Require().Equal(borrowedCoinsPre.Add(tc.args.expectedBidCoins...), borrowedCoinsPost)
 			} else {
 				suite.Require().Error(err)
-				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 
 				// Check that the user's borrow exists
 				_, foundBorrowAfter := suite.keeper.GetBorrow(liqCtx, tc.args.borrower)
@@ -671,3 +672,604 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSwapLiquidation() {
+	type args struct {
+		swapLiquidation       types.SwapLiquidation
+		expectSwap            bool
+		expectedBorrowerCoins sdk.Coins // coins the borrower address should have after liquidation
+	}
+
+	type liqTest struct {
+		name string
+		args args
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+	provider := sdk.AccAddress(crypto.AddressHash([]byte("testprovider")))
+
+	testCases := []liqTest{
+		{
+			"valid: lot sold through swap pool",
+			args{
+				swapLiquidation:       types.NewSwapLiquidation("ukava", sdk.NewInt(10*KAVA_CF), sdk.MustNewDecFromStr("0.05")),
+				expectSwap:            true,
+				expectedBorrowerCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(91052896)), sdk.NewCoin("usdx", sdk.NewInt(16*USDX_CF))),
+			},
+		},
+		{
+			"valid: lot above max lot size is auctioned",
+			args{
+				swapLiquidation:       types.NewSwapLiquidation("ukava", sdk.NewInt(1*KAVA_CF), sdk.MustNewDecFromStr("0.05")),
+				expectSwap:            false,
+				expectedBorrowerCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90000001)), sdk.NewCoin("usdx", sdk.NewInt(16*USDX_CF))),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{borrower, keeper, provider},
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100000*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(190000*USDX_CF))),
+				},
+			)
+
+			hardParams := types.NewParams(
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
+						"usdx:usd",                     // Market ID
+						sdk.NewInt(USDX_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+					types.NewMoneyMarket("ukava",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+						"kava:usd",                     // Market ID
+						sdk.NewInt(KAVA_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+				},
+			)
+			hardParams.SwapLiquidations = types.SwapLiquidations{tc.args.swapLiquidation}
+			hardGS := types.NewGenesisState(hardParams, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			swapGS := swap.NewGenesisState(
+				swap.NewParams(swap.AllowedPools{swap.NewAllowedPool("ukava", "usdx")}, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()),
+				swap.Pools{}, swap.ShareRecords{},
+			)
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+				app.GenesisState{swap.ModuleName: swap.ModuleCdc.MustMarshalJSON(swapGS)})
+
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			suite.auctionKeeper = tApp.GetAuctionKeeper()
+			swapKeeper := tApp.GetSwapKeeper()
+
+			// Provide liquidity at a price of $1.90 per kava
+			err := swapKeeper.Deposit(suite.ctx, provider, sdk.NewCoin("ukava", sdk.NewInt(100000*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(190000*USDX_CF)), sdk.MustNewDecFromStr("0.01"))
+			suite.Require().NoError(err)
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			// Deposit $20 of kava and borrow the maximum $16 of usdx
+			err = suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(16*USDX_CF))))
+			suite.Require().NoError(err)
+
+			// Drop the kava price so the position is liquidatable
+			pricefeedKeeper := tApp.GetPriceFeedKeeper()
+			_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.90"), suite.ctx.BlockTime().Add(time.Hour))
+			suite.Require().NoError(err)
+			err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd")
+			suite.Require().NoError(err)
+			// current prices are updated at the end of a block, so hard uses the new price from the next block
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+			hardMaccBefore := suite.getModuleAccountAtCtx(types.ModuleAccountName, suite.ctx).GetCoins()
+			poolBefore, found := swapKeeper.GetPool(suite.ctx, swap.PoolID("ukava", "usdx"))
+			suite.Require().True(found)
+
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+			suite.Require().NoError(err)
+
+			accBorrower := suite.getAccountAtCtx(borrower, suite.ctx)
+			suite.Require().Equal(tc.args.expectedBorrowerCoins, accBorrower.GetCoins())
+
+			auctions := suite.auctionKeeper.GetAllAuctions(suite.ctx)
+			poolAfter, found := swapKeeper.GetPool(suite.ctx, swap.PoolID("ukava", "usdx"))
+			suite.Require().True(found)
+			hardMaccAfter := suite.getModuleAccountAtCtx(types.ModuleAccountName, suite.ctx).GetCoins()
+			if tc.args.expectSwap {
+				suite.Require().Len(auctions, 0)
+				suite.Require().Equal(poolBefore.ReservesB.Amount.Sub(sdk.NewInt(16*USDX_CF)), poolAfter.ReservesB.Amount)
+				suite.Require().Equal(hardMaccBefore.AmountOf("usdx").Add(sdk.NewInt(16*USDX_CF)), hardMaccAfter.AmountOf("usdx"))
+			} else {
+				suite.Require().Len(auctions, 1)
+				suite.Require().Equal(poolBefore, poolAfter)
+				suite.Require().Equal(hardMaccBefore.AmountOf("usdx"), hardMaccAfter.AmountOf("usdx"))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCloseFactorLiquidation() {
+	type args struct {
+		closeFactor             sdk.Dec
+		expectedDepositCoins    sdk.Coins // coins left in the borrower's deposit after liquidation
+		expectedBorrowCoins     sdk.Coins // coins left in the borrower's borrow after liquidation
+		expectedPositionDeleted bool
+		expectedEventAttributes []sdk.Attribute // accounting attributes of the liquidation event
+	}
+
+	type liqTest struct {
+		name string
+		args args
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+
+	testCases := []liqTest{
+		{
+			"valid: full liquidation",
+			args{
+				closeFactor:             sdk.OneDec(),
+				expectedPositionDeleted: true,
+				expectedEventAttributes: []sdk.Attribute{
+					sdk.NewAttribute(types.AttributeKeySeizedCoins, "10000000ukava"),
+					sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, "500000ukava"),
+					sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, "9499999ukava"),
+					sdk.NewAttribute(types.AttributeKeyDebtCovered, "16000000usdx"),
+					sdk.NewAttribute(types.AttributeKeyResidualDebt, ""),
+					sdk.NewAttribute(types.AttributeKeyResultingLtv, "0.000000000000000000"),
+				},
+			},
+		},
+		{
+			"valid: half of the position is liquidated",
+			args{
+				closeFactor:          sdk.MustNewDecFromStr("0.5"),
+				expectedDepositCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF))),
+				expectedBorrowCoins:  sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF))),
+				expectedEventAttributes: []sdk.Attribute{
+					sdk.NewAttribute(types.AttributeKeySeizedCoins, "5000000ukava"),
+					sdk.NewAttribute(types.AttributeKeyKeeperRewardCoins, "250000ukava"),
+					sdk.NewAttribute(types.AttributeKeyLiquidatedCoins, "4749999ukava"),
+					sdk.NewAttribute(types.AttributeKeyDebtCovered, "8000000usdx"),
+					sdk.NewAttribute(types.AttributeKeyResidualDebt, "8000000usdx"),
+					sdk.NewAttribute(types.AttributeKeyResultingLtv, "0.842105263157894737"), // $8 of usdx against $9.50 of kava
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{borrower, keeper},
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				},
+			)
+
+			usdxMarket := types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
+				"usdx:usd",                    // Market ID
+				sdk.NewInt(USDX_CF),           // Conversion Factor
+				model,                         // Interest Rate Model
+				reserveFactor,                 // Reserve Factor
+				sdk.MustNewDecFromStr("0.05")) // Keeper Reward Percent
+			usdxMarket.CloseFactor = tc.args.closeFactor
+			hardGS := types.NewGenesisState(types.NewParams(
+				types.MoneyMarkets{
+					usdxMarket,
+					types.NewMoneyMarket("ukava",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+						"kava:usd",                     // Market ID
+						sdk.NewInt(KAVA_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+				},
+			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			// Deposit $20 of kava and borrow the maximum $16 of usdx
+			err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(16*USDX_CF))))
+			suite.Require().NoError(err)
+
+			// Drop the kava price so the position is liquidatable
+			pricefeedKeeper := tApp.GetPriceFeedKeeper()
+			_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.90"), suite.ctx.BlockTime().Add(time.Hour))
+			suite.Require().NoError(err)
+			err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd")
+			suite.Require().NoError(err)
+			// current prices are updated at the end of a block, so hard uses the new price from the next block
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+			suite.Require().NoError(err)
+			suite.Require().Len(suite.auctionKeeper.GetAllAuctions(suite.ctx), 1)
+
+			// a single event carries the full accounting of the liquidation
+			var liquidationEvents sdk.Events
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeHardLiquidation {
+					liquidationEvents = append(liquidationEvents, event)
+				}
+			}
+			suite.Require().Len(liquidationEvents, 1)
+			expectedAttributes := append([]sdk.Attribute{
+				sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+				sdk.NewAttribute(types.AttributeKeyBorrower, borrower.String()),
+				sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			}, tc.args.expectedEventAttributes...)
+			for _, attr := range expectedAttributes {
+				suite.Require().Contains(liquidationEvents[0].Attributes, attr.ToKVPair())
+			}
+
+			deposit, foundDeposit := suite.keeper.GetDeposit(suite.ctx, borrower)
+			borrow, foundBorrow := suite.keeper.GetBorrow(suite.ctx, borrower)
+			if tc.args.expectedPositionDeleted {
+				suite.Require().False(foundDeposit)
+				suite.Require().False(foundBorrow)
+				return
+			}
+			suite.Require().True(foundDeposit)
+			suite.Require().True(foundBorrow)
+			suite.Require().Equal(tc.args.expectedDepositCoins, deposit.Amount)
+			suite.Require().Equal(tc.args.expectedBorrowCoins, borrow.Amount)
+
+			// the remaining position is still liquidatable
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+			suite.Require().NoError(err)
+			suite.Require().Len(suite.auctionKeeper.GetAllAuctions(suite.ctx), 2)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLiquidationOrder() {
+	type args struct {
+		liquidationOrder     string
+		moduleBnb            sdk.Int   // extra bnb held by the module account
+		expectedDepositCoins sdk.Coins // coins left in the borrower's deposit after liquidation
+		expectedSeizureOrder string
+	}
+
+	type liqTest struct {
+		name string
+		args args
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	keeper := sdk.AccAddress(crypto.AddressHash([]byte("testkeeper")))
+
+	testCases := []liqTest{
+		{
+			"valid: proportional seizes half of each deposit denom",
+			args{
+				liquidationOrder:     types.LiquidationOrderProportional,
+				moduleBnb:            sdk.ZeroInt(),
+				expectedDepositCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(BNB_CF/2))),
+				expectedSeizureOrder: "bnb,ukava",
+			},
+		},
+		{
+			"valid: highest value seizes $14.50 of the $19 of kava",
+			args{
+				liquidationOrder:     types.LiquidationOrderHighestValue,
+				moduleBnb:            sdk.ZeroInt(),
+				expectedDepositCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(2368422)), sdk.NewCoin("bnb", sdk.NewInt(BNB_CF))),
+				expectedSeizureOrder: "ukava",
+			},
+		},
+		{
+			"valid: most liquid seizes the $10 of bnb then $4.50 of kava",
+			args{
+				liquidationOrder:     types.LiquidationOrderMostLiquid,
+				moduleBnb:            sdk.NewInt(10 * BNB_CF),
+				expectedDepositCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(7631579))),
+				expectedSeizureOrder: "bnb,ukava",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{borrower, keeper},
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(100*BNB_CF))),
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+				},
+			)
+
+			usdxMarket := types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
+				"usdx:usd",                    // Market ID
+				sdk.NewInt(USDX_CF),           // Conversion Factor
+				model,                         // Interest Rate Model
+				reserveFactor,                 // Reserve Factor
+				sdk.MustNewDecFromStr("0.05")) // Keeper Reward Percent
+			usdxMarket.CloseFactor = sdk.MustNewDecFromStr("0.5")
+			hardParams := types.NewParams(
+				types.MoneyMarkets{
+					usdxMarket,
+					types.NewMoneyMarket("ukava",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+						"kava:usd",                     // Market ID
+						sdk.NewInt(KAVA_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+					types.NewMoneyMarket("bnb",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*BNB_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+						"bnb:usd",                      // Market ID
+						sdk.NewInt(BNB_CF),             // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+				},
+			)
+			hardParams.LiquidationOrder = tc.args.liquidationOrder
+			hardGS := types.NewGenesisState(hardParams, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "bnb:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("10.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+			if tc.args.moduleBnb.IsPositive() {
+				supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("bnb", tc.args.moduleBnb)))
+			}
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			// Deposit $20 of kava and $10 of bnb and borrow the maximum $24 of usdx
+			err := suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("bnb", sdk.NewInt(BNB_CF))))
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(24*USDX_CF))))
+			suite.Require().NoError(err)
+
+			// Drop the kava price so the position is liquidatable
+			pricefeedKeeper := tApp.GetPriceFeedKeeper()
+			_, err = pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.90"), suite.ctx.BlockTime().Add(time.Hour))
+			suite.Require().NoError(err)
+			err = pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd")
+			suite.Require().NoError(err)
+			// current prices are updated at the end of a block, so hard uses the new price from the next block
+			suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeper, borrower)
+			suite.Require().NoError(err)
+
+			var liquidationEvents sdk.Events
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeHardLiquidation {
+					liquidationEvents = append(liquidationEvents, event)
+				}
+			}
+			suite.Require().Len(liquidationEvents, 1)
+			suite.Require().Contains(liquidationEvents[0].Attributes, sdk.NewAttribute(types.AttributeKeyLiquidationOrder, tc.args.liquidationOrder).ToKVPair())
+			suite.Require().Contains(liquidationEvents[0].Attributes, sdk.NewAttribute(types.AttributeKeySeizureOrder, tc.args.expectedSeizureOrder).ToKVPair())
+
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.expectedDepositCoins, deposit.Amount)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestLiquidatorWhitelist() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	vettedKeeper := sdk.AccAddress(crypto.AddressHash([]byte("vettedkeeper")))
+	otherKeeper := sdk.AccAddress(crypto.AddressHash([]byte("otherkeeper")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	authGS := app.NewAuthGenState(
+		[]sdk.AccAddress{borrower},
+		[]sdk.Coins{sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))},
+	)
+
+	// only the kava market restricts liquidations
+	kavaMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05"))
+	kavaMarket.LiquidatorWhitelist = []sdk.AccAddress{vettedKeeper}
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("usdx",
+				types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+				"usdx:usd", sdk.NewInt(USDX_CF), model, reserveFactor, sdk.MustNewDecFromStr("0.05")),
+			kavaMarket,
+		},
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: time.Now().Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: time.Now().Add(100 * time.Hour)},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	suite.auctionKeeper = tApp.GetAuctionKeeper()
+
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// Deposit $20 of kava, borrow the maximum $16 of usdx, then drop the kava price so the position is liquidatable
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(16*USDX_CF)))))
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	_, err := pricefeedKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", sdk.MustNewDecFromStr("1.90"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "kava:usd"))
+	// current prices are updated at the end of a block, so hard uses the new price from the next block
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+
+	// the position holds kava so only whitelisted keepers can liquidate it
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, otherKeeper, borrower)
+	suite.Require().True(errors.Is(err, types.ErrLiquidatorNotPermitted))
+	_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().True(found)
+
+	suite.Require().NoError(suite.keeper.AttemptKeeperLiquidation(suite.ctx, vettedKeeper, borrower))
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+	suite.Require().Len(suite.auctionKeeper.GetAllAuctions(suite.ctx), 1)
+}
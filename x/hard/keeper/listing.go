@@ -0,0 +1,180 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// ScheduleMoneyMarket validates a money market listing against the current state and stores it until its activation time
+func (k Keeper) ScheduleMoneyMarket(ctx sdk.Context, listing types.ScheduledMoneyMarket) error {
+	moneyMarket, err := k.DeriveConversionFactor(listing.MoneyMarket)
+	if err != nil {
+		return err
+	}
+	// a borrow limit ramp without a start time begins when the market is listed
+	if moneyMarket.BorrowLimit.RampDuration > 0 && moneyMarket.BorrowLimit.RampStartTime.IsZero() {
+		moneyMarket.BorrowLimit.RampStartTime = listing.ActivationTime
+	}
+	listing.MoneyMarket = moneyMarket
+	if err := listing.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.GetParams(ctx).ValidateAnnualRate(listing.MoneyMarket.InterestRateModel); err != nil {
+		return sdkerrors.Wrap(types.ErrExceedsMaxAnnualRate, err.Error())
+	}
+
+	denom := listing.MoneyMarket.Denom
+	if _, found := k.GetMoneyMarketParam(ctx, denom); found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketExists, "denom %s", denom)
+	}
+	if _, found := k.GetScheduledMoneyMarket(ctx, denom); found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketExists, "denom %s is already scheduled", denom)
+	}
+
+	if err := k.validatePricefeedMarket(ctx, listing.MoneyMarket.SpotMarketID); err != nil {
+		return err
+	}
+	if listing.MoneyMarket.PriceSource.UsesTwap() {
+		if err := k.validatePricefeedMarket(ctx, listing.MoneyMarket.TwapMarketID); err != nil {
+			return err
+		}
+	}
+
+	if !listing.ActivationTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidActivationTime, "%s is not after block time %s", listing.ActivationTime, ctx.BlockTime())
+	}
+
+	k.SetScheduledMoneyMarket(ctx, listing)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardMoneyMarketScheduled,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyActivationTime, listing.ActivationTime.Format(time.RFC3339)),
+		),
+	)
+	return nil
+}
+
+// DeriveConversionFactor sets the conversion factor of a money market from the registered metadata of its denom.
+// A conversion factor that is already set must match the registered one. Denoms without registered metadata are
+// returned unchanged and must carry their own conversion factor.
+func (k Keeper) DeriveConversionFactor(mm types.MoneyMarket) (types.MoneyMarket, error) {
+	if k.denomMetadata == nil {
+		return mm, nil
+	}
+	conversionFactor, found := k.denomMetadata.GetConversionFactor(mm.Denom)
+	if !found {
+		return mm, nil
+	}
+	if mm.ConversionFactor.IsNil() || mm.ConversionFactor.IsZero() {
+		mm.ConversionFactor = conversionFactor
+		return mm, nil
+	}
+	if !mm.ConversionFactor.Equal(conversionFactor) {
+		return mm, sdkerrors.Wrapf(types.ErrConversionFactorMismatch, "denom %s has conversion factor %s, metadata requires %s",
+			mm.Denom, mm.ConversionFactor, conversionFactor)
+	}
+	return mm, nil
+}
+
+// ActivateScheduledMoneyMarkets adds the scheduled money markets whose activation time has passed to the params
+func (k Keeper) ActivateScheduledMoneyMarkets(ctx sdk.Context) {
+	var activated types.ScheduledMoneyMarkets
+	k.IterateScheduledMoneyMarkets(ctx, func(listing types.ScheduledMoneyMarket) bool {
+		if listing.IsActive(ctx.BlockTime()) {
+			activated = append(activated, listing)
+		}
+		return false
+	})
+	if len(activated) == 0 {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	listed := make(map[string]bool)
+	for _, mm := range params.MoneyMarkets {
+		listed[mm.Denom] = true
+	}
+	for _, listing := range activated {
+		k.DeleteScheduledMoneyMarket(ctx, listing.MoneyMarket.Denom)
+
+		// a market for the denom may have been added by a param change since the listing was scheduled
+		if listed[listing.MoneyMarket.Denom] {
+			k.Logger(ctx).Info("skipping scheduled money market, denom already listed", "denom", listing.MoneyMarket.Denom)
+			continue
+		}
+		params.MoneyMarkets = append(params.MoneyMarkets, listing.MoneyMarket)
+		listed[listing.MoneyMarket.Denom] = true
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHardMoneyMarketActivation,
+				sdk.NewAttribute(types.AttributeKeyDenom, listing.MoneyMarket.Denom),
+			),
+		)
+	}
+	k.SetParams(ctx, params)
+}
+
+// GetScheduledMoneyMarket returns the scheduled money market listing for a denom
+func (k Keeper) GetScheduledMoneyMarket(ctx sdk.Context, denom string) (types.ScheduledMoneyMarket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ScheduledMoneyMarketsPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.ScheduledMoneyMarket{}, false
+	}
+	var listing types.ScheduledMoneyMarket
+	k.cdc.MustUnmarshalBinaryBare(bz, &listing)
+	return listing, true
+}
+
+// SetScheduledMoneyMarket sets a scheduled money market listing in the store
+func (k Keeper) SetScheduledMoneyMarket(ctx sdk.Context, listing types.ScheduledMoneyMarket) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ScheduledMoneyMarketsPrefix)
+	store.Set([]byte(listing.MoneyMarket.Denom), k.cdc.MustMarshalBinaryBare(listing))
+}
+
+// DeleteScheduledMoneyMarket deletes a scheduled money market listing from the store
+func (k Keeper) DeleteScheduledMoneyMarket(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ScheduledMoneyMarketsPrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateScheduledMoneyMarkets iterates over all scheduled money market listings and performs a callback function
+func (k Keeper) IterateScheduledMoneyMarkets(ctx sdk.Context, cb func(listing types.ScheduledMoneyMarket) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ScheduledMoneyMarketsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var listing types.ScheduledMoneyMarket
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &listing)
+		if cb(listing) {
+			break
+		}
+	}
+}
+
+// GetAllScheduledMoneyMarkets returns all scheduled money market listings
+func (k Keeper) GetAllScheduledMoneyMarkets(ctx sdk.Context) types.ScheduledMoneyMarkets {
+	listings := types.ScheduledMoneyMarkets{}
+	k.IterateScheduledMoneyMarkets(ctx, func(listing types.ScheduledMoneyMarket) bool {
+		listings = append(listings, listing)
+		return false
+	})
+	return listings
+}
+
+func (k Keeper) validatePricefeedMarket(ctx sdk.Context, marketID string) error {
+	market, found := k.pricefeedKeeper.GetMarket(ctx, marketID)
+	if !found || !market.Active {
+		return sdkerrors.Wrapf(types.ErrPricefeedMarketInactive, "market id %s", marketID)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestScheduleMoneyMarket() {
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	moneyMarket := func(denom, spotMarketID string, conversionFactor sdk.Int) types.MoneyMarket {
+		return types.NewMoneyMarket(denom, types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), spotMarketID, conversionFactor, model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	}
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{moneyMarket("ukava", "kava:usd", sdk.NewInt(KAVA_CF))},
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: false},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{},
+	}
+	tApp.InitializeFromGenesisStates(
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	keeper := tApp.GetHardKeeper()
+
+	activationTime := blockTime.Add(24 * time.Hour)
+	testCases := []struct {
+		name        string
+		listing     types.ScheduledMoneyMarket
+		expectedErr error
+	}{
+		{"existing money market", types.NewScheduledMoneyMarket(moneyMarket("ukava", "kava:usd", sdk.NewInt(KAVA_CF)), activationTime), types.ErrMoneyMarketExists},
+		{"conversion factor mismatch", types.NewScheduledMoneyMarket(moneyMarket("bnb", "bnb:usd", sdk.NewInt(KAVA_CF)), activationTime), types.ErrConversionFactorMismatch},
+		{"missing pricefeed market", types.NewScheduledMoneyMarket(moneyMarket("xrpb", "xrp:usd", sdk.Int{}), activationTime), types.ErrPricefeedMarketInactive},
+		{"inactive pricefeed market", types.NewScheduledMoneyMarket(moneyMarket("btcb", "btc:usd", sdk.Int{}), activationTime), types.ErrPricefeedMarketInactive},
+		{"activation time not in future", types.NewScheduledMoneyMarket(moneyMarket("bnb", "bnb:usd", sdk.Int{}), blockTime), types.ErrInvalidActivationTime},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := keeper.ScheduleMoneyMarket(ctx, tc.listing)
+			suite.Require().True(errors.Is(err, tc.expectedErr))
+		})
+	}
+
+	// the market's interest rate model must stay within the max annual rate
+	params := keeper.GetParams(ctx)
+	params.MaxAnnualRate = sdk.MustNewDecFromStr("3")
+	keeper.SetParams(ctx, params)
+	listing := types.NewScheduledMoneyMarket(moneyMarket("bnb", "bnb:usd", sdk.Int{}), activationTime)
+	err := keeper.ScheduleMoneyMarket(ctx, listing)
+	suite.Require().True(errors.Is(err, types.ErrExceedsMaxAnnualRate))
+	params.MaxAnnualRate = types.DefaultMaxAnnualRate
+	keeper.SetParams(ctx, params)
+
+	// the conversion factor is derived from the denom metadata when left out
+	suite.Require().NoError(keeper.ScheduleMoneyMarket(ctx, listing))
+	err = keeper.ScheduleMoneyMarket(ctx, listing)
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketExists))
+
+	// the market is not listed until its activation time
+	keeper.ActivateScheduledMoneyMarkets(ctx.WithBlockTime(activationTime.Add(-time.Second)))
+	_, found := keeper.GetMoneyMarketParam(ctx, "bnb")
+	suite.Require().False(found)
+
+	keeper.ActivateScheduledMoneyMarkets(ctx.WithBlockTime(activationTime))
+	mm, found := keeper.GetMoneyMarketParam(ctx, "bnb")
+	suite.Require().True(found)
+	suite.Require().Equal(moneyMarket("bnb", "bnb:usd", sdk.NewInt(100000000)), mm)
+	_, found = keeper.GetScheduledMoneyMarket(ctx, "bnb")
+	suite.Require().False(found)
+
+	// a borrow limit ramp without a start time begins at the activation time
+	rampListing := types.NewScheduledMoneyMarket(moneyMarket("xrpb", "bnb:usd", sdk.Int{}), activationTime)
+	rampListing.MoneyMarket.BorrowLimit.HasMaxLimit = true
+	rampListing.MoneyMarket.BorrowLimit.InitialLimit = sdk.NewDec(KAVA_CF)
+	rampListing.MoneyMarket.BorrowLimit.RampDuration = 10 * time.Hour
+	suite.Require().NoError(keeper.ScheduleMoneyMarket(ctx, rampListing))
+	rampListing, found = keeper.GetScheduledMoneyMarket(ctx, "xrpb")
+	suite.Require().True(found)
+	suite.Require().Equal(activationTime, rampListing.MoneyMarket.BorrowLimit.RampStartTime)
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetMaxWithdraw returns the largest amount of a denom that an account can withdraw while staying within its
+// loan-to-value limit, including interest that has not been synced. The safety margin is the fraction of the account's
+// borrowable value that is left unused, so a margin of 0.1 keeps the borrows at or below 90% of the borrowable value.
+func (k Keeper) GetMaxWithdraw(ctx sdk.Context, addr sdk.AccAddress, denom string, safetyMargin sdk.Dec) (sdk.Coin, error) {
+	deposit, found := k.GetSyncedDeposit(ctx, addr)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", addr)
+	}
+	deposited := deposit.Amount.AmountOf(denom)
+	if deposited.IsZero() {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	borrow, _ := k.GetSyncedBorrow(ctx, addr)
+
+	headroom, liqMap, err := k.getBorrowHeadroom(ctx, deposit, borrow, safetyMargin)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if borrow.Amount.IsZero() {
+		return sdk.NewCoin(denom, deposited), nil
+	}
+	if !headroom.IsPositive() {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	// each unit withdrawn reduces the borrowable value by its value multiplied by the loan-to-value ratio
+	lData := liqMap[denom]
+	borrowableUSDPerUnit := lData.depositPrice.Mul(lData.ltv).Mul(sdk.OneDec().Sub(safetyMargin)).Quo(lData.conversionFactor.ToDec())
+	if borrowableUSDPerUnit.IsZero() {
+		return sdk.NewCoin(denom, deposited), nil
+	}
+	amount := headroom.Quo(borrowableUSDPerUnit).TruncateInt()
+	return sdk.NewCoin(denom, sdk.MinInt(amount, deposited)), nil
+}
+
+// GetMaxBorrow returns the largest amount of a denom that an account can borrow while staying within its loan-to-value
+// limit, including interest that has not been synced. The amount is also limited by the money market's global borrow
+// limit and the coins available to borrow. The safety margin is applied in the same way as in GetMaxWithdraw.
+func (k Keeper) GetMaxBorrow(ctx sdk.Context, addr sdk.AccAddress, denom string, safetyMargin sdk.Dec) (sdk.Coin, error) {
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if !found {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", denom)
+	}
+	if k.IsMoneyMarketDeprecated(ctx, denom) {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	deposit, found := k.GetSyncedDeposit(ctx, addr)
+	if !found {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+	borrow, _ := k.GetSyncedBorrow(ctx, addr)
+
+	headroom, _, err := k.getBorrowHeadroom(ctx, deposit, borrow, safetyMargin)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !headroom.IsPositive() {
+		return sdk.NewCoin(denom, sdk.ZeroInt()), nil
+	}
+
+	price, err := k.GetBorrowPrice(ctx, mm)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	amount := headroom.Mul(mm.ConversionFactor.ToDec()).Quo(price).TruncateInt()
+
+	amount = sdk.MinInt(amount, k.GetCash(ctx).AmountOf(denom))
+	if mm.BorrowLimit.HasMaxLimit {
+		borrowedCoins, _ := k.GetBorrowedCoins(ctx)
+		globalLimit := mm.BorrowLimit.MaximumLimitAt(ctx.BlockTime()).TruncateInt().Sub(borrowedCoins.AmountOf(denom))
+		amount = sdk.MinInt(amount, globalLimit)
+	}
+	return sdk.NewCoin(denom, sdk.MaxInt(amount, sdk.ZeroInt())), nil
+}
+
+// getBorrowHeadroom returns the USD value an account can still borrow, which is negative when the account is over its
+// loan-to-value limit, along with the liquidation data of its deposit and borrow denoms
+func (k Keeper) getBorrowHeadroom(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow, safetyMargin sdk.Dec) (sdk.Dec, map[string]LiqData, error) {
+	if safetyMargin.IsNegative() || safetyMargin.GTE(sdk.OneDec()) {
+		return sdk.Dec{}, nil, sdkerrors.Wrapf(types.ErrInvalidSafetyMargin, "%s", safetyMargin)
+	}
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return sdk.Dec{}, nil, err
+	}
+
+	totalBorrowableUSDAmount := sdk.ZeroDec()
+	for _, depCoin := range deposit.Amount {
+		lData := liqMap[depCoin.Denom]
+		usdValue := depCoin.Amount.ToDec().Quo(lData.conversionFactor.ToDec()).Mul(lData.depositPrice)
+		totalBorrowableUSDAmount = totalBorrowableUSDAmount.Add(usdValue.Mul(lData.ltv))
+	}
+	totalBorrowedUSDAmount := sdk.ZeroDec()
+	for _, coin := range borrow.Amount {
+		lData := liqMap[coin.Denom]
+		usdValue := coin.Amount.ToDec().Quo(lData.conversionFactor.ToDec()).Mul(lData.borrowPrice)
+		totalBorrowedUSDAmount = totalBorrowedUSDAmount.Add(usdValue)
+	}
+
+	allowedUSDAmount := totalBorrowableUSDAmount.Mul(sdk.OneDec().Sub(safetyMargin))
+	return allowedUSDAmount.Sub(totalBorrowedUSDAmount), liqMap, nil
+}
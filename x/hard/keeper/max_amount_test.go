@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestMaxWithdrawAndBorrow() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	authGS := app.NewAuthGenState([]sdk.AccAddress{borrower}, []sdk.Coins{coins})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{
+		types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+	}), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	_, err := suite.keeper.GetMaxWithdraw(suite.ctx, borrower, "ukava", sdk.ZeroDec())
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+	maxBorrow, err := suite.keeper.GetMaxBorrow(suite.ctx, borrower, "usdx", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.ZeroInt()), maxBorrow)
+	_, err = suite.keeper.GetMaxBorrow(suite.ctx, borrower, "bnb", sdk.ZeroDec())
+	suite.Require().True(errors.Is(err, types.ErrMarketNotFound))
+
+	// $20 of kava can be borrowed against up to $16, leaving $8 after the usdx borrow
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	maxWithdraw, err := suite.keeper.GetMaxWithdraw(suite.ctx, borrower, "ukava", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), maxWithdraw)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF)))))
+
+	testCases := []struct {
+		name             string
+		safetyMargin     sdk.Dec
+		expectedWithdraw sdk.Coin
+		expectedBorrow   sdk.Coin
+		expectedErr      error
+	}{
+		{"no safety margin", sdk.ZeroDec(), sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF)), nil},
+		{"safety margin", sdk.MustNewDecFromStr("0.25"), sdk.NewCoin("ukava", sdk.NewInt(3333333)), sdk.NewCoin("usdx", sdk.NewInt(4*USDX_CF)), nil},
+		{"safety margin uses all headroom", sdk.MustNewDecFromStr("0.6"), sdk.NewCoin("ukava", sdk.ZeroInt()), sdk.NewCoin("usdx", sdk.ZeroInt()), nil},
+		{"negative safety margin", sdk.MustNewDecFromStr("-0.1"), sdk.Coin{}, sdk.Coin{}, types.ErrInvalidSafetyMargin},
+		{"safety margin of one", sdk.OneDec(), sdk.Coin{}, sdk.Coin{}, types.ErrInvalidSafetyMargin},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			maxWithdraw, err := suite.keeper.GetMaxWithdraw(suite.ctx, borrower, "ukava", tc.safetyMargin)
+			if tc.expectedErr != nil {
+				suite.Require().True(errors.Is(err, tc.expectedErr))
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedWithdraw, maxWithdraw)
+			}
+			maxBorrow, err := suite.keeper.GetMaxBorrow(suite.ctx, borrower, "usdx", tc.safetyMargin)
+			if tc.expectedErr != nil {
+				suite.Require().True(errors.Is(err, tc.expectedErr))
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedBorrow, maxBorrow)
+			}
+		})
+	}
+
+	// the maximum amounts are accepted, anything more is rejected
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err = suite.keeper.Borrow(cacheCtx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF+1))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientLoanToValue))
+	suite.Require().NoError(suite.keeper.Borrow(cacheCtx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF)))))
+
+	cacheCtx, _ = suite.ctx.CacheContext()
+	err = suite.keeper.Withdraw(cacheCtx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF+1))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientLoanToValue))
+	suite.Require().NoError(suite.keeper.Withdraw(cacheCtx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)))))
+
+	// interest accrued since the borrow was last synced is included
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(48 * time.Hour))
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+	maxBorrow, err = suite.keeper.GetMaxBorrow(suite.ctx, borrower, "usdx", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().True(maxBorrow.Amount.LT(sdk.NewInt(8 * USDX_CF)))
+
+	// a ramping global borrow limit caps the maximum borrow at the limit in effect at the block time
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MoneyMarkets[0].BorrowLimit.HasMaxLimit = true
+	params.MoneyMarkets[0].BorrowLimit.MaximumLimit = sdk.NewDec(20 * USDX_CF)
+	params.MoneyMarkets[0].BorrowLimit.InitialLimit = sdk.NewDec(8 * USDX_CF)
+	params.MoneyMarkets[0].BorrowLimit.RampStartTime = suite.ctx.BlockTime()
+	params.MoneyMarkets[0].BorrowLimit.RampDuration = 12 * time.Hour
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.ApplyInterestRateUpdates(suite.ctx)
+	maxBorrow, err = suite.keeper.GetMaxBorrow(suite.ctx, borrower, "usdx", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().True(maxBorrow.IsZero())
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1))))
+	suite.Require().True(errors.Is(err, types.ErrGreaterThanAssetBorrowLimit))
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	maxBorrow, err = suite.keeper.GetMaxBorrow(suite.ctx, borrower, "usdx", sdk.ZeroDec())
+	suite.Require().NoError(err)
+	borrowedCoins, _ := suite.keeper.GetBorrowedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewCoin("usdx", sdk.NewInt(9*USDX_CF).Sub(borrowedCoins.AmountOf("usdx"))), maxBorrow)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hardmath "github.com/kava-labs/kava/x/hard/math"
+)
+
+// UpdateMarketMetrics reports the total supplied, total borrowed, and utilization of each money market
+func (k Keeper) UpdateMarketMetrics(ctx sdk.Context) {
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	suppliedCoins, _ := k.GetSuppliedCoins(ctx)
+	borrowedCoins, _ := k.GetBorrowedCoins(ctx)
+	reserves, _ := k.GetTotalReserves(ctx)
+	cash := k.GetCash(ctx)
+
+	for _, mm := range k.GetParams(ctx).MoneyMarkets {
+		borrowed := borrowedCoins.AmountOf(mm.Denom)
+		utilization := hardmath.CalculateUtilizationRatio(cash.AmountOf(mm.Denom).ToDec(), borrowed.ToDec(), reserves.AmountOf(mm.Denom).ToDec())
+
+		k.metrics.TotalSupplied.With("denom", mm.Denom).Set(intToFloat64(suppliedCoins.AmountOf(mm.Denom)))
+		k.metrics.TotalBorrowed.With("denom", mm.Denom).Set(intToFloat64(borrowed))
+		k.metrics.Utilization.With("denom", mm.Denom).Set(decToFloat64(utilization))
+	}
+}
+
+// recordLiquidation counts a liquidated position
+func (k Keeper) recordLiquidation(ctx sdk.Context) {
+	if ctx.IsCheckTx() {
+		return
+	}
+	k.metrics.Liquidations.Add(1)
+}
+
+func intToFloat64(i sdk.Int) float64 {
+	f, _ := new(big.Float).SetInt(i.BigInt()).Float64()
+	return f
+}
+
+func decToFloat64(d sdk.Dec) float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
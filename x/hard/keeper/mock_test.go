@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// mockPricefeedKeeper returns fixed prices for markets, without running the pricefeed module
+type mockPricefeedKeeper struct {
+	prices map[string]sdk.Dec
+}
+
+var _ types.PricefeedKeeper = mockPricefeedKeeper{}
+
+func (pk mockPricefeedKeeper) GetCurrentPrice(_ sdk.Context, marketID string) (pftypes.CurrentPrice, error) {
+	price, found := pk.prices[marketID]
+	if !found {
+		return pftypes.CurrentPrice{}, errors.New("no price")
+	}
+	return pftypes.NewCurrentPrice(marketID, price), nil
+}
+
+func (pk mockPricefeedKeeper) GetMarket(_ sdk.Context, marketID string) (pftypes.Market, bool) {
+	_, found := pk.prices[marketID]
+	return pftypes.Market{MarketID: marketID, Active: found}, found
+}
+
+// newMockKeeper returns a hard keeper backed by an in-memory store, using the given pricefeed keeper and no other
+// module keepers. It is intended for unit tests that only use the parts of the keeper covered by the mocks.
+func newMockKeeper(t *testing.T, pfk types.PricefeedKeeper) (keeper.Keeper, sdk.Context) {
+	cdc := app.MakeCodec()
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	tStoreKey := sdk.NewTransientStoreKey(types.TStoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tStoreKey, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey)
+	k := keeper.NewKeeper(cdc, storeKey, tStoreKey, paramsKeeper.Subspace(types.DefaultParamspace), nil, nil, nil, pfk, nil, nil, nil)
+	return k, ctx
+}
+
+func TestLiquidationKeeper_CalculateLtv(t *testing.T) {
+	pfk := mockPricefeedKeeper{prices: map[string]sdk.Dec{
+		"bnb:usd":     sdk.MustNewDecFromStr("10.00"),
+		"bnb:usd:30":  sdk.MustNewDecFromStr("8.00"),
+		"usdx:usd":    sdk.OneDec(),
+		"usdx:usd:30": sdk.OneDec(),
+	}}
+	k, ctx := newMockKeeper(t, pfk)
+	var lk keeper.LiquidationKeeper = k
+
+	irm := types.NewInterestRateModel(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	bnb := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "bnb:usd",
+		sdk.NewInt(100000000), irm, sdk.ZeroDec(), sdk.ZeroDec())
+	bnb.TwapMarketID = "bnb:usd:30"
+	usdx := types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.8")), "usdx:usd",
+		sdk.NewInt(1000000), irm, sdk.ZeroDec(), sdk.ZeroDec())
+	k.SetMoneyMarket(ctx, "bnb", bnb)
+	k.SetMoneyMarket(ctx, "usdx", usdx)
+
+	addr := sdk.AccAddress("test")
+	deposit := types.NewDeposit(addr, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10*100000000))), types.SupplyInterestFactors{})
+	borrow := types.NewBorrow(addr, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(40*1000000))), types.BorrowInterestFactors{})
+
+	// deposits are valued at the spot price
+	ltv, err := lk.CalculateLtv(ctx, deposit, borrow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), ltv)
+
+	// a conservative price source values deposits at the lower twap price
+	bnb.PriceSource = types.PriceSourceConservative
+	k.SetMoneyMarket(ctx, "bnb", bnb)
+	ltv, err = lk.CalculateLtv(ctx, deposit, borrow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), ltv)
+
+	valid, err := lk.IsWithinValidLtvRange(ctx, deposit, borrow)
+	require.NoError(t, err)
+	require.True(t, valid)
+
+	// $40 of usdx borrowed against $64 borrowable from $80 of bnb
+	healthFactor, err := lk.CalculateHealthFactor(ctx, deposit, borrow)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.625"), healthFactor)
+}
@@ -28,3 +28,14 @@ func (k Keeper) GetMoneyMarketParam(ctx sdk.Context, denom string) (types.MoneyM
 	}
 	return types.MoneyMarket{}, false
 }
+
+// GetSwapLiquidation returns the swap liquidation param for a specific denom
+func (k Keeper) GetSwapLiquidation(ctx sdk.Context, denom string) (types.SwapLiquidation, bool) {
+	params := k.GetParams(ctx)
+	for _, sl := range params.SwapLiquidations {
+		if sl.Denom == denom {
+			return sl, true
+		}
+	}
+	return types.SwapLiquidation{}, false
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetDeposit returns a deposit from the store for a particular depositor address, deposit denom
+func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	bz := store.Get(depositor.Bytes())
+	if bz == nil {
+		return types.Deposit{}, false
+	}
+	var deposit types.Deposit
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit, true
+}
+
+// SetDeposit sets the input deposit in the store, prefixed by the deposit type, deposit denom, and depositor address, in that order.
+// The depositor's vesting deposit is limited to the deposited coins.
+func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(deposit)
+	store.Set(deposit.Depositor.Bytes(), bz)
+	k.capVestingDeposit(ctx, deposit.Depositor, deposit.Amount)
+}
+
+// DeleteDeposit deletes a deposit and the depositor's vesting deposit from the store
+func (k Keeper) DeleteDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	store.Delete(deposit.Depositor.Bytes())
+	k.SetVestingDeposit(ctx, deposit.Depositor, sdk.Coins{})
+}
+
+// IterateDeposits iterates over all deposit objects in the store and performs a callback function
+func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetDepositsByUser gets all deposits for an individual user
+func (k Keeper) GetDepositsByUser(ctx sdk.Context, user sdk.AccAddress) []types.Deposit {
+	var deposits []types.Deposit
+	deposit, found := k.GetDeposit(ctx, user)
+	if found {
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// GetBorrow returns a Borrow from the store for a particular borrower address and borrow denom
+func (k Keeper) GetBorrow(ctx sdk.Context, borrower sdk.AccAddress) (types.Borrow, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+	bz := store.Get(borrower)
+	if bz == nil {
+		return types.Borrow{}, false
+	}
+	var borrow types.Borrow
+	k.cdc.MustUnmarshalBinaryBare(bz, &borrow)
+	return borrow, true
+}
+
+// SetBorrow sets the input borrow in the store, prefixed by the borrower address and borrow denom
+func (k Keeper) SetBorrow(ctx sdk.Context, borrow types.Borrow) {
+	existingBorrow, _ := k.GetBorrow(ctx, borrow.Borrower)
+	k.updateBorrowsByDenomIndex(ctx, borrow.Borrower, existingBorrow.Amount, borrow.Amount)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(borrow)
+	store.Set(borrow.Borrower, bz)
+}
+
+// DeleteBorrow deletes a borrow from the store
+func (k Keeper) DeleteBorrow(ctx sdk.Context, borrow types.Borrow) {
+	existingBorrow, found := k.GetBorrow(ctx, borrow.Borrower)
+	if found {
+		k.updateBorrowsByDenomIndex(ctx, borrow.Borrower, existingBorrow.Amount, sdk.NewCoins())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+	store.Delete(borrow.Borrower)
+}
+
+// IterateBorrows iterates over all borrow objects in the store and performs a callback function
+func (k Keeper) IterateBorrows(ctx sdk.Context, cb func(borrow types.Borrow) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var borrow types.Borrow
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &borrow)
+		if cb(borrow) {
+			break
+		}
+	}
+}
+
+// SetBorrowedCoins sets the total amount of coins currently borrowed in the store
+func (k Keeper) SetBorrowedCoins(ctx sdk.Context, borrowedCoins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowedCoinsPrefix)
+	if borrowedCoins.Empty() {
+		store.Set([]byte{}, []byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(borrowedCoins)
+		store.Set([]byte{}, bz)
+	}
+}
+
+// GetBorrowedCoins returns an sdk.Coins object from the store representing all currently borrowed coins
+func (k Keeper) GetBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BorrowedCoinsPrefix)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var borrowedCoins sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &borrowedCoins)
+	return borrowedCoins, true
+}
+
+// SetSuppliedCoins sets the total amount of coins currently supplied in the store
+func (k Keeper) SetSuppliedCoins(ctx sdk.Context, suppliedCoins sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
+	if suppliedCoins.Empty() {
+		store.Set([]byte{}, []byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(suppliedCoins)
+		store.Set([]byte{}, bz)
+	}
+}
+
+// GetSuppliedCoins returns an sdk.Coins object from the store representing all currently supplied coins
+func (k Keeper) GetSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SuppliedCoinsPrefix)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var suppliedCoins sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &suppliedCoins)
+	return suppliedCoins, true
+}
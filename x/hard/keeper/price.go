@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetDepositPrice returns the price used to value deposits of a money market, as set by its price source
+func (k Keeper) GetDepositPrice(ctx sdk.Context, mm types.MoneyMarket) (sdk.Dec, error) {
+	return k.getMoneyMarketPrice(ctx, mm, false)
+}
+
+// GetBorrowPrice returns the price used to value borrows of a money market, as set by its price source
+func (k Keeper) GetBorrowPrice(ctx sdk.Context, mm types.MoneyMarket) (sdk.Dec, error) {
+	return k.getMoneyMarketPrice(ctx, mm, true)
+}
+
+// getMoneyMarketPrice returns the price of a money market. When the price source is conservative, borrows are valued
+// at the higher and deposits at the lower of the spot and TWAP prices, so a short-lived price spike in either direction
+// cannot be used to borrow more than the position supports.
+func (k Keeper) getMoneyMarketPrice(ctx sdk.Context, mm types.MoneyMarket, borrow bool) (sdk.Dec, error) {
+	switch mm.PriceSource {
+	case types.PriceSourceTwap:
+		return k.getPrice(ctx, mm.TwapMarketID)
+	case types.PriceSourceConservative:
+		spotPrice, err := k.getPrice(ctx, mm.SpotMarketID)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		twapPrice, err := k.getPrice(ctx, mm.TwapMarketID)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		if borrow {
+			return sdk.MaxDec(spotPrice, twapPrice), nil
+		}
+		return sdk.MinDec(spotPrice, twapPrice), nil
+	default:
+		return k.getPrice(ctx, mm.SpotMarketID)
+	}
+}
+
+// getPrice returns the current price of a pricefeed market. Markets that exist without a current price are reported
+// as stale, so clients can tell an unlisted market apart from one whose oracles have stopped posting.
+//
+// Pricefeed only updates current prices at the end of a block, so prices are cached in the transient store for the
+// rest of the block. This keeps begin blockers that value many positions from reading the same prices repeatedly.
+// Queries do not use the cache, since the transient store belongs to the block being executed.
+func (k Keeper) getPrice(ctx sdk.Context, marketID string) (sdk.Dec, error) {
+	if !k.readOnly {
+		if price, found := k.getCachedPrice(ctx, marketID); found {
+			return price, nil
+		}
+	}
+	priceInfo, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		if _, found := k.pricefeedKeeper.GetMarket(ctx, marketID); found {
+			return sdk.Dec{}, sdkerrors.Wrapf(types.ErrStalePrice, "no valid price for market %s", marketID)
+		}
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrPriceNotFound, "no price found for market %s", marketID)
+	}
+	if !k.readOnly {
+		k.setCachedPrice(ctx, marketID, priceInfo.Price)
+	}
+	return priceInfo.Price, nil
+}
+
+// getCachedPrice returns the price of a market cached earlier in the current block
+func (k Keeper) getCachedPrice(ctx sdk.Context, marketID string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.PriceCachePrefix)
+	bz := store.Get(types.PriceCacheKey(ctx.BlockHeight(), marketID))
+	if bz == nil {
+		return sdk.Dec{}, false
+	}
+	var price sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, true
+}
+
+// setCachedPrice caches the price of a market for the rest of the current block. Cached prices are discarded at the
+// end of the block.
+func (k Keeper) setCachedPrice(ctx sdk.Context, marketID string, price sdk.Dec) {
+	store := prefix.NewStore(ctx.TransientStore(k.tkey), types.PriceCachePrefix)
+	store.Set(types.PriceCacheKey(ctx.BlockHeight(), marketID), k.cdc.MustMarshalBinaryBare(price))
+}
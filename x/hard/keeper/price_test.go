@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func (suite *KeeperTestSuite) TestGetMoneyMarketPrices() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	pricefeedKeeper := suite.app.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(suite.ctx, pricefeedtypes.NewParams(pricefeedtypes.Markets{
+		pricefeedtypes.NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{oracle}, true),
+		pricefeedtypes.NewMarket("bnb:usd:30", "bnb", "usd", []sdk.AccAddress{oracle}, true),
+	}))
+	for marketID, price := range map[string]string{"bnb:usd": "12.00", "bnb:usd:30": "10.00"} {
+		_, err := pricefeedKeeper.SetPrice(suite.ctx, oracle, marketID, sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, marketID))
+	}
+
+	newMoneyMarket := func(source types.PriceSource, twapMarketID string) types.MoneyMarket {
+		mm := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd",
+			sdk.NewInt(100000000), types.NewInterestRateModel(sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()),
+			sdk.ZeroDec(), sdk.ZeroDec())
+		mm.PriceSource = source
+		mm.TwapMarketID = twapMarketID
+		return mm
+	}
+	testCases := []struct {
+		name                 string
+		moneyMarket          types.MoneyMarket
+		expectedDepositPrice sdk.Dec
+		expectedBorrowPrice  sdk.Dec
+		expectPass           bool
+	}{
+		{"spot", newMoneyMarket(types.PriceSourceSpot, ""), sdk.MustNewDecFromStr("12.00"), sdk.MustNewDecFromStr("12.00"), true},
+		{"empty source uses spot", newMoneyMarket("", ""), sdk.MustNewDecFromStr("12.00"), sdk.MustNewDecFromStr("12.00"), true},
+		{"twap", newMoneyMarket(types.PriceSourceTwap, "bnb:usd:30"), sdk.MustNewDecFromStr("10.00"), sdk.MustNewDecFromStr("10.00"), true},
+		{"conservative", newMoneyMarket(types.PriceSourceConservative, "bnb:usd:30"), sdk.MustNewDecFromStr("10.00"), sdk.MustNewDecFromStr("12.00"), true},
+		{"missing twap price", newMoneyMarket(types.PriceSourceConservative, "bnb:usd:60"), sdk.Dec{}, sdk.Dec{}, false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			depositPrice, err := suite.keeper.GetDepositPrice(suite.ctx, tc.moneyMarket)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), types.ErrPriceNotFound.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedDepositPrice, depositPrice)
+
+			borrowPrice, err := suite.keeper.GetBorrowPrice(suite.ctx, tc.moneyMarket)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedBorrowPrice, borrowPrice)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPriceCache() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	oracle := addrs[0]
+	pricefeedKeeper := suite.app.GetPriceFeedKeeper()
+	pricefeedKeeper.SetParams(suite.ctx, pricefeedtypes.NewParams(pricefeedtypes.Markets{
+		pricefeedtypes.NewMarket("bnb:usd", "bnb", "usd", []sdk.AccAddress{oracle}, true),
+	}))
+	setPrice := func(price string) {
+		_, err := pricefeedKeeper.SetPrice(suite.ctx, oracle, "bnb:usd", sdk.MustNewDecFromStr(price), suite.ctx.BlockTime().Add(time.Hour))
+		suite.Require().NoError(err)
+		suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(suite.ctx, "bnb:usd"))
+	}
+	mm := types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5")), "bnb:usd",
+		sdk.NewInt(100000000), types.NewInterestRateModel(sdk.ZeroDec(), sdk.ZeroDec(), sdk.OneDec(), sdk.ZeroDec()),
+		sdk.ZeroDec(), sdk.ZeroDec())
+
+	// a market without a price is not cached
+	_, err := suite.keeper.GetDepositPrice(suite.ctx, mm)
+	suite.Require().Error(err)
+	setPrice("12.00")
+	price, err := suite.keeper.GetDepositPrice(suite.ctx, mm)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("12.00"), price)
+
+	// the price is cached for the rest of the block
+	setPrice("10.00")
+	price, err = suite.keeper.GetDepositPrice(suite.ctx, mm)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("12.00"), price)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 1)
+	price, err = suite.keeper.GetDepositPrice(suite.ctx, mm)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("10.00"), price)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// SeedProtocolLiquidity supplies coins from the community pool to their money markets as a deposit owned by the
+// protocol. The deposit earns supply interest like any other, but incentive hooks are not called for it, so it
+// earns no rewards.
+func (k Keeper) SeedProtocolLiquidity(ctx sdk.Context, amount sdk.Coins) error {
+	depositor := types.ProtocolLiquidityAddress
+
+	// Set any new denoms' global supply index to 1.0
+	for _, coin := range amount {
+		if _, found := k.GetSupplyInterestFactor(ctx, coin.Denom); !found {
+			if _, found := k.GetMoneyMarket(ctx, coin.Denom); found {
+				k.SetSupplyInterestFactor(ctx, coin.Denom, sdk.OneDec())
+			}
+		}
+	}
+	if err := k.SyncMoneyMarketInterest(ctx, amount); err != nil {
+		return err
+	}
+	k.SyncSupplyInterest(ctx, depositor)
+
+	if err := k.ValidateDeposit(ctx, amount); err != nil {
+		return err
+	}
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	if err := k.distrKeeper.DistributeFromFeePool(ctx, amount, moduleAddr); err != nil {
+		return err
+	}
+
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		deposit = types.NewDeposit(depositor, sdk.NewCoins(), types.SupplyInterestFactors{})
+	}
+	for _, coin := range amount {
+		if factor, found := k.GetSupplyInterestFactor(ctx, coin.Denom); found {
+			deposit.Index = deposit.Index.SetInterestFactor(coin.Denom, factor)
+		}
+	}
+	deposit.Amount = deposit.Amount.Add(amount...)
+	k.SetDeposit(ctx, deposit)
+	k.IncrementSuppliedCoins(ctx, amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardProtocolLiquiditySeed,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	k.recordDepositStats(ctx, amount)
+	k.Logger(ctx).Info("seeded protocol liquidity", "amount", amount.String())
+	return nil
+}
+
+// WithdrawProtocolLiquidity returns coins from the protocol owned deposit to the community pool, without charging a
+// withdraw fee. It fails if the money markets do not hold enough unborrowed coins.
+func (k Keeper) WithdrawProtocolLiquidity(ctx sdk.Context, amount sdk.Coins) error {
+	depositor := types.ProtocolLiquidityAddress
+	if _, found := k.GetDeposit(ctx, depositor); !found {
+		return sdkerrors.Wrap(types.ErrDepositNotFound, "no protocol liquidity deposit found")
+	}
+	if err := k.SyncMoneyMarketInterest(ctx, amount); err != nil {
+		return err
+	}
+	k.SyncSupplyInterest(ctx, depositor)
+	deposit, _ := k.GetDeposit(ctx, depositor)
+	if !amount.IsAllLTE(deposit.Amount) {
+		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "%s exceeds protocol liquidity %s", amount, deposit.Amount)
+	}
+
+	if err := k.recallStrategyAllocations(ctx, amount); err != nil {
+		return err
+	}
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	if err := k.distrKeeper.FundCommunityPool(ctx, amount, moduleAddr); err != nil {
+		return err
+	}
+
+	deposit.Amount = deposit.Amount.Sub(amount)
+	for _, coin := range amount {
+		if deposit.Amount.AmountOf(coin.Denom).IsZero() {
+			depositIndex, removed := deposit.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			deposit.Index = depositIndex
+		}
+	}
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	if err := k.DecrementSuppliedCoins(ctx, amount); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardProtocolLiquidityWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+	k.recordWithdrawalStats(ctx, amount)
+	k.Logger(ctx).Info("withdrew protocol liquidity", "amount", amount.String())
+	return nil
+}
+
+// withdrawAllProtocolLiquidity returns the protocol owned deposit of a denom, including its interest, to the
+// community pool
+func (k Keeper) withdrawAllProtocolLiquidity(ctx sdk.Context, denom string) error {
+	deposit, found := k.GetDeposit(ctx, types.ProtocolLiquidityAddress)
+	if !found {
+		return sdkerrors.Wrap(types.ErrDepositNotFound, "no protocol liquidity deposit found")
+	}
+	if err := k.SyncMoneyMarketInterest(ctx, deposit.Amount); err != nil {
+		return err
+	}
+	k.SyncSupplyInterest(ctx, types.ProtocolLiquidityAddress)
+	deposit, _ = k.GetDeposit(ctx, types.ProtocolLiquidityAddress)
+	return k.WithdrawProtocolLiquidity(ctx, sdk.NewCoins(sdk.NewCoin(denom, deposit.Amount.AmountOf(denom))))
+}
+
+// GetProtocolLiquidity returns the protocol owned deposit, including supply interest that has not been synced
+func (k Keeper) GetProtocolLiquidity(ctx sdk.Context) sdk.Coins {
+	deposit, found := k.GetSyncedDeposit(ctx, types.ProtocolLiquidityAddress)
+	if !found {
+		return sdk.NewCoins()
+	}
+	return deposit.Amount
+}
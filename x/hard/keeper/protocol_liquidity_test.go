@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestProtocolLiquidity() {
+	funder := sdk.AccAddress(crypto.AddressHash([]byte("funder")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	authGS := app.NewAuthGenState([]sdk.AccAddress{funder, borrower}, []sdk.Coins{
+		sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000*KAVA_CF))),
+		sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))),
+	})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{
+		types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+	}), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	distrKeeper := tApp.GetDistrKeeper()
+	suite.Require().NoError(distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(500*KAVA_CF))), funder))
+	communityPool := func() sdk.Int {
+		return distrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf("ukava").TruncateInt()
+	}
+	startingPool := communityPool()
+
+	testCases := []struct {
+		name        string
+		amount      sdk.Coins
+		expectedErr error
+	}{
+		{"unknown money market", sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(KAVA_CF))), types.ErrInvalidDepositDenom},
+		{"exceeds community pool", sdk.NewCoins(sdk.NewCoin("ukava", startingPool.AddRaw(1))), nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := suite.ctx.CacheContext()
+			err := suite.keeper.SeedProtocolLiquidity(cacheCtx, tc.amount)
+			suite.Require().Error(err)
+			if tc.expectedErr != nil {
+				suite.Require().True(errors.Is(err, tc.expectedErr))
+			}
+		})
+	}
+
+	err := suite.keeper.WithdrawProtocolLiquidity(suite.ctx, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+
+	// seeding moves coins from the community pool into a deposit owned by the protocol
+	seed := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	suite.Require().NoError(suite.keeper.SeedProtocolLiquidity(suite.ctx, seed))
+	suite.Require().Equal(startingPool.Sub(sdk.NewInt(100*KAVA_CF)), communityPool())
+	suite.Require().Equal(seed, suite.keeper.GetProtocolLiquidity(suite.ctx))
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(seed, supplied)
+
+	// the seeded liquidity can be borrowed and earns supply interest
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(500*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90*KAVA_CF)))))
+	suite.ctx = suite.ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(30 * 24 * time.Hour))
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	liquidity := suite.keeper.GetProtocolLiquidity(suite.ctx)
+	suite.Require().True(liquidity.AmountOf("ukava").GT(sdk.NewInt(100 * KAVA_CF)))
+
+	// withdrawals cannot exceed the deposit or the coins that are not borrowed
+	err = suite.keeper.WithdrawProtocolLiquidity(suite.ctx, liquidity.Add(sdk.NewCoin("ukava", sdk.OneInt())))
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+	err = suite.keeper.WithdrawProtocolLiquidity(suite.ctx, liquidity)
+	suite.Require().Error(err)
+
+	// withdrawing returns coins to the community pool
+	withdrawal := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)))
+	poolBefore := communityPool()
+	suite.Require().NoError(suite.keeper.WithdrawProtocolLiquidity(suite.ctx, withdrawal))
+	suite.Require().Equal(poolBefore.Add(sdk.NewInt(5*KAVA_CF)), communityPool())
+	suite.Require().Equal(liquidity.Sub(withdrawal), suite.keeper.GetProtocolLiquidity(suite.ctx))
+}
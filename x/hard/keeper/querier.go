@@ -9,12 +9,16 @@ import (
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	hardmath "github.com/kava-labs/kava/x/hard/math"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
-// NewQuerier is the module level router for state queries
+// NewQuerier is the module level router for state queries. Every query is served from a read only context with
+// interest accrued up to the block time, so queries return up to date balances and never modify state.
 func NewQuerier(k Keeper) sdk.Querier {
+	k = k.readOnlyKeeper()
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		ctx = k.ReadOnlyContext(ctx)
 		switch path[0] {
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, k)
@@ -30,6 +34,30 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetTotalBorrowed(ctx, req, k)
 		case types.QueryGetInterestRate:
 			return queryGetInterestRate(ctx, req, k)
+		case types.QueryGetAccrualTimes:
+			return queryGetAccrualTimes(ctx, req, k)
+		case types.QueryGetReferralVolumes:
+			return queryGetReferralVolumes(ctx, req, k)
+		case types.QueryGetInterestAudits:
+			return queryGetInterestAudits(ctx, req, k)
+		case types.QueryGetWindDowns:
+			return queryGetWindDowns(ctx, req, k)
+		case types.QueryGetMaxWithdraw:
+			return queryGetMaxWithdraw(ctx, req, k)
+		case types.QueryGetMaxBorrow:
+			return queryGetMaxBorrow(ctx, req, k)
+		case types.QueryGetSimulation:
+			return queryGetSimulation(ctx, req, k)
+		case types.QueryGetStopLosses:
+			return queryGetStopLosses(ctx, req, k)
+		case types.QueryGetInterestRateCurve:
+			return queryGetInterestRateCurve(ctx, req, k)
+		case types.QueryGetBorrowHistory:
+			return queryGetBorrowHistory(ctx, req, k)
+		case types.QueryGetHealthFactor:
+			return queryGetHealthFactor(ctx, req, k)
+		case types.QueryGetProtocolLiquidity:
+			return queryGetProtocolLiquidity(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -100,10 +128,8 @@ func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 			deposits = append(deposits, deposit)
 		}
 	case denom:
-		k.IterateDeposits(ctx, func(deposit types.Deposit) (stop bool) {
-			if deposit.Amount.AmountOf(params.Denom).IsPositive() {
-				deposits = append(deposits, deposit)
-			}
+		k.IterateDepositsByDenom(ctx, params.Denom, func(deposit types.Deposit) (stop bool) {
+			deposits = append(deposits, deposit)
 			return false
 		})
 	default:
@@ -172,10 +198,8 @@ func queryGetBorrows(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 			borrows = append(borrows, borrow)
 		}
 	case denom:
-		k.IterateBorrows(ctx, func(borrow types.Borrow) (stop bool) {
-			if borrow.Amount.AmountOf(params.Denom).IsPositive() {
-				borrows = append(borrows, borrow)
-			}
+		k.IterateBorrowsByDenom(ctx, params.Denom, func(borrow types.Borrow) (stop bool) {
+			borrows = append(borrows, borrow)
 			return false
 		})
 	default:
@@ -289,7 +313,7 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]b
 	// Calculate the borrow and supply APY interest rates for each money market
 	for _, moneyMarket := range moneyMarkets {
 		denom := moneyMarket.Denom
-		cash := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(denom)
+		cash := k.GetCash(ctx).AmountOf(denom)
 
 		borrowed := sdk.NewCoin(denom, sdk.ZeroInt())
 		borrowedCoins, foundBorrowedCoins := k.GetBorrowedCoins(ctx)
@@ -303,12 +327,13 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]b
 		}
 
 		// CalculateBorrowRate calculates the current interest rate based on utilization (the fraction of supply that has been borrowed)
-		borrowAPY, err := CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		borrowAPY, err := hardmath.CalculateBorrowRate(moneyMarket.InterestRateModel, sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		if err != nil {
 			return nil, err
 		}
+		borrowAPY = moneyMarket.BoundBorrowRate(borrowAPY)
 
-		utilRatio := CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
+		utilRatio := hardmath.CalculateUtilizationRatio(sdk.NewDecFromInt(cash), sdk.NewDecFromInt(borrowed.Amount), sdk.NewDecFromInt(reserves.AmountOf(denom)))
 		fullSupplyAPY := borrowAPY.Mul(utilRatio)
 		realSupplyAPY := fullSupplyAPY.Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
 
@@ -328,3 +353,331 @@ func queryGetInterestRate(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]b
 
 	return bz, nil
 }
+
+func queryGetInterestRateCurve(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryInterestRateCurveParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	points := params.Points
+	if points == 0 {
+		points = types.DefaultInterestRateCurvePoints
+	}
+	if points < 2 || points > types.MaxInterestRateCurvePoints {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCurvePoints, "%d is not between 2 and %d", points, types.MaxInterestRateCurvePoints)
+	}
+
+	moneyMarket, found := k.GetMoneyMarket(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrMoneyMarketNotFound
+	}
+
+	// Sample the rates the money market would pay at evenly spaced utilization ratios, including 0 and 1
+	curve := types.InterestRateCurve{Denom: moneyMarket.Denom}
+	for i := 0; i < points; i++ {
+		utilRatio := sdk.NewDec(int64(i)).QuoInt64(int64(points - 1))
+		borrowAPY := moneyMarket.BoundBorrowRate(hardmath.CalculateBorrowRateAtUtilization(moneyMarket.InterestRateModel, utilRatio))
+		supplyAPY := borrowAPY.Mul(utilRatio).Mul(sdk.OneDec().Sub(moneyMarket.ReserveFactor))
+		curve.Points = append(curve.Points, types.NewInterestRateCurvePoint(utilRatio, borrowAPY, supplyAPY))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, curve)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetAccrualTimes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAccrualTimesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var moneyMarkets types.MoneyMarkets
+	if len(params.Denom) > 0 {
+		moneyMarket, found := k.GetMoneyMarket(ctx, params.Denom)
+		if !found {
+			return nil, types.ErrMoneyMarketNotFound
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	} else {
+		moneyMarkets = k.GetAllMoneyMarkets(ctx)
+	}
+
+	accrualTimes := types.GenesisAccumulationTimes{}
+	for _, moneyMarket := range moneyMarkets {
+		previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, moneyMarket.Denom)
+		if !found {
+			continue
+		}
+		supplyFactor, found := k.GetSupplyInterestFactor(ctx, moneyMarket.Denom)
+		if !found {
+			supplyFactor = sdk.OneDec()
+		}
+		borrowFactor, found := k.GetBorrowInterestFactor(ctx, moneyMarket.Denom)
+		if !found {
+			borrowFactor = sdk.OneDec()
+		}
+		accrualTimes = append(accrualTimes, types.NewGenesisAccumulationTime(moneyMarket.Denom, previousAccrualTime, supplyFactor, borrowFactor))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, accrualTimes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetReferralVolumes(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryReferralVolumesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	volumes := types.ReferralVolumes{}
+	if len(params.Referrer) > 0 {
+		volume, found := k.GetReferralVolume(ctx, params.Referrer)
+		if found {
+			volumes = append(volumes, types.NewReferralVolume(params.Referrer, volume))
+		}
+	} else {
+		volumes = k.GetAllReferralVolumes(ctx)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, volumes)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetStopLosses(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryStopLossesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	stopLosses := types.StopLosses{}
+	if len(params.Borrower) > 0 {
+		stopLoss, found := k.GetStopLoss(ctx, params.Borrower)
+		if found {
+			stopLosses = append(stopLosses, stopLoss)
+		}
+	} else {
+		stopLosses = k.GetAllStopLosses(ctx)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, stopLosses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetBorrowHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBorrowHistoryParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	// Entries are returned newest first
+	entries := types.BorrowHistoryEntries{}
+	history, found := k.GetBorrowHistory(ctx, params.Owner)
+	if found {
+		for i := len(history.Entries) - 1; i >= 0; i-- {
+			entries = append(entries, history.Entries[i])
+		}
+	}
+
+	start, end := client.Paginate(len(entries), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		entries = types.BorrowHistoryEntries{}
+	} else {
+		entries = entries[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, entries)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetHealthFactor(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryHealthFactorParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	health, err := k.GetPositionHealth(ctx, params.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, health)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetInterestAudits(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryInterestAuditsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var moneyMarkets types.MoneyMarkets
+	if len(params.Denom) > 0 {
+		moneyMarket, found := k.GetMoneyMarket(ctx, params.Denom)
+		if !found {
+			return nil, types.ErrMoneyMarketNotFound
+		}
+		moneyMarkets = append(moneyMarkets, moneyMarket)
+	} else {
+		moneyMarkets = k.GetAllMoneyMarkets(ctx)
+	}
+
+	// markets that have not accrued interest are reported with zero totals
+	audits := types.InterestAudits{}
+	for _, moneyMarket := range moneyMarkets {
+		audit, _ := k.GetInterestAudit(ctx, moneyMarket.Denom)
+		audits = append(audits, audit)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, audits)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetWindDowns(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryWindDownsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var windDowns types.MoneyMarketWindDowns
+	if len(params.Denom) > 0 {
+		windDown, found := k.GetMoneyMarketWindDown(ctx, params.Denom)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "no deprecated money market for %s", params.Denom)
+		}
+		windDowns = append(windDowns, windDown)
+	} else {
+		windDowns = k.GetAllMoneyMarketWindDowns(ctx)
+	}
+
+	progresses := types.WindDownProgresses{}
+	for _, windDown := range windDowns {
+		progresses = append(progresses, k.GetWindDownProgress(ctx, windDown))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, progresses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetMaxWithdraw(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryMaxAmountParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.SafetyMargin.IsNil() {
+		params.SafetyMargin = sdk.ZeroDec()
+	}
+
+	maxWithdraw, err := k.GetMaxWithdraw(ctx, params.Owner, params.Denom, params.SafetyMargin)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, maxWithdraw)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetMaxBorrow(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryMaxAmountParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.SafetyMargin.IsNil() {
+		params.SafetyMargin = sdk.ZeroDec()
+	}
+
+	maxBorrow, err := k.GetMaxBorrow(ctx, params.Owner, params.Denom, params.SafetyMargin)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, maxBorrow)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetSimulation(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySimulationParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if params.Sender.Empty() {
+		params.Sender = params.Owner
+	}
+
+	simulated, err := k.SimulatePositionChange(ctx, params.Action, params.Sender, params.Owner, params.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, simulated)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryGetProtocolLiquidity(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetProtocolLiquidity(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
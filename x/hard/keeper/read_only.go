@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReadOnlyContext returns a cached copy of the context with interest accrued on every money market up to the block
+// time. Changes made in it are never written, so it can be used to compute up to date positions and totals without
+// modifying state. Positions read with GetSyncedDeposit and GetSyncedBorrow in it include all interest owed.
+func (k Keeper) ReadOnlyContext(ctx sdk.Context) sdk.Context {
+	readCtx, _ := ctx.CacheContext()
+	readCtx = readCtx.WithEventManager(sdk.NewEventManager())
+	for _, mm := range k.GetAllMoneyMarkets(readCtx) {
+		if err := k.AccrueInterest(readCtx, mm.Denom); err != nil {
+			k.Logger(ctx).Error("failed to compute synced interest", "denom", mm.Denom, "err", err.Error())
+		}
+	}
+	return readCtx
+}
+
+// readOnlyKeeper returns a copy of the keeper for serving queries. It does not read or write the transient store,
+// which is shared with the block being executed.
+func (k Keeper) readOnlyKeeper() Keeper {
+	k.readOnly = true
+	return k
+}
+
+// GetSyncedSuppliedCoins returns the total supplied coins including supply interest that has not been accrued
+func (k Keeper) GetSyncedSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	return k.GetSuppliedCoins(k.ReadOnlyContext(ctx))
+}
+
+// GetSyncedBorrowedCoins returns the total borrowed coins including borrow interest that has not been accrued
+func (k Keeper) GetSyncedBorrowedCoins(ctx sdk.Context) (sdk.Coins, bool) {
+	return k.GetBorrowedCoins(k.ReadOnlyContext(ctx))
+}
+
+// GetSyncedTotalReserves returns the total reserves including reserves from interest that has not been accrued
+func (k Keeper) GetSyncedTotalReserves(ctx sdk.Context) (sdk.Coins, bool) {
+	return k.GetTotalReserves(k.ReadOnlyContext(ctx))
+}
+
+// GetSyncedBorrowInterestFactor returns the borrow interest factor of a denom including interest that has not been
+// accrued
+func (k Keeper) GetSyncedBorrowInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	return k.GetBorrowInterestFactor(k.ReadOnlyContext(ctx), denom)
+}
+
+// GetSyncedSupplyInterestFactor returns the supply interest factor of a denom including interest that has not been
+// accrued
+func (k Keeper) GetSyncedSupplyInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	return k.GetSupplyInterestFactor(k.ReadOnlyContext(ctx), denom)
+}
@@ -12,8 +12,13 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 	// Check borrow exists here to avoid duplicating store read in ValidateRepay
 	borrow, found := k.GetBorrow(ctx, owner)
 	if !found {
-		return types.ErrBorrowNotFound
+		return sdkerrors.Wrapf(types.ErrBorrowNotFound, "no borrow found for %s", owner)
 	}
+	// Accrue interest on the markets being modified
+	if err := k.SyncMoneyMarketInterest(ctx, coins); err != nil {
+		return err
+	}
+
 	// Call incentive hook
 	k.BeforeBorrowModified(ctx, borrow)
 
@@ -37,6 +42,8 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 		return err
 	}
 
+	k.recordBorrowHistory(ctx, owner, types.BorrowHistoryRepayment, payment)
+
 	// If any coin denoms have been completely repaid reset the denom's borrow index factor
 	for _, coin := range payment {
 		if coin.Amount.Equal(borrow.Amount.AmountOf(coin.Denom)) {
@@ -73,6 +80,7 @@ func (k Keeper) Repay(ctx sdk.Context, sender, owner sdk.AccAddress, coins sdk.C
 			sdk.NewAttribute(types.AttributeKeyRepayCoins, payment.String()),
 		),
 	)
+	k.recordRepayStats(ctx, payment)
 
 	return nil
 }
@@ -96,7 +104,7 @@ func (k Keeper) CalculatePaymentAmount(owed sdk.Coins, payment sdk.Coins) (sdk.C
 	repayment := sdk.Coins{}
 
 	if !payment.DenomsSubsetOf(owed) {
-		return repayment, types.ErrInvalidRepaymentDenom
+		return repayment, sdkerrors.Wrapf(types.ErrInvalidRepaymentDenom, "requested %s, borrowed %s", payment, owed)
 	}
 
 	for _, coin := range payment {
@@ -1,8 +1,7 @@
 package keeper_test
 
 import (
-	"strings"
-	"time"
+	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -10,9 +9,9 @@ import (
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/app/genesis"
 	"github.com/kava-labs/kava/x/hard"
 	"github.com/kava-labs/kava/x/hard/types"
-	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 func (suite *KeeperTestSuite) TestRepay() {
@@ -30,7 +29,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 	type errArgs struct {
 		expectPass   bool
 		expectDelete bool
-		contains     string
+		expectedErr  error
 	}
 
 	type borrowTest struct {
@@ -55,7 +54,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: false,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -71,7 +70,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: true,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -87,7 +86,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: true,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -103,7 +102,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 			errArgs{
 				expectPass:   false,
 				expectDelete: false,
-				contains:     "account can only repay up to 0bnb",
+				expectedErr:  types.ErrInsufficientBalanceForRepay,
 			},
 		},
 		{
@@ -119,7 +118,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 			errArgs{
 				expectPass:   false,
 				expectDelete: false,
-				contains:     "account can only repay up to 50000000ukava",
+				expectedErr:  types.ErrInsufficientBalanceForRepay,
 			},
 		},
 	}
@@ -130,67 +129,28 @@ func (suite *KeeperTestSuite) TestRepay() {
 			tApp := app.NewTestApp()
 			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
 
-			// Auth module genesis state
-			authGS := app.NewAuthGenState(
-				[]sdk.AccAddress{tc.args.borrower},
-				[]sdk.Coins{tc.args.initialBorrowerCoins})
-
-			// Hard module genesis state
-			hardGS := types.NewGenesisState(types.NewParams(
-				types.MoneyMarkets{
-					types.NewMoneyMarket("usdx",
-						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
-						"usdx:usd",                     // Market ID
-						sdk.NewInt(USDX_CF),            // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
-					types.NewMoneyMarket("ukava",
-						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
-						"kava:usd",                     // Market ID
-						sdk.NewInt(KAVA_CF),            // Conversion Factor
-						model,                          // Interest Rate Model
-						sdk.MustNewDecFromStr("0.05"),  // Reserve Factor
-						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
-				},
-			), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
-				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
-			)
-
-			// Pricefeed module genesis state
-			pricefeedGS := pricefeed.GenesisState{
-				Params: pricefeed.Params{
-					Markets: []pricefeed.Market{
-						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-					},
-				},
-				PostedPrices: []pricefeed.PostedPrice{
-					{
-						MarketID:      "usdx:usd",
-						OracleAddress: sdk.AccAddress{},
-						Price:         sdk.MustNewDecFromStr("1.00"),
-						Expiry:        time.Now().Add(1 * time.Hour),
-					},
-					{
-						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
-						Price:         sdk.MustNewDecFromStr("2.00"),
-						Expiry:        time.Now().Add(1 * time.Hour),
-					},
-				},
-			}
-
-			// Initialize test application
-			tApp.InitializeFromGenesisStates(authGS,
-				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
-				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+			tApp.InitializeFromGenesisStates(genesis.NewBuilder().
+				WithAccountBalance(tc.args.borrower, tc.args.initialBorrowerCoins).
+				WithModuleAccountBalance(types.ModuleAccountName, tc.args.initialModuleCoins).
+				WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+				WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+				WithHardMarket(types.NewMoneyMarket("usdx",
+					types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")), // Borrow Limit
+					"usdx:usd",                      // Market ID
+					sdk.NewInt(USDX_CF),             // Conversion Factor
+					model,                           // Interest Rate Model
+					sdk.MustNewDecFromStr("0.05"),   // Reserve Factor
+					sdk.MustNewDecFromStr("0.05"))). // Keeper Reward Percent
+				WithHardMarket(types.NewMoneyMarket("ukava",
+					types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+					"kava:usd",                      // Market ID
+					sdk.NewInt(KAVA_CF),             // Conversion Factor
+					model,                           // Interest Rate Model
+					sdk.MustNewDecFromStr("0.05"),   // Reserve Factor
+					sdk.MustNewDecFromStr("0.05"))). // Keeper Reward Percent
+				Build(),
 			)
 
-			// Mint coins to Hard module account
-			supplyKeeper := tApp.GetSupplyKeeper()
-			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, tc.args.initialModuleCoins)
-
 			keeper := tApp.GetHardKeeper()
 			suite.app = tApp
 			suite.ctx = ctx
@@ -238,7 +198,7 @@ func (suite *KeeperTestSuite) TestRepay() {
 				}
 			} else {
 				suite.Require().Error(err)
-				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 
 				// Check borrower balance (no repay coins)
 				expectedBorrowerCoins := tc.args.initialBorrowerCoins.Sub(tc.args.depositCoins).Add(tc.args.borrowCoins...)
@@ -258,3 +218,65 @@ func (suite *KeeperTestSuite) TestRepay() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestDepositRepayFirst() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates(genesis.NewBuilder().
+		WithAccountBalance(borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(200*KAVA_CF)))).
+		WithModuleAccountBalance(types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF)))).
+		WithPricefeedPrice("usdx:usd", sdk.MustNewDecFromStr("1.00")).
+		WithPricefeedPrice("kava:usd", sdk.MustNewDecFromStr("2.00")).
+		WithHardMarket(types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("1")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		WithHardMarket(types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))).
+		Build(),
+	)
+	keeper := tApp.GetHardKeeper()
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = keeper
+
+	pricefeedKeeper := tApp.GetPriceFeedKeeper()
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "usdx:usd"))
+	suite.Require().NoError(pricefeedKeeper.SetCurrentPrices(ctx, "kava:usd"))
+
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(50*USDX_CF)))))
+
+	suite.Require().False(keeper.GetRepayFirst(ctx, borrower))
+	keeper.SetRepayFirst(ctx, borrower, true)
+	suite.Require().True(keeper.GetRepayFirst(ctx, borrower))
+
+	// a deposit smaller than the borrow is used entirely for repayment
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(30*USDX_CF)))))
+	borrow, found := keeper.GetBorrow(ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF))), borrow.Amount)
+	deposit, found := keeper.GetDeposit(ctx, borrower)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))), deposit.Amount)
+
+	// denoms that are not borrowed are deposited as usual, and any excess is deposited after the borrow is repaid
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(
+		sdk.NewCoin("usdx", sdk.NewInt(20*USDX_CF)),
+		sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)),
+	)))
+	_, found = keeper.GetBorrow(ctx, borrower)
+	suite.Require().False(found)
+	deposit, _ = keeper.GetDeposit(ctx, borrower)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(110*KAVA_CF))), deposit.Amount)
+
+	// with repay first disabled, deposits do not repay borrows
+	suite.Require().NoError(keeper.Borrow(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF)))))
+	keeper.SetRepayFirst(ctx, borrower, false)
+	suite.Require().NoError(keeper.Deposit(ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF)))))
+	borrow, _ = keeper.GetBorrow(ctx, borrower)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF))), borrow.Amount)
+	deposit, _ = keeper.GetDeposit(ctx, borrower)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(110*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))), deposit.Amount)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// PayoutReserves pays out from the reserves to the recipients affected by an incident. The total payout
+// must be covered by the reserves of each denom, otherwise nothing is paid.
+func (k Keeper) PayoutReserves(ctx sdk.Context, incident string, payouts types.ReservePayouts) error {
+	total := payouts.Total()
+	reserves, _ := k.GetTotalReserves(ctx)
+	if !total.IsAllLTE(reserves) {
+		return sdkerrors.Wrapf(types.ErrInsufficientReserves, "payout %s exceeds reserves %s", total, reserves)
+	}
+
+	if err := k.recallStrategyAllocations(ctx, total); err != nil {
+		return err
+	}
+	for _, payout := range payouts {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, payout.Recipient, payout.Amount)
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHardReservePayout,
+				sdk.NewAttribute(types.AttributeKeyIncident, incident),
+				sdk.NewAttribute(types.AttributeKeyRecipient, payout.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyPayoutCoins, payout.Amount.String()),
+			),
+		)
+	}
+
+	k.SetTotalReserves(ctx, reserves.Sub(total))
+	k.Logger(ctx).Info("paid out reserves", "incident", incident, "recipients", len(payouts), "total", total.String())
+	return nil
+}
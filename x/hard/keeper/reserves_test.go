@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+func (suite *KeeperTestSuite) TestPayoutReserves() {
+	user := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	recipientA := sdk.AccAddress(crypto.AddressHash([]byte("recipientA")))
+	recipientB := sdk.AccAddress(crypto.AddressHash([]byte("recipientB")))
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	moneyMarket := types.NewMoneyMarket("ukava",
+		types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	_, ctx := suite.setupUkavaMarket(user, moneyMarket, tmtime.Now())
+
+	// the deposit funds the module account, part of which is counted as reserves
+	suite.Require().NoError(suite.keeper.Deposit(ctx, user, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.keeper.SetTotalReserves(ctx, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+
+	proposal := types.NewReservePayoutProposal("Oracle failure", "Refund liquidations caused by the oracle failure", "kava:usd oracle failure",
+		types.ReservePayouts{
+			types.NewReservePayout(recipientA, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(6*KAVA_CF)))),
+			types.NewReservePayout(recipientB, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(5*KAVA_CF)))),
+		},
+	)
+	handler := hard.NewProposalHandler(suite.keeper)
+
+	// the total payout cannot exceed the reserves
+	err := handler(ctx, proposal)
+	suite.Require().True(errors.Is(err, types.ErrInsufficientReserves))
+
+	proposal.Payouts[1].Amount = sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(4*KAVA_CF)))
+	suite.Require().NoError(handler(ctx, proposal))
+
+	suite.Require().Equal(sdk.NewInt(6*KAVA_CF), suite.getAccount(recipientA).GetCoins().AmountOf("ukava"))
+	suite.Require().Equal(sdk.NewInt(4*KAVA_CF), suite.getAccount(recipientB).GetCoins().AmountOf("ukava"))
+	reserves, _ := suite.keeper.GetTotalReserves(ctx)
+	suite.Require().True(reserves.Empty())
+
+	payoutEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeHardReservePayout {
+			payoutEvents++
+		}
+	}
+	suite.Require().Equal(2, payoutEvents)
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// validateRiskTiers checks that the borrows from each risk tier are collateralized by deposits the tier borrow rules
+// allow to back them. For each tier, deposits of the same tier or of markets without a tier count at their LTV,
+// deposits of another tier count at their LTV reduced by the rule's haircut, and deposits of tiers without a rule do
+// not count. Borrows from markets without a risk tier are only limited by the position's health factor.
+func (k Keeper) validateRiskTiers(ctx sdk.Context, deposit types.Deposit, borrow types.Borrow) error {
+	rules := k.GetParams(ctx).TierBorrowRules
+
+	depositTiers := make(map[string]string)
+	for _, coin := range deposit.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
+		}
+		depositTiers[coin.Denom] = mm.RiskTier
+	}
+	borrowedByTier := make(map[string]sdk.Coins)
+	for _, coin := range borrow.Amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrMarketNotFound, "no market found for denom %s", coin.Denom)
+		}
+		if mm.RiskTier == "" {
+			continue
+		}
+		borrowedByTier[mm.RiskTier] = borrowedByTier[mm.RiskTier].Add(coin)
+	}
+	if len(borrowedByTier) == 0 {
+		return nil
+	}
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+
+	// check tiers in a fixed order so the same error is returned on every node
+	var tiers []string
+	for tier := range borrowedByTier {
+		tiers = append(tiers, tier)
+	}
+	sort.Strings(tiers)
+
+	for _, tier := range tiers {
+		borrowedUSD := sdk.ZeroDec()
+		for _, coin := range borrowedByTier[tier] {
+			lData := liqMap[coin.Denom]
+			borrowedUSD = borrowedUSD.Add(coin.Amount.ToDec().Quo(lData.conversionFactor.ToDec()).Mul(lData.borrowPrice))
+		}
+		borrowableUSD := sdk.ZeroDec()
+		for _, coin := range deposit.Amount {
+			haircut, allowed := rules.CollateralHaircut(depositTiers[coin.Denom], tier)
+			if !allowed {
+				continue
+			}
+			lData := liqMap[coin.Denom]
+			usdValue := coin.Amount.ToDec().Quo(lData.conversionFactor.ToDec()).Mul(lData.depositPrice)
+			borrowableUSD = borrowableUSD.Add(usdValue.Mul(lData.ltv).Mul(sdk.OneDec().Sub(haircut)))
+		}
+		if types.IsLiquidatable(types.CalculateHealthFactor(borrowedUSD, borrowableUSD)) {
+			return sdkerrors.Wrapf(types.ErrInsufficientTierCollateral,
+				"borrows of %s USD from risk tier %s exceed the %s USD borrowable against collateral allowed for the tier",
+				borrowedUSD, tier, borrowableUSD)
+		}
+	}
+	return nil
+}
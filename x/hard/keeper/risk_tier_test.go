@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestRiskTiers() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	authGS := app.NewAuthGenState([]sdk.AccAddress{borrower}, []sdk.Coins{coins})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	usdxMarket := types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+		"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+	usdxMarket.RiskTier = "stable"
+	kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+		"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05"))
+	kavaMarket.RiskTier = "volatile"
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{usdxMarket, kavaMarket}),
+		types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+
+	// $20 of kava can be borrowed against up to $16
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+
+	// without a rule, volatile deposits only collateralize volatile borrows
+	err := suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientTierCollateral))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))))
+
+	// with a 50% haircut, the kava collateralizes up to $8 of stable borrows
+	params := suite.keeper.GetParams(suite.ctx)
+	params.TierBorrowRules = types.TierBorrowRules{types.NewTierBorrowRule("volatile", "stable", sdk.MustNewDecFromStr("0.5"))}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF)))))
+	err = suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientTierCollateral))
+
+	// withdrawals that leave the stable borrows undercollateralized are rejected, although the position stays healthy
+	err = suite.keeper.Withdraw(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInsufficientTierCollateral))
+
+	// markets without a risk tier are not restricted
+	params.MoneyMarkets[0].RiskTier = ""
+	suite.keeper.SetParams(suite.ctx, params)
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))))
+}
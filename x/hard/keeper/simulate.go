@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// SimulatePositionChange returns the outcome of a hypothetical deposit, withdraw, borrow, repay, or liquidation of the
+// owner's position without modifying state. The change is run against a cached copy of the store, so it fails for the
+// same reasons as the transaction would at the current block.
+func (k Keeper) SimulatePositionChange(ctx sdk.Context, action string, sender, owner sdk.AccAddress, amount sdk.Coins) (types.SimulatedPosition, error) {
+	var apply func(sdk.Context) error
+	switch action {
+	case types.SimulationDeposit:
+		apply = func(ctx sdk.Context) error { return k.Deposit(ctx, owner, amount) }
+	case types.SimulationWithdraw:
+		apply = func(ctx sdk.Context) error { return k.Withdraw(ctx, owner, amount) }
+	case types.SimulationBorrow:
+		apply = func(ctx sdk.Context) error { return k.Borrow(ctx, owner, amount) }
+	case types.SimulationRepay:
+		apply = func(ctx sdk.Context) error { return k.Repay(ctx, sender, owner, amount) }
+	case types.SimulationLiquidation:
+		apply = func(ctx sdk.Context) error { return k.AttemptKeeperLiquidation(ctx, sender, owner) }
+	default:
+		return types.SimulatedPosition{}, sdkerrors.Wrapf(types.ErrInvalidSimulationAction, "%s", action)
+	}
+
+	deposit, _ := k.GetDeposit(ctx, owner)
+	syncedDeposit, _ := k.GetSyncedDeposit(ctx, owner)
+	borrow, _ := k.GetBorrow(ctx, owner)
+	syncedBorrow, _ := k.GetSyncedBorrow(ctx, owner)
+	simulated := types.SimulatedPosition{
+		Action:         action,
+		Owner:          owner,
+		SupplyInterest: syncedDeposit.Amount.Sub(deposit.Amount),
+		BorrowInterest: syncedBorrow.Amount.Sub(borrow.Amount),
+	}
+
+	// positions are left as they are when the change fails
+	resultCtx, _ := ctx.CacheContext()
+	if err := apply(resultCtx); err != nil {
+		simulated.Error = err.Error()
+		resultCtx = ctx
+	} else {
+		simulated.Success = true
+	}
+
+	deposit, _ = k.GetSyncedDeposit(resultCtx, owner)
+	borrow, _ = k.GetSyncedBorrow(resultCtx, owner)
+	simulated.Deposit = deposit.Amount
+	simulated.Borrow = borrow.Amount
+	ltv, err := k.CalculateLtv(resultCtx, deposit, borrow)
+	if err != nil {
+		return types.SimulatedPosition{}, err
+	}
+	simulated.LTV = ltv
+	healthFactor, err := k.CalculateHealthFactor(resultCtx, deposit, borrow)
+	if err != nil {
+		return types.SimulatedPosition{}, err
+	}
+	simulated.HealthFactor = healthFactor
+	return simulated, nil
+}
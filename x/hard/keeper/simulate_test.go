@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestSimulatePositionChange() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	authGS := app.NewAuthGenState([]sdk.AccAddress{borrower}, []sdk.Coins{coins})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{
+		types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+	}), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	kava := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(amount*KAVA_CF))) }
+	usdx := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(amount*USDX_CF))) }
+
+	_, err := suite.keeper.SimulatePositionChange(suite.ctx, "mint", borrower, borrower, kava(1))
+	suite.Require().True(errors.Is(err, types.ErrInvalidSimulationAction))
+
+	// a simulated change is reported without being applied
+	simulated, err := suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationDeposit, borrower, borrower, kava(10))
+	suite.Require().NoError(err)
+	suite.Require().True(simulated.Success)
+	suite.Require().Equal(kava(10), simulated.Deposit)
+	suite.Require().True(simulated.LTV.IsZero())
+	_, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+	suite.Require().False(found)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, kava(10)))
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationBorrow, borrower, borrower, usdx(8))
+	suite.Require().NoError(err)
+	suite.Require().True(simulated.Success)
+	suite.Require().Equal(usdx(8), simulated.Borrow)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.4"), simulated.LTV)
+	_, found = suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+
+	// a failed change reports the reason and leaves the position as it is
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationBorrow, borrower, borrower, usdx(17))
+	suite.Require().NoError(err)
+	suite.Require().False(simulated.Success)
+	suite.Require().Contains(simulated.Error, types.ErrInsufficientLoanToValue.Error())
+	suite.Require().True(simulated.Borrow.Empty())
+	suite.Require().Equal(kava(10), simulated.Deposit)
+
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, usdx(8)))
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationWithdraw, borrower, borrower, kava(6))
+	suite.Require().NoError(err)
+	suite.Require().False(simulated.Success)
+	suite.Require().Contains(simulated.Error, types.ErrInsufficientLoanToValue.Error())
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.4"), simulated.LTV)
+
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationRepay, borrower, borrower, usdx(4))
+	suite.Require().NoError(err)
+	suite.Require().True(simulated.Success)
+	suite.Require().Equal(usdx(4), simulated.Borrow)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2"), simulated.LTV)
+
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationLiquidation, borrower, borrower, nil)
+	suite.Require().NoError(err)
+	suite.Require().False(simulated.Success)
+	suite.Require().NotEmpty(simulated.Error)
+
+	// interest accrued since the position was last synced is reported
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+	suite.ctx = suite.ctx.WithBlockTime(blockTime.Add(48 * time.Hour))
+	suite.Require().NoError(suite.keeper.AccrueInterest(suite.ctx, "usdx"))
+	simulated, err = suite.keeper.SimulatePositionChange(suite.ctx, types.SimulationRepay, borrower, borrower, usdx(1))
+	suite.Require().NoError(err)
+	suite.Require().True(simulated.Success)
+	suite.Require().True(simulated.BorrowInterest.AmountOf("usdx").IsPositive())
+	suite.Require().True(simulated.SupplyInterest.Empty())
+	suite.Require().True(simulated.Borrow.AmountOf("usdx").GT(sdk.NewInt(7 * USDX_CF)))
+}
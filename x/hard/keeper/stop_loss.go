@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// RegisterStopLoss sets a borrower's stop loss, replacing any stop loss they already have
+func (k Keeper) RegisterStopLoss(ctx sdk.Context, stopLoss types.StopLoss) error {
+	if err := stopLoss.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidStopLoss, err.Error())
+	}
+	k.SetStopLoss(ctx, stopLoss)
+	return nil
+}
+
+// RemoveStopLoss removes a borrower's stop loss
+func (k Keeper) RemoveStopLoss(ctx sdk.Context, borrower sdk.AccAddress) error {
+	if _, found := k.GetStopLoss(ctx, borrower); !found {
+		return sdkerrors.Wrapf(types.ErrStopLossNotFound, "%s", borrower)
+	}
+	k.DeleteStopLoss(ctx, borrower)
+	return nil
+}
+
+// ProcessStopLosses reduces the borrows of positions whose LTV has risen above the threshold of their stop loss.
+// Each stop loss runs in a cached context, so one that fails, for example because a swap exceeds its max slippage,
+// leaves no state changes and is retried in the next block.
+func (k Keeper) ProcessStopLosses(ctx sdk.Context) {
+	for _, stopLoss := range k.GetAllStopLosses(ctx) {
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.applyStopLoss(cacheCtx, stopLoss); err != nil {
+			k.Logger(ctx).Error("failed to apply stop loss", "borrower", stopLoss.Borrower, "err", err.Error())
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// applyStopLoss repays up to the max repay value of a borrow if the position's LTV is above the stop loss threshold.
+// For each borrowed denom in turn, deposits of the same denom repay it directly, then deposits of denoms that are
+// not borrowed and have a swap liquidation configured are sold for it through the swap module.
+func (k Keeper) applyStopLoss(ctx sdk.Context, stopLoss types.StopLoss) error {
+	deposit, found := k.GetSyncedDeposit(ctx, stopLoss.Borrower)
+	if !found {
+		return nil
+	}
+	borrow, found := k.GetSyncedBorrow(ctx, stopLoss.Borrower)
+	if !found {
+		return nil
+	}
+	ltv, err := k.CalculateLtv(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	if !stopLoss.IsTriggered(ltv) {
+		return nil
+	}
+
+	if err := k.SyncMoneyMarketInterest(ctx, deposit.Amount.Add(borrow.Amount...)); err != nil {
+		return err
+	}
+
+	// Call incentive hooks
+	deposit, _ = k.GetDeposit(ctx, stopLoss.Borrower)
+	borrow, _ = k.GetBorrow(ctx, stopLoss.Borrower)
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, stopLoss.Borrower)
+	k.SyncSupplyInterest(ctx, stopLoss.Borrower)
+
+	deposit, _ = k.GetDeposit(ctx, stopLoss.Borrower)
+	borrow, _ = k.GetBorrow(ctx, stopLoss.Borrower)
+
+	liqMap, err := k.LoadLiquidationData(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+
+	available := deposit.Amount
+	repaid := sdk.NewCoins()
+	sold := sdk.NewCoins()
+	proceeds := sdk.NewCoins()
+	remainingValue := stopLoss.MaxRepayValue
+	for _, bCoin := range borrow.Amount {
+		if !remainingValue.IsPositive() {
+			break
+		}
+		bData := liqMap[bCoin.Denom]
+		target := sdk.MinInt(bCoin.Amount, remainingValue.MulInt(bData.conversionFactor).Quo(bData.borrowPrice).TruncateInt())
+		if !target.IsPositive() {
+			continue
+		}
+		owed := target
+
+		// deposits of the borrowed denom repay it directly
+		direct := sdk.MinInt(owed, available.AmountOf(bCoin.Denom))
+		if direct.IsPositive() {
+			available = available.Sub(sdk.NewCoins(sdk.NewCoin(bCoin.Denom, direct)))
+			owed = owed.Sub(direct)
+		}
+
+		// other deposits are sold for the borrowed denom
+		for _, dCoin := range available {
+			if !owed.IsPositive() {
+				break
+			}
+			if dCoin.Denom == bCoin.Denom || borrow.Amount.AmountOf(dCoin.Denom).IsPositive() {
+				continue
+			}
+			sl, found := k.GetSwapLiquidation(ctx, dCoin.Denom)
+			if !found {
+				continue
+			}
+			lot := sdk.NewCoin(dCoin.Denom, sdk.MinInt(dCoin.Amount, sl.MaxLotSize))
+			if err := k.recallStrategyAllocations(ctx, sdk.NewCoins(lot)); err != nil {
+				return err
+			}
+			lotSold, lotProceeds, ok := k.swapLot(ctx, lot, sdk.NewCoin(bCoin.Denom, owed), sl.MaxSlippage, liqMap)
+			if !ok {
+				continue
+			}
+			available = available.Sub(sdk.NewCoins(lotSold))
+			sold = sold.Add(lotSold)
+			proceeds = proceeds.Add(lotProceeds)
+			owed = owed.Sub(sdk.MinInt(owed, lotProceeds.Amount))
+		}
+
+		repayment := sdk.NewCoin(bCoin.Denom, target.Sub(owed))
+		if repayment.IsPositive() {
+			repaid = repaid.Add(repayment)
+			remainingValue = remainingValue.Sub(repayment.Amount.ToDec().Quo(bData.conversionFactor.ToDec()).Mul(bData.borrowPrice))
+		}
+	}
+	if repaid.Empty() {
+		return nil
+	}
+
+	// proceeds beyond the amount owed are returned to the borrower
+	used := deposit.Amount.Sub(available)
+	excess := used.Sub(sold).Add(proceeds...).Sub(repaid)
+	if !excess.Empty() {
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, stopLoss.Borrower, excess); err != nil {
+			return err
+		}
+	}
+
+	for _, coin := range used {
+		if coin.Amount.Equal(deposit.Amount.AmountOf(coin.Denom)) {
+			depositIndex, removed := deposit.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			deposit.Index = depositIndex
+		}
+	}
+	deposit.Amount = available
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	if err := k.DecrementSuppliedCoins(ctx, used); err != nil {
+		return err
+	}
+
+	k.recordBorrowHistory(ctx, stopLoss.Borrower, types.BorrowHistoryRepayment, repaid)
+
+	for _, coin := range repaid {
+		if coin.Amount.Equal(borrow.Amount.AmountOf(coin.Denom)) {
+			borrowIndex, removed := borrow.Index.RemoveInterestFactor(coin.Denom)
+			if !removed {
+				return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", coin.Denom)
+			}
+			borrow.Index = borrowIndex
+		}
+	}
+	borrow.Amount = borrow.Amount.Sub(repaid)
+	if borrow.Amount.Empty() {
+		k.DeleteBorrow(ctx, borrow)
+	} else {
+		k.SetBorrow(ctx, borrow)
+	}
+	if err := k.DecrementBorrowedCoins(ctx, repaid); err != nil {
+		return err
+	}
+
+	// Call incentive hooks
+	k.AfterDepositModified(ctx, deposit)
+	if !borrow.Amount.Empty() {
+		k.AfterBorrowModified(ctx, borrow)
+	}
+
+	resultingLtv, err := k.CalculateLtv(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	resultingHealthFactor, err := k.CalculateHealthFactor(ctx, deposit, borrow)
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardStopLoss,
+			sdk.NewAttribute(types.AttributeKeyBorrower, stopLoss.Borrower.String()),
+			sdk.NewAttribute(types.AttributeKeyLtv, ltv.String()),
+			sdk.NewAttribute(types.AttributeKeyRepayCoins, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, sold.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, proceeds.String()),
+			sdk.NewAttribute(types.AttributeKeyResultingLtv, resultingLtv.String()),
+			sdk.NewAttribute(types.AttributeKeyResultingHealthFactor, resultingHealthFactor.String()),
+		),
+	)
+	k.recordWithdrawalStats(ctx, used)
+	k.recordRepayStats(ctx, repaid)
+	k.Logger(ctx).Info("applied stop loss", "borrower", stopLoss.Borrower, "ltv", ltv, "repaid", repaid, "sold", sold)
+	return nil
+}
+
+// GetStopLoss returns a borrower's stop loss
+func (k Keeper) GetStopLoss(ctx sdk.Context, borrower sdk.AccAddress) (types.StopLoss, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StopLossesPrefix)
+	bz := store.Get(borrower)
+	if bz == nil {
+		return types.StopLoss{}, false
+	}
+	var stopLoss types.StopLoss
+	k.cdc.MustUnmarshalBinaryBare(bz, &stopLoss)
+	return stopLoss, true
+}
+
+// SetStopLoss sets a borrower's stop loss in the store
+func (k Keeper) SetStopLoss(ctx sdk.Context, stopLoss types.StopLoss) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StopLossesPrefix)
+	store.Set(stopLoss.Borrower, k.cdc.MustMarshalBinaryBare(stopLoss))
+}
+
+// DeleteStopLoss deletes a borrower's stop loss from the store
+func (k Keeper) DeleteStopLoss(ctx sdk.Context, borrower sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StopLossesPrefix)
+	store.Delete(borrower)
+}
+
+// IterateStopLosses iterates over all stop losses and performs a callback function
+func (k Keeper) IterateStopLosses(ctx sdk.Context, cb func(stopLoss types.StopLoss) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.StopLossesPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stopLoss types.StopLoss
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stopLoss)
+		if cb(stopLoss) {
+			break
+		}
+	}
+}
+
+// GetAllStopLosses returns all stop losses
+func (k Keeper) GetAllStopLosses(ctx sdk.Context) types.StopLosses {
+	stopLosses := types.StopLosses{}
+	k.IterateStopLosses(ctx, func(stopLoss types.StopLoss) bool {
+		stopLosses = append(stopLosses, stopLoss)
+		return false
+	})
+	return stopLosses
+}
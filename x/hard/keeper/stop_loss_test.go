@@ -0,0 +1,224 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/swap"
+)
+
+func (suite *KeeperTestSuite) TestStopLoss() {
+	type args struct {
+		depositCoins          sdk.Coins
+		borrowCoins           sdk.Coins
+		stopLoss              types.StopLoss
+		expectedDeposit       sdk.Coins
+		expectedBorrow        sdk.Coins
+		expectedBorrowerCoins sdk.Coins
+		expectEvent           bool
+	}
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	reserveFactor := sdk.MustNewDecFromStr("0.05")
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+	provider := sdk.AccAddress(crypto.AddressHash([]byte("testprovider")))
+
+	testCases := []struct {
+		name string
+		args args
+	}{
+		{
+			"valid: borrow repaid from deposit of the same denom",
+			args{
+				depositCoins:          sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))),
+				borrowCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				stopLoss:              types.NewStopLoss(borrower, sdk.MustNewDecFromStr("0.5"), sdk.NewDec(3)),
+				expectedDeposit:       sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(2*USDX_CF))),
+				expectedBorrow:        sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(12*USDX_CF))),
+				expectedBorrowerCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				expectEvent:           true,
+			},
+		},
+		{
+			"valid: borrow repaid by selling another deposit denom",
+			args{
+				depositCoins:          sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+				borrowCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(10*USDX_CF))),
+				stopLoss:              types.NewStopLoss(borrower, sdk.MustNewDecFromStr("0.4"), sdk.NewDec(4)),
+				expectedBorrow:        sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(6*USDX_CF))),
+				expectedBorrowerCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				expectEvent:           true,
+			},
+		},
+		{
+			"valid: position below the threshold is unchanged",
+			args{
+				depositCoins:          sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))),
+				borrowCoins:           sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				stopLoss:              types.NewStopLoss(borrower, sdk.MustNewDecFromStr("0.7"), sdk.NewDec(3)),
+				expectedDeposit:       sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))),
+				expectedBorrow:        sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				expectedBorrowerCoins: sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(15*USDX_CF))),
+				expectEvent:           false,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+			authGS := app.NewAuthGenState(
+				[]sdk.AccAddress{borrower, provider},
+				[]sdk.Coins{
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(5*USDX_CF))),
+					sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100000*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(200000*USDX_CF))),
+				},
+			)
+
+			hardParams := types.NewParams(
+				types.MoneyMarkets{
+					types.NewMoneyMarket("usdx",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")), // Borrow Limit
+						"usdx:usd",                     // Market ID
+						sdk.NewInt(USDX_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+					types.NewMoneyMarket("ukava",
+						types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), // Borrow Limit
+						"kava:usd",                     // Market ID
+						sdk.NewInt(KAVA_CF),            // Conversion Factor
+						model,                          // Interest Rate Model
+						reserveFactor,                  // Reserve Factor
+						sdk.MustNewDecFromStr("0.05")), // Keeper Reward Percent
+				},
+			)
+			hardParams.SwapLiquidations = types.SwapLiquidations{
+				types.NewSwapLiquidation("ukava", sdk.NewInt(10*KAVA_CF), sdk.MustNewDecFromStr("0.05")),
+			}
+			hardGS := types.NewGenesisState(hardParams, types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+				types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+			)
+
+			pricefeedGS := pricefeed.GenesisState{
+				Params: pricefeed.Params{
+					Markets: []pricefeed.Market{
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+					},
+				},
+				PostedPrices: []pricefeed.PostedPrice{
+					{
+						MarketID:      "usdx:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("1.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+					{
+						MarketID:      "kava:usd",
+						OracleAddress: sdk.AccAddress{},
+						Price:         sdk.MustNewDecFromStr("2.00"),
+						Expiry:        time.Now().Add(100 * time.Hour),
+					},
+				},
+			}
+
+			swapGS := swap.NewGenesisState(
+				swap.NewParams(swap.AllowedPools{swap.NewAllowedPool("ukava", "usdx")}, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec()),
+				swap.Pools{}, swap.ShareRecords{},
+			)
+
+			tApp.InitializeFromGenesisStates(authGS,
+				app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+				app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+				app.GenesisState{swap.ModuleName: swap.ModuleCdc.MustMarshalJSON(swapGS)})
+
+			supplyKeeper := tApp.GetSupplyKeeper()
+			supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+
+			suite.app = tApp
+			suite.ctx = ctx
+			suite.keeper = tApp.GetHardKeeper()
+			swapKeeper := tApp.GetSwapKeeper()
+
+			// Provide liquidity at a price of $2.00 per kava
+			err := swapKeeper.Deposit(suite.ctx, provider, sdk.NewCoin("ukava", sdk.NewInt(100000*KAVA_CF)), sdk.NewCoin("usdx", sdk.NewInt(200000*USDX_CF)), sdk.MustNewDecFromStr("0.01"))
+			suite.Require().NoError(err)
+
+			hard.BeginBlocker(suite.ctx, suite.keeper)
+
+			err = suite.keeper.Deposit(suite.ctx, borrower, tc.args.depositCoins)
+			suite.Require().NoError(err)
+			err = suite.keeper.Borrow(suite.ctx, borrower, tc.args.borrowCoins)
+			suite.Require().NoError(err)
+
+			err = suite.keeper.RegisterStopLoss(suite.ctx, tc.args.stopLoss)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.keeper.ProcessStopLosses(suite.ctx)
+
+			borrow, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.args.expectedBorrow, borrow.Amount)
+
+			deposit, found := suite.keeper.GetDeposit(suite.ctx, borrower)
+			suite.Require().True(found)
+			if tc.args.expectedDeposit != nil {
+				suite.Require().Equal(tc.args.expectedDeposit, deposit.Amount)
+			} else {
+				// the kava sold is worth about the amount repaid
+				sold := tc.args.depositCoins.AmountOf("ukava").Sub(deposit.Amount.AmountOf("ukava"))
+				suite.Require().True(sold.GT(sdk.NewInt(2 * KAVA_CF)))
+				suite.Require().True(sold.LT(sdk.NewInt(21 * KAVA_CF / 10)))
+			}
+
+			accBorrower := suite.getAccountAtCtx(borrower, suite.ctx)
+			suite.Require().Equal(tc.args.expectedBorrowerCoins, accBorrower.GetCoins())
+
+			_, found = suite.keeper.GetStopLoss(suite.ctx, borrower)
+			suite.Require().True(found)
+
+			eventFound := false
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeHardStopLoss {
+					eventFound = true
+				}
+			}
+			suite.Require().Equal(tc.args.expectEvent, eventFound)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRemoveStopLoss() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	tApp.InitializeFromGenesisStates()
+	keeper := tApp.GetHardKeeper()
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+
+	err := keeper.RemoveStopLoss(ctx, borrower)
+	suite.Require().True(errors.Is(err, types.ErrStopLossNotFound))
+
+	err = keeper.RegisterStopLoss(ctx, types.NewStopLoss(borrower, sdk.MustNewDecFromStr("0.5"), sdk.NewDec(100)))
+	suite.Require().NoError(err)
+	suite.Require().Len(keeper.GetAllStopLosses(ctx), 1)
+
+	err = keeper.RegisterStopLoss(ctx, types.NewStopLoss(borrower, sdk.OneDec(), sdk.NewDec(100)))
+	suite.Require().True(errors.Is(err, types.ErrInvalidStopLoss))
+
+	err = keeper.RemoveStopLoss(ctx, borrower)
+	suite.Require().NoError(err)
+	suite.Require().Len(keeper.GetAllStopLosses(ctx), 0)
+}
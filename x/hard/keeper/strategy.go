@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// GetCash returns the un-borrowed liquidity of every money market, which is the balance of the hard module account
+// plus the coins allocated to yield strategies
+func (k Keeper) GetCash(ctx sdk.Context) sdk.Coins {
+	cash := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins()
+	allocations, _ := k.GetStrategyAllocations(ctx)
+	return cash.Add(allocations...)
+}
+
+// RebalanceStrategies harvests the yield of each registered yield strategy, credits it to suppliers, and moves the
+// strategy's allocation to the target set by its money market's max strategy allocation. A strategy that fails is
+// left unchanged until the next block.
+func (k Keeper) RebalanceStrategies(ctx sdk.Context) {
+	denoms := make([]string, 0, len(k.strategies))
+	for denom := range k.strategies {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	for _, denom := range denoms {
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		if err := k.rebalanceStrategy(cacheCtx, denom, k.strategies[denom]); err != nil {
+			k.Logger(ctx).Error("failed to rebalance yield strategy", "denom", denom, "err", err)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// rebalanceStrategy harvests a strategy's yield and allocates or withdraws coins until the strategy holds its
+// target allocation. Markets that have been removed have a target of zero, so their allocation is withdrawn.
+func (k Keeper) rebalanceStrategy(ctx sdk.Context, denom string, strategy types.YieldStrategy) error {
+	yield, err := k.harvestStrategy(ctx, denom, strategy)
+	if err != nil {
+		return err
+	}
+
+	allocations, _ := k.GetStrategyAllocations(ctx)
+	allocated := allocations.AmountOf(denom)
+	target := sdk.ZeroInt()
+	mm, found := k.GetMoneyMarket(ctx, denom)
+	if found {
+		target = mm.StrategyAllocationTarget(k.GetCash(ctx).AmountOf(denom))
+	}
+
+	switch {
+	case target.GT(allocated):
+		err = k.depositToStrategy(ctx, strategy, sdk.NewCoin(denom, target.Sub(allocated)))
+	case target.LT(allocated):
+		err = k.withdrawFromStrategy(ctx, strategy, sdk.NewCoin(denom, allocated.Sub(target)))
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardStrategyRebalance,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyStrategyAllocation, sdk.NewCoin(denom, target).String()),
+			sdk.NewAttribute(types.AttributeKeyStrategyYield, yield.String()),
+		),
+	)
+	return nil
+}
+
+// harvestStrategy collects the yield earned by a strategy and credits it to the money market's suppliers by
+// increasing the supply interest factor. Yield is added to reserves when the market has no suppliers to credit.
+func (k Keeper) harvestStrategy(ctx sdk.Context, denom string, strategy types.YieldStrategy) (sdk.Coin, error) {
+	cashPrior := k.GetCash(ctx).AmountOf(denom)
+	balancePrior := k.getModuleBalance(ctx, denom)
+	if err := strategy.Harvest(ctx, denom); err != nil {
+		return sdk.Coin{}, err
+	}
+	// only coins that reached the module account are credited
+	yield := sdk.NewCoin(denom, sdk.MaxInt(sdk.ZeroInt(), k.getModuleBalance(ctx, denom).Sub(balancePrior)))
+	if yield.IsZero() {
+		return yield, nil
+	}
+
+	borrowed, _ := k.GetBorrowedCoins(ctx)
+	reserves, _ := k.GetTotalReserves(ctx)
+	supplyInterestFactorPrior, found := k.GetSupplyInterestFactor(ctx, denom)
+	totalSupply := cashPrior.Add(borrowed.AmountOf(denom)).Sub(reserves.AmountOf(denom))
+	if !found || !totalSupply.IsPositive() {
+		k.SetTotalReserves(ctx, reserves.Add(yield))
+		return yield, nil
+	}
+
+	supplyInterestFactor := CalculateSupplyInterestFactor(yield.Amount.ToDec(), cashPrior.ToDec(),
+		borrowed.AmountOf(denom).ToDec(), reserves.AmountOf(denom).ToDec())
+	k.SetSupplyInterestFactor(ctx, denom, supplyInterestFactorPrior.Mul(supplyInterestFactor))
+	k.IncrementSuppliedCoins(ctx, sdk.NewCoins(yield))
+	return yield, nil
+}
+
+// depositToStrategy sends coins from the hard module account to a strategy and records them as allocated
+func (k Keeper) depositToStrategy(ctx sdk.Context, strategy types.YieldStrategy, coin sdk.Coin) error {
+	coins := sdk.NewCoins(coin)
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, strategy.GetAddress(ctx), coins)
+	if err != nil {
+		return err
+	}
+	if err := strategy.Deposit(ctx, coins); err != nil {
+		return err
+	}
+
+	allocations, _ := k.GetStrategyAllocations(ctx)
+	k.SetStrategyAllocations(ctx, allocations.Add(coins...))
+	return nil
+}
+
+// withdrawFromStrategy returns allocated coins from a strategy to the hard module account, failing if the strategy
+// does not send back the full amount
+func (k Keeper) withdrawFromStrategy(ctx sdk.Context, strategy types.YieldStrategy, coin sdk.Coin) error {
+	coins := sdk.NewCoins(coin)
+	allocations, _ := k.GetStrategyAllocations(ctx)
+	remaining, isNegative := allocations.SafeSub(coins)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrStrategyWithdrawal, "withdrawal %s exceeds allocation %s", coin, allocations)
+	}
+
+	balancePrior := k.getModuleBalance(ctx, coin.Denom)
+	if err := strategy.Withdraw(ctx, coins); err != nil {
+		return err
+	}
+	returned := k.getModuleBalance(ctx, coin.Denom).Sub(balancePrior)
+	if returned.LT(coin.Amount) {
+		return sdkerrors.Wrapf(types.ErrStrategyWithdrawal, "strategy returned %s%s of %s", returned, coin.Denom, coin)
+	}
+
+	k.SetStrategyAllocations(ctx, remaining)
+	return nil
+}
+
+// recallStrategyAllocations withdraws coins from yield strategies when the hard module account's balance cannot
+// cover coins that are about to be sent out of it, so allocated liquidity never blocks withdrawals or borrows
+func (k Keeper) recallStrategyAllocations(ctx sdk.Context, coins sdk.Coins) error {
+	allocations, _ := k.GetStrategyAllocations(ctx)
+	if allocations.Empty() {
+		return nil
+	}
+
+	for _, coin := range coins {
+		shortfall := coin.Amount.Sub(k.getModuleBalance(ctx, coin.Denom))
+		allocated := allocations.AmountOf(coin.Denom)
+		if !shortfall.IsPositive() || !allocated.IsPositive() {
+			continue
+		}
+		strategy, found := k.strategies[coin.Denom]
+		if !found {
+			return sdkerrors.Wrapf(types.ErrStrategyNotFound, "%s", coin.Denom)
+		}
+		if err := k.withdrawFromStrategy(ctx, strategy, sdk.NewCoin(coin.Denom, sdk.MinInt(shortfall, allocated))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getModuleBalance returns the hard module account's balance of a denom
+func (k Keeper) getModuleBalance(ctx sdk.Context, denom string) sdk.Int {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins().AmountOf(denom)
+}
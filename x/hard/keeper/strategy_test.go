@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+// mockStrategy holds deposited coins in an account and pays a fixed yield from the same account on each harvest
+type mockStrategy struct {
+	supplyKeeper supply.Keeper
+	addr         sdk.AccAddress
+	yield        sdk.Coins
+	shortfall    sdk.Coins
+}
+
+var _ types.YieldStrategy = &mockStrategy{}
+
+func (s *mockStrategy) GetAddress(ctx sdk.Context) sdk.AccAddress { return s.addr }
+
+func (s *mockStrategy) Deposit(ctx sdk.Context, coins sdk.Coins) error { return nil }
+
+func (s *mockStrategy) Withdraw(ctx sdk.Context, coins sdk.Coins) error {
+	return s.supplyKeeper.SendCoinsFromAccountToModule(ctx, s.addr, types.ModuleAccountName, coins.Sub(s.shortfall))
+}
+
+func (s *mockStrategy) Harvest(ctx sdk.Context, denom string) error {
+	yield := sdk.NewCoins(sdk.NewCoin(denom, s.yield.AmountOf(denom)))
+	if yield.Empty() {
+		return nil
+	}
+	return s.supplyKeeper.SendCoinsFromAccountToModule(ctx, s.addr, types.ModuleAccountName, yield)
+}
+
+func (suite *KeeperTestSuite) setupStrategyMarket(lender, strategyAddr sdk.AccAddress) *mockStrategy {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	authGS := app.NewAuthGenState(
+		[]sdk.AccAddress{lender, strategyAddr},
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+			sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))),
+		},
+	)
+
+	// up to half of the market's idle liquidity is allocated to the strategy
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	kavaMarket.MaxStrategyAllocation = sdk.MustNewDecFromStr("0.5")
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{kavaMarket},
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	strategy := &mockStrategy{supplyKeeper: tApp.GetSupplyKeeper(), addr: strategyAddr}
+	suite.keeper.SetStrategy("ukava", strategy)
+	return strategy
+}
+
+func (suite *KeeperTestSuite) TestStrategyAllocation() {
+	lender := sdk.AccAddress(crypto.AddressHash([]byte("testlender")))
+	strategyAddr := sdk.AccAddress(crypto.AddressHash([]byte("teststrategy")))
+	strategy := suite.setupStrategyMarket(lender, strategyAddr)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.keeper.RebalanceStrategies(suite.ctx)
+
+	allocations, _ := suite.keeper.GetStrategyAllocations(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))), allocations)
+	suite.Require().Equal(sdk.NewInt(50*KAVA_CF), suite.getModuleAccount(types.ModuleAccountName).GetCoins().AmountOf("ukava"))
+	suite.Require().Equal(sdk.NewInt(100*KAVA_CF), suite.keeper.GetCash(suite.ctx).AmountOf("ukava"))
+
+	// withdrawing more than the module account holds recalls the shortfall from the strategy
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(80*KAVA_CF)))))
+	suite.Require().Equal(sdk.NewInt(80*KAVA_CF), suite.getAccount(lender).GetCoins().AmountOf("ukava"))
+	allocations, _ = suite.keeper.GetStrategyAllocations(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(20*KAVA_CF))), allocations)
+	suite.Require().True(suite.getModuleAccount(types.ModuleAccountName).GetCoins().AmountOf("ukava").IsZero())
+
+	// yield is credited to suppliers and the allocation moves back to half of the market's cash
+	strategy.yield = sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))
+	suite.keeper.RebalanceStrategies(suite.ctx)
+
+	supplyInterestFactor, _ := suite.keeper.GetSupplyInterestFactor(suite.ctx, "ukava")
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.05"), supplyInterestFactor)
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(21*KAVA_CF), supplied.AmountOf("ukava"))
+	deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, lender)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(21*KAVA_CF))), deposit.Amount)
+	allocations, _ = suite.keeper.GetStrategyAllocations(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10500000))), allocations)
+	suite.Require().Equal(sdk.NewInt(21*KAVA_CF), suite.keeper.GetCash(suite.ctx).AmountOf("ukava"))
+}
+
+func (suite *KeeperTestSuite) TestStrategyWithdrawalShortfall() {
+	lender := sdk.AccAddress(crypto.AddressHash([]byte("testlender")))
+	strategyAddr := sdk.AccAddress(crypto.AddressHash([]byte("teststrategy")))
+	strategy := suite.setupStrategyMarket(lender, strategyAddr)
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.keeper.RebalanceStrategies(suite.ctx)
+
+	// a strategy that cannot return the full allocation fails the withdrawal instead of leaving it unbacked
+	strategy.shortfall = sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1)))
+	cacheCtx, _ := suite.ctx.CacheContext()
+	err := suite.keeper.Withdraw(cacheCtx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(80*KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrStrategyWithdrawal))
+
+	// a failed rebalance leaves the allocation unchanged
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(40*KAVA_CF)))))
+	suite.keeper.RebalanceStrategies(suite.ctx)
+	allocations, _ := suite.keeper.GetStrategyAllocations(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF))), allocations)
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// swapSeizedDeposit attempts to sell a seized lot held by the hard module account through the swap module, raising
+// the bid amount. Any part of the lot that is not sold is returned to the borrower. If the lot is not eligible for swap
+// liquidation, or the sale cannot be made within the max slippage, no state is changed and false is returned so that
+// the lot can be auctioned instead.
+func (k Keeper) swapSeizedDeposit(ctx sdk.Context, borrower sdk.AccAddress, lot, bid sdk.Coin, liqMap map[string]LiqData) bool {
+	sl, found := k.GetSwapLiquidation(ctx, lot.Denom)
+	if !found || lot.Amount.GT(sl.MaxLotSize) {
+		return false
+	}
+
+	// run the sale in a cached context with a separate event manager so failed swaps leave no trace
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	sold, proceeds, ok := k.swapLot(cacheCtx, lot, bid, sl.MaxSlippage, liqMap)
+	if !ok {
+		return false
+	}
+
+	remaining := lot.Sub(sold)
+	if remaining.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleAccountName, borrower, sdk.NewCoins(remaining))
+		if err != nil {
+			return false
+		}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardLiquidationSwap,
+			sdk.NewAttribute(types.AttributeKeyLiquidatedOwner, borrower.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, sold.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, proceeds.String()),
+		),
+	)
+	return true
+}
+
+// swapLot sells a lot held by the hard module account through the swap module for the bid denom. Only as much of
+// the lot as is needed to raise the bid amount at market prices is sold, or all of it if it is worth less than the
+// bid. It returns the coins sold and the proceeds, or false if the sale cannot be made within the max slippage, in
+// which case the context should be discarded.
+func (k Keeper) swapLot(ctx sdk.Context, lot, bid sdk.Coin, maxSlippage sdk.Dec, liqMap map[string]LiqData) (sdk.Coin, sdk.Coin, bool) {
+	lData, found := liqMap[lot.Denom]
+	if !found || !lData.depositPrice.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	bData, found := liqMap[bid.Denom]
+	if !found || !bData.borrowPrice.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+
+	macc := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	balanceBefore := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins()
+
+	bidUsdValue := bid.Amount.ToDec().Quo(bData.conversionFactor.ToDec()).Mul(bData.borrowPrice)
+	expectedInput := bidUsdValue.Quo(lData.depositPrice).MulInt(lData.conversionFactor).Ceil().TruncateInt()
+
+	var err error
+	if expectedInput.IsPositive() && expectedInput.LTE(lot.Amount) {
+		// sell only as much of the lot as is needed to raise the bid, never spending more than the lot
+		slippage := sdk.MinDec(maxSlippage, lot.Amount.ToDec().Quo(expectedInput.ToDec()).Sub(sdk.OneDec()))
+		err = k.swapKeeper.SwapForExactTokens(ctx, macc, sdk.NewCoin(lot.Denom, expectedInput), bid, slippage)
+	} else {
+		// the lot is worth less than the bid, so sell all of it
+		lotUsdValue := lot.Amount.ToDec().Quo(lData.conversionFactor.ToDec()).Mul(lData.depositPrice)
+		expectedOutput := lotUsdValue.Quo(bData.borrowPrice).MulInt(bData.conversionFactor).TruncateInt()
+		if !expectedOutput.IsPositive() {
+			return sdk.Coin{}, sdk.Coin{}, false
+		}
+		err = k.swapKeeper.SwapExactForTokens(ctx, macc, lot, sdk.NewCoin(bid.Denom, expectedOutput), maxSlippage)
+	}
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+
+	balanceAfter := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins()
+	sold := sdk.NewCoin(lot.Denom, balanceBefore.AmountOf(lot.Denom).Sub(balanceAfter.AmountOf(lot.Denom)))
+	proceeds := sdk.NewCoin(bid.Denom, balanceAfter.AmountOf(bid.Denom).Sub(balanceBefore.AmountOf(bid.Denom)))
+	if sold.Amount.GT(lot.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, false
+	}
+	return sold, proceeds, true
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/kava-labs/kava/x/hard/types"
+	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
+)
+
+// GetVestingDeposit returns the part of a depositor's deposit made with vesting coins
+func (k Keeper) GetVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VestingDepositsPrefix)
+	bz := store.Get(depositor)
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var amount sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount, true
+}
+
+// SetVestingDeposit sets the part of a depositor's deposit made with vesting coins
+func (k Keeper) SetVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VestingDepositsPrefix)
+	if amount.Empty() {
+		store.Delete(depositor)
+		return
+	}
+	store.Set(depositor, k.cdc.MustMarshalBinaryBare(amount))
+}
+
+// IterateVestingDeposits iterates over all vesting deposits and performs a callback function
+func (k Keeper) IterateVestingDeposits(ctx sdk.Context, cb func(depositor sdk.AccAddress, amount sdk.Coins) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.VestingDepositsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		if cb(sdk.AccAddress(iterator.Key()), amount) {
+			break
+		}
+	}
+}
+
+// GetAllVestingDeposits returns the vesting deposits of all depositors
+func (k Keeper) GetAllVestingDeposits(ctx sdk.Context) types.VestingDeposits {
+	deposits := types.VestingDeposits{}
+	k.IterateVestingDeposits(ctx, func(depositor sdk.AccAddress, amount sdk.Coins) bool {
+		deposits = append(deposits, types.NewVestingDeposit(depositor, amount))
+		return false
+	})
+	return deposits
+}
+
+// ValidateVestingDeposit returns the vesting coins a deposit would use, which are the deposited coins that exceed the
+// depositor's spendable coins. Only periodic vesting accounts can deposit vesting coins. Validator vesting accounts
+// can only deposit vested coins, and cannot deposit at all while they owe coins from a failed vesting period, as those
+// coins are clawed back from the account's balance and delegations.
+func (k Keeper) ValidateVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) (sdk.Coins, error) {
+	acc := k.accountKeeper.GetAccount(ctx, depositor)
+	vacc, isVesting := acc.(vestexported.VestingAccount)
+	// deposits exceeding the account balance fail when the coins are sent
+	if !isVesting || !vacc.GetCoins().IsAllGTE(coins) {
+		return sdk.Coins{}, nil
+	}
+	if vva, ok := acc.(*validatorvesting.ValidatorVestingAccount); ok && !vva.DebtAfterFailedVesting.IsZero() {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidVestingDeposit,
+			"validator vesting account %s owes %s from a failed vesting period", depositor, vva.DebtAfterFailedVesting)
+	}
+
+	spendable := vacc.SpendableCoins(ctx.BlockTime())
+	vestingCoins := sdk.Coins{}
+	for _, coin := range coins {
+		if shortfall := coin.Amount.Sub(spendable.AmountOf(coin.Denom)); shortfall.IsPositive() {
+			vestingCoins = vestingCoins.Add(sdk.NewCoin(coin.Denom, shortfall))
+		}
+	}
+	if vestingCoins.Empty() {
+		return vestingCoins, nil
+	}
+	if _, ok := acc.(*validatorvesting.ValidatorVestingAccount); ok {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidVestingDeposit,
+			"%s of vesting coins from validator vesting account %s cannot be deposited, as they can be clawed back", vestingCoins, depositor)
+	}
+	if _, ok := acc.(*vesting.PeriodicVestingAccount); !ok {
+		return sdk.Coins{}, sdkerrors.Wrapf(types.ErrInvalidVestingDeposit,
+			"%s of vesting coins cannot be deposited from account type %T", vestingCoins, acc)
+	}
+	return vestingCoins, nil
+}
+
+// trackVestingDeposit adds the vesting coins used by a deposit to the depositor's delegated vesting coins, so they can
+// be sent to the hard module account, and records them so they are returned to the vesting balance when withdrawn
+func (k Keeper) trackVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress, vestingCoins sdk.Coins) {
+	if vestingCoins.Empty() {
+		return
+	}
+	pva := k.accountKeeper.GetAccount(ctx, depositor).(*vesting.PeriodicVestingAccount)
+	pva.DelegatedVesting = pva.DelegatedVesting.Add(vestingCoins...)
+	k.accountKeeper.SetAccount(ctx, pva)
+
+	amount, _ := k.GetVestingDeposit(ctx, depositor)
+	k.SetVestingDeposit(ctx, depositor, amount.Add(vestingCoins...))
+}
+
+// releaseVestingDeposit returns withdrawn coins to the depositor's vesting balance, up to the vesting coins they
+// deposited. Vesting coins are released before any interest, which is returned as spendable coins. Coins that vested
+// while deposited become spendable as the depositor's vesting schedule progresses.
+func (k Keeper) releaseVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress, withdrawn sdk.Coins) {
+	amount, found := k.GetVestingDeposit(ctx, depositor)
+	if !found {
+		return
+	}
+	pva, ok := k.accountKeeper.GetAccount(ctx, depositor).(*vesting.PeriodicVestingAccount)
+	if !ok {
+		return
+	}
+
+	released := sdk.Coins{}
+	for _, coin := range withdrawn {
+		releasedAmount := sdk.MinInt(coin.Amount, amount.AmountOf(coin.Denom))
+		// delegated vesting coins are shared with staking, which may have already reduced them
+		releasedAmount = sdk.MinInt(releasedAmount, pva.DelegatedVesting.AmountOf(coin.Denom))
+		if releasedAmount.IsPositive() {
+			released = released.Add(sdk.NewCoin(coin.Denom, releasedAmount))
+		}
+	}
+	if released.Empty() {
+		return
+	}
+	pva.DelegatedVesting = pva.DelegatedVesting.Sub(released)
+	k.accountKeeper.SetAccount(ctx, pva)
+	k.SetVestingDeposit(ctx, depositor, amount.Sub(released))
+}
+
+// capVestingDeposit limits a depositor's vesting deposit to the coins they have deposited, after deposits are seized
+// or deleted without being returned. As with slashed delegations, the coins stay tracked as delegated vesting coins.
+func (k Keeper) capVestingDeposit(ctx sdk.Context, depositor sdk.AccAddress, deposited sdk.Coins) {
+	amount, found := k.GetVestingDeposit(ctx, depositor)
+	if !found {
+		return
+	}
+	capped := sdk.Coins{}
+	for _, coin := range amount {
+		if cappedAmount := sdk.MinInt(coin.Amount, deposited.AmountOf(coin.Denom)); cappedAmount.IsPositive() {
+			capped = capped.Add(sdk.NewCoin(coin.Denom, cappedAmount))
+		}
+	}
+	if !capped.IsEqual(amount) {
+		k.SetVestingDeposit(ctx, depositor, capped)
+	}
+}
@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+	validatorvesting "github.com/kava-labs/kava/x/validator-vesting"
+)
+
+func (suite *KeeperTestSuite) TestVestingDeposit() {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	bacc := auth.NewBaseAccount(depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000)), nil, 0, 0)
+	bva, err := vesting.NewBaseVestingAccount(bacc, sdk.NewCoins(sdk.NewInt64Coin("bnb", 600)), genTime.Unix()+200)
+	suite.Require().NoError(err)
+	periods := vesting.Periods{
+		vesting.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("bnb", 300))},
+		vesting.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("bnb", 300))},
+	}
+	suite.initVestingDepositTest(genTime, vesting.NewPeriodicVestingAccountRaw(bva, genTime.Unix(), periods))
+
+	// 400 of the account's coins are spendable, so a deposit of 500 uses 100 vesting coins
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 500))))
+	suite.checkVestingDeposit(depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), sdk.Coins(nil))
+	vestingDeposit, found := suite.keeper.GetVestingDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)), vestingDeposit)
+
+	// withdrawn coins return to the vesting balance first
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50))))
+	suite.checkVestingDeposit(depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50)), sdk.Coins(nil))
+	vestingDeposit, found = suite.keeper.GetVestingDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 50)), vestingDeposit)
+
+	// accrue 50 bnb of interest on the deposit
+	suite.Require().NoError(suite.app.GetSupplyKeeper().MintCoins(suite.ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50))))
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	deposit.Amount = deposit.Amount.Add(sdk.NewInt64Coin("bnb", 50))
+	suite.keeper.SetDeposit(suite.ctx, deposit)
+	suite.keeper.IncrementSuppliedCoins(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin("bnb", 50)))
+
+	// after the remaining vesting coins are returned to the vesting balance, interest is returned as spendable coins
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 500))))
+	acc := suite.getAccount(depositor).(*vesting.PeriodicVestingAccount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1050)), acc.GetCoins())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 450)), acc.SpendableCoins(suite.ctx.BlockTime()))
+	suite.Require().True(acc.DelegatedVesting.IsZero())
+	_, found = suite.keeper.GetVestingDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+
+	// coins that vest while deposited are spendable when withdrawn
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1050))))
+	suite.checkVestingDeposit(depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 600)), sdk.Coins(nil))
+	suite.ctx = suite.ctx.WithBlockTime(genTime.Add(150 * time.Second))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1050))))
+	suite.checkVestingDeposit(depositor, sdk.Coins(nil), sdk.NewCoins(sdk.NewInt64Coin("bnb", 750)))
+	_, found = suite.keeper.GetVestingDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestValidateVestingDeposit() {
+	genTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("test")))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))
+	periods := vesting.Periods{
+		vesting.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("bnb", 500))},
+		vesting.Period{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("bnb", 500))},
+	}
+	newValidatorVestingAccount := func(debt sdk.Coins) authexported.Account {
+		vva := validatorvesting.NewValidatorVestingAccount(auth.NewBaseAccount(depositor, coins, nil, 0, 0),
+			genTime.Unix(), periods, sdk.ConsAddress(crypto.AddressHash([]byte("validator"))), nil, 90)
+		vva.VestingPeriodProgress[0] = validatorvesting.VestingProgress{PeriodComplete: true, VestingSuccessful: true}
+		vva.DebtAfterFailedVesting = debt
+		return vva
+	}
+	newContinuousVestingAccount := func() authexported.Account {
+		bva, err := vesting.NewBaseVestingAccount(auth.NewBaseAccount(depositor, coins, nil, 0, 0), coins, genTime.Unix()+200)
+		suite.Require().NoError(err)
+		return vesting.NewContinuousVestingAccountRaw(bva, genTime.Unix())
+	}
+
+	type args struct {
+		account              authexported.Account
+		blockTime            time.Time
+		amount               sdk.Coins
+		expectedVestingCoins sdk.Coins
+	}
+	type errArgs struct {
+		expectPass  bool
+		expectedErr error
+	}
+	testCases := []struct {
+		name    string
+		args    args
+		errArgs errArgs
+	}{
+		{
+			"base account",
+			args{
+				account:              auth.NewBaseAccount(depositor, coins, nil, 0, 0),
+				blockTime:            genTime,
+				amount:               sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000)),
+				expectedVestingCoins: sdk.Coins{},
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"validator vesting account with vested coins",
+			args{
+				account:              newValidatorVestingAccount(sdk.Coins{}),
+				blockTime:            genTime.Add(150 * time.Second),
+				amount:               sdk.NewCoins(sdk.NewInt64Coin("bnb", 500)),
+				expectedVestingCoins: sdk.Coins{},
+			},
+			errArgs{expectPass: true},
+		},
+		{
+			"validator vesting account with vesting coins",
+			args{
+				account:   newValidatorVestingAccount(sdk.Coins{}),
+				blockTime: genTime.Add(150 * time.Second),
+				amount:    sdk.NewCoins(sdk.NewInt64Coin("bnb", 600)),
+			},
+			errArgs{expectPass: false, expectedErr: types.ErrInvalidVestingDeposit},
+		},
+		{
+			"validator vesting account with debt",
+			args{
+				account:   newValidatorVestingAccount(sdk.NewCoins(sdk.NewInt64Coin("bnb", 100))),
+				blockTime: genTime.Add(150 * time.Second),
+				amount:    sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)),
+			},
+			errArgs{expectPass: false, expectedErr: types.ErrInvalidVestingDeposit},
+		},
+		{
+			"continuous vesting account with vesting coins",
+			args{
+				account:   newContinuousVestingAccount(),
+				blockTime: genTime.Add(100 * time.Second),
+				amount:    sdk.NewCoins(sdk.NewInt64Coin("bnb", 600)),
+			},
+			errArgs{expectPass: false, expectedErr: types.ErrInvalidVestingDeposit},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.initVestingDepositTest(genTime, tc.args.account)
+			suite.ctx = suite.ctx.WithBlockTime(tc.args.blockTime)
+
+			vestingCoins, err := suite.keeper.ValidateVestingDeposit(suite.ctx, depositor, tc.args.amount)
+			depositErr := suite.keeper.Deposit(suite.ctx, depositor, tc.args.amount)
+			if tc.errArgs.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.args.expectedVestingCoins, vestingCoins)
+				suite.Require().NoError(depositErr)
+			} else {
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
+				suite.Require().True(errors.Is(depositErr, tc.errArgs.expectedErr))
+			}
+		})
+	}
+}
+
+// initVestingDepositTest initializes the app with a bnb money market and the input depositor account
+func (suite *KeeperTestSuite) initVestingDepositTest(genTime time.Time, acc authexported.Account) {
+	tApp := app.NewTestApp()
+	authGS := app.NewAuthGenState([]sdk.AccAddress{acc.GetAddress()}, []sdk.Coins{acc.GetCoins()})
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{
+			types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")), "bnb:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")), sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec()),
+		},
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "bnb:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("10.00"),
+				Expiry:        genTime.Add(1 * time.Hour),
+			},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(genTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)},
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1, Time: genTime})
+
+	// replace the funded base account with the input account
+	ak := tApp.GetAccountKeeper()
+	existing := ak.GetAccount(ctx, acc.GetAddress())
+	suite.Require().NoError(acc.SetAccountNumber(existing.GetAccountNumber()))
+	ak.SetAccount(ctx, acc)
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+	hard.BeginBlocker(suite.ctx, suite.keeper)
+}
+
+// checkVestingDeposit checks the depositor's delegated vesting and spendable coins
+func (suite *KeeperTestSuite) checkVestingDeposit(depositor sdk.AccAddress, delegatedVesting, spendable sdk.Coins) {
+	acc := suite.getAccount(depositor).(*vesting.PeriodicVestingAccount)
+	suite.Require().Equal(delegatedVesting, acc.DelegatedVesting)
+	suite.Require().Equal(spendable, acc.SpendableCoins(suite.ctx.BlockTime()))
+}
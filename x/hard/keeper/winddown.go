@@ -0,0 +1,302 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// DeprecateMoneyMarket marks a money market as deprecated and sets the deadline for its remaining positions to be closed
+func (k Keeper) DeprecateMoneyMarket(ctx sdk.Context, windDown types.MoneyMarketWindDown) error {
+	if err := windDown.Validate(); err != nil {
+		return err
+	}
+	if _, found := k.GetMoneyMarketParam(ctx, windDown.Denom); !found {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", windDown.Denom)
+	}
+	if k.IsMoneyMarketDeprecated(ctx, windDown.Denom) {
+		return sdkerrors.Wrapf(types.ErrMoneyMarketDeprecated, "%s", windDown.Denom)
+	}
+	if !windDown.Deadline.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrInvalidWindDownDeadline, "%s is not after block time %s", windDown.Deadline, ctx.BlockTime())
+	}
+
+	k.SetMoneyMarketWindDown(ctx, windDown)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardMoneyMarketDeprecated,
+			sdk.NewAttribute(types.AttributeKeyDenom, windDown.Denom),
+			sdk.NewAttribute(types.AttributeKeyWindDownDeadline, windDown.Deadline.Format(time.RFC3339)),
+		),
+	)
+	return nil
+}
+
+// IsMoneyMarketDeprecated returns true if a money market is being wound down
+func (k Keeper) IsMoneyMarketDeprecated(ctx sdk.Context, denom string) bool {
+	_, found := k.GetMoneyMarketWindDown(ctx, denom)
+	return found
+}
+
+// ProcessMoneyMarketWindDowns closes the remaining positions of deprecated money markets once their deadline has
+// passed. Positions that borrow the denom, or use it as collateral for a borrow, are liquidated in full, then deposits
+// of the denom are returned to their owners, with protocol liquidity returned to the community pool. Deposits that
+// cannot be returned yet, for example while seized collateral is still at auction, are retried in later blocks. The
+// market is removed from the params once no positions remain.
+func (k Keeper) ProcessMoneyMarketWindDowns(ctx sdk.Context) {
+	var expired types.MoneyMarketWindDowns
+	k.IterateMoneyMarketWindDowns(ctx, func(windDown types.MoneyMarketWindDown) bool {
+		if windDown.IsPastDeadline(ctx.BlockTime()) {
+			expired = append(expired, windDown)
+		}
+		return false
+	})
+
+	for _, windDown := range expired {
+		denom := windDown.Denom
+
+		for _, borrower := range k.getWindDownBorrowers(ctx, denom) {
+			k.applyWindDownStep(ctx, func(cacheCtx sdk.Context) error {
+				return k.liquidateWindDownPosition(cacheCtx, borrower)
+			}, "failed to liquidate position in deprecated money market", denom, borrower)
+		}
+
+		var depositors []sdk.AccAddress
+		k.IterateDepositsByDenom(ctx, denom, func(deposit types.Deposit) bool {
+			depositors = append(depositors, deposit.Depositor)
+			return false
+		})
+		for _, depositor := range depositors {
+			if depositor.Equals(types.ProtocolLiquidityAddress) {
+				k.applyWindDownStep(ctx, func(cacheCtx sdk.Context) error {
+					return k.withdrawAllProtocolLiquidity(cacheCtx, denom)
+				}, "failed to return protocol liquidity in deprecated money market", denom, depositor)
+				continue
+			}
+			k.applyWindDownStep(ctx, func(cacheCtx sdk.Context) error {
+				return k.forceWithdraw(cacheCtx, depositor, denom)
+			}, "failed to return deposit in deprecated money market", denom, depositor)
+		}
+
+		progress := k.GetWindDownProgress(ctx, windDown)
+		if progress.Depositors > 0 || progress.Borrowers > 0 {
+			continue
+		}
+
+		params := k.GetParams(ctx)
+		var moneyMarkets types.MoneyMarkets
+		for _, mm := range params.MoneyMarkets {
+			if mm.Denom != denom {
+				moneyMarkets = append(moneyMarkets, mm)
+			}
+		}
+		params.MoneyMarkets = moneyMarkets
+		k.SetParams(ctx, params)
+		k.DeleteMoneyMarketWindDown(ctx, denom)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeHardMoneyMarketDelisted,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			),
+		)
+	}
+}
+
+// applyWindDownStep runs a step of a wind down in a cached context, discarding its state and events if it fails
+func (k Keeper) applyWindDownStep(ctx sdk.Context, step func(sdk.Context) error, msg, denom string, owner sdk.AccAddress) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := step(cacheCtx); err != nil {
+		k.Logger(ctx).Error(msg, "denom", denom, "owner", owner, "err", err.Error())
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// getWindDownBorrowers returns the borrowers whose positions depend on a deprecated money market, either by
+// borrowing its denom or by holding it as collateral
+func (k Keeper) getWindDownBorrowers(ctx sdk.Context, denom string) []sdk.AccAddress {
+	var borrowers []sdk.AccAddress
+	seen := make(map[string]bool)
+	k.IterateBorrowersByDenom(ctx, denom, func(borrower sdk.AccAddress) bool {
+		borrowers = append(borrowers, borrower)
+		seen[borrower.String()] = true
+		return false
+	})
+	k.IterateDepositsByDenom(ctx, denom, func(deposit types.Deposit) bool {
+		if seen[deposit.Depositor.String()] {
+			return false
+		}
+		if _, found := k.GetBorrow(ctx, deposit.Depositor); found {
+			borrowers = append(borrowers, deposit.Depositor)
+		}
+		return false
+	})
+	return borrowers
+}
+
+// liquidateWindDownPosition liquidates a borrower's full position regardless of its LTV. No keeper reward is paid.
+func (k Keeper) liquidateWindDownPosition(ctx sdk.Context, borrower sdk.AccAddress) error {
+	deposit, found := k.GetDeposit(ctx, borrower)
+	if !found {
+		return types.ErrDepositNotFound
+	}
+	borrow, found := k.GetBorrow(ctx, borrower)
+	if !found {
+		return types.ErrBorrowNotFound
+	}
+
+	if err := k.SyncMoneyMarketInterest(ctx, deposit.Amount.Add(borrow.Amount...)); err != nil {
+		return err
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.BeforeBorrowModified(ctx, borrow)
+
+	k.SyncBorrowInterest(ctx, borrower)
+	k.SyncSupplyInterest(ctx, borrower)
+
+	deposit, _ = k.GetDeposit(ctx, borrower)
+	borrow, _ = k.GetBorrow(ctx, borrower)
+	// the position is liquidated even if its prices are unavailable, in which case its health factor is reported as zero
+	healthFactor, _ := k.CalculateHealthFactor(ctx, deposit, borrow)
+
+	result, err := k.SeizeDeposits(ctx, nil, deposit, borrow, getDenoms(deposit.Amount), getDenoms(borrow.Amount))
+	if err != nil {
+		return err
+	}
+	k.recordLiquidation(ctx)
+	k.Logger(ctx).Info("liquidated position in deprecated money market", "borrower", borrower, "seized_deposit", deposit.Amount, "seized_borrow", borrow.Amount)
+
+	k.DeleteDeposit(ctx, deposit)
+	k.DeleteBorrow(ctx, borrow)
+	k.emitLiquidationEvent(ctx, nil, healthFactor, deposit, result, types.Deposit{}, types.Borrow{})
+	return nil
+}
+
+// forceWithdraw returns a depositor's full deposit of a denom without charging a withdraw fee
+func (k Keeper) forceWithdraw(ctx sdk.Context, depositor sdk.AccAddress, denom string) error {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", depositor)
+	}
+	if _, found := k.GetBorrow(ctx, depositor); found {
+		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "%s has an open borrow", depositor)
+	}
+	if err := k.SyncMoneyMarketInterest(ctx, deposit.Amount); err != nil {
+		return err
+	}
+
+	// Call incentive hooks
+	k.BeforeDepositModified(ctx, deposit)
+	k.SyncSupplyInterest(ctx, depositor)
+	deposit, _ = k.GetDeposit(ctx, depositor)
+
+	amount := sdk.NewCoins(sdk.NewCoin(denom, deposit.Amount.AmountOf(denom)))
+	if err := k.recallStrategyAllocations(ctx, amount); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount); err != nil {
+		return err
+	}
+	k.releaseVestingDeposit(ctx, depositor, amount)
+
+	depositIndex, removed := deposit.Index.RemoveInterestFactor(denom)
+	if !removed {
+		return sdkerrors.Wrapf(types.ErrInvalidIndexFactorDenom, "%s", denom)
+	}
+	deposit.Index = depositIndex
+	deposit.Amount = deposit.Amount.Sub(amount)
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	k.DecrementSuppliedCoins(ctx, amount)
+
+	// Call incentive hook
+	k.AfterDepositModified(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHardForcedWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// GetWindDownProgress returns the positions that remain open in a deprecated money market
+func (k Keeper) GetWindDownProgress(ctx sdk.Context, windDown types.MoneyMarketWindDown) types.WindDownProgress {
+	supplied, _ := k.GetSuppliedCoins(ctx)
+	borrowed, _ := k.GetBorrowedCoins(ctx)
+
+	depositors := 0
+	k.IterateDepositsByDenom(ctx, windDown.Denom, func(types.Deposit) bool {
+		depositors++
+		return false
+	})
+	borrowers := 0
+	k.IterateBorrowersByDenom(ctx, windDown.Denom, func(sdk.AccAddress) bool {
+		borrowers++
+		return false
+	})
+	return types.NewWindDownProgress(windDown, supplied.AmountOf(windDown.Denom), borrowed.AmountOf(windDown.Denom), depositors, borrowers)
+}
+
+// GetMoneyMarketWindDown returns the wind down of a deprecated money market
+func (k Keeper) GetMoneyMarketWindDown(ctx sdk.Context, denom string) (types.MoneyMarketWindDown, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketWindDownsPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.MoneyMarketWindDown{}, false
+	}
+	var windDown types.MoneyMarketWindDown
+	k.cdc.MustUnmarshalBinaryBare(bz, &windDown)
+	return windDown, true
+}
+
+// SetMoneyMarketWindDown sets the wind down of a deprecated money market in the store
+func (k Keeper) SetMoneyMarketWindDown(ctx sdk.Context, windDown types.MoneyMarketWindDown) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketWindDownsPrefix)
+	store.Set([]byte(windDown.Denom), k.cdc.MustMarshalBinaryBare(windDown))
+}
+
+// DeleteMoneyMarketWindDown deletes the wind down of a money market from the store
+func (k Keeper) DeleteMoneyMarketWindDown(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketWindDownsPrefix)
+	store.Delete([]byte(denom))
+}
+
+// IterateMoneyMarketWindDowns iterates over the wind downs of all deprecated money markets and performs a callback function
+func (k Keeper) IterateMoneyMarketWindDowns(ctx sdk.Context, cb func(windDown types.MoneyMarketWindDown) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MoneyMarketWindDownsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var windDown types.MoneyMarketWindDown
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &windDown)
+		if cb(windDown) {
+			break
+		}
+	}
+}
+
+// GetAllMoneyMarketWindDowns returns the wind downs of all deprecated money markets
+func (k Keeper) GetAllMoneyMarketWindDowns(ctx sdk.Context) types.MoneyMarketWindDowns {
+	windDowns := types.MoneyMarketWindDowns{}
+	k.IterateMoneyMarketWindDowns(ctx, func(windDown types.MoneyMarketWindDown) bool {
+		windDowns = append(windDowns, windDown)
+		return false
+	})
+	return windDowns
+}
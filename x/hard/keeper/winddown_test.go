@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func (suite *KeeperTestSuite) TestMoneyMarketWindDown() {
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("borrower")))
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("depositor")))
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	authGS := app.NewAuthGenState([]sdk.AccAddress{borrower, depositor}, []sdk.Coins{coins, coins})
+
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0"), sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.5"))
+	hardGS := types.NewGenesisState(types.NewParams(types.MoneyMarkets{
+		types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*USDX_CF), sdk.MustNewDecFromStr("0.9")),
+			"usdx:usd", sdk.NewInt(USDX_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+		types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")),
+			"kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("0.05")),
+	}), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{MarketID: "usdx:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("1.00"), Expiry: blockTime.Add(100 * time.Hour)},
+			{MarketID: "kava:usd", OracleAddress: sdk.AccAddress{}, Price: sdk.MustNewDecFromStr("2.00"), Expiry: blockTime.Add(100 * time.Hour)},
+		},
+	}
+	tApp.InitializeFromGenesisStatesWithTime(blockTime, authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	tApp.GetSupplyKeeper().MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF))))
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	// the borrower uses kava as collateral, the depositor only supplies kava
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(8*USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF)))))
+
+	deadline := blockTime.Add(7 * 24 * time.Hour)
+	testCases := []struct {
+		name        string
+		windDown    types.MoneyMarketWindDown
+		expectedErr error
+	}{
+		{"unknown money market", types.NewMoneyMarketWindDown("bnb", deadline), types.ErrMoneyMarketNotFound},
+		{"deadline not in future", types.NewMoneyMarketWindDown("ukava", blockTime), types.ErrInvalidWindDownDeadline},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.keeper.DeprecateMoneyMarket(suite.ctx, tc.windDown)
+			suite.Require().True(errors.Is(err, tc.expectedErr))
+		})
+	}
+	suite.Require().NoError(suite.keeper.DeprecateMoneyMarket(suite.ctx, types.NewMoneyMarketWindDown("ukava", deadline)))
+	err := suite.keeper.DeprecateMoneyMarket(suite.ctx, types.NewMoneyMarketWindDown("ukava", deadline))
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketDeprecated))
+
+	// deprecated markets accept no new deposits or borrows, but existing positions can still be closed
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketDeprecated))
+	err = suite.keeper.Borrow(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))))
+	suite.Require().True(errors.Is(err, types.ErrMoneyMarketDeprecated))
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))))
+
+	// nothing is closed before the deadline
+	suite.keeper.ProcessMoneyMarketWindDowns(suite.ctx.WithBlockTime(deadline.Add(-time.Second)))
+	progress := suite.keeper.GetWindDownProgress(suite.ctx, types.NewMoneyMarketWindDown("ukava", deadline))
+	suite.Require().Equal(2, progress.Depositors)
+	suite.Require().Equal(sdk.NewInt(19*KAVA_CF), progress.TotalSupplied)
+
+	suite.keeper.ProcessMoneyMarketWindDowns(suite.ctx.WithBlockTime(deadline))
+	_, found := suite.keeper.GetBorrow(suite.ctx, borrower)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+	suite.Require().Equal(sdk.NewInt(100*KAVA_CF), suite.getAccount(depositor).GetCoins().AmountOf("ukava"))
+	suite.Require().NotEmpty(tApp.GetAuctionKeeper().GetAllAuctions(suite.ctx))
+
+	// the market is removed once no positions remain
+	_, found = suite.keeper.GetMoneyMarketParam(suite.ctx, "ukava")
+	suite.Require().False(found)
+	suite.Require().False(suite.keeper.IsMoneyMarketDeprecated(suite.ctx, "ukava"))
+}
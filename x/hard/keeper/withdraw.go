@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	hardmath "github.com/kava-labs/kava/x/hard/math"
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
@@ -13,6 +14,11 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	if !found {
 		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", depositor)
 	}
+	// Accrue interest on the markets being modified
+	if err := k.SyncMoneyMarketInterest(ctx, coins); err != nil {
+		return err
+	}
+
 	// Call incentive hooks
 	k.BeforeDepositModified(ctx, deposit)
 	existingBorrow, hasExistingBorrow := k.GetBorrow(ctx, depositor)
@@ -34,19 +40,33 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	}
 
 	proposedDeposit := types.NewDeposit(deposit.Depositor, deposit.Amount.Sub(amount), types.SupplyInterestFactors{})
-	valid, err := k.IsWithinValidLtvRange(ctx, proposedDeposit, borrow)
+	borrowableUSD, borrowedUSD, err := k.getLtvUSDValues(ctx, proposedDeposit, borrow)
 	if err != nil {
 		return err
 	}
-	if !valid {
-		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "proposed withdraw outside loan-to-value range")
+	if healthFactor := types.CalculateHealthFactor(borrowedUSD, borrowableUSD); types.IsLiquidatable(healthFactor) {
+		return sdkerrors.Wrapf(types.ErrInsufficientLoanToValue,
+			"proposed withdraw would result in health factor %s: borrows of %s USD would exceed the borrowable %s USD after withdrawing %s",
+			healthFactor, borrowedUSD, borrowableUSD, amount)
+	}
+	if err := k.validateRiskTiers(ctx, proposedDeposit, borrow); err != nil {
+		return err
 	}
 
-	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount)
+	fees, err := k.CalculateWithdrawFees(ctx, amount)
 	if err != nil {
 		return err
 	}
 
+	if err := k.recallStrategyAllocations(ctx, amount.Sub(fees)); err != nil {
+		return err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, amount.Sub(fees))
+	if err != nil {
+		return err
+	}
+	k.releaseVestingDeposit(ctx, depositor, amount.Sub(fees))
+
 	// If any coin denoms have been completely withdrawn reset the denom's supply index factor
 	for _, coin := range deposit.Amount {
 		if !sdk.NewCoins(coin).DenomsSubsetOf(proposedDeposit.Amount) {
@@ -66,6 +86,11 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 	}
 	// Update total supplied amount
 	k.DecrementSuppliedCoins(ctx, amount)
+	// Withdraw fees stay in the module account as reserves
+	if !fees.IsZero() {
+		reserves, _ := k.GetTotalReserves(ctx)
+		k.SetTotalReserves(ctx, reserves.Add(fees...))
+	}
 
 	// Call incentive hook
 	k.AfterDepositModified(ctx, deposit)
@@ -75,9 +100,36 @@ func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Co
 			types.EventTypeHardWithdrawal,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawFee, fees.String()),
 		),
 	)
-	return nil
+	k.recordWithdrawalStats(ctx, amount)
+	return k.emitHealthFactorWarning(ctx, deposit, borrow)
+}
+
+// CalculateWithdrawFees returns the fees kept from a withdrawal. Each money market's fee rate is set from the
+// utilization the market would have after the withdrawal, so withdrawals that drain a highly utilized market pay
+// the most.
+func (k Keeper) CalculateWithdrawFees(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, error) {
+	cash := k.GetCash(ctx)
+	borrowed, _ := k.GetBorrowedCoins(ctx)
+	reserves, _ := k.GetTotalReserves(ctx)
+
+	fees := sdk.NewCoins()
+	for _, coin := range amount {
+		mm, found := k.GetMoneyMarket(ctx, coin.Denom)
+		if !found {
+			return sdk.Coins{}, sdkerrors.Wrapf(types.ErrMoneyMarketNotFound, "%s", coin.Denom)
+		}
+		utilization := hardmath.CalculateUtilizationRatio(
+			cash.AmountOf(coin.Denom).Sub(coin.Amount).ToDec(),
+			borrowed.AmountOf(coin.Denom).ToDec(),
+			reserves.AmountOf(coin.Denom).ToDec(),
+		)
+		fee := coin.Amount.ToDec().Mul(mm.WithdrawFeeRate(utilization)).TruncateInt()
+		fees = fees.Add(sdk.NewCoin(coin.Denom, fee))
+	}
+	return fees, nil
 }
 
 // CalculateWithdrawAmount enables full withdraw of deposited coins by adjusting withdraw amount
@@ -86,7 +138,7 @@ func (k Keeper) CalculateWithdrawAmount(available sdk.Coins, request sdk.Coins)
 	result := sdk.Coins{}
 
 	if !request.DenomsSubsetOf(available) {
-		return result, types.ErrInvalidWithdrawDenom
+		return result, sdkerrors.Wrapf(types.ErrInvalidWithdrawDenom, "requested %s, deposited %s", request, available)
 	}
 
 	for _, coin := range request {
@@ -1,7 +1,7 @@
 package keeper_test
 
 import (
-	"strings"
+	"errors"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,7 +29,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 	type errArgs struct {
 		expectPass   bool
 		expectDelete bool
-		contains     string
+		expectedErr  error
 	}
 	type withdrawTest struct {
 		name    string
@@ -52,7 +52,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: false,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -70,7 +70,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: true,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -88,7 +88,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 			errArgs{
 				expectPass:   true,
 				expectDelete: true,
-				contains:     "",
+				expectedErr:  nil,
 			},
 		},
 		{
@@ -106,7 +106,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 			errArgs{
 				expectPass:   false,
 				expectDelete: false,
-				contains:     "no coins of this type deposited",
+				expectedErr:  types.ErrInvalidWithdrawDenom,
 			},
 		},
 	}
@@ -198,7 +198,7 @@ func (suite *KeeperTestSuite) TestWithdraw() {
 				}
 			} else {
 				suite.Require().Error(err)
-				suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+				suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 			}
 		})
 
@@ -216,8 +216,8 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 	}
 
 	type errArgs struct {
-		expectPass bool
-		contains   string
+		expectPass  bool
+		expectedErr error
 	}
 
 	type liqTest struct {
@@ -244,8 +244,8 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 				futureTime:           oneMonthInSeconds,
 			},
 			errArgs{
-				expectPass: false,
-				contains:   "proposed withdraw outside loan-to-value range",
+				expectPass:  false,
+				expectedErr: types.ErrInsufficientLoanToValue,
 			},
 		},
 	}
@@ -341,7 +341,7 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 			// Attempting to withdraw fails
 			err = suite.keeper.Withdraw(suite.ctx, tc.args.borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.OneInt())))
 			suite.Require().Error(err)
-			suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+			suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 
 			// Set up future chain context and run begin blocker, increasing user's owed borrow balance
 			runAtTime := time.Unix(suite.ctx.BlockTime().Unix()+(tc.args.futureTime), 0)
@@ -351,7 +351,7 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 			// Attempted withdraw of 1 coin still fails
 			err = suite.keeper.Withdraw(suite.ctx, tc.args.borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.OneInt())))
 			suite.Require().Error(err)
-			suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+			suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 
 			// Repay the initial principal
 			err = suite.keeper.Repay(suite.ctx, tc.args.borrower, tc.args.borrower, tc.args.borrowCoins)
@@ -360,7 +360,7 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 			// Attempted withdraw of all deposited coins fails as user hasn't repaid interest debt
 			err = suite.keeper.Withdraw(suite.ctx, tc.args.borrower, tc.args.depositCoins)
 			suite.Require().Error(err)
-			suite.Require().True(strings.Contains(err.Error(), tc.errArgs.contains))
+			suite.Require().True(errors.Is(err, tc.errArgs.expectedErr))
 
 			// Withdrawing half the coins should succeed
 			withdrawCoins := sdk.NewCoins(sdk.NewCoin("ukava", tc.args.depositCoins[0].Amount.Quo(sdk.NewInt(2))))
@@ -369,3 +369,75 @@ func (suite *KeeperTestSuite) TestLtvWithdraw() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestWithdrawFee() {
+	lender := sdk.AccAddress(crypto.AddressHash([]byte("testlender")))
+	borrower := sdk.AccAddress(crypto.AddressHash([]byte("testborrower")))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	authGS := app.NewAuthGenState(
+		[]sdk.AccAddress{lender, borrower},
+		[]sdk.Coins{
+			sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF))),
+			sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*KAVA_CF))),
+		},
+	)
+
+	// the withdraw fee starts at 50% utilization and reaches 10% at full utilization
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("10"))
+	kavaMarket := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "kava:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	kavaMarket.WithdrawFee = sdk.MustNewDecFromStr("0.1")
+	usdxMarket := types.NewMoneyMarket("usdx", types.NewBorrowLimit(false, sdk.NewDec(100000000*KAVA_CF), sdk.MustNewDecFromStr("0.8")), "usdx:usd", sdk.NewInt(KAVA_CF), model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	hardGS := types.NewGenesisState(types.NewParams(
+		types.MoneyMarkets{kavaMarket, usdxMarket},
+	), types.DefaultAccumulationTimes, types.DefaultDeposits, types.DefaultBorrows,
+		types.DefaultTotalSupplied, types.DefaultTotalBorrowed, types.DefaultTotalReserves,
+	)
+
+	pricefeedGS := pricefeed.GenesisState{
+		Params: pricefeed.Params{
+			Markets: []pricefeed.Market{
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			},
+		},
+		PostedPrices: []pricefeed.PostedPrice{
+			{
+				MarketID:      "usdx:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("1.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+			{
+				MarketID:      "kava:usd",
+				OracleAddress: sdk.AccAddress{},
+				Price:         sdk.MustNewDecFromStr("2.00"),
+				Expiry:        time.Now().Add(100 * time.Hour),
+			},
+		},
+	}
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pricefeedGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(hardGS)})
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetHardKeeper()
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*KAVA_CF)))))
+	suite.Require().NoError(suite.keeper.Borrow(suite.ctx, borrower, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(60*KAVA_CF)))))
+
+	// withdrawing 20 of the 40 ukava available leaves the market 75% utilized, for a fee of 5%
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, lender, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(20*KAVA_CF)))))
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(19*KAVA_CF))), suite.getAccount(lender).GetCoins())
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, lender)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(80*KAVA_CF))), deposit.Amount)
+	reserves, _ := suite.keeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF))), reserves)
+	supplied, _ := suite.keeper.GetSuppliedCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(80*KAVA_CF), supplied.AmountOf("ukava"))
+}
@@ -0,0 +1,17 @@
+/*
+Package math implements the interest math of the hard money markets as pure functions.
+
+The functions take and return plain decimal values and have no dependency on a context or store, so they can be
+reused by clients that estimate interest off chain and property-tested independently of the keeper. The hard keeper
+uses them when accruing interest on money markets and when synchronizing positions with the accrued interest.
+
+Interest rates are represented in two forms:
+
+	APY: the annual rate expressed as 1 + rate, for example 1.10 for 10%
+	SPY: the per second rate that compounds to the APY over a year, expressed the same way
+
+Interest factors start at 1.0 for each money market and are multiplied by the interest accrued each period. The
+interest owed on a position is calculated from the ratio of the current factor to the factor the position was last
+synchronized at.
+*/
+package math
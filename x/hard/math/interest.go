@@ -0,0 +1,114 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+const (
+	// ScalingFactor is the precision interest factors are compounded at
+	ScalingFactor = 1e18
+	// SecondsPerYear is the number of seconds an APY compounds over
+	SecondsPerYear = 31536000
+)
+
+// APYToSPY converts the input annual interest rate. For example, 10% apy would be passed as 1.10.
+// SPY = Per second compounded interest rate is how cosmos mathematically represents APY.
+func APYToSPY(apy sdk.Dec) (sdk.Dec, error) {
+	// Note: any APY 179 or greater will cause an out-of-bounds error
+	root, err := apy.ApproxRoot(uint64(SecondsPerYear))
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	return root, nil
+}
+
+// SPYToEstimatedAPY converts the internal per second compounded interest rate into an estimated annual
+// interest rate. The returned value is an estimate  and should not be used for financial calculations.
+func SPYToEstimatedAPY(apy sdk.Dec) sdk.Dec {
+	return apy.Power(uint64(SecondsPerYear))
+}
+
+// CalculateUtilizationRatio calculates an asset's current utilization rate
+func CalculateUtilizationRatio(cash, borrows, reserves sdk.Dec) sdk.Dec {
+	// Utilization rate is 0 when there are no borrows
+	if borrows.Equal(sdk.ZeroDec()) {
+		return sdk.ZeroDec()
+	}
+
+	totalSupply := cash.Add(borrows).Sub(reserves)
+	if totalSupply.IsNegative() {
+		return sdk.OneDec()
+	}
+
+	return sdk.MinDec(sdk.OneDec(), borrows.Quo(totalSupply))
+}
+
+// CalculateBorrowRate calculates the borrow rate, which is the current APY expressed as a decimal
+// based on the current utilization.
+func CalculateBorrowRate(model types.InterestRateModel, cash, borrows, reserves sdk.Dec) (sdk.Dec, error) {
+	utilRatio := CalculateUtilizationRatio(cash, borrows, reserves)
+	return CalculateBorrowRateAtUtilization(model, utilRatio), nil
+}
+
+// CalculateBorrowRateAtUtilization calculates the borrow rate the interest rate model sets at a utilization ratio
+func CalculateBorrowRateAtUtilization(model types.InterestRateModel, utilRatio sdk.Dec) sdk.Dec {
+	// Calculate normal borrow rate (under kink)
+	if utilRatio.LTE(model.Kink) {
+		return utilRatio.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
+	}
+
+	// Calculate jump borrow rate (over kink)
+	normalRate := model.Kink.Mul(model.BaseMultiplier).Add(model.BaseRateAPY)
+	excessUtil := utilRatio.Sub(model.Kink)
+	return excessUtil.Mul(model.JumpMultiplier).Add(normalRate)
+}
+
+// CalculateBorrowInterestFactor calculates the simple interest scaling factor,
+// which is equal to: (per-second interest rate * number of seconds elapsed)
+// Will return 1.000x, multiply by principal to get new principal with added interest
+func CalculateBorrowInterestFactor(perSecondInterestRate sdk.Dec, secondsElapsed sdk.Int) sdk.Dec {
+	scalingFactorUint := sdk.NewUint(uint64(ScalingFactor))
+	scalingFactorInt := sdk.NewInt(int64(ScalingFactor))
+
+	// Convert per-second interest rate to a uint scaled by 1e18
+	interestMantissa := sdk.NewUint(perSecondInterestRate.MulInt(scalingFactorInt).RoundInt().Uint64())
+	// Convert seconds elapsed to uint (*not scaled*)
+	secondsElapsedUint := sdk.NewUint(secondsElapsed.Uint64())
+	// Calculate the interest factor as a uint scaled by 1e18
+	interestFactorMantissa := sdk.RelativePow(interestMantissa, secondsElapsedUint, scalingFactorUint)
+
+	// Convert interest factor to an unscaled sdk.Dec
+	return sdk.NewDecFromBigInt(interestFactorMantissa.BigInt()).QuoInt(scalingFactorInt)
+}
+
+// CalculateSupplyInterestFactor calculates the supply interest factor, which is the percentage of borrow interest
+// that flows to each unit of supply, i.e. at 50% utilization and 0% reserve factor, a 5% borrow interest will
+// correspond to a 2.5% supply interest.
+func CalculateSupplyInterestFactor(newInterest, cash, borrows, reserves sdk.Dec) sdk.Dec {
+	totalSupply := cash.Add(borrows).Sub(reserves)
+	if totalSupply.IsZero() {
+		return sdk.OneDec()
+	}
+	return (newInterest.Quo(totalSupply)).Add(sdk.OneDec())
+}
+
+// CompoundInterestFactor returns the interest factor of a money market after a period with the given period factor
+func CompoundInterestFactor(prior, periodFactor sdk.Dec) sdk.Dec {
+	return prior.Mul(periodFactor)
+}
+
+// CalculateBorrowInterest returns the interest owed on a borrowed amount that was last synchronized at
+// lastFactor, now that the money market's borrow interest factor is currentFactor
+func CalculateBorrowInterest(amount sdk.Int, lastFactor, currentFactor sdk.Dec) sdk.Dec {
+	storedAmount := sdk.NewDecFromInt(amount)
+	return (storedAmount.Quo(lastFactor).Mul(currentFactor)).Sub(storedAmount)
+}
+
+// CalculateSupplyInterest returns the interest earned on a supplied amount that was last synchronized at
+// lastFactor, now that the money market's supply interest factor is currentFactor
+func CalculateSupplyInterest(amount sdk.Int, lastFactor, currentFactor sdk.Dec) sdk.Dec {
+	storedAmount := sdk.NewDecFromInt(amount)
+	return (storedAmount.Mul(currentFactor).Quo(lastFactor)).Sub(storedAmount)
+}
@@ -0,0 +1,125 @@
+package math_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hardmath "github.com/kava-labs/kava/x/hard/math"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// number of random inputs each property is checked against
+const propertyRuns = 200
+
+// randDec returns a random decimal in [min, max) with 18 decimal places
+func randDec(r *rand.Rand, min, max sdk.Dec) sdk.Dec {
+	return min.Add(max.Sub(min).MulInt64(r.Int63n(1e18)).QuoInt64(1e18))
+}
+
+// requireApproxEqual checks that two decimals are within a relative tolerance of each other
+func requireApproxEqual(t *testing.T, expected, actual, tolerance sdk.Dec) {
+	diff := expected.Sub(actual).Abs()
+	require.Truef(t, diff.LTE(expected.Abs().Mul(tolerance)), "expected %s, got %s", expected, actual)
+}
+
+func TestAPYToSPY_RoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		apy := randDec(r, sdk.OneDec(), sdk.NewDec(2))
+		spy, err := hardmath.APYToSPY(apy)
+		require.NoError(t, err)
+		require.True(t, spy.GTE(sdk.OneDec()))
+		requireApproxEqual(t, apy, hardmath.SPYToEstimatedAPY(spy), sdk.MustNewDecFromStr("0.000001"))
+	}
+}
+
+func TestCalculateUtilizationRatio_Bounds(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for i := 0; i < propertyRuns; i++ {
+		cash := randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12))
+		borrows := randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12))
+		reserves := randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12))
+		ratio := hardmath.CalculateUtilizationRatio(cash, borrows, reserves)
+		require.True(t, ratio.GTE(sdk.ZeroDec()) && ratio.LTE(sdk.OneDec()), "utilization ratio %s out of bounds", ratio)
+	}
+}
+
+func TestCalculateBorrowRate_Monotonic(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < propertyRuns; i++ {
+		model := types.NewInterestRateModel(
+			randDec(r, sdk.ZeroDec(), sdk.OneDec()),
+			randDec(r, sdk.ZeroDec(), sdk.NewDec(2)),
+			randDec(r, sdk.ZeroDec(), sdk.OneDec()),
+			randDec(r, sdk.ZeroDec(), sdk.NewDec(10)),
+		)
+		cash := randDec(r, sdk.OneDec(), sdk.NewDec(1e12))
+		borrows := randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12))
+		moreBorrows := borrows.Add(randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12)))
+
+		rate, err := hardmath.CalculateBorrowRate(model, cash, borrows, sdk.ZeroDec())
+		require.NoError(t, err)
+		higherRate, err := hardmath.CalculateBorrowRate(model, cash, moreBorrows, sdk.ZeroDec())
+		require.NoError(t, err)
+		require.True(t, rate.GTE(model.BaseRateAPY))
+		require.True(t, higherRate.GTE(rate), "borrow rate decreased from %s to %s as utilization increased", rate, higherRate)
+	}
+}
+
+func TestCalculateBorrowInterestFactor_Compounds(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for i := 0; i < propertyRuns; i++ {
+		spy := randDec(r, sdk.OneDec(), sdk.MustNewDecFromStr("1.000000100000000000"))
+		first := sdk.NewInt(r.Int63n(7 * 24 * 60 * 60))
+		second := sdk.NewInt(r.Int63n(7 * 24 * 60 * 60))
+
+		firstFactor := hardmath.CalculateBorrowInterestFactor(spy, first)
+		secondFactor := hardmath.CalculateBorrowInterestFactor(spy, second)
+		totalFactor := hardmath.CalculateBorrowInterestFactor(spy, first.Add(second))
+		require.True(t, firstFactor.GTE(sdk.OneDec()))
+		require.True(t, totalFactor.GTE(firstFactor))
+
+		// accruing over two periods is the same as accruing over their sum, up to rounding
+		requireApproxEqual(t, totalFactor, hardmath.CompoundInterestFactor(firstFactor, secondFactor), sdk.MustNewDecFromStr("0.000000000001"))
+	}
+}
+
+func TestCalculateSupplyInterestFactor_Bounds(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for i := 0; i < propertyRuns; i++ {
+		cash := randDec(r, sdk.ZeroDec(), sdk.NewDec(1e12))
+		borrows := randDec(r, sdk.OneDec(), sdk.NewDec(1e12))
+		interest := randDec(r, sdk.ZeroDec(), borrows)
+		factor := hardmath.CalculateSupplyInterestFactor(interest, cash, borrows, sdk.ZeroDec())
+		require.True(t, factor.GTE(sdk.OneDec()))
+		// suppliers earn the interest paid by borrowers, up to rounding
+		supplyInterest := factor.Sub(sdk.OneDec()).Mul(cash.Add(borrows))
+		require.True(t, supplyInterest.Sub(interest).Abs().LTE(sdk.MustNewDecFromStr("0.000001")))
+	}
+}
+
+func TestCalculateInterest_Positions(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	for i := 0; i < propertyRuns; i++ {
+		amount := sdk.NewInt(r.Int63n(1e15))
+		lastFactor := randDec(r, sdk.OneDec(), sdk.NewDec(2))
+		currentFactor := lastFactor.Mul(randDec(r, sdk.OneDec(), sdk.MustNewDecFromStr("1.1")))
+
+		// positions synchronized at the current factor owe nothing
+		require.True(t, hardmath.CalculateBorrowInterest(amount, lastFactor, lastFactor).Abs().LTE(sdk.MustNewDecFromStr("0.000001")))
+		require.True(t, hardmath.CalculateSupplyInterest(amount, lastFactor, lastFactor).Abs().LTE(sdk.MustNewDecFromStr("0.000001")))
+
+		// interest grows with the factor and matches the change in the factor
+		borrowInterest := hardmath.CalculateBorrowInterest(amount, lastFactor, currentFactor)
+		supplyInterest := hardmath.CalculateSupplyInterest(amount, lastFactor, currentFactor)
+		require.False(t, borrowInterest.IsNegative())
+		require.False(t, supplyInterest.IsNegative())
+		expected := amount.ToDec().Mul(currentFactor.Quo(lastFactor).Sub(sdk.OneDec()))
+		require.True(t, expected.Sub(borrowInterest).Abs().LTE(sdk.MustNewDecFromStr("0.001")))
+		require.True(t, expected.Sub(supplyInterest).Abs().LTE(sdk.MustNewDecFromStr("0.001")))
+	}
+}
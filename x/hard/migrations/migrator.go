@@ -0,0 +1,187 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	v2 "github.com/kava-labs/kava/x/hard/migrations/v2"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// Handler migrates the hard store in place from one version to the next
+type Handler func(ctx sdk.Context) error
+
+// Migrator runs the hard store migrations needed to bring a store up to the current version
+type Migrator struct {
+	keeper        keeper.Keeper
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramSubspace subspace.Subspace
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(k keeper.Keeper, cdc *codec.Codec, storeKey sdk.StoreKey, paramSubspace subspace.Subspace) Migrator {
+	return Migrator{
+		keeper:        k,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		paramSubspace: paramSubspace,
+	}
+}
+
+// Handlers returns the migration handlers keyed by the version they migrate from
+func (m Migrator) Handlers() map[uint64]Handler {
+	return map[uint64]Handler{
+		1: m.Migrate1to2,
+		2: m.Migrate2to3,
+		3: m.Migrate3to4,
+		4: m.Migrate4to5,
+		5: m.Migrate5to6,
+		6: m.Migrate6to7,
+		7: m.Migrate7to8,
+		8: m.Migrate8to9,
+	}
+}
+
+// Migrate runs each migration handler from the stored version up to types.StoreVersion
+func (m Migrator) Migrate(ctx sdk.Context) error {
+	version := m.keeper.GetStoreVersion(ctx)
+	if version > types.StoreVersion {
+		return fmt.Errorf("hard store version %d is newer than supported version %d", version, types.StoreVersion)
+	}
+
+	handlers := m.Handlers()
+	for ; version < types.StoreVersion; version++ {
+		handler, found := handlers[version]
+		if !found {
+			return fmt.Errorf("no hard store migration from version %d", version)
+		}
+		if err := handler(ctx); err != nil {
+			return fmt.Errorf("failed to migrate hard store from version %d: %w", version, err)
+		}
+		m.keeper.SetStoreVersion(ctx, version+1)
+	}
+	return nil
+}
+
+// Migrate1to2 adds the supply limit and close factor to money markets and initializes the swap liquidations
+// and minimum accrual interval params
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.cdc, m.storeKey, m.paramSubspace)
+}
+
+// Migrate2to3 builds the index of borrows by denom from the stored borrows and initializes the liquidation gas
+// budget param
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyLiquidationGasBudget) {
+		m.paramSubspace.Set(ctx, types.KeyLiquidationGasBudget, types.DefaultLiquidationGasBudget)
+	}
+
+	var borrows types.Borrows
+	m.keeper.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+		borrows = append(borrows, borrow)
+		return false
+	})
+	// borrows are deleted before being set again so each borrow is indexed as new
+	for _, borrow := range borrows {
+		m.keeper.DeleteBorrow(ctx, borrow)
+		m.keeper.SetBorrow(ctx, borrow)
+	}
+	return nil
+}
+
+// Migrate3to4 sets the money market fields added since version 3 to their defaults in the params and in the store:
+// the borrow rate bounds, the withdraw fee, and the max strategy allocation. Fields that are already set are kept.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if m.paramSubspace.Has(ctx, types.KeyMoneyMarkets) {
+		var moneyMarkets types.MoneyMarkets
+		m.paramSubspace.Get(ctx, types.KeyMoneyMarkets, &moneyMarkets)
+		newMoneyMarkets := types.MoneyMarkets{}
+		for _, mm := range moneyMarkets {
+			newMoneyMarkets = append(newMoneyMarkets, setMoneyMarketDefaults(mm))
+		}
+		if err := newMoneyMarkets.Validate(); err != nil {
+			return err
+		}
+		m.paramSubspace.Set(ctx, types.KeyMoneyMarkets, newMoneyMarkets)
+	}
+
+	// collect before writing so the store is not modified while iterating
+	var denoms []string
+	var storedMoneyMarkets types.MoneyMarkets
+	m.keeper.IterateMoneyMarkets(ctx, func(denom string, mm types.MoneyMarket) bool {
+		denoms = append(denoms, denom)
+		storedMoneyMarkets = append(storedMoneyMarkets, setMoneyMarketDefaults(mm))
+		return false
+	})
+	for i, mm := range storedMoneyMarkets {
+		m.keeper.SetMoneyMarket(ctx, denoms[i], mm)
+	}
+	return nil
+}
+
+// Migrate4to5 initializes the liquidation order param to the proportional order used before it was added
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyLiquidationOrder) {
+		m.paramSubspace.Set(ctx, types.KeyLiquidationOrder, types.DefaultLiquidationOrder)
+	}
+	return nil
+}
+
+// Migrate5to6 initializes the max annual rate param to zero, which places no limit on interest rate models
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyMaxAnnualRate) {
+		m.paramSubspace.Set(ctx, types.KeyMaxAnnualRate, types.DefaultMaxAnnualRate)
+	}
+	return nil
+}
+
+// Migrate6to7 initializes the borrow history length param to its default, which starts recording borrow histories
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyBorrowHistoryLength) {
+		m.paramSubspace.Set(ctx, types.KeyBorrowHistoryLength, types.DefaultBorrowHistoryLength)
+	}
+	return nil
+}
+
+// Migrate7to8 initializes the health factor warning param to its default
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyHealthFactorWarning) {
+		m.paramSubspace.Set(ctx, types.KeyHealthFactorWarning, types.DefaultHealthFactorWarning)
+	}
+	return nil
+}
+
+// Migrate8to9 initializes the tier borrow rules param with no rules. Money markets written before version 9 have no
+// risk tier, so they are not restricted by the rules.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	if !m.paramSubspace.Has(ctx, types.KeyTierBorrowRules) {
+		m.paramSubspace.Set(ctx, types.KeyTierBorrowRules, types.DefaultTierBorrowRules)
+	}
+	return nil
+}
+
+// setMoneyMarketDefaults fills in the money market fields that are missing from money markets written before
+// version 4
+func setMoneyMarketDefaults(mm types.MoneyMarket) types.MoneyMarket {
+	if mm.PriceSource == "" {
+		mm.PriceSource = types.PriceSourceSpot
+	}
+	if mm.MinBorrowAPY.IsNil() {
+		mm.MinBorrowAPY = types.DefaultMinBorrowAPY
+	}
+	if mm.MaxBorrowAPY.IsNil() {
+		mm.MaxBorrowAPY = types.DefaultMaxBorrowAPY
+	}
+	if mm.WithdrawFee.IsNil() {
+		mm.WithdrawFee = types.DefaultWithdrawFee
+	}
+	if mm.MaxStrategyAllocation.IsNil() {
+		mm.MaxStrategyAllocation = types.DefaultMaxStrategyAllocation
+	}
+	return mm
+}
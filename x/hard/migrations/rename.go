@@ -0,0 +1,176 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// RenameDenom moves all hard state of one denom to another, for upgrade handlers of chains where a denom is renamed.
+// The money market params, positions and their interest factors, totals, and the records kept for each denom are
+// migrated, and the index of borrows by denom is updated as the borrows are rewritten. Pricefeed market IDs are kept.
+// Account balances, including the coins held by the hard module account, are kept by the bank and must be renamed
+// separately in the same upgrade.
+func (m Migrator) RenameDenom(ctx sdk.Context, from, to string) error {
+	if err := sdk.ValidateDenom(to); err != nil {
+		return fmt.Errorf("invalid denom %s: %w", to, err)
+	}
+	if from == to {
+		return fmt.Errorf("cannot rename denom %s to itself", from)
+	}
+	params := m.keeper.GetParams(ctx)
+	for _, mm := range params.MoneyMarkets {
+		if mm.Denom == to {
+			return fmt.Errorf("money market already exists for denom %s", to)
+		}
+	}
+	if _, found := m.keeper.GetMoneyMarket(ctx, to); found {
+		return fmt.Errorf("money market already exists for denom %s", to)
+	}
+
+	for i := range params.MoneyMarkets {
+		if params.MoneyMarkets[i].Denom == from {
+			params.MoneyMarkets[i].Denom = to
+		}
+	}
+	for i := range params.SwapLiquidations {
+		if params.SwapLiquidations[i].Denom == from {
+			params.SwapLiquidations[i].Denom = to
+		}
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+
+	m.renameDenomRecords(ctx, from, to)
+	m.renameTotals(ctx, from, to)
+	m.renamePositions(ctx, from, to)
+	return nil
+}
+
+// renameDenomRecords moves the store entries keyed by denom, updating the denom held by their values
+func (m Migrator) renameDenomRecords(ctx sdk.Context, from, to string) {
+	for _, keyPrefix := range [][]byte{
+		types.MoneyMarketsPrefix,
+		types.PreviousAccrualTimePrefix,
+		types.BorrowInterestFactorPrefix,
+		types.SupplyInterestFactorPrefix,
+		types.DelegatorInterestFactorPrefix,
+		types.InterestRateModelChangePrefix,
+		types.InterestAuditPrefix,
+		types.ScheduledMoneyMarketsPrefix,
+		types.MoneyMarketWindDownsPrefix,
+	} {
+		store := prefix.NewStore(ctx.KVStore(m.storeKey), keyPrefix)
+		if bz := store.Get([]byte(from)); bz != nil {
+			store.Set([]byte(to), bz)
+			store.Delete([]byte(from))
+		}
+	}
+
+	if mm, found := m.keeper.GetMoneyMarket(ctx, to); found {
+		mm.Denom = to
+		m.keeper.SetMoneyMarket(ctx, to, mm)
+	}
+	if change, found := m.keeper.GetInterestRateModelChange(ctx, to); found {
+		change.Denom = to
+		m.keeper.SetInterestRateModelChange(ctx, change)
+	}
+	if audit, found := m.keeper.GetInterestAudit(ctx, to); found {
+		audit.Denom = to
+		m.keeper.SetInterestAudit(ctx, audit)
+	}
+	if listing, found := m.keeper.GetScheduledMoneyMarket(ctx, to); found {
+		listing.MoneyMarket.Denom = to
+		m.keeper.SetScheduledMoneyMarket(ctx, listing)
+	}
+	if windDown, found := m.keeper.GetMoneyMarketWindDown(ctx, to); found {
+		windDown.Denom = to
+		m.keeper.SetMoneyMarketWindDown(ctx, windDown)
+	}
+}
+
+// renameTotals renames the denom in the module wide totals
+func (m Migrator) renameTotals(ctx sdk.Context, from, to string) {
+	if supplied, found := m.keeper.GetSuppliedCoins(ctx); found {
+		m.keeper.SetSuppliedCoins(ctx, renameCoins(supplied, from, to))
+	}
+	if borrowed, found := m.keeper.GetBorrowedCoins(ctx); found {
+		m.keeper.SetBorrowedCoins(ctx, renameCoins(borrowed, from, to))
+	}
+	if reserves, found := m.keeper.GetTotalReserves(ctx); found {
+		m.keeper.SetTotalReserves(ctx, renameCoins(reserves, from, to))
+	}
+	if allocations, found := m.keeper.GetStrategyAllocations(ctx); found {
+		m.keeper.SetStrategyAllocations(ctx, renameCoins(allocations, from, to))
+	}
+}
+
+// renamePositions renames the denom in deposits, borrows, and the records kept for each account
+func (m Migrator) renamePositions(ctx sdk.Context, from, to string) {
+	// vesting deposits are renamed first, as setting a deposit limits its vesting deposit to the deposited coins
+	for _, vd := range m.keeper.GetAllVestingDeposits(ctx) {
+		m.keeper.SetVestingDeposit(ctx, vd.Depositor, renameCoins(vd.Amount, from, to))
+	}
+
+	// collect before writing so the store is not modified while iterating
+	var deposits types.Deposits
+	m.keeper.IterateDeposits(ctx, func(deposit types.Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+	for _, deposit := range deposits {
+		deposit.Amount = renameCoins(deposit.Amount, from, to)
+		for i := range deposit.Index {
+			if deposit.Index[i].Denom == from {
+				deposit.Index[i].Denom = to
+			}
+		}
+		m.keeper.SetDeposit(ctx, deposit)
+	}
+
+	var borrows types.Borrows
+	m.keeper.IterateBorrows(ctx, func(borrow types.Borrow) bool {
+		borrows = append(borrows, borrow)
+		return false
+	})
+	// setting a borrow moves it in the index of borrows by denom
+	for _, borrow := range borrows {
+		borrow.Amount = renameCoins(borrow.Amount, from, to)
+		for i := range borrow.Index {
+			if borrow.Index[i].Denom == from {
+				borrow.Index[i].Denom = to
+			}
+		}
+		m.keeper.SetBorrow(ctx, borrow)
+	}
+
+	for _, volume := range m.keeper.GetAllReferralVolumes(ctx) {
+		m.keeper.SetReferralVolume(ctx, volume.Referrer, renameCoins(volume.Volume, from, to))
+	}
+	for _, history := range m.keeper.GetAllBorrowHistories(ctx) {
+		history.Principal = renameCoins(history.Principal, from, to)
+		for i := range history.Entries {
+			if history.Entries[i].Amount.Denom == from {
+				history.Entries[i].Amount.Denom = to
+			}
+		}
+		m.keeper.SetBorrowHistory(ctx, history)
+	}
+}
+
+// renameCoins returns the coins with the denom renamed, sorted by their new denoms
+func renameCoins(coins sdk.Coins, from, to string) sdk.Coins {
+	renamed := sdk.Coins{}
+	for _, coin := range coins {
+		if coin.Denom == from {
+			coin.Denom = to
+		}
+		renamed = renamed.Add(coin)
+	}
+	return renamed
+}
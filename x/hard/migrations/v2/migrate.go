@@ -0,0 +1,121 @@
+package v2
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// Migrate migrates the hard store from version 1 to version 2:
+// money markets in the params and in the store gain a supply limit and close factor, the swap liquidations
+// and minimum accrual interval params are initialized, and positions left without any coins are removed.
+func Migrate(ctx sdk.Context, cdc *codec.Codec, storeKey sdk.StoreKey, paramSubspace subspace.Subspace) error {
+	if err := migrateParams(ctx, cdc, paramSubspace); err != nil {
+		return err
+	}
+	if err := migrateStoredMoneyMarkets(ctx, cdc, storeKey); err != nil {
+		return err
+	}
+	migratePositions(ctx, cdc, storeKey)
+	return nil
+}
+
+// MigrateMoneyMarket converts a version 1 money market, with no supply limit and a close factor of 1.0
+func MigrateMoneyMarket(mm MoneyMarket) types.MoneyMarket {
+	borrowLimit := types.NewBorrowLimit(mm.BorrowLimit.HasMaxLimit, mm.BorrowLimit.MaximumLimit, mm.BorrowLimit.LoanToValue)
+	return types.NewMoneyMarket(mm.Denom, borrowLimit, mm.SpotMarketID, mm.ConversionFactor,
+		mm.InterestRateModel, mm.ReserveFactor, mm.KeeperRewardPercentage)
+}
+
+// MigrateMoneyMarkets converts version 1 money markets
+func MigrateMoneyMarkets(mms MoneyMarkets) types.MoneyMarkets {
+	newMoneyMarkets := types.MoneyMarkets{}
+	for _, mm := range mms {
+		newMoneyMarkets = append(newMoneyMarkets, MigrateMoneyMarket(mm))
+	}
+	return newMoneyMarkets
+}
+
+func migrateParams(ctx sdk.Context, cdc *codec.Codec, paramSubspace subspace.Subspace) error {
+	bz := paramSubspace.GetRaw(ctx, types.KeyMoneyMarkets)
+	if bz != nil {
+		var moneyMarkets MoneyMarkets
+		if err := cdc.UnmarshalJSON(bz, &moneyMarkets); err != nil {
+			return fmt.Errorf("failed to decode version 1 money market params: %w", err)
+		}
+		newMoneyMarkets := MigrateMoneyMarkets(moneyMarkets)
+		if err := newMoneyMarkets.Validate(); err != nil {
+			return err
+		}
+		paramSubspace.Set(ctx, types.KeyMoneyMarkets, newMoneyMarkets)
+	}
+
+	if !paramSubspace.Has(ctx, types.KeySwapLiquidations) {
+		paramSubspace.Set(ctx, types.KeySwapLiquidations, types.SwapLiquidations{})
+	}
+	if !paramSubspace.Has(ctx, types.KeyMinimumAccrualInterval) {
+		paramSubspace.Set(ctx, types.KeyMinimumAccrualInterval, types.DefaultMinimumAccrualInterval)
+	}
+	return nil
+}
+
+func migrateStoredMoneyMarkets(ctx sdk.Context, cdc *codec.Codec, storeKey sdk.StoreKey) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.MoneyMarketsPrefix)
+
+	// collect before writing so the store is not modified while iterating
+	var keys [][]byte
+	var moneyMarkets types.MoneyMarkets
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var mm MoneyMarket
+		if err := cdc.UnmarshalBinaryBare(iterator.Value(), &mm); err != nil {
+			iterator.Close()
+			return fmt.Errorf("failed to decode version 1 money market: %w", err)
+		}
+		keys = append(keys, iterator.Key())
+		moneyMarkets = append(moneyMarkets, MigrateMoneyMarket(mm))
+	}
+	iterator.Close()
+
+	for i, mm := range moneyMarkets {
+		store.Set(keys[i], cdc.MustMarshalBinaryBare(mm))
+	}
+	return nil
+}
+
+func migratePositions(ctx sdk.Context, cdc *codec.Codec, storeKey sdk.StoreKey) {
+	depositStore := prefix.NewStore(ctx.KVStore(storeKey), types.DepositsKeyPrefix)
+	var emptyDeposits [][]byte
+	iterator := sdk.KVStorePrefixIterator(depositStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
+		if deposit.Amount.Empty() {
+			emptyDeposits = append(emptyDeposits, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range emptyDeposits {
+		depositStore.Delete(key)
+	}
+
+	borrowStore := prefix.NewStore(ctx.KVStore(storeKey), types.BorrowsKeyPrefix)
+	var emptyBorrows [][]byte
+	iterator = sdk.KVStorePrefixIterator(borrowStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var borrow types.Borrow
+		cdc.MustUnmarshalBinaryBare(iterator.Value(), &borrow)
+		if borrow.Amount.Empty() {
+			emptyBorrows = append(emptyBorrows, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range emptyBorrows {
+		borrowStore.Delete(key)
+	}
+}
@@ -0,0 +1,146 @@
+package v2_test
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/kava-labs/kava/app"
+	v2 "github.com/kava-labs/kava/x/hard/migrations/v2"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+type MigrateTestSuite struct {
+	suite.Suite
+
+	ctx           sdk.Context
+	cdc           *codec.Codec
+	storeKey      sdk.StoreKey
+	paramsKey     sdk.StoreKey
+	paramSubspace params.Subspace
+	v1Params      []byte
+}
+
+func (suite *MigrateTestSuite) SetupTest() {
+	suite.cdc = app.MakeCodec()
+	suite.storeKey = sdk.NewKVStoreKey(types.StoreKey)
+	suite.paramsKey = sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(suite.storeKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(suite.paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	suite.Require().NoError(ms.LoadLatestVersion())
+	suite.ctx = sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	paramsKeeper := params.NewKeeper(suite.cdc, suite.paramsKey, paramsTKey)
+	suite.paramSubspace = paramsKeeper.Subspace(types.DefaultParamspace).WithKeyTable(types.ParamKeyTable())
+
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", "hard-v1-money-markets.json"))
+	suite.Require().NoError(err)
+	suite.v1Params = bz
+}
+
+// setV1Store writes the money market params and store entries in their version 1 encoding
+func (suite *MigrateTestSuite) setV1Store() v2.MoneyMarkets {
+	var moneyMarkets v2.MoneyMarkets
+	suite.Require().NoError(suite.cdc.UnmarshalJSON(suite.v1Params, &moneyMarkets))
+
+	paramStore := prefix.NewStore(suite.ctx.KVStore(suite.paramsKey), append([]byte(types.DefaultParamspace), '/'))
+	paramStore.Set(types.KeyMoneyMarkets, suite.v1Params)
+
+	mmStore := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.MoneyMarketsPrefix)
+	for _, mm := range moneyMarkets {
+		mmStore.Set([]byte(mm.Denom), suite.cdc.MustMarshalBinaryBare(mm))
+	}
+	return moneyMarkets
+}
+
+func (suite *MigrateTestSuite) TestMigrateMoneyMarkets() {
+	var moneyMarkets v2.MoneyMarkets
+	suite.Require().NoError(suite.cdc.UnmarshalJSON(suite.v1Params, &moneyMarkets))
+
+	newMoneyMarkets := v2.MigrateMoneyMarkets(moneyMarkets)
+	suite.Require().NoError(newMoneyMarkets.Validate())
+	suite.Require().Len(newMoneyMarkets, len(moneyMarkets))
+	for i, mm := range newMoneyMarkets {
+		suite.Equal(moneyMarkets[i].Denom, mm.Denom)
+		suite.True(moneyMarkets[i].ConversionFactor.Equal(mm.ConversionFactor))
+		suite.True(moneyMarkets[i].BorrowLimit.Equal(mm.BorrowLimit))
+		suite.True(moneyMarkets[i].InterestRateModel.Equal(mm.InterestRateModel))
+		suite.True(types.DefaultSupplyLimit.Equal(mm.SupplyLimit))
+		suite.True(types.DefaultCloseFactor.Equal(mm.CloseFactor))
+	}
+}
+
+func (suite *MigrateTestSuite) TestMigrate() {
+	moneyMarkets := suite.setV1Store()
+
+	depositor := sdk.AccAddress("depositor")
+	emptyDepositor := sdk.AccAddress("emptydepositor")
+	depositStore := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.DepositsKeyPrefix)
+	deposit := types.NewDeposit(depositor, sdk.NewCoins(sdk.NewInt64Coin("bnb", 100)),
+		types.SupplyInterestFactors{types.NewSupplyInterestFactor("bnb", sdk.OneDec())})
+	depositStore.Set(depositor, suite.cdc.MustMarshalBinaryBare(deposit))
+	depositStore.Set(emptyDepositor, suite.cdc.MustMarshalBinaryBare(types.NewDeposit(emptyDepositor, sdk.Coins{}, types.SupplyInterestFactors{})))
+
+	borrowStore := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.BorrowsKeyPrefix)
+	borrowStore.Set(emptyDepositor, suite.cdc.MustMarshalBinaryBare(types.NewBorrow(emptyDepositor, sdk.Coins{}, types.BorrowInterestFactors{})))
+
+	err := v2.Migrate(suite.ctx, suite.cdc, suite.storeKey, suite.paramSubspace)
+	suite.Require().NoError(err)
+
+	// params added after version 2 are initialized by later migrations, so only the version 2 params are read
+	params := types.DefaultParams()
+	suite.NotPanics(func() {
+		suite.paramSubspace.Get(suite.ctx, types.KeyMoneyMarkets, &params.MoneyMarkets)
+		suite.paramSubspace.Get(suite.ctx, types.KeySwapLiquidations, &params.SwapLiquidations)
+		suite.paramSubspace.Get(suite.ctx, types.KeyMinimumAccrualInterval, &params.MinimumAccrualInterval)
+	})
+	suite.Require().NoError(params.Validate())
+	suite.Equal(v2.MigrateMoneyMarkets(moneyMarkets), params.MoneyMarkets)
+	suite.Empty(params.SwapLiquidations)
+	suite.Equal(types.DefaultMinimumAccrualInterval, params.MinimumAccrualInterval)
+
+	mmStore := prefix.NewStore(suite.ctx.KVStore(suite.storeKey), types.MoneyMarketsPrefix)
+	for _, mm := range moneyMarkets {
+		var storedMM types.MoneyMarket
+		suite.cdc.MustUnmarshalBinaryBare(mmStore.Get([]byte(mm.Denom)), &storedMM)
+		suite.True(v2.MigrateMoneyMarket(mm).Equal(storedMM))
+	}
+
+	suite.True(depositStore.Has(depositor))
+	suite.False(depositStore.Has(emptyDepositor))
+	suite.False(borrowStore.Has(emptyDepositor))
+}
+
+func (suite *MigrateTestSuite) TestMigrate_KeepsSwapLiquidations() {
+	suite.setV1Store()
+	swapLiquidations := types.SwapLiquidations{types.NewSwapLiquidation("bnb", sdk.NewInt(1000), sdk.MustNewDecFromStr("0.05"))}
+	suite.paramSubspace.Set(suite.ctx, types.KeySwapLiquidations, swapLiquidations)
+
+	err := v2.Migrate(suite.ctx, suite.cdc, suite.storeKey, suite.paramSubspace)
+	suite.Require().NoError(err)
+
+	var migratedSwapLiquidations types.SwapLiquidations
+	suite.paramSubspace.Get(suite.ctx, types.KeySwapLiquidations, &migratedSwapLiquidations)
+	suite.Equal(swapLiquidations, migratedSwapLiquidations)
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
@@ -0,0 +1,21 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// MoneyMarket is a money market as stored by version 1 of the hard store
+type MoneyMarket struct {
+	Denom                  string                  `json:"denom" yaml:"denom"`
+	BorrowLimit            types.BorrowLimit       `json:"borrow_limit" yaml:"borrow_limit"`
+	SpotMarketID           string                  `json:"spot_market_id" yaml:"spot_market_id"`
+	ConversionFactor       sdk.Int                 `json:"conversion_factor" yaml:"conversion_factor"`
+	InterestRateModel      types.InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`
+	ReserveFactor          sdk.Dec                 `json:"reserve_factor" yaml:"reserve_factor"`
+	KeeperRewardPercentage sdk.Dec                 `json:"keeper_reward_percentage" yaml:"keeper_reward_percentages"`
+}
+
+// MoneyMarkets slice of version 1 MoneyMarket
+type MoneyMarkets []MoneyMarket
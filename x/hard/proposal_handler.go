@@ -0,0 +1,65 @@
+package hard
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/hard/keeper"
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+// NewProposalHandler creates a governance handler for hard proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.ReservePayoutProposal:
+			return handleReservePayoutProposal(ctx, k, c)
+		case types.AddMoneyMarketProposal:
+			return handleAddMoneyMarketProposal(ctx, k, c)
+		case types.DelistMoneyMarketProposal:
+			return handleDelistMoneyMarketProposal(ctx, k, c)
+		case types.SeedProtocolLiquidityProposal:
+			return handleSeedProtocolLiquidityProposal(ctx, k, c)
+		case types.WithdrawProtocolLiquidityProposal:
+			return handleWithdrawProtocolLiquidityProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func handleReservePayoutProposal(ctx sdk.Context, k keeper.Keeper, p types.ReservePayoutProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.PayoutReserves(ctx, p.Incident, p.Payouts)
+}
+
+func handleAddMoneyMarketProposal(ctx sdk.Context, k keeper.Keeper, p types.AddMoneyMarketProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.ScheduleMoneyMarket(ctx, p.ScheduledMoneyMarket())
+}
+
+func handleDelistMoneyMarketProposal(ctx sdk.Context, k keeper.Keeper, p types.DelistMoneyMarketProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.DeprecateMoneyMarket(ctx, p.WindDown())
+}
+
+func handleSeedProtocolLiquidityProposal(ctx sdk.Context, k keeper.Keeper, p types.SeedProtocolLiquidityProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.SeedProtocolLiquidity(ctx, p.Amount)
+}
+
+func handleWithdrawProtocolLiquidityProposal(ctx sdk.Context, k keeper.Keeper, p types.WithdrawProtocolLiquidityProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.WithdrawProtocolLiquidity(ctx, p.Amount)
+}
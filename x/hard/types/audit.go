@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InterestAudit tracks the interest accrued by a money market since genesis. Interest charged to borrowers is
+// split between suppliers and reserves, so BorrowInterest is always equal to SupplyInterest plus Reserves.
+// Dust is the fractional interest dropped by rounding when individual deposits and borrows are synced, it stays
+// in the market's totals but is not added to any position.
+type InterestAudit struct {
+	Denom          string  `json:"denom" yaml:"denom"`
+	BorrowInterest sdk.Int `json:"borrow_interest" yaml:"borrow_interest"`
+	SupplyInterest sdk.Int `json:"supply_interest" yaml:"supply_interest"`
+	Reserves       sdk.Int `json:"reserves" yaml:"reserves"`
+	Dust           sdk.Dec `json:"dust" yaml:"dust"`
+}
+
+// NewInterestAudit returns a new InterestAudit
+func NewInterestAudit(denom string, borrowInterest, supplyInterest, reserves sdk.Int, dust sdk.Dec) InterestAudit {
+	return InterestAudit{
+		Denom:          denom,
+		BorrowInterest: borrowInterest,
+		SupplyInterest: supplyInterest,
+		Reserves:       reserves,
+		Dust:           dust,
+	}
+}
+
+// NewEmptyInterestAudit returns an InterestAudit for a market that has not accrued any interest
+func NewEmptyInterestAudit(denom string) InterestAudit {
+	return NewInterestAudit(denom, sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroDec())
+}
+
+// Residual returns the borrow interest not accounted for by supply interest and reserves, which is zero when
+// interest has been conserved
+func (ia InterestAudit) Residual() sdk.Int {
+	return ia.BorrowInterest.Sub(ia.SupplyInterest).Sub(ia.Reserves)
+}
+
+// Validate performs basic validation of an InterestAudit
+func (ia InterestAudit) Validate() error {
+	if err := sdk.ValidateDenom(ia.Denom); err != nil {
+		return err
+	}
+	if ia.BorrowInterest.IsNil() || ia.BorrowInterest.IsNegative() {
+		return fmt.Errorf("borrow interest cannot be negative for %s", ia.Denom)
+	}
+	if ia.SupplyInterest.IsNil() || ia.SupplyInterest.IsNegative() {
+		return fmt.Errorf("supply interest cannot be negative for %s", ia.Denom)
+	}
+	if ia.Reserves.IsNil() || ia.Reserves.IsNegative() {
+		return fmt.Errorf("reserves cannot be negative for %s", ia.Denom)
+	}
+	if ia.Dust.IsNil() || ia.Dust.IsNegative() {
+		return fmt.Errorf("dust cannot be negative for %s", ia.Denom)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ia InterestAudit) String() string {
+	return fmt.Sprintf(`Interest Audit:
+	Denom: %s
+	Borrow Interest: %s
+	Supply Interest: %s
+	Reserves: %s
+	Residual: %s
+	Dust: %s
+`, ia.Denom, ia.BorrowInterest, ia.SupplyInterest, ia.Reserves, ia.Residual(), ia.Dust)
+}
+
+// InterestAudits slice of InterestAudit
+type InterestAudits []InterestAudit
+
+// Validate performs basic validation of each interest audit and checks that no denom is repeated
+func (ias InterestAudits) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, ia := range ias {
+		if err := ia.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[ia.Denom] {
+			return fmt.Errorf("duplicate interest audit denom: %s", ia.Denom)
+		}
+		seenDenoms[ia.Denom] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ias InterestAudits) String() string {
+	out := ""
+	for _, ia := range ias {
+		out += ia.String()
+	}
+	return strings.TrimSpace(out)
+}
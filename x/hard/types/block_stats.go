@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ActivityStats is the number and USD volume of one kind of activity in a block
+type ActivityStats struct {
+	Count  uint64  `json:"count" yaml:"count"`
+	Volume sdk.Dec `json:"volume" yaml:"volume"`
+}
+
+// NewActivityStats returns a new ActivityStats with no activity
+func NewActivityStats() ActivityStats {
+	return ActivityStats{
+		Count:  0,
+		Volume: sdk.ZeroDec(),
+	}
+}
+
+// Add returns the stats with one more activity of the given USD value
+func (as ActivityStats) Add(value sdk.Dec) ActivityStats {
+	return ActivityStats{
+		Count:  as.Count + 1,
+		Volume: as.Volume.Add(value),
+	}
+}
+
+// String implements fmt.Stringer
+func (as ActivityStats) String() string {
+	return fmt.Sprintf("%d:%s", as.Count, as.Volume)
+}
+
+// BlockStats is a summary of the deposits, withdrawals, borrows, and repays made in a block, with volumes valued in USD
+type BlockStats struct {
+	Deposits    ActivityStats `json:"deposits" yaml:"deposits"`
+	Withdrawals ActivityStats `json:"withdrawals" yaml:"withdrawals"`
+	Borrows     ActivityStats `json:"borrows" yaml:"borrows"`
+	Repays      ActivityStats `json:"repays" yaml:"repays"`
+}
+
+// NewBlockStats returns a new BlockStats with no activity
+func NewBlockStats() BlockStats {
+	return BlockStats{
+		Deposits:    NewActivityStats(),
+		Withdrawals: NewActivityStats(),
+		Borrows:     NewActivityStats(),
+		Repays:      NewActivityStats(),
+	}
+}
+
+// Empty returns true if there was no activity in the block
+func (bs BlockStats) Empty() bool {
+	return bs.Deposits.Count == 0 && bs.Withdrawals.Count == 0 && bs.Borrows.Count == 0 && bs.Repays.Count == 0
+}
+
+// String implements fmt.Stringer
+func (bs BlockStats) String() string {
+	return fmt.Sprintf(`Block Stats:
+	Deposits: %s
+	Withdrawals: %s
+	Borrows: %s
+	Repays: %s`,
+		bs.Deposits, bs.Withdrawals, bs.Borrows, bs.Repays)
+}
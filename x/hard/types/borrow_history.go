@@ -0,0 +1,140 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Borrow history entry types
+const (
+	// BorrowHistoryOrigination records coins borrowed
+	BorrowHistoryOrigination = "origination"
+	// BorrowHistoryRepayment records coins repaid, including any interest they paid
+	BorrowHistoryRepayment = "repayment"
+)
+
+// MaxBorrowHistoryLength is the largest borrow history length param, which bounds the size of each stored history
+const MaxBorrowHistoryLength uint64 = 1000
+
+// BorrowHistoryEntry records a single borrow origination or repayment of one denom
+type BorrowHistoryEntry struct {
+	Type     string    `json:"type" yaml:"type"`
+	Amount   sdk.Coin  `json:"amount" yaml:"amount"`
+	Interest sdk.Int   `json:"interest" yaml:"interest"` // portion of a repayment that paid interest, zero for originations
+	Height   int64     `json:"height" yaml:"height"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+// NewBorrowHistoryEntry returns a new BorrowHistoryEntry
+func NewBorrowHistoryEntry(entryType string, amount sdk.Coin, interest sdk.Int, height int64, blockTime time.Time) BorrowHistoryEntry {
+	return BorrowHistoryEntry{
+		Type:     entryType,
+		Amount:   amount,
+		Interest: interest,
+		Height:   height,
+		Time:     blockTime,
+	}
+}
+
+// Validate performs basic validation of a BorrowHistoryEntry
+func (e BorrowHistoryEntry) Validate() error {
+	switch e.Type {
+	case BorrowHistoryOrigination, BorrowHistoryRepayment:
+	default:
+		return fmt.Errorf("invalid borrow history entry type: %s", e.Type)
+	}
+	if !e.Amount.IsValid() || !e.Amount.IsPositive() {
+		return fmt.Errorf("borrow history entry amount must be positive: %s", e.Amount)
+	}
+	if e.Interest.IsNil() || e.Interest.IsNegative() || e.Interest.GT(e.Amount.Amount) {
+		return fmt.Errorf("borrow history entry interest must be between 0 and the amount %s: %s", e.Amount.Amount, e.Interest)
+	}
+	if e.Type == BorrowHistoryOrigination && !e.Interest.IsZero() {
+		return fmt.Errorf("borrow history origination cannot pay interest: %s", e.Interest)
+	}
+	if e.Height < 0 {
+		return fmt.Errorf("borrow history entry height cannot be negative: %d", e.Height)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (e BorrowHistoryEntry) String() string {
+	return fmt.Sprintf("%s of %s (interest %s) at height %d", e.Type, e.Amount, e.Interest, e.Height)
+}
+
+// BorrowHistoryEntries slice of BorrowHistoryEntry
+type BorrowHistoryEntries []BorrowHistoryEntry
+
+// BorrowHistory is an account's recent borrow originations and repayments, oldest first. Principal is the amount
+// borrowed and not yet repaid, which separates the interest paid by each repayment from the principal it returned.
+type BorrowHistory struct {
+	Borrower  sdk.AccAddress       `json:"borrower" yaml:"borrower"`
+	Principal sdk.Coins            `json:"principal" yaml:"principal"`
+	Entries   BorrowHistoryEntries `json:"entries" yaml:"entries"`
+}
+
+// NewBorrowHistory returns a new BorrowHistory
+func NewBorrowHistory(borrower sdk.AccAddress, principal sdk.Coins, entries BorrowHistoryEntries) BorrowHistory {
+	return BorrowHistory{
+		Borrower:  borrower,
+		Principal: principal,
+		Entries:   entries,
+	}
+}
+
+// Validate performs basic validation of a BorrowHistory
+func (bh BorrowHistory) Validate() error {
+	if bh.Borrower.Empty() {
+		return errors.New("borrow history borrower cannot be empty")
+	}
+	if !bh.Principal.IsValid() {
+		return fmt.Errorf("invalid borrow history principal: %s", bh.Principal)
+	}
+	for _, e := range bh.Entries {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AddEntry appends an entry to the history and prunes the oldest entries beyond maxLength
+func (bh BorrowHistory) AddEntry(entry BorrowHistoryEntry, maxLength uint64) BorrowHistory {
+	entries := append(BorrowHistoryEntries{}, bh.Entries...)
+	entries = append(entries, entry)
+	if uint64(len(entries)) > maxLength {
+		entries = entries[uint64(len(entries))-maxLength:]
+	}
+	bh.Entries = entries
+	return bh
+}
+
+// String implements fmt.Stringer
+func (bh BorrowHistory) String() string {
+	return fmt.Sprintf(`Borrow History:
+	Borrower: %s
+	Principal: %s
+	Entries: %d`, bh.Borrower, bh.Principal, len(bh.Entries))
+}
+
+// BorrowHistories slice of BorrowHistory
+type BorrowHistories []BorrowHistory
+
+// Validate performs basic validation of each borrow history and checks that no borrower has more than one
+func (bhs BorrowHistories) Validate() error {
+	seenBorrowers := make(map[string]bool)
+	for _, bh := range bhs {
+		if err := bh.Validate(); err != nil {
+			return err
+		}
+		if seenBorrowers[bh.Borrower.String()] {
+			return fmt.Errorf("duplicate borrow history borrower: %s", bh.Borrower)
+		}
+		seenBorrowers[bh.Borrower.String()] = true
+	}
+	return nil
+}
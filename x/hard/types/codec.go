@@ -19,4 +19,12 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgBorrow{}, "hard/MsgBorrow", nil)
 	cdc.RegisterConcrete(MsgLiquidate{}, "hard/MsgLiquidate", nil)
 	cdc.RegisterConcrete(MsgRepay{}, "hard/MsgRepay", nil)
+	cdc.RegisterConcrete(MsgAccrueInterest{}, "hard/MsgAccrueInterest", nil)
+	cdc.RegisterConcrete(MsgSetRepayFirst{}, "hard/MsgSetRepayFirst", nil)
+	cdc.RegisterConcrete(MsgSetStopLoss{}, "hard/MsgSetStopLoss", nil)
+	cdc.RegisterConcrete(ReservePayoutProposal{}, "hard/ReservePayoutProposal", nil)
+	cdc.RegisterConcrete(AddMoneyMarketProposal{}, "hard/AddMoneyMarketProposal", nil)
+	cdc.RegisterConcrete(DelistMoneyMarketProposal{}, "hard/DelistMoneyMarketProposal", nil)
+	cdc.RegisterConcrete(SeedProtocolLiquidityProposal{}, "hard/SeedProtocolLiquidityProposal", nil)
+	cdc.RegisterConcrete(WithdrawProtocolLiquidityProposal{}, "hard/WithdrawProtocolLiquidityProposal", nil)
 }
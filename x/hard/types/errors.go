@@ -63,4 +63,48 @@ var (
 	ErrInvalidRepaymentDenom = sdkerrors.Register(ModuleName, 28, "no coins of this type borrowed")
 	// ErrInvalidIndexFactorDenom error for when index factor denom cannot be found
 	ErrInvalidIndexFactorDenom = sdkerrors.Register(ModuleName, 29, "no index factor found for denom")
+	// ErrExceedsSupplyLimit error for when a deposit would exceed a money market's supply limit
+	ErrExceedsSupplyLimit = sdkerrors.Register(ModuleName, 30, "deposit exceeds supply limit")
+	// ErrInsufficientReserves error for when a payout exceeds the reserves available
+	ErrInsufficientReserves = sdkerrors.Register(ModuleName, 31, "insufficient reserves")
+	// ErrLiquidatorNotPermitted error for when a keeper is not on the liquidator whitelist of a money market
+	ErrLiquidatorNotPermitted = sdkerrors.Register(ModuleName, 32, "liquidator not permitted")
+	// ErrStrategyNotFound error for when coins are allocated to a denom that has no registered yield strategy
+	ErrStrategyNotFound = sdkerrors.Register(ModuleName, 33, "yield strategy not found")
+	// ErrStrategyWithdrawal error for when a yield strategy does not return the coins withdrawn from it
+	ErrStrategyWithdrawal = sdkerrors.Register(ModuleName, 34, "yield strategy withdrawal failed")
+	// ErrMoneyMarketExists error for when a money market is listed for a denom that already has one
+	ErrMoneyMarketExists = sdkerrors.Register(ModuleName, 35, "money market already exists")
+	// ErrPricefeedMarketInactive error for when a money market is listed with a pricefeed market that is missing or inactive
+	ErrPricefeedMarketInactive = sdkerrors.Register(ModuleName, 36, "pricefeed market not found or inactive")
+	// ErrInvalidActivationTime error for when a money market is scheduled to activate at or before the current block time
+	ErrInvalidActivationTime = sdkerrors.Register(ModuleName, 37, "activation time must be in the future")
+	// ErrMoneyMarketDeprecated error for when a deposit or borrow is made in a money market that is being wound down
+	ErrMoneyMarketDeprecated = sdkerrors.Register(ModuleName, 38, "money market is deprecated")
+	// ErrInvalidWindDownDeadline error for when a money market wind down deadline is at or before the current block time
+	ErrInvalidWindDownDeadline = sdkerrors.Register(ModuleName, 39, "wind down deadline must be in the future")
+	// ErrConversionFactorMismatch error for when a money market conversion factor does not match the registered denom metadata
+	ErrConversionFactorMismatch = sdkerrors.Register(ModuleName, 40, "conversion factor does not match denom metadata")
+	// ErrInvalidSafetyMargin error for when a max withdraw or borrow query has a safety margin outside of [0, 1)
+	ErrInvalidSafetyMargin = sdkerrors.Register(ModuleName, 41, "safety margin must be in the range [0, 1)")
+	// ErrStalePrice error for when a pricefeed market exists but has no current price, such as when every posted price has expired
+	ErrStalePrice = sdkerrors.Register(ModuleName, 42, "no valid price for market")
+	// ErrInsufficientBalanceForDeposit error for when a requested deposit exceeds the depositor's spendable balance
+	ErrInsufficientBalanceForDeposit = sdkerrors.Register(ModuleName, 43, "insufficient balance for deposit")
+	// ErrInvalidSimulationAction error for when a simulate query has an unsupported action
+	ErrInvalidSimulationAction = sdkerrors.Register(ModuleName, 44, "invalid simulation action")
+	// ErrInvalidStopLoss error for when a stop loss has an LTV threshold outside of (0, 1) or a non-positive max repay value
+	ErrInvalidStopLoss = sdkerrors.Register(ModuleName, 45, "invalid stop loss")
+	// ErrStopLossNotFound error for when a borrower without a stop loss tries to remove one
+	ErrStopLossNotFound = sdkerrors.Register(ModuleName, 46, "stop loss not found")
+	// ErrExceedsMaxAnnualRate error for when a money market's interest rate model sets a rate above the max annual rate
+	ErrExceedsMaxAnnualRate = sdkerrors.Register(ModuleName, 47, "interest rate model exceeds max annual rate")
+	// ErrInvalidCurvePoints error for when an interest rate curve query asks for an unsupported number of points
+	ErrInvalidCurvePoints = sdkerrors.Register(ModuleName, 48, "invalid number of interest rate curve points")
+	// ErrAuctionLimitReached error for when the collateral auctions that can be started in a block have all been started
+	ErrAuctionLimitReached = sdkerrors.Register(ModuleName, 49, "collateral auction limit reached for this block")
+	// ErrInsufficientTierCollateral error for when the borrows of a risk tier exceed the collateral the tier borrow rules allow for them
+	ErrInsufficientTierCollateral = sdkerrors.Register(ModuleName, 50, "not enough collateral allowed for risk tier")
+	// ErrInvalidVestingDeposit error for when a deposit would move coins out of a vesting account against its vesting schedule
+	ErrInvalidVestingDeposit = sdkerrors.Register(ModuleName, 51, "deposit violates vesting schedule")
 )
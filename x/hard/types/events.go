@@ -9,7 +9,21 @@ const (
 	EventTypeHardWithdrawal            = "hard_withdrawal"
 	EventTypeHardBorrow                = "hard_borrow"
 	EventTypeHardLiquidation           = "hard_liquidation"
+	EventTypeHardLiquidationSwap       = "hard_liquidation_swap"
 	EventTypeHardRepay                 = "hard_repay"
+	EventTypeInterestRateModelChange   = "hard_interest_rate_model_change"
+	EventTypeHardReservePayout         = "hard_reserve_payout"
+	EventTypeHardDepositReferral       = "hard_deposit_referral"
+	EventTypeHardStrategyRebalance     = "hard_strategy_rebalance"
+	EventTypeHardMoneyMarketScheduled  = "hard_money_market_scheduled"
+	EventTypeHardMoneyMarketActivation = "hard_money_market_activation"
+	EventTypeHardMoneyMarketDeprecated = "hard_money_market_deprecated"
+	EventTypeHardMoneyMarketDelisted   = "hard_money_market_delisted"
+	EventTypeHardForcedWithdrawal      = "hard_forced_withdrawal"
+	EventTypeHardBlockStats            = "hard_block_stats"
+	EventTypeHardStopLoss              = "hard_stop_loss"
+	EventTypeHardHealthFactorWarning   = "hard_health_factor_warning"
+	EventTypeHardAuctionSettlement     = "hard_auction_settlement"
 	AttributeValueCategory             = ModuleName
 	AttributeKeyBlockHeight            = "block_height"
 	AttributeKeyRewardsDistribution    = "rewards_distributed"
@@ -26,5 +40,44 @@ const (
 	AttributeKeyLiquidatedCoins        = "liquidated_coins"
 	AttributeKeyKeeper                 = "keeper"
 	AttributeKeyKeeperRewardCoins      = "keeper_reward_coins"
+	AttributeKeySeizedCoins            = "seized_coins"
+	AttributeKeyDebtCovered            = "debt_covered"
+	AttributeKeyResidualDebt           = "residual_debt"
+	AttributeKeyResultingLtv           = "resulting_ltv"
+	AttributeKeyLiquidationOrder       = "liquidation_order"
+	AttributeKeySeizureOrder           = "seizure_order"
+	AttributeKeyDepositCount           = "deposit_count"
+	AttributeKeyDepositVolume          = "deposit_volume"
+	AttributeKeyWithdrawalCount        = "withdrawal_count"
+	AttributeKeyWithdrawalVolume       = "withdrawal_volume"
+	AttributeKeyBorrowCount            = "borrow_count"
+	AttributeKeyBorrowVolume           = "borrow_volume"
+	AttributeKeyRepayCount             = "repay_count"
+	AttributeKeyRepayVolume            = "repay_volume"
 	AttributeKeyOwner                  = "owner"
+	AttributeKeySwapInput              = "swap_input"
+	AttributeKeySwapOutput             = "swap_output"
+	AttributeKeyDenom                  = "denom"
+	AttributeKeyPreviousModel          = "previous_interest_rate_model"
+	AttributeKeyNewModel               = "new_interest_rate_model"
+	AttributeKeyIncident               = "incident"
+	AttributeKeyRecipient              = "recipient"
+	AttributeKeyPayoutCoins            = "payout_coins"
+	AttributeKeyReferrer               = "referrer"
+	AttributeKeyAuctionID              = "auction_id"
+	AttributeKeyLot                    = "lot"
+	AttributeKeyProceeds               = "proceeds"
+	AttributeKeyShortfall              = "shortfall"
+	AttributeKeyWithdrawFee            = "withdraw_fee"
+	AttributeKeyStrategyAllocation     = "strategy_allocation"
+	AttributeKeyStrategyYield          = "strategy_yield"
+	AttributeKeyActivationTime         = "activation_time"
+	AttributeKeyWindDownDeadline       = "wind_down_deadline"
+	AttributeKeyLtv                    = "ltv"
+	AttributeKeyHealthFactor           = "health_factor"
+	AttributeKeyResultingHealthFactor  = "resulting_health_factor"
+	AttributeKeyHealthFactorWarning    = "health_factor_warning"
+
+	EventTypeHardProtocolLiquiditySeed       = "hard_protocol_liquidity_seed"
+	EventTypeHardProtocolLiquidityWithdrawal = "hard_protocol_liquidity_withdrawal"
 )
@@ -39,11 +39,31 @@ type StakingKeeper interface {
 // PricefeedKeeper defines the expected interface for the pricefeed
 type PricefeedKeeper interface {
 	GetCurrentPrice(sdk.Context, string) (pftypes.CurrentPrice, error)
+	GetMarket(ctx sdk.Context, marketID string) (pftypes.Market, bool)
+}
+
+// DenomMetadataRegistry defines the expected interface for the app's registry of denom metadata (noalias)
+type DenomMetadataRegistry interface {
+	GetConversionFactor(denom string) (sdk.Int, bool)
 }
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
 type AuctionKeeper interface {
 	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, error)
+	HasCollateralAuctionCapacity(ctx sdk.Context) bool
+}
+
+// SwapKeeper expected interface for the swap keeper (noalias)
+type SwapKeeper interface {
+	SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error
+	SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error
+}
+
+// DistributionKeeper defines the expected interface for the distribution keeper, whose community pool funds
+// protocol owned liquidity (noalias)
+type DistributionKeeper interface {
+	DistributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receiveAddr sdk.AccAddress) error
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
 
 // HARDHooks event hooks for other keepers to run code in response to HARD modifications
@@ -55,3 +75,17 @@ type HARDHooks interface {
 	BeforeBorrowModified(ctx sdk.Context, borrow Borrow)
 	AfterBorrowModified(ctx sdk.Context, borrow Borrow)
 }
+
+// YieldStrategy is a module that earns yield on idle money market liquidity allocated to it by the hard module.
+// Allocated coins must be returned in full on withdrawal, the hard module recalls them whenever its own balance
+// cannot cover a withdrawal, borrow, liquidation, or reserve payout.
+type YieldStrategy interface {
+	// GetAddress returns the account that holds coins deposited into the strategy
+	GetAddress(ctx sdk.Context) sdk.AccAddress
+	// Deposit is called after coins have been sent from the hard module account to the strategy's address
+	Deposit(ctx sdk.Context, coins sdk.Coins) error
+	// Withdraw sends previously deposited coins back to the hard module account
+	Withdraw(ctx sdk.Context, coins sdk.Coins) error
+	// Harvest sends the yield earned on deposited coins of a denom to the hard module account
+	Harvest(ctx sdk.Context, denom string) error
+}
@@ -17,6 +17,15 @@ type GenesisState struct {
 	TotalSupplied             sdk.Coins                `json:"total_supplied" yaml:"total_supplied"`
 	TotalBorrowed             sdk.Coins                `json:"total_borrowed" yaml:"total_borrowed"`
 	TotalReserves             sdk.Coins                `json:"total_reserves" yaml:"total_reserves"`
+	RepayFirstAddresses       []sdk.AccAddress         `json:"repay_first_addresses" yaml:"repay_first_addresses"`
+	ReferralVolumes           ReferralVolumes          `json:"referral_volumes" yaml:"referral_volumes"`
+	InterestAudits            InterestAudits           `json:"interest_audits" yaml:"interest_audits"`
+	StrategyAllocations       sdk.Coins                `json:"strategy_allocations" yaml:"strategy_allocations"`
+	ScheduledMoneyMarkets     ScheduledMoneyMarkets    `json:"scheduled_money_markets" yaml:"scheduled_money_markets"`
+	MoneyMarketWindDowns      MoneyMarketWindDowns     `json:"money_market_wind_downs" yaml:"money_market_wind_downs"`
+	StopLosses                StopLosses               `json:"stop_losses" yaml:"stop_losses"`
+	BorrowHistories           BorrowHistories          `json:"borrow_histories" yaml:"borrow_histories"`
+	VestingDeposits           VestingDeposits          `json:"vesting_deposits" yaml:"vesting_deposits"`
 }
 
 // NewGenesisState returns a new genesis state
@@ -44,6 +53,15 @@ func DefaultGenesisState() GenesisState {
 		TotalSupplied:             DefaultTotalSupplied,
 		TotalBorrowed:             DefaultTotalBorrowed,
 		TotalReserves:             DefaultTotalReserves,
+		RepayFirstAddresses:       DefaultRepayFirstAddresses,
+		ReferralVolumes:           DefaultReferralVolumes,
+		InterestAudits:            DefaultInterestAudits,
+		StrategyAllocations:       DefaultStrategyAllocations,
+		ScheduledMoneyMarkets:     DefaultScheduledMoneyMarkets,
+		MoneyMarketWindDowns:      DefaultMoneyMarketWindDowns,
+		StopLosses:                DefaultStopLosses,
+		BorrowHistories:           DefaultBorrowHistories,
+		VestingDeposits:           DefaultVestingDeposits,
 	}
 }
 
@@ -73,6 +91,75 @@ func (gs GenesisState) Validate() error {
 	if !gs.TotalReserves.IsValid() {
 		return fmt.Errorf("invalid total reserves coins: %s", gs.TotalReserves)
 	}
+
+	seenAddresses := make(map[string]bool)
+	for _, addr := range gs.RepayFirstAddresses {
+		if addr.Empty() {
+			return fmt.Errorf("repay first address cannot be empty")
+		}
+		if seenAddresses[addr.String()] {
+			return fmt.Errorf("duplicate repay first address: %s", addr)
+		}
+		seenAddresses[addr.String()] = true
+	}
+	if err := gs.ReferralVolumes.Validate(); err != nil {
+		return err
+	}
+	if err := gs.InterestAudits.Validate(); err != nil {
+		return err
+	}
+	if !gs.StrategyAllocations.IsValid() {
+		return fmt.Errorf("invalid strategy allocation coins: %s", gs.StrategyAllocations)
+	}
+	if err := gs.ScheduledMoneyMarkets.Validate(); err != nil {
+		return err
+	}
+	for _, smm := range gs.ScheduledMoneyMarkets {
+		for _, mm := range gs.Params.MoneyMarkets {
+			if mm.Denom == smm.MoneyMarket.Denom {
+				return fmt.Errorf("scheduled money market already exists in params: %s", mm.Denom)
+			}
+		}
+	}
+	if err := gs.MoneyMarketWindDowns.Validate(); err != nil {
+		return err
+	}
+	for _, wd := range gs.MoneyMarketWindDowns {
+		found := false
+		for _, mm := range gs.Params.MoneyMarkets {
+			if mm.Denom == wd.Denom {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("wound down money market not found in params: %s", wd.Denom)
+		}
+	}
+	if err := gs.StopLosses.Validate(); err != nil {
+		return err
+	}
+	if err := gs.BorrowHistories.Validate(); err != nil {
+		return err
+	}
+	if err := gs.VestingDeposits.Validate(); err != nil {
+		return err
+	}
+	for _, vd := range gs.VestingDeposits {
+		found := false
+		for _, deposit := range gs.Deposits {
+			if deposit.Depositor.Equals(vd.Depositor) {
+				if !deposit.Amount.IsAllGTE(vd.Amount) {
+					return fmt.Errorf("vesting deposit %s exceeds deposit %s of %s", vd.Amount, deposit.Amount, vd.Depositor)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("vesting deposit without a deposit: %s", vd.Depositor)
+		}
+	}
 	return nil
 }
 
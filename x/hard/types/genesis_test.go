@@ -89,6 +89,81 @@ func (suite *GenesisTestSuite) TestGenesisValidation() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestInterestAuditsValidation() {
+	audit := types.NewInterestAudit("usdx", sdk.NewInt(100), sdk.NewInt(95), sdk.NewInt(5), sdk.MustNewDecFromStr("0.5"))
+	suite.NoError(types.InterestAudits{audit, types.NewEmptyInterestAudit("ukava")}.Validate())
+	suite.True(audit.Residual().IsZero())
+
+	err := types.InterestAudits{audit, audit}.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "duplicate interest audit denom")
+
+	negative := audit
+	negative.Dust = sdk.MustNewDecFromStr("-0.1")
+	err = types.InterestAudits{negative}.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "dust cannot be negative")
+
+	gs := types.DefaultGenesisState()
+	gs.InterestAudits = types.InterestAudits{types.NewEmptyInterestAudit("")}
+	suite.Error(gs.Validate())
+}
+
+func (suite *GenesisTestSuite) TestStopLossesValidation() {
+	stopLoss := types.NewStopLoss(sdk.AccAddress("test1"), sdk.MustNewDecFromStr("0.7"), sdk.NewDec(100))
+	other := types.NewStopLoss(sdk.AccAddress("test2"), sdk.MustNewDecFromStr("0.5"), sdk.NewDec(50))
+	suite.NoError(types.StopLosses{stopLoss, other}.Validate())
+
+	err := types.StopLosses{stopLoss, stopLoss}.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "duplicate stop loss borrower")
+
+	gs := types.DefaultGenesisState()
+	gs.StopLosses = types.StopLosses{types.NewStopLoss(sdk.AccAddress{}, sdk.MustNewDecFromStr("0.7"), sdk.NewDec(100))}
+	suite.Error(gs.Validate())
+}
+
+func (suite *GenesisTestSuite) TestBorrowHistoriesValidation() {
+	entry := types.NewBorrowHistoryEntry(types.BorrowHistoryRepayment, sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.NewInt(10), 5, time.Unix(100, 0))
+	history := types.NewBorrowHistory(sdk.AccAddress("test1"), sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000))), types.BorrowHistoryEntries{entry})
+	other := types.NewBorrowHistory(sdk.AccAddress("test2"), sdk.NewCoins(), types.BorrowHistoryEntries{})
+	suite.NoError(types.BorrowHistories{history, other}.Validate())
+
+	err := types.BorrowHistories{history, history}.Validate()
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), "duplicate borrow history borrower")
+
+	// interest cannot exceed the amount repaid, and originations pay no interest
+	invalid := types.NewBorrowHistoryEntry(types.BorrowHistoryRepayment, sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.NewInt(101), 5, time.Unix(100, 0))
+	suite.Error(invalid.Validate())
+	invalid = types.NewBorrowHistoryEntry(types.BorrowHistoryOrigination, sdk.NewCoin("bnb", sdk.NewInt(100)), sdk.NewInt(10), 5, time.Unix(100, 0))
+	suite.Error(invalid.Validate())
+
+	gs := types.DefaultGenesisState()
+	gs.BorrowHistories = types.BorrowHistories{types.NewBorrowHistory(sdk.AccAddress("test1"), sdk.NewCoins(), types.BorrowHistoryEntries{invalid})}
+	suite.Error(gs.Validate())
+}
+
+func (suite *GenesisTestSuite) TestVestingDepositsValidation() {
+	depositor := sdk.AccAddress("test1")
+	deposit := types.NewDeposit(depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1000))), types.SupplyInterestFactors{})
+	vestingDeposit := types.NewVestingDeposit(depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))))
+
+	gs := types.DefaultGenesisState()
+	gs.Deposits = types.Deposits{deposit}
+	gs.VestingDeposits = types.VestingDeposits{vestingDeposit}
+	suite.NoError(gs.Validate())
+
+	gs.VestingDeposits = types.VestingDeposits{vestingDeposit, vestingDeposit}
+	suite.Error(gs.Validate())
+
+	// vesting deposits must be covered by a deposit
+	gs.VestingDeposits = types.VestingDeposits{types.NewVestingDeposit(depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(1001))))}
+	suite.Error(gs.Validate())
+	gs.VestingDeposits = types.VestingDeposits{types.NewVestingDeposit(sdk.AccAddress("test2"), sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))))}
+	suite.Error(gs.Validate())
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// LiquidationHealthFactor is the health factor above which a position can be liquidated
+	LiquidationHealthFactor = sdk.OneDec()
+	// MaxHealthFactor caps the health factor of positions whose borrows are very large relative to their borrowable
+	// value, including positions with borrows and no borrowable value at all
+	MaxHealthFactor = sdk.NewDec(1000000)
+)
+
+// CalculateHealthFactor returns the health factor of a position: the USD value of its borrows divided by the USD value
+// it can borrow, which is the value of its deposits multiplied by their loan-to-value ratios. A position without
+// borrows has a health factor of zero, and a position is liquidatable once its health factor is above one.
+func CalculateHealthFactor(borrowedUSD, borrowableUSD sdk.Dec) sdk.Dec {
+	if !borrowedUSD.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	if !borrowableUSD.IsPositive() || borrowedUSD.GT(borrowableUSD.Mul(MaxHealthFactor)) {
+		return MaxHealthFactor
+	}
+	return borrowedUSD.Quo(borrowableUSD)
+}
+
+// IsLiquidatable returns true if a position with the given health factor can be liquidated
+func IsLiquidatable(healthFactor sdk.Dec) bool {
+	return healthFactor.GT(LiquidationHealthFactor)
+}
+
+// PositionHealth is the health factor of an account's position along with the USD values it is calculated from
+type PositionHealth struct {
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
+	BorrowedUSD   sdk.Dec        `json:"borrowed_usd" yaml:"borrowed_usd"`
+	BorrowableUSD sdk.Dec        `json:"borrowable_usd" yaml:"borrowable_usd"`
+	HealthFactor  sdk.Dec        `json:"health_factor" yaml:"health_factor"`
+	Liquidatable  bool           `json:"liquidatable" yaml:"liquidatable"`
+}
+
+// NewPositionHealth returns a new PositionHealth calculated from a position's borrowed and borrowable USD values
+func NewPositionHealth(owner sdk.AccAddress, borrowedUSD, borrowableUSD sdk.Dec) PositionHealth {
+	healthFactor := CalculateHealthFactor(borrowedUSD, borrowableUSD)
+	return PositionHealth{
+		Owner:         owner,
+		BorrowedUSD:   borrowedUSD,
+		BorrowableUSD: borrowableUSD,
+		HealthFactor:  healthFactor,
+		Liquidatable:  IsLiquidatable(healthFactor),
+	}
+}
+
+// String implements fmt.Stringer
+func (ph PositionHealth) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`PositionHealth:
+	Owner: %s
+	Borrowed USD: %s
+	Borrowable USD: %s
+	Health Factor: %s
+	Liquidatable: %t`,
+		ph.Owner, ph.BorrowedUSD, ph.BorrowableUSD, ph.HealthFactor, ph.Liquidatable,
+	))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// InterestRateModelChange records the most recent change of a money market's interest rate model.
+// Interest is accrued under the previous model up to the change and under the new model afterwards.
+type InterestRateModelChange struct {
+	Denom         string            `json:"denom" yaml:"denom"`
+	PreviousModel InterestRateModel `json:"previous_model" yaml:"previous_model"`
+	NewModel      InterestRateModel `json:"new_model" yaml:"new_model"`
+	Height        int64             `json:"height" yaml:"height"`
+	Time          time.Time         `json:"time" yaml:"time"`
+}
+
+// NewInterestRateModelChange returns a new InterestRateModelChange
+func NewInterestRateModelChange(denom string, previousModel, newModel InterestRateModel, height int64, changeTime time.Time) InterestRateModelChange {
+	return InterestRateModelChange{
+		Denom:         denom,
+		PreviousModel: previousModel,
+		NewModel:      newModel,
+		Height:        height,
+		Time:          changeTime,
+	}
+}
+
+// String implements fmt.Stringer
+func (c InterestRateModelChange) String() string {
+	return fmt.Sprintf(`Interest Rate Model Change:
+	Denom: %s
+	Previous Model: %s
+	New Model: %s
+	Height: %d
+	Time: %s
+`, c.Denom, c.PreviousModel, c.NewModel, c.Height, c.Time)
+}
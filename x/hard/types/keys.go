@@ -1,5 +1,10 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+)
+
 const (
 	// ModuleName name that will be used throughout the module
 	ModuleName = "hard"
@@ -7,9 +12,15 @@ const (
 	// ModuleAccountName name of module account used to hold deposits
 	ModuleAccountName = "hard"
 
+	// ProtocolLiquidityName is the name the address of the protocol owned deposit is derived from
+	ProtocolLiquidityName = "hard_protocol_liquidity"
+
 	// StoreKey Top level store key where all module items will be stored
 	StoreKey = ModuleName
 
+	// TStoreKey is the transient store key, for state that is discarded at the end of each block
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey Top level router key
 	RouterKey = ModuleName
 
@@ -18,6 +29,9 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// StoreVersion is the version of the store layout written by this version of the module
+	StoreVersion uint64 = 9
 )
 
 var (
@@ -31,7 +45,29 @@ var (
 	BorrowInterestFactorPrefix    = []byte{0x08} // denom -> sdk.Dec
 	SupplyInterestFactorPrefix    = []byte{0x09} // denom -> sdk.Dec
 	DelegatorInterestFactorPrefix = []byte{0x10} // denom -> sdk.Dec
+	StoreVersionKey               = []byte{0x11}
+	InterestRateModelChangePrefix = []byte{0x12} // denom -> InterestRateModelChange
+	RepayFirstPrefix              = []byte{0x13} // address -> repay first preference
+	ReferralVolumePrefix          = []byte{0x14} // referrer address -> sdk.Coins
+	BorrowsByDenomPrefix          = []byte{0x15} // denom:borrower address -> empty
+	LiquidationCursorKey          = []byte{0x16} // -> last borrower address checked by begin blocker liquidations
+	InterestAuditPrefix           = []byte{0x17} // denom -> InterestAudit
+	StrategyAllocationsPrefix     = []byte{0x18} // -> sdk.Coins allocated to yield strategies
+	ScheduledMoneyMarketsPrefix   = []byte{0x19} // denom -> ScheduledMoneyMarket
+	MoneyMarketWindDownsPrefix    = []byte{0x1a} // denom -> MoneyMarketWindDown
+	StopLossesPrefix              = []byte{0x1b} // borrower address -> StopLoss
+	BorrowHistoriesPrefix         = []byte{0x1c} // borrower address -> BorrowHistory
+	VestingDepositsPrefix         = []byte{0x1d} // depositor address -> sdk.Coins
 	sep                           = []byte(":")
+
+	// BlockStatsKey is the key of the current block's stats in the transient store
+	BlockStatsKey = []byte{0x01}
+	// PriceCachePrefix is the prefix of the prices cached in the transient store for the current block
+	PriceCachePrefix = []byte{0x02} // block height:market id -> sdk.Dec
+
+	// ProtocolLiquidityAddress is the depositor of the protocol owned deposit. No key controls it, so the deposit
+	// can only be changed by governance proposals.
+	ProtocolLiquidityAddress = supply.NewModuleAddress(ProtocolLiquidityName)
 )
 
 // DepositTypeIteratorKey returns an interator prefix for interating over deposits by deposit denom
@@ -39,6 +75,21 @@ func DepositTypeIteratorKey(denom string) []byte {
 	return createKey([]byte(denom))
 }
 
+// BorrowsByDenomKey returns the key of a borrower in the index of borrows by denom
+func BorrowsByDenomKey(denom string, borrower sdk.AccAddress) []byte {
+	return createKey([]byte(denom), sep, borrower)
+}
+
+// BorrowsByDenomIteratorKey returns an iterator prefix for iterating over the borrowers of a denom
+func BorrowsByDenomIteratorKey(denom string) []byte {
+	return createKey([]byte(denom), sep)
+}
+
+// PriceCacheKey returns the key of a market's cached price at a block height
+func PriceCacheKey(height int64, marketID string) []byte {
+	return createKey(sdk.Uint64ToBigEndian(uint64(height)), sep, []byte(marketID))
+}
+
 func createKey(bytes ...[]byte) (r []byte) {
 	for _, b := range bytes {
 		r = append(r, b...)
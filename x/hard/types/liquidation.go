@@ -68,3 +68,13 @@ func (m ValuationMap) GetSortedKeys() []string {
 	sort.Strings(keys)
 	return keys
 }
+
+// GetKeysByValue returns an array of the map's keys ordered from highest to lowest USD value, with keys of equal
+// value in alphabetical order
+func (m ValuationMap) GetKeysByValue() []string {
+	keys := m.GetSortedKeys()
+	sort.SliceStable(keys, func(i, j int) bool {
+		return m.Usd[keys[i]].GT(m.Usd[keys[j]])
+	})
+	return keys
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ScheduledMoneyMarket is a money market approved by governance that is added to the params at its activation time
+type ScheduledMoneyMarket struct {
+	MoneyMarket    MoneyMarket `json:"money_market" yaml:"money_market"`
+	ActivationTime time.Time   `json:"activation_time" yaml:"activation_time"`
+}
+
+// NewScheduledMoneyMarket returns a new ScheduledMoneyMarket
+func NewScheduledMoneyMarket(moneyMarket MoneyMarket, activationTime time.Time) ScheduledMoneyMarket {
+	return ScheduledMoneyMarket{
+		MoneyMarket:    moneyMarket,
+		ActivationTime: activationTime,
+	}
+}
+
+// Validate performs basic validation of a ScheduledMoneyMarket. Unlike money markets in the params, listings
+// come from user submitted proposals, so fields that are left out of the proposal json are rejected up front.
+func (smm ScheduledMoneyMarket) Validate() error {
+	mm := smm.MoneyMarket
+	required := []struct {
+		name  string
+		value sdk.Dec
+	}{
+		{"borrow limit maximum limit", mm.BorrowLimit.MaximumLimit},
+		{"borrow limit loan to value", mm.BorrowLimit.LoanToValue},
+		{"interest rate model base rate apy", mm.InterestRateModel.BaseRateAPY},
+		{"interest rate model base multiplier", mm.InterestRateModel.BaseMultiplier},
+		{"interest rate model kink", mm.InterestRateModel.Kink},
+		{"interest rate model jump multiplier", mm.InterestRateModel.JumpMultiplier},
+		{"reserve factor", mm.ReserveFactor},
+		{"keeper reward percentage", mm.KeeperRewardPercentage},
+	}
+	for _, field := range required {
+		if field.value.IsNil() {
+			return fmt.Errorf("%s cannot be empty", field.name)
+		}
+	}
+	if err := mm.Validate(); err != nil {
+		return err
+	}
+	if len(mm.SpotMarketID) == 0 {
+		return errors.New("spot market id cannot be empty")
+	}
+	if mm.ConversionFactor.IsNil() || !mm.ConversionFactor.IsPositive() {
+		return fmt.Errorf("conversion factor must be positive: %s", mm.ConversionFactor)
+	}
+	if smm.ActivationTime.IsZero() {
+		return errors.New("activation time cannot be empty")
+	}
+	return nil
+}
+
+// IsActive returns true if the money market should be added to the params at the given block time
+func (smm ScheduledMoneyMarket) IsActive(blockTime time.Time) bool {
+	return !smm.ActivationTime.After(blockTime)
+}
+
+// ScheduledMoneyMarkets slice of ScheduledMoneyMarket
+type ScheduledMoneyMarkets []ScheduledMoneyMarket
+
+// Validate performs basic validation of each scheduled money market and checks that no denom is scheduled twice
+func (smms ScheduledMoneyMarkets) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, smm := range smms {
+		if err := smm.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[smm.MoneyMarket.Denom] {
+			return fmt.Errorf("duplicate scheduled money market: %s", smm.MoneyMarket.Denom)
+		}
+		seenDenoms[smm.MoneyMarket.Denom] = true
+	}
+	return nil
+}
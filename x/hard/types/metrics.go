@@ -0,0 +1,63 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem is the subsystem of all metrics exposed by the hard module
+const MetricsSubsystem = ModuleName
+
+// Metrics contains the metrics exposed by the hard module
+type Metrics struct {
+	// Total coins supplied to each money market, labeled by denom
+	TotalSupplied metrics.Gauge
+	// Total coins borrowed from each money market, labeled by denom
+	TotalBorrowed metrics.Gauge
+	// Utilization ratio of each money market, labeled by denom
+	Utilization metrics.Gauge
+	// Number of liquidated positions
+	Liquidations metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics registered with the default Prometheus registry
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		TotalSupplied: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "total_supplied",
+			Help:      "Total coins supplied to a money market.",
+		}, []string{"denom"}),
+		TotalBorrowed: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "total_borrowed",
+			Help:      "Total coins borrowed from a money market.",
+		}, []string{"denom"}),
+		Utilization: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "utilization_ratio",
+			Help:      "Ratio of borrowed coins to supplied coins in a money market.",
+		}, []string{"denom"}),
+		Liquidations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "liquidations",
+			Help:      "Number of liquidated positions.",
+		}, []string{}),
+	}
+}
+
+// NopMetrics returns no-op Metrics
+func NopMetrics() *Metrics {
+	return &Metrics{
+		TotalSupplied: discard.NewGauge(),
+		TotalBorrowed: discard.NewGauge(),
+		Utilization:   discard.NewGauge(),
+		Liquidations:  discard.NewCounter(),
+	}
+}
@@ -16,10 +16,11 @@ var (
 	_ sdk.Msg = &MsgLiquidate{}
 )
 
-// MsgDeposit deposit collateral to the hard module.
+// MsgDeposit deposit collateral to the hard module. The optional referrer is credited with the deposited volume.
 type MsgDeposit struct {
 	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+	Referrer  sdk.AccAddress `json:"referrer,omitempty" yaml:"referrer,omitempty"`
 }
 
 // NewMsgDeposit returns a new MsgDeposit
@@ -44,6 +45,9 @@ func (msg MsgDeposit) ValidateBasic() error {
 	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
 	}
+	if msg.Referrer.Equals(msg.Depositor) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor cannot refer their own deposit")
+	}
 	return nil
 }
 
@@ -63,7 +67,8 @@ func (msg MsgDeposit) String() string {
 	return fmt.Sprintf(`Deposit Message:
 	Depositor:         %s
 	Amount: %s
-`, msg.Depositor, msg.Amount)
+	Referrer: %s
+`, msg.Depositor, msg.Amount, msg.Referrer)
 }
 
 // MsgWithdraw withdraw from the hard module.
@@ -271,3 +276,162 @@ func (msg MsgLiquidate) String() string {
 	Borrower:         %s
 `, msg.Keeper, msg.Borrower)
 }
+
+// MsgAccrueInterest accrues interest for a money market, bringing its interest factors up to date
+type MsgAccrueInterest struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Denom  string         `json:"denom" yaml:"denom"`
+}
+
+// NewMsgAccrueInterest returns a new MsgAccrueInterest
+func NewMsgAccrueInterest(sender sdk.AccAddress, denom string) MsgAccrueInterest {
+	return MsgAccrueInterest{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgAccrueInterest) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgAccrueInterest) Type() string { return "accrue_interest" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgAccrueInterest) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgAccrueInterest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgAccrueInterest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgAccrueInterest) String() string {
+	return fmt.Sprintf(`Accrue Interest Message:
+	Sender:         %s
+	Denom:          %s
+`, msg.Sender, msg.Denom)
+}
+
+// MsgSetRepayFirst sets whether deposits of a denom the sender has borrowed are used to repay the borrow first
+type MsgSetRepayFirst struct {
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Enabled bool           `json:"enabled" yaml:"enabled"`
+}
+
+// NewMsgSetRepayFirst returns a new MsgSetRepayFirst
+func NewMsgSetRepayFirst(sender sdk.AccAddress, enabled bool) MsgSetRepayFirst {
+	return MsgSetRepayFirst{
+		Sender:  sender,
+		Enabled: enabled,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetRepayFirst) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetRepayFirst) Type() string { return "hard_set_repay_first" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetRepayFirst) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetRepayFirst) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetRepayFirst) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgSetRepayFirst) String() string {
+	return fmt.Sprintf(`Set Repay First Message:
+	Sender:         %s
+	Enabled:        %t
+`, msg.Sender, msg.Enabled)
+}
+
+// MsgSetStopLoss sets the sender's stop loss, which repays their borrow from their deposits once their LTV rises
+// above the threshold. A zero LTV threshold removes the sender's stop loss.
+type MsgSetStopLoss struct {
+	Sender        sdk.AccAddress `json:"sender" yaml:"sender"`
+	LtvThreshold  sdk.Dec        `json:"ltv_threshold" yaml:"ltv_threshold"`
+	MaxRepayValue sdk.Dec        `json:"max_repay_value" yaml:"max_repay_value"`
+}
+
+// NewMsgSetStopLoss returns a new MsgSetStopLoss
+func NewMsgSetStopLoss(sender sdk.AccAddress, ltvThreshold, maxRepayValue sdk.Dec) MsgSetStopLoss {
+	return MsgSetStopLoss{
+		Sender:        sender,
+		LtvThreshold:  ltvThreshold,
+		MaxRepayValue: maxRepayValue,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetStopLoss) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetStopLoss) Type() string { return "hard_set_stop_loss" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSetStopLoss) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if msg.IsRemoval() {
+		return nil
+	}
+	if err := NewStopLoss(msg.Sender, msg.LtvThreshold, msg.MaxRepayValue).Validate(); err != nil {
+		return sdkerrors.Wrap(ErrInvalidStopLoss, err.Error())
+	}
+	return nil
+}
+
+// IsRemoval returns true if the message removes the sender's stop loss
+func (msg MsgSetStopLoss) IsRemoval() bool {
+	return !msg.LtvThreshold.IsNil() && msg.LtvThreshold.IsZero()
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetStopLoss) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetStopLoss) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgSetStopLoss) String() string {
+	return fmt.Sprintf(`Set Stop Loss Message:
+	Sender:         %s
+	LTV Threshold:  %s
+	Max Repay Value: %s
+`, msg.Sender, msg.LtvThreshold, msg.MaxRepayValue)
+}
@@ -19,6 +19,7 @@ func (suite *MsgTestSuite) TestMsgDeposit() {
 	type args struct {
 		depositor sdk.AccAddress
 		amount    sdk.Coins
+		referrer  sdk.AccAddress
 	}
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress("test1"),
@@ -48,10 +49,31 @@ func (suite *MsgTestSuite) TestMsgDeposit() {
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "valid with referrer",
+			args: args{
+				depositor: addrs[0],
+				amount:    sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000))),
+				referrer:  addrs[1],
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "self referral",
+			args: args{
+				depositor: addrs[0],
+				amount:    sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(10000000))),
+				referrer:  addrs[0],
+			},
+			expectPass:  false,
+			expectedErr: "cannot refer their own deposit",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			msg := types.NewMsgDeposit(tc.args.depositor, tc.args.amount)
+			msg.Referrer = tc.args.referrer
 			err := msg.ValidateBasic()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -189,6 +211,168 @@ func (suite *MsgTestSuite) TestMsgRepay() {
 	}
 }
 
+func (suite *MsgTestSuite) TestMsgAccrueInterest() {
+	type args struct {
+		sender sdk.AccAddress
+		denom  string
+	}
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			args: args{
+				sender: addrs[0],
+				denom:  "bnb",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "empty sender",
+			args: args{
+				sender: sdk.AccAddress{},
+				denom:  "bnb",
+			},
+			expectPass:  false,
+			expectedErr: "sender address cannot be empty",
+		},
+		{
+			name: "invalid denom",
+			args: args{
+				sender: addrs[0],
+				denom:  "",
+			},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgAccrueInterest(tc.args.sender, tc.args.denom)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetRepayFirst() {
+	testCases := []struct {
+		name        string
+		sender      sdk.AccAddress
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			sender:      sdk.AccAddress("test1"),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "empty sender",
+			sender:      sdk.AccAddress{},
+			expectPass:  false,
+			expectedErr: "sender address cannot be empty",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetRepayFirst(tc.sender, true)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *MsgTestSuite) TestMsgSetStopLoss() {
+	testCases := []struct {
+		name          string
+		sender        sdk.AccAddress
+		ltvThreshold  sdk.Dec
+		maxRepayValue sdk.Dec
+		expectPass    bool
+		expectedErr   string
+	}{
+		{
+			name:          "valid",
+			sender:        sdk.AccAddress("test1"),
+			ltvThreshold:  sdk.MustNewDecFromStr("0.7"),
+			maxRepayValue: sdk.NewDec(100),
+			expectPass:    true,
+			expectedErr:   "",
+		},
+		{
+			name:          "valid: removal",
+			sender:        sdk.AccAddress("test1"),
+			ltvThreshold:  sdk.ZeroDec(),
+			maxRepayValue: sdk.ZeroDec(),
+			expectPass:    true,
+			expectedErr:   "",
+		},
+		{
+			name:          "empty sender",
+			sender:        sdk.AccAddress{},
+			ltvThreshold:  sdk.MustNewDecFromStr("0.7"),
+			maxRepayValue: sdk.NewDec(100),
+			expectPass:    false,
+			expectedErr:   "sender address cannot be empty",
+		},
+		{
+			name:          "threshold of one",
+			sender:        sdk.AccAddress("test1"),
+			ltvThreshold:  sdk.OneDec(),
+			maxRepayValue: sdk.NewDec(100),
+			expectPass:    false,
+			expectedErr:   "LTV threshold must be between (0, 1)",
+		},
+		{
+			name:          "negative threshold",
+			sender:        sdk.AccAddress("test1"),
+			ltvThreshold:  sdk.MustNewDecFromStr("-0.5"),
+			maxRepayValue: sdk.NewDec(100),
+			expectPass:    false,
+			expectedErr:   "LTV threshold must be between (0, 1)",
+		},
+		{
+			name:          "zero max repay value",
+			sender:        sdk.AccAddress("test1"),
+			ltvThreshold:  sdk.MustNewDecFromStr("0.7"),
+			maxRepayValue: sdk.ZeroDec(),
+			expectPass:    false,
+			expectedErr:   "max repay value must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := types.NewMsgSetStopLoss(tc.sender, tc.ltvThreshold, tc.maxRepayValue)
+			err := msg.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
 func TestMsgTestSuite(t *testing.T) {
 	suite.Run(t, new(MsgTestSuite))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -11,20 +12,81 @@ import (
 
 // Parameter keys and default values
 var (
-	KeyMoneyMarkets          = []byte("MoneyMarkets")
-	DefaultMoneyMarkets      = MoneyMarkets{}
-	GovDenom                 = cdptypes.DefaultGovDenom
-	DefaultAccumulationTimes = GenesisAccumulationTimes{}
-	DefaultTotalSupplied     = sdk.Coins{}
-	DefaultTotalBorrowed     = sdk.Coins{}
-	DefaultTotalReserves     = sdk.Coins{}
-	DefaultDeposits          = Deposits{}
-	DefaultBorrows           = Borrows{}
+	KeyMoneyMarkets                             = []byte("MoneyMarkets")
+	KeySwapLiquidations                         = []byte("SwapLiquidations")
+	KeyMinimumAccrualInterval                   = []byte("MinimumAccrualInterval")
+	KeyLiquidationGasBudget                     = []byte("LiquidationGasBudget")
+	KeyLiquidationOrder                         = []byte("LiquidationOrder")
+	KeyMaxAnnualRate                            = []byte("MaxAnnualRate")
+	KeyBorrowHistoryLength                      = []byte("BorrowHistoryLength")
+	KeyHealthFactorWarning                      = []byte("HealthFactorWarning")
+	KeyTierBorrowRules                          = []byte("TierBorrowRules")
+	DefaultMoneyMarkets                         = MoneyMarkets{}
+	GovDenom                                    = cdptypes.DefaultGovDenom
+	DefaultAccumulationTimes                    = GenesisAccumulationTimes{}
+	DefaultTotalSupplied                        = sdk.Coins{}
+	DefaultTotalBorrowed                        = sdk.Coins{}
+	DefaultTotalReserves                        = sdk.Coins{}
+	DefaultDeposits                             = Deposits{}
+	DefaultBorrows                              = Borrows{}
+	DefaultRepayFirstAddresses                  = []sdk.AccAddress{}
+	DefaultReferralVolumes                      = ReferralVolumes{}
+	DefaultInterestAudits                       = InterestAudits{}
+	DefaultStrategyAllocations                  = sdk.Coins{}
+	DefaultScheduledMoneyMarkets                = ScheduledMoneyMarkets{}
+	DefaultMoneyMarketWindDowns                 = MoneyMarketWindDowns{}
+	DefaultStopLosses                           = StopLosses{}
+	DefaultBorrowHistories                      = BorrowHistories{}
+	DefaultVestingDeposits                      = VestingDeposits{}
+	DefaultSupplyLimit                          = sdk.ZeroInt()
+	DefaultCloseFactor                          = sdk.OneDec()
+	DefaultMinBorrowAPY                         = sdk.ZeroDec()
+	DefaultMaxBorrowAPY                         = sdk.ZeroDec()
+	DefaultWithdrawFee                          = sdk.ZeroDec()
+	DefaultMaxStrategyAllocation                = sdk.ZeroDec()
+	DefaultMinimumAccrualInterval time.Duration = 0
+	DefaultLiquidationGasBudget   uint64        = 0
+	DefaultLiquidationOrder                     = LiquidationOrderProportional
+	DefaultMaxAnnualRate                        = sdk.ZeroDec()
+	DefaultBorrowHistoryLength    uint64        = 100
+	DefaultHealthFactorWarning                  = sdk.MustNewDecFromStr("0.9")
+	DefaultTierBorrowRules                      = TierBorrowRules{}
+)
+
+// Liquidation orders control how the collateral of a position with deposits in several denoms is seized
+const (
+	// LiquidationOrderProportional seizes the same share of every deposit denom and auctions them in denom order
+	LiquidationOrderProportional = "proportional"
+	// LiquidationOrderHighestValue seizes the deposit denoms with the highest USD value first
+	LiquidationOrderHighestValue = "highest_value"
+	// LiquidationOrderMostLiquid seizes the deposit denoms with the most USD value available to withdraw from the
+	// hard module account first
+	LiquidationOrderMostLiquid = "most_liquid"
 )
 
 // Params governance parameters for hard module
 type Params struct {
-	MoneyMarkets MoneyMarkets `json:"money_markets" yaml:"money_markets"`
+	MoneyMarkets     MoneyMarkets     `json:"money_markets" yaml:"money_markets"`
+	SwapLiquidations SwapLiquidations `json:"swap_liquidations" yaml:"swap_liquidations"`
+	// MinimumAccrualInterval is the minimum time between interest accruals in the begin blocker
+	MinimumAccrualInterval time.Duration `json:"minimum_accrual_interval" yaml:"minimum_accrual_interval"`
+	// LiquidationGasBudget is the gas the begin blocker may use each block to check positions and liquidate those
+	// outside the valid LTV range, zero disables begin blocker liquidations
+	LiquidationGasBudget uint64 `json:"liquidation_gas_budget" yaml:"liquidation_gas_budget"`
+	// LiquidationOrder is the order in which the deposit denoms of a position are seized when it is liquidated
+	LiquidationOrder string `json:"liquidation_order" yaml:"liquidation_order"`
+	// MaxAnnualRate is the highest borrow rate any money market's interest rate model may set at full utilization,
+	// zero for no limit
+	MaxAnnualRate sdk.Dec `json:"max_annual_rate" yaml:"max_annual_rate"`
+	// BorrowHistoryLength is the number of borrow originations and repayments kept in each account's borrow history,
+	// zero disables borrow histories
+	BorrowHistoryLength uint64 `json:"borrow_history_length" yaml:"borrow_history_length"`
+	// HealthFactorWarning is the health factor at or above which borrows and withdrawals emit a health factor warning
+	// event, zero disables warnings
+	HealthFactorWarning sdk.Dec `json:"health_factor_warning" yaml:"health_factor_warning"`
+	// TierBorrowRules define which money market risk tiers can collateralize borrows from other risk tiers, and the
+	// LTV haircut applied to the collateral when they do
+	TierBorrowRules TierBorrowRules `json:"tier_borrow_rules" yaml:"tier_borrow_rules"`
 }
 
 // BorrowLimit enforces restrictions on a money market
@@ -32,14 +94,20 @@ type BorrowLimit struct {
 	HasMaxLimit  bool    `json:"has_max_limit" yaml:"has_max_limit"`
 	MaximumLimit sdk.Dec `json:"maximum_limit" yaml:"maximum_limit"`
 	LoanToValue  sdk.Dec `json:"loan_to_value" yaml:"loan_to_value"`
+	// InitialLimit, RampStartTime, and RampDuration raise the maximum limit linearly from InitialLimit at
+	// RampStartTime to MaximumLimit after RampDuration. A zero RampDuration disables the ramp.
+	InitialLimit  sdk.Dec       `json:"initial_limit" yaml:"initial_limit"`
+	RampStartTime time.Time     `json:"ramp_start_time" yaml:"ramp_start_time"`
+	RampDuration  time.Duration `json:"ramp_duration" yaml:"ramp_duration"`
 }
 
-// NewBorrowLimit returns a new BorrowLimit
+// NewBorrowLimit returns a new BorrowLimit with no ramp
 func NewBorrowLimit(hasMaxLimit bool, maximumLimit, loanToValue sdk.Dec) BorrowLimit {
 	return BorrowLimit{
 		HasMaxLimit:  hasMaxLimit,
 		MaximumLimit: maximumLimit,
 		LoanToValue:  loanToValue,
+		InitialLimit: sdk.ZeroDec(),
 	}
 }
 
@@ -54,9 +122,43 @@ func (bl BorrowLimit) Validate() error {
 	if bl.LoanToValue.GT(sdk.OneDec()) {
 		return fmt.Errorf("loan-to-value cannot be greater than 1.0: %s", bl.LoanToValue)
 	}
+	if bl.RampDuration < 0 || (bl.RampDuration > 0 && bl.RampDuration < time.Second) {
+		return fmt.Errorf("ramp duration must be zero or at least one second: %s", bl.RampDuration)
+	}
+	if bl.RampDuration > 0 {
+		if !bl.HasMaxLimit {
+			return fmt.Errorf("borrow limit ramp requires a maximum limit")
+		}
+		if bl.InitialLimit.IsNil() || bl.InitialLimit.IsNegative() || bl.InitialLimit.GT(bl.MaximumLimit) {
+			return fmt.Errorf("initial limit must be between 0 and the maximum limit %s: %s", bl.MaximumLimit, bl.InitialLimit)
+		}
+		if bl.RampStartTime.IsZero() {
+			return fmt.Errorf("ramp start time cannot be empty")
+		}
+	}
 	return nil
 }
 
+// IsRamping returns true if the maximum limit has not reached its final value at the given block time
+func (bl BorrowLimit) IsRamping(blockTime time.Time) bool {
+	return bl.RampDuration > 0 && blockTime.Before(bl.RampStartTime.Add(bl.RampDuration))
+}
+
+// MaximumLimitAt returns the maximum limit in effect at the given block time. While the ramp is in progress the
+// limit is interpolated linearly between the initial and maximum limits.
+func (bl BorrowLimit) MaximumLimitAt(blockTime time.Time) sdk.Dec {
+	if !bl.IsRamping(blockTime) {
+		return bl.MaximumLimit
+	}
+	if !blockTime.After(bl.RampStartTime) {
+		return bl.InitialLimit
+	}
+	// seconds are precise enough for the ramp and keep the intermediate product small
+	elapsed := int64(blockTime.Sub(bl.RampStartTime) / time.Second)
+	rampIncrease := bl.MaximumLimit.Sub(bl.InitialLimit).MulInt64(elapsed).QuoInt64(int64(bl.RampDuration / time.Second))
+	return bl.InitialLimit.Add(rampIncrease)
+}
+
 // Equal returns a boolean indicating if an BorrowLimit is equal to another BorrowLimit
 func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	if bl.HasMaxLimit != blCompareTo.HasMaxLimit {
@@ -68,9 +170,52 @@ func (bl BorrowLimit) Equal(blCompareTo BorrowLimit) bool {
 	if !bl.LoanToValue.Equal(blCompareTo.LoanToValue) {
 		return false
 	}
+	if bl.RampDuration != blCompareTo.RampDuration || !bl.RampStartTime.Equal(blCompareTo.RampStartTime) {
+		return false
+	}
+	if !bl.initialLimit().Equal(blCompareTo.initialLimit()) {
+		return false
+	}
 	return true
 }
 
+// initialLimit returns the initial limit, treating an unset initial limit as zero
+func (bl BorrowLimit) initialLimit() sdk.Dec {
+	if bl.InitialLimit.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return bl.InitialLimit
+}
+
+// PriceSource selects which pricefeed price a money market uses to value deposits and borrows
+type PriceSource string
+
+// Supported price sources. An empty price source is treated as spot for compatibility with older params.
+const (
+	// PriceSourceSpot values deposits and borrows at the spot price
+	PriceSourceSpot PriceSource = "spot"
+	// PriceSourceTwap values deposits and borrows at the time weighted average price
+	PriceSourceTwap PriceSource = "twap"
+	// PriceSourceConservative values deposits at the lower and borrows at the higher of the spot and time weighted
+	// average prices
+	PriceSourceConservative PriceSource = "conservative"
+)
+
+// Validate checks the price source is supported
+func (ps PriceSource) Validate() error {
+	switch ps {
+	case "", PriceSourceSpot, PriceSourceTwap, PriceSourceConservative:
+		return nil
+	default:
+		return fmt.Errorf("invalid price source: %s", ps)
+	}
+}
+
+// UsesTwap returns true if the price source requires a time weighted average price
+func (ps PriceSource) UsesTwap() bool {
+	return ps == PriceSourceTwap || ps == PriceSourceConservative
+}
+
 // MoneyMarket is a money market for an individual asset
 type MoneyMarket struct {
 	Denom                  string            `json:"denom" yaml:"denom"`
@@ -80,9 +225,21 @@ type MoneyMarket struct {
 	InterestRateModel      InterestRateModel `json:"interest_rate_model" yaml:"interest_rate_model"`
 	ReserveFactor          sdk.Dec           `json:"reserve_factor" yaml:"reserve_factor"`
 	KeeperRewardPercentage sdk.Dec           `json:"keeper_reward_percentage" yaml:"keeper_reward_percentages"`
+	SupplyLimit            sdk.Int           `json:"supply_limit" yaml:"supply_limit"`
+	CloseFactor            sdk.Dec           `json:"close_factor" yaml:"close_factor"`
+	TwapMarketID           string            `json:"twap_market_id" yaml:"twap_market_id"`
+	PriceSource            PriceSource       `json:"price_source" yaml:"price_source"`
+	MinBorrowAPY           sdk.Dec           `json:"min_borrow_apy" yaml:"min_borrow_apy"`
+	MaxBorrowAPY           sdk.Dec           `json:"max_borrow_apy" yaml:"max_borrow_apy"`
+	WithdrawFee            sdk.Dec           `json:"withdraw_fee" yaml:"withdraw_fee"`
+	LiquidatorWhitelist    []sdk.AccAddress  `json:"liquidator_whitelist" yaml:"liquidator_whitelist"`
+	MaxStrategyAllocation  sdk.Dec           `json:"max_strategy_allocation" yaml:"max_strategy_allocation"`
+	// RiskTier classifies the money market for the tier borrow rules, empty for a market that is not restricted by them
+	RiskTier string `json:"risk_tier" yaml:"risk_tier"`
 }
 
-// NewMoneyMarket returns a new MoneyMarket
+// NewMoneyMarket returns a new MoneyMarket with no supply limit, a close factor of 1.0, spot pricing, no
+// borrow rate bounds, no withdraw fee, permissionless liquidations, and no liquidity allocated to a yield strategy
 func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string, conversionFactor sdk.Int,
 	interestRateModel InterestRateModel, reserveFactor, keeperRewardPercentage sdk.Dec) MoneyMarket {
 	return MoneyMarket{
@@ -93,6 +250,13 @@ func NewMoneyMarket(denom string, borrowLimit BorrowLimit, spotMarketID string,
 		InterestRateModel:      interestRateModel,
 		ReserveFactor:          reserveFactor,
 		KeeperRewardPercentage: keeperRewardPercentage,
+		SupplyLimit:            DefaultSupplyLimit,
+		CloseFactor:            DefaultCloseFactor,
+		PriceSource:            PriceSourceSpot,
+		MinBorrowAPY:           DefaultMinBorrowAPY,
+		MaxBorrowAPY:           DefaultMaxBorrowAPY,
+		WithdrawFee:            DefaultWithdrawFee,
+		MaxStrategyAllocation:  DefaultMaxStrategyAllocation,
 	}
 }
 
@@ -118,9 +282,122 @@ func (mm MoneyMarket) Validate() error {
 		return fmt.Errorf("Keeper reward percentage must be between 0.0-1.0")
 	}
 
+	if mm.SupplyLimit.IsNil() || mm.SupplyLimit.IsNegative() {
+		return fmt.Errorf("Supply limit cannot be negative")
+	}
+
+	if mm.CloseFactor.IsNil() || !mm.CloseFactor.IsPositive() || mm.CloseFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("Close factor must be greater than 0.0 and at most 1.0")
+	}
+
+	if err := mm.PriceSource.Validate(); err != nil {
+		return err
+	}
+
+	if mm.PriceSource.UsesTwap() && len(mm.TwapMarketID) == 0 {
+		return fmt.Errorf("TWAP market id cannot be empty for price source %s", mm.PriceSource)
+	}
+
+	if mm.MinBorrowAPY.IsNil() || mm.MinBorrowAPY.IsNegative() {
+		return fmt.Errorf("Min borrow APY cannot be negative")
+	}
+
+	if mm.MaxBorrowAPY.IsNil() || mm.MaxBorrowAPY.IsNegative() {
+		return fmt.Errorf("Max borrow APY cannot be negative")
+	}
+
+	// the bounds must leave a range of rates for the interest rate model to set
+	if mm.MinBorrowAPY.GT(mm.InterestRateModel.MaxBorrowRate()) {
+		return fmt.Errorf("Min borrow APY %s cannot be greater than the interest rate model's maximum rate %s",
+			mm.MinBorrowAPY, mm.InterestRateModel.MaxBorrowRate())
+	}
+
+	// a max borrow APY of zero means borrow rates have no ceiling
+	if mm.MaxBorrowAPY.IsPositive() {
+		if mm.MaxBorrowAPY.LT(mm.MinBorrowAPY) {
+			return fmt.Errorf("Max borrow APY %s cannot be less than min borrow APY %s", mm.MaxBorrowAPY, mm.MinBorrowAPY)
+		}
+		if mm.MaxBorrowAPY.LT(mm.InterestRateModel.BaseRateAPY) {
+			return fmt.Errorf("Max borrow APY %s cannot be less than the interest rate model's base rate %s",
+				mm.MaxBorrowAPY, mm.InterestRateModel.BaseRateAPY)
+		}
+	}
+
+	if mm.WithdrawFee.IsNil() || mm.WithdrawFee.IsNegative() || mm.WithdrawFee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("Withdraw fee must be between 0.0-1.0")
+	}
+
+	seenLiquidators := make(map[string]bool)
+	for _, liquidator := range mm.LiquidatorWhitelist {
+		if liquidator.Empty() {
+			return fmt.Errorf("Liquidator whitelist address cannot be empty")
+		}
+		if seenLiquidators[liquidator.String()] {
+			return fmt.Errorf("duplicate liquidator whitelist address: %s", liquidator)
+		}
+		seenLiquidators[liquidator.String()] = true
+	}
+
+	if mm.MaxStrategyAllocation.IsNil() || mm.MaxStrategyAllocation.IsNegative() || mm.MaxStrategyAllocation.GT(sdk.OneDec()) {
+		return fmt.Errorf("Max strategy allocation must be between 0.0-1.0")
+	}
+
+	if mm.RiskTier != "" {
+		if err := ValidateRiskTier(mm.RiskTier); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// BoundBorrowRate limits a borrow rate calculated by the interest rate model to the money market's
+// min and max borrow APY
+func (mm MoneyMarket) BoundBorrowRate(borrowRateAPY sdk.Dec) sdk.Dec {
+	if !mm.MinBorrowAPY.IsNil() && borrowRateAPY.LT(mm.MinBorrowAPY) {
+		borrowRateAPY = mm.MinBorrowAPY
+	}
+	if !mm.MaxBorrowAPY.IsNil() && mm.MaxBorrowAPY.IsPositive() && borrowRateAPY.GT(mm.MaxBorrowAPY) {
+		borrowRateAPY = mm.MaxBorrowAPY
+	}
+	return borrowRateAPY
+}
+
+// WithdrawFeeRate returns the share of a withdrawal kept as a fee at a utilization ratio. The fee is zero up to
+// the interest rate model's kink and rises linearly to the full WithdrawFee at 100% utilization.
+func (mm MoneyMarket) WithdrawFeeRate(utilization sdk.Dec) sdk.Dec {
+	if mm.WithdrawFee.IsNil() || !mm.WithdrawFee.IsPositive() || mm.InterestRateModel.Kink.GTE(sdk.OneDec()) {
+		return sdk.ZeroDec()
+	}
+	if utilization.LTE(mm.InterestRateModel.Kink) {
+		return sdk.ZeroDec()
+	}
+	excessUtil := sdk.MinDec(utilization, sdk.OneDec()).Sub(mm.InterestRateModel.Kink)
+	return mm.WithdrawFee.Mul(excessUtil).Quo(sdk.OneDec().Sub(mm.InterestRateModel.Kink))
+}
+
+// PermitsLiquidator returns true if the address may liquidate positions in the money market, which is any address
+// when the liquidator whitelist is empty
+func (mm MoneyMarket) PermitsLiquidator(addr sdk.AccAddress) bool {
+	if len(mm.LiquidatorWhitelist) == 0 {
+		return true
+	}
+	for _, liquidator := range mm.LiquidatorWhitelist {
+		if liquidator.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// StrategyAllocationTarget returns the amount of a money market's cash that should be allocated to its yield strategy
+func (mm MoneyMarket) StrategyAllocationTarget(cash sdk.Int) sdk.Int {
+	if mm.MaxStrategyAllocation.IsNil() || !mm.MaxStrategyAllocation.IsPositive() || !cash.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return cash.ToDec().Mul(mm.MaxStrategyAllocation).TruncateInt()
+}
+
 // Equal returns a boolean indicating if a MoneyMarket is equal to another MoneyMarket
 func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if mm.Denom != mmCompareTo.Denom {
@@ -144,6 +421,41 @@ func (mm MoneyMarket) Equal(mmCompareTo MoneyMarket) bool {
 	if !mm.KeeperRewardPercentage.Equal(mmCompareTo.KeeperRewardPercentage) {
 		return false
 	}
+	if !mm.SupplyLimit.Equal(mmCompareTo.SupplyLimit) {
+		return false
+	}
+	if !mm.CloseFactor.Equal(mmCompareTo.CloseFactor) {
+		return false
+	}
+	if mm.TwapMarketID != mmCompareTo.TwapMarketID {
+		return false
+	}
+	if mm.PriceSource != mmCompareTo.PriceSource {
+		return false
+	}
+	if !mm.MinBorrowAPY.Equal(mmCompareTo.MinBorrowAPY) {
+		return false
+	}
+	if !mm.MaxBorrowAPY.Equal(mmCompareTo.MaxBorrowAPY) {
+		return false
+	}
+	if !mm.WithdrawFee.Equal(mmCompareTo.WithdrawFee) {
+		return false
+	}
+	if len(mm.LiquidatorWhitelist) != len(mmCompareTo.LiquidatorWhitelist) {
+		return false
+	}
+	for i := range mm.LiquidatorWhitelist {
+		if !mm.LiquidatorWhitelist[i].Equals(mmCompareTo.LiquidatorWhitelist[i]) {
+			return false
+		}
+	}
+	if !mm.MaxStrategyAllocation.Equal(mmCompareTo.MaxStrategyAllocation) {
+		return false
+	}
+	if mm.RiskTier != mmCompareTo.RiskTier {
+		return false
+	}
 	return true
 }
 
@@ -160,6 +472,59 @@ func (mms MoneyMarkets) Validate() error {
 	return nil
 }
 
+// SwapLiquidation configures selling liquidated deposits of a money market through the swap module.
+// Seized lots no larger than MaxLotSize are sold directly into swap pools instead of being auctioned,
+// provided the sale can be made within MaxSlippage of the market price. Otherwise an auction is started.
+type SwapLiquidation struct {
+	Denom       string  `json:"denom" yaml:"denom"`
+	MaxLotSize  sdk.Int `json:"max_lot_size" yaml:"max_lot_size"`
+	MaxSlippage sdk.Dec `json:"max_slippage" yaml:"max_slippage"`
+}
+
+// NewSwapLiquidation returns a new SwapLiquidation
+func NewSwapLiquidation(denom string, maxLotSize sdk.Int, maxSlippage sdk.Dec) SwapLiquidation {
+	return SwapLiquidation{
+		Denom:       denom,
+		MaxLotSize:  maxLotSize,
+		MaxSlippage: maxSlippage,
+	}
+}
+
+// Validate SwapLiquidation param
+func (sl SwapLiquidation) Validate() error {
+	if err := sdk.ValidateDenom(sl.Denom); err != nil {
+		return err
+	}
+
+	if sl.MaxLotSize.IsNil() || !sl.MaxLotSize.IsPositive() {
+		return fmt.Errorf("Max lot size must be positive")
+	}
+
+	if sl.MaxSlippage.IsNil() || sl.MaxSlippage.IsNegative() || sl.MaxSlippage.GTE(sdk.OneDec()) {
+		return fmt.Errorf("Max slippage must be between 0.0-1.0")
+	}
+
+	return nil
+}
+
+// SwapLiquidations slice of SwapLiquidation
+type SwapLiquidations []SwapLiquidation
+
+// Validate swap liquidations
+func (sls SwapLiquidations) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, sl := range sls {
+		if err := sl.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[sl.Denom] {
+			return fmt.Errorf("duplicate swap liquidation denom: %s", sl.Denom)
+		}
+		seenDenoms[sl.Denom] = true
+	}
+	return nil
+}
+
 // InterestRateModel contains information about an asset's interest rate
 type InterestRateModel struct {
 	BaseRateAPY    sdk.Dec `json:"base_rate_apy" yaml:"base_rate_apy"`
@@ -196,9 +561,20 @@ func (irm InterestRateModel) Validate() error {
 		return fmt.Errorf("Jump multiplier must be positive")
 	}
 
+	// rates must rise at least as steeply above the kink as below it
+	if irm.JumpMultiplier.LT(irm.BaseMultiplier) {
+		return fmt.Errorf("Jump multiplier %s cannot be less than base multiplier %s", irm.JumpMultiplier, irm.BaseMultiplier)
+	}
+
 	return nil
 }
 
+// MaxBorrowRate returns the borrow rate of the model at full utilization, the highest rate it can set
+func (irm InterestRateModel) MaxBorrowRate() sdk.Dec {
+	normalRate := irm.Kink.Mul(irm.BaseMultiplier).Add(irm.BaseRateAPY)
+	return sdk.OneDec().Sub(irm.Kink).Mul(irm.JumpMultiplier).Add(normalRate)
+}
+
 // Equal returns a boolean indicating if an InterestRateModel is equal to another InterestRateModel
 func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 	if !irm.BaseRateAPY.Equal(irmCompareTo.BaseRateAPY) {
@@ -216,13 +592,23 @@ func (irm InterestRateModel) Equal(irmCompareTo InterestRateModel) bool {
 	return true
 }
 
+// String implements fmt.Stringer
+func (irm InterestRateModel) String() string {
+	return fmt.Sprintf("base rate APY: %s, base multiplier: %s, kink: %s, jump multiplier: %s",
+		irm.BaseRateAPY, irm.BaseMultiplier, irm.Kink, irm.JumpMultiplier)
+}
+
 // InterestRateModels slice of InterestRateModel
 type InterestRateModels []InterestRateModel
 
 // NewParams returns a new params object
 func NewParams(moneyMarkets MoneyMarkets) Params {
 	return Params{
-		MoneyMarkets: moneyMarkets,
+		MoneyMarkets:        moneyMarkets,
+		LiquidationOrder:    DefaultLiquidationOrder,
+		MaxAnnualRate:       DefaultMaxAnnualRate,
+		BorrowHistoryLength: DefaultBorrowHistoryLength,
+		HealthFactorWarning: DefaultHealthFactorWarning,
 	}
 }
 
@@ -234,8 +620,17 @@ func DefaultParams() Params {
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-	Money Markets %v`,
-		p.MoneyMarkets)
+	Money Markets %v
+	Swap Liquidations %v
+	Minimum Accrual Interval %s
+	Liquidation Gas Budget %d
+	Liquidation Order %s
+	Max Annual Rate %s
+	Borrow History Length %d
+	Health Factor Warning %s
+	Tier Borrow Rules %v`,
+		p.MoneyMarkets, p.SwapLiquidations, p.MinimumAccrualInterval, p.LiquidationGasBudget, p.LiquidationOrder, p.MaxAnnualRate,
+		p.BorrowHistoryLength, p.HealthFactorWarning, p.TierBorrowRules)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -247,12 +642,80 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMoneyMarkets, &p.MoneyMarkets, validateMoneyMarketParams),
+		params.NewParamSetPair(KeySwapLiquidations, &p.SwapLiquidations, validateSwapLiquidationsParams),
+		params.NewParamSetPair(KeyMinimumAccrualInterval, &p.MinimumAccrualInterval, validateMinimumAccrualIntervalParam),
+		params.NewParamSetPair(KeyLiquidationGasBudget, &p.LiquidationGasBudget, validateLiquidationGasBudgetParam),
+		params.NewParamSetPair(KeyLiquidationOrder, &p.LiquidationOrder, validateLiquidationOrderParam),
+		params.NewParamSetPair(KeyMaxAnnualRate, &p.MaxAnnualRate, validateMaxAnnualRateParam),
+		params.NewParamSetPair(KeyBorrowHistoryLength, &p.BorrowHistoryLength, validateBorrowHistoryLengthParam),
+		params.NewParamSetPair(KeyHealthFactorWarning, &p.HealthFactorWarning, validateHealthFactorWarningParam),
+		params.NewParamSetPair(KeyTierBorrowRules, &p.TierBorrowRules, validateTierBorrowRulesParam),
 	}
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
-	return validateMoneyMarketParams(p.MoneyMarkets)
+	if err := validateMoneyMarketParams(p.MoneyMarkets); err != nil {
+		return err
+	}
+
+	if err := validateSwapLiquidationsParams(p.SwapLiquidations); err != nil {
+		return err
+	}
+
+	if err := validateMinimumAccrualIntervalParam(p.MinimumAccrualInterval); err != nil {
+		return err
+	}
+
+	if err := validateLiquidationGasBudgetParam(p.LiquidationGasBudget); err != nil {
+		return err
+	}
+
+	if err := validateLiquidationOrderParam(p.LiquidationOrder); err != nil {
+		return err
+	}
+
+	if err := validateMaxAnnualRateParam(p.MaxAnnualRate); err != nil {
+		return err
+	}
+
+	if err := validateBorrowHistoryLengthParam(p.BorrowHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateHealthFactorWarningParam(p.HealthFactorWarning); err != nil {
+		return err
+	}
+
+	if err := validateTierBorrowRulesParam(p.TierBorrowRules); err != nil {
+		return err
+	}
+
+	marketDenoms := make(map[string]bool)
+	for _, mm := range p.MoneyMarkets {
+		marketDenoms[mm.Denom] = true
+		if err := p.ValidateAnnualRate(mm.InterestRateModel); err != nil {
+			return fmt.Errorf("money market %s: %w", mm.Denom, err)
+		}
+	}
+	for _, sl := range p.SwapLiquidations {
+		if !marketDenoms[sl.Denom] {
+			return fmt.Errorf("swap liquidation denom %s has no money market", sl.Denom)
+		}
+	}
+	return nil
+}
+
+// ValidateAnnualRate checks that an interest rate model's rate at full utilization does not exceed the max annual rate
+func (p Params) ValidateAnnualRate(irm InterestRateModel) error {
+	if p.MaxAnnualRate.IsNil() || !p.MaxAnnualRate.IsPositive() {
+		return nil
+	}
+	if irm.MaxBorrowRate().GT(p.MaxAnnualRate) {
+		return fmt.Errorf("interest rate model's maximum rate %s cannot be greater than max annual rate %s",
+			irm.MaxBorrowRate(), p.MaxAnnualRate)
+	}
+	return nil
 }
 
 func validateMoneyMarketParams(i interface{}) error {
@@ -263,3 +726,91 @@ func validateMoneyMarketParams(i interface{}) error {
 
 	return mm.Validate()
 }
+
+func validateSwapLiquidationsParams(i interface{}) error {
+	sls, ok := i.(SwapLiquidations)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sls.Validate()
+}
+
+func validateTierBorrowRulesParam(i interface{}) error {
+	rules, ok := i.(TierBorrowRules)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return rules.Validate()
+}
+
+func validateMinimumAccrualIntervalParam(i interface{}) error {
+	interval, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval < 0 {
+		return fmt.Errorf("minimum accrual interval cannot be negative: %s", interval)
+	}
+	return nil
+}
+
+func validateLiquidationGasBudgetParam(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateLiquidationOrderParam(i interface{}) error {
+	order, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	switch order {
+	case LiquidationOrderProportional, LiquidationOrderHighestValue, LiquidationOrderMostLiquid:
+		return nil
+	default:
+		return fmt.Errorf("invalid liquidation order: %s", order)
+	}
+}
+
+func validateMaxAnnualRateParam(i interface{}) error {
+	rate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if rate.IsNil() || rate.IsNegative() {
+		return fmt.Errorf("max annual rate cannot be negative: %s", rate)
+	}
+	return nil
+}
+
+func validateBorrowHistoryLengthParam(i interface{}) error {
+	length, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if length > MaxBorrowHistoryLength {
+		return fmt.Errorf("borrow history length cannot be greater than %d: %d", MaxBorrowHistoryLength, length)
+	}
+	return nil
+}
+
+func validateHealthFactorWarningParam(i interface{}) error {
+	warning, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if warning.IsNil() || warning.IsNegative() || warning.GT(LiquidationHealthFactor) {
+		return fmt.Errorf("health factor warning must be between 0 and the liquidation health factor %s: %s", LiquidationHealthFactor, warning)
+	}
+	return nil
+}
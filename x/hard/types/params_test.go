@@ -3,9 +3,12 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/kava-labs/kava/x/hard/types"
 )
 
@@ -15,7 +18,47 @@ type ParamTestSuite struct {
 
 func (suite *ParamTestSuite) TestParamValidation() {
 	type args struct {
-		mms types.MoneyMarkets
+		mms                    types.MoneyMarkets
+		minimumAccrualInterval time.Duration
+		liquidationOrder       string
+		maxAnnualRate          string
+		borrowHistoryLength    uint64
+		healthFactorWarning    string
+	}
+	newMoneyMarket := func(supplyLimit sdk.Int, closeFactor sdk.Dec) types.MoneyMarket {
+		mm := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")),
+			"kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+			sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+		mm.SupplyLimit = supplyLimit
+		mm.CloseFactor = closeFactor
+		return mm
+	}
+	withBorrowAPYBounds := func(mm types.MoneyMarket, min, max string) types.MoneyMarket {
+		mm.MinBorrowAPY = sdk.MustNewDecFromStr(min)
+		mm.MaxBorrowAPY = sdk.MustNewDecFromStr(max)
+		return mm
+	}
+	withWithdrawFee := func(mm types.MoneyMarket, fee string) types.MoneyMarket {
+		mm.WithdrawFee = sdk.MustNewDecFromStr(fee)
+		return mm
+	}
+	withLiquidatorWhitelist := func(mm types.MoneyMarket, liquidators ...sdk.AccAddress) types.MoneyMarket {
+		mm.LiquidatorWhitelist = liquidators
+		return mm
+	}
+	withMaxStrategyAllocation := func(mm types.MoneyMarket, allocation string) types.MoneyMarket {
+		mm.MaxStrategyAllocation = sdk.MustNewDecFromStr(allocation)
+		return mm
+	}
+	withInterestRateModel := func(mm types.MoneyMarket, baseMultiplier, jumpMultiplier string) types.MoneyMarket {
+		mm.InterestRateModel.BaseMultiplier = sdk.MustNewDecFromStr(baseMultiplier)
+		mm.InterestRateModel.JumpMultiplier = sdk.MustNewDecFromStr(jumpMultiplier)
+		return mm
+	}
+	withPriceSource := func(mm types.MoneyMarket, source types.PriceSource, twapMarketID string) types.MoneyMarket {
+		mm.PriceSource = source
+		mm.TwapMarketID = twapMarketID
+		return mm
 	}
 	testCases := []struct {
 		name        string
@@ -31,10 +74,280 @@ func (suite *ParamTestSuite) TestParamValidation() {
 			expectPass:  true,
 			expectedErr: "",
 		},
+		{
+			name: "valid supply limit and close factor",
+			args: args{
+				mms: types.MoneyMarkets{newMoneyMarket(sdk.NewInt(1000000), sdk.MustNewDecFromStr("0.5"))},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative supply limit",
+			args: args{
+				mms: types.MoneyMarkets{newMoneyMarket(sdk.NewInt(-1), sdk.OneDec())},
+			},
+			expectPass:  false,
+			expectedErr: "Supply limit cannot be negative",
+		},
+		{
+			name: "zero close factor",
+			args: args{
+				mms: types.MoneyMarkets{newMoneyMarket(sdk.ZeroInt(), sdk.ZeroDec())},
+			},
+			expectPass:  false,
+			expectedErr: "Close factor must be greater than 0.0 and at most 1.0",
+		},
+		{
+			name: "close factor above one",
+			args: args{
+				mms: types.MoneyMarkets{newMoneyMarket(sdk.ZeroInt(), sdk.MustNewDecFromStr("1.1"))},
+			},
+			expectPass:  false,
+			expectedErr: "Close factor must be greater than 0.0 and at most 1.0",
+		},
+		{
+			name: "valid twap price source",
+			args: args{
+				mms: types.MoneyMarkets{withPriceSource(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), types.PriceSourceConservative, "kava:usd:30")},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "twap price source without twap market",
+			args: args{
+				mms: types.MoneyMarkets{withPriceSource(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), types.PriceSourceTwap, "")},
+			},
+			expectPass:  false,
+			expectedErr: "TWAP market id cannot be empty",
+		},
+		{
+			name: "invalid price source",
+			args: args{
+				mms: types.MoneyMarkets{withPriceSource(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "median", "kava:usd:30")},
+			},
+			expectPass:  false,
+			expectedErr: "invalid price source",
+		},
+		{
+			name: "valid borrow apy bounds",
+			args: args{
+				mms: types.MoneyMarkets{withBorrowAPYBounds(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "0.02", "0.5")},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative min borrow apy",
+			args: args{
+				mms: types.MoneyMarkets{withBorrowAPYBounds(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "-0.01", "0")},
+			},
+			expectPass:  false,
+			expectedErr: "Min borrow APY cannot be negative",
+		},
+		{
+			name: "max borrow apy below min borrow apy",
+			args: args{
+				mms: types.MoneyMarkets{withBorrowAPYBounds(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "0.2", "0.1")},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be less than min borrow APY",
+		},
+		{
+			name: "max borrow apy below model base rate",
+			args: args{
+				mms: types.MoneyMarkets{withBorrowAPYBounds(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "0", "0.01")},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be less than the interest rate model's base rate",
+		},
+		{
+			name: "min borrow apy above model maximum rate",
+			args: args{
+				mms: types.MoneyMarkets{withBorrowAPYBounds(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "5", "0")},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be greater than the interest rate model's maximum rate",
+		},
+		{
+			name: "valid withdraw fee",
+			args: args{
+				mms: types.MoneyMarkets{withWithdrawFee(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "0.02")},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative withdraw fee",
+			args: args{
+				mms: types.MoneyMarkets{withWithdrawFee(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "-0.01")},
+			},
+			expectPass:  false,
+			expectedErr: "Withdraw fee must be between 0.0-1.0",
+		},
+		{
+			name: "withdraw fee of one",
+			args: args{
+				mms: types.MoneyMarkets{withWithdrawFee(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "1")},
+			},
+			expectPass:  false,
+			expectedErr: "Withdraw fee must be between 0.0-1.0",
+		},
+		{
+			name: "valid liquidator whitelist",
+			args: args{
+				mms: types.MoneyMarkets{withLiquidatorWhitelist(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), sdk.AccAddress("keeper1"), sdk.AccAddress("keeper2"))},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "empty liquidator whitelist address",
+			args: args{
+				mms: types.MoneyMarkets{withLiquidatorWhitelist(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), sdk.AccAddress{})},
+			},
+			expectPass:  false,
+			expectedErr: "Liquidator whitelist address cannot be empty",
+		},
+		{
+			name: "duplicate liquidator whitelist address",
+			args: args{
+				mms: types.MoneyMarkets{withLiquidatorWhitelist(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), sdk.AccAddress("keeper1"), sdk.AccAddress("keeper1"))},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate liquidator whitelist address",
+		},
+		{
+			name: "valid max strategy allocation",
+			args: args{
+				mms: types.MoneyMarkets{withMaxStrategyAllocation(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "0.5")},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "max strategy allocation greater than one",
+			args: args{
+				mms: types.MoneyMarkets{withMaxStrategyAllocation(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "1.01")},
+			},
+			expectPass:  false,
+			expectedErr: "Max strategy allocation must be between 0.0-1.0",
+		},
+		{
+			name: "valid minimum accrual interval",
+			args: args{
+				mms:                    types.DefaultMoneyMarkets,
+				minimumAccrualInterval: time.Minute,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "negative minimum accrual interval",
+			args: args{
+				mms:                    types.DefaultMoneyMarkets,
+				minimumAccrualInterval: -time.Minute,
+			},
+			expectPass:  false,
+			expectedErr: "minimum accrual interval cannot be negative",
+		},
+		{
+			name: "valid liquidation order",
+			args: args{
+				mms:              types.DefaultMoneyMarkets,
+				liquidationOrder: types.LiquidationOrderMostLiquid,
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid liquidation order",
+			args: args{
+				mms:              types.DefaultMoneyMarkets,
+				liquidationOrder: "lowest_value",
+			},
+			expectPass:  false,
+			expectedErr: "invalid liquidation order",
+		},
+		{
+			name: "valid jump multiplier equal to base multiplier",
+			args: args{
+				mms: types.MoneyMarkets{withInterestRateModel(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "2", "2")},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid jump multiplier below base multiplier",
+			args: args{
+				mms: types.MoneyMarkets{withInterestRateModel(newMoneyMarket(sdk.ZeroInt(), sdk.OneDec()), "2", "1.5")},
+			},
+			expectPass:  false,
+			expectedErr: "Jump multiplier 1.500000000000000000 cannot be less than base multiplier",
+		},
+		{
+			name: "valid max annual rate",
+			args: args{
+				mms:           types.MoneyMarkets{newMoneyMarket(sdk.ZeroInt(), sdk.OneDec())},
+				maxAnnualRate: "3.65",
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "invalid rate at full utilization above max annual rate",
+			args: args{
+				mms:           types.MoneyMarkets{newMoneyMarket(sdk.ZeroInt(), sdk.OneDec())},
+				maxAnnualRate: "3",
+			},
+			expectPass:  false,
+			expectedErr: "money market ukava: interest rate model's maximum rate 3.650000000000000000 cannot be greater than max annual rate",
+		},
+		{
+			name: "invalid negative max annual rate",
+			args: args{
+				mms:           types.DefaultMoneyMarkets,
+				maxAnnualRate: "-1",
+			},
+			expectPass:  false,
+			expectedErr: "max annual rate cannot be negative",
+		},
+		{
+			name: "invalid borrow history length above max",
+			args: args{
+				mms:                 types.DefaultMoneyMarkets,
+				borrowHistoryLength: types.MaxBorrowHistoryLength + 1,
+			},
+			expectPass:  false,
+			expectedErr: "borrow history length cannot be greater than 1000",
+		},
+		{
+			name: "invalid health factor warning above the liquidation health factor",
+			args: args{
+				mms:                 types.DefaultMoneyMarkets,
+				healthFactorWarning: "1.1",
+			},
+			expectPass:  false,
+			expectedErr: "health factor warning must be between 0 and the liquidation health factor",
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			params := types.NewParams(tc.args.mms)
+			params.MinimumAccrualInterval = tc.args.minimumAccrualInterval
+			if tc.args.liquidationOrder != "" {
+				params.LiquidationOrder = tc.args.liquidationOrder
+			}
+			if tc.args.maxAnnualRate != "" {
+				params.MaxAnnualRate = sdk.MustNewDecFromStr(tc.args.maxAnnualRate)
+			}
+			if tc.args.borrowHistoryLength != 0 {
+				params.BorrowHistoryLength = tc.args.borrowHistoryLength
+			}
+			if tc.args.healthFactorWarning != "" {
+				params.HealthFactorWarning = sdk.MustNewDecFromStr(tc.args.healthFactorWarning)
+			}
 			err := params.Validate()
 			if tc.expectPass {
 				suite.NoError(err)
@@ -46,6 +359,170 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestTierBorrowRulesValidation() {
+	rule := func(collateralTier, borrowTier, haircut string) types.TierBorrowRule {
+		return types.NewTierBorrowRule(collateralTier, borrowTier, sdk.MustNewDecFromStr(haircut))
+	}
+	testCases := []struct {
+		name        string
+		rules       types.TierBorrowRules
+		expectedErr string
+	}{
+		{"no rules", types.TierBorrowRules{}, ""},
+		{"valid rules", types.TierBorrowRules{rule("stable", "volatile", "0"), rule("volatile", "stable", "0.5")}, ""},
+		{"invalid tier", types.TierBorrowRules{rule("Stable", "volatile", "0")}, "invalid risk tier"},
+		{"empty tier", types.TierBorrowRules{rule("stable", "", "0")}, "invalid risk tier"},
+		{"single tier", types.TierBorrowRules{rule("stable", "stable", "0")}, "cannot be within a single tier"},
+		{"negative haircut", types.TierBorrowRules{rule("stable", "volatile", "-0.1")}, "LTV haircut must be between"},
+		{"haircut of one", types.TierBorrowRules{rule("stable", "volatile", "1")}, "LTV haircut must be between"},
+		{"duplicate pair", types.TierBorrowRules{rule("stable", "volatile", "0"), rule("stable", "volatile", "0.1")}, "duplicate tier borrow rule"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.TierBorrowRules = tc.rules
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				suite.NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+
+	haircut, allowed := types.TierBorrowRules{rule("volatile", "stable", "0.5")}.CollateralHaircut("volatile", "stable")
+	suite.True(allowed)
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), haircut)
+	_, allowed = types.TierBorrowRules{rule("volatile", "stable", "0.5")}.CollateralHaircut("stable", "volatile")
+	suite.False(allowed)
+	_, allowed = types.TierBorrowRules{}.CollateralHaircut("", "stable")
+	suite.True(allowed)
+}
+
+func (suite *ParamTestSuite) TestCalculateHealthFactor() {
+	testCases := []struct {
+		name          string
+		borrowedUSD   string
+		borrowableUSD string
+		expected      sdk.Dec
+		liquidatable  bool
+	}{
+		{"no borrows", "0", "100", sdk.ZeroDec(), false},
+		{"healthy position", "40", "64", sdk.MustNewDecFromStr("0.625"), false},
+		{"borrows equal to the borrowable value", "64", "64", sdk.OneDec(), false},
+		{"liquidatable position", "80", "64", sdk.MustNewDecFromStr("1.25"), true},
+		{"borrows without borrowable value", "10", "0", types.MaxHealthFactor, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			healthFactor := types.CalculateHealthFactor(sdk.MustNewDecFromStr(tc.borrowedUSD), sdk.MustNewDecFromStr(tc.borrowableUSD))
+			suite.Equal(tc.expected, healthFactor)
+			suite.Equal(tc.liquidatable, types.IsLiquidatable(healthFactor))
+		})
+	}
+}
+
+func (suite *ParamTestSuite) TestBoundBorrowRate() {
+	mm := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")),
+		"kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	suite.Equal(sdk.MustNewDecFromStr("3.65"), mm.InterestRateModel.MaxBorrowRate())
+
+	// no bounds by default
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), mm.BoundBorrowRate(sdk.MustNewDecFromStr("0.01")))
+	suite.Equal(sdk.MustNewDecFromStr("3.65"), mm.BoundBorrowRate(sdk.MustNewDecFromStr("3.65")))
+
+	mm.MinBorrowAPY = sdk.MustNewDecFromStr("0.1")
+	mm.MaxBorrowAPY = sdk.MustNewDecFromStr("0.5")
+	suite.Equal(sdk.MustNewDecFromStr("0.1"), mm.BoundBorrowRate(sdk.MustNewDecFromStr("0.05")))
+	suite.Equal(sdk.MustNewDecFromStr("0.3"), mm.BoundBorrowRate(sdk.MustNewDecFromStr("0.3")))
+	suite.Equal(sdk.MustNewDecFromStr("0.5"), mm.BoundBorrowRate(sdk.MustNewDecFromStr("3.65")))
+}
+
+func (suite *ParamTestSuite) TestWithdrawFeeRate() {
+	mm := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")),
+		"kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+
+	// no fee by default
+	suite.Equal(sdk.ZeroDec(), mm.WithdrawFeeRate(sdk.OneDec()))
+
+	mm.WithdrawFee = sdk.MustNewDecFromStr("0.02")
+	suite.Equal(sdk.ZeroDec(), mm.WithdrawFeeRate(sdk.MustNewDecFromStr("0.5")))
+	suite.Equal(sdk.ZeroDec(), mm.WithdrawFeeRate(sdk.MustNewDecFromStr("0.8")))
+	suite.Equal(sdk.MustNewDecFromStr("0.01"), mm.WithdrawFeeRate(sdk.MustNewDecFromStr("0.9")))
+	suite.Equal(sdk.MustNewDecFromStr("0.02"), mm.WithdrawFeeRate(sdk.OneDec()))
+
+	// no fee when the kink is at full utilization
+	mm.InterestRateModel.Kink = sdk.OneDec()
+	suite.Equal(sdk.ZeroDec(), mm.WithdrawFeeRate(sdk.OneDec()))
+}
+
+func (suite *ParamTestSuite) TestPermitsLiquidator() {
+	mm := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")),
+		"kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+
+	// anyone can liquidate by default
+	suite.True(mm.PermitsLiquidator(sdk.AccAddress("keeper1")))
+
+	mm.LiquidatorWhitelist = []sdk.AccAddress{sdk.AccAddress("keeper1")}
+	suite.True(mm.PermitsLiquidator(sdk.AccAddress("keeper1")))
+	suite.False(mm.PermitsLiquidator(sdk.AccAddress("keeper2")))
+}
+
+func (suite *ParamTestSuite) TestStrategyAllocationTarget() {
+	mm := types.NewMoneyMarket("ukava", types.NewBorrowLimit(false, sdk.NewDec(1000000000000000), sdk.MustNewDecFromStr("0.6")),
+		"kava:usd", sdk.NewInt(1000000), types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10")),
+		sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+
+	// nothing is allocated by default
+	suite.Equal(sdk.ZeroInt(), mm.StrategyAllocationTarget(sdk.NewInt(1000)))
+
+	mm.MaxStrategyAllocation = sdk.MustNewDecFromStr("0.25")
+	suite.Equal(sdk.NewInt(250), mm.StrategyAllocationTarget(sdk.NewInt(1000)))
+	suite.Equal(sdk.NewInt(2), mm.StrategyAllocationTarget(sdk.NewInt(9)))
+	suite.Equal(sdk.ZeroInt(), mm.StrategyAllocationTarget(sdk.ZeroInt()))
+}
+
+func (suite *ParamTestSuite) TestBorrowLimitRamp() {
+	rampStart := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	bl := types.NewBorrowLimit(true, sdk.NewDec(1000), sdk.MustNewDecFromStr("0.6"))
+
+	// no ramp by default
+	suite.NoError(bl.Validate())
+	suite.False(bl.IsRamping(rampStart))
+	suite.Equal(sdk.NewDec(1000), bl.MaximumLimitAt(rampStart))
+
+	bl.InitialLimit = sdk.NewDec(200)
+	bl.RampStartTime = rampStart
+	bl.RampDuration = 100 * time.Hour
+	suite.NoError(bl.Validate())
+	suite.Equal(sdk.NewDec(200), bl.MaximumLimitAt(rampStart.Add(-time.Hour)))
+	suite.Equal(sdk.NewDec(200), bl.MaximumLimitAt(rampStart))
+	suite.Equal(sdk.NewDec(208), bl.MaximumLimitAt(rampStart.Add(time.Hour)))
+	suite.Equal(sdk.NewDec(600), bl.MaximumLimitAt(rampStart.Add(50*time.Hour)))
+	suite.True(bl.IsRamping(rampStart.Add(100*time.Hour - time.Second)))
+	suite.False(bl.IsRamping(rampStart.Add(100 * time.Hour)))
+	suite.Equal(sdk.NewDec(1000), bl.MaximumLimitAt(rampStart.Add(200*time.Hour)))
+
+	invalid := bl
+	invalid.InitialLimit = sdk.NewDec(1001)
+	suite.Error(invalid.Validate())
+	invalid = bl
+	invalid.RampStartTime = time.Time{}
+	suite.Error(invalid.Validate())
+	invalid = bl
+	invalid.HasMaxLimit = false
+	suite.Error(invalid.Validate())
+	invalid = bl
+	invalid.RampDuration = -time.Hour
+	suite.Error(invalid.Validate())
+	invalid.RampDuration = time.Millisecond
+	suite.Error(invalid.Validate())
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -0,0 +1,356 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeReservePayout defines the type for a ReservePayoutProposal
+	ProposalTypeReservePayout = "HardReservePayout"
+	// ProposalTypeAddMoneyMarket defines the type for an AddMoneyMarketProposal
+	ProposalTypeAddMoneyMarket = "HardAddMoneyMarket"
+	// ProposalTypeDelistMoneyMarket defines the type for a DelistMoneyMarketProposal
+	ProposalTypeDelistMoneyMarket = "HardDelistMoneyMarket"
+	// ProposalTypeSeedProtocolLiquidity defines the type for a SeedProtocolLiquidityProposal
+	ProposalTypeSeedProtocolLiquidity = "HardSeedProtocolLiquidity"
+	// ProposalTypeWithdrawProtocolLiquidity defines the type for a WithdrawProtocolLiquidityProposal
+	ProposalTypeWithdrawProtocolLiquidity = "HardWithdrawProtocolLiquidity"
+	// MaxIncidentLength is the maximum length of the incident identifier of a ReservePayoutProposal
+	MaxIncidentLength = 140
+)
+
+// ensure proposal types fulfill the gov Content interface
+var _ govtypes.Content = ReservePayoutProposal{}
+var _ govtypes.Content = AddMoneyMarketProposal{}
+var _ govtypes.Content = DelistMoneyMarketProposal{}
+var _ govtypes.Content = SeedProtocolLiquidityProposal{}
+var _ govtypes.Content = WithdrawProtocolLiquidityProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeReservePayout)
+	govtypes.RegisterProposalTypeCodec(ReservePayoutProposal{}, "hard/ReservePayoutProposal")
+	govtypes.RegisterProposalType(ProposalTypeAddMoneyMarket)
+	govtypes.RegisterProposalTypeCodec(AddMoneyMarketProposal{}, "hard/AddMoneyMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeDelistMoneyMarket)
+	govtypes.RegisterProposalTypeCodec(DelistMoneyMarketProposal{}, "hard/DelistMoneyMarketProposal")
+	govtypes.RegisterProposalType(ProposalTypeSeedProtocolLiquidity)
+	govtypes.RegisterProposalTypeCodec(SeedProtocolLiquidityProposal{}, "hard/SeedProtocolLiquidityProposal")
+	govtypes.RegisterProposalType(ProposalTypeWithdrawProtocolLiquidity)
+	govtypes.RegisterProposalTypeCodec(WithdrawProtocolLiquidityProposal{}, "hard/WithdrawProtocolLiquidityProposal")
+}
+
+// ReservePayout is an amount paid out of the hard reserves to a single recipient
+type ReservePayout struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewReservePayout returns a new ReservePayout
+func NewReservePayout(recipient sdk.AccAddress, amount sdk.Coins) ReservePayout {
+	return ReservePayout{
+		Recipient: recipient,
+		Amount:    amount,
+	}
+}
+
+// Validate performs basic validation of a ReservePayout
+func (rp ReservePayout) Validate() error {
+	if rp.Recipient.Empty() {
+		return errors.New("payout recipient cannot be empty")
+	}
+	if !rp.Amount.IsValid() || rp.Amount.Empty() {
+		return fmt.Errorf("invalid payout amount: %s", rp.Amount)
+	}
+	return nil
+}
+
+// ReservePayouts slice of ReservePayout
+type ReservePayouts []ReservePayout
+
+// Validate performs basic validation of each payout and checks that no recipient is paid twice
+func (rps ReservePayouts) Validate() error {
+	if len(rps) == 0 {
+		return errors.New("reserve payouts cannot be empty")
+	}
+	seenRecipients := make(map[string]bool)
+	for _, rp := range rps {
+		if err := rp.Validate(); err != nil {
+			return err
+		}
+		if seenRecipients[rp.Recipient.String()] {
+			return fmt.Errorf("duplicate payout recipient: %s", rp.Recipient)
+		}
+		seenRecipients[rp.Recipient.String()] = true
+	}
+	return nil
+}
+
+// Total returns the sum of all payouts
+func (rps ReservePayouts) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, rp := range rps {
+		total = total.Add(rp.Amount...)
+	}
+	return total
+}
+
+// ReservePayoutProposal is a proposal to pay out from the hard reserves to addresses affected by an incident,
+// such as an oracle failure. The payouts are made in full or not at all.
+type ReservePayoutProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Incident    string         `json:"incident" yaml:"incident"`
+	Payouts     ReservePayouts `json:"payouts" yaml:"payouts"`
+}
+
+// NewReservePayoutProposal returns a new ReservePayoutProposal
+func NewReservePayoutProposal(title, description, incident string, payouts ReservePayouts) ReservePayoutProposal {
+	return ReservePayoutProposal{
+		Title:       title,
+		Description: description,
+		Incident:    incident,
+		Payouts:     payouts,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (rpp ReservePayoutProposal) GetTitle() string { return rpp.Title }
+
+// GetDescription returns the description of the proposal.
+func (rpp ReservePayoutProposal) GetDescription() string { return rpp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (rpp ReservePayoutProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (rpp ReservePayoutProposal) ProposalType() string { return ProposalTypeReservePayout }
+
+// ValidateBasic runs basic stateless validity checks
+func (rpp ReservePayoutProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(rpp); err != nil {
+		return err
+	}
+	if len(rpp.Incident) == 0 {
+		return errors.New("incident cannot be empty")
+	}
+	if len(rpp.Incident) > MaxIncidentLength {
+		return fmt.Errorf("incident is longer than max length of %d", MaxIncidentLength)
+	}
+	return rpp.Payouts.Validate()
+}
+
+// String implements the Stringer interface.
+func (rpp ReservePayoutProposal) String() string {
+	bz, _ := yaml.Marshal(rpp)
+	return string(bz)
+}
+
+// AddMoneyMarketProposal is a proposal to list a new money market. The market is added to the params
+// at the activation time, provided its pricefeed markets exist and are active when the proposal passes.
+// The conversion factor can be left out for denoms with registered metadata, in which case it is derived.
+type AddMoneyMarketProposal struct {
+	Title          string      `json:"title" yaml:"title"`
+	Description    string      `json:"description" yaml:"description"`
+	MoneyMarket    MoneyMarket `json:"money_market" yaml:"money_market"`
+	ActivationTime time.Time   `json:"activation_time" yaml:"activation_time"`
+}
+
+// NewAddMoneyMarketProposal returns a new AddMoneyMarketProposal
+func NewAddMoneyMarketProposal(title, description string, moneyMarket MoneyMarket, activationTime time.Time) AddMoneyMarketProposal {
+	return AddMoneyMarketProposal{
+		Title:          title,
+		Description:    description,
+		MoneyMarket:    moneyMarket,
+		ActivationTime: activationTime,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (ammp AddMoneyMarketProposal) GetTitle() string { return ammp.Title }
+
+// GetDescription returns the description of the proposal.
+func (ammp AddMoneyMarketProposal) GetDescription() string { return ammp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (ammp AddMoneyMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (ammp AddMoneyMarketProposal) ProposalType() string { return ProposalTypeAddMoneyMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (ammp AddMoneyMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(ammp); err != nil {
+		return err
+	}
+	listing := ammp.ScheduledMoneyMarket()
+	// an unset conversion factor is derived from the denom metadata when the proposal is handled
+	if listing.MoneyMarket.ConversionFactor.IsNil() || listing.MoneyMarket.ConversionFactor.IsZero() {
+		listing.MoneyMarket.ConversionFactor = sdk.OneInt()
+	}
+	return listing.Validate()
+}
+
+// ScheduledMoneyMarket returns the money market listing the proposal schedules
+func (ammp AddMoneyMarketProposal) ScheduledMoneyMarket() ScheduledMoneyMarket {
+	return NewScheduledMoneyMarket(ammp.MoneyMarket, ammp.ActivationTime)
+}
+
+// String implements the Stringer interface.
+func (ammp AddMoneyMarketProposal) String() string {
+	bz, _ := yaml.Marshal(ammp)
+	return string(bz)
+}
+
+// DelistMoneyMarketProposal is a proposal to deprecate a money market. Once deprecated the market accepts no new
+// deposits or borrows, and at the wind down deadline remaining borrows are liquidated and deposits are returned.
+type DelistMoneyMarketProposal struct {
+	Title            string    `json:"title" yaml:"title"`
+	Description      string    `json:"description" yaml:"description"`
+	Denom            string    `json:"denom" yaml:"denom"`
+	WindDownDeadline time.Time `json:"wind_down_deadline" yaml:"wind_down_deadline"`
+}
+
+// NewDelistMoneyMarketProposal returns a new DelistMoneyMarketProposal
+func NewDelistMoneyMarketProposal(title, description, denom string, windDownDeadline time.Time) DelistMoneyMarketProposal {
+	return DelistMoneyMarketProposal{
+		Title:            title,
+		Description:      description,
+		Denom:            denom,
+		WindDownDeadline: windDownDeadline,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (dmmp DelistMoneyMarketProposal) GetTitle() string { return dmmp.Title }
+
+// GetDescription returns the description of the proposal.
+func (dmmp DelistMoneyMarketProposal) GetDescription() string { return dmmp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (dmmp DelistMoneyMarketProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (dmmp DelistMoneyMarketProposal) ProposalType() string { return ProposalTypeDelistMoneyMarket }
+
+// ValidateBasic runs basic stateless validity checks
+func (dmmp DelistMoneyMarketProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(dmmp); err != nil {
+		return err
+	}
+	return dmmp.WindDown().Validate()
+}
+
+// WindDown returns the wind down the proposal starts
+func (dmmp DelistMoneyMarketProposal) WindDown() MoneyMarketWindDown {
+	return NewMoneyMarketWindDown(dmmp.Denom, dmmp.WindDownDeadline)
+}
+
+// String implements the Stringer interface.
+func (dmmp DelistMoneyMarketProposal) String() string {
+	bz, _ := yaml.Marshal(dmmp)
+	return string(bz)
+}
+
+// SeedProtocolLiquidityProposal is a proposal to supply coins from the community pool to money markets as protocol
+// owned liquidity, so a newly listed market has liquidity to borrow before suppliers arrive. The liquidity earns
+// supply interest but no incentive rewards, and is only withdrawn by governance or a money market wind down.
+type SeedProtocolLiquidityProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Amount      sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// NewSeedProtocolLiquidityProposal returns a new SeedProtocolLiquidityProposal
+func NewSeedProtocolLiquidityProposal(title, description string, amount sdk.Coins) SeedProtocolLiquidityProposal {
+	return SeedProtocolLiquidityProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (splp SeedProtocolLiquidityProposal) GetTitle() string { return splp.Title }
+
+// GetDescription returns the description of the proposal.
+func (splp SeedProtocolLiquidityProposal) GetDescription() string { return splp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (splp SeedProtocolLiquidityProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (splp SeedProtocolLiquidityProposal) ProposalType() string {
+	return ProposalTypeSeedProtocolLiquidity
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (splp SeedProtocolLiquidityProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(splp); err != nil {
+		return err
+	}
+	if !splp.Amount.IsValid() || splp.Amount.Empty() {
+		return fmt.Errorf("invalid protocol liquidity amount: %s", splp.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (splp SeedProtocolLiquidityProposal) String() string {
+	bz, _ := yaml.Marshal(splp)
+	return string(bz)
+}
+
+// WithdrawProtocolLiquidityProposal is a proposal to return protocol owned liquidity, including the supply interest it
+// has earned, from the money markets to the community pool
+type WithdrawProtocolLiquidityProposal struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	Amount      sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// NewWithdrawProtocolLiquidityProposal returns a new WithdrawProtocolLiquidityProposal
+func NewWithdrawProtocolLiquidityProposal(title, description string, amount sdk.Coins) WithdrawProtocolLiquidityProposal {
+	return WithdrawProtocolLiquidityProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (wplp WithdrawProtocolLiquidityProposal) GetTitle() string { return wplp.Title }
+
+// GetDescription returns the description of the proposal.
+func (wplp WithdrawProtocolLiquidityProposal) GetDescription() string { return wplp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (wplp WithdrawProtocolLiquidityProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (wplp WithdrawProtocolLiquidityProposal) ProposalType() string {
+	return ProposalTypeWithdrawProtocolLiquidity
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (wplp WithdrawProtocolLiquidityProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(wplp); err != nil {
+		return err
+	}
+	if !wplp.Amount.IsValid() || wplp.Amount.Empty() {
+		return fmt.Errorf("invalid protocol liquidity amount: %s", wplp.Amount)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (wplp WithdrawProtocolLiquidityProposal) String() string {
+	bz, _ := yaml.Marshal(wplp)
+	return string(bz)
+}
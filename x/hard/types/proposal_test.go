@@ -0,0 +1,267 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/hard/types"
+)
+
+type ProposalTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ProposalTestSuite) TestReservePayoutProposal_ValidateBasic() {
+	addrs := []sdk.AccAddress{
+		sdk.AccAddress("test1"),
+		sdk.AccAddress("test2"),
+	}
+	coins := sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))
+	testCases := []struct {
+		name        string
+		incident    string
+		payouts     types.ReservePayouts
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			incident:    "bnb oracle failure",
+			payouts:     types.ReservePayouts{types.NewReservePayout(addrs[0], coins), types.NewReservePayout(addrs[1], coins)},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "empty incident",
+			incident:    "",
+			payouts:     types.ReservePayouts{types.NewReservePayout(addrs[0], coins)},
+			expectPass:  false,
+			expectedErr: "incident cannot be empty",
+		},
+		{
+			name:        "incident too long",
+			incident:    strings.Repeat("a", types.MaxIncidentLength+1),
+			payouts:     types.ReservePayouts{types.NewReservePayout(addrs[0], coins)},
+			expectPass:  false,
+			expectedErr: "incident is longer than max length",
+		},
+		{
+			name:        "no payouts",
+			incident:    "bnb oracle failure",
+			payouts:     types.ReservePayouts{},
+			expectPass:  false,
+			expectedErr: "reserve payouts cannot be empty",
+		},
+		{
+			name:        "empty recipient",
+			incident:    "bnb oracle failure",
+			payouts:     types.ReservePayouts{types.NewReservePayout(sdk.AccAddress{}, coins)},
+			expectPass:  false,
+			expectedErr: "payout recipient cannot be empty",
+		},
+		{
+			name:        "empty amount",
+			incident:    "bnb oracle failure",
+			payouts:     types.ReservePayouts{types.NewReservePayout(addrs[0], sdk.NewCoins())},
+			expectPass:  false,
+			expectedErr: "invalid payout amount",
+		},
+		{
+			name:        "duplicate recipient",
+			incident:    "bnb oracle failure",
+			payouts:     types.ReservePayouts{types.NewReservePayout(addrs[0], coins), types.NewReservePayout(addrs[0], coins)},
+			expectPass:  false,
+			expectedErr: "duplicate payout recipient",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal := types.NewReservePayoutProposal("A Title", "A description for this proposal.", tc.incident, tc.payouts)
+			err := proposal.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *ProposalTestSuite) TestReservePayouts_Total() {
+	payouts := types.ReservePayouts{
+		types.NewReservePayout(sdk.AccAddress("test1"), sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000))),
+		types.NewReservePayout(sdk.AccAddress("test2"), sdk.NewCoins(sdk.NewInt64Coin("bnb", 500), sdk.NewInt64Coin("ukava", 10))),
+	}
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("bnb", 1500), sdk.NewInt64Coin("ukava", 10)), payouts.Total())
+}
+
+func (suite *ProposalTestSuite) TestAddMoneyMarketProposal_ValidateBasic() {
+	model := types.NewInterestRateModel(sdk.MustNewDecFromStr("0.05"), sdk.MustNewDecFromStr("2"), sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("10"))
+	moneyMarket := func(spotMarketID string, conversionFactor sdk.Int) types.MoneyMarket {
+		return types.NewMoneyMarket("bnb", types.NewBorrowLimit(false, sdk.NewDec(1000), sdk.MustNewDecFromStr("0.5")), spotMarketID, conversionFactor, model, sdk.MustNewDecFromStr("0.05"), sdk.ZeroDec())
+	}
+	activationTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name           string
+		moneyMarket    types.MoneyMarket
+		activationTime time.Time
+		expectPass     bool
+		expectedErr    string
+	}{
+		{
+			name:           "valid",
+			moneyMarket:    moneyMarket("bnb:usd", sdk.NewInt(100000000)),
+			activationTime: activationTime,
+			expectPass:     true,
+			expectedErr:    "",
+		},
+		{
+			name:           "empty spot market id",
+			moneyMarket:    moneyMarket("", sdk.NewInt(100000000)),
+			activationTime: activationTime,
+			expectPass:     false,
+			expectedErr:    "spot market id cannot be empty",
+		},
+		{
+			name:           "unset conversion factor",
+			moneyMarket:    moneyMarket("bnb:usd", sdk.Int{}),
+			activationTime: activationTime,
+			expectPass:     true,
+			expectedErr:    "",
+		},
+		{
+			name:           "negative conversion factor",
+			moneyMarket:    moneyMarket("bnb:usd", sdk.NewInt(-1)),
+			activationTime: activationTime,
+			expectPass:     false,
+			expectedErr:    "conversion factor must be positive",
+		},
+		{
+			name:           "missing money market fields",
+			moneyMarket:    types.MoneyMarket{Denom: "bnb", SpotMarketID: "bnb:usd", ConversionFactor: sdk.NewInt(100000000)},
+			activationTime: activationTime,
+			expectPass:     false,
+			expectedErr:    "cannot be empty",
+		},
+		{
+			name:           "empty activation time",
+			moneyMarket:    moneyMarket("bnb:usd", sdk.NewInt(100000000)),
+			activationTime: time.Time{},
+			expectPass:     false,
+			expectedErr:    "activation time cannot be empty",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal := types.NewAddMoneyMarketProposal("A Title", "A description for this proposal.", tc.moneyMarket, tc.activationTime)
+			err := proposal.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *ProposalTestSuite) TestDelistMoneyMarketProposal_ValidateBasic() {
+	deadline := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name        string
+		denom       string
+		deadline    time.Time
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			denom:       "bnb",
+			deadline:    deadline,
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "invalid denom",
+			denom:       "",
+			deadline:    deadline,
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+		{
+			name:        "empty deadline",
+			denom:       "bnb",
+			deadline:    time.Time{},
+			expectPass:  false,
+			expectedErr: "wind down deadline cannot be empty",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposal := types.NewDelistMoneyMarketProposal("A Title", "A description for this proposal.", tc.denom, tc.deadline)
+			err := proposal.ValidateBasic()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *ProposalTestSuite) TestProtocolLiquidityProposals_ValidateBasic() {
+	testCases := []struct {
+		name        string
+		amount      sdk.Coins
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "valid",
+			amount:      sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(100))),
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "empty amount",
+			amount:      sdk.Coins{},
+			expectPass:  false,
+			expectedErr: "invalid protocol liquidity amount",
+		},
+		{
+			name:        "invalid amount",
+			amount:      sdk.Coins{sdk.Coin{Denom: "bnb", Amount: sdk.ZeroInt()}},
+			expectPass:  false,
+			expectedErr: "invalid protocol liquidity amount",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			proposals := []govtypes.Content{
+				types.NewSeedProtocolLiquidityProposal("A Title", "A description for this proposal.", tc.amount),
+				types.NewWithdrawProtocolLiquidityProposal("A Title", "A description for this proposal.", tc.amount),
+			}
+			for _, proposal := range proposals {
+				err := proposal.ValidateBasic()
+				if tc.expectPass {
+					suite.NoError(err)
+				} else {
+					suite.Error(err)
+					suite.Require().True(strings.Contains(err.Error(), tc.expectedErr))
+				}
+			}
+		})
+	}
+}
+
+func TestProposalTestSuite(t *testing.T) {
+	suite.Run(t, new(ProposalTestSuite))
+}
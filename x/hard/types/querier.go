@@ -1,18 +1,48 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Querier routes for the hard module
 const (
-	QueryGetParams         = "params"
-	QueryGetModuleAccounts = "accounts"
-	QueryGetDeposits       = "deposits"
-	QueryGetTotalDeposited = "total-deposited"
-	QueryGetBorrows        = "borrows"
-	QueryGetTotalBorrowed  = "total-borrowed"
-	QueryGetInterestRate   = "interest-rate"
+	QueryGetParams            = "params"
+	QueryGetModuleAccounts    = "accounts"
+	QueryGetDeposits          = "deposits"
+	QueryGetTotalDeposited    = "total-deposited"
+	QueryGetBorrows           = "borrows"
+	QueryGetTotalBorrowed     = "total-borrowed"
+	QueryGetInterestRate      = "interest-rate"
+	QueryGetAccrualTimes      = "accrual-times"
+	QueryGetReferralVolumes   = "referral-volumes"
+	QueryGetInterestAudits    = "interest-audits"
+	QueryGetWindDowns         = "wind-downs"
+	QueryGetMaxWithdraw       = "max-withdraw"
+	QueryGetMaxBorrow         = "max-borrow"
+	QueryGetSimulation        = "simulate"
+	QueryGetStopLosses        = "stop-losses"
+	QueryGetInterestRateCurve = "interest-rate-curve"
+	QueryGetBorrowHistory     = "borrow-history"
+	QueryGetHealthFactor      = "health-factor"
+	QueryGetProtocolLiquidity = "protocol-liquidity"
+)
+
+// Number of utilization points an interest rate curve query samples
+const (
+	DefaultInterestRateCurvePoints = 11
+	MaxInterestRateCurvePoints     = 101
+)
+
+// Position changes that can be dry run with the simulate query
+const (
+	SimulationDeposit     = "deposit"
+	SimulationWithdraw    = "withdraw"
+	SimulationBorrow      = "borrow"
+	SimulationRepay       = "repay"
+	SimulationLiquidation = "liquidate"
 )
 
 // QueryDepositsParams is the params for a filtered deposit query
@@ -103,6 +133,54 @@ func NewQueryInterestRateParams(denom string) QueryInterestRateParams {
 	}
 }
 
+// QueryAccrualTimesParams is the params for a filtered accrual times query
+type QueryAccrualTimesParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryAccrualTimesParams creates a new QueryAccrualTimesParams
+func NewQueryAccrualTimesParams(denom string) QueryAccrualTimesParams {
+	return QueryAccrualTimesParams{
+		Denom: denom,
+	}
+}
+
+// QueryInterestAuditsParams is the params for a filtered interest audits query
+type QueryInterestAuditsParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryInterestAuditsParams creates a new QueryInterestAuditsParams
+func NewQueryInterestAuditsParams(denom string) QueryInterestAuditsParams {
+	return QueryInterestAuditsParams{
+		Denom: denom,
+	}
+}
+
+// QueryReferralVolumesParams is the params for a filtered referral volumes query
+type QueryReferralVolumesParams struct {
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
+}
+
+// NewQueryReferralVolumesParams creates a new QueryReferralVolumesParams
+func NewQueryReferralVolumesParams(referrer sdk.AccAddress) QueryReferralVolumesParams {
+	return QueryReferralVolumesParams{
+		Referrer: referrer,
+	}
+}
+
+// QueryStopLossesParams is the params for a filtered stop losses query
+type QueryStopLossesParams struct {
+	Borrower sdk.AccAddress `json:"borrower" yaml:"borrower"`
+}
+
+// NewQueryStopLossesParams creates a new QueryStopLossesParams
+func NewQueryStopLossesParams(borrower sdk.AccAddress) QueryStopLossesParams {
+	return QueryStopLossesParams{
+		Borrower: borrower,
+	}
+}
+
 // MoneyMarketInterestRate is a unique type returned by interest rate queries
 type MoneyMarketInterestRate struct {
 	Denom              string  `json:"denom" yaml:"denom"`
@@ -121,3 +199,156 @@ func NewMoneyMarketInterestRate(denom string, supplyInterestRate, borrowInterest
 
 // MoneyMarketInterestRates is a slice of MoneyMarketInterestRate
 type MoneyMarketInterestRates []MoneyMarketInterestRate
+
+// QueryWindDownsParams is the params for a filtered money market wind downs query
+type QueryWindDownsParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryWindDownsParams creates a new QueryWindDownsParams
+func NewQueryWindDownsParams(denom string) QueryWindDownsParams {
+	return QueryWindDownsParams{
+		Denom: denom,
+	}
+}
+
+// QueryMaxAmountParams is the params for a max withdraw or max borrow query
+type QueryMaxAmountParams struct {
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	Denom        string         `json:"denom" yaml:"denom"`
+	SafetyMargin sdk.Dec        `json:"safety_margin" yaml:"safety_margin"`
+}
+
+// NewQueryMaxAmountParams creates a new QueryMaxAmountParams
+func NewQueryMaxAmountParams(owner sdk.AccAddress, denom string, safetyMargin sdk.Dec) QueryMaxAmountParams {
+	return QueryMaxAmountParams{
+		Owner:        owner,
+		Denom:        denom,
+		SafetyMargin: safetyMargin,
+	}
+}
+
+// QuerySimulationParams is the params for a simulate query. The sender makes the change to the owner's position, they
+// differ only for repayments made on behalf of another account and for liquidations, where the sender is the keeper.
+type QuerySimulationParams struct {
+	Action string         `json:"action" yaml:"action"`
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewQuerySimulationParams creates a new QuerySimulationParams
+func NewQuerySimulationParams(action string, sender, owner sdk.AccAddress, amount sdk.Coins) QuerySimulationParams {
+	return QuerySimulationParams{
+		Action: action,
+		Sender: sender,
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// SimulatedPosition is the outcome of a hypothetical deposit, withdraw, borrow, repay, or liquidation
+type SimulatedPosition struct {
+	Action         string         `json:"action" yaml:"action"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Deposit        sdk.Coins      `json:"deposit" yaml:"deposit"`                 // deposit after the change
+	Borrow         sdk.Coins      `json:"borrow" yaml:"borrow"`                   // borrow after the change
+	SupplyInterest sdk.Coins      `json:"supply_interest" yaml:"supply_interest"` // interest earned since the deposit was last synced
+	BorrowInterest sdk.Coins      `json:"borrow_interest" yaml:"borrow_interest"` // interest owed since the borrow was last synced
+	LTV            sdk.Dec        `json:"ltv" yaml:"ltv"`                         // loan-to-value ratio after the change
+	HealthFactor   sdk.Dec        `json:"health_factor" yaml:"health_factor"`     // health factor after the change
+	Success        bool           `json:"success" yaml:"success"`                 // whether the change would succeed
+	Error          string         `json:"error,omitempty" yaml:"error,omitempty"` // reason the change would fail
+}
+
+// String implements fmt.Stringer
+func (sp SimulatedPosition) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`SimulatedPosition:
+	Action: %s
+	Owner: %s
+	Deposit: %s
+	Borrow: %s
+	Supply Interest: %s
+	Borrow Interest: %s
+	LTV: %s
+	Health Factor: %s
+	Success: %t
+	Error: %s`,
+		sp.Action, sp.Owner, sp.Deposit, sp.Borrow, sp.SupplyInterest, sp.BorrowInterest, sp.LTV, sp.HealthFactor, sp.Success, sp.Error,
+	))
+}
+
+// QueryInterestRateCurveParams is the params for an interest rate curve query
+type QueryInterestRateCurveParams struct {
+	Denom  string `json:"denom" yaml:"denom"`
+	Points int    `json:"points" yaml:"points"`
+}
+
+// NewQueryInterestRateCurveParams creates a new QueryInterestRateCurveParams
+func NewQueryInterestRateCurveParams(denom string, points int) QueryInterestRateCurveParams {
+	return QueryInterestRateCurveParams{
+		Denom:  denom,
+		Points: points,
+	}
+}
+
+// InterestRateCurvePoint is the borrow and supply APY a money market would pay at a utilization ratio
+type InterestRateCurvePoint struct {
+	Utilization        sdk.Dec `json:"utilization" yaml:"utilization"`
+	BorrowInterestRate sdk.Dec `json:"borrow_interest_rate" yaml:"borrow_interest_rate"`
+	SupplyInterestRate sdk.Dec `json:"supply_interest_rate" yaml:"supply_interest_rate"`
+}
+
+// NewInterestRateCurvePoint returns a new InterestRateCurvePoint
+func NewInterestRateCurvePoint(utilization, borrowInterestRate, supplyInterestRate sdk.Dec) InterestRateCurvePoint {
+	return InterestRateCurvePoint{
+		Utilization:        utilization,
+		BorrowInterestRate: borrowInterestRate,
+		SupplyInterestRate: supplyInterestRate,
+	}
+}
+
+// InterestRateCurve is a money market's interest rate model sampled at evenly spaced utilization ratios from 0 to 1
+type InterestRateCurve struct {
+	Denom  string                   `json:"denom" yaml:"denom"`
+	Points []InterestRateCurvePoint `json:"points" yaml:"points"`
+}
+
+// String implements fmt.Stringer
+func (c InterestRateCurve) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Interest Rate Curve %s:", c.Denom)
+	for _, p := range c.Points {
+		fmt.Fprintf(&b, "\n\tUtilization: %s, Borrow Interest Rate: %s, Supply Interest Rate: %s",
+			p.Utilization, p.BorrowInterestRate, p.SupplyInterestRate)
+	}
+	return b.String()
+}
+
+// QueryBorrowHistoryParams is the params for a paginated borrow history query
+type QueryBorrowHistoryParams struct {
+	Page  int            `json:"page" yaml:"page"`
+	Limit int            `json:"limit" yaml:"limit"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryBorrowHistoryParams creates a new QueryBorrowHistoryParams
+func NewQueryBorrowHistoryParams(page, limit int, owner sdk.AccAddress) QueryBorrowHistoryParams {
+	return QueryBorrowHistoryParams{
+		Page:  page,
+		Limit: limit,
+		Owner: owner,
+	}
+}
+
+// QueryHealthFactorParams is the params for a health factor query
+type QueryHealthFactorParams struct {
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryHealthFactorParams creates a new QueryHealthFactorParams
+func NewQueryHealthFactorParams(owner sdk.AccAddress) QueryHealthFactorParams {
+	return QueryHealthFactorParams{
+		Owner: owner,
+	}
+}
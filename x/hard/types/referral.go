@@ -0,0 +1,69 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReferralVolume is the total volume of deposits made with an address as the referrer
+type ReferralVolume struct {
+	Referrer sdk.AccAddress `json:"referrer" yaml:"referrer"`
+	Volume   sdk.Coins      `json:"volume" yaml:"volume"`
+}
+
+// NewReferralVolume returns a new ReferralVolume
+func NewReferralVolume(referrer sdk.AccAddress, volume sdk.Coins) ReferralVolume {
+	return ReferralVolume{
+		Referrer: referrer,
+		Volume:   volume,
+	}
+}
+
+// Validate performs basic validation of a ReferralVolume
+func (rv ReferralVolume) Validate() error {
+	if rv.Referrer.Empty() {
+		return errors.New("referrer cannot be empty")
+	}
+	if !rv.Volume.IsValid() {
+		return fmt.Errorf("invalid referral volume: %s", rv.Volume)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rv ReferralVolume) String() string {
+	return fmt.Sprintf(`Referral Volume:
+	Referrer: %s
+	Volume: %s
+`, rv.Referrer, rv.Volume)
+}
+
+// ReferralVolumes slice of ReferralVolume
+type ReferralVolumes []ReferralVolume
+
+// Validate performs basic validation of each referral volume and checks that no referrer is repeated
+func (rvs ReferralVolumes) Validate() error {
+	seenReferrers := make(map[string]bool)
+	for _, rv := range rvs {
+		if err := rv.Validate(); err != nil {
+			return err
+		}
+		if seenReferrers[rv.Referrer.String()] {
+			return fmt.Errorf("duplicate referrer: %s", rv.Referrer)
+		}
+		seenReferrers[rv.Referrer.String()] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rvs ReferralVolumes) String() string {
+	out := ""
+	for _, rv := range rvs {
+		out += rv.String()
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// reRiskTier matches valid risk tier names
+var reRiskTier = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ValidateRiskTier checks that a risk tier name is 1-32 lowercase letters, digits, underscores, or dashes, starting
+// with a letter
+func ValidateRiskTier(tier string) error {
+	if !reRiskTier.MatchString(tier) {
+		return fmt.Errorf("invalid risk tier: %q", tier)
+	}
+	return nil
+}
+
+// TierBorrowRule allows deposits in money markets of one risk tier to collateralize borrows from money markets of
+// another. The loan-to-value ratio of the collateral is reduced by LtvHaircut for those borrows, so a haircut of 0.25
+// counts collateral with an LTV of 0.8 at 0.6.
+type TierBorrowRule struct {
+	CollateralTier string  `json:"collateral_tier" yaml:"collateral_tier"`
+	BorrowTier     string  `json:"borrow_tier" yaml:"borrow_tier"`
+	LtvHaircut     sdk.Dec `json:"ltv_haircut" yaml:"ltv_haircut"`
+}
+
+// NewTierBorrowRule returns a new TierBorrowRule
+func NewTierBorrowRule(collateralTier, borrowTier string, ltvHaircut sdk.Dec) TierBorrowRule {
+	return TierBorrowRule{
+		CollateralTier: collateralTier,
+		BorrowTier:     borrowTier,
+		LtvHaircut:     ltvHaircut,
+	}
+}
+
+// Validate performs basic validation of a TierBorrowRule
+func (r TierBorrowRule) Validate() error {
+	if err := ValidateRiskTier(r.CollateralTier); err != nil {
+		return err
+	}
+	if err := ValidateRiskTier(r.BorrowTier); err != nil {
+		return err
+	}
+	if r.CollateralTier == r.BorrowTier {
+		return fmt.Errorf("tier borrow rule cannot be within a single tier: %s", r.CollateralTier)
+	}
+	if r.LtvHaircut.IsNil() || r.LtvHaircut.IsNegative() || r.LtvHaircut.GTE(sdk.OneDec()) {
+		return fmt.Errorf("tier borrow rule LTV haircut must be between [0, 1): %s", r.LtvHaircut)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (r TierBorrowRule) String() string {
+	return fmt.Sprintf("%s collateralizes %s (LTV haircut %s)", r.CollateralTier, r.BorrowTier, r.LtvHaircut)
+}
+
+// TierBorrowRules slice of TierBorrowRule
+type TierBorrowRules []TierBorrowRule
+
+// Validate performs basic validation of each rule and checks that no pair of tiers has more than one rule
+func (rules TierBorrowRules) Validate() error {
+	seenPairs := make(map[string]bool)
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		pair := r.CollateralTier + "/" + r.BorrowTier
+		if seenPairs[pair] {
+			return fmt.Errorf("duplicate tier borrow rule: %s", pair)
+		}
+		seenPairs[pair] = true
+	}
+	return nil
+}
+
+// CollateralHaircut returns the LTV haircut applied to deposits of the collateral tier when they collateralize borrows of
+// the borrow tier, and false if they cannot collateralize them at all. Deposits always collateralize borrows of their
+// own tier in full, and markets without a risk tier are not restricted.
+func (rules TierBorrowRules) CollateralHaircut(collateralTier, borrowTier string) (sdk.Dec, bool) {
+	if collateralTier == "" || borrowTier == "" || collateralTier == borrowTier {
+		return sdk.ZeroDec(), true
+	}
+	for _, r := range rules {
+		if r.CollateralTier == collateralTier && r.BorrowTier == borrowTier {
+			return r.LtvHaircut, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
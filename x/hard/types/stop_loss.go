@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StopLoss is a borrower's instruction to reduce their borrow automatically once the LTV of their position rises
+// above a threshold. When it triggers, deposits of a borrowed denom repay the borrow directly, then other deposits
+// are sold through the swap module for the borrowed denoms, until up to MaxRepayValue USD of the borrow is repaid.
+type StopLoss struct {
+	Borrower      sdk.AccAddress `json:"borrower" yaml:"borrower"`
+	LtvThreshold  sdk.Dec        `json:"ltv_threshold" yaml:"ltv_threshold"`
+	MaxRepayValue sdk.Dec        `json:"max_repay_value" yaml:"max_repay_value"`
+}
+
+// NewStopLoss returns a new StopLoss
+func NewStopLoss(borrower sdk.AccAddress, ltvThreshold, maxRepayValue sdk.Dec) StopLoss {
+	return StopLoss{
+		Borrower:      borrower,
+		LtvThreshold:  ltvThreshold,
+		MaxRepayValue: maxRepayValue,
+	}
+}
+
+// Validate performs basic validation of a StopLoss
+func (sl StopLoss) Validate() error {
+	if sl.Borrower.Empty() {
+		return errors.New("stop loss borrower cannot be empty")
+	}
+	if sl.LtvThreshold.IsNil() || !sl.LtvThreshold.IsPositive() || sl.LtvThreshold.GTE(sdk.OneDec()) {
+		return fmt.Errorf("stop loss LTV threshold must be between (0, 1): %s", sl.LtvThreshold)
+	}
+	if sl.MaxRepayValue.IsNil() || !sl.MaxRepayValue.IsPositive() {
+		return fmt.Errorf("stop loss max repay value must be positive: %s", sl.MaxRepayValue)
+	}
+	return nil
+}
+
+// IsTriggered returns true if a position with the given LTV should be reduced
+func (sl StopLoss) IsTriggered(ltv sdk.Dec) bool {
+	return ltv.GT(sl.LtvThreshold)
+}
+
+// String implements fmt.Stringer
+func (sl StopLoss) String() string {
+	return fmt.Sprintf(`Stop Loss:
+	Borrower: %s
+	LTV Threshold: %s
+	Max Repay Value: %s`, sl.Borrower, sl.LtvThreshold, sl.MaxRepayValue)
+}
+
+// StopLosses slice of StopLoss
+type StopLosses []StopLoss
+
+// Validate performs basic validation of each stop loss and checks that no borrower has more than one
+func (sls StopLosses) Validate() error {
+	seenBorrowers := make(map[string]bool)
+	for _, sl := range sls {
+		if err := sl.Validate(); err != nil {
+			return err
+		}
+		if seenBorrowers[sl.Borrower.String()] {
+			return fmt.Errorf("duplicate stop loss borrower: %s", sl.Borrower)
+		}
+		seenBorrowers[sl.Borrower.String()] = true
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingDeposit is the part of a deposit made with coins that were still vesting, which is tracked as delegated
+// vesting coins on the depositor's vesting account until it is withdrawn
+type VestingDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewVestingDeposit returns a new VestingDeposit
+func NewVestingDeposit(depositor sdk.AccAddress, amount sdk.Coins) VestingDeposit {
+	return VestingDeposit{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Validate performs basic validation of a VestingDeposit
+func (vd VestingDeposit) Validate() error {
+	if vd.Depositor.Empty() {
+		return errors.New("depositor cannot be empty")
+	}
+	if !vd.Amount.IsValid() || vd.Amount.Empty() {
+		return fmt.Errorf("invalid vesting deposit amount: %s", vd.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (vd VestingDeposit) String() string {
+	return fmt.Sprintf(`Vesting Deposit:
+	Depositor: %s
+	Amount: %s
+`, vd.Depositor, vd.Amount)
+}
+
+// VestingDeposits slice of VestingDeposit
+type VestingDeposits []VestingDeposit
+
+// Validate performs basic validation of each vesting deposit and checks that no depositor is repeated
+func (vds VestingDeposits) Validate() error {
+	seenDepositors := make(map[string]bool)
+	for _, vd := range vds {
+		if err := vd.Validate(); err != nil {
+			return err
+		}
+		if seenDepositors[vd.Depositor.String()] {
+			return fmt.Errorf("duplicate vesting depositor: %s", vd.Depositor)
+		}
+		seenDepositors[vd.Depositor.String()] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (vds VestingDeposits) String() string {
+	out := ""
+	for _, vd := range vds {
+		out += vd.String()
+	}
+	return strings.TrimSpace(out)
+}
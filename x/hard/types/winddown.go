@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MoneyMarketWindDown marks a money market as deprecated. Deprecated markets accept no new deposits or borrows,
+// and at the deadline their remaining positions are closed so the market can be removed from the params.
+type MoneyMarketWindDown struct {
+	Denom    string    `json:"denom" yaml:"denom"`
+	Deadline time.Time `json:"deadline" yaml:"deadline"`
+}
+
+// NewMoneyMarketWindDown returns a new MoneyMarketWindDown
+func NewMoneyMarketWindDown(denom string, deadline time.Time) MoneyMarketWindDown {
+	return MoneyMarketWindDown{
+		Denom:    denom,
+		Deadline: deadline,
+	}
+}
+
+// Validate performs basic validation of a MoneyMarketWindDown
+func (wd MoneyMarketWindDown) Validate() error {
+	if err := sdk.ValidateDenom(wd.Denom); err != nil {
+		return err
+	}
+	if wd.Deadline.IsZero() {
+		return errors.New("wind down deadline cannot be empty")
+	}
+	return nil
+}
+
+// IsPastDeadline returns true if the remaining positions of the market should be closed at the given block time
+func (wd MoneyMarketWindDown) IsPastDeadline(blockTime time.Time) bool {
+	return !wd.Deadline.After(blockTime)
+}
+
+// MoneyMarketWindDowns slice of MoneyMarketWindDown
+type MoneyMarketWindDowns []MoneyMarketWindDown
+
+// Validate performs basic validation of each wind down and checks that no denom is wound down twice
+func (wds MoneyMarketWindDowns) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, wd := range wds {
+		if err := wd.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[wd.Denom] {
+			return fmt.Errorf("duplicate money market wind down: %s", wd.Denom)
+		}
+		seenDenoms[wd.Denom] = true
+	}
+	return nil
+}
+
+// WindDownProgress reports the positions that remain open in a deprecated money market
+type WindDownProgress struct {
+	Denom         string    `json:"denom" yaml:"denom"`
+	Deadline      time.Time `json:"deadline" yaml:"deadline"`
+	TotalSupplied sdk.Int   `json:"total_supplied" yaml:"total_supplied"`
+	TotalBorrowed sdk.Int   `json:"total_borrowed" yaml:"total_borrowed"`
+	Depositors    int       `json:"depositors" yaml:"depositors"`
+	Borrowers     int       `json:"borrowers" yaml:"borrowers"`
+}
+
+// NewWindDownProgress returns a new WindDownProgress
+func NewWindDownProgress(windDown MoneyMarketWindDown, totalSupplied, totalBorrowed sdk.Int, depositors, borrowers int) WindDownProgress {
+	return WindDownProgress{
+		Denom:         windDown.Denom,
+		Deadline:      windDown.Deadline,
+		TotalSupplied: totalSupplied,
+		TotalBorrowed: totalBorrowed,
+		Depositors:    depositors,
+		Borrowers:     borrowers,
+	}
+}
+
+// WindDownProgresses slice of WindDownProgress
+type WindDownProgresses []WindDownProgress
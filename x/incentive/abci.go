@@ -33,4 +33,7 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(err)
 		}
 	}
+	k.RolloverRewardPeriods(ctx)
+	k.SynchronizeExcludedClaims(ctx)
+	k.ExpireUnclaimedRewards(ctx)
 }
@@ -15,7 +15,15 @@ const (
 	AttributeKeyClaimPeriod        = types.AttributeKeyClaimPeriod
 	AttributeKeyClaimType          = types.AttributeKeyClaimType
 	AttributeKeyClaimedBy          = types.AttributeKeyClaimedBy
+	AttributeKeyCollateralType     = types.AttributeKeyCollateralType
+	AttributeKeyExpiredAmount      = types.AttributeKeyExpiredAmount
+	AttributeKeyPenaltyAmount      = types.AttributeKeyPenaltyAmount
+	AttributeKeyPenaltyBurned      = types.AttributeKeyPenaltyBurned
 	AttributeKeyRewardPeriod       = types.AttributeKeyRewardPeriod
+	AttributeKeyRewardType         = types.AttributeKeyRewardType
+	AttributeKeyRolloverPolicy     = types.AttributeKeyRolloverPolicy
+	AttributeKeyUnlockAmount       = types.AttributeKeyUnlockAmount
+	AttributeKeyUnlockedBy         = types.AttributeKeyUnlockedBy
 	AttributeValueCategory         = types.AttributeValueCategory
 	BondDenom                      = types.BondDenom
 	DefaultParamspace              = types.DefaultParamspace
@@ -23,12 +31,19 @@ const (
 	EventTypeClaimPeriod           = types.EventTypeClaimPeriod
 	EventTypeClaimPeriodExpiry     = types.EventTypeClaimPeriodExpiry
 	EventTypeRewardPeriod          = types.EventTypeRewardPeriod
+	EventTypeRewardPeriodEnd       = types.EventTypeRewardPeriodEnd
+	EventTypeRewardsExpired        = types.EventTypeRewardsExpired
+	EventTypeUnlockRewardsEarly    = types.EventTypeUnlockRewardsEarly
+	HardBorrowRewardType           = types.HardBorrowRewardType
+	HardDelegatorRewardType        = types.HardDelegatorRewardType
 	HardLiquidityProviderClaimType = types.HardLiquidityProviderClaimType
+	HardSupplyRewardType           = types.HardSupplyRewardType
 	Large                          = types.Large
 	Medium                         = types.Medium
 	ModuleName                     = types.ModuleName
 	QuerierRoute                   = types.QuerierRoute
 	QueryGetClaimPeriods           = types.QueryGetClaimPeriods
+	QueryGetExpiredRewards         = types.QueryGetExpiredRewards
 	QueryGetHardRewards            = types.QueryGetHardRewards
 	QueryGetParams                 = types.QueryGetParams
 	QueryGetRewardPeriods          = types.QueryGetRewardPeriods
@@ -37,10 +52,14 @@ const (
 	RestClaimCollateralType        = types.RestClaimCollateralType
 	RestClaimOwner                 = types.RestClaimOwner
 	RestClaimType                  = types.RestClaimType
+	RolloverRepeat                 = types.RolloverRepeat
+	RolloverRetire                 = types.RolloverRetire
+	RolloverTaper                  = types.RolloverTaper
 	RouterKey                      = types.RouterKey
 	Small                          = types.Small
 	StoreKey                       = types.StoreKey
 	USDXMintingClaimType           = types.USDXMintingClaimType
+	USDXMintingRewardType          = types.USDXMintingRewardType
 )
 
 var (
@@ -48,14 +67,22 @@ var (
 	CalculateTimeElapsed                   = keeper.CalculateTimeElapsed
 	NewKeeper                              = keeper.NewKeeper
 	NewQuerier                             = keeper.NewQuerier
+	ClaimTypeOfRewardType                  = types.ClaimTypeOfRewardType
 	DefaultGenesisState                    = types.DefaultGenesisState
 	DefaultParams                          = types.DefaultParams
+	GetRewardAccrualKey                    = types.GetRewardAccrualKey
+	GetRewardLockupKey                     = types.GetRewardLockupKey
+	GetRewardPeriodKey                     = types.GetRewardPeriodKey
 	GetTotalVestingPeriodLength            = types.GetTotalVestingPeriodLength
+	NewClaimDeadline                       = types.NewClaimDeadline
+	NewEarlyUnlockPenalty                  = types.NewEarlyUnlockPenalty
+	NewExpiredReward                       = types.NewExpiredReward
 	NewGenesisAccumulationTime             = types.NewGenesisAccumulationTime
 	NewGenesisState                        = types.NewGenesisState
 	NewHardLiquidityProviderClaim          = types.NewHardLiquidityProviderClaim
 	NewMsgClaimHardLiquidityProviderReward = types.NewMsgClaimHardLiquidityProviderReward
 	NewMsgClaimUSDXMintingReward           = types.NewMsgClaimUSDXMintingReward
+	NewMsgUnlockRewardsEarly               = types.NewMsgUnlockRewardsEarly
 	NewMultiRewardIndex                    = types.NewMultiRewardIndex
 	NewMultiRewardPeriod                   = types.NewMultiRewardPeriod
 	NewMultiplier                          = types.NewMultiplier
@@ -64,43 +91,58 @@ var (
 	NewQueryHardRewardsParams              = types.NewQueryHardRewardsParams
 	NewQueryRewardsParams                  = types.NewQueryRewardsParams
 	NewQueryUSDXMintingRewardsParams       = types.NewQueryUSDXMintingRewardsParams
+	NewRewardAccrual                       = types.NewRewardAccrual
 	NewRewardIndex                         = types.NewRewardIndex
+	NewRewardLockup                        = types.NewRewardLockup
 	NewRewardPeriod                        = types.NewRewardPeriod
+	NewRewardPeriodRollover                = types.NewRewardPeriodRollover
 	NewUSDXMintingClaim                    = types.NewUSDXMintingClaim
 	ParamKeyTable                          = types.ParamKeyTable
 	RegisterCodec                          = types.RegisterCodec
 
 	// variable aliases
 	DefaultActive                                   = types.DefaultActive
+	DefaultClaimDeadlines                           = types.DefaultClaimDeadlines
 	DefaultClaimEnd                                 = types.DefaultClaimEnd
+	DefaultEarlyUnlockPenalty                       = types.DefaultEarlyUnlockPenalty
+	DefaultExcludedAddresses                        = types.DefaultExcludedAddresses
 	DefaultGenesisAccumulationTimes                 = types.DefaultGenesisAccumulationTimes
 	DefaultHardClaims                               = types.DefaultHardClaims
 	DefaultMultiRewardPeriods                       = types.DefaultMultiRewardPeriods
 	DefaultMultipliers                              = types.DefaultMultipliers
+	DefaultRewardPeriodRollovers                    = types.DefaultRewardPeriodRollovers
 	DefaultRewardPeriods                            = types.DefaultRewardPeriods
 	DefaultUSDXClaims                               = types.DefaultUSDXClaims
 	ErrAccountNotFound                              = types.ErrAccountNotFound
 	ErrClaimExpired                                 = types.ErrClaimExpired
 	ErrClaimNotFound                                = types.ErrClaimNotFound
+	ErrEarlyUnlockDisabled                          = types.ErrEarlyUnlockDisabled
 	ErrInsufficientModAccountBalance                = types.ErrInsufficientModAccountBalance
 	ErrInvalidAccountType                           = types.ErrInvalidAccountType
 	ErrInvalidClaimType                             = types.ErrInvalidClaimType
 	ErrInvalidMultiplier                            = types.ErrInvalidMultiplier
+	ErrInvalidVestingSchedule                       = types.ErrInvalidVestingSchedule
 	ErrNoClaimsFound                                = types.ErrNoClaimsFound
+	ErrNoLockedRewards                              = types.ErrNoLockedRewards
 	ErrRewardPeriodNotFound                         = types.ErrRewardPeriodNotFound
 	ErrZeroClaim                                    = types.ErrZeroClaim
+	ExpiredRewardKeyPrefix                          = types.ExpiredRewardKeyPrefix
 	GovDenom                                        = types.GovDenom
 	HardBorrowRewardIndexesKeyPrefix                = types.HardBorrowRewardIndexesKeyPrefix
-	HardDelegatorRewardFactorKeyPrefix              = types.HardDelegatorRewardFactorKeyPrefix
+	HardDelegatorRewardIndexesKeyPrefix             = types.HardDelegatorRewardIndexesKeyPrefix
 	HardLiquidityClaimKeyPrefix                     = types.HardLiquidityClaimKeyPrefix
 	HardLiquidityRewardDenom                        = types.HardLiquidityRewardDenom
 	HardSupplyRewardIndexesKeyPrefix                = types.HardSupplyRewardIndexesKeyPrefix
 	IncentiveMacc                                   = types.IncentiveMacc
+	KeyClaimDeadlines                               = types.KeyClaimDeadlines
 	KeyClaimEnd                                     = types.KeyClaimEnd
+	KeyEarlyUnlockPenalty                           = types.KeyEarlyUnlockPenalty
+	KeyExcludedAddresses                            = types.KeyExcludedAddresses
 	KeyHardBorrowRewardPeriods                      = types.KeyHardBorrowRewardPeriods
 	KeyHardDelegatorRewardPeriods                   = types.KeyHardDelegatorRewardPeriods
 	KeyHardSupplyRewardPeriods                      = types.KeyHardSupplyRewardPeriods
 	KeyMultipliers                                  = types.KeyMultipliers
+	KeyRewardPeriodRollovers                        = types.KeyRewardPeriodRollovers
 	KeyUSDXMintingRewardPeriods                     = types.KeyUSDXMintingRewardPeriods
 	ModuleCdc                                       = types.ModuleCdc
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = types.PreviousHardBorrowRewardAccrualTimeKeyPrefix
@@ -108,6 +150,8 @@ var (
 	PreviousHardSupplyRewardAccrualTimeKeyPrefix    = types.PreviousHardSupplyRewardAccrualTimeKeyPrefix
 	PreviousUSDXMintingRewardAccrualTimeKeyPrefix   = types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix
 	PrincipalDenom                                  = types.PrincipalDenom
+	RewardAccrualKeyPrefix                          = types.RewardAccrualKeyPrefix
+	RewardLockupKeyPrefix                           = types.RewardLockupKeyPrefix
 	USDXMintingClaimKeyPrefix                       = types.USDXMintingClaimKeyPrefix
 	USDXMintingRewardDenom                          = types.USDXMintingRewardDenom
 	USDXMintingRewardFactorKeyPrefix                = types.USDXMintingRewardFactorKeyPrefix
@@ -122,7 +166,12 @@ type (
 	CDPHooks                            = types.CDPHooks
 	CdpKeeper                           = types.CdpKeeper
 	Claim                               = types.Claim
+	ClaimDeadline                       = types.ClaimDeadline
+	ClaimDeadlines                      = types.ClaimDeadlines
 	Claims                              = types.Claims
+	EarlyUnlockPenalty                  = types.EarlyUnlockPenalty
+	ExpiredReward                       = types.ExpiredReward
+	ExpiredRewards                      = types.ExpiredRewards
 	GenesisAccumulationTime             = types.GenesisAccumulationTime
 	GenesisAccumulationTimes            = types.GenesisAccumulationTimes
 	GenesisState                        = types.GenesisState
@@ -132,6 +181,7 @@ type (
 	HardLiquidityProviderClaims         = types.HardLiquidityProviderClaims
 	MsgClaimHardLiquidityProviderReward = types.MsgClaimHardLiquidityProviderReward
 	MsgClaimUSDXMintingReward           = types.MsgClaimUSDXMintingReward
+	MsgUnlockRewardsEarly               = types.MsgUnlockRewardsEarly
 	MultiRewardIndex                    = types.MultiRewardIndex
 	MultiRewardIndexes                  = types.MultiRewardIndexes
 	MultiRewardPeriod                   = types.MultiRewardPeriod
@@ -141,13 +191,21 @@ type (
 	Multipliers                         = types.Multipliers
 	Params                              = types.Params
 	PostClaimReq                        = types.PostClaimReq
+	PostUnlockRewardsEarlyReq           = types.PostUnlockRewardsEarlyReq
 	QueryHardRewardsParams              = types.QueryHardRewardsParams
 	QueryRewardsParams                  = types.QueryRewardsParams
 	QueryUSDXMintingRewardsParams       = types.QueryUSDXMintingRewardsParams
+	RewardAccrual                       = types.RewardAccrual
+	RewardAccruals                      = types.RewardAccruals
 	RewardIndex                         = types.RewardIndex
 	RewardIndexes                       = types.RewardIndexes
+	RewardLockup                        = types.RewardLockup
+	RewardLockups                       = types.RewardLockups
 	RewardPeriod                        = types.RewardPeriod
+	RewardPeriodRollover                = types.RewardPeriodRollover
+	RewardPeriodRollovers               = types.RewardPeriodRollovers
 	RewardPeriods                       = types.RewardPeriods
+	RolloverPolicy                      = types.RolloverPolicy
 	StakingKeeper                       = types.StakingKeeper
 	SupplyKeeper                        = types.SupplyKeeper
 	USDXMintingClaim                    = types.USDXMintingClaim
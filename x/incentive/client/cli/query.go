@@ -31,6 +31,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	incentiveQueryCmd.AddCommand(flags.GetCommands(
 		queryParamsCmd(queryRoute, cdc),
 		queryRewardsCmd(queryRoute, cdc),
+		queryExpiredRewardsCmd(queryRoute, cdc),
 	)...)
 
 	return incentiveQueryCmd
@@ -139,6 +140,33 @@ func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+func queryExpiredRewardsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "expired-rewards",
+		Short: "get the rewards that expired after their claim deadline",
+		Long:  "Get the total rewards from each reward period that were not claimed before the reward period's claim deadline.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetExpiredRewards)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var expired types.ExpiredRewards
+			if err := cdc.UnmarshalJSON(res, &expired); err != nil {
+				return fmt.Errorf("failed to unmarshal expired rewards: %w", err)
+			}
+			return cliCtx.PrintOutput(expired)
+		},
+	}
+}
+
 func executeHardRewardsQuery(queryRoute string, cdc *codec.Codec, cliCtx context.CLIContext,
 	params types.QueryHardRewardsParams) (types.HardLiquidityProviderClaims, error) {
 	bz, err := cdc.MarshalJSON(params)
@@ -29,6 +29,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	incentiveTxCmd.AddCommand(flags.PostCommands(
 		getCmdClaimCdp(cdc),
 		getCmdClaimHard(cdc),
+		getCmdUnlockRewardsEarly(cdc),
 	)...)
 
 	return incentiveTxCmd
@@ -108,3 +109,40 @@ func getCmdClaimHard(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdUnlockRewardsEarly(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlock-rewards-early [owner]",
+		Short: "unlock vesting rewards before the end of their lockup",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unlock all of owner's claimed rewards that are still vesting, forfeiting the early unlock penalty,
+
+			Example:
+			$ %s tx %s unlock-rewards-early kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+		`, version.ClientName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInputAndFrom(inBuf, args[0]).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress()
+			if !sender.Equals(owner) {
+				return sdkerrors.Wrapf(types.ErrInvalidClaimOwner, "tx sender %s does not match owner %s", sender, owner)
+			}
+
+			msg := types.NewMsgUnlockRewardsEarly(owner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -48,8 +48,13 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		k.SetHardBorrowRewardIndexes(ctx, mrp.CollateralType, newRewardIndexes)
 	}
 
-	for _, rp := range gs.Params.HardDelegatorRewardPeriods {
-		k.SetHardDelegatorRewardFactor(ctx, rp.CollateralType, sdk.ZeroDec())
+	for _, mrp := range gs.Params.HardDelegatorRewardPeriods {
+		newRewardIndexes := types.RewardIndexes{}
+		for _, rc := range mrp.RewardsPerSecond {
+			ri := types.NewRewardIndex(rc.Denom, sdk.ZeroDec())
+			newRewardIndexes = append(newRewardIndexes, ri)
+		}
+		k.SetHardDelegatorRewardIndexes(ctx, mrp.CollateralType, newRewardIndexes)
 	}
 
 	k.SetParams(ctx, gs.Params)
@@ -95,13 +100,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 				}
 			}
 		}
-		for _, ri := range claim.DelegatorRewardIndexes {
-			if ri.RewardFactor != sdk.ZeroDec() {
-				ri.RewardFactor = sdk.ZeroDec()
+		for _, mri := range claim.DelegatorRewardIndexes {
+			for _, ri := range mri.RewardIndexes {
+				if ri.RewardFactor != sdk.ZeroDec() {
+					ri.RewardFactor = sdk.ZeroDec()
+				}
 			}
 		}
 		k.SetHardLiquidityProviderClaim(ctx, claim)
 	}
+
+	for _, rl := range gs.RewardLockups {
+		k.SetRewardLockup(ctx, rl)
+	}
+
+	for _, ra := range gs.RewardAccruals {
+		k.SetRewardAccrual(ctx, ra)
+	}
+
+	for _, er := range gs.ExpiredRewards {
+		k.SetExpiredReward(ctx, er)
+	}
 }
 
 // ExportGenesis export genesis state for incentive module
@@ -142,7 +161,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 			}
 		}
 		for _, dri := range claim.DelegatorRewardIndexes {
-			dri.RewardFactor = sdk.ZeroDec()
+			for _, ri := range dri.RewardIndexes {
+				ri.RewardFactor = sdk.ZeroDec()
+			}
 		}
 		synchronizedHardClaims = append(synchronizedHardClaims, claim)
 	}
@@ -162,5 +183,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		gats = append(gats, gat)
 	}
 
-	return types.NewGenesisState(params, gats, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, synchronizedUsdxClaims, synchronizedHardClaims)
+	gs := types.NewGenesisState(params, gats, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, DefaultGenesisAccumulationTimes, synchronizedUsdxClaims, synchronizedHardClaims)
+	gs.RewardLockups = k.GetAllRewardLockups(ctx)
+	gs.RewardAccruals = k.GetAllRewardAccruals(ctx)
+	gs.ExpiredRewards = k.GetAllExpiredRewards(ctx)
+	return gs
 }
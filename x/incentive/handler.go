@@ -17,6 +17,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgClaimUSDXMintingReward(ctx, k, msg)
 		case types.MsgClaimHardLiquidityProviderReward:
 			return handleMsgClaimHardLiquidityProviderReward(ctx, k, msg)
+		case types.MsgUnlockRewardsEarly:
+			return handleMsgUnlockRewardsEarly(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -44,3 +46,14 @@ func handleMsgClaimHardLiquidityProviderReward(ctx sdk.Context, k keeper.Keeper,
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgUnlockRewardsEarly(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlockRewardsEarly) (*sdk.Result, error) {
+
+	err := k.UnlockRewardsEarly(ctx, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
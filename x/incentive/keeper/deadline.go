@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// InitializeClaimDeadlineParams sets the claim deadline params to their default if they have not been set, such as on
+// chains that were started before claim deadlines were added
+func (k Keeper) InitializeClaimDeadlineParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyClaimDeadlines) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyClaimDeadlines, types.DefaultClaimDeadlines)
+}
+
+// ExpireUnclaimedRewards removes the rewards earned from each reward period whose claim deadline has passed from the
+// claims that have not claimed them. Expired rewards stay in the incentive module account to fund future rewards.
+func (k Keeper) ExpireUnclaimedRewards(ctx sdk.Context) {
+	for _, deadline := range k.GetParams(ctx).ClaimDeadlines {
+		if !deadline.HasPassed(ctx.BlockTime()) {
+			continue
+		}
+		var accruals types.RewardAccruals
+		k.IterateRewardPeriodAccruals(ctx, deadline.RewardType, deadline.CollateralType, func(ra types.RewardAccrual) bool {
+			accruals = append(accruals, ra)
+			return false
+		})
+		if len(accruals) == 0 {
+			continue
+		}
+
+		expired := sdk.NewCoins()
+		for _, ra := range accruals {
+			expired = expired.Add(k.removeAccruedReward(ctx, ra)...)
+			k.DeleteRewardAccrual(ctx, ra.RewardType, ra.CollateralType, ra.Owner)
+		}
+		k.addExpiredReward(ctx, deadline.RewardType, deadline.CollateralType, expired)
+	}
+}
+
+// accrueReward returns the part of a reward earned from a reward period that can be added to the owner's claim. Excluded
+// addresses do not earn rewards. Rewards from a reward period with a claim deadline are tracked until they are claimed,
+// and rewards earned after the deadline has passed expire immediately.
+func (k Keeper) accrueReward(ctx sdk.Context, owner sdk.AccAddress, rewardType, collateralType string, reward sdk.Coins) sdk.Coins {
+	if k.IsExcludedFromRewards(ctx, owner) {
+		return sdk.NewCoins()
+	}
+	deadline, found := k.GetParams(ctx).ClaimDeadlines.Get(rewardType, collateralType)
+	if !found || reward.Empty() {
+		return reward
+	}
+	if deadline.HasPassed(ctx.BlockTime()) {
+		k.addExpiredReward(ctx, rewardType, collateralType, reward)
+		return sdk.NewCoins()
+	}
+
+	accrual, found := k.GetRewardAccrual(ctx, rewardType, collateralType, owner)
+	if !found {
+		accrual = types.NewRewardAccrual(owner, rewardType, collateralType, sdk.NewCoins())
+	}
+	accrual.Amount = accrual.Amount.Add(reward...)
+	k.SetRewardAccrual(ctx, accrual)
+	return reward
+}
+
+// rewardExpired returns true if the claim deadline of a reward period has passed
+func (k Keeper) rewardExpired(ctx sdk.Context, rewardType, collateralType string) bool {
+	deadline, found := k.GetParams(ctx).ClaimDeadlines.Get(rewardType, collateralType)
+	return found && deadline.HasPassed(ctx.BlockTime())
+}
+
+// removeAccruedReward removes an accrued reward from its owner's claim and returns the amount removed
+func (k Keeper) removeAccruedReward(ctx sdk.Context, ra types.RewardAccrual) sdk.Coins {
+	switch types.ClaimTypeOfRewardType(ra.RewardType) {
+	case types.USDXMintingClaimType:
+		claim, found := k.GetUSDXMintingClaim(ctx, ra.Owner)
+		if !found {
+			return sdk.NewCoins()
+		}
+		amount := sdk.MinInt(claim.Reward.Amount, ra.Amount.AmountOf(claim.Reward.Denom))
+		claim.Reward = claim.Reward.Sub(sdk.NewCoin(claim.Reward.Denom, amount))
+		k.SetUSDXMintingClaim(ctx, claim)
+		return sdk.NewCoins(sdk.NewCoin(claim.Reward.Denom, amount))
+	case types.HardLiquidityProviderClaimType:
+		claim, found := k.GetHardLiquidityProviderClaim(ctx, ra.Owner)
+		if !found {
+			return sdk.NewCoins()
+		}
+		removed := minCoins(claim.Reward, ra.Amount)
+		claim.Reward = claim.Reward.Sub(removed)
+		k.SetHardLiquidityProviderClaim(ctx, claim)
+		return removed
+	}
+	return sdk.NewCoins()
+}
+
+// deleteRewardAccruals deletes an owner's accrued rewards from each reward period with a claim deadline whose rewards
+// are added to claims of the claim type, once the claim has been paid
+func (k Keeper) deleteRewardAccruals(ctx sdk.Context, owner sdk.AccAddress, claimType string) {
+	for _, deadline := range k.GetParams(ctx).ClaimDeadlines {
+		if types.ClaimTypeOfRewardType(deadline.RewardType) == claimType {
+			k.DeleteRewardAccrual(ctx, deadline.RewardType, deadline.CollateralType, owner)
+		}
+	}
+}
+
+func (k Keeper) addExpiredReward(ctx sdk.Context, rewardType, collateralType string, amount sdk.Coins) {
+	if amount.Empty() {
+		return
+	}
+	expired, found := k.GetExpiredReward(ctx, rewardType, collateralType)
+	if !found {
+		expired = types.NewExpiredReward(rewardType, collateralType, sdk.NewCoins())
+	}
+	expired.Amount = expired.Amount.Add(amount...)
+	k.SetExpiredReward(ctx, expired)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardsExpired,
+			sdk.NewAttribute(types.AttributeKeyRewardType, rewardType),
+			sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+			sdk.NewAttribute(types.AttributeKeyExpiredAmount, amount.String()),
+		),
+	)
+}
+
+// minCoins returns the smaller amount of each denom in a that is also in b
+func minCoins(a, b sdk.Coins) sdk.Coins {
+	min := sdk.NewCoins()
+	for _, coin := range a {
+		amount := sdk.MinInt(coin.Amount, b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			min = min.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return min
+}
+
+// GetRewardAccrual returns the rewards an owner has earned from a reward period with a claim deadline and not claimed
+func (k Keeper) GetRewardAccrual(ctx sdk.Context, rewardType, collateralType string, owner sdk.AccAddress) (types.RewardAccrual, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardAccrualKeyPrefix)
+	bz := store.Get(types.GetRewardAccrualKey(rewardType, collateralType, owner))
+	if bz == nil {
+		return types.RewardAccrual{}, false
+	}
+	var ra types.RewardAccrual
+	k.cdc.MustUnmarshalBinaryBare(bz, &ra)
+	return ra, true
+}
+
+// SetRewardAccrual sets a reward accrual in the store
+func (k Keeper) SetRewardAccrual(ctx sdk.Context, ra types.RewardAccrual) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardAccrualKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(ra)
+	store.Set(types.GetRewardAccrualKey(ra.RewardType, ra.CollateralType, ra.Owner), bz)
+}
+
+// DeleteRewardAccrual deletes the rewards an owner has earned from a reward period from the store
+func (k Keeper) DeleteRewardAccrual(ctx sdk.Context, rewardType, collateralType string, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardAccrualKeyPrefix)
+	store.Delete(types.GetRewardAccrualKey(rewardType, collateralType, owner))
+}
+
+// IterateRewardPeriodAccruals iterates over the reward accruals of a reward period
+func (k Keeper) IterateRewardPeriodAccruals(ctx sdk.Context, rewardType, collateralType string, cb func(ra types.RewardAccrual) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(append([]byte{}, types.RewardAccrualKeyPrefix...), types.GetRewardPeriodKey(rewardType, collateralType)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ra types.RewardAccrual
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &ra)
+		if cb(ra) {
+			break
+		}
+	}
+}
+
+// IterateRewardAccruals iterates over all reward accruals in the store
+func (k Keeper) IterateRewardAccruals(ctx sdk.Context, cb func(ra types.RewardAccrual) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardAccrualKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ra types.RewardAccrual
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &ra)
+		if cb(ra) {
+			break
+		}
+	}
+}
+
+// GetAllRewardAccruals returns all reward accruals in the store
+func (k Keeper) GetAllRewardAccruals(ctx sdk.Context) types.RewardAccruals {
+	accruals := types.RewardAccruals{}
+	k.IterateRewardAccruals(ctx, func(ra types.RewardAccrual) bool {
+		accruals = append(accruals, ra)
+		return false
+	})
+	return accruals
+}
+
+// GetExpiredReward returns the total rewards that expired from a reward period
+func (k Keeper) GetExpiredReward(ctx sdk.Context, rewardType, collateralType string) (types.ExpiredReward, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ExpiredRewardKeyPrefix)
+	bz := store.Get(types.GetRewardPeriodKey(rewardType, collateralType))
+	if bz == nil {
+		return types.ExpiredReward{}, false
+	}
+	var er types.ExpiredReward
+	k.cdc.MustUnmarshalBinaryBare(bz, &er)
+	return er, true
+}
+
+// SetExpiredReward sets the total rewards that expired from a reward period in the store
+func (k Keeper) SetExpiredReward(ctx sdk.Context, er types.ExpiredReward) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ExpiredRewardKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(er)
+	store.Set(types.GetRewardPeriodKey(er.RewardType, er.CollateralType), bz)
+}
+
+// GetAllExpiredRewards returns the total rewards that expired from each reward period
+func (k Keeper) GetAllExpiredRewards(ctx sdk.Context) types.ExpiredRewards {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ExpiredRewardKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	expired := types.ExpiredRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		var er types.ExpiredReward
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &er)
+		expired = append(expired, er)
+	}
+	return expired
+}
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+func (suite *KeeperTestSuite) TestClaimDeadlines() {
+	suite.SetupWithGenState()
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+	delegator := suite.addrs[0]
+
+	params := types.NewParams(
+		types.RewardPeriods{}, types.MultiRewardPeriods{}, types.MultiRewardPeriods{}, types.MultiRewardPeriods{},
+		types.Multipliers{types.NewMultiplier(types.Small, 1, d("0.25")), types.NewMultiplier(types.Large, 12, d("1.0"))},
+		initialTime.Add(time.Hour*24*365*5),
+	)
+	params.HardDelegatorRewardPeriods = types.MultiRewardPeriods{
+		types.NewMultiRewardPeriod(true, types.BondDenom, initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 1000), c("ukava", 500))),
+	}
+	params.ClaimDeadlines = types.ClaimDeadlines{
+		types.NewClaimDeadline(types.HardDelegatorRewardType, types.BondDenom, initialTime.Add(30*time.Second)),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, types.BondDenom, initialTime)
+	rewardPeriod := params.HardDelegatorRewardPeriods[0]
+
+	suite.Require().NoError(suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[0], c("ukava", 1_000_000)))
+	suite.Require().NoError(suite.deliverMsgDelegate(suite.ctx, delegator, suite.validatorAddrs[0], c("ukava", 1_000_000)))
+	staking.EndBlocker(suite.ctx, suite.stakingKeeper)
+
+	// rewards earned before the deadline are tracked until they are claimed
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(10 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateHardDelegatorRewards(suite.ctx, rewardPeriod))
+	suite.keeper.SynchronizeHardDelegatorRewards(suite.ctx, delegator)
+	accrual, found := suite.keeper.GetRewardAccrual(suite.ctx, types.HardDelegatorRewardType, types.BondDenom, delegator)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 2500)), accrual.Amount)
+
+	supplyKeeper := suite.app.GetSupplyKeeper()
+	suite.Require().NoError(supplyKeeper.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("hard", 1_000_000), c("ukava", 1_000_000))))
+	suite.Require().NoError(suite.keeper.ClaimHardReward(suite.ctx, delegator, types.Large))
+	_, found = suite.keeper.GetRewardAccrual(suite.ctx, types.HardDelegatorRewardType, types.BondDenom, delegator)
+	suite.Require().False(found)
+
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(20 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateHardDelegatorRewards(suite.ctx, rewardPeriod))
+	suite.keeper.SynchronizeHardDelegatorRewards(suite.ctx, delegator)
+
+	// nothing expires before the deadline
+	suite.keeper.ExpireUnclaimedRewards(suite.ctx)
+	claim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, delegator)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 2500)), claim.Reward)
+	suite.Require().Empty(suite.keeper.GetAllExpiredRewards(suite.ctx))
+
+	// unclaimed rewards are removed from the claim once the deadline passes
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(40 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateHardDelegatorRewards(suite.ctx, rewardPeriod))
+	suite.keeper.ExpireUnclaimedRewards(suite.ctx)
+	claim, _ = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, delegator)
+	suite.Require().True(claim.Reward.IsZero())
+	_, found = suite.keeper.GetRewardAccrual(suite.ctx, types.HardDelegatorRewardType, types.BondDenom, delegator)
+	suite.Require().False(found)
+	expired, found := suite.keeper.GetExpiredReward(suite.ctx, types.HardDelegatorRewardType, types.BondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 2500)), expired.Amount)
+
+	// rewards synchronized after the deadline expire immediately, and are not shown by simulated synchronization
+	suite.Require().True(suite.keeper.SimulateHardSynchronization(suite.ctx, claim).Reward.IsZero())
+	suite.keeper.SynchronizeHardDelegatorRewards(suite.ctx, delegator)
+	claim, _ = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, delegator)
+	suite.Require().True(claim.Reward.IsZero())
+	expired, _ = suite.keeper.GetExpiredReward(suite.ctx, types.HardDelegatorRewardType, types.BondDenom)
+	suite.Require().Equal(cs(c("hard", 15000), c("ukava", 7500)), expired.Amount)
+	suite.Require().Equal(types.ExpiredRewards{expired}, suite.keeper.GetAllExpiredRewards(suite.ctx))
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// InitializeExcludedAddressParams sets the excluded addresses param to its default if it has not been set, such as on
+// chains that were started before addresses could be excluded from rewards
+func (k Keeper) InitializeExcludedAddressParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyExcludedAddresses) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyExcludedAddresses, types.DefaultExcludedAddresses)
+}
+
+// IsExcludedFromRewards returns true if an address is excluded from earning rewards
+func (k Keeper) IsExcludedFromRewards(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, excluded := range k.GetParams(ctx).ExcludedAddresses {
+		if excluded.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// SynchronizeExcludedClaims synchronizes the claims of each excluded address, which moves their reward indexes up to
+// the global indexes without adding rewards. This keeps addresses that are removed from the excluded addresses from
+// earning the rewards of the time they were excluded.
+func (k Keeper) SynchronizeExcludedClaims(ctx sdk.Context) {
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		if claim, found := k.GetUSDXMintingClaim(ctx, addr); found {
+			if _, err := k.SynchronizeUSDXMintingClaim(ctx, claim); err != nil {
+				panic(err)
+			}
+		}
+		if _, found := k.GetHardLiquidityProviderClaim(ctx, addr); found {
+			k.SynchronizeHardLiquidityProviderClaim(ctx, addr)
+		}
+	}
+}
+
+// getExcludedUSDXMintingPrincipal returns the principal of the cdps of a collateral type owned by excluded addresses
+func (k Keeper) getExcludedUSDXMintingPrincipal(ctx sdk.Context, collateralType string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, addr, collateralType)
+		if found {
+			total = total.Add(cdp.GetTotalPrincipal().Amount)
+		}
+	}
+	return total
+}
+
+// getExcludedHardSupplied returns the amount of a denom supplied to hard by excluded addresses, including hard's
+// protocol owned liquidity, which never earns rewards
+func (k Keeper) getExcludedHardSupplied(ctx sdk.Context, denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	if deposit, found := k.hardKeeper.GetDeposit(ctx, hardtypes.ProtocolLiquidityAddress); found {
+		total = total.Add(deposit.Amount.AmountOf(denom))
+	}
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		if addr.Equals(hardtypes.ProtocolLiquidityAddress) {
+			continue
+		}
+		deposit, found := k.hardKeeper.GetDeposit(ctx, addr)
+		if found {
+			total = total.Add(deposit.Amount.AmountOf(denom))
+		}
+	}
+	return total
+}
+
+// getExcludedHardBorrowed returns the amount of a denom borrowed from hard by excluded addresses
+func (k Keeper) getExcludedHardBorrowed(ctx sdk.Context, denom string) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		borrow, found := k.hardKeeper.GetBorrow(ctx, addr)
+		if found {
+			total = total.Add(borrow.Amount.AmountOf(denom))
+		}
+	}
+	return total
+}
+
+// getExcludedBonded returns the tokens excluded addresses have delegated to bonded validators
+func (k Keeper) getExcludedBonded(ctx sdk.Context) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, addr := range k.GetParams(ctx).ExcludedAddresses {
+		total = total.Add(k.getTotalDelegated(ctx, addr))
+	}
+	return total
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *KeeperTestSuite) TestExcludedAddresses() {
+	suite.SetupWithGenState()
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+	excluded := suite.addrs[0]
+	delegator := suite.addrs[1]
+
+	params := types.NewParams(
+		types.RewardPeriods{}, types.MultiRewardPeriods{}, types.MultiRewardPeriods{}, types.MultiRewardPeriods{},
+		types.Multipliers{types.NewMultiplier(types.Small, 1, d("0.25")), types.NewMultiplier(types.Large, 12, d("1.0"))},
+		initialTime.Add(time.Hour*24*365*5),
+	)
+	params.HardDelegatorRewardPeriods = types.MultiRewardPeriods{
+		types.NewMultiRewardPeriod(true, types.BondDenom, initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 1000), c("ukava", 500))),
+	}
+	params.ExcludedAddresses = []sdk.AccAddress{excluded}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, types.BondDenom, initialTime)
+	rewardPeriod := params.HardDelegatorRewardPeriods[0]
+
+	suite.Require().NoError(suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[0], c("ukava", 1_000_000)))
+	suite.Require().NoError(suite.deliverMsgDelegate(suite.ctx, excluded, suite.validatorAddrs[0], c("ukava", 1_000_000)))
+	suite.Require().NoError(suite.deliverMsgDelegate(suite.ctx, delegator, suite.validatorAddrs[0], c("ukava", 1_000_000)))
+	staking.EndBlocker(suite.ctx, suite.stakingKeeper)
+
+	// rewards are shared between the 2 million bonded tokens not delegated by the excluded address
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(10 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateHardDelegatorRewards(suite.ctx, rewardPeriod))
+	suite.keeper.SynchronizeExcludedClaims(suite.ctx)
+	suite.keeper.SynchronizeHardDelegatorRewards(suite.ctx, delegator)
+	claim, _ := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, delegator)
+	suite.Require().Equal(cs(c("hard", 5000), c("ukava", 2500)), claim.Reward)
+
+	excludedClaim, found := suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, excluded)
+	suite.Require().True(found)
+	suite.Require().True(excludedClaim.Reward.IsZero())
+	suite.Require().True(suite.keeper.SimulateHardSynchronization(suite.ctx, excludedClaim).Reward.IsZero())
+
+	// once the address is no longer excluded it only earns rewards from then on, shared between all 3 million tokens
+	params.ExcludedAddresses = []sdk.AccAddress{}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(20 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateHardDelegatorRewards(suite.ctx, rewardPeriod))
+	suite.keeper.SynchronizeHardDelegatorRewards(suite.ctx, excluded)
+	excludedClaim, _ = suite.keeper.GetHardLiquidityProviderClaim(suite.ctx, excluded)
+	suite.Require().Equal(cs(c("hard", 3333), c("ukava", 1667)), excludedClaim.Reward)
+}
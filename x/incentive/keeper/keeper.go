@@ -228,21 +228,23 @@ func (k Keeper) GetHardBorrowRewardIndexes(ctx sdk.Context, denom string) (types
 	return rewardIndexes, true
 }
 
-// GetHardDelegatorRewardFactor returns the current reward factor for an individual collateral type
-func (k Keeper) GetHardDelegatorRewardFactor(ctx sdk.Context, ctype string) (factor sdk.Dec, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardDelegatorRewardFactorKeyPrefix)
+// SetHardDelegatorRewardIndexes sets the current reward indexes for an individual collateral type
+func (k Keeper) SetHardDelegatorRewardIndexes(ctx sdk.Context, ctype string, indexes types.RewardIndexes) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HardDelegatorRewardIndexesKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(indexes)
+	store.Set([]byte(ctype), bz)
+}
+
+// GetHardDelegatorRewardIndexes gets the current reward indexes for an individual collateral type
+func (k Keeper) GetHardDelegatorRewardIndexes(ctx sdk.Context, ctype string) (types.RewardIndexes, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HardDelegatorRewardIndexesKeyPrefix)
 	bz := store.Get([]byte(ctype))
 	if bz == nil {
-		return sdk.ZeroDec(), false
+		return types.RewardIndexes{}, false
 	}
-	k.cdc.MustUnmarshalBinaryBare(bz, &factor)
-	return factor, true
-}
-
-// SetHardDelegatorRewardFactor sets the current reward factor for an individual collateral type
-func (k Keeper) SetHardDelegatorRewardFactor(ctx sdk.Context, ctype string, factor sdk.Dec) {
-	store := prefix.NewStore(ctx.KVStore(k.key), types.HardDelegatorRewardFactorKeyPrefix)
-	store.Set([]byte(ctype), k.cdc.MustMarshalBinaryBare(factor))
+	var rewardIndexes types.RewardIndexes
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewardIndexes)
+	return rewardIndexes, true
 }
 
 // GetPreviousHardSupplyRewardAccrualTime returns the last time a denom accrued Hard protocol supply-side rewards
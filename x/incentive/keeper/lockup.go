@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// InitializeEarlyUnlockPenaltyParams sets the early unlock penalty param to its default if it has not been set, such
+// as on chains that were started before early unlocks were added
+func (k Keeper) InitializeEarlyUnlockPenaltyParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyEarlyUnlockPenalty) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyEarlyUnlockPenalty, types.DefaultEarlyUnlockPenalty)
+}
+
+// UnlockRewardsEarly makes all rewards still locked in the owner's account spendable, less the early unlock penalty.
+// The penalty is taken from the owner's account and either burned or returned to the incentive module account.
+// Only rewards claimed through the incentive module can be unlocked, other vesting coins are unchanged.
+func (k Keeper) UnlockRewardsEarly(ctx sdk.Context, owner sdk.AccAddress) error {
+	penaltyParams := k.GetParams(ctx).EarlyUnlockPenalty
+	if !penaltyParams.Active {
+		return types.ErrEarlyUnlockDisabled
+	}
+
+	var ownerLockups, lockups types.RewardLockups
+	k.IterateRewardLockupsByOwner(ctx, owner, func(rl types.RewardLockup) bool {
+		ownerLockups = append(ownerLockups, rl)
+		if rl.EndTime.After(ctx.BlockTime()) {
+			lockups = append(lockups, rl)
+		}
+		return false
+	})
+	if len(lockups) == 0 {
+		return sdkerrors.Wrapf(types.ErrNoLockedRewards, "address: %s", owner)
+	}
+
+	acc := k.accountKeeper.GetAccount(ctx, owner)
+	vacc, ok := acc.(*vesting.PeriodicVestingAccount)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrInvalidAccountType, "%T", acc)
+	}
+	for _, rl := range lockups {
+		if err := removeCoinsFromVestingSchedule(vacc, rl.Amount, rl.EndTime.Unix()); err != nil {
+			return err
+		}
+	}
+	unlocked := lockups.Total()
+	releaseDelegatedVesting(vacc, ctx.BlockTime())
+	k.accountKeeper.SetAccount(ctx, vacc)
+
+	penalty := penaltyParams.Penalty(unlocked)
+	if !penalty.IsZero() {
+		if penaltyParams.Burn {
+			if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, penalty); err != nil {
+				return err
+			}
+			if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleName, penalty); err != nil {
+				return err
+			}
+		} else {
+			if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, owner, types.IncentiveMacc, penalty); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rl := range ownerLockups {
+		k.DeleteRewardLockup(ctx, rl.Owner, rl.EndTime)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnlockRewardsEarly,
+			sdk.NewAttribute(types.AttributeKeyUnlockedBy, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyUnlockAmount, unlocked.String()),
+			sdk.NewAttribute(types.AttributeKeyPenaltyAmount, penalty.String()),
+			sdk.NewAttribute(types.AttributeKeyPenaltyBurned, strconv.FormatBool(penaltyParams.Burn)),
+		),
+	)
+	return nil
+}
+
+// removeCoinsFromVestingSchedule removes coins from the vesting period of the account that ends at the end time
+func removeCoinsFromVestingSchedule(vacc *vesting.PeriodicVestingAccount, amt sdk.Coins, endTime int64) error {
+	periodEnd := vacc.StartTime
+	for i, period := range vacc.VestingPeriods {
+		periodEnd += period.Length
+		if periodEnd != endTime {
+			continue
+		}
+		newAmount, isNegative := period.Amount.SafeSub(amt)
+		if isNegative {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingSchedule, "period ending at %d has %s, expected at least %s", endTime, period.Amount, amt)
+		}
+		newOriginalVesting, isNegative := vacc.OriginalVesting.SafeSub(amt)
+		if isNegative {
+			return sdkerrors.Wrapf(types.ErrInvalidVestingSchedule, "original vesting %s is less than %s", vacc.OriginalVesting, amt)
+		}
+		vacc.VestingPeriods[i].Amount = newAmount
+		vacc.OriginalVesting = newOriginalVesting
+		return nil
+	}
+	return sdkerrors.Wrapf(types.ErrInvalidVestingSchedule, "no vesting period ends at %d", endTime)
+}
+
+// releaseDelegatedVesting moves delegated coins that are no longer vesting from delegated vesting to delegated free
+func releaseDelegatedVesting(vacc *vesting.PeriodicVestingAccount, blockTime time.Time) {
+	vestingCoins := vacc.GetVestingCoins(blockTime)
+	for _, coin := range vacc.DelegatedVesting {
+		excess := coin.Amount.Sub(vestingCoins.AmountOf(coin.Denom))
+		if !excess.IsPositive() {
+			continue
+		}
+		released := sdk.NewCoins(sdk.NewCoin(coin.Denom, excess))
+		vacc.DelegatedVesting = vacc.DelegatedVesting.Sub(released)
+		vacc.DelegatedFree = vacc.DelegatedFree.Add(released...)
+	}
+}
+
+// addRewardLockup records rewards sent to an account that are vesting until the end time, and removes the
+// owner's lockups that have already ended
+func (k Keeper) addRewardLockup(ctx sdk.Context, owner sdk.AccAddress, amt sdk.Coins, endTime time.Time) {
+	var ended types.RewardLockups
+	k.IterateRewardLockupsByOwner(ctx, owner, func(rl types.RewardLockup) bool {
+		if !rl.EndTime.After(ctx.BlockTime()) {
+			ended = append(ended, rl)
+		}
+		return false
+	})
+	for _, rl := range ended {
+		k.DeleteRewardLockup(ctx, rl.Owner, rl.EndTime)
+	}
+	lockup, found := k.GetRewardLockup(ctx, owner, endTime)
+	if !found {
+		lockup = types.NewRewardLockup(owner, sdk.NewCoins(), endTime)
+	}
+	lockup.Amount = lockup.Amount.Add(amt...)
+	k.SetRewardLockup(ctx, lockup)
+}
+
+// GetRewardLockup returns the reward lockup of the owner ending at the end time
+func (k Keeper) GetRewardLockup(ctx sdk.Context, owner sdk.AccAddress, endTime time.Time) (types.RewardLockup, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardLockupKeyPrefix)
+	bz := store.Get(types.GetRewardLockupKey(owner, endTime))
+	if bz == nil {
+		return types.RewardLockup{}, false
+	}
+	var rl types.RewardLockup
+	k.cdc.MustUnmarshalBinaryBare(bz, &rl)
+	return rl, true
+}
+
+// SetRewardLockup sets a reward lockup in the store
+func (k Keeper) SetRewardLockup(ctx sdk.Context, rl types.RewardLockup) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardLockupKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(rl)
+	store.Set(types.GetRewardLockupKey(rl.Owner, rl.EndTime), bz)
+}
+
+// DeleteRewardLockup deletes the reward lockup of the owner ending at the end time
+func (k Keeper) DeleteRewardLockup(ctx sdk.Context, owner sdk.AccAddress, endTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardLockupKeyPrefix)
+	store.Delete(types.GetRewardLockupKey(owner, endTime))
+}
+
+// IterateRewardLockupsByOwner iterates over the reward lockups of an owner in order of end time
+func (k Keeper) IterateRewardLockupsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(rl types.RewardLockup) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(append([]byte{}, types.RewardLockupKeyPrefix...), owner.Bytes()...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rl types.RewardLockup
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rl)
+		if cb(rl) {
+			break
+		}
+	}
+}
+
+// IterateRewardLockups iterates over all reward lockups in the store
+func (k Keeper) IterateRewardLockups(ctx sdk.Context, cb func(rl types.RewardLockup) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RewardLockupKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rl types.RewardLockup
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rl)
+		if cb(rl) {
+			break
+		}
+	}
+}
+
+// GetAllRewardLockups returns all reward lockups in the store
+func (k Keeper) GetAllRewardLockups(ctx sdk.Context) types.RewardLockups {
+	lockups := types.RewardLockups{}
+	k.IterateRewardLockups(ctx, func(rl types.RewardLockup) bool {
+		lockups = append(lockups, rl)
+		return false
+	})
+	return lockups
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+func (suite *KeeperTestSuite) TestUnlockRewardsEarly() {
+	setup := func(penalty types.EarlyUnlockPenalty) {
+		suite.SetupWithAccountState()
+		params := suite.keeper.GetParams(suite.ctx)
+		params.EarlyUnlockPenalty = penalty
+		suite.keeper.SetParams(suite.ctx, params)
+
+		// addrs[0] has 400 ukava vesting from genesis, and is sent 100 ukava of rewards locked for 5 seconds
+		err := suite.keeper.SendTimeLockedCoinsToAccount(suite.ctx, kavadist.ModuleName, suite.addrs[0], cs(c("ukava", 100)), 5)
+		suite.Require().NoError(err)
+	}
+
+	suite.Run("unlocks rewards and burns the penalty", func() {
+		setup(types.NewEarlyUnlockPenalty(true, d("0.2"), true))
+		supplyBefore := suite.app.GetSupplyKeeper().GetSupply(suite.ctx).GetTotal()
+
+		err := suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[0])
+		suite.Require().NoError(err)
+
+		vacc := suite.getAccount(suite.addrs[0]).(*vesting.PeriodicVestingAccount)
+		suite.Require().Equal(cs(c("ukava", 480)), vacc.Coins)
+		suite.Require().Equal(cs(c("ukava", 400)), vacc.OriginalVesting)
+		suite.Require().Equal(cs(c("ukava", 400)), vacc.GetVestingCoins(suite.ctx.BlockTime()))
+		suite.Require().Equal(cs(c("ukava", 80)), vacc.SpendableCoins(suite.ctx.BlockTime()))
+		suite.Require().Equal(supplyBefore.Sub(cs(c("ukava", 20))), suite.app.GetSupplyKeeper().GetSupply(suite.ctx).GetTotal())
+
+		_, found := suite.keeper.GetRewardLockup(suite.ctx, suite.addrs[0], suite.ctx.BlockTime().Add(5*time.Second))
+		suite.Require().False(found)
+
+		// the rewards can only be unlocked once
+		err = suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[0])
+		suite.Require().True(errors.Is(err, types.ErrNoLockedRewards))
+	})
+
+	suite.Run("returns the penalty to the incentive module account", func() {
+		setup(types.NewEarlyUnlockPenalty(true, d("0.5"), false))
+
+		err := suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[0])
+		suite.Require().NoError(err)
+
+		suite.Require().Equal(cs(c("ukava", 450)), suite.getAccount(suite.addrs[0]).GetCoins())
+		suite.Require().Equal(cs(c("ukava", 550)), suite.getModuleAccount(kavadist.ModuleName).GetCoins())
+	})
+
+	suite.Run("inactive", func() {
+		setup(types.NewEarlyUnlockPenalty(false, d("0.5"), true))
+
+		err := suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[0])
+		suite.Require().True(errors.Is(err, types.ErrEarlyUnlockDisabled))
+	})
+
+	suite.Run("rewards that have vested are not unlocked", func() {
+		setup(types.NewEarlyUnlockPenalty(true, d("0.5"), true))
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(5 * time.Second))
+
+		err := suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[0])
+		suite.Require().True(errors.Is(err, types.ErrNoLockedRewards))
+		suite.Require().Equal(cs(c("ukava", 500)), suite.getAccount(suite.addrs[0]).GetCoins())
+	})
+
+	suite.Run("genesis vesting is not unlocked", func() {
+		setup(types.NewEarlyUnlockPenalty(true, d("0.5"), true))
+
+		err := suite.keeper.UnlockRewardsEarly(suite.ctx, suite.addrs[1])
+		suite.Require().True(errors.Is(err, types.ErrNoLockedRewards))
+	})
+}
+
+func (suite *KeeperTestSuite) TestRewardLockupsAreMerged() {
+	suite.SetupWithAccountState()
+
+	suite.Require().NoError(suite.keeper.SendTimeLockedCoinsToAccount(suite.ctx, kavadist.ModuleName, suite.addrs[1], cs(c("ukava", 100)), 5))
+	suite.Require().NoError(suite.keeper.SendTimeLockedCoinsToAccount(suite.ctx, kavadist.ModuleName, suite.addrs[1], cs(c("ukava", 50)), 5))
+	suite.Require().NoError(suite.keeper.SendTimeLockedCoinsToAccount(suite.ctx, kavadist.ModuleName, suite.addrs[1], cs(c("ukava", 10)), 0))
+
+	lockups := suite.keeper.GetAllRewardLockups(suite.ctx)
+	suite.Require().Len(lockups, 1)
+	suite.Require().Equal(cs(c("ukava", 150)), lockups[0].Amount)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(5*time.Second).Unix(), lockups[0].EndTime.Unix())
+	suite.Require().Equal(suite.addrs[1], lockups[0].Owner)
+}
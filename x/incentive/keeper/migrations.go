@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// legacyHardLiquidityProviderClaim is a hard liquidity provider claim from before hard delegator rewards could be paid
+// in more than one denom, when the claim stored a single delegator reward factor for each collateral type
+type legacyHardLiquidityProviderClaim struct {
+	types.BaseMultiClaim   `json:"base_claim" yaml:"base_claim"`
+	SupplyRewardIndexes    types.MultiRewardIndexes `json:"supply_reward_indexes" yaml:"supply_reward_indexes"`
+	BorrowRewardIndexes    types.MultiRewardIndexes `json:"borrow_reward_indexes" yaml:"borrow_reward_indexes"`
+	DelegatorRewardIndexes types.RewardIndexes      `json:"delegator_reward_indexes" yaml:"delegator_reward_indexes"`
+}
+
+// legacyClaimCdc decodes legacy claims, which are stored with the prefix bytes of the registered claim type
+var legacyClaimCdc = func() *codec.Codec {
+	cdc := codec.New()
+	cdc.RegisterConcrete(legacyHardLiquidityProviderClaim{}, "incentive/HardLiquidityProviderClaim", nil)
+	return cdc.Seal()
+}()
+
+// MigrateMultiDenomHardDelegatorRewards converts the hard delegator reward periods, the global hard delegator reward
+// factors and the delegator reward indexes of each hard liquidity provider claim from a single reward denom to
+// multiple reward denoms. Rewards accumulated before the migration were paid in the hard liquidity reward denom, so
+// existing reward factors become reward indexes of that denom.
+func (k Keeper) MigrateMultiDenomHardDelegatorRewards(ctx sdk.Context) error {
+	if err := k.migrateHardDelegatorRewardPeriods(ctx); err != nil {
+		return err
+	}
+	if err := k.migrateHardDelegatorRewardFactors(ctx); err != nil {
+		return err
+	}
+	return k.migrateHardLiquidityProviderClaims(ctx)
+}
+
+func (k Keeper) migrateHardDelegatorRewardPeriods(ctx sdk.Context) error {
+	bz := k.paramSubspace.GetRaw(ctx, types.KeyHardDelegatorRewardPeriods)
+	if bz == nil {
+		k.paramSubspace.Set(ctx, types.KeyHardDelegatorRewardPeriods, types.DefaultMultiRewardPeriods)
+		return nil
+	}
+	var rewardPeriods types.RewardPeriods
+	if err := k.cdc.UnmarshalJSON(bz, &rewardPeriods); err != nil {
+		return fmt.Errorf("failed to decode single denom hard delegator reward periods: %w", err)
+	}
+
+	multiRewardPeriods := types.MultiRewardPeriods{}
+	for _, rp := range rewardPeriods {
+		multiRewardPeriods = append(multiRewardPeriods, types.NewMultiRewardPeriod(
+			rp.Active, rp.CollateralType, rp.Start, rp.End, sdk.NewCoins(rp.RewardsPerSecond),
+		))
+	}
+	if err := multiRewardPeriods.Validate(); err != nil {
+		return err
+	}
+	k.paramSubspace.Set(ctx, types.KeyHardDelegatorRewardPeriods, multiRewardPeriods)
+	return nil
+}
+
+func (k Keeper) migrateHardDelegatorRewardFactors(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HardDelegatorRewardIndexesKeyPrefix)
+
+	// collect before writing so the store is not modified while iterating
+	var collateralTypes []string
+	var factors []sdk.Dec
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var factor sdk.Dec
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &factor); err != nil {
+			iterator.Close()
+			return fmt.Errorf("failed to decode hard delegator reward factor: %w", err)
+		}
+		collateralTypes = append(collateralTypes, string(iterator.Key()))
+		factors = append(factors, factor)
+	}
+	iterator.Close()
+
+	for i, collateralType := range collateralTypes {
+		rewardIndexes := types.RewardIndexes{types.NewRewardIndex(types.HardLiquidityRewardDenom, factors[i])}
+		k.SetHardDelegatorRewardIndexes(ctx, collateralType, rewardIndexes)
+	}
+	return nil
+}
+
+func (k Keeper) migrateHardLiquidityProviderClaims(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
+
+	var claims types.HardLiquidityProviderClaims
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var legacyClaim legacyHardLiquidityProviderClaim
+		if err := legacyClaimCdc.UnmarshalBinaryBare(iterator.Value(), &legacyClaim); err != nil {
+			iterator.Close()
+			return fmt.Errorf("failed to decode single denom hard liquidity provider claim: %w", err)
+		}
+
+		delegatorRewardIndexes := types.MultiRewardIndexes{}
+		for _, ri := range legacyClaim.DelegatorRewardIndexes {
+			delegatorRewardIndexes = append(delegatorRewardIndexes, types.NewMultiRewardIndex(
+				ri.CollateralType, types.RewardIndexes{types.NewRewardIndex(types.HardLiquidityRewardDenom, ri.RewardFactor)},
+			))
+		}
+		claims = append(claims, types.NewHardLiquidityProviderClaim(legacyClaim.Owner, legacyClaim.Reward,
+			legacyClaim.SupplyRewardIndexes, legacyClaim.BorrowRewardIndexes, delegatorRewardIndexes))
+	}
+	iterator.Close()
+
+	for _, claim := range claims {
+		k.SetHardLiquidityProviderClaim(ctx, claim)
+	}
+	return nil
+}
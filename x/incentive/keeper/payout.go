@@ -149,16 +149,23 @@ func (k Keeper) SendTimeLockedCoinsToAccount(ctx sdk.Context, senderModule strin
 		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
 	}
 
+	var err error
 	switch acc.(type) {
 	case *validatorvesting.ValidatorVestingAccount, supplyExported.ModuleAccountI:
 		return sdkerrors.Wrapf(types.ErrInvalidAccountType, "%T", acc)
 	case *vesting.PeriodicVestingAccount:
-		return k.SendTimeLockedCoinsToPeriodicVestingAccount(ctx, senderModule, recipientAddr, amt, length)
+		err = k.SendTimeLockedCoinsToPeriodicVestingAccount(ctx, senderModule, recipientAddr, amt, length)
 	case *auth.BaseAccount:
-		return k.SendTimeLockedCoinsToBaseAccount(ctx, senderModule, recipientAddr, amt, length)
+		err = k.SendTimeLockedCoinsToBaseAccount(ctx, senderModule, recipientAddr, amt, length)
 	default:
 		return sdkerrors.Wrapf(types.ErrInvalidAccountType, "%T", acc)
 	}
+	if err != nil {
+		return err
+	}
+	// record the lockup so the rewards can be unlocked early
+	k.addRewardLockup(ctx, recipientAddr, amt, time.Unix(ctx.BlockTime().Unix()+length, 0).UTC())
+	return nil
 }
 
 // SendTimeLockedCoinsToPeriodicVestingAccount sends time-locked coins from the input module account to the recipient
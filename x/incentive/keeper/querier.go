@@ -21,6 +21,8 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetHardRewards(ctx, req, k)
 		case types.QueryGetUSDXMintingRewards:
 			return queryGetUSDXMintingRewards(ctx, req, k)
+		case types.QueryGetExpiredRewards:
+			return queryGetExpiredRewards(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -40,6 +42,17 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 	return bz, nil
 }
 
+// query the total rewards that expired from each reward period with a claim deadline
+func queryGetExpiredRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	expired := k.GetAllExpiredRewards(ctx)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, expired)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryGetHardRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryHardRewardsParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
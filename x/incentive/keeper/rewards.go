@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
@@ -23,12 +24,14 @@ func (k Keeper) AccumulateUSDXMintingRewards(ctx sdk.Context, rewardPeriod types
 	if timeElapsed.IsZero() {
 		return nil
 	}
-	if rewardPeriod.RewardsPerSecond.Amount.IsZero() {
+	if !rewardPeriod.Active || rewardPeriod.RewardsPerSecond.Amount.IsZero() {
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
-	totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rewardPeriod.CollateralType, types.PrincipalDenom).ToDec()
-	if totalPrincipal.IsZero() {
+	// rewards are shared between cdps not owned by excluded addresses
+	totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rewardPeriod.CollateralType, types.PrincipalDenom).
+		Sub(k.getExcludedUSDXMintingPrincipal(ctx, rewardPeriod.CollateralType)).ToDec()
+	if !totalPrincipal.IsPositive() {
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -61,7 +64,7 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 	if timeElapsed.IsZero() {
 		return nil
 	}
-	if rewardPeriod.RewardsPerSecond.IsZero() {
+	if !rewardPeriod.Active || rewardPeriod.RewardsPerSecond.IsZero() {
 		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -72,8 +75,9 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 		return nil
 	}
 
-	totalBorrowed := totalBorrowedCoins.AmountOf(rewardPeriod.CollateralType).ToDec()
-	if totalBorrowed.IsZero() {
+	totalBorrowed := totalBorrowedCoins.AmountOf(rewardPeriod.CollateralType).
+		Sub(k.getExcludedHardBorrowed(ctx, rewardPeriod.CollateralType)).ToDec()
+	if !totalBorrowed.IsPositive() {
 		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -127,7 +131,7 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 	if timeElapsed.IsZero() {
 		return nil
 	}
-	if rewardPeriod.RewardsPerSecond.IsZero() {
+	if !rewardPeriod.Active || rewardPeriod.RewardsPerSecond.IsZero() {
 		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -138,8 +142,9 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 		return nil
 	}
 
-	totalSupplied := totalSuppliedCoins.AmountOf(rewardPeriod.CollateralType).ToDec()
-	if totalSupplied.IsZero() {
+	totalSupplied := totalSuppliedCoins.AmountOf(rewardPeriod.CollateralType).
+		Sub(k.getExcludedHardSupplied(ctx, rewardPeriod.CollateralType)).ToDec()
+	if !totalSupplied.IsPositive() {
 		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -251,7 +256,8 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 		return
 	}
 	newRewardsCoin := sdk.NewCoin(types.USDXMintingRewardDenom, newRewardsAmount)
-	claim.Reward = claim.Reward.Add(newRewardsCoin)
+	accrued := k.accrueReward(ctx, cdp.Owner, types.USDXMintingRewardType, cdp.Type, sdk.NewCoins(newRewardsCoin))
+	claim.Reward = claim.Reward.Add(sdk.NewCoin(types.USDXMintingRewardDenom, accrued.AmountOf(types.USDXMintingRewardDenom)))
 	k.SetUSDXMintingClaim(ctx, claim)
 	return
 }
@@ -331,7 +337,7 @@ func (k Keeper) SynchronizeHardSupplyReward(ctx sdk.Context, deposit hardtypes.D
 			}
 			claim.SupplyRewardIndexes[userRewardIndexIndex].RewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
 			newRewardsCoin := sdk.NewCoin(userRewardIndex.CollateralType, newRewardsAmount)
-			claim.Reward = claim.Reward.Add(newRewardsCoin)
+			claim.Reward = claim.Reward.Add(k.accrueReward(ctx, deposit.Depositor, types.HardSupplyRewardType, coin.Denom, sdk.NewCoins(newRewardsCoin))...)
 		}
 	}
 	k.SetHardLiquidityProviderClaim(ctx, claim)
@@ -408,7 +414,7 @@ func (k Keeper) SynchronizeHardBorrowReward(ctx sdk.Context, borrow hardtypes.Bo
 			}
 			claim.BorrowRewardIndexes[userRewardIndexIndex].RewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
 			newRewardsCoin := sdk.NewCoin(userRewardIndex.CollateralType, newRewardsAmount)
-			claim.Reward = claim.Reward.Add(newRewardsCoin)
+			claim.Reward = claim.Reward.Add(k.accrueReward(ctx, borrow.Borrower, types.HardBorrowRewardType, coin.Denom, sdk.NewCoins(newRewardsCoin))...)
 		}
 	}
 	k.SetHardLiquidityProviderClaim(ctx, claim)
@@ -473,63 +479,13 @@ func (k Keeper) SynchronizeHardDelegatorRewards(ctx sdk.Context, delegator sdk.A
 		return
 	}
 
-	delagatorFactor, found := k.GetHardDelegatorRewardFactor(ctx, types.BondDenom)
-	if !found {
-		return
-	}
-
-	delegatorIndex, hasDelegatorRewardIndex := claim.HasDelegatorRewardIndex(types.BondDenom)
-	if !hasDelegatorRewardIndex {
-		return
-	}
-
-	userRewardFactor := claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor
-	rewardsAccumulatedFactor := delagatorFactor.Sub(userRewardFactor)
-	if rewardsAccumulatedFactor.IsZero() {
-		return
-	}
-	claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor = delagatorFactor
-
-	totalDelegated := sdk.ZeroDec()
-
-	// TODO: set reasonable max limit on delegation iteration
-	maxUInt := ^uint16(0)
-	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, maxUInt)
-	for _, delegation := range delegations {
-		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
-		if !found {
-			continue
-		}
-
-		// Delegators don't accumulate rewards if their validator is unbonded/slashed
-		if validator.GetStatus() != sdk.Bonded {
-			continue
-		}
-
-		if validator.GetTokens().IsZero() {
-			continue
-		}
-
-		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
-		if delegatedTokens.IsZero() || delegatedTokens.IsNegative() {
-			continue
-		}
-		totalDelegated = totalDelegated.Add(delegatedTokens)
-	}
-
-	rewardsEarned := rewardsAccumulatedFactor.Mul(totalDelegated).RoundInt()
-	if rewardsEarned.IsZero() || rewardsEarned.IsNegative() {
-		return
-	}
-
-	// Add rewards to delegator's hard claim
-	newRewardsCoin := sdk.NewCoin(types.HardLiquidityRewardDenom, rewardsEarned)
-	claim.Reward = claim.Reward.Add(newRewardsCoin)
+	claim, rewardsEarned := k.synchronizeHardDelegatorRewardIndexes(ctx, claim)
+	claim.Reward = claim.Reward.Add(k.accrueReward(ctx, delegator, types.HardDelegatorRewardType, types.BondDenom, rewardsEarned)...)
 	k.SetHardLiquidityProviderClaim(ctx, claim)
 }
 
 // AccumulateHardDelegatorRewards updates the rewards accumulated for the input reward period
-func (k Keeper) AccumulateHardDelegatorRewards(ctx sdk.Context, rewardPeriod types.RewardPeriod) error {
+func (k Keeper) AccumulateHardDelegatorRewards(ctx sdk.Context, rewardPeriod types.MultiRewardPeriod) error {
 	previousAccrualTime, found := k.GetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType)
 	if !found {
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
@@ -539,38 +495,38 @@ func (k Keeper) AccumulateHardDelegatorRewards(ctx sdk.Context, rewardPeriod typ
 	if timeElapsed.IsZero() {
 		return nil
 	}
-	if rewardPeriod.RewardsPerSecond.Amount.IsZero() {
+	if !rewardPeriod.Active || rewardPeriod.RewardsPerSecond.IsZero() {
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
 
-	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx).ToDec()
-	if totalBonded.IsZero() {
+	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx).ToDec().Sub(k.getExcludedBonded(ctx))
+	if !totalBonded.IsPositive() {
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
 
-	newRewards := timeElapsed.Mul(rewardPeriod.RewardsPerSecond.Amount)
-	rewardFactor := newRewards.ToDec().Quo(totalBonded)
+	rewardIndexes, _ := k.GetHardDelegatorRewardIndexes(ctx, rewardPeriod.CollateralType)
+	for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
+		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
+		rewardFactor := newRewards.Quo(totalBonded)
 
-	previousRewardFactor, found := k.GetHardDelegatorRewardFactor(ctx, rewardPeriod.CollateralType)
-	if !found {
-		previousRewardFactor = sdk.ZeroDec()
+		i, found := rewardIndexes.GetFactorIndex(rewardCoin.Denom)
+		if found {
+			rewardIndexes[i].RewardFactor = rewardIndexes[i].RewardFactor.Add(rewardFactor)
+		} else {
+			rewardIndexes = append(rewardIndexes, types.NewRewardIndex(rewardCoin.Denom, rewardFactor))
+		}
 	}
-	newRewardFactor := previousRewardFactor.Add(rewardFactor)
-	k.SetHardDelegatorRewardFactor(ctx, rewardPeriod.CollateralType, newRewardFactor)
+	k.SetHardDelegatorRewardIndexes(ctx, rewardPeriod.CollateralType, rewardIndexes)
 	k.SetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 	return nil
 }
 
-// InitializeHardDelegatorReward initializes the delegator reward index of a hard claim
+// InitializeHardDelegatorReward initializes the delegator reward indexes of a hard claim. Rewards already earned by the
+// delegator's existing delegations are synchronized first, so they are not lost when the indexes are reset.
 func (k Keeper) InitializeHardDelegatorReward(ctx sdk.Context, delegator sdk.AccAddress) {
-	delegatorFactor, foundDelegatorFactor := k.GetHardDelegatorRewardFactor(ctx, types.BondDenom)
-	if !foundDelegatorFactor { // Should always be found...
-		delegatorFactor = sdk.ZeroDec()
-	}
-
-	delegatorRewardIndexes := types.NewRewardIndex(types.BondDenom, delegatorFactor)
+	k.SynchronizeHardDelegatorRewards(ctx, delegator)
 
 	claim, found := k.GetHardLiquidityProviderClaim(ctx, delegator)
 	if !found {
@@ -578,14 +534,85 @@ func (k Keeper) InitializeHardDelegatorReward(ctx sdk.Context, delegator sdk.Acc
 		claim = types.NewHardLiquidityProviderClaim(delegator, sdk.Coins{}, nil, nil, nil)
 	}
 
-	claim.DelegatorRewardIndexes = types.RewardIndexes{delegatorRewardIndexes}
+	globalRewardIndexes, _ := k.GetHardDelegatorRewardIndexes(ctx, types.BondDenom)
+	claim.DelegatorRewardIndexes = types.MultiRewardIndexes{types.NewMultiRewardIndex(types.BondDenom, globalRewardIndexes)}
 	k.SetHardLiquidityProviderClaim(ctx, claim)
 }
 
+// synchronizeHardDelegatorRewardIndexes returns the claim with its delegator reward indexes set to the global indexes,
+// and the rewards the delegator earned since the indexes were last updated. Reward denoms missing from the claim were
+// added to the reward period after it was last updated, so the delegator has earned all of their accumulated rewards.
+func (k Keeper) synchronizeHardDelegatorRewardIndexes(ctx sdk.Context, claim types.HardLiquidityProviderClaim) (types.HardLiquidityProviderClaim, sdk.Coins) {
+	rewardsEarned := sdk.NewCoins()
+	globalRewardIndexes, found := k.GetHardDelegatorRewardIndexes(ctx, types.BondDenom)
+	if !found {
+		return claim, rewardsEarned
+	}
+	delegatorIndex, hasDelegatorRewardIndex := claim.HasDelegatorRewardIndex(types.BondDenom)
+	if !hasDelegatorRewardIndex {
+		return claim, rewardsEarned
+	}
+
+	totalDelegated := k.getTotalDelegated(ctx, claim.Owner)
+	userRewardIndexes := make(types.RewardIndexes, len(claim.DelegatorRewardIndexes[delegatorIndex].RewardIndexes))
+	copy(userRewardIndexes, claim.DelegatorRewardIndexes[delegatorIndex].RewardIndexes)
+
+	for _, globalRewardIndex := range globalRewardIndexes {
+		userRewardFactor := sdk.ZeroDec()
+		factorIndex, found := userRewardIndexes.GetFactorIndex(globalRewardIndex.CollateralType)
+		if found {
+			userRewardFactor = userRewardIndexes[factorIndex].RewardFactor
+			userRewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
+		} else {
+			userRewardIndexes = append(userRewardIndexes, globalRewardIndex)
+		}
+
+		newRewardsAmount := globalRewardIndex.RewardFactor.Sub(userRewardFactor).Mul(totalDelegated).RoundInt()
+		if newRewardsAmount.IsPositive() {
+			rewardsEarned = rewardsEarned.Add(sdk.NewCoin(globalRewardIndex.CollateralType, newRewardsAmount))
+		}
+	}
+
+	delegatorRewardIndexes := make(types.MultiRewardIndexes, len(claim.DelegatorRewardIndexes))
+	copy(delegatorRewardIndexes, claim.DelegatorRewardIndexes)
+	delegatorRewardIndexes[delegatorIndex] = types.NewMultiRewardIndex(types.BondDenom, userRewardIndexes)
+	claim.DelegatorRewardIndexes = delegatorRewardIndexes
+	return claim, rewardsEarned
+}
+
+// getTotalDelegated returns the tokens a delegator has delegated to bonded validators
+func (k Keeper) getTotalDelegated(ctx sdk.Context, delegator sdk.AccAddress) sdk.Dec {
+	totalDelegated := sdk.ZeroDec()
+	k.stakingKeeper.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingexported.DelegationI) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			return false
+		}
+
+		// Delegators don't accumulate rewards if their validator is unbonded/slashed
+		if validator.GetStatus() != sdk.Bonded {
+			return false
+		}
+
+		if validator.GetTokens().IsZero() {
+			return false
+		}
+
+		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
+		if delegatedTokens.IsZero() || delegatedTokens.IsNegative() {
+			return false
+		}
+		totalDelegated = totalDelegated.Add(delegatedTokens)
+		return false
+	})
+	return totalDelegated
+}
+
 // ZeroUSDXMintingClaim zeroes out the claim object's rewards and returns the updated claim object
 func (k Keeper) ZeroUSDXMintingClaim(ctx sdk.Context, claim types.USDXMintingClaim) types.USDXMintingClaim {
 	claim.Reward = sdk.NewCoin(claim.Reward.Denom, sdk.ZeroInt())
 	k.SetUSDXMintingClaim(ctx, claim)
+	k.deleteRewardAccruals(ctx, claim.Owner, types.USDXMintingClaimType)
 	return claim
 }
 
@@ -636,12 +663,19 @@ func (k Keeper) ZeroHardLiquidityProviderClaim(ctx sdk.Context, claim types.Hard
 	}
 	claim.Reward = zeroRewards
 	k.SetHardLiquidityProviderClaim(ctx, claim)
+	k.deleteRewardAccruals(ctx, claim.Owner, types.HardLiquidityProviderClaimType)
 	return claim
 }
 
 // CalculateTimeElapsed calculates the number of reward-eligible seconds that have passed since the previous
-// time rewards were accrued, taking into account the end time of the reward period
+// time rewards were accrued, taking into account the start and end time of the reward period
 func CalculateTimeElapsed(start, end, blockTime time.Time, previousAccrualTime time.Time) sdk.Int {
+	if previousAccrualTime.Before(start) {
+		previousAccrualTime = start
+	}
+	if !blockTime.After(previousAccrualTime) {
+		return sdk.ZeroInt()
+	}
 	if end.Before(blockTime) &&
 		(end.Before(previousAccrualTime) || end.Equal(previousAccrualTime)) {
 		return sdk.ZeroInt()
@@ -658,6 +692,10 @@ func CalculateTimeElapsed(start, end, blockTime time.Time, previousAccrualTime t
 
 // SimulateHardSynchronization calculates a user's outstanding hard rewards by simulating reward synchronization
 func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiquidityProviderClaim) types.HardLiquidityProviderClaim {
+	if k.IsExcludedFromRewards(ctx, claim.GetOwner()) {
+		return claim
+	}
+
 	// 1. Simulate Hard supply-side rewards
 	for _, ri := range claim.SupplyRewardIndexes {
 		globalRewardIndexes, foundGlobalRewardIndexes := k.GetHardSupplyRewardIndexes(ctx, ri.CollateralType)
@@ -703,6 +741,9 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 				continue
 			}
 			claim.SupplyRewardIndexes[userRewardIndexIndex].RewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
+			if k.rewardExpired(ctx, types.HardSupplyRewardType, ri.CollateralType) {
+				continue
+			}
 			newRewardsCoin := sdk.NewCoin(userRewardIndex.CollateralType, newRewardsAmount)
 			claim.Reward = claim.Reward.Add(newRewardsCoin)
 		}
@@ -753,70 +794,29 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 				continue
 			}
 			claim.BorrowRewardIndexes[userRewardIndexIndex].RewardIndexes[factorIndex].RewardFactor = globalRewardIndex.RewardFactor
+			if k.rewardExpired(ctx, types.HardBorrowRewardType, ri.CollateralType) {
+				continue
+			}
 			newRewardsCoin := sdk.NewCoin(userRewardIndex.CollateralType, newRewardsAmount)
 			claim.Reward = claim.Reward.Add(newRewardsCoin)
 		}
 	}
 
 	// 3. Simulate Hard delegator rewards
-	delagatorFactor, found := k.GetHardDelegatorRewardFactor(ctx, types.BondDenom)
-	if !found {
-		return claim
-	}
-
-	delegatorIndex, hasDelegatorRewardIndex := claim.HasDelegatorRewardIndex(types.BondDenom)
-	if !hasDelegatorRewardIndex {
-		return claim
+	claim, rewardsEarned := k.synchronizeHardDelegatorRewardIndexes(ctx, claim)
+	if !k.rewardExpired(ctx, types.HardDelegatorRewardType, types.BondDenom) {
+		claim.Reward = claim.Reward.Add(rewardsEarned...)
 	}
 
-	userRewardFactor := claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor
-	rewardsAccumulatedFactor := delagatorFactor.Sub(userRewardFactor)
-	if rewardsAccumulatedFactor.IsZero() {
-		return claim
-	}
-	claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor = delagatorFactor
-
-	totalDelegated := sdk.ZeroDec()
-
-	// TODO: set reasonable max limit on delegation iteration
-	maxUInt := ^uint16(0)
-	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, claim.GetOwner(), maxUInt)
-	for _, delegation := range delegations {
-		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
-		if !found {
-			continue
-		}
-
-		// Delegators don't accumulate rewards if their validator is unbonded/slashed
-		if validator.GetStatus() != sdk.Bonded {
-			continue
-		}
-
-		if validator.GetTokens().IsZero() {
-			continue
-		}
-
-		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
-		if delegatedTokens.IsZero() || delegatedTokens.IsNegative() {
-			continue
-		}
-		totalDelegated = totalDelegated.Add(delegatedTokens)
-	}
-
-	rewardsEarned := rewardsAccumulatedFactor.Mul(totalDelegated).RoundInt()
-	if rewardsEarned.IsZero() || rewardsEarned.IsNegative() {
-		return claim
-	}
-
-	// Add rewards to delegator's hard claim
-	newRewardsCoin := sdk.NewCoin(types.HardLiquidityRewardDenom, rewardsEarned)
-	claim.Reward = claim.Reward.Add(newRewardsCoin)
-
 	return claim
 }
 
 // SimulateUSDXMintingSynchronization calculates a user's outstanding USDX minting rewards by simulating reward synchronization
 func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.USDXMintingClaim) types.USDXMintingClaim {
+	if k.IsExcludedFromRewards(ctx, claim.GetOwner()) {
+		return claim
+	}
+
 	for _, ri := range claim.RewardIndexes {
 		_, found := k.GetUSDXMintingRewardPeriod(ctx, ri.CollateralType)
 		if !found {
@@ -846,7 +846,7 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 			continue
 		}
 		newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
-		if newRewardsAmount.IsZero() {
+		if newRewardsAmount.IsZero() || k.rewardExpired(ctx, types.USDXMintingRewardType, ri.CollateralType) {
 			continue
 		}
 		newRewardsCoin := sdk.NewCoin(types.USDXMintingRewardDenom, newRewardsAmount)
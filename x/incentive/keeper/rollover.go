@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// InitializeRewardPeriodRolloverParams sets the reward period rollover params to their default if they have not been
+// set, such as on chains that were started before rollovers were added
+func (k Keeper) InitializeRewardPeriodRolloverParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyRewardPeriodRollovers) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyRewardPeriodRollovers, types.DefaultRewardPeriodRollovers)
+}
+
+// RolloverRewardPeriods applies the rollover policy of each active reward period that has reached its end time.
+// Periods without a rollover are retired.
+func (k Keeper) RolloverRewardPeriods(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	updated := false
+
+	for i, rp := range params.USDXMintingRewardPeriods {
+		if newRp, ok := k.rolloverRewardPeriod(ctx, params.RewardPeriodRollovers, types.USDXMintingRewardType, rp); ok {
+			params.USDXMintingRewardPeriods[i] = newRp
+			updated = true
+		}
+	}
+	for i, rp := range params.HardSupplyRewardPeriods {
+		if newRp, ok := k.rolloverMultiRewardPeriod(ctx, params.RewardPeriodRollovers, types.HardSupplyRewardType, rp); ok {
+			params.HardSupplyRewardPeriods[i] = newRp
+			updated = true
+		}
+	}
+	for i, rp := range params.HardBorrowRewardPeriods {
+		if newRp, ok := k.rolloverMultiRewardPeriod(ctx, params.RewardPeriodRollovers, types.HardBorrowRewardType, rp); ok {
+			params.HardBorrowRewardPeriods[i] = newRp
+			updated = true
+		}
+	}
+	for i, rp := range params.HardDelegatorRewardPeriods {
+		if newRp, ok := k.rolloverMultiRewardPeriod(ctx, params.RewardPeriodRollovers, types.HardDelegatorRewardType, rp); ok {
+			params.HardDelegatorRewardPeriods[i] = newRp
+			updated = true
+		}
+	}
+
+	if updated {
+		k.SetParams(ctx, params)
+	}
+}
+
+// rolloverRewardPeriod returns the reward period after its rollover, and false if it has not ended
+func (k Keeper) rolloverRewardPeriod(ctx sdk.Context, rollovers types.RewardPeriodRollovers, rewardType string, rp types.RewardPeriod) (types.RewardPeriod, bool) {
+	if !rp.Active || ctx.BlockTime().Before(rp.End) {
+		return rp, false
+	}
+	rollover := getRollover(rollovers, rewardType, rp.CollateralType, rp.Start, rp.End)
+
+	start, end, count := nextRewardPeriodTimes(rp.Start, rp.End, ctx.BlockTime())
+	rewardAmount := rp.RewardsPerSecond.Amount
+	switch rollover.Policy {
+	case types.RolloverRepeat:
+		rp.Start, rp.End = start, end
+	case types.RolloverTaper:
+		rp.Start, rp.End = start, end
+		rewardAmount = taperAmount(rewardAmount, rollover.TaperFactor, count)
+		rp.RewardsPerSecond = sdk.NewCoin(rp.RewardsPerSecond.Denom, rewardAmount)
+	}
+	if rollover.Policy == types.RolloverRetire || rewardAmount.IsZero() {
+		rp.Active = false
+	}
+
+	emitRolloverEvent(ctx, rewardType, rp.CollateralType, rollover.Policy, rp.Active, rp.Start, rp.End)
+	return rp, true
+}
+
+// rolloverMultiRewardPeriod returns the multi reward period after its rollover, and false if it has not ended
+func (k Keeper) rolloverMultiRewardPeriod(ctx sdk.Context, rollovers types.RewardPeriodRollovers, rewardType string, rp types.MultiRewardPeriod) (types.MultiRewardPeriod, bool) {
+	if !rp.Active || ctx.BlockTime().Before(rp.End) {
+		return rp, false
+	}
+	rollover := getRollover(rollovers, rewardType, rp.CollateralType, rp.Start, rp.End)
+
+	start, end, count := nextRewardPeriodTimes(rp.Start, rp.End, ctx.BlockTime())
+	switch rollover.Policy {
+	case types.RolloverRepeat:
+		rp.Start, rp.End = start, end
+	case types.RolloverTaper:
+		rp.Start, rp.End = start, end
+		rewards := sdk.NewCoins()
+		for _, coin := range rp.RewardsPerSecond {
+			rewards = rewards.Add(sdk.NewCoin(coin.Denom, taperAmount(coin.Amount, rollover.TaperFactor, count)))
+		}
+		rp.RewardsPerSecond = rewards
+	}
+	if rollover.Policy == types.RolloverRetire || rp.RewardsPerSecond.IsZero() {
+		rp.Active = false
+	}
+
+	emitRolloverEvent(ctx, rewardType, rp.CollateralType, rollover.Policy, rp.Active, rp.Start, rp.End)
+	return rp, true
+}
+
+// getRollover returns the rollover for a reward period, periods without a rollover or with no duration are retired
+func getRollover(rollovers types.RewardPeriodRollovers, rewardType, collateralType string, start, end time.Time) types.RewardPeriodRollover {
+	rollover, found := rollovers.Get(rewardType, collateralType)
+	if !found || !end.After(start) {
+		return types.NewRewardPeriodRollover(rewardType, collateralType, types.RolloverRetire, sdk.ZeroDec())
+	}
+	return rollover
+}
+
+// nextRewardPeriodTimes shifts a reward period forward by its duration until it ends after the block time, returning
+// the new start and end times and the number of periods it was shifted by
+func nextRewardPeriodTimes(start, end, blockTime time.Time) (time.Time, time.Time, int64) {
+	duration := end.Sub(start)
+	count := int64(blockTime.Sub(start) / duration)
+	shift := time.Duration(count) * duration
+	return start.Add(shift), end.Add(shift), count
+}
+
+// taperAmount multiplies the amount by the taper factor once for each period
+func taperAmount(amount sdk.Int, factor sdk.Dec, periods int64) sdk.Int {
+	return amount.ToDec().Mul(factor.Power(uint64(periods))).TruncateInt()
+}
+
+func emitRolloverEvent(ctx sdk.Context, rewardType, collateralType string, policy types.RolloverPolicy, active bool, start, end time.Time) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyRewardType, rewardType),
+		sdk.NewAttribute(types.AttributeKeyCollateralType, collateralType),
+		sdk.NewAttribute(types.AttributeKeyRolloverPolicy, string(policy)),
+	}
+	if active {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeKeyRewardPeriod, start.Format(time.RFC3339)+"/"+end.Format(time.RFC3339)))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRewardPeriodEnd, attributes...))
+}
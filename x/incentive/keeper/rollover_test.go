@@ -0,0 +1,105 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+func (suite *KeeperTestSuite) TestCalculateTimeElapsedBeforeStart() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	// no rewards accrue before the reward period starts
+	elapsed := keeper.CalculateTimeElapsed(start, end, start.Add(-time.Minute), start.Add(-time.Hour))
+	suite.Require().Equal(sdk.ZeroInt(), elapsed)
+
+	// only time after the start is counted
+	elapsed = keeper.CalculateTimeElapsed(start, end, start.Add(time.Minute), start.Add(-time.Hour))
+	suite.Require().Equal(sdk.NewInt(60), elapsed)
+}
+
+func (suite *KeeperTestSuite) TestRolloverRewardPeriods() {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	blockTime := end.Add(36 * time.Hour) // two periods have ended since the start
+
+	setup := func(rollovers types.RewardPeriodRollovers) {
+		suite.SetupTest()
+		suite.ctx = suite.ctx.WithBlockTime(blockTime)
+		params := types.NewParams(
+			types.RewardPeriods{types.NewRewardPeriod(true, "bnb-a", start, end, c("ukava", 1000))},
+			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "bnb", start, end, cs(c("hard", 1000), c("ukava", 1)))},
+			types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, "bnb", start, blockTime.Add(time.Hour), cs(c("hard", 1000)))},
+			types.MultiRewardPeriods{types.NewMultiRewardPeriod(false, "ukava", start, end, cs(c("hard", 1000)))},
+			types.DefaultMultipliers,
+			blockTime.Add(365*24*time.Hour),
+		)
+		params.RewardPeriodRollovers = rollovers
+		suite.keeper.SetParams(suite.ctx, params)
+	}
+
+	suite.Run("periods without a rollover are retired", func() {
+		setup(types.DefaultRewardPeriodRollovers)
+		suite.keeper.RolloverRewardPeriods(suite.ctx)
+
+		params := suite.keeper.GetParams(suite.ctx)
+		suite.Require().False(params.USDXMintingRewardPeriods[0].Active)
+		suite.Require().Equal(end, params.USDXMintingRewardPeriods[0].End)
+		suite.Require().False(params.HardSupplyRewardPeriods[0].Active)
+		// periods that have not ended are unchanged
+		suite.Require().True(params.HardBorrowRewardPeriods[0].Active)
+		suite.Require().Len(suite.ctx.EventManager().Events(), 2)
+	})
+
+	suite.Run("repeated periods start again with the same rewards", func() {
+		setup(types.RewardPeriodRollovers{
+			types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverRepeat, sdk.ZeroDec()),
+			types.NewRewardPeriodRollover(types.HardDelegatorRewardType, "ukava", types.RolloverRepeat, sdk.ZeroDec()),
+		})
+		suite.keeper.RolloverRewardPeriods(suite.ctx)
+
+		params := suite.keeper.GetParams(suite.ctx)
+		rp := params.USDXMintingRewardPeriods[0]
+		suite.Require().True(rp.Active)
+		suite.Require().Equal(start.Add(48*time.Hour), rp.Start)
+		suite.Require().Equal(end.Add(48*time.Hour), rp.End)
+		suite.Require().Equal(c("ukava", 1000), rp.RewardsPerSecond)
+		// inactive periods are not rolled over
+		suite.Require().False(params.HardDelegatorRewardPeriods[0].Active)
+		suite.Require().Equal(start, params.HardDelegatorRewardPeriods[0].Start)
+	})
+
+	suite.Run("tapered periods start again with reduced rewards", func() {
+		setup(types.RewardPeriodRollovers{
+			types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverTaper, d("0.5")),
+			types.NewRewardPeriodRollover(types.HardSupplyRewardType, "bnb", types.RolloverTaper, d("0.5")),
+		})
+		suite.keeper.RolloverRewardPeriods(suite.ctx)
+
+		params := suite.keeper.GetParams(suite.ctx)
+		rp := params.USDXMintingRewardPeriods[0]
+		suite.Require().True(rp.Active)
+		suite.Require().Equal(end.Add(48*time.Hour), rp.End)
+		suite.Require().Equal(c("ukava", 250), rp.RewardsPerSecond)
+
+		// rewards that taper to zero are removed
+		mrp := params.HardSupplyRewardPeriods[0]
+		suite.Require().True(mrp.Active)
+		suite.Require().Equal(cs(c("hard", 250)), mrp.RewardsPerSecond)
+	})
+
+	suite.Run("tapered periods are retired when rewards reach zero", func() {
+		setup(types.RewardPeriodRollovers{
+			types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverTaper, d("0.001")),
+		})
+		suite.keeper.RolloverRewardPeriods(suite.ctx)
+
+		params := suite.keeper.GetParams(suite.ctx)
+		suite.Require().False(params.USDXMintingRewardPeriods[0].Active)
+		suite.Require().True(params.USDXMintingRewardPeriods[0].RewardsPerSecond.IsZero())
+	})
+}
@@ -0,0 +1,195 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClaimDeadline is the time after which rewards earned from a reward period and not yet claimed expire. Expired
+// rewards are removed from claims and stay in the incentive module account, which funds future rewards.
+type ClaimDeadline struct {
+	RewardType     string    `json:"reward_type" yaml:"reward_type"`
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	Deadline       time.Time `json:"deadline" yaml:"deadline"`
+}
+
+// NewClaimDeadline returns a new ClaimDeadline
+func NewClaimDeadline(rewardType, collateralType string, deadline time.Time) ClaimDeadline {
+	return ClaimDeadline{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		Deadline:       deadline,
+	}
+}
+
+// Validate performs a basic check of a ClaimDeadline
+func (cd ClaimDeadline) Validate() error {
+	if err := validateRewardType(cd.RewardType); err != nil {
+		return fmt.Errorf("invalid claim deadline reward type: %s", cd.RewardType)
+	}
+	if strings.TrimSpace(cd.CollateralType) == "" {
+		return fmt.Errorf("claim deadline collateral type cannot be blank for %s", cd.RewardType)
+	}
+	if cd.Deadline.Unix() <= 0 {
+		return fmt.Errorf("claim deadline for %s %s cannot be 0", cd.RewardType, cd.CollateralType)
+	}
+	return nil
+}
+
+// HasPassed returns true if the deadline is before the block time
+func (cd ClaimDeadline) HasPassed(blockTime time.Time) bool {
+	return blockTime.After(cd.Deadline)
+}
+
+// String implements fmt.Stringer
+func (cd ClaimDeadline) String() string {
+	return fmt.Sprintf(`Claim Deadline:
+	Reward Type: %s,
+	Collateral Type: %s,
+	Deadline: %s,
+	`, cd.RewardType, cd.CollateralType, cd.Deadline)
+}
+
+// ClaimDeadlines slice of ClaimDeadline
+type ClaimDeadlines []ClaimDeadline
+
+// Validate checks that each claim deadline is valid and that no reward period has more than one deadline
+func (cds ClaimDeadlines) Validate() error {
+	seen := make(map[string]bool)
+	for _, cd := range cds {
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+		key := cd.RewardType + "/" + cd.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated claim deadline for %s reward period with collateral type %s", cd.RewardType, cd.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Get returns the claim deadline for the reward period with the input reward type and collateral type
+func (cds ClaimDeadlines) Get(rewardType, collateralType string) (ClaimDeadline, bool) {
+	for _, cd := range cds {
+		if cd.RewardType == rewardType && cd.CollateralType == collateralType {
+			return cd, true
+		}
+	}
+	return ClaimDeadline{}, false
+}
+
+// RewardAccrual is the amount an owner has earned from a reward period with a claim deadline and not yet claimed
+type RewardAccrual struct {
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	RewardType     string         `json:"reward_type" yaml:"reward_type"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	Amount         sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewRewardAccrual returns a new RewardAccrual
+func NewRewardAccrual(owner sdk.AccAddress, rewardType, collateralType string, amount sdk.Coins) RewardAccrual {
+	return RewardAccrual{
+		Owner:          owner,
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Validate performs a basic check of a RewardAccrual
+func (ra RewardAccrual) Validate() error {
+	if ra.Owner.Empty() {
+		return fmt.Errorf("reward accrual owner cannot be empty")
+	}
+	if err := validateRewardType(ra.RewardType); err != nil {
+		return err
+	}
+	if strings.TrimSpace(ra.CollateralType) == "" {
+		return fmt.Errorf("reward accrual collateral type cannot be blank for %s", ra.RewardType)
+	}
+	if !ra.Amount.IsValid() {
+		return fmt.Errorf("invalid reward accrual amount: %s", ra.Amount)
+	}
+	return nil
+}
+
+// RewardAccruals slice of RewardAccrual
+type RewardAccruals []RewardAccrual
+
+// Validate checks that each reward accrual is valid and that no owner has more than one for a reward period
+func (ras RewardAccruals) Validate() error {
+	seen := make(map[string]bool)
+	for _, ra := range ras {
+		if err := ra.Validate(); err != nil {
+			return err
+		}
+		key := ra.Owner.String() + "/" + ra.RewardType + "/" + ra.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated reward accrual for %s from %s reward period with collateral type %s", ra.Owner, ra.RewardType, ra.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// ExpiredReward is the total amount of rewards earned from a reward period that expired before they were claimed
+type ExpiredReward struct {
+	RewardType     string    `json:"reward_type" yaml:"reward_type"`
+	CollateralType string    `json:"collateral_type" yaml:"collateral_type"`
+	Amount         sdk.Coins `json:"amount" yaml:"amount"`
+}
+
+// NewExpiredReward returns a new ExpiredReward
+func NewExpiredReward(rewardType, collateralType string, amount sdk.Coins) ExpiredReward {
+	return ExpiredReward{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		Amount:         amount,
+	}
+}
+
+// Validate performs a basic check of an ExpiredReward
+func (er ExpiredReward) Validate() error {
+	if err := validateRewardType(er.RewardType); err != nil {
+		return err
+	}
+	if strings.TrimSpace(er.CollateralType) == "" {
+		return fmt.Errorf("expired reward collateral type cannot be blank for %s", er.RewardType)
+	}
+	if !er.Amount.IsValid() {
+		return fmt.Errorf("invalid expired reward amount: %s", er.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (er ExpiredReward) String() string {
+	return fmt.Sprintf(`Expired Reward:
+	Reward Type: %s,
+	Collateral Type: %s,
+	Amount: %s,
+	`, er.RewardType, er.CollateralType, er.Amount)
+}
+
+// ExpiredRewards slice of ExpiredReward
+type ExpiredRewards []ExpiredReward
+
+// Validate checks that each expired reward is valid and that no reward period has more than one
+func (ers ExpiredRewards) Validate() error {
+	seen := make(map[string]bool)
+	for _, er := range ers {
+		if err := er.Validate(); err != nil {
+			return err
+		}
+		key := er.RewardType + "/" + er.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated expired reward for %s reward period with collateral type %s", er.RewardType, er.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
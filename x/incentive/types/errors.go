@@ -19,4 +19,7 @@ var (
 	ErrClaimExpired                  = sdkerrors.Register(ModuleName, 10, "claim has expired")
 	ErrInvalidClaimType              = sdkerrors.Register(ModuleName, 11, "invalid claim type")
 	ErrInvalidClaimOwner             = sdkerrors.Register(ModuleName, 12, "invalid claim owner")
+	ErrEarlyUnlockDisabled           = sdkerrors.Register(ModuleName, 13, "early unlock of rewards is not active")
+	ErrNoLockedRewards               = sdkerrors.Register(ModuleName, 14, "no locked rewards found for address")
+	ErrInvalidVestingSchedule        = sdkerrors.Register(ModuleName, 15, "vesting schedule does not match reward lockups")
 )
@@ -2,15 +2,26 @@ package types
 
 // Events emitted by the incentive module
 const (
-	EventTypeClaim             = "claim_reward"
-	EventTypeRewardPeriod      = "new_reward_period"
-	EventTypeClaimPeriod       = "new_claim_period"
-	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeClaim              = "claim_reward"
+	EventTypeRewardPeriod       = "new_reward_period"
+	EventTypeClaimPeriod        = "new_claim_period"
+	EventTypeClaimPeriodExpiry  = "claim_period_expiry"
+	EventTypeRewardPeriodEnd    = "reward_period_end"
+	EventTypeUnlockRewardsEarly = "unlock_rewards_early"
+	EventTypeRewardsExpired     = "rewards_expired"
 
-	AttributeValueCategory   = ModuleName
-	AttributeKeyClaimedBy    = "claimed_by"
-	AttributeKeyClaimAmount  = "claim_amount"
-	AttributeKeyClaimType    = "claim_type"
-	AttributeKeyRewardPeriod = "reward_period"
-	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyClaimedBy      = "claimed_by"
+	AttributeKeyClaimAmount    = "claim_amount"
+	AttributeKeyClaimType      = "claim_type"
+	AttributeKeyRewardPeriod   = "reward_period"
+	AttributeKeyClaimPeriod    = "claim_period"
+	AttributeKeyRewardType     = "reward_type"
+	AttributeKeyCollateralType = "collateral_type"
+	AttributeKeyRolloverPolicy = "rollover_policy"
+	AttributeKeyUnlockedBy     = "unlocked_by"
+	AttributeKeyUnlockAmount   = "unlock_amount"
+	AttributeKeyPenaltyAmount  = "penalty_amount"
+	AttributeKeyPenaltyBurned  = "penalty_burned"
+	AttributeKeyExpiredAmount  = "expired_amount"
 )
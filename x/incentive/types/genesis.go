@@ -17,6 +17,9 @@ type GenesisState struct {
 	HardDelegatorAccumulationTimes GenesisAccumulationTimes    `json:"hard_delegator_accumulation_times" yaml:"hard_delegator_accumulation_times"`
 	USDXMintingClaims              USDXMintingClaims           `json:"usdx_minting_claims" yaml:"usdx_minting_claims"`
 	HardLiquidityProviderClaims    HardLiquidityProviderClaims `json:"hard_liquidity_provider_claims" yaml:"hard_liquidity_provider_claims"`
+	RewardLockups                  RewardLockups               `json:"reward_lockups" yaml:"reward_lockups"`
+	RewardAccruals                 RewardAccruals              `json:"reward_accruals" yaml:"reward_accruals"`
+	ExpiredRewards                 ExpiredRewards              `json:"expired_rewards" yaml:"expired_rewards"`
 }
 
 // NewGenesisState returns a new genesis state
@@ -42,6 +45,9 @@ func DefaultGenesisState() GenesisState {
 		HardDelegatorAccumulationTimes: GenesisAccumulationTimes{},
 		USDXMintingClaims:              DefaultUSDXClaims,
 		HardLiquidityProviderClaims:    DefaultHardClaims,
+		RewardLockups:                  RewardLockups{},
+		RewardAccruals:                 RewardAccruals{},
+		ExpiredRewards:                 ExpiredRewards{},
 	}
 }
 
@@ -67,6 +73,15 @@ func (gs GenesisState) Validate() error {
 	if err := gs.HardLiquidityProviderClaims.Validate(); err != nil {
 		return err
 	}
+	if err := gs.RewardLockups.Validate(); err != nil {
+		return err
+	}
+	if err := gs.RewardAccruals.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ExpiredRewards.Validate(); err != nil {
+		return err
+	}
 	return gs.USDXMintingClaims.Validate()
 }
 
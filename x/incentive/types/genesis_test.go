@@ -54,7 +54,7 @@ func TestGenesisStateValidate(t *testing.T) {
 					},
 					DefaultMultiRewardPeriods,
 					DefaultMultiRewardPeriods,
-					DefaultRewardPeriods,
+					DefaultMultiRewardPeriods,
 					Multipliers{
 						NewMultiplier(Small, 1, sdk.MustNewDecFromStr("0.33")),
 					},
@@ -142,3 +142,18 @@ func TestGenesisStateValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisStateValidateRewardLockups(t *testing.T) {
+	owner := sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser1")))
+	endTime := time.Date(2021, 6, 15, 14, 0, 0, 0, time.UTC)
+
+	gs := DefaultGenesisState()
+	gs.RewardLockups = RewardLockups{NewRewardLockup(owner, sdk.NewCoins(sdk.NewInt64Coin("hard", 100)), endTime)}
+	require.NoError(t, gs.Validate())
+
+	gs.RewardLockups = append(gs.RewardLockups, NewRewardLockup(owner, sdk.NewCoins(sdk.NewInt64Coin("ukava", 100)), endTime))
+	require.Error(t, gs.Validate())
+
+	gs.RewardLockups = RewardLockups{NewRewardLockup(owner, sdk.NewCoins(), endTime)}
+	require.Error(t, gs.Validate())
+}
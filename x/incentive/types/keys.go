@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -31,9 +37,30 @@ var (
 	PreviousHardSupplyRewardAccrualTimeKeyPrefix    = []byte{0x06} // prefix for key that stores the previous time Hard supply rewards accrued
 	HardBorrowRewardIndexesKeyPrefix                = []byte{0x07} // prefix for key that stores Hard borrow reward factors
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = []byte{0x08} // prefix for key that stores the previous time Hard borrow rewards accrued
-	HardDelegatorRewardFactorKeyPrefix              = []byte{0x09} // prefix for key that stores Hard delegator reward factors
+	HardDelegatorRewardIndexesKeyPrefix             = []byte{0x09} // prefix for key that stores Hard delegator reward factors
 	PreviousHardDelegatorRewardAccrualTimeKeyPrefix = []byte{0x10} // prefix for key that stores the previous time Hard delegator rewards accrued
+	RewardLockupKeyPrefix                           = []byte{0x11} // prefix for keys that store the claimed rewards vesting in each account
+	RewardAccrualKeyPrefix                          = []byte{0x15} // prefix for keys that store unclaimed rewards earned from reward periods with a claim deadline
+	ExpiredRewardKeyPrefix                          = []byte{0x16} // prefix for keys that store the rewards that expired from each reward period
 
 	USDXMintingRewardDenom   = "ukava"
 	HardLiquidityRewardDenom = "hard"
 )
+
+// GetRewardLockupKey returns the key for the reward lockup of an owner ending at the end time
+func GetRewardLockupKey(owner sdk.AccAddress, endTime time.Time) []byte {
+	return append(owner.Bytes(), sdk.FormatTimeBytes(endTime)...)
+}
+
+// GetRewardPeriodKey returns the key for a reward period, with the reward type and collateral type length prefixed so
+// keys of different reward periods cannot overlap
+func GetRewardPeriodKey(rewardType, collateralType string) []byte {
+	key := append([]byte{byte(len(rewardType))}, []byte(rewardType)...)
+	key = append(key, byte(len(collateralType)))
+	return append(key, []byte(collateralType)...)
+}
+
+// GetRewardAccrualKey returns the key for the rewards an owner has earned from a reward period
+func GetRewardAccrualKey(rewardType, collateralType string, owner sdk.AccAddress) []byte {
+	return append(GetRewardPeriodKey(rewardType, collateralType), owner.Bytes()...)
+}
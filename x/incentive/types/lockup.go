@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RewardLockup is an amount of claimed rewards that is vesting in the owner's account until the end time
+type RewardLockup struct {
+	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+	EndTime time.Time      `json:"end_time" yaml:"end_time"`
+}
+
+// NewRewardLockup returns a new RewardLockup
+func NewRewardLockup(owner sdk.AccAddress, amount sdk.Coins, endTime time.Time) RewardLockup {
+	return RewardLockup{
+		Owner:   owner,
+		Amount:  amount,
+		EndTime: endTime,
+	}
+}
+
+// Validate performs a basic check of a RewardLockup
+func (rl RewardLockup) Validate() error {
+	if rl.Owner.Empty() {
+		return errors.New("reward lockup owner cannot be empty")
+	}
+	if !rl.Amount.IsValid() || rl.Amount.Empty() {
+		return fmt.Errorf("invalid reward lockup amount: %s", rl.Amount)
+	}
+	if rl.EndTime.IsZero() {
+		return fmt.Errorf("reward lockup end time cannot be zero for %s", rl.Owner)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rl RewardLockup) String() string {
+	return fmt.Sprintf(`Reward Lockup:
+	Owner: %s,
+	Amount: %s,
+	End Time: %s,
+	`, rl.Owner, rl.Amount, rl.EndTime)
+}
+
+// RewardLockups slice of RewardLockup
+type RewardLockups []RewardLockup
+
+// Validate checks that each lockup is valid and that no owner has two lockups with the same end time
+func (rls RewardLockups) Validate() error {
+	seen := make(map[string]bool)
+	for _, rl := range rls {
+		if err := rl.Validate(); err != nil {
+			return err
+		}
+		key := rl.Owner.String() + "/" + rl.EndTime.UTC().String()
+		if seen[key] {
+			return fmt.Errorf("duplicated reward lockup for %s ending at %s", rl.Owner, rl.EndTime)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Total returns the sum of the lockup amounts
+func (rls RewardLockups) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, rl := range rls {
+		total = total.Add(rl.Amount...)
+	}
+	return total
+}
+
+// EarlyUnlockPenalty is the share of locked rewards forfeited when rewards are unlocked before the end of their lockup
+type EarlyUnlockPenalty struct {
+	Active bool    `json:"active" yaml:"active"`
+	Rate   sdk.Dec `json:"rate" yaml:"rate"`
+	Burn   bool    `json:"burn" yaml:"burn"` // burn forfeited rewards instead of returning them to the incentive module account
+}
+
+// NewEarlyUnlockPenalty returns a new EarlyUnlockPenalty
+func NewEarlyUnlockPenalty(active bool, rate sdk.Dec, burn bool) EarlyUnlockPenalty {
+	return EarlyUnlockPenalty{
+		Active: active,
+		Rate:   rate,
+		Burn:   burn,
+	}
+}
+
+// Validate performs a basic check of an EarlyUnlockPenalty
+func (eup EarlyUnlockPenalty) Validate() error {
+	if eup.Rate.IsNil() {
+		return errors.New("early unlock penalty rate cannot be nil")
+	}
+	if eup.Rate.IsNegative() || eup.Rate.GT(sdk.OneDec()) {
+		return fmt.Errorf("early unlock penalty rate must be between 0 and 1, got %s", eup.Rate)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (eup EarlyUnlockPenalty) String() string {
+	return fmt.Sprintf(`Early Unlock Penalty:
+	Active: %t,
+	Rate: %s,
+	Burn: %t,
+	`, eup.Active, eup.Rate, eup.Burn)
+}
+
+// Penalty returns the amount of the input coins forfeited, rounded down
+func (eup EarlyUnlockPenalty) Penalty(coins sdk.Coins) sdk.Coins {
+	penalty := sdk.NewCoins()
+	for _, coin := range coins {
+		penalty = penalty.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(eup.Rate).TruncateInt()))
+	}
+	return penalty
+}
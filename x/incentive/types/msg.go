@@ -10,6 +10,7 @@ import (
 // ensure Msg interface compliance at compile time
 var _ sdk.Msg = &MsgClaimUSDXMintingReward{}
 var _ sdk.Msg = &MsgClaimHardLiquidityProviderReward{}
+var _ sdk.Msg = &MsgUnlockRewardsEarly{}
 
 // MsgClaimUSDXMintingReward message type used to claim USDX minting rewards
 type MsgClaimUSDXMintingReward struct {
@@ -90,3 +91,41 @@ func (msg MsgClaimHardLiquidityProviderReward) GetSignBytes() []byte {
 func (msg MsgClaimHardLiquidityProviderReward) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
+
+// MsgUnlockRewardsEarly message type used to unlock vesting rewards before the end of their lockup, forfeiting a share
+// of the locked rewards
+type MsgUnlockRewardsEarly struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+}
+
+// NewMsgUnlockRewardsEarly returns a new MsgUnlockRewardsEarly.
+func NewMsgUnlockRewardsEarly(sender sdk.AccAddress) MsgUnlockRewardsEarly {
+	return MsgUnlockRewardsEarly{
+		Sender: sender,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgUnlockRewardsEarly) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgUnlockRewardsEarly) Type() string { return "unlock_rewards_early" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgUnlockRewardsEarly) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgUnlockRewardsEarly) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgUnlockRewardsEarly) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
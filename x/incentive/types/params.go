@@ -30,10 +30,18 @@ var (
 	KeyHardDelegatorRewardPeriods   = []byte("HardDelegatorRewardPeriods")
 	KeyClaimEnd                     = []byte("ClaimEnd")
 	KeyMultipliers                  = []byte("ClaimMultipliers")
+	KeyRewardPeriodRollovers        = []byte("RewardPeriodRollovers")
+	KeyEarlyUnlockPenalty           = []byte("EarlyUnlockPenalty")
+	KeyClaimDeadlines               = []byte("ClaimDeadlines")
+	KeyExcludedAddresses            = []byte("ExcludedAddresses")
 	DefaultActive                   = false
 	DefaultRewardPeriods            = RewardPeriods{}
 	DefaultMultiRewardPeriods       = MultiRewardPeriods{}
 	DefaultMultipliers              = Multipliers{}
+	DefaultRewardPeriodRollovers    = RewardPeriodRollovers{}
+	DefaultEarlyUnlockPenalty       = NewEarlyUnlockPenalty(false, sdk.MustNewDecFromStr("0.5"), true)
+	DefaultClaimDeadlines           = ClaimDeadlines{}
+	DefaultExcludedAddresses        = []sdk.AccAddress{}
 	DefaultUSDXClaims               = USDXMintingClaims{}
 	DefaultHardClaims               = HardLiquidityProviderClaims{}
 	DefaultGenesisAccumulationTimes = GenesisAccumulationTimes{}
@@ -45,17 +53,22 @@ var (
 
 // Params governance parameters for the incentive module
 type Params struct {
-	USDXMintingRewardPeriods   RewardPeriods      `json:"usdx_minting_reward_periods" yaml:"usdx_minting_reward_periods"`
-	HardSupplyRewardPeriods    MultiRewardPeriods `json:"hard_supply_reward_periods" yaml:"hard_supply_reward_periods"`
-	HardBorrowRewardPeriods    MultiRewardPeriods `json:"hard_borrow_reward_periods" yaml:"hard_borrow_reward_periods"`
-	HardDelegatorRewardPeriods RewardPeriods      `json:"hard_delegator_reward_periods" yaml:"hard_delegator_reward_periods"`
-	ClaimMultipliers           Multipliers        `json:"claim_multipliers" yaml:"claim_multipliers"`
-	ClaimEnd                   time.Time          `json:"claim_end" yaml:"claim_end"`
+	USDXMintingRewardPeriods   RewardPeriods         `json:"usdx_minting_reward_periods" yaml:"usdx_minting_reward_periods"`
+	HardSupplyRewardPeriods    MultiRewardPeriods    `json:"hard_supply_reward_periods" yaml:"hard_supply_reward_periods"`
+	HardBorrowRewardPeriods    MultiRewardPeriods    `json:"hard_borrow_reward_periods" yaml:"hard_borrow_reward_periods"`
+	HardDelegatorRewardPeriods MultiRewardPeriods    `json:"hard_delegator_reward_periods" yaml:"hard_delegator_reward_periods"`
+	ClaimMultipliers           Multipliers           `json:"claim_multipliers" yaml:"claim_multipliers"`
+	ClaimEnd                   time.Time             `json:"claim_end" yaml:"claim_end"`
+	RewardPeriodRollovers      RewardPeriodRollovers `json:"reward_period_rollovers" yaml:"reward_period_rollovers"`
+	EarlyUnlockPenalty         EarlyUnlockPenalty    `json:"early_unlock_penalty" yaml:"early_unlock_penalty"`
+	ClaimDeadlines             ClaimDeadlines        `json:"claim_deadlines" yaml:"claim_deadlines"`
+	ExcludedAddresses          []sdk.AccAddress      `json:"excluded_addresses" yaml:"excluded_addresses"`
 }
 
-// NewParams returns a new params object
-func NewParams(usdxMinting RewardPeriods, hardSupply, hardBorrow MultiRewardPeriods,
-	hardDelegator RewardPeriods, multipliers Multipliers, claimEnd time.Time) Params {
+// NewParams returns a new params object with no reward period rollovers, the default early unlock penalty, no claim
+// deadlines, and no excluded addresses
+func NewParams(usdxMinting RewardPeriods, hardSupply, hardBorrow, hardDelegator MultiRewardPeriods,
+	multipliers Multipliers, claimEnd time.Time) Params {
 	return Params{
 		USDXMintingRewardPeriods:   usdxMinting,
 		HardSupplyRewardPeriods:    hardSupply,
@@ -63,13 +76,17 @@ func NewParams(usdxMinting RewardPeriods, hardSupply, hardBorrow MultiRewardPeri
 		HardDelegatorRewardPeriods: hardDelegator,
 		ClaimMultipliers:           multipliers,
 		ClaimEnd:                   claimEnd,
+		RewardPeriodRollovers:      DefaultRewardPeriodRollovers,
+		EarlyUnlockPenalty:         DefaultEarlyUnlockPenalty,
+		ClaimDeadlines:             DefaultClaimDeadlines,
+		ExcludedAddresses:          DefaultExcludedAddresses,
 	}
 }
 
 // DefaultParams returns default params for incentive module
 func DefaultParams() Params {
 	return NewParams(DefaultRewardPeriods, DefaultMultiRewardPeriods,
-		DefaultMultiRewardPeriods, DefaultRewardPeriods, DefaultMultipliers, DefaultClaimEnd)
+		DefaultMultiRewardPeriods, DefaultMultiRewardPeriods, DefaultMultipliers, DefaultClaimEnd)
 }
 
 // String implements fmt.Stringer
@@ -81,8 +98,13 @@ func (p Params) String() string {
 	Hard Delegator Reward Periods: %s
 	Claim Multipliers :%s
 	Claim End Time: %s
+	Reward Period Rollovers: %s
+	Early Unlock Penalty: %s
+	Claim Deadlines: %s
+	Excluded Addresses: %s
 	`, p.USDXMintingRewardPeriods, p.HardSupplyRewardPeriods, p.HardBorrowRewardPeriods,
-		p.HardDelegatorRewardPeriods, p.ClaimMultipliers, p.ClaimEnd)
+		p.HardDelegatorRewardPeriods, p.ClaimMultipliers, p.ClaimEnd, p.RewardPeriodRollovers, p.EarlyUnlockPenalty,
+		p.ClaimDeadlines, p.ExcludedAddresses)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -96,9 +118,13 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyUSDXMintingRewardPeriods, &p.USDXMintingRewardPeriods, validateRewardPeriodsParam),
 		params.NewParamSetPair(KeyHardSupplyRewardPeriods, &p.HardSupplyRewardPeriods, validateMultiRewardPeriodsParam),
 		params.NewParamSetPair(KeyHardBorrowRewardPeriods, &p.HardBorrowRewardPeriods, validateMultiRewardPeriodsParam),
-		params.NewParamSetPair(KeyHardDelegatorRewardPeriods, &p.HardDelegatorRewardPeriods, validateRewardPeriodsParam),
+		params.NewParamSetPair(KeyHardDelegatorRewardPeriods, &p.HardDelegatorRewardPeriods, validateMultiRewardPeriodsParam),
 		params.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
 		params.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersParam),
+		params.NewParamSetPair(KeyRewardPeriodRollovers, &p.RewardPeriodRollovers, validateRewardPeriodRolloversParam),
+		params.NewParamSetPair(KeyEarlyUnlockPenalty, &p.EarlyUnlockPenalty, validateEarlyUnlockPenaltyParam),
+		params.NewParamSetPair(KeyClaimDeadlines, &p.ClaimDeadlines, validateClaimDeadlinesParam),
+		params.NewParamSetPair(KeyExcludedAddresses, &p.ExcludedAddresses, validateExcludedAddressesParam),
 	}
 }
 
@@ -121,7 +147,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateRewardPeriodsParam(p.HardDelegatorRewardPeriods)
+	if err := validateMultiRewardPeriodsParam(p.HardDelegatorRewardPeriods); err != nil {
+		return err
+	}
+
+	if err := validateRewardPeriodRolloversParam(p.RewardPeriodRollovers); err != nil {
+		return err
+	}
+
+	if err := validateEarlyUnlockPenaltyParam(p.EarlyUnlockPenalty); err != nil {
+		return err
+	}
+
+	if err := validateClaimDeadlinesParam(p.ClaimDeadlines); err != nil {
+		return err
+	}
+
+	return validateExcludedAddressesParam(p.ExcludedAddresses)
 }
 
 func validateRewardPeriodsParam(i interface{}) error {
@@ -142,6 +184,52 @@ func validateMultiRewardPeriodsParam(i interface{}) error {
 	return rewards.Validate()
 }
 
+func validateRewardPeriodRolloversParam(i interface{}) error {
+	rollovers, ok := i.(RewardPeriodRollovers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return rollovers.Validate()
+}
+
+func validateClaimDeadlinesParam(i interface{}) error {
+	deadlines, ok := i.(ClaimDeadlines)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return deadlines.Validate()
+}
+
+func validateExcludedAddressesParam(i interface{}) error {
+	addresses, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenAddresses := make(map[string]bool)
+	for _, addr := range addresses {
+		if addr.Empty() {
+			return errors.New("excluded address cannot be empty")
+		}
+		if seenAddresses[addr.String()] {
+			return fmt.Errorf("duplicated excluded address: %s", addr)
+		}
+		seenAddresses[addr.String()] = true
+	}
+	return nil
+}
+
+func validateEarlyUnlockPenaltyParam(i interface{}) error {
+	penalty, ok := i.(EarlyUnlockPenalty)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return penalty.Validate()
+}
+
 func validateMultipliersParam(i interface{}) error {
 	multipliers, ok := i.(Multipliers)
 	if !ok {
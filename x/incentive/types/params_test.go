@@ -23,7 +23,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		usdxMintingRewardPeriods   types.RewardPeriods
 		hardSupplyRewardPeriods    types.MultiRewardPeriods
 		hardBorrowRewardPeriods    types.MultiRewardPeriods
-		hardDelegatorRewardPeriods types.RewardPeriods
+		hardDelegatorRewardPeriods types.MultiRewardPeriods
 		multipliers                types.Multipliers
 		end                        time.Time
 	}
@@ -45,7 +45,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
 				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
 				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
-				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultMultiRewardPeriods,
 				multipliers:                types.DefaultMultipliers,
 				end:                        types.DefaultClaimEnd,
 			},
@@ -70,7 +70,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				},
 				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
 				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
-				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultMultiRewardPeriods,
 				end:                        time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
 			},
 			errArgs{
@@ -96,6 +96,112 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestRewardPeriodRolloverValidation() {
+	testCases := []struct {
+		name     string
+		rollover types.RewardPeriodRollover
+		contains string
+	}{
+		{"retire", types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverRetire, sdk.ZeroDec()), ""},
+		{"repeat", types.NewRewardPeriodRollover(types.HardSupplyRewardType, "bnb", types.RolloverRepeat, sdk.ZeroDec()), ""},
+		{"taper", types.NewRewardPeriodRollover(types.HardDelegatorRewardType, "ukava", types.RolloverTaper, sdk.MustNewDecFromStr("0.5")), ""},
+		{"invalid reward type", types.NewRewardPeriodRollover("hard", "bnb", types.RolloverRepeat, sdk.ZeroDec()), "invalid rollover reward type"},
+		{"blank collateral type", types.NewRewardPeriodRollover(types.HardBorrowRewardType, " ", types.RolloverRepeat, sdk.ZeroDec()), "collateral type cannot be blank"},
+		{"invalid policy", types.NewRewardPeriodRollover(types.HardBorrowRewardType, "bnb", "extend", sdk.ZeroDec()), "invalid rollover policy"},
+		{"nil taper factor", types.NewRewardPeriodRollover(types.HardBorrowRewardType, "bnb", types.RolloverRepeat, sdk.Dec{}), "taper factor cannot be nil"},
+		{"zero taper factor", types.NewRewardPeriodRollover(types.HardBorrowRewardType, "bnb", types.RolloverTaper, sdk.ZeroDec()), "between 0 and 1"},
+		{"taper factor of one", types.NewRewardPeriodRollover(types.HardBorrowRewardType, "bnb", types.RolloverTaper, sdk.OneDec()), "between 0 and 1"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.rollover.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+
+	duplicated := types.RewardPeriodRollovers{
+		types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverRetire, sdk.ZeroDec()),
+		types.NewRewardPeriodRollover(types.USDXMintingRewardType, "bnb-a", types.RolloverRepeat, sdk.ZeroDec()),
+	}
+	suite.Require().Error(duplicated.Validate())
+}
+
+func (suite *ParamTestSuite) TestClaimDeadlineValidation() {
+	deadline := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		deadline types.ClaimDeadline
+		contains string
+	}{
+		{"valid", types.NewClaimDeadline(types.HardSupplyRewardType, "bnb", deadline), ""},
+		{"invalid reward type", types.NewClaimDeadline("hard", "bnb", deadline), "invalid claim deadline reward type"},
+		{"blank collateral type", types.NewClaimDeadline(types.HardDelegatorRewardType, " ", deadline), "collateral type cannot be blank"},
+		{"zero deadline", types.NewClaimDeadline(types.USDXMintingRewardType, "bnb-a", time.Time{}), "cannot be 0"},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.deadline.Validate()
+			if tc.contains == "" {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.contains)
+			}
+		})
+	}
+
+	duplicated := types.ClaimDeadlines{
+		types.NewClaimDeadline(types.HardBorrowRewardType, "bnb", deadline),
+		types.NewClaimDeadline(types.HardBorrowRewardType, "bnb", deadline.Add(time.Hour)),
+	}
+	suite.Require().Error(duplicated.Validate())
+}
+
+func (suite *ParamTestSuite) TestExcludedAddressesValidation() {
+	params := types.DefaultParams()
+	addr := sdk.AccAddress("excluded")
+	params.ExcludedAddresses = []sdk.AccAddress{addr}
+	suite.Require().NoError(params.Validate())
+
+	params.ExcludedAddresses = []sdk.AccAddress{addr, addr}
+	suite.Require().Error(params.Validate())
+
+	params.ExcludedAddresses = []sdk.AccAddress{sdk.AccAddress{}}
+	suite.Require().Error(params.Validate())
+}
+
+func (suite *ParamTestSuite) TestEarlyUnlockPenalty() {
+	suite.Require().NoError(types.DefaultEarlyUnlockPenalty.Validate())
+	suite.Require().NoError(types.NewEarlyUnlockPenalty(true, sdk.OneDec(), false).Validate())
+	suite.Require().Error(types.NewEarlyUnlockPenalty(true, sdk.Dec{}, false).Validate())
+	suite.Require().Error(types.NewEarlyUnlockPenalty(true, sdk.MustNewDecFromStr("-0.1"), false).Validate())
+	suite.Require().Error(types.NewEarlyUnlockPenalty(true, sdk.MustNewDecFromStr("1.1"), false).Validate())
+
+	penalty := types.NewEarlyUnlockPenalty(true, sdk.MustNewDecFromStr("0.25"), true).Penalty(
+		sdk.NewCoins(sdk.NewInt64Coin("hard", 10), sdk.NewInt64Coin("ukava", 3)),
+	)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("hard", 2)), penalty)
+}
+
+func (suite *ParamTestSuite) TestHardDelegatorRewardPeriods() {
+	start := time.Date(2020, 10, 15, 14, 0, 0, 0, time.UTC)
+	params := types.DefaultParams()
+	params.ClaimEnd = start.Add(time.Hour * 24 * 365)
+	suite.Require().NoError(params.Validate())
+
+	rewardPeriod := types.NewMultiRewardPeriod(true, types.BondDenom, start, start.Add(time.Hour*24*90), sdk.NewCoins(sdk.NewInt64Coin("hard", 10), sdk.NewInt64Coin("ukava", 5)))
+	params.HardDelegatorRewardPeriods = types.MultiRewardPeriods{rewardPeriod}
+	suite.Require().NoError(params.Validate())
+
+	params.HardDelegatorRewardPeriods = types.MultiRewardPeriods{rewardPeriod, rewardPeriod}
+	suite.Require().Error(params.Validate())
+}
+
 func TestParamTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
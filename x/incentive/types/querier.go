@@ -13,6 +13,7 @@ const (
 	QueryGetParams             = "parameters"
 	QueryGetRewardPeriods      = "reward-periods"
 	QueryGetClaimPeriods       = "claim-periods"
+	QueryGetExpiredRewards     = "expired-rewards"
 	RestClaimCollateralType    = "collateral_type"
 	RestClaimOwner             = "owner"
 	RestClaimType              = "type"
@@ -74,3 +75,9 @@ type PostClaimReq struct {
 	Sender         sdk.AccAddress `json:"sender" yaml:"sender"`
 	MultiplierName string         `json:"multiplier_name" yaml:"multiplier_name"`
 }
+
+// PostUnlockRewardsEarlyReq defines the properties of an early unlock transaction's request body.
+type PostUnlockRewardsEarlyReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+}
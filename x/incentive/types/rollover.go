@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reward types that reward period rollovers and claim deadlines apply to
+const (
+	USDXMintingRewardType   = "usdx_minting"
+	HardSupplyRewardType    = "hard_supply"
+	HardBorrowRewardType    = "hard_borrow"
+	HardDelegatorRewardType = "hard_delegator"
+)
+
+// validateRewardType returns an error if the reward type is not one of the reward types above
+func validateRewardType(rewardType string) error {
+	switch rewardType {
+	case USDXMintingRewardType, HardSupplyRewardType, HardBorrowRewardType, HardDelegatorRewardType:
+		return nil
+	}
+	return fmt.Errorf("invalid reward type: %s", rewardType)
+}
+
+// ClaimTypeOfRewardType returns the type of the claim that rewards of a reward type are added to
+func ClaimTypeOfRewardType(rewardType string) string {
+	switch rewardType {
+	case USDXMintingRewardType:
+		return USDXMintingClaimType
+	case HardSupplyRewardType, HardBorrowRewardType, HardDelegatorRewardType:
+		return HardLiquidityProviderClaimType
+	}
+	return ""
+}
+
+// Valid rollover policies
+const (
+	RolloverRetire RolloverPolicy = "retire"
+	RolloverRepeat RolloverPolicy = "repeat"
+	RolloverTaper  RolloverPolicy = "taper"
+)
+
+// RolloverPolicy is what happens to a reward period when it reaches its end time
+type RolloverPolicy string
+
+// IsValid checks if the input is one of the expected strings
+func (rp RolloverPolicy) IsValid() error {
+	switch rp {
+	case RolloverRetire, RolloverRepeat, RolloverTaper:
+		return nil
+	}
+	return fmt.Errorf("invalid rollover policy: %s", rp)
+}
+
+// RewardPeriodRollover sets the rollover policy of a reward period. When a reward period reaches its end time it is
+// deactivated if it is retired, or if it has no rollover. A repeated reward period starts again with the same
+// duration and rewards, and a tapered one starts again with its rewards per second multiplied by the taper factor.
+type RewardPeriodRollover struct {
+	RewardType     string         `json:"reward_type" yaml:"reward_type"`
+	CollateralType string         `json:"collateral_type" yaml:"collateral_type"`
+	Policy         RolloverPolicy `json:"policy" yaml:"policy"`
+	TaperFactor    sdk.Dec        `json:"taper_factor" yaml:"taper_factor"` // only used by the taper policy
+}
+
+// NewRewardPeriodRollover returns a new RewardPeriodRollover
+func NewRewardPeriodRollover(rewardType, collateralType string, policy RolloverPolicy, taperFactor sdk.Dec) RewardPeriodRollover {
+	return RewardPeriodRollover{
+		RewardType:     rewardType,
+		CollateralType: collateralType,
+		Policy:         policy,
+		TaperFactor:    taperFactor,
+	}
+}
+
+// Validate performs a basic check of a RewardPeriodRollover
+func (rpr RewardPeriodRollover) Validate() error {
+	if err := validateRewardType(rpr.RewardType); err != nil {
+		return fmt.Errorf("invalid rollover reward type: %s", rpr.RewardType)
+	}
+	if strings.TrimSpace(rpr.CollateralType) == "" {
+		return fmt.Errorf("rollover collateral type cannot be blank for %s", rpr.RewardType)
+	}
+	if err := rpr.Policy.IsValid(); err != nil {
+		return err
+	}
+	if rpr.TaperFactor.IsNil() {
+		return fmt.Errorf("rollover taper factor cannot be nil for %s %s", rpr.RewardType, rpr.CollateralType)
+	}
+	if rpr.Policy == RolloverTaper && (!rpr.TaperFactor.IsPositive() || rpr.TaperFactor.GTE(sdk.OneDec())) {
+		return fmt.Errorf("rollover taper factor must be between 0 and 1, got %s", rpr.TaperFactor)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (rpr RewardPeriodRollover) String() string {
+	return fmt.Sprintf(`Reward Period Rollover:
+	Reward Type: %s,
+	Collateral Type: %s,
+	Policy: %s,
+	Taper Factor: %s,
+	`, rpr.RewardType, rpr.CollateralType, rpr.Policy, rpr.TaperFactor)
+}
+
+// RewardPeriodRollovers slice of RewardPeriodRollover
+type RewardPeriodRollovers []RewardPeriodRollover
+
+// Validate checks that each rollover is valid and that no reward period has more than one rollover
+func (rprs RewardPeriodRollovers) Validate() error {
+	seen := make(map[string]bool)
+	for _, rpr := range rprs {
+		if err := rpr.Validate(); err != nil {
+			return err
+		}
+		key := rpr.RewardType + "/" + rpr.CollateralType
+		if seen[key] {
+			return fmt.Errorf("duplicated rollover for %s reward period with collateral type %s", rpr.RewardType, rpr.CollateralType)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Get returns the rollover for the reward period with the input reward type and collateral type
+func (rprs RewardPeriodRollovers) Get(rewardType, collateralType string) (RewardPeriodRollover, bool) {
+	for _, rpr := range rprs {
+		if rpr.RewardType == rewardType && rpr.CollateralType == collateralType {
+			return rpr, true
+		}
+	}
+	return RewardPeriodRollover{}, false
+}
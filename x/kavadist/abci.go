@@ -9,4 +9,15 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 	if err != nil {
 		panic(err)
 	}
+	err = k.BurnPeriodCoins(ctx)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// EndBlocker routes the fee split of the block's fees to the kavadist account
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	if err := k.RouteFees(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -8,18 +8,25 @@ import (
 )
 
 const (
-	AttributeKeyInflation  = types.AttributeKeyInflation
-	AttributeKeyStatus     = types.AttributeKeyStatus
-	AttributeValueInactive = types.AttributeValueInactive
-	DefaultParamspace      = types.DefaultParamspace
-	EventTypeKavaDist      = types.EventTypeKavaDist
-	KavaDistMacc           = types.KavaDistMacc
-	ModuleName             = types.ModuleName
-	QuerierRoute           = types.QuerierRoute
-	QueryGetBalance        = types.QueryGetBalance
-	QueryGetParams         = types.QueryGetParams
-	RouterKey              = types.RouterKey
-	StoreKey               = types.StoreKey
+	AttributeKeyBurnAmount     = types.AttributeKeyBurnAmount
+	AttributeKeyBurnSource     = types.AttributeKeyBurnSource
+	AttributeKeyFeeSplitAmount = types.AttributeKeyFeeSplitAmount
+	AttributeKeyInflation      = types.AttributeKeyInflation
+	AttributeKeyStatus         = types.AttributeKeyStatus
+	AttributeValueInactive     = types.AttributeValueInactive
+	DefaultParamspace          = types.DefaultParamspace
+	EventTypeKavaDist          = types.EventTypeKavaDist
+	EventTypeKavaDistBurn      = types.EventTypeKavaDistBurn
+	EventTypeKavaDistFeeSplit  = types.EventTypeKavaDistFeeSplit
+	KavaDistMacc               = types.KavaDistMacc
+	ModuleName                 = types.ModuleName
+	QuerierRoute               = types.QuerierRoute
+	QueryGetBalance            = types.QueryGetBalance
+	QueryGetBurned             = types.QueryGetBurned
+	QueryGetFees               = types.QueryGetFees
+	QueryGetParams             = types.QueryGetParams
+	RouterKey                  = types.RouterKey
+	StoreKey                   = types.StoreKey
 )
 
 var (
@@ -28,6 +35,7 @@ var (
 	NewQuerier          = keeper.NewQuerier
 	DefaultGenesisState = types.DefaultGenesisState
 	DefaultParams       = types.DefaultParams
+	NewBurnPeriod       = types.NewBurnPeriod
 	NewGenesisState     = types.NewGenesisState
 	NewParams           = types.NewParams
 	NewPeriod           = types.NewPeriod
@@ -37,17 +45,26 @@ var (
 	// variable aliases
 	CurrentDistPeriodKey     = types.CurrentDistPeriodKey
 	DefaultActive            = types.DefaultActive
+	DefaultBurnPeriods       = types.DefaultBurnPeriods
+	DefaultFeeSplit          = types.DefaultFeeSplit
 	DefaultPeriods           = types.DefaultPeriods
 	DefaultPreviousBlockTime = types.DefaultPreviousBlockTime
 	GovDenom                 = types.GovDenom
 	KeyActive                = types.KeyActive
+	KeyBurnPeriods           = types.KeyBurnPeriods
+	KeyFeeSplit              = types.KeyFeeSplit
 	KeyPeriods               = types.KeyPeriods
 	ModuleCdc                = types.ModuleCdc
 	PreviousBlockTimeKey     = types.PreviousBlockTimeKey
+	PreviousBurnTimeKey      = types.PreviousBurnTimeKey
+	TotalBurnedKey           = types.TotalBurnedKey
+	TotalRoutedFeesKey       = types.TotalRoutedFeesKey
 )
 
 type (
 	Keeper       = keeper.Keeper
+	BurnPeriod   = types.BurnPeriod
+	BurnPeriods  = types.BurnPeriods
 	GenesisState = types.GenesisState
 	Params       = types.Params
 	Period       = types.Period
@@ -23,6 +23,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	kavadistQueryCmd.AddCommand(flags.GetCommands(
 		queryParamsCmd(queryRoute, cdc),
 		queryBalanceCmd(queryRoute, cdc),
+		queryBurnedCmd(queryRoute, cdc),
+		queryRoutedFeesCmd(queryRoute, cdc),
 	)...)
 
 	return kavadistQueryCmd
@@ -80,3 +82,53 @@ func queryBalanceCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func queryBurnedCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burned",
+		Short: "get the coins burned by kavadist burn periods",
+		Long:  "Get the total coins burned by kavadist burn periods.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBurned)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var coins sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &coins); err != nil {
+				return fmt.Errorf("failed to unmarshal burned coins: %w", err)
+			}
+			return cliCtx.PrintOutput(coins)
+		},
+	}
+}
+
+func queryRoutedFeesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "routed-fees",
+		Short: "get the tx fees routed to the kavadist account",
+		Long:  "Get the total tx fees routed to the kavadist account by the fee split.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetFees)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var coins sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &coins); err != nil {
+				return fmt.Errorf("failed to unmarshal routed fees: %w", err)
+			}
+			return cliCtx.PrintOutput(coins)
+		},
+	}
+}
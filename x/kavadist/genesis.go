@@ -20,6 +20,11 @@ func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs
 	if !gs.PreviousBlockTime.Equal(DefaultPreviousBlockTime) {
 		k.SetPreviousBlockTime(ctx, gs.PreviousBlockTime)
 	}
+	if !gs.PreviousBurnTime.IsZero() && !gs.PreviousBurnTime.Equal(DefaultPreviousBlockTime) {
+		k.SetPreviousBurnTime(ctx, gs.PreviousBurnTime)
+	}
+	k.SetTotalBurned(ctx, gs.TotalBurned)
+	k.SetTotalRoutedFees(ctx, gs.TotalRoutedFees)
 
 	// check if the module account exists
 	moduleAcc := supplyKeeper.GetModuleAccount(ctx, KavaDistMacc)
@@ -36,5 +41,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, previousBlockTime)
+	gs := NewGenesisState(params, previousBlockTime)
+	previousBurnTime, found := k.GetPreviousBurnTime(ctx)
+	if found {
+		gs.PreviousBurnTime = previousBurnTime
+	}
+	gs.TotalBurned = k.GetTotalBurned(ctx)
+	gs.TotalRoutedFees = k.GetTotalRoutedFees(ctx)
+	return gs
 }
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// InitializeBurnParams sets the burn periods param to its default if it has not been set, such as on chains that were
+// started before burn periods were added
+func (k Keeper) InitializeBurnParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyBurnPeriods) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyBurnPeriods, types.DefaultBurnPeriods)
+}
+
+// BurnPeriodCoins burns the coins scheduled by each burn period for the time since the previous burn. Burns are capped
+// at the balance of their source module account, and run whether or not inflation is active.
+func (k Keeper) BurnPeriodCoins(ctx sdk.Context) error {
+	previousBurnTime, found := k.GetPreviousBurnTime(ctx)
+	if !found {
+		k.SetPreviousBurnTime(ctx, ctx.BlockTime())
+		return nil
+	}
+
+	for _, bp := range k.GetParams(ctx).BurnPeriods {
+		start := bp.Start
+		if previousBurnTime.After(start) {
+			start = previousBurnTime
+		}
+		end := bp.End
+		if ctx.BlockTime().Before(end) {
+			end = ctx.BlockTime()
+		}
+		if !end.After(start) {
+			continue
+		}
+		timeElapsed := sdk.NewInt(end.Unix() - start.Unix())
+		if err := k.burnCoins(ctx, bp, bp.Rate.Amount.Mul(timeElapsed)); err != nil {
+			return err
+		}
+	}
+	k.SetPreviousBurnTime(ctx, ctx.BlockTime())
+	return nil
+}
+
+func (k Keeper) burnCoins(ctx sdk.Context, bp types.BurnPeriod, amount sdk.Int) error {
+	sourceAcc := k.supplyKeeper.GetModuleAccount(ctx, bp.Source)
+	if sourceAcc == nil {
+		k.Logger(ctx).Error("burn period source module account not found", "source", bp.Source)
+		return nil
+	}
+	amount = sdk.MinInt(amount, sourceAcc.GetCoins().AmountOf(bp.Rate.Denom))
+	if !amount.IsPositive() {
+		return nil
+	}
+	burned := sdk.NewCoins(sdk.NewCoin(bp.Rate.Denom, amount))
+
+	if bp.Source != types.KavaDistMacc {
+		if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, bp.Source, types.KavaDistMacc, burned); err != nil {
+			return err
+		}
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.KavaDistMacc, burned); err != nil {
+		return err
+	}
+	k.SetTotalBurned(ctx, k.GetTotalBurned(ctx).Add(burned...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeKavaDistBurn,
+			sdk.NewAttribute(types.AttributeKeyBurnAmount, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyBurnSource, bp.Source),
+		),
+	)
+	return nil
+}
+
+// GetPreviousBurnTime get the time coins were last burned
+func (k Keeper) GetPreviousBurnTime(ctx sdk.Context) (burnTime time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBurnTimeKey)
+	b := store.Get([]byte{})
+	if b == nil {
+		return time.Time{}, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &burnTime)
+	return burnTime, true
+}
+
+// SetPreviousBurnTime set the time coins were last burned
+func (k Keeper) SetPreviousBurnTime(ctx sdk.Context, burnTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBurnTimeKey)
+	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(burnTime))
+}
+
+// GetTotalBurned returns the total coins burned by burn periods
+func (k Keeper) GetTotalBurned(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBurnedKey)
+	b := store.Get([]byte{})
+	if b == nil {
+		return sdk.Coins{}
+	}
+	var burned sdk.Coins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &burned)
+	return burned
+}
+
+// SetTotalBurned sets the total coins burned by burn periods
+func (k Keeper) SetTotalBurned(ctx sdk.Context, burned sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalBurnedKey)
+	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(burned))
+}
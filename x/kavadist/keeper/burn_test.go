@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/kavadist/keeper"
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func (suite *KeeperTestSuite) TestBurnPeriodCoins() {
+	start := time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.BurnPeriods = types.BurnPeriods{
+		types.NewBurnPeriod(start, start.Add(100*time.Second), sdk.NewCoin("ukava", sdk.NewInt(1000)), types.KavaDistMacc),
+		types.NewBurnPeriod(start, start.Add(100*time.Second), sdk.NewCoin("ukava", sdk.NewInt(1000)), "missing"),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.supplyKeeper.MintCoins(suite.ctx, types.KavaDistMacc, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50000)))))
+	initialSupply := suite.supplyKeeper.GetSupply(suite.ctx).GetTotal().AmountOf("ukava")
+
+	// the first burn only sets the previous burn time
+	ctx := suite.ctx.WithBlockTime(start.Add(-50 * time.Second))
+	suite.Require().NoError(suite.keeper.BurnPeriodCoins(ctx))
+	suite.Require().Empty(suite.keeper.GetTotalBurned(ctx))
+
+	// coins are only burned for the time since the period started
+	ctx = ctx.WithBlockTime(start.Add(10 * time.Second))
+	suite.Require().NoError(suite.keeper.BurnPeriodCoins(ctx))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10000))), suite.keeper.GetTotalBurned(ctx))
+	suite.Require().Equal(initialSupply.SubRaw(10000), suite.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf("ukava"))
+
+	// burns are capped at the balance of the source account
+	ctx = ctx.WithBlockTime(start.Add(200 * time.Second))
+	suite.Require().NoError(suite.keeper.BurnPeriodCoins(ctx))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50000))), suite.keeper.GetTotalBurned(ctx))
+	suite.Require().True(suite.supplyKeeper.GetModuleAccount(ctx, types.KavaDistMacc).GetCoins().AmountOf("ukava").IsZero())
+
+	querier := keeper.NewQuerier(suite.keeper)
+	bz, err := querier(ctx, []string{types.QueryGetBurned}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+	var burned sdk.Coins
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &burned))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(50000))), burned)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+// InitializeFeeSplitParams sets the fee split param to its default if it has not been set, such as on chains that were
+// started before fee splits were added
+func (k Keeper) InitializeFeeSplitParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyFeeSplit) {
+		return
+	}
+	k.paramSubspace.Set(ctx, types.KeyFeeSplit, types.DefaultFeeSplit)
+}
+
+// RouteFees sends the fee split of the fees collected in the block to the kavadist account. It runs at the end of the
+// block, before the distribution module allocates the remaining fees to validators at the start of the next block.
+func (k Keeper) RouteFees(ctx sdk.Context) error {
+	feeSplit := k.GetParams(ctx).GetFeeSplit()
+	if feeSplit.IsZero() {
+		return nil
+	}
+	feeCollector := k.supplyKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+	if feeCollector == nil {
+		return nil
+	}
+
+	routed := sdk.NewCoins()
+	for _, coin := range feeCollector.GetCoins() {
+		amount := coin.Amount.ToDec().Mul(feeSplit).TruncateInt()
+		if amount.IsPositive() {
+			routed = routed.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	if routed.IsZero() {
+		return nil
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.KavaDistMacc, routed); err != nil {
+		return err
+	}
+	k.SetTotalRoutedFees(ctx, k.GetTotalRoutedFees(ctx).Add(routed...))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeKavaDistFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyFeeSplitAmount, routed.String()),
+		),
+	)
+	return nil
+}
+
+// GetTotalRoutedFees returns the total fees routed to the kavadist account by the fee split
+func (k Keeper) GetTotalRoutedFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalRoutedFeesKey)
+	b := store.Get([]byte{})
+	if b == nil {
+		return sdk.Coins{}
+	}
+	var routed sdk.Coins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(b, &routed)
+	return routed
+}
+
+// SetTotalRoutedFees sets the total fees routed to the kavadist account by the fee split
+func (k Keeper) SetTotalRoutedFees(ctx sdk.Context, routed sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalRoutedFeesKey)
+	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(routed))
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/kavadist/keeper"
+	"github.com/kava-labs/kava/x/kavadist/types"
+)
+
+func (suite *KeeperTestSuite) TestRouteFees() {
+	fees := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1005)), sdk.NewCoin("usdx", sdk.NewInt(3)))
+	suite.Require().NoError(suite.supplyKeeper.MintCoins(suite.ctx, types.KavaDistMacc, fees))
+	suite.Require().NoError(suite.supplyKeeper.SendCoinsFromModuleToModule(suite.ctx, types.KavaDistMacc, authtypes.FeeCollectorName, fees))
+
+	// no fees are routed by default
+	suite.Require().NoError(suite.keeper.RouteFees(suite.ctx))
+	suite.Require().Equal(fees, suite.supplyKeeper.GetModuleAccount(suite.ctx, authtypes.FeeCollectorName).GetCoins())
+	suite.Require().Empty(suite.keeper.GetTotalRoutedFees(suite.ctx))
+
+	// the split is rounded down, so small fees may not be routed
+	params := suite.keeper.GetParams(suite.ctx)
+	params.FeeSplit = sdk.MustNewDecFromStr("0.1")
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().NoError(suite.keeper.RouteFees(suite.ctx))
+	routed := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100)))
+	suite.Require().Equal(routed, suite.supplyKeeper.GetModuleAccount(suite.ctx, types.KavaDistMacc).GetCoins())
+	suite.Require().Equal(fees.Sub(routed), suite.supplyKeeper.GetModuleAccount(suite.ctx, authtypes.FeeCollectorName).GetCoins())
+	suite.Require().Equal(routed, suite.keeper.GetTotalRoutedFees(suite.ctx))
+
+	// routed fees accumulate
+	suite.Require().NoError(suite.keeper.RouteFees(suite.ctx))
+	routed = routed.Add(sdk.NewCoin("ukava", sdk.NewInt(90)))
+	suite.Require().Equal(routed, suite.keeper.GetTotalRoutedFees(suite.ctx))
+
+	querier := keeper.NewQuerier(suite.keeper)
+	bz, err := querier(suite.ctx, []string{types.QueryGetFees}, abci.RequestQuery{})
+	suite.Require().NoError(err)
+	var queried sdk.Coins
+	suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(bz, &queried))
+	suite.Require().Equal(routed, queried)
+}
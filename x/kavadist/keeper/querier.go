@@ -18,6 +18,10 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, k)
 		case types.QueryGetBalance:
 			return queryGetBalance(ctx, req, k)
+		case types.QueryGetBurned:
+			return queryGetBurned(ctx, req, k)
+		case types.QueryGetFees:
+			return queryGetFees(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -48,3 +52,23 @@ func queryGetBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte,
 
 	return bz, nil
 }
+
+// queryGetBurned returns the total coins burned by burn periods
+func queryGetBurned(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetTotalBurned(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryGetFees returns the total fees routed to the kavadist account by the fee split
+func queryGetFees(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(k.cdc, k.GetTotalRoutedFees(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
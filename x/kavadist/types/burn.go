@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BurnPeriod stores the start and end dates of a scheduled burn, the coins burned each second, and the name of the
+// module account the coins are burned from
+type BurnPeriod struct {
+	Start  time.Time `json:"start" yaml:"start"`   // example "2020-03-01T15:20:00Z"
+	End    time.Time `json:"end" yaml:"end"`       // example "2020-06-01T15:20:00Z"
+	Rate   sdk.Coin  `json:"rate" yaml:"rate"`     // example {"denom": "ukava", "amount": "1000"} - coins burned per second
+	Source string    `json:"source" yaml:"source"` // example "kavadist"
+}
+
+// NewBurnPeriod returns a new instance of BurnPeriod
+func NewBurnPeriod(start time.Time, end time.Time, rate sdk.Coin, source string) BurnPeriod {
+	return BurnPeriod{
+		Start:  start,
+		End:    end,
+		Rate:   rate,
+		Source: source,
+	}
+}
+
+// Validate performs a basic check of a BurnPeriod
+func (bp BurnPeriod) Validate() error {
+	if bp.Start.Unix() <= 0 || bp.End.Unix() <= 0 {
+		return fmt.Errorf("start or end time cannot be zero: %s", bp)
+	}
+	if !bp.End.After(bp.Start) {
+		return fmt.Errorf("end time for burn period must be after start time: %s", bp)
+	}
+	if !bp.Rate.IsValid() || !bp.Rate.IsPositive() {
+		return fmt.Errorf("burn period rate must be positive: %s", bp.Rate)
+	}
+	if strings.TrimSpace(bp.Source) == "" {
+		return fmt.Errorf("burn period source cannot be blank: %s", bp)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (bp BurnPeriod) String() string {
+	return fmt.Sprintf(`Burn Period:
+	Start: %s
+	End: %s
+	Rate: %s
+	Source: %s`, bp.Start, bp.End, bp.Rate, bp.Source)
+}
+
+// BurnPeriods array of BurnPeriod
+type BurnPeriods []BurnPeriod
+
+// Validate checks that each burn period is valid
+func (bps BurnPeriods) Validate() error {
+	for _, bp := range bps {
+		if err := bp.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (bps BurnPeriods) String() string {
+	out := "Burn Periods\n"
+	for _, bp := range bps {
+		out += fmt.Sprintf("%s\n", bp)
+	}
+	return out
+}
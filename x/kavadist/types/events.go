@@ -6,4 +6,11 @@ const (
 	AttributeKeyInflation  = "kava_dist_inflation"
 	AttributeKeyStatus     = "kava_dist_status"
 	AttributeValueInactive = "inactive"
+
+	EventTypeKavaDistBurn  = "kavadist_burn"
+	AttributeKeyBurnAmount = "burn_amount"
+	AttributeKeyBurnSource = "burn_source"
+
+	EventTypeKavaDistFeeSplit  = "kavadist_fee_split"
+	AttributeKeyFeeSplitAmount = "fee_split_amount"
 )
@@ -12,4 +12,5 @@ type SupplyKeeper interface {
 	GetSupply(ctx sdk.Context) (supply exported.SupplyI)
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) error
 }
@@ -4,19 +4,27 @@ import (
 	"bytes"
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
 	Params            Params    `json:"params" yaml:"params"`
 	PreviousBlockTime time.Time `json:"previous_block_time" yaml:"previous_block_time"`
+	PreviousBurnTime  time.Time `json:"previous_burn_time" yaml:"previous_burn_time"`
+	TotalBurned       sdk.Coins `json:"total_burned" yaml:"total_burned"`
+	TotalRoutedFees   sdk.Coins `json:"total_routed_fees" yaml:"total_routed_fees"`
 }
 
-// NewGenesisState returns a new genesis state
+// NewGenesisState returns a new genesis state that has not burned any coins or routed any fees
 func NewGenesisState(params Params, previousBlockTime time.Time) GenesisState {
 	return GenesisState{
 		Params:            params,
 		PreviousBlockTime: previousBlockTime,
+		PreviousBurnTime:  DefaultPreviousBlockTime,
+		TotalBurned:       sdk.Coins{},
+		TotalRoutedFees:   sdk.Coins{},
 	}
 }
 
@@ -25,6 +33,9 @@ func DefaultGenesisState() GenesisState {
 	return GenesisState{
 		Params:            DefaultParams(),
 		PreviousBlockTime: DefaultPreviousBlockTime,
+		PreviousBurnTime:  DefaultPreviousBlockTime,
+		TotalBurned:       sdk.Coins{},
+		TotalRoutedFees:   sdk.Coins{},
 	}
 }
 
@@ -38,6 +49,12 @@ func (gs GenesisState) Validate() error {
 	if gs.PreviousBlockTime.Equal(time.Time{}) {
 		return fmt.Errorf("previous block time not set")
 	}
+	if !gs.TotalBurned.IsValid() {
+		return fmt.Errorf("invalid total burned coins: %s", gs.TotalBurned)
+	}
+	if !gs.TotalRoutedFees.IsValid() {
+		return fmt.Errorf("invalid total routed fees: %s", gs.TotalRoutedFees)
+	}
 	return nil
 }
 
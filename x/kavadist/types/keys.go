@@ -23,4 +23,7 @@ const (
 var (
 	CurrentDistPeriodKey = []byte{0x00}
 	PreviousBlockTimeKey = []byte{0x01}
+	PreviousBurnTimeKey  = []byte{0x02}
+	TotalBurnedKey       = []byte{0x03}
+	TotalRoutedFeesKey   = []byte{0x04}
 )
@@ -16,16 +16,22 @@ import (
 var (
 	KeyActive                = []byte("Active")
 	KeyPeriods               = []byte("Periods")
+	KeyBurnPeriods           = []byte("BurnPeriods")
+	KeyFeeSplit              = []byte("FeeSplit")
 	DefaultActive            = false
 	DefaultPeriods           = Periods{}
+	DefaultBurnPeriods       = BurnPeriods{}
+	DefaultFeeSplit          = sdk.ZeroDec()
 	DefaultPreviousBlockTime = tmtime.Canonical(time.Unix(1, 0))
 	GovDenom                 = cdptypes.DefaultGovDenom
 )
 
 // Params governance parameters for kavadist module
 type Params struct {
-	Active  bool    `json:"active" yaml:"active"`
-	Periods Periods `json:"periods" yaml:"periods"`
+	Active      bool        `json:"active" yaml:"active"`
+	Periods     Periods     `json:"periods" yaml:"periods"`
+	BurnPeriods BurnPeriods `json:"burn_periods" yaml:"burn_periods"`
+	FeeSplit    sdk.Dec     `json:"fee_split" yaml:"fee_split"` // fraction of tx fees routed to the kavadist account
 }
 
 // Period stores the specified start and end dates, and the inflation, expressed as a decimal representing the yearly APR of KAVA tokens that will be minted during that period
@@ -64,11 +70,12 @@ func (prs Periods) String() string {
 	return out
 }
 
-// NewParams returns a new params object
+// NewParams returns a new params object with no burn periods that routes no fees
 func NewParams(active bool, periods Periods) Params {
 	return Params{
-		Active:  active,
-		Periods: periods,
+		Active:   active,
+		Periods:  periods,
+		FeeSplit: DefaultFeeSplit,
 	}
 }
 
@@ -81,7 +88,9 @@ func DefaultParams() Params {
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
 	Active: %t
-	Periods %s`, p.Active, p.Periods)
+	Periods %s
+	Burn Periods %s
+	Fee Split: %s`, p.Active, p.Periods, p.BurnPeriods, p.FeeSplit)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -94,6 +103,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyActive, &p.Active, validateActiveParam),
 		params.NewParamSetPair(KeyPeriods, &p.Periods, validatePeriodsParams),
+		params.NewParamSetPair(KeyBurnPeriods, &p.BurnPeriods, validateBurnPeriodsParams),
+		params.NewParamSetPair(KeyFeeSplit, &p.FeeSplit, validateFeeSplitParam),
 	}
 }
 
@@ -103,7 +114,23 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validatePeriodsParams(p.Periods)
+	if err := validatePeriodsParams(p.Periods); err != nil {
+		return err
+	}
+
+	if err := validateBurnPeriodsParams(p.BurnPeriods); err != nil {
+		return err
+	}
+
+	return validateFeeSplitParam(p.FeeSplit)
+}
+
+// GetFeeSplit returns the fraction of tx fees routed to the kavadist account, zero if it is unset
+func (p Params) GetFeeSplit() sdk.Dec {
+	if p.FeeSplit.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.FeeSplit
 }
 
 func validateActiveParam(i interface{}) error {
@@ -141,3 +168,29 @@ func validatePeriodsParams(i interface{}) error {
 
 	return nil
 }
+
+func validateBurnPeriodsParams(i interface{}) error {
+	burnPeriods, ok := i.(BurnPeriods)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return burnPeriods.Validate()
+}
+
+func validateFeeSplitParam(i interface{}) error {
+	feeSplit, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// genesis files exported before the fee split was added do not set it
+	if feeSplit.IsNil() {
+		return nil
+	}
+
+	if feeSplit.IsNegative() || feeSplit.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee split must be between 0 and 1: %s", feeSplit)
+	}
+
+	return nil
+}
@@ -96,6 +96,60 @@ func (suite *ParamTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamTestSuite) TestBurnPeriodValidation() {
+	start := time.Date(2021, time.March, 1, 1, 0, 0, 0, time.UTC)
+	rate := sdk.NewCoin("ukava", sdk.NewInt(1000))
+	testCases := []struct {
+		name       string
+		burnPeriod types.BurnPeriod
+		expectPass bool
+	}{
+		{"valid", types.NewBurnPeriod(start, start.Add(time.Hour), rate, types.KavaDistMacc), true},
+		{"end before start", types.NewBurnPeriod(start, start.Add(-time.Hour), rate, types.KavaDistMacc), false},
+		{"zero start", types.NewBurnPeriod(time.Time{}, start, rate, types.KavaDistMacc), false},
+		{"zero rate", types.NewBurnPeriod(start, start.Add(time.Hour), sdk.NewCoin("ukava", sdk.ZeroInt()), types.KavaDistMacc), false},
+		{"blank source", types.NewBurnPeriod(start, start.Add(time.Hour), rate, " "), false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.BurnPeriods = types.BurnPeriods{tc.burnPeriod}
+			err := params.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *ParamTestSuite) TestFeeSplitValidation() {
+	testCases := []struct {
+		name       string
+		feeSplit   sdk.Dec
+		expectPass bool
+	}{
+		{"zero", sdk.ZeroDec(), true},
+		{"one", sdk.OneDec(), true},
+		{"unset", sdk.Dec{}, true},
+		{"negative", sdk.MustNewDecFromStr("-0.1"), false},
+		{"greater than one", sdk.MustNewDecFromStr("1.1"), false},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.DefaultParams()
+			params.FeeSplit = tc.feeSplit
+			err := params.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamTestSuite))
 }
@@ -4,4 +4,6 @@ package types
 const (
 	QueryGetParams  = "params"
 	QueryGetBalance = "balance"
+	QueryGetBurned  = "burned"
+	QueryGetFees    = "routed-fees"
 )
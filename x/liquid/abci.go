@@ -0,0 +1,17 @@
+package liquid
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker compounds staking rewards, undelegates the queued unbonding batch, and posts the derivative price
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.CompoundRewards(ctx)
+	k.ProcessUnbondingBatch(ctx)
+	k.UpdateDerivativePrice(ctx)
+}
+
+// EndBlocker pays out unbonding records whose batch finished unbonding in the staking end blocker
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.PayoutUnbondingRecords(ctx)
+}
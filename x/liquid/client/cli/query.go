@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// flags for cli queries
+const (
+	flagOwner = "owner"
+)
+
+// GetQueryCmd returns the cli query commands for the liquid module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	liquidQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the liquid module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	liquidQueryCmd.AddCommand(flags.GetCommands(
+		queryParamsCmd(queryRoute, cdc),
+		queryDerivativeValueCmd(queryRoute, cdc),
+		queryUnbondingRecordsCmd(queryRoute, cdc),
+	)...)
+
+	return liquidQueryCmd
+}
+
+func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the liquid module parameters",
+		Long:  "Get the current liquid module parameters, including the validator allowlist and unbonding batch interval.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetParams)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func queryDerivativeValueCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "derivative-value",
+		Short: "get the staked kava backing the derivative supply",
+		Long:  "Get the total kava staked by the liquid module, the derivative supply, and the exchange rate between them.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDerivativeValue)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var value types.DerivativeValue
+			if err := cdc.UnmarshalJSON(res, &value); err != nil {
+				return fmt.Errorf("failed to unmarshal derivative value: %w", err)
+			}
+			return cliCtx.PrintOutput(value)
+		},
+	}
+}
+
+func queryUnbondingRecordsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-records",
+		Short: "query liquid module unbonding records with optional filters",
+		Long: strings.TrimSpace(`query for all unbonding records or the records of an owner using flags:
+
+		Example:
+		$ kvcli q liquid unbonding-records
+		$ kvcli q liquid unbonding-records --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+			if ownerBech := viper.GetString(flagOwner); len(ownerBech) != 0 {
+				recordOwner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				owner = recordOwner
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			params := types.NewQueryUnbondingRecordsParams(page, limit, owner)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetUnbondingRecords)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var records types.UnbondingRecords
+			if err := cdc.UnmarshalJSON(res, &records); err != nil {
+				return fmt.Errorf("failed to unmarshal unbonding records: %w", err)
+			}
+			return cliCtx.PrintOutput(records)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+	cmd.Flags().String(flagOwner, "", "(optional) filter for unbonding records by owner address")
+	return cmd
+}
@@ -0,0 +1,35 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// REST variable names
+// nolint
+const (
+	RestOwner = "owner"
+)
+
+// RegisterRoutes registers liquid-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
+
+// PostMintDerivativeReq defines the properties of a mint derivative request's body
+type PostMintDerivativeReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// PostBurnDerivativeReq defines the properties of a burn derivative request's body
+type PostBurnDerivativeReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+}
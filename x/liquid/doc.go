@@ -0,0 +1,10 @@
+/*
+Package liquid implements liquid staking of kava through the bkava staking derivative.
+
+Kava sent to the module is delegated across a governance allowlist of validators and the sender receives bkava,
+a fungible coin whose exchange rate into staked kava grows as the module compounds its staking rewards. Burning
+bkava queues the kava it is worth for unbonding; queued kava is undelegated in periodic batches and paid out once
+the staking unbonding period ends. The module posts the price of bkava to the pricefeed so it can be listed as
+hard and cdp collateral.
+*/
+package liquid
@@ -0,0 +1,46 @@
+package liquid
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, record := range gs.UnbondingRecords {
+		k.SetUnbondingRecord(ctx, record)
+	}
+	k.SetNextUnbondingID(ctx, gs.NextUnbondingID)
+	k.SetPendingUnbonding(ctx, gs.PendingUnbonding)
+	// a zero batch time means the next batch can be undelegated from the first block
+	if !gs.NextBatchTime.IsZero() {
+		k.SetNextBatchTime(ctx, gs.NextBatchTime)
+	}
+
+	// check if the module account exists
+	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleAccountName))
+	}
+}
+
+// ExportGenesis export genesis state for liquid module
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	nextBatchTime, _ := k.GetNextBatchTime(ctx)
+	return NewGenesisState(
+		k.GetParams(ctx),
+		k.GetAllUnbondingRecords(ctx),
+		k.GetNextUnbondingID(ctx),
+		k.GetPendingUnbonding(ctx),
+		nextBatchTime,
+	)
+}
@@ -0,0 +1,60 @@
+package liquid
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// NewHandler creates an sdk.Handler for liquid messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case types.MsgMintDerivative:
+			return handleMsgMintDerivative(ctx, k, msg)
+		case types.MsgBurnDerivative:
+			return handleMsgBurnDerivative(ctx, k, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
+		}
+	}
+}
+
+func handleMsgMintDerivative(ctx sdk.Context, k keeper.Keeper, msg types.MsgMintDerivative) (*sdk.Result, error) {
+	_, err := k.MintDerivative(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgBurnDerivative(ctx sdk.Context, k keeper.Keeper, msg types.MsgBurnDerivative) (*sdk.Result, error) {
+	_, err := k.BurnDerivative(ctx, msg.Sender, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender.String()),
+		),
+	)
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetTotalStaked returns the amount of kava backing the derivative supply: the tokens of every module delegation
+// plus the module account's free balance, less the kava queued for unbonding
+func (k Keeper) GetTotalStaked(ctx sdk.Context) sdk.Int {
+	total := k.getDelegatedTokens(ctx).Add(k.getModuleBalance(ctx)).Sub(k.GetPendingUnbonding(ctx))
+	return sdk.MaxInt(sdk.ZeroInt(), total)
+}
+
+// GetDerivativeSupply returns the total supply of the derivative coin
+func (k Keeper) GetDerivativeSupply(ctx sdk.Context) sdk.Int {
+	return k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(types.DerivativeDenom)
+}
+
+// GetExchangeRate returns the amount of staked kava each derivative coin is worth
+func (k Keeper) GetExchangeRate(ctx sdk.Context) sdk.Dec {
+	supply := k.GetDerivativeSupply(ctx)
+	if supply.IsZero() {
+		return sdk.OneDec()
+	}
+	return k.GetTotalStaked(ctx).ToDec().Quo(supply.ToDec())
+}
+
+// GetDerivativeValue returns the amount of kava backing the derivative supply
+func (k Keeper) GetDerivativeValue(ctx sdk.Context) types.DerivativeValue {
+	return types.NewDerivativeValue(k.GetTotalStaked(ctx), k.GetDerivativeSupply(ctx), k.GetExchangeRate(ctx))
+}
+
+// MintDerivative stakes kava with the least delegated allowlisted validator and mints derivative coins to the sender
+// at the current exchange rate
+func (k Keeper) MintDerivative(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (sdk.Coin, error) {
+	if amount.Denom != k.stakingKeeper.BondDenom(ctx) {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s, expected %s", amount.Denom, k.stakingKeeper.BondDenom(ctx))
+	}
+
+	// the exchange rate is calculated before the sender's kava reaches the module account
+	derivativeAmount := amount.Amount
+	supply := k.GetDerivativeSupply(ctx)
+	totalStaked := k.GetTotalStaked(ctx)
+	if supply.IsPositive() && totalStaked.IsPositive() {
+		derivativeAmount = amount.Amount.Mul(supply).Quo(totalStaked)
+	}
+	if !derivativeAmount.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidAmount, "%s is worth less than one %s", amount, types.DerivativeDenom)
+	}
+
+	validator, found := k.getDelegationTarget(ctx)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidators
+	}
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.delegate(ctx, validator, amount.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	derivative := sdk.NewCoin(types.DerivativeDenom, derivativeAmount)
+	if err := k.supplyKeeper.MintCoins(ctx, types.ModuleAccountName, sdk.NewCoins(derivative)); err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, sender, sdk.NewCoins(derivative))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMintDerivative,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDerivative, derivative.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+		),
+	)
+	return derivative, nil
+}
+
+// BurnDerivative burns derivative coins and queues the staked kava they are worth for the next unbonding batch.
+// The kava is paid to the sender once the batch finishes unbonding.
+func (k Keeper) BurnDerivative(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) (types.UnbondingRecord, error) {
+	if amount.Denom != types.DerivativeDenom {
+		return types.UnbondingRecord{}, sdkerrors.Wrapf(types.ErrInvalidDenom, "%s, expected %s", amount.Denom, types.DerivativeDenom)
+	}
+
+	supply := k.GetDerivativeSupply(ctx)
+	if amount.Amount.GT(supply) {
+		return types.UnbondingRecord{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "%s exceeds derivative supply %s", amount, supply)
+	}
+	value := amount.Amount.Mul(k.GetTotalStaked(ctx)).Quo(supply)
+	if !value.IsPositive() {
+		return types.UnbondingRecord{}, sdkerrors.Wrapf(types.ErrInvalidAmount, "%s is worth less than one %s", amount, k.stakingKeeper.BondDenom(ctx))
+	}
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleAccountName, sdk.NewCoins(amount))
+	if err != nil {
+		return types.UnbondingRecord{}, err
+	}
+	if err := k.supplyKeeper.BurnCoins(ctx, types.ModuleAccountName, sdk.NewCoins(amount)); err != nil {
+		return types.UnbondingRecord{}, err
+	}
+
+	id := k.GetNextUnbondingID(ctx)
+	record := types.NewUnbondingRecord(id, sender, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), value))
+	k.SetUnbondingRecord(ctx, record)
+	k.SetNextUnbondingID(ctx, id+1)
+	k.SetPendingUnbonding(ctx, k.GetPendingUnbonding(ctx).Add(value))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBurnDerivative,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, record.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDerivative, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyUnbondingID, sdk.NewUint(id).String()),
+		),
+	)
+	return record, nil
+}
+
+// getDelegationTarget returns the allowlisted validator that the module has delegated the fewest tokens to,
+// skipping validators that do not exist or are jailed
+func (k Keeper) getDelegationTarget(ctx sdk.Context) (staking.Validator, bool) {
+	delegated := k.getDelegatedTokensByValidator(ctx)
+
+	var target staking.Validator
+	minTokens := sdk.ZeroInt()
+	found := false
+	for _, valAddr := range k.GetParams(ctx).Validators {
+		validator, f := k.stakingKeeper.GetValidator(ctx, valAddr)
+		if !f || validator.IsJailed() {
+			continue
+		}
+		tokens, ok := delegated[valAddr.String()]
+		if !ok {
+			tokens = sdk.ZeroInt()
+		}
+		if !found || tokens.LT(minTokens) {
+			target = validator
+			minTokens = tokens
+			found = true
+		}
+	}
+	return target, found
+}
+
+// delegate bonds kava from the module account to a validator
+func (k Keeper) delegate(ctx sdk.Context, validator staking.Validator, amount sdk.Int) error {
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	_, err := k.stakingKeeper.Delegate(ctx, moduleAddr, amount, sdk.Unbonded, validator, true)
+	return err
+}
+
+// getDelegations returns the module account's delegations
+func (k Keeper) getDelegations(ctx sdk.Context) []staking.Delegation {
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	return k.stakingKeeper.GetDelegatorDelegations(ctx, moduleAddr, math.MaxUint16)
+}
+
+// getDelegatedTokensByValidator returns the tokens the module account has delegated to each validator, keyed by
+// validator address
+func (k Keeper) getDelegatedTokensByValidator(ctx sdk.Context) map[string]sdk.Int {
+	delegated := make(map[string]sdk.Int)
+	for _, delegation := range k.getDelegations(ctx) {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+		delegated[delegation.ValidatorAddress.String()] = validator.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+	return delegated
+}
+
+// getDelegatedTokens returns the total tokens delegated by the module account
+func (k Keeper) getDelegatedTokens(ctx sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, tokens := range k.getDelegatedTokensByValidator(ctx) {
+		total = total.Add(tokens)
+	}
+	return total
+}
+
+// getModuleBalance returns the module account's balance of the bond denom
+func (k Keeper) getModuleBalance(ctx sdk.Context) sdk.Int {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName).GetCoins().AmountOf(k.stakingKeeper.BondDenom(ctx))
+}
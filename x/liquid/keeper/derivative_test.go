@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+func (suite *KeeperTestSuite) TestMintDerivative() {
+	// the first mint is 1:1 and delegates to the first allowlisted validator
+	derivative, err := suite.keeper.MintDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(types.DerivativeDenom, sdk.NewInt(100*KAVA_CF)), derivative)
+	suite.Require().Equal(sdk.NewInt(100*KAVA_CF), suite.getAccount(suite.addrs[0]).GetCoins().AmountOf(types.DerivativeDenom))
+	suite.Require().Equal(sdk.NewInt(100*KAVA_CF), suite.getDelegatedTokens(suite.validatorAddrs[0]))
+
+	// later mints go to the least delegated validator
+	_, err = suite.keeper.MintDerivative(suite.ctx, suite.addrs[1], sdk.NewCoin("ukava", sdk.NewInt(50*KAVA_CF)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(50*KAVA_CF), suite.getDelegatedTokens(suite.validatorAddrs[1]))
+	suite.Require().Equal(sdk.NewInt(150*KAVA_CF), suite.keeper.GetTotalStaked(suite.ctx))
+
+	_, err = suite.keeper.MintDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("usdx", sdk.NewInt(KAVA_CF)))
+	suite.Require().True(errors.Is(err, types.ErrInvalidDenom))
+}
+
+func (suite *KeeperTestSuite) TestCompoundRewards() {
+	_, err := suite.keeper.MintDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	suite.Require().NoError(err)
+
+	// kava sent to the module account raises the exchange rate and is staked by the next compound
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.SendCoinsFromAccountToModule(suite.ctx, suite.addrs[1], types.ModuleAccountName, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.1"), suite.keeper.GetExchangeRate(suite.ctx))
+
+	suite.keeper.CompoundRewards(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(10*KAVA_CF), suite.getDelegatedTokens(suite.validatorAddrs[1]))
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.1"), suite.keeper.GetExchangeRate(suite.ctx))
+
+	// new mints receive fewer derivative coins at the higher exchange rate
+	derivative, err := suite.keeper.MintDerivative(suite.ctx, suite.addrs[1], sdk.NewCoin("ukava", sdk.NewInt(11*KAVA_CF)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(types.DerivativeDenom, sdk.NewInt(10*KAVA_CF)), derivative)
+}
+
+func (suite *KeeperTestSuite) TestBurnDerivative() {
+	_, err := suite.keeper.MintDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	suite.Require().NoError(err)
+
+	record, err := suite.keeper.BurnDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin(types.DerivativeDenom, sdk.NewInt(40*KAVA_CF)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("ukava", sdk.NewInt(40*KAVA_CF)), record.Amount)
+	suite.Require().True(record.IsQueued())
+	suite.Require().Equal(sdk.NewInt(40*KAVA_CF), suite.keeper.GetPendingUnbonding(suite.ctx))
+	// queued kava no longer backs the remaining derivative supply
+	suite.Require().Equal(sdk.NewInt(60*KAVA_CF), suite.keeper.GetTotalStaked(suite.ctx))
+	suite.Require().Equal(sdk.OneDec(), suite.keeper.GetExchangeRate(suite.ctx))
+
+	_, err = suite.keeper.BurnDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("ukava", sdk.NewInt(KAVA_CF)))
+	suite.Require().True(errors.Is(err, types.ErrInvalidDenom))
+}
+
+func (suite *KeeperTestSuite) TestUnbondingBatch() {
+	_, err := suite.keeper.MintDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin("ukava", sdk.NewInt(100*KAVA_CF)))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.BurnDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin(types.DerivativeDenom, sdk.NewInt(40*KAVA_CF)))
+	suite.Require().NoError(err)
+
+	suite.keeper.ProcessUnbondingBatch(suite.ctx)
+	suite.Require().True(suite.keeper.GetPendingUnbonding(suite.ctx).IsZero())
+	suite.Require().Equal(sdk.NewInt(60*KAVA_CF), suite.getDelegatedTokens(suite.validatorAddrs[0]))
+
+	stakingKeeper := suite.app.GetStakingKeeper()
+	completionTime := suite.ctx.BlockTime().Add(stakingKeeper.UnbondingTime(suite.ctx))
+	record, found := suite.keeper.GetUnbondingRecord(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(completionTime, record.CompletionTime)
+
+	// burns after a batch wait for the batch interval before being undelegated
+	_, err = suite.keeper.BurnDerivative(suite.ctx, suite.addrs[0], sdk.NewCoin(types.DerivativeDenom, sdk.NewInt(10*KAVA_CF)))
+	suite.Require().NoError(err)
+	suite.keeper.ProcessUnbondingBatch(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(10*KAVA_CF), suite.keeper.GetPendingUnbonding(suite.ctx))
+
+	// records are paid once staking completes the undelegation
+	balancePrior := suite.getAccount(suite.addrs[0]).GetCoins().AmountOf("ukava")
+	suite.ctx = suite.ctx.WithBlockTime(completionTime)
+	staking.EndBlocker(suite.ctx, stakingKeeper)
+	suite.keeper.PayoutUnbondingRecords(suite.ctx)
+
+	suite.Require().Equal(balancePrior.Add(sdk.NewInt(40*KAVA_CF)), suite.getAccount(suite.addrs[0]).GetCoins().AmountOf("ukava"))
+	_, found = suite.keeper.GetUnbondingRecord(suite.ctx, 1)
+	suite.Require().False(found)
+	_, found = suite.keeper.GetUnbondingRecord(suite.ctx, 2)
+	suite.Require().True(found)
+}
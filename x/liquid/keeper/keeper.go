@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// Keeper keeper for the liquid module
+type Keeper struct {
+	key                sdk.StoreKey
+	cdc                *codec.Codec
+	paramSubspace      subspace.Subspace
+	supplyKeeper       types.SupplyKeeper
+	stakingKeeper      types.StakingKeeper
+	distributionKeeper types.DistributionKeeper
+	pricefeedKeeper    types.PricefeedKeeper
+}
+
+// NewKeeper creates a new keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.SupplyKeeper,
+	stk types.StakingKeeper, dk types.DistributionKeeper, pfk types.PricefeedKeeper) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		key:                key,
+		cdc:                cdc,
+		paramSubspace:      paramstore,
+		supplyKeeper:       sk,
+		stakingKeeper:      stk,
+		distributionKeeper: dk,
+		pricefeedKeeper:    pfk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetUnbondingRecord returns an unbonding record from the store
+func (k Keeper) GetUnbondingRecord(ctx sdk.Context, id uint64) (types.UnbondingRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnbondingRecordsKeyPrefix)
+	bz := store.Get(types.GetUnbondingRecordKey(id))
+	if bz == nil {
+		return types.UnbondingRecord{}, false
+	}
+	var record types.UnbondingRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// SetUnbondingRecord sets an unbonding record in the store, keyed by its id
+func (k Keeper) SetUnbondingRecord(ctx sdk.Context, record types.UnbondingRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnbondingRecordsKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(record)
+	store.Set(types.GetUnbondingRecordKey(record.ID), bz)
+}
+
+// DeleteUnbondingRecord deletes an unbonding record from the store
+func (k Keeper) DeleteUnbondingRecord(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnbondingRecordsKeyPrefix)
+	store.Delete(types.GetUnbondingRecordKey(id))
+}
+
+// IterateUnbondingRecords iterates over all unbonding records in id order and performs a callback function
+func (k Keeper) IterateUnbondingRecords(ctx sdk.Context, cb func(record types.UnbondingRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.UnbondingRecordsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.UnbondingRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllUnbondingRecords returns all unbonding records from the store
+func (k Keeper) GetAllUnbondingRecords(ctx sdk.Context) types.UnbondingRecords {
+	records := types.UnbondingRecords{}
+	k.IterateUnbondingRecords(ctx, func(record types.UnbondingRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// GetNextUnbondingID returns the id of the next unbonding record
+func (k Keeper) GetNextUnbondingID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextUnbondingIDKey)
+	if bz == nil {
+		return types.DefaultNextUnbondingID
+	}
+	return types.Uint64FromBytes(bz)
+}
+
+// SetNextUnbondingID sets the id of the next unbonding record
+func (k Keeper) SetNextUnbondingID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextUnbondingIDKey, types.Uint64ToBytes(id))
+}
+
+// GetPendingUnbonding returns the amount of staked kava queued for the next unbonding batch
+func (k Keeper) GetPendingUnbonding(ctx sdk.Context) sdk.Int {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PendingUnbondingKey)
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var pending sdk.Int
+	k.cdc.MustUnmarshalBinaryBare(bz, &pending)
+	return pending
+}
+
+// SetPendingUnbonding sets the amount of staked kava queued for the next unbonding batch
+func (k Keeper) SetPendingUnbonding(ctx sdk.Context, pending sdk.Int) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(pending)
+	store.Set(types.PendingUnbondingKey, bz)
+}
+
+// GetNextBatchTime returns the earliest time the next unbonding batch can be undelegated
+func (k Keeper) GetNextBatchTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextBatchTimeKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var nextBatchTime time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &nextBatchTime)
+	return nextBatchTime, true
+}
+
+// SetNextBatchTime sets the earliest time the next unbonding batch can be undelegated
+func (k Keeper) SetNextBatchTime(ctx sdk.Context, nextBatchTime time.Time) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(nextBatchTime)
+	store.Set(types.NextBatchTimeKey, bz)
+}
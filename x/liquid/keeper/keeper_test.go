@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/liquid/keeper"
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+const KAVA_CF = 1000000
+
+// Test suite used for all keeper tests
+type KeeperTestSuite struct {
+	suite.Suite
+	keeper         keeper.Keeper
+	app            app.TestApp
+	ctx            sdk.Context
+	addrs          []sdk.AccAddress
+	validatorAddrs []sdk.ValAddress
+}
+
+// The default state used by each test: two validators on the allowlist, each with a 10 KAVA self delegation
+func (suite *KeeperTestSuite) SetupTest() {
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	coins := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(1000*KAVA_CF)))
+	authGS := app.NewAuthGenState(addrs, []sdk.Coins{coins, coins, coins, coins})
+
+	validatorAddrs := []sdk.ValAddress{sdk.ValAddress(addrs[2]), sdk.ValAddress(addrs[3])}
+	liquidGS := types.NewGenesisState(
+		types.NewParams(validatorAddrs, 24*time.Hour, "", ""),
+		types.DefaultUnbondingRecords, types.DefaultNextUnbondingID, types.DefaultPendingUnbonding, time.Time{},
+	)
+
+	stakingGS := staking.DefaultGenesisState()
+	stakingGS.Params.BondDenom = "ukava"
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{staking.ModuleName: staking.ModuleCdc.MustMarshalJSON(stakingGS)},
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(liquidGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetLiquidKeeper()
+	suite.addrs = addrs
+	suite.validatorAddrs = validatorAddrs
+
+	for _, valAddr := range validatorAddrs {
+		suite.Require().NoError(suite.deliverMsgCreateValidator(valAddr, sdk.NewCoin("ukava", sdk.NewInt(10*KAVA_CF))))
+	}
+	staking.EndBlocker(suite.ctx, tApp.GetStakingKeeper())
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteUnbondingRecord() {
+	record := types.NewUnbondingRecord(1, sdk.AccAddress("test"), sdk.NewCoin("ukava", sdk.NewInt(100)))
+
+	_, f := suite.keeper.GetUnbondingRecord(suite.ctx, 1)
+	suite.Require().False(f)
+
+	suite.keeper.SetUnbondingRecord(suite.ctx, record)
+
+	testRecord, f := suite.keeper.GetUnbondingRecord(suite.ctx, 1)
+	suite.Require().True(f)
+	suite.Require().Equal(record, testRecord)
+	suite.Require().Equal(types.UnbondingRecords{record}, suite.keeper.GetAllUnbondingRecords(suite.ctx))
+
+	suite.keeper.DeleteUnbondingRecord(suite.ctx, 1)
+
+	_, f = suite.keeper.GetUnbondingRecord(suite.ctx, 1)
+	suite.Require().False(f)
+}
+
+func (suite *KeeperTestSuite) deliverMsgCreateValidator(address sdk.ValAddress, selfDelegation sdk.Coin) error {
+	msg := staking.NewMsgCreateValidator(
+		address,
+		ed25519.GenPrivKey().PubKey(),
+		selfDelegation,
+		staking.Description{},
+		staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+		sdk.NewInt(1_000_000),
+	)
+	handleStakingMsg := staking.NewHandler(suite.app.GetStakingKeeper())
+	_, err := handleStakingMsg(suite.ctx, msg)
+	return err
+}
+
+func (suite *KeeperTestSuite) getAccount(addr sdk.AccAddress) authexported.Account {
+	ak := suite.app.GetAccountKeeper()
+	return ak.GetAccount(suite.ctx, addr)
+}
+
+func (suite *KeeperTestSuite) getDelegatedTokens(valAddr sdk.ValAddress) sdk.Int {
+	sk := suite.app.GetStakingKeeper()
+	moduleAddr := suite.app.GetSupplyKeeper().GetModuleAddress(types.ModuleAccountName)
+	delegation, found := sk.GetDelegation(suite.ctx, moduleAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+	validator, _ := sk.GetValidator(suite.ctx, valAddr)
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
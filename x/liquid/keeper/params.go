@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSubspace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// IsAllowedValidator returns true if a validator is on the delegation allowlist
+func (k Keeper) IsAllowedValidator(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	for _, val := range k.GetParams(ctx).Validators {
+		if val.Equals(valAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// InitializeParams sets the params to their defaults if they have not been set, such as on chains that were started
+// before the liquid module was added
+func (k Keeper) InitializeParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyValidators) {
+		return
+	}
+	k.SetParams(ctx, types.DefaultParams())
+}
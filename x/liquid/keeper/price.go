@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// UpdateDerivativePrice posts the derivative's price to the pricefeed, as the price of the underlying market
+// multiplied by the exchange rate, so the derivative can be valued as hard and cdp collateral. The module account
+// posts the price as an oracle of the derivative market, which must be listed in the pricefeed params.
+func (k Keeper) UpdateDerivativePrice(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.DerivativeMarketID == "" {
+		return
+	}
+
+	underlyingPrice, err := k.pricefeedKeeper.GetCurrentPrice(ctx, params.UnderlyingMarketID)
+	if err != nil {
+		k.Logger(ctx).Debug("no price to update derivative price from", "market", params.UnderlyingMarketID, "err", err)
+		return
+	}
+
+	price := underlyingPrice.Price.Mul(k.GetExchangeRate(ctx))
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	expiry := ctx.BlockTime().Add(types.DerivativePriceExpiry)
+	if _, err := k.pricefeedKeeper.SetPrice(ctx, moduleAddr, params.DerivativeMarketID, price, expiry); err != nil {
+		k.Logger(ctx).Error("failed to post derivative price", "market", params.DerivativeMarketID, "err", err)
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryGetParams:
+			return queryGetParams(ctx, req, k)
+		case types.QueryGetDerivativeValue:
+			return queryGetDerivativeValue(ctx, req, k)
+		case types.QueryGetUnbondingRecords:
+			return queryGetUnbondingRecords(ctx, req, k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
+		}
+	}
+}
+
+func queryGetParams(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetDerivativeValue(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	value := k.GetDerivativeValue(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, value)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetUnbondingRecords(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryUnbondingRecordsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	records := types.UnbondingRecords{}
+	k.IterateUnbondingRecords(ctx, func(record types.UnbondingRecord) (stop bool) {
+		if len(params.Owner) == 0 || record.Owner.Equals(params.Owner) {
+			records = append(records, record)
+		}
+		return false
+	})
+
+	start, end := client.Paginate(len(records), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		records = types.UnbondingRecords{}
+	} else {
+		records = records[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, records)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
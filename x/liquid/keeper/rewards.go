@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// CompoundRewards withdraws the staking rewards of every module delegation and delegates the module account's free
+// kava to the least delegated allowlisted validator, increasing the exchange rate of the derivative.
+// Rewards in other denoms are left in the module account.
+func (k Keeper) CompoundRewards(ctx sdk.Context) {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	compounded, err := k.compoundRewards(cacheCtx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to compound staking rewards", "err", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	if compounded.IsPositive() {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompoundRewards,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, k.GetExchangeRate(ctx).String()),
+			),
+		)
+	}
+}
+
+func (k Keeper) compoundRewards(ctx sdk.Context) (sdk.Coin, error) {
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	for _, delegation := range k.getDelegations(ctx) {
+		if _, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, moduleAddr, delegation.ValidatorAddress); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// kava queued for unbonding is still delegated, so the whole free balance can be staked
+	free := sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), k.getModuleBalance(ctx))
+	if !free.IsPositive() {
+		return free, nil
+	}
+	validator, found := k.getDelegationTarget(ctx)
+	if !found {
+		return sdk.Coin{}, types.ErrNoValidators
+	}
+	if err := k.delegate(ctx, validator, free.Amount); err != nil {
+		return sdk.Coin{}, err
+	}
+	return free, nil
+}
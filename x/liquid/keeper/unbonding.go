@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+// ProcessUnbondingBatch undelegates the kava queued by derivative burns once the batch interval has passed since
+// the previous batch, and sets the completion time of every queued unbonding record. A batch that fails is retried
+// in the next block.
+func (k Keeper) ProcessUnbondingBatch(ctx sdk.Context) {
+	nextBatchTime, _ := k.GetNextBatchTime(ctx)
+	pending := k.GetPendingUnbonding(ctx)
+	if ctx.BlockTime().Before(nextBatchTime) || !pending.IsPositive() {
+		return
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	completionTime, err := k.undelegateBatch(cacheCtx, pending)
+	if err != nil {
+		k.Logger(ctx).Error("failed to undelegate unbonding batch", "amount", pending, "err", err)
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.IterateUnbondingRecords(ctx, func(record types.UnbondingRecord) bool {
+		if record.IsQueued() {
+			record.CompletionTime = completionTime
+			k.SetUnbondingRecord(ctx, record)
+		}
+		return false
+	})
+	k.SetPendingUnbonding(ctx, sdk.ZeroInt())
+	k.SetNextBatchTime(ctx, ctx.BlockTime().Add(k.GetParams(ctx).UnbondingBatchInterval))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnbondingBatch,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(k.stakingKeeper.BondDenom(ctx), pending).String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.UTC().String()),
+		),
+	)
+}
+
+// undelegateBatch undelegates an amount of kava from the module account's delegations, returning the time the last
+// undelegation completes. Delegations to validators that have been removed from the allowlist are undelegated
+// first, followed by the largest delegations.
+func (k Keeper) undelegateBatch(ctx sdk.Context, amount sdk.Int) (time.Time, error) {
+	type delegatedTokens struct {
+		valAddr sdk.ValAddress
+		tokens  sdk.Int
+		allowed bool
+	}
+	var delegations []delegatedTokens
+	for _, delegation := range k.getDelegations(ctx) {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.ValidatorAddress)
+		if !found {
+			continue
+		}
+		delegations = append(delegations, delegatedTokens{
+			valAddr: delegation.ValidatorAddress,
+			tokens:  validator.TokensFromShares(delegation.Shares).TruncateInt(),
+			allowed: k.IsAllowedValidator(ctx, delegation.ValidatorAddress),
+		})
+	}
+	sort.Slice(delegations, func(i, j int) bool {
+		if delegations[i].allowed != delegations[j].allowed {
+			return !delegations[i].allowed
+		}
+		if !delegations[i].tokens.Equal(delegations[j].tokens) {
+			return delegations[i].tokens.GT(delegations[j].tokens)
+		}
+		return bytes.Compare(delegations[i].valAddr, delegations[j].valAddr) < 0
+	})
+
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleAccountName)
+	completionTime := ctx.BlockTime()
+	remaining := amount
+	for _, delegation := range delegations {
+		if !remaining.IsPositive() {
+			break
+		}
+		if !delegation.tokens.IsPositive() || k.stakingKeeper.HasMaxUnbondingDelegationEntries(ctx, moduleAddr, delegation.valAddr) {
+			continue
+		}
+		unbondAmount := sdk.MinInt(remaining, delegation.tokens)
+		shares, err := k.stakingKeeper.ValidateUnbondAmount(ctx, moduleAddr, delegation.valAddr, unbondAmount)
+		if err != nil {
+			return time.Time{}, err
+		}
+		valCompletionTime, err := k.stakingKeeper.Undelegate(ctx, moduleAddr, delegation.valAddr, shares)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if valCompletionTime.After(completionTime) {
+			completionTime = valCompletionTime
+		}
+		remaining = remaining.Sub(unbondAmount)
+	}
+	if remaining.IsPositive() {
+		return time.Time{}, sdkerrors.Wrapf(types.ErrInsufficientDelegation, "%s of %s could not be undelegated", remaining, amount)
+	}
+	return completionTime, nil
+}
+
+// PayoutUnbondingRecords pays the owner of every unbonding record whose batch has finished unbonding. If the
+// module account cannot cover a record, because the batch was slashed while unbonding, the remaining balance is paid.
+func (k Keeper) PayoutUnbondingRecords(ctx sdk.Context) {
+	var matured types.UnbondingRecords
+	k.IterateUnbondingRecords(ctx, func(record types.UnbondingRecord) bool {
+		if record.IsMature(ctx.BlockTime()) {
+			matured = append(matured, record)
+		}
+		return false
+	})
+
+	for _, record := range matured {
+		payout := sdk.NewCoin(record.Amount.Denom, sdk.MinInt(record.Amount.Amount, k.getModuleBalance(ctx)))
+		if payout.IsPositive() {
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, record.Owner, sdk.NewCoins(payout))
+			if err != nil {
+				panic(err)
+			}
+		}
+		k.DeleteUnbondingRecord(ctx, record.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUnbondingPayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, payout.String()),
+				sdk.NewAttribute(types.AttributeKeyOwner, record.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyUnbondingID, sdk.NewUint(record.ID).String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DONTCOVER
+
+// Errors used by the liquid module
+var (
+	// ErrInvalidDenom error for when coins of the wrong denom are minted or burned
+	ErrInvalidDenom = sdkerrors.Register(ModuleName, 2, "invalid denom")
+	// ErrNoValidators error for when no allowlisted validator can accept a delegation
+	ErrNoValidators = sdkerrors.Register(ModuleName, 3, "no validator available for delegation")
+	// ErrInvalidAmount error for when an amount is too small to mint or burn any coins
+	ErrInvalidAmount = sdkerrors.Register(ModuleName, 4, "invalid amount")
+	// ErrInsufficientDelegation error for when the module's delegations cannot cover an unbonding batch
+	ErrInsufficientDelegation = sdkerrors.Register(ModuleName, 5, "insufficient delegation to unbond")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Event types for liquid module
+const (
+	EventTypeMintDerivative    = "mint_derivative"
+	EventTypeBurnDerivative    = "burn_derivative"
+	EventTypeUnbondingBatch    = "liquid_unbonding_batch"
+	EventTypeUnbondingPayout   = "liquid_unbonding_payout"
+	EventTypeCompoundRewards   = "liquid_compound_rewards"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyOwner          = "owner"
+	AttributeKeyDerivative     = "derivative"
+	AttributeKeyUnbondingID    = "unbonding_id"
+	AttributeKeyCompletionTime = "completion_time"
+	AttributeKeyExchangeRate   = "exchange_rate"
+)
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	Params           Params           `json:"params" yaml:"params"`
+	UnbondingRecords UnbondingRecords `json:"unbonding_records" yaml:"unbonding_records"`
+	NextUnbondingID  uint64           `json:"next_unbonding_id" yaml:"next_unbonding_id"`
+	PendingUnbonding sdk.Int          `json:"pending_unbonding" yaml:"pending_unbonding"`
+	NextBatchTime    time.Time        `json:"next_batch_time" yaml:"next_batch_time"`
+}
+
+// NewGenesisState returns a new genesis state
+func NewGenesisState(params Params, records UnbondingRecords, nextUnbondingID uint64, pendingUnbonding sdk.Int, nextBatchTime time.Time) GenesisState {
+	return GenesisState{
+		Params:           params,
+		UnbondingRecords: records,
+		NextUnbondingID:  nextUnbondingID,
+		PendingUnbonding: pendingUnbonding,
+		NextBatchTime:    nextBatchTime,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultUnbondingRecords, DefaultNextUnbondingID, DefaultPendingUnbonding, time.Time{})
+}
+
+// Validate performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.UnbondingRecords.Validate(); err != nil {
+		return err
+	}
+	queued := sdk.ZeroInt()
+	for _, ur := range gs.UnbondingRecords {
+		if ur.ID >= gs.NextUnbondingID {
+			return fmt.Errorf("unbonding record id %d must be less than next unbonding id %d", ur.ID, gs.NextUnbondingID)
+		}
+		if ur.IsQueued() {
+			queued = queued.Add(ur.Amount.Amount)
+		}
+	}
+	if gs.PendingUnbonding.IsNil() || !gs.PendingUnbonding.Equal(queued) {
+		return fmt.Errorf("pending unbonding %s does not match queued unbonding records %s", gs.PendingUnbonding, queued)
+	}
+	return nil
+}
+
+// Equal checks whether two gov GenesisState structs are equivalent
+func (gs GenesisState) Equal(gs2 GenesisState) bool {
+	b1 := ModuleCdc.MustMarshalBinaryBare(gs)
+	b2 := ModuleCdc.MustMarshalBinaryBare(gs2)
+	return bytes.Equal(b1, b2)
+}
+
+// IsEmpty returns true if a GenesisState is empty
+func (gs GenesisState) IsEmpty() bool {
+	return gs.Equal(GenesisState{})
+}
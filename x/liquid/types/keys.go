@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+const (
+	// ModuleName name that will be used throughout the module
+	ModuleName = "liquid"
+
+	// ModuleAccountName name of module account used to delegate staked kava and mint derivatives
+	ModuleAccountName = ModuleName
+
+	// StoreKey Top level store key where all module items will be stored
+	StoreKey = ModuleName
+
+	// RouterKey Top level router key
+	RouterKey = ModuleName
+
+	// QuerierRoute Top level query string
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+
+	// DerivativeDenom is the denom of the staking derivative minted by the module
+	DerivativeDenom = "bkava"
+)
+
+var (
+	UnbondingRecordsKeyPrefix = []byte{0x01} // id -> UnbondingRecord
+	NextUnbondingIDKey        = []byte{0x02} // -> uint64
+	PendingUnbondingKey       = []byte{0x03} // -> sdk.Int
+	NextBatchTimeKey          = []byte{0x04} // -> time.Time
+)
+
+// GetUnbondingRecordKey returns the bytes of an unbonding record key
+func GetUnbondingRecordKey(id uint64) []byte {
+	return Uint64ToBytes(id)
+}
+
+// Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
+func Uint64ToBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// Uint64FromBytes converts some fixed length bytes back into a uint64.
+func Uint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgMintDerivative{}
+	_ sdk.Msg = &MsgBurnDerivative{}
+)
+
+// MsgMintDerivative stakes kava and mints derivative coins
+type MsgMintDerivative struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgMintDerivative returns a new MsgMintDerivative
+func NewMsgMintDerivative(sender sdk.AccAddress, amount sdk.Coin) MsgMintDerivative {
+	return MsgMintDerivative{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgMintDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgMintDerivative) Type() string { return "mint_derivative" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgMintDerivative) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "mint amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgMintDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgMintDerivative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgMintDerivative) String() string {
+	return fmt.Sprintf(`Mint Derivative Message:
+	Sender: %s
+	Amount: %s
+`, msg.Sender, msg.Amount)
+}
+
+// MsgBurnDerivative burns derivative coins and queues the kava they are worth for unbonding
+type MsgBurnDerivative struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgBurnDerivative returns a new MsgBurnDerivative
+func NewMsgBurnDerivative(sender sdk.AccAddress, amount sdk.Coin) MsgBurnDerivative {
+	return MsgBurnDerivative{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgBurnDerivative) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgBurnDerivative) Type() string { return "burn_derivative" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgBurnDerivative) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "burn amount %s", msg.Amount)
+	}
+	if msg.Amount.Denom != DerivativeDenom {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "%s, expected %s", msg.Amount.Denom, DerivativeDenom)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgBurnDerivative) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgBurnDerivative) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgBurnDerivative) String() string {
+	return fmt.Sprintf(`Burn Derivative Message:
+	Sender: %s
+	Amount: %s
+`, msg.Sender, msg.Amount)
+}
@@ -0,0 +1,141 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyValidators                 = []byte("Validators")
+	KeyUnbondingBatchInterval     = []byte("UnbondingBatchInterval")
+	KeyDerivativeMarketID         = []byte("DerivativeMarketID")
+	KeyUnderlyingMarketID         = []byte("UnderlyingMarketID")
+	DefaultValidators             = []sdk.ValAddress{}
+	DefaultUnbondingBatchInterval = 72 * time.Hour
+	DefaultDerivativeMarketID     = ""
+	DefaultUnderlyingMarketID     = ""
+	DefaultUnbondingRecords       = UnbondingRecords{}
+	DefaultNextUnbondingID        = uint64(1)
+	DefaultPendingUnbonding       = sdk.ZeroInt()
+	// DerivativePriceExpiry is how long a derivative price posted by the module remains valid
+	DerivativePriceExpiry = time.Hour
+)
+
+// Params governance parameters for the liquid module
+type Params struct {
+	Validators             []sdk.ValAddress `json:"validators" yaml:"validators"`
+	UnbondingBatchInterval time.Duration    `json:"unbonding_batch_interval" yaml:"unbonding_batch_interval"`
+	DerivativeMarketID     string           `json:"derivative_market_id" yaml:"derivative_market_id"`
+	UnderlyingMarketID     string           `json:"underlying_market_id" yaml:"underlying_market_id"`
+}
+
+// NewParams returns a new params object
+func NewParams(validators []sdk.ValAddress, unbondingBatchInterval time.Duration, derivativeMarketID, underlyingMarketID string) Params {
+	return Params{
+		Validators:             validators,
+		UnbondingBatchInterval: unbondingBatchInterval,
+		DerivativeMarketID:     derivativeMarketID,
+		UnderlyingMarketID:     underlyingMarketID,
+	}
+}
+
+// DefaultParams returns default params for liquid module
+func DefaultParams() Params {
+	return NewParams(DefaultValidators, DefaultUnbondingBatchInterval, DefaultDerivativeMarketID, DefaultUnderlyingMarketID)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	validators := make([]string, len(p.Validators))
+	for i, val := range p.Validators {
+		validators[i] = val.String()
+	}
+	return fmt.Sprintf(`Params:
+	Validators: %s
+	Unbonding Batch Interval: %s
+	Derivative Market ID: %s
+	Underlying Market ID: %s`, strings.Join(validators, ", "), p.UnbondingBatchInterval, p.DerivativeMarketID, p.UnderlyingMarketID)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyValidators, &p.Validators, validateValidatorsParam),
+		params.NewParamSetPair(KeyUnbondingBatchInterval, &p.UnbondingBatchInterval, validateUnbondingBatchIntervalParam),
+		params.NewParamSetPair(KeyDerivativeMarketID, &p.DerivativeMarketID, validateMarketIDParam),
+		params.NewParamSetPair(KeyUnderlyingMarketID, &p.UnderlyingMarketID, validateMarketIDParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateValidatorsParam(p.Validators); err != nil {
+		return err
+	}
+	if err := validateUnbondingBatchIntervalParam(p.UnbondingBatchInterval); err != nil {
+		return err
+	}
+	if err := validateMarketIDParam(p.DerivativeMarketID); err != nil {
+		return err
+	}
+	if err := validateMarketIDParam(p.UnderlyingMarketID); err != nil {
+		return err
+	}
+	if (p.DerivativeMarketID == "") != (p.UnderlyingMarketID == "") {
+		return fmt.Errorf("derivative and underlying market ids must both be set or both be empty")
+	}
+	return nil
+}
+
+func validateValidatorsParam(i interface{}) error {
+	validators, ok := i.([]sdk.ValAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenValidators := make(map[string]bool)
+	for _, val := range validators {
+		if val.Empty() {
+			return fmt.Errorf("validator address cannot be empty")
+		}
+		if seenValidators[val.String()] {
+			return fmt.Errorf("duplicate validator: %s", val)
+		}
+		seenValidators[val.String()] = true
+	}
+	return nil
+}
+
+func validateUnbondingBatchIntervalParam(i interface{}) error {
+	interval, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval <= 0 {
+		return fmt.Errorf("unbonding batch interval must be positive: %s", interval)
+	}
+	return nil
+}
+
+func validateMarketIDParam(i interface{}) error {
+	marketID, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if marketID != strings.TrimSpace(marketID) {
+		return fmt.Errorf("market id cannot have leading or trailing whitespace: '%s'", marketID)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/liquid/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ParamsTestSuite) TestParamValidation() {
+	val1 := sdk.ValAddress("val1")
+	val2 := sdk.ValAddress("val2")
+
+	testCases := []struct {
+		name        string
+		params      types.Params
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "default",
+			params:     types.DefaultParams(),
+			expectPass: true,
+		},
+		{
+			name:       "valid",
+			params:     types.NewParams([]sdk.ValAddress{val1, val2}, 24*time.Hour, "bkava:usd", "kava:usd"),
+			expectPass: true,
+		},
+		{
+			name:        "empty validator",
+			params:      types.NewParams([]sdk.ValAddress{val1, {}}, 24*time.Hour, "", ""),
+			expectPass:  false,
+			expectedErr: "validator address cannot be empty",
+		},
+		{
+			name:        "duplicate validator",
+			params:      types.NewParams([]sdk.ValAddress{val1, val1}, 24*time.Hour, "", ""),
+			expectPass:  false,
+			expectedErr: "duplicate validator",
+		},
+		{
+			name:        "zero batch interval",
+			params:      types.NewParams([]sdk.ValAddress{val1}, 0, "", ""),
+			expectPass:  false,
+			expectedErr: "unbonding batch interval must be positive",
+		},
+		{
+			name:        "missing underlying market",
+			params:      types.NewParams([]sdk.ValAddress{val1}, 24*time.Hour, "bkava:usd", ""),
+			expectPass:  false,
+			expectedErr: "must both be set",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.params.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func (suite *ParamsTestSuite) TestGenesisValidation() {
+	owner := sdk.AccAddress("test1")
+	queued := types.NewUnbondingRecord(1, owner, sdk.NewCoin("ukava", sdk.NewInt(100)))
+	batched := types.NewUnbondingRecord(2, owner, sdk.NewCoin("ukava", sdk.NewInt(50)))
+	batched.CompletionTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name        string
+		gs          types.GenesisState
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "default",
+			gs:         types.DefaultGenesisState(),
+			expectPass: true,
+		},
+		{
+			name:       "valid",
+			gs:         types.NewGenesisState(types.DefaultParams(), types.UnbondingRecords{queued, batched}, 3, sdk.NewInt(100), time.Time{}),
+			expectPass: true,
+		},
+		{
+			name:        "pending does not match queued records",
+			gs:          types.NewGenesisState(types.DefaultParams(), types.UnbondingRecords{queued, batched}, 3, sdk.NewInt(150), time.Time{}),
+			expectPass:  false,
+			expectedErr: "does not match queued unbonding records",
+		},
+		{
+			name:        "record id not below next id",
+			gs:          types.NewGenesisState(types.DefaultParams(), types.UnbondingRecords{queued, batched}, 2, sdk.NewInt(100), time.Time{}),
+			expectPass:  false,
+			expectedErr: "must be less than next unbonding id",
+		},
+		{
+			name:        "duplicate record id",
+			gs:          types.NewGenesisState(types.DefaultParams(), types.UnbondingRecords{queued, queued}, 3, sdk.NewInt(200), time.Time{}),
+			expectPass:  false,
+			expectedErr: "duplicate unbonding record id",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.gs.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
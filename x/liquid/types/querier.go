@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the liquid module
+const (
+	QueryGetParams           = "params"
+	QueryGetDerivativeValue  = "derivative-value"
+	QueryGetUnbondingRecords = "unbonding-records"
+)
+
+// DerivativeValue is the amount of kava backing the derivative supply
+type DerivativeValue struct {
+	TotalStaked      sdk.Int `json:"total_staked" yaml:"total_staked"`
+	DerivativeSupply sdk.Int `json:"derivative_supply" yaml:"derivative_supply"`
+	ExchangeRate     sdk.Dec `json:"exchange_rate" yaml:"exchange_rate"`
+}
+
+// NewDerivativeValue returns a new DerivativeValue
+func NewDerivativeValue(totalStaked, derivativeSupply sdk.Int, exchangeRate sdk.Dec) DerivativeValue {
+	return DerivativeValue{
+		TotalStaked:      totalStaked,
+		DerivativeSupply: derivativeSupply,
+		ExchangeRate:     exchangeRate,
+	}
+}
+
+// QueryUnbondingRecordsParams is the params for a filtered unbonding record query
+type QueryUnbondingRecordsParams struct {
+	Page  int            `json:"page" yaml:"page"`
+	Limit int            `json:"limit" yaml:"limit"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryUnbondingRecordsParams creates a new QueryUnbondingRecordsParams
+func NewQueryUnbondingRecordsParams(page, limit int, owner sdk.AccAddress) QueryUnbondingRecordsParams {
+	return QueryUnbondingRecordsParams{
+		Page:  page,
+		Limit: limit,
+		Owner: owner,
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnbondingRecord is a claim on staked kava that was redeemed by burning derivative coins. The completion time is
+// zero while the record is queued, and is set when the record's batch is undelegated.
+type UnbondingRecord struct {
+	ID             uint64         `json:"id" yaml:"id"`
+	Owner          sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount         sdk.Coin       `json:"amount" yaml:"amount"`
+	CompletionTime time.Time      `json:"completion_time" yaml:"completion_time"`
+}
+
+// NewUnbondingRecord returns a new queued UnbondingRecord
+func NewUnbondingRecord(id uint64, owner sdk.AccAddress, amount sdk.Coin) UnbondingRecord {
+	return UnbondingRecord{
+		ID:     id,
+		Owner:  owner,
+		Amount: amount,
+	}
+}
+
+// IsQueued returns true if the record has not yet been included in an unbonding batch
+func (ur UnbondingRecord) IsQueued() bool {
+	return ur.CompletionTime.IsZero()
+}
+
+// IsMature returns true if the record's batch has finished unbonding at the given block time
+func (ur UnbondingRecord) IsMature(blockTime time.Time) bool {
+	return !ur.IsQueued() && !blockTime.Before(ur.CompletionTime)
+}
+
+// Validate performs basic validation of an UnbondingRecord
+func (ur UnbondingRecord) Validate() error {
+	if ur.ID == 0 {
+		return fmt.Errorf("unbonding record id cannot be 0")
+	}
+	if ur.Owner.Empty() {
+		return fmt.Errorf("unbonding record owner cannot be empty")
+	}
+	if !ur.Amount.IsValid() || !ur.Amount.IsPositive() {
+		return fmt.Errorf("invalid unbonding record amount: %s", ur.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ur UnbondingRecord) String() string {
+	return fmt.Sprintf(`Unbonding Record %d:
+	Owner: %s
+	Amount: %s
+	Completion Time: %s
+`, ur.ID, ur.Owner, ur.Amount, ur.CompletionTime)
+}
+
+// UnbondingRecords slice of UnbondingRecord
+type UnbondingRecords []UnbondingRecord
+
+// Validate performs basic validation of each record and checks that no id is repeated
+func (urs UnbondingRecords) Validate() error {
+	seenIDs := make(map[uint64]bool)
+	for _, ur := range urs {
+		if err := ur.Validate(); err != nil {
+			return err
+		}
+		if seenIDs[ur.ID] {
+			return fmt.Errorf("duplicate unbonding record id: %d", ur.ID)
+		}
+		seenIDs[ur.ID] = true
+	}
+	return nil
+}
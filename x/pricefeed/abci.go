@@ -9,9 +9,17 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
+	// on local networks, keep the configured markets fresh with prices from the mock oracle
+	k.PostMockPrices(ctx)
+
 	// Update the current price of each asset.
 	for _, market := range k.GetMarkets(ctx) {
 		if !market.Active {
+			k.ResetMarketHeartbeat(ctx, market.MarketID)
+			continue
+		}
+		// stale markets keep their current price cleared until a price is posted again
+		if k.UpdateMarketHeartbeat(ctx, market) {
 			continue
 		}
 
@@ -19,5 +27,8 @@ func EndBlocker(ctx sdk.Context, k Keeper) {
 		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
 			panic(err)
 		}
+		if err == nil {
+			k.UpdatePriceCandles(ctx, market)
+		}
 	}
 }
@@ -8,25 +8,44 @@ import (
 )
 
 const (
+	AttributeActive             = types.AttributeActive
 	AttributeExpiry             = types.AttributeExpiry
+	AttributeLastPostTime       = types.AttributeLastPostTime
 	AttributeMarketID           = types.AttributeMarketID
 	AttributeMarketPrice        = types.AttributeMarketPrice
 	AttributeOracle             = types.AttributeOracle
+	AttributeRewardAmount       = types.AttributeRewardAmount
 	AttributeValueCategory      = types.AttributeValueCategory
+	CandleIntervalDay           = types.CandleIntervalDay
+	CandleIntervalHour          = types.CandleIntervalHour
 	DefaultParamspace           = types.DefaultParamspace
+	EventTypeClaimOracleReward  = types.EventTypeClaimOracleReward
+	EventTypeMarketFresh        = types.EventTypeMarketFresh
 	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
+	EventTypeMarketStale        = types.EventTypeMarketStale
+	EventTypeMarketStatus       = types.EventTypeMarketStatus
 	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
 	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
+	MaxDisplayNameLength        = types.MaxDisplayNameLength
 	MaxExpiry                   = types.MaxExpiry
+	MaxMarketDecimals           = types.MaxMarketDecimals
+	MetricsSubsystem            = types.MetricsSubsystem
+	ModuleAccountName           = types.ModuleAccountName
 	ModuleName                  = types.ModuleName
+	ProposalTypeMarketStatus    = types.ProposalTypeMarketStatus
 	QuerierRoute                = types.QuerierRoute
 	QueryGetParams              = types.QueryGetParams
+	QueryMarketMetadata         = types.QueryMarketMetadata
 	QueryMarkets                = types.QueryMarkets
+	QueryOracleReward           = types.QueryOracleReward
+	QueryOracleRewards          = types.QueryOracleRewards
 	QueryOracles                = types.QueryOracles
 	QueryPrice                  = types.QueryPrice
+	QueryPriceHistory           = types.QueryPriceHistory
 	QueryRawPrices              = types.QueryRawPrices
 	RouterKey                   = types.RouterKey
 	StoreKey                    = types.StoreKey
+	TypeMsgClaimOracleReward    = types.TypeMsgClaimOracleReward
 	TypeMsgPostPrice            = types.TypeMsgPostPrice
 )
 
@@ -34,32 +53,65 @@ var (
 	// function aliases
 	NewKeeper                  = keeper.NewKeeper
 	NewQuerier                 = keeper.NewQuerier
+	CandleIntervalDuration     = types.CandleIntervalDuration
 	CurrentPriceKey            = types.CurrentPriceKey
 	DefaultGenesisState        = types.DefaultGenesisState
 	DefaultParams              = types.DefaultParams
+	FeeWaiverCountKey          = types.FeeWaiverCountKey
+	LastOraclePostKey          = types.LastOraclePostKey
+	LastPostTimeKey            = types.LastPostTimeKey
+	LastRewardHeightKey        = types.LastRewardHeightKey
 	NewCurrentPrice            = types.NewCurrentPrice
 	NewGenesisState            = types.NewGenesisState
 	NewMarket                  = types.NewMarket
+	NewMarketMetadata          = types.NewMarketMetadata
+	NewMarketMetadataResponse  = types.NewMarketMetadataResponse
+	NewMarketStatusProposal    = types.NewMarketStatusProposal
+	NewMsgClaimOracleReward    = types.NewMsgClaimOracleReward
 	NewMsgPostPrice            = types.NewMsgPostPrice
+	NewOracleReward            = types.NewOracleReward
 	NewParams                  = types.NewParams
 	NewPostedPrice             = types.NewPostedPrice
+	NewPriceCandle             = types.NewPriceCandle
+	NewQueryOracleRewardParams = types.NewQueryOracleRewardParams
+	NewQueryPriceHistoryParams = types.NewQueryPriceHistoryParams
 	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
+	NopMetrics                 = types.NopMetrics
+	OracleRewardKey            = types.OracleRewardKey
 	ParamKeyTable              = types.ParamKeyTable
+	ParseMockPrices            = types.ParseMockPrices
+	PriceCandleKey             = types.PriceCandleKey
+	PriceCandleMarketKey       = types.PriceCandleMarketKey
+	PrometheusMetrics          = types.PrometheusMetrics
 	RawPriceKey                = types.RawPriceKey
 	RegisterCodec              = types.RegisterCodec
+	StaleMarketKey             = types.StaleMarketKey
 
 	// variable aliases
-	CurrentPricePrefix = types.CurrentPricePrefix
-	DefaultMarkets     = types.DefaultMarkets
-	ErrAssetNotFound   = types.ErrAssetNotFound
-	ErrEmptyInput      = types.ErrEmptyInput
-	ErrExpired         = types.ErrExpired
-	ErrInvalidMarket   = types.ErrInvalidMarket
-	ErrInvalidOracle   = types.ErrInvalidOracle
-	ErrNoValidPrice    = types.ErrNoValidPrice
-	KeyMarkets         = types.KeyMarkets
-	ModuleCdc          = types.ModuleCdc
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
+	CandleIntervals            = types.CandleIntervals
+	CurrentPricePrefix         = types.CurrentPricePrefix
+	DefaultMarkets             = types.DefaultMarkets
+	ErrAssetNotFound           = types.ErrAssetNotFound
+	ErrEmptyInput              = types.ErrEmptyInput
+	ErrExpired                 = types.ErrExpired
+	ErrInsufficientRewardFunds = types.ErrInsufficientRewardFunds
+	ErrInvalidMarket           = types.ErrInvalidMarket
+	ErrInvalidOracle           = types.ErrInvalidOracle
+	ErrNoOracleReward          = types.ErrNoOracleReward
+	ErrNoValidPrice            = types.ErrNoValidPrice
+	ErrPostTooFrequent         = types.ErrPostTooFrequent
+	FeeWaiverCountPrefix       = types.FeeWaiverCountPrefix
+	KeyMarkets                 = types.KeyMarkets
+	LastOraclePostPrefix       = types.LastOraclePostPrefix
+	LastPostTimePrefix         = types.LastPostTimePrefix
+	LastRewardHeightPrefix     = types.LastRewardHeightPrefix
+	MockOracleAddress          = types.MockOracleAddress
+	MockPriceExpiry            = types.MockPriceExpiry
+	ModuleCdc                  = types.ModuleCdc
+	OracleRewardPrefix         = types.OracleRewardPrefix
+	PriceCandlePrefix          = types.PriceCandlePrefix
+	RawPriceFeedPrefix         = types.RawPriceFeedPrefix
+	StaleMarketPrefix          = types.StaleMarketPrefix
 )
 
 type (
@@ -68,11 +120,27 @@ type (
 	CurrentPrices           = types.CurrentPrices
 	GenesisState            = types.GenesisState
 	Market                  = types.Market
+	MarketMetadata          = types.MarketMetadata
+	MarketMetadataResponse  = types.MarketMetadataResponse
+	MarketMetadataResponses = types.MarketMetadataResponses
+	MarketStatusProposal    = types.MarketStatusProposal
 	Markets                 = types.Markets
+	Metrics                 = types.Metrics
+	MockOracleConfig        = types.MockOracleConfig
+	MockPrice               = types.MockPrice
+	MockPrices              = types.MockPrices
+	MsgClaimOracleReward    = types.MsgClaimOracleReward
 	MsgPostPrice            = types.MsgPostPrice
+	OracleReward            = types.OracleReward
+	OracleRewards           = types.OracleRewards
 	Params                  = types.Params
 	PostedPrice             = types.PostedPrice
 	PostedPrices            = types.PostedPrices
+	PriceCandle             = types.PriceCandle
+	PriceCandles            = types.PriceCandles
+	QueryOracleRewardParams = types.QueryOracleRewardParams
+	QueryPriceHistoryParams = types.QueryPriceHistoryParams
 	QueryWithMarketIDParams = types.QueryWithMarketIDParams
 	SortDecs                = types.SortDecs
+	SupplyKeeper            = types.SupplyKeeper
 )
@@ -2,8 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -14,6 +16,11 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+const (
+	flagStart = "start"
+	flagEnd   = "end"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -32,6 +39,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdOracles(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdOracleReward(queryRoute, cdc),
+		GetCmdOracleRewards(queryRoute, cdc),
+		GetCmdPriceHistory(queryRoute, cdc),
+		GetCmdMarketMetadata(queryRoute, cdc),
 	)...)
 
 	return pricefeedQueryCmd
@@ -193,3 +204,134 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdOracleReward queries the unclaimed reward of an oracle
+func GetCmdOracleReward(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-reward [oracle-addr]",
+		Short: "get the unclaimed reward of an oracle",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			oracle, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryOracleRewardParams(oracle))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleReward)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var reward types.OracleReward
+			cdc.MustUnmarshalJSON(res, &reward)
+			return cliCtx.PrintOutput(reward)
+		},
+	}
+}
+
+// GetCmdOracleRewards queries the unclaimed rewards of all oracles
+func GetCmdOracleRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-rewards",
+		Short: "get the unclaimed rewards of all oracles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleRewards)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			var rewards types.OracleRewards
+			cdc.MustUnmarshalJSON(res, &rewards)
+			return cliCtx.PrintOutput(rewards)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the downsampled price candles of a market
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [marketID] [hour|day]",
+		Short: "get the hourly or daily price candles of a market",
+		Long: `Get the open, high, low and close prices of a market over each hour or day that candles are retained for.
+Optionally restrict the candles to those opening between a start and end time.`,
+		Example: fmt.Sprintf(`$ kvcli q %[1]s price-history bnb:usd hour
+$ kvcli q %[1]s price-history bnb:usd day --start 2021-01-01T00:00:00Z --end 2021-02-01T00:00:00Z`, types.ModuleName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var start, end time.Time
+			if strStart := viper.GetString(flagStart); strStart != "" {
+				t, err := time.Parse(time.RFC3339, strStart)
+				if err != nil {
+					return fmt.Errorf("invalid start time: %w", err)
+				}
+				start = t
+			}
+			if strEnd := viper.GetString(flagEnd); strEnd != "" {
+				t, err := time.Parse(time.RFC3339, strEnd)
+				if err != nil {
+					return fmt.Errorf("invalid end time: %w", err)
+				}
+				end = t
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPriceHistoryParams(args[0], args[1], start, end))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var candles types.PriceCandles
+			cdc.MustUnmarshalJSON(res, &candles)
+			return cliCtx.PrintOutput(candles)
+		},
+	}
+	cmd.Flags().String(flagStart, "", "(optional) only return candles opening at or after this RFC3339 time")
+	cmd.Flags().String(flagEnd, "", "(optional) only return candles opening before this RFC3339 time")
+	return cmd
+}
+
+// GetCmdMarketMetadata queries the display metadata of markets
+func GetCmdMarketMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "market-metadata [marketID]",
+		Short: "get the display metadata of all markets, or of a single market",
+		Example: fmt.Sprintf(`$ kvcli q %[1]s market-metadata
+$ kvcli q %[1]s market-metadata bnb:usd`, types.ModuleName),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var params types.QueryWithMarketIDParams
+			if len(args) > 0 {
+				params = types.NewQueryWithMarketIDParams(args[0])
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMarketMetadata)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var metadata types.MarketMetadataResponses
+			cdc.MustUnmarshalJSON(res, &metadata)
+			return cliCtx.PrintOutput(metadata)
+		},
+	}
+}
@@ -34,6 +34,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdClaimOracleReward(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -77,3 +78,26 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdClaimOracleReward cli command for claiming oracle rewards.
+func GetCmdClaimOracleReward(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-reward",
+		Short: "claim the reward accrued for posting prices",
+		Example: fmt.Sprintf("%s tx %s claim-reward --from validator",
+			version.ClientName, types.ModuleName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.NewMsgClaimOracleReward(cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -9,6 +9,10 @@ import (
 
 const (
 	RestMarketID = "market_id"
+	RestOracle   = "oracle"
+	RestInterval = "interval"
+	RestStart    = "start"
+	RestEnd      = "end"
 )
 
 // PostPriceReq defines the properties of a PostPrice request's body.
@@ -19,6 +23,11 @@ type PostPriceReq struct {
 	Expiry   string       `json:"expiry"`
 }
 
+// ClaimOracleRewardReq defines the properties of a ClaimOracleReward request's body.
+type ClaimOracleRewardReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
+
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
@@ -1,14 +1,30 @@
 package pricefeed
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // InitGenesis sets distribution information for genesis.
-func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper SupplyKeeper, gs GenesisState) {
+	// check if the module account exists
+	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleAccountName))
+	}
+
 	// Set the markets and oracles from params
 	keeper.SetParams(ctx, gs.Params)
 
+	for _, reward := range gs.OracleRewards {
+		keeper.SetOracleReward(ctx, reward)
+	}
+
+	for _, candle := range gs.PriceCandles {
+		keeper.SetPriceCandle(ctx, candle)
+	}
+
 	// Iterate through the posted prices and set them in the store if they are not expired
 	for _, pp := range gs.PostedPrices {
 		if pp.Expiry.After(ctx.BlockTime()) {
@@ -54,5 +70,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return NewGenesisState(params, postedPrices)
+	return NewGenesisState(params, postedPrices, keeper.GetOracleRewards(ctx), keeper.GetAllPriceCandles(ctx))
 }
@@ -12,6 +12,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgClaimOracleReward:
+			return HandleMsgClaimOracleReward(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -35,6 +37,7 @@ func HandleMsgPostPrice(
 	if err != nil {
 		return nil, err
 	}
+	k.AccrueOracleReward(ctx, msg.MarketID, msg.From)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -46,3 +49,21 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// HandleMsgClaimOracleReward handles claims of oracle rewards
+func HandleMsgClaimOracleReward(ctx sdk.Context, k Keeper, msg MsgClaimOracleReward) (*sdk.Result, error) {
+	_, err := k.ClaimOracleReward(ctx, msg.Oracle)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Oracle.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetFeeWaiverCount returns the number of price posts to a market that have had their fees waived in the current block
+func (k Keeper) GetFeeWaiverCount(ctx sdk.Context, marketID string) uint64 {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.FeeWaiverCountKey(marketID))
+	if bz == nil {
+		return 0
+	}
+	// counts are stored with the height they were recorded at, so they reset each block without an end blocker
+	if int64(binary.BigEndian.Uint64(bz[:8])) != ctx.BlockHeight() {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz[8:])
+}
+
+// IncrementFeeWaiverCount records a price post to a market that has had its fees waived in the current block
+func (k Keeper) IncrementFeeWaiverCount(ctx sdk.Context, marketID string) {
+	count := k.GetFeeWaiverCount(ctx, marketID) + 1
+	store := ctx.KVStore(k.key)
+	bz := append(sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())), sdk.Uint64ToBigEndian(count)...)
+	store.Set(types.FeeWaiverCountKey(marketID), bz)
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// UpdateMarketHeartbeat flags a market as stale when no oracle has posted a price for it within its heartbeat
+// interval, and clears the flag once a price is posted again. A stale market has its current price cleared so
+// consumers stop using it, and is deactivated if the market is configured to. Returns true if the market is stale.
+func (k Keeper) UpdateMarketHeartbeat(ctx sdk.Context, market types.Market) bool {
+	if !market.HasHeartbeat() {
+		k.deleteStaleMarket(ctx, market.MarketID)
+		return false
+	}
+
+	lastPostTime, found := k.GetLastPostTime(ctx, market.MarketID)
+	if !found {
+		// markets that have never received a price get a full interval from when the heartbeat is first checked
+		lastPostTime = ctx.BlockTime()
+		k.setLastPostTime(ctx, market.MarketID, lastPostTime)
+	}
+
+	wasStale := k.IsMarketStale(ctx, market.MarketID)
+	if ctx.BlockTime().Sub(lastPostTime) <= market.HeartbeatInterval {
+		if wasStale {
+			k.deleteStaleMarket(ctx, market.MarketID)
+			k.emitHeartbeatEvent(ctx, types.EventTypeMarketFresh, market.MarketID, lastPostTime)
+		}
+		return false
+	}
+	if wasStale {
+		return true
+	}
+
+	k.setStaleMarket(ctx, market.MarketID)
+	k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+	k.emitHeartbeatEvent(ctx, types.EventTypeMarketStale, market.MarketID, lastPostTime)
+	k.Logger(ctx).Info("market missed heartbeat", "market", market.MarketID, "last post time", lastPostTime)
+
+	if market.DeactivateOnStale {
+		if err := k.SetMarketStatus(ctx, market.MarketID, false); err != nil {
+			panic(err)
+		}
+	}
+	return true
+}
+
+// ResetMarketHeartbeat clears the heartbeat state of a market, so it starts a new interval when it is next checked.
+// This is used for inactive markets, so that reactivated markets are not immediately flagged stale.
+func (k Keeper) ResetMarketHeartbeat(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.LastPostTimeKey(marketID))
+	k.deleteStaleMarket(ctx, marketID)
+}
+
+func (k Keeper) emitHeartbeatEvent(ctx sdk.Context, eventType, marketID string, lastPostTime time.Time) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeLastPostTime, lastPostTime.UTC().String()),
+		),
+	)
+}
+
+// GetLastPostTime returns the time a price was last posted for a market
+func (k Keeper) GetLastPostTime(ctx sdk.Context, marketID string) (time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastPostTimeKey(marketID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	lastPostTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return lastPostTime, true
+}
+
+func (k Keeper) setLastPostTime(ctx sdk.Context, marketID string, lastPostTime time.Time) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.LastPostTimeKey(marketID), sdk.FormatTimeBytes(lastPostTime))
+}
+
+// IsMarketStale returns true if the market has been flagged stale for missing its heartbeat
+func (k Keeper) IsMarketStale(ctx sdk.Context, marketID string) bool {
+	store := ctx.KVStore(k.key)
+	return store.Has(types.StaleMarketKey(marketID))
+}
+
+func (k Keeper) setStaleMarket(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.StaleMarketKey(marketID), []byte{0x01})
+}
+
+func (k Keeper) deleteStaleMarket(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.StaleMarketKey(marketID))
+}
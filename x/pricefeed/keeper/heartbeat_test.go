@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_MarketHeartbeat(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: blockTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, HeartbeatInterval: time.Hour},
+			types.Market{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: addrs, Active: true, HeartbeatInterval: time.Hour, DeactivateOnStale: true},
+		},
+	})
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		_, err := keeper.SetPrice(ctx, addrs[0], marketID, sdk.OneDec(), blockTime.Add(24*time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, marketID))
+	}
+
+	// markets are fresh within the heartbeat interval
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	market, _ := keeper.GetMarket(ctx, "tstusd")
+	require.False(t, keeper.UpdateMarketHeartbeat(ctx, market))
+	require.False(t, keeper.IsMarketStale(ctx, "tstusd"))
+
+	// a missed heartbeat flags the market stale and clears its current price
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour + time.Second))
+	require.True(t, keeper.UpdateMarketHeartbeat(ctx, market))
+	require.True(t, keeper.IsMarketStale(ctx, "tstusd"))
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	market, _ = keeper.GetMarket(ctx, "tstusd")
+	require.True(t, market.Active)
+
+	// a fresh post clears the flag
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), blockTime.Add(24*time.Hour))
+	require.NoError(t, err)
+	require.False(t, keeper.UpdateMarketHeartbeat(ctx, market))
+	require.False(t, keeper.IsMarketStale(ctx, "tstusd"))
+
+	// markets configured to deactivate on stale are deactivated
+	market, _ = keeper.GetMarket(ctx, "tst2usd")
+	require.True(t, keeper.UpdateMarketHeartbeat(ctx, market))
+	market, _ = keeper.GetMarket(ctx, "tst2usd")
+	require.False(t, market.Active)
+
+	// resetting the heartbeat of an inactive market gives it a new interval once it is reactivated
+	keeper.ResetMarketHeartbeat(ctx, "tst2usd")
+	require.NoError(t, keeper.SetMarketStatus(ctx, "tst2usd", true))
+	market, _ = keeper.GetMarket(ctx, "tst2usd")
+	require.False(t, keeper.UpdateMarketHeartbeat(ctx, market))
+	lastPostTime, found := keeper.GetLastPostTime(ctx, "tst2usd")
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), lastPostTime)
+}
@@ -24,11 +24,17 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// The reference to the supply keeper used to pay oracle rewards
+	supplyKeeper types.SupplyKeeper
+	// Metrics reported by the keeper
+	metrics *types.Metrics
+	// Prices posted every block on local networks, disabled by default
+	mockOracle types.MockOracleConfig
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.SupplyKeeper,
 ) Keeper {
 	if !paramstore.HasKeyTable() {
 		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
@@ -38,9 +44,16 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		supplyKeeper:  sk,
+		metrics:       types.NopMetrics(),
 	}
 }
 
+// SetMetrics sets the metrics the keeper reports to
+func (k *Keeper) SetMetrics(metrics *types.Metrics) {
+	k.metrics = metrics
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -57,6 +70,11 @@ func (k Keeper) SetPrice(
 	if !expiry.After(ctx.BlockTime()) {
 		return types.PostedPrice{}, types.ErrExpired
 	}
+	// only the latest of an oracle's posts to a market within a block is kept, so repeat posts skip the interval check
+	repost, err := k.validatePostInterval(ctx, marketID, oracle)
+	if err != nil {
+		return types.PostedPrice{}, err
+	}
 
 	store := ctx.KVStore(k.key)
 	prices, err := k.GetRawPrices(ctx, marketID)
@@ -93,6 +111,10 @@ func (k Keeper) SetPrice(
 	)
 
 	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
+	if !repost {
+		k.setLastPostTime(ctx, marketID, ctx.BlockTime())
+		k.setLastOraclePost(ctx, marketID, oracle)
+	}
 	return prices[index], nil
 }
 
@@ -120,6 +142,7 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
 		}
 	}
+	k.recordPriceMetrics(ctx, marketID, prices, len(notExpiredPrices))
 
 	if len(notExpiredPrices) == 0 {
 		// NOTE: The current price stored will continue storing the most recent (expired)
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordPriceMetrics reports the number of valid oracle prices for a market and the time until the last one expires
+func (k Keeper) recordPriceMetrics(ctx sdk.Context, marketID string, prices types.PostedPrices, validPrices int) {
+	if ctx.IsCheckTx() || len(prices) == 0 {
+		return
+	}
+	latestExpiry := prices[0].Expiry
+	for _, p := range prices[1:] {
+		if p.Expiry.After(latestExpiry) {
+			latestExpiry = p.Expiry
+		}
+	}
+	k.metrics.ValidPrices.With("market_id", marketID).Set(float64(validPrices))
+	k.metrics.TimeToPriceExpiry.With("market_id", marketID).Set(latestExpiry.Sub(ctx.BlockTime()).Seconds())
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SetMockOracle enables the mock oracle, which posts prices for the configured markets every block.
+// It is intended for local networks only.
+func (k *Keeper) SetMockOracle(config types.MockOracleConfig) {
+	if err := config.Validate(); err != nil {
+		panic(err)
+	}
+	k.mockOracle = config
+}
+
+// PostMockPrices posts a price from the mock oracle for each market configured in the mock oracle. With a random
+// walk, each price moves from the market's current price by a random fraction of up to the random walk. The
+// randomness is seeded from the block height and market, so every node posts the same prices.
+func (k Keeper) PostMockPrices(ctx sdk.Context) {
+	if !k.mockOracle.Enabled() {
+		return
+	}
+	for _, mp := range k.mockOracle.Prices {
+		market, found := k.GetMarket(ctx, mp.MarketID)
+		if !found || !market.Active {
+			continue
+		}
+
+		price := mp.Price
+		walk := k.mockOracle.RandomWalk
+		if !walk.IsNil() && walk.IsPositive() {
+			if current, err := k.GetCurrentPrice(ctx, mp.MarketID); err == nil {
+				price = current.Price
+			}
+			price = price.Mul(sdk.OneDec().Add(walk.Mul(randomUnit(ctx.BlockHeight(), mp.MarketID))))
+		}
+
+		_, err := k.SetPrice(ctx, types.MockOracleAddress, mp.MarketID, price, ctx.BlockTime().Add(types.MockPriceExpiry))
+		if err != nil {
+			k.Logger(ctx).Error("mock oracle failed to post price", "market", mp.MarketID, "err", err)
+		}
+	}
+}
+
+// randomUnit returns a deterministic random decimal in [-1, 1] for a block height and market
+func randomUnit(height int64, marketID string) sdk.Dec {
+	heightBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBz, uint64(height))
+	seed := sha256.Sum256(append(heightBz, []byte(marketID)...))
+	r := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(seed[:8]))))
+	const precision = 1000000
+	return sdk.NewDec(r.Int63n(2*precision+1) - precision).QuoInt64(precision)
+}
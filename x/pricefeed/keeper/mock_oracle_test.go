@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_PostMockPrices(t *testing.T) {
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+			types.Market{MarketID: "inactiveusd", BaseAsset: "inactive", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: false},
+		},
+	})
+
+	// the mock oracle is disabled by default
+	keeper.PostMockPrices(ctx)
+	prices, err := keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	keeper.SetMockOracle(types.MockOracleConfig{Prices: types.MockPrices{
+		{MarketID: "tstusd", Price: sdk.MustNewDecFromStr("2.00")},
+		{MarketID: "inactiveusd", Price: sdk.MustNewDecFromStr("1.00")},
+		{MarketID: "missingusd", Price: sdk.MustNewDecFromStr("1.00")},
+	}})
+
+	// static prices are posted unchanged, skipping inactive and missing markets
+	keeper.PostMockPrices(ctx)
+	prices, err = keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, types.PostedPrices{
+		types.NewPostedPrice("tstusd", types.MockOracleAddress, sdk.MustNewDecFromStr("2.00"), blockTime.Add(types.MockPriceExpiry)),
+	}, prices)
+	prices, err = keeper.GetRawPrices(ctx, "inactiveusd")
+	require.NoError(t, err)
+	require.Empty(t, prices)
+
+	// prices are reposted each block, so they never expire
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(2 * types.MockPriceExpiry))
+	keeper.PostMockPrices(ctx)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("2.00"), price.Price)
+
+	// a random walk moves the current price by at most the walk each block, the same way on every node
+	walk := sdk.MustNewDecFromStr("0.01")
+	keeper.SetMockOracle(types.MockOracleConfig{
+		Prices:     types.MockPrices{{MarketID: "tstusd", Price: sdk.MustNewDecFromStr("2.00")}},
+		RandomWalk: walk,
+	})
+	for height := int64(3); height < 20; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		previous, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+
+		cacheCtx, _ := ctx.CacheContext()
+		keeper.PostMockPrices(cacheCtx)
+		repeated, err := keeper.GetRawPrices(cacheCtx, "tstusd")
+		require.NoError(t, err)
+
+		keeper.PostMockPrices(ctx)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+		current, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, repeated[0].Price, current.Price)
+
+		change := current.Price.Sub(previous.Price).Abs()
+		require.True(t, change.LTE(previous.Price.Mul(walk)), "price moved from %s to %s", previous.Price, current.Price)
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -60,6 +62,38 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID string) (types.Market, bool)
 	return types.Market{}, false
 }
 
+// SetMarketStatus activates or deactivates a market. Deactivating a market clears its current price so
+// consumers can no longer read it, and the end blocker stops updating it until the market is reactivated.
+func (k Keeper) SetMarketStatus(ctx sdk.Context, marketID string, active bool) error {
+	params := k.GetParams(ctx)
+	found := false
+	for i := range params.Markets {
+		if params.Markets[i].MarketID == marketID {
+			params.Markets[i].Active = active
+			found = true
+			break
+		}
+	}
+	if !found {
+		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
+	}
+	k.SetParams(ctx, params)
+
+	if !active {
+		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarketStatus,
+			sdk.NewAttribute(types.AttributeMarketID, marketID),
+			sdk.NewAttribute(types.AttributeActive, strconv.FormatBool(active)),
+		),
+	)
+	k.Logger(ctx).Info("updated market status", "market", marketID, "active", active)
+	return nil
+}
+
 // GetAuthorizedAddresses returns a list of addresses that have special authorization within this module, eg the oracles of all markets.
 func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	oracles := []sdk.AccAddress{}
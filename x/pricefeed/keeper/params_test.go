@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -65,6 +66,42 @@ func (suite *KeeperTestSuite) TestGetAuthorizedAddresses() {
 
 	suite.Require().ElementsMatch(oracles, actualOracles)
 }
+
+func (suite *KeeperTestSuite) TestSetMarketStatus() {
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "btc:usd"))
+	_, err := suite.keeper.GetCurrentPrice(suite.ctx, "btc:usd")
+	suite.Require().NoError(err)
+
+	err = suite.keeper.SetMarketStatus(suite.ctx, "btc:usd", false)
+	suite.Require().NoError(err)
+	market, found := suite.keeper.GetMarket(suite.ctx, "btc:usd")
+	suite.Require().True(found)
+	suite.False(market.Active)
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "btc:usd")
+	suite.Require().True(errors.Is(err, pricefeed.ErrNoValidPrice))
+	suite.Contains(suite.ctx.EventManager().Events(), sdk.NewEvent(
+		pricefeed.EventTypeMarketStatus,
+		sdk.NewAttribute(pricefeed.AttributeMarketID, "btc:usd"),
+		sdk.NewAttribute(pricefeed.AttributeActive, "false"),
+	))
+
+	// other markets are unaffected
+	market, found = suite.keeper.GetMarket(suite.ctx, "xrp:usd")
+	suite.Require().True(found)
+	suite.True(market.Active)
+
+	err = suite.keeper.SetMarketStatus(suite.ctx, "btc:usd", true)
+	suite.Require().NoError(err)
+	market, _ = suite.keeper.GetMarket(suite.ctx, "btc:usd")
+	suite.True(market.Active)
+	suite.Require().NoError(suite.keeper.SetCurrentPrices(suite.ctx, "btc:usd"))
+	_, err = suite.keeper.GetCurrentPrice(suite.ctx, "btc:usd")
+	suite.NoError(err)
+
+	err = suite.keeper.SetMarketStatus(suite.ctx, "eth:usd", false)
+	suite.Require().True(errors.Is(err, pricefeed.ErrInvalidMarket))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetLastOraclePost returns the block height and time an oracle last posted a price to a market
+func (k Keeper) GetLastOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (int64, time.Time, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.LastOraclePostKey(marketID, oracle))
+	if bz == nil {
+		return 0, time.Time{}, false
+	}
+	postTime, err := sdk.ParseTimeBytes(bz[8:])
+	if err != nil {
+		panic(err)
+	}
+	return int64(binary.BigEndian.Uint64(bz[:8])), postTime, true
+}
+
+func (k Keeper) setLastOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(ctx.BlockHeight()))
+	store.Set(types.LastOraclePostKey(marketID, oracle), append(bz, sdk.FormatTimeBytes(ctx.BlockTime())...))
+}
+
+// validatePostInterval checks an oracle has waited the market's minimum post interval since its last post. It returns
+// true if the oracle already posted to the market in the current block, in which case the new post replaces the old one.
+func (k Keeper) validatePostInterval(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (bool, error) {
+	lastHeight, lastTime, found := k.GetLastOraclePost(ctx, marketID, oracle)
+	if !found {
+		return false, nil
+	}
+	if lastHeight == ctx.BlockHeight() && lastTime.Equal(ctx.BlockTime()) {
+		return true, nil
+	}
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.MinPostInterval == 0 {
+		return false, nil
+	}
+	if elapsed := ctx.BlockTime().Sub(lastTime); elapsed < market.MinPostInterval {
+		return false, sdkerrors.Wrapf(types.ErrPostTooFrequent, "%s since last post, market %s requires %s", elapsed, marketID, market.MinPostInterval)
+	}
+	return false, nil
+}
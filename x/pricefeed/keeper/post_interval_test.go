@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_MinPostInterval(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: blockTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, MinPostInterval: time.Minute},
+		},
+	})
+	expiry := blockTime.Add(24 * time.Hour)
+
+	_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), expiry)
+	require.NoError(t, err)
+
+	// a repeat post in the same block replaces the oracle's earlier post
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(2), expiry)
+	require.NoError(t, err)
+	prices, err := keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, prices, 1)
+	require.Equal(t, sdk.NewDec(2), prices[0].Price)
+	height, postTime, found := keeper.GetLastOraclePost(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(1), height)
+	require.Equal(t, blockTime, postTime)
+
+	// posts in later blocks within the interval are rejected
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(blockTime.Add(time.Minute - time.Second))
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(3), expiry)
+	require.True(t, errors.Is(err, types.ErrPostTooFrequent))
+
+	// other oracles are limited separately
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.NewDec(3), expiry)
+	require.NoError(t, err)
+
+	// posts are accepted once the interval has passed
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(blockTime.Add(time.Minute))
+	_, err = keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(4), expiry)
+	require.NoError(t, err)
+	height, postTime, found = keeper.GetLastOraclePost(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	require.Equal(t, int64(3), height)
+	require.Equal(t, blockTime.Add(time.Minute), postTime)
+}
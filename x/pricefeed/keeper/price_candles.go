@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// UpdatePriceCandles applies the current price of a market to its open candles and prunes candles that have
+// passed the market's retention. Intervals with no retention have any remaining candles removed.
+func (k Keeper) UpdatePriceCandles(ctx sdk.Context, market types.Market) {
+	currentPrice, err := k.GetCurrentPrice(ctx, market.MarketID)
+	if err != nil {
+		return
+	}
+	for _, interval := range types.CandleIntervals {
+		retention := market.CandleRetention(interval)
+		if retention == 0 {
+			k.pruneMarketPriceCandles(ctx, market.MarketID, interval, ctx.BlockTime().Add(time.Nanosecond))
+			continue
+		}
+		length, _ := types.CandleIntervalDuration(interval)
+		openTime := ctx.BlockTime().Truncate(length)
+
+		candle, found := k.GetPriceCandle(ctx, market.MarketID, interval, openTime)
+		if found {
+			candle = candle.Update(currentPrice.Price)
+		} else {
+			candle = types.NewPriceCandle(market.MarketID, interval, openTime, currentPrice.Price)
+		}
+		k.SetPriceCandle(ctx, candle)
+
+		k.pruneMarketPriceCandles(ctx, market.MarketID, interval, ctx.BlockTime().Add(-retention))
+	}
+}
+
+// pruneMarketPriceCandles deletes the candles of a market that opened before a cutoff time
+func (k Keeper) pruneMarketPriceCandles(ctx sdk.Context, marketID, interval string, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceCandleMarketKey(marketID, interval))
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetPriceCandle returns the candle of a market over the interval opened at a time
+func (k Keeper) GetPriceCandle(ctx sdk.Context, marketID, interval string, openTime time.Time) (types.PriceCandle, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.PriceCandleKey(marketID, interval, openTime))
+	if bz == nil {
+		return types.PriceCandle{}, false
+	}
+	var candle types.PriceCandle
+	k.cdc.MustUnmarshalBinaryBare(bz, &candle)
+	return candle, true
+}
+
+// SetPriceCandle sets a price candle in the store
+func (k Keeper) SetPriceCandle(ctx sdk.Context, candle types.PriceCandle) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceCandleKey(candle.MarketID, candle.Interval, candle.OpenTime), k.cdc.MustMarshalBinaryBare(candle))
+}
+
+// GetPriceCandles returns the candles of a market over an interval that opened at or after start and before end,
+// ordered by open time. A zero end returns all candles from start onwards.
+func (k Keeper) GetPriceCandles(ctx sdk.Context, marketID, interval string, start, end time.Time) types.PriceCandles {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceCandleMarketKey(marketID, interval))
+	var endBytes []byte
+	if !end.IsZero() {
+		endBytes = sdk.FormatTimeBytes(end)
+	}
+	iterator := store.Iterator(sdk.FormatTimeBytes(start), endBytes)
+	defer iterator.Close()
+
+	candles := types.PriceCandles{}
+	for ; iterator.Valid(); iterator.Next() {
+		var candle types.PriceCandle
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &candle)
+		candles = append(candles, candle)
+	}
+	return candles
+}
+
+// IteratePriceCandles iterates over all price candles in the store and performs a callback function
+func (k Keeper) IteratePriceCandles(ctx sdk.Context, cb func(candle types.PriceCandle) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PriceCandlePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var candle types.PriceCandle
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &candle)
+		if cb(candle) {
+			break
+		}
+	}
+}
+
+// GetAllPriceCandles returns all price candles in the store
+func (k Keeper) GetAllPriceCandles(ctx sdk.Context) types.PriceCandles {
+	candles := types.PriceCandles{}
+	k.IteratePriceCandles(ctx, func(candle types.PriceCandle) bool {
+		candles = append(candles, candle)
+		return false
+	})
+	return candles
+}
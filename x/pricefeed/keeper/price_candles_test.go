@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+func TestKeeper_PriceCandles(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	blockTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: blockTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	keeper.SetParams(ctx, types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, HourlyCandleRetention: 2 * time.Hour, DailyCandleRetention: 24 * time.Hour},
+		},
+	})
+	market, _ := keeper.GetMarket(ctx, "tstusd")
+	postPrice := func(blockTime time.Time, price string) {
+		ctx = ctx.WithBlockTime(blockTime)
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), blockTime.Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+		keeper.UpdatePriceCandles(ctx, market)
+	}
+
+	postPrice(blockTime.Add(10*time.Minute), "2.0")
+	postPrice(blockTime.Add(20*time.Minute), "3.0")
+	postPrice(blockTime.Add(30*time.Minute), "1.0")
+	postPrice(blockTime.Add(40*time.Minute), "1.5")
+
+	hourly := keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, time.Time{}, time.Time{})
+	require.Equal(t, types.PriceCandles{
+		{MarketID: "tstusd", Interval: types.CandleIntervalHour, OpenTime: blockTime, Open: sdk.MustNewDecFromStr("2.0"), High: sdk.MustNewDecFromStr("3.0"), Low: sdk.MustNewDecFromStr("1.0"), Close: sdk.MustNewDecFromStr("1.5")},
+	}, hourly)
+
+	// a price in the next hour opens a new hourly candle but updates the same daily candle
+	postPrice(blockTime.Add(70*time.Minute), "4.0")
+	hourly = keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, time.Time{}, time.Time{})
+	require.Len(t, hourly, 2)
+	require.Equal(t, types.NewPriceCandle("tstusd", types.CandleIntervalHour, blockTime.Add(time.Hour), sdk.MustNewDecFromStr("4.0")), hourly[1])
+	daily := keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalDay, time.Time{}, time.Time{})
+	require.Len(t, daily, 1)
+	require.Equal(t, sdk.MustNewDecFromStr("4.0"), daily[0].High)
+	require.Equal(t, sdk.MustNewDecFromStr("4.0"), daily[0].Close)
+
+	// candles can be queried over a range of open times
+	require.Equal(t, hourly[:1], keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, blockTime, blockTime.Add(time.Hour)))
+	require.Equal(t, hourly[1:], keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, blockTime.Add(time.Hour), time.Time{}))
+
+	// candles older than the retention are pruned
+	postPrice(blockTime.Add(150*time.Minute), "5.0")
+	hourly = keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, time.Time{}, time.Time{})
+	require.Len(t, hourly, 2)
+	require.Equal(t, blockTime.Add(time.Hour), hourly[0].OpenTime)
+	require.Equal(t, blockTime.Add(2*time.Hour), hourly[1].OpenTime)
+
+	// disabling an interval removes its candles
+	market.HourlyCandleRetention = 0
+	keeper.SetParams(ctx, types.NewParams(types.Markets{market}))
+	postPrice(blockTime.Add(160*time.Minute), "5.0")
+	require.Empty(t, keeper.GetPriceCandles(ctx, "tstusd", types.CandleIntervalHour, time.Time{}, time.Time{}))
+	require.Len(t, keeper.GetAllPriceCandles(ctx), 1)
+}
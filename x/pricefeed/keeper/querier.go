@@ -26,6 +26,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryMarkets(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryOracleReward:
+			return queryOracleReward(ctx, req, keeper)
+		case types.QueryOracleRewards:
+			return queryOracleRewards(ctx, req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, req, keeper)
+		case types.QueryMarketMetadata:
+			return queryMarketMetadata(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -119,6 +127,38 @@ func queryMarkets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []
 	return bz, nil
 }
 
+func queryOracleReward(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryOracleRewardParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// oracles without an unclaimed reward have a reward of zero
+	reward, found := keeper.GetOracleReward(ctx, requestParams.Oracle)
+	if !found {
+		reward = types.NewOracleReward(requestParams.Oracle, sdk.NewCoins())
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, reward)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryOracleRewards(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	rewards := keeper.GetOracleRewards(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, rewards)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // query params in the pricefeed store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	params := keeper.GetParams(ctx)
@@ -130,3 +170,55 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	}
 	return bz, nil
 }
+
+func queryPriceHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryPriceHistoryParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return []byte{}, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+	if _, err := types.CandleIntervalDuration(requestParams.Interval); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !requestParams.End.IsZero() && requestParams.End.Before(requestParams.Start) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "end %s is before start %s", requestParams.End, requestParams.Start)
+	}
+
+	candles := keeper.GetPriceCandles(ctx, requestParams.MarketID, requestParams.Interval, requestParams.Start, requestParams.End)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, candles)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryMarketMetadata(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	if len(req.Data) > 0 {
+		if err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	metadata := types.MarketMetadataResponses{}
+	for _, market := range keeper.GetMarkets(ctx) {
+		if requestParams.MarketID != "" && market.MarketID != requestParams.MarketID {
+			continue
+		}
+		metadata = append(metadata, types.NewMarketMetadataResponse(market))
+	}
+	if requestParams.MarketID != "" && len(metadata) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, metadata)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
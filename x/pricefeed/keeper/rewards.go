@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// AccrueOracleReward credits an oracle with the reward per post of a market. An oracle is rewarded at most once
+// per block for each market, so posting several prices in the same block does not earn additional rewards.
+func (k Keeper) AccrueOracleReward(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || market.OracleRewardPerPost.Empty() {
+		return
+	}
+	store := ctx.KVStore(k.key)
+	heightKey := types.LastRewardHeightKey(marketID, oracle)
+	if bz := store.Get(heightKey); bz != nil && int64(binary.BigEndian.Uint64(bz)) == ctx.BlockHeight() {
+		return
+	}
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, uint64(ctx.BlockHeight()))
+	store.Set(heightKey, heightBytes)
+
+	reward, found := k.GetOracleReward(ctx, oracle)
+	if !found {
+		reward = types.NewOracleReward(oracle, sdk.NewCoins())
+	}
+	reward.Amount = reward.Amount.Add(market.OracleRewardPerPost...)
+	k.SetOracleReward(ctx, reward)
+}
+
+// ClaimOracleReward pays an oracle its unclaimed reward from the module account
+func (k Keeper) ClaimOracleReward(ctx sdk.Context, oracle sdk.AccAddress) (sdk.Coins, error) {
+	reward, found := k.GetOracleReward(ctx, oracle)
+	if !found || reward.Amount.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrNoOracleReward, oracle.String())
+	}
+	macc := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	if !reward.Amount.IsAllLTE(macc.GetCoins()) {
+		return nil, sdkerrors.Wrapf(types.ErrInsufficientRewardFunds, "reward %s, available %s", reward.Amount, macc.GetCoins())
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, oracle, reward.Amount)
+	if err != nil {
+		return nil, err
+	}
+	k.DeleteOracleReward(ctx, oracle)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimOracleReward,
+			sdk.NewAttribute(types.AttributeOracle, oracle.String()),
+			sdk.NewAttribute(types.AttributeRewardAmount, reward.Amount.String()),
+		),
+	)
+	return reward.Amount, nil
+}
+
+// GetOracleReward returns the unclaimed reward of an oracle
+func (k Keeper) GetOracleReward(ctx sdk.Context, oracle sdk.AccAddress) (types.OracleReward, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleRewardKey(oracle))
+	if bz == nil {
+		return types.OracleReward{}, false
+	}
+	var reward types.OracleReward
+	k.cdc.MustUnmarshalBinaryBare(bz, &reward)
+	return reward, true
+}
+
+// SetOracleReward sets the unclaimed reward of an oracle in the store
+func (k Keeper) SetOracleReward(ctx sdk.Context, reward types.OracleReward) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleRewardKey(reward.Oracle), k.cdc.MustMarshalBinaryBare(reward))
+}
+
+// DeleteOracleReward deletes the unclaimed reward of an oracle from the store
+func (k Keeper) DeleteOracleReward(ctx sdk.Context, oracle sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.OracleRewardKey(oracle))
+}
+
+// IterateOracleRewards iterates over all unclaimed oracle rewards in the store and performs a callback function
+func (k Keeper) IterateOracleRewards(ctx sdk.Context, cb func(reward types.OracleReward) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleRewardPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.OracleReward
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &reward)
+		if cb(reward) {
+			break
+		}
+	}
+}
+
+// GetOracleRewards returns all unclaimed oracle rewards from the store
+func (k Keeper) GetOracleRewards(ctx sdk.Context) types.OracleRewards {
+	rewards := types.OracleRewards{}
+	k.IterateOracleRewards(ctx, func(reward types.OracleReward) (stop bool) {
+		rewards = append(rewards, reward)
+		return false
+	})
+	return rewards
+}
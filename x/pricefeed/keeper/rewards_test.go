@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// TestKeeper_OracleRewards tests accruing and claiming oracle rewards
+func TestKeeper_OracleRewards(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	oracle, funder := addrs[0], addrs[1]
+	reward := sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()})
+	pfGenesis := pricefeed.NewGenesisState(
+		types.NewParams(types.Markets{
+			{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true, OracleRewardPerPost: reward},
+			{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+		}),
+		nil,
+		types.OracleRewards{},
+		types.PriceCandles{},
+	)
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState([]sdk.AccAddress{funder}, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000))}),
+		app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(pfGenesis)},
+	)
+	keeper := tApp.GetPriceFeedKeeper()
+	handler := pricefeed.NewHandler(keeper)
+	postPrice := func(ctx sdk.Context, marketID string) {
+		_, err := handler(ctx, types.NewMsgPostPrice(oracle, marketID, sdk.OneDec(), ctx.BlockTime().Add(time.Hour)))
+		require.NoError(t, err)
+	}
+
+	// oracles are rewarded at most once per block for each market
+	postPrice(ctx, "tstusd")
+	postPrice(ctx, "tstusd")
+	postPrice(ctx, "tst2usd")
+	oracleReward, found := keeper.GetOracleReward(ctx, oracle)
+	require.True(t, found)
+	require.Equal(t, reward, oracleReward.Amount)
+
+	ctx = ctx.WithBlockHeight(2)
+	postPrice(ctx, "tstusd")
+	oracleReward, _ = keeper.GetOracleReward(ctx, oracle)
+	require.Equal(t, reward.Add(reward...), oracleReward.Amount)
+	require.Equal(t, types.OracleRewards{oracleReward}, keeper.GetOracleRewards(ctx))
+
+	// claims fail until the module account is funded
+	_, err := handler(ctx, types.NewMsgClaimOracleReward(oracle))
+	require.True(t, errors.Is(err, types.ErrInsufficientRewardFunds))
+
+	err = tApp.GetSupplyKeeper().SendCoinsFromAccountToModule(ctx, funder, types.ModuleAccountName, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)))
+	require.NoError(t, err)
+	_, err = handler(ctx, types.NewMsgClaimOracleReward(oracle))
+	require.NoError(t, err)
+	require.Equal(t, reward.Add(reward...), tApp.GetAccountKeeper().GetAccount(ctx, oracle).GetCoins())
+	_, found = keeper.GetOracleReward(ctx, oracle)
+	require.False(t, found)
+
+	_, err = handler(ctx, types.NewMsgClaimOracleReward(oracle))
+	require.True(t, errors.Is(err, types.ErrNoOracleReward))
+}
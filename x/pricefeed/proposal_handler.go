@@ -0,0 +1,29 @@
+package pricefeed
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// NewProposalHandler creates a governance handler for pricefeed proposals
+func NewProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.MarketStatusProposal:
+			return handleMarketStatusProposal(ctx, k, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", ModuleName, c)
+		}
+	}
+}
+
+func handleMarketStatusProposal(ctx sdk.Context, k keeper.Keeper, p types.MarketStatusProposal) error {
+	if err := p.ValidateBasic(); err != nil {
+		return err
+	}
+	return k.SetMarketStatus(ctx, p.MarketID, p.Active)
+}
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,6 +15,15 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
+	case bytes.HasPrefix(kvA.Key, types.OracleRewardPrefix):
+		var rewardA, rewardB types.OracleReward
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &rewardA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &rewardB)
+		return fmt.Sprintf("%s\n%s", rewardA, rewardB)
+
+	case bytes.HasPrefix(kvA.Key, types.LastRewardHeightPrefix):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
 	case bytes.Contains(kvA.Key, []byte(types.CurrentPricePrefix)):
 		var priceA, priceB types.CurrentPrice
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"encoding/binary"
 	"fmt"
 	"testing"
 	"time"
@@ -28,10 +29,15 @@ func TestDecodeDistributionStore(t *testing.T) {
 
 	currentPrice := types.CurrentPrice{MarketID: "current", Price: sdk.OneDec()}
 	postedPrice := []types.PostedPrice{{MarketID: "posted", Price: sdk.OneDec(), Expiry: time.Now().UTC()}}
+	oracleReward := types.NewOracleReward(sdk.AccAddress("oracle"), sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)))
+	height := make([]byte, 8)
+	binary.BigEndian.PutUint64(height, 10)
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: []byte(types.CurrentPricePrefix), Value: cdc.MustMarshalBinaryBare(currentPrice)},
 		kv.Pair{Key: []byte(types.RawPriceFeedPrefix), Value: cdc.MustMarshalBinaryBare(postedPrice)},
+		kv.Pair{Key: types.OracleRewardKey(oracleReward.Oracle), Value: cdc.MustMarshalBinaryBare(oracleReward)},
+		kv.Pair{Key: types.LastRewardHeightKey("posted", oracleReward.Oracle), Value: height},
 		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -41,6 +47,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 	}{
 		{"CurrentPrice", fmt.Sprintf("%v\n%v", currentPrice, currentPrice)},
 		{"PostedPrice", fmt.Sprintf("%s\n%s", postedPrice, postedPrice)},
+		{"OracleReward", fmt.Sprintf("%s\n%s", oracleReward, oracleReward)},
+		{"LastRewardHeight", "10\n10"},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -55,7 +55,7 @@ func loadPricefeedGenState(simState *module.SimulationState) pricefeed.GenesisSt
 		postedPrices = append(postedPrices, postedPrice)
 	}
 	params := pricefeed.NewParams(markets)
-	return pricefeed.NewGenesisState(params, postedPrices)
+	return pricefeed.NewGenesisState(params, postedPrices, pricefeed.OracleRewards{}, pricefeed.PriceCandles{})
 }
 
 // getInitialPrice gets the starting price for each of the base assets
@@ -19,4 +19,10 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrNoOracleReward error for claims by oracles with no unclaimed reward
+	ErrNoOracleReward = sdkerrors.Register(ModuleName, 8, "no oracle reward to claim")
+	// ErrInsufficientRewardFunds error for claims that exceed the funds of the module account
+	ErrInsufficientRewardFunds = sdkerrors.Register(ModuleName, 9, "insufficient funds to pay oracle reward")
+	// ErrPostTooFrequent error for posted prices sent before the market's minimum post interval has passed
+	ErrPostTooFrequent = sdkerrors.Register(ModuleName, 10, "price posted too soon after the oracle's last post")
 )
@@ -5,10 +5,17 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeClaimOracleReward  = "claim_oracle_reward"
+	EventTypeMarketStatus       = "market_status"
+	EventTypeMarketStale        = "market_stale"
+	EventTypeMarketFresh        = "market_fresh"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeRewardAmount  = "reward_amount"
+	AttributeActive        = "active"
+	AttributeLastPostTime  = "last_post_time"
 )
@@ -6,15 +6,19 @@ import (
 
 // GenesisState - pricefeed state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	PostedPrices PostedPrices `json:"posted_prices" yaml:"posted_prices"`
+	Params        Params        `json:"params" yaml:"params"`
+	PostedPrices  PostedPrices  `json:"posted_prices" yaml:"posted_prices"`
+	OracleRewards OracleRewards `json:"oracle_rewards" yaml:"oracle_rewards"`
+	PriceCandles  PriceCandles  `json:"price_candles" yaml:"price_candles"`
 }
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, ors OracleRewards, pcs PriceCandles) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:        p,
+		PostedPrices:  pp,
+		OracleRewards: ors,
+		PriceCandles:  pcs,
 	}
 }
 
@@ -23,6 +27,8 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		OracleRewards{},
+		PriceCandles{},
 	)
 }
 
@@ -44,5 +50,11 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleRewards.Validate(); err != nil {
+		return err
+	}
+	return gs.PriceCandles.Validate()
 }
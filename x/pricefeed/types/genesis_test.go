@@ -13,6 +13,7 @@ import (
 
 func TestGenesisStateValidate(t *testing.T) {
 	now := time.Now()
+	candleTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
 	mockPrivKey := tmtypes.NewMockPV()
 	pubkey, err := mockPrivKey.GetPubKey()
 	require.NoError(t, err)
@@ -32,9 +33,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OracleRewards{},
+				PriceCandles{},
 			),
 			expPass: true,
 		},
@@ -42,9 +45,11 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OracleRewards{},
+				PriceCandles{},
 			),
 			expPass: false,
 		},
@@ -52,10 +57,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
+					NewMarket("market", "xrp", "bnb", []sdk.AccAddress{addr}, true),
 				}),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				OracleRewards{},
+				PriceCandles{},
 			),
 			expPass: false,
 		},
@@ -64,6 +71,8 @@ func TestGenesisStateValidate(t *testing.T) {
 			genesisState: NewGenesisState(
 				NewParams(Markets{}),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				OracleRewards{},
+				PriceCandles{},
 			),
 			expPass: false,
 		},
@@ -75,6 +84,79 @@ func TestGenesisStateValidate(t *testing.T) {
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				OracleRewards{},
+				PriceCandles{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid oracle rewards",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{NewOracleReward(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)))},
+				PriceCandles{},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated oracle rewards",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{
+					NewOracleReward(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))),
+					NewOracleReward(addr, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10))),
+				},
+				PriceCandles{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid oracle reward",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{NewOracleReward(nil, sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)))},
+				PriceCandles{},
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid price candles",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{},
+				PriceCandles{
+					NewPriceCandle("xrp", CandleIntervalHour, candleTime, sdk.OneDec()),
+					NewPriceCandle("xrp", CandleIntervalDay, candleTime, sdk.OneDec()),
+				},
+			),
+			expPass: true,
+		},
+		{
+			msg: "duplicated price candles",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{},
+				PriceCandles{
+					NewPriceCandle("xrp", CandleIntervalHour, candleTime, sdk.OneDec()),
+					NewPriceCandle("xrp", CandleIntervalHour, candleTime, sdk.OneDec()),
+				},
+			),
+			expPass: false,
+		},
+		{
+			msg: "price candle not aligned to interval",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}),
+				[]PostedPrice{},
+				OracleRewards{},
+				PriceCandles{
+					NewPriceCandle("xrp", CandleIntervalDay, candleTime.Add(time.Hour), sdk.OneDec()),
+				},
 			),
 			expPass: false,
 		},
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -15,6 +21,9 @@ const (
 
 	// DefaultParamspace default namestore
 	DefaultParamspace = ModuleName
+
+	// ModuleAccountName name of the module account that oracle rewards are paid from
+	ModuleAccountName = ModuleName
 )
 
 var (
@@ -23,6 +32,27 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// OracleRewardPrefix prefix for the unclaimed reward of an oracle
+	OracleRewardPrefix = []byte{0x02}
+
+	// LastRewardHeightPrefix prefix for the block height an oracle was last rewarded for posting to a market
+	LastRewardHeightPrefix = []byte{0x03}
+
+	// LastPostTimePrefix prefix for the time a price was last posted for a market
+	LastPostTimePrefix = []byte{0x04}
+
+	// StaleMarketPrefix prefix for the markets flagged stale for missing their heartbeat
+	StaleMarketPrefix = []byte{0x05}
+
+	// FeeWaiverCountPrefix prefix for the number of price posts to a market that have had their fees waived in a block
+	FeeWaiverCountPrefix = []byte{0x06}
+
+	// PriceCandlePrefix prefix for the downsampled price candles of a market
+	PriceCandlePrefix = []byte{0x07}
+
+	// LastOraclePostPrefix prefix for the block height and time an oracle last posted a price to a market
+	LastOraclePostPrefix = []byte{0x08}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -34,3 +64,46 @@ func CurrentPriceKey(marketID string) []byte {
 func RawPriceKey(marketID string) []byte {
 	return append(RawPriceFeedPrefix, []byte(marketID)...)
 }
+
+// OracleRewardKey returns the key for the unclaimed reward of an oracle
+func OracleRewardKey(oracle sdk.AccAddress) []byte {
+	return append(OracleRewardPrefix, oracle...)
+}
+
+// LastRewardHeightKey returns the key for the block height an oracle was last rewarded for posting to a market
+func LastRewardHeightKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(append(LastRewardHeightPrefix, oracle...), []byte(marketID)...)
+}
+
+// LastPostTimeKey returns the key for the time a price was last posted for a market
+func LastPostTimeKey(marketID string) []byte {
+	return append(LastPostTimePrefix, []byte(marketID)...)
+}
+
+// StaleMarketKey returns the key for the stale flag of a market
+func StaleMarketKey(marketID string) []byte {
+	return append(StaleMarketPrefix, []byte(marketID)...)
+}
+
+// FeeWaiverCountKey returns the key for the number of price posts to a market that have had their fees waived in a block
+func FeeWaiverCountKey(marketID string) []byte {
+	return append(FeeWaiverCountPrefix, []byte(marketID)...)
+}
+
+// PriceCandleMarketKey returns the prefix for the candles of a market over an interval
+func PriceCandleMarketKey(marketID, interval string) []byte {
+	key := append(PriceCandlePrefix, byte(len(marketID)))
+	key = append(key, []byte(marketID)...)
+	key = append(key, byte(len(interval)))
+	return append(key, []byte(interval)...)
+}
+
+// PriceCandleKey returns the key for a candle, candles of a market sort by their open time
+func PriceCandleKey(marketID, interval string, openTime time.Time) []byte {
+	return append(PriceCandleMarketKey(marketID, interval), sdk.FormatTimeBytes(openTime)...)
+}
+
+// LastOraclePostKey returns the key for the block height and time an oracle last posted a price to a market
+func LastOraclePostKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(append(LastOraclePostPrefix, oracle...), []byte(marketID)...)
+}
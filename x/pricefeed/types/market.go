@@ -9,6 +9,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	// MaxDisplayNameLength the longest display name a market can have
+	MaxDisplayNameLength = 64
+	// MaxMarketDecimals the most decimals an asset of a market can have, matching the precision of posted prices
+	MaxMarketDecimals = sdk.Precision
+)
+
 // Market an asset in the pricefeed
 type Market struct {
 	MarketID   string           `json:"market_id" yaml:"market_id"`
@@ -16,6 +23,20 @@ type Market struct {
 	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
 	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
 	Active     bool             `json:"active" yaml:"active"`
+	// OracleRewardPerPost is credited to an oracle each block it posts a price for the market
+	OracleRewardPerPost sdk.Coins `json:"oracle_reward_per_post" yaml:"oracle_reward_per_post"`
+	// HeartbeatInterval is the longest time the market can go without a posted price before it is flagged stale, zero disables the check
+	HeartbeatInterval time.Duration `json:"heartbeat_interval" yaml:"heartbeat_interval"`
+	// DeactivateOnStale deactivates the market when it is flagged stale
+	DeactivateOnStale bool `json:"deactivate_on_stale" yaml:"deactivate_on_stale"`
+	// HourlyCandleRetention is how long hourly price candles are kept for the market, zero disables hourly candles
+	HourlyCandleRetention time.Duration `json:"hourly_candle_retention" yaml:"hourly_candle_retention"`
+	// DailyCandleRetention is how long daily price candles are kept for the market, zero disables daily candles
+	DailyCandleRetention time.Duration `json:"daily_candle_retention" yaml:"daily_candle_retention"`
+	// MinPostInterval is the shortest time an oracle must wait between blocks it posts a price for the market in, zero disables the limit
+	MinPostInterval time.Duration `json:"min_post_interval" yaml:"min_post_interval"`
+	// Metadata describes how the market's price should be displayed, it does not affect how prices are calculated
+	Metadata MarketMetadata `json:"metadata" yaml:"metadata"`
 }
 
 // NewMarket returns a new Market
@@ -36,8 +57,16 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	Oracle Reward Per Post: %s
+	Heartbeat Interval: %s
+	Deactivate On Stale: %t
+	Hourly Candle Retention: %s
+	Daily Candle Retention: %s
+	Min Post Interval: %s
+	Metadata: %s`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.OracleRewardPerPost, m.HeartbeatInterval, m.DeactivateOnStale,
+		m.HourlyCandleRetention, m.DailyCandleRetention, m.MinPostInterval, m.Metadata)
 }
 
 // Validate performs a basic validation of the market params
@@ -61,9 +90,136 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	if !m.OracleRewardPerPost.IsValid() {
+		return fmt.Errorf("invalid oracle reward per post: %s", m.OracleRewardPerPost)
+	}
+	if m.HeartbeatInterval < 0 {
+		return fmt.Errorf("heartbeat interval cannot be negative: %s", m.HeartbeatInterval)
+	}
+	if m.DeactivateOnStale && m.HeartbeatInterval == 0 {
+		return errors.New("deactivate on stale requires a heartbeat interval")
+	}
+	if m.MinPostInterval < 0 {
+		return fmt.Errorf("min post interval cannot be negative: %s", m.MinPostInterval)
+	}
+	if m.HasHeartbeat() && m.MinPostInterval >= m.HeartbeatInterval {
+		return fmt.Errorf("min post interval must be shorter than the heartbeat interval: %s >= %s", m.MinPostInterval, m.HeartbeatInterval)
+	}
+	for _, interval := range CandleIntervals {
+		retention := m.CandleRetention(interval)
+		length, _ := CandleIntervalDuration(interval)
+		if retention != 0 && retention < length {
+			return fmt.Errorf("%s candle retention must be zero or at least %s: %s", interval, length, retention)
+		}
+	}
+	if err := m.Metadata.Validate(); err != nil {
+		return fmt.Errorf("invalid metadata: %w", err)
+	}
+	return nil
+}
+
+// CandleRetention returns how long the market keeps price candles of an interval, zero if they are disabled
+func (m Market) CandleRetention(interval string) time.Duration {
+	switch interval {
+	case CandleIntervalHour:
+		return m.HourlyCandleRetention
+	case CandleIntervalDay:
+		return m.DailyCandleRetention
+	default:
+		return 0
+	}
+}
+
+// HasHeartbeat returns true if the market must receive a posted price at least once every heartbeat interval
+func (m Market) HasHeartbeat() bool {
+	return m.HeartbeatInterval > 0
+}
+
+// DisplayName returns the name of the market shown to users, defaulting to the uppercase base and quote assets
+func (m Market) DisplayName() string {
+	if m.Metadata.DisplayName != "" {
+		return m.Metadata.DisplayName
+	}
+	if m.Metadata.DisplayInverted {
+		return fmt.Sprintf("%s/%s", strings.ToUpper(m.QuoteAsset), strings.ToUpper(m.BaseAsset))
+	}
+	return fmt.Sprintf("%s/%s", strings.ToUpper(m.BaseAsset), strings.ToUpper(m.QuoteAsset))
+}
+
+// MarketMetadata display information for a market
+type MarketMetadata struct {
+	// DisplayName is the name of the market shown to users, blank to use the base and quote assets
+	DisplayName string `json:"display_name" yaml:"display_name"`
+	// BaseDecimals is the number of decimals of the base asset's display unit
+	BaseDecimals uint32 `json:"base_decimals" yaml:"base_decimals"`
+	// QuoteDecimals is the number of decimals of the quote asset's display unit
+	QuoteDecimals uint32 `json:"quote_decimals" yaml:"quote_decimals"`
+	// DisplayInverted hints that the price is conventionally shown as quote per base, ie one over the posted price
+	DisplayInverted bool `json:"display_inverted" yaml:"display_inverted"`
+}
+
+// NewMarketMetadata returns a new MarketMetadata
+func NewMarketMetadata(displayName string, baseDecimals, quoteDecimals uint32, displayInverted bool) MarketMetadata {
+	return MarketMetadata{
+		DisplayName:     displayName,
+		BaseDecimals:    baseDecimals,
+		QuoteDecimals:   quoteDecimals,
+		DisplayInverted: displayInverted,
+	}
+}
+
+// String implement fmt.Stringer
+func (mm MarketMetadata) String() string {
+	return fmt.Sprintf(`Display Name: %s, Base Decimals: %d, Quote Decimals: %d, Display Inverted: %t`,
+		mm.DisplayName, mm.BaseDecimals, mm.QuoteDecimals, mm.DisplayInverted)
+}
+
+// Validate performs a basic validation of the market metadata
+func (mm MarketMetadata) Validate() error {
+	if strings.TrimSpace(mm.DisplayName) != mm.DisplayName {
+		return fmt.Errorf("display name cannot have leading or trailing whitespace: %q", mm.DisplayName)
+	}
+	if len(mm.DisplayName) > MaxDisplayNameLength {
+		return fmt.Errorf("display name cannot be longer than %d characters: %s", MaxDisplayNameLength, mm.DisplayName)
+	}
+	if mm.BaseDecimals > MaxMarketDecimals {
+		return fmt.Errorf("base decimals cannot be greater than %d: %d", MaxMarketDecimals, mm.BaseDecimals)
+	}
+	if mm.QuoteDecimals > MaxMarketDecimals {
+		return fmt.Errorf("quote decimals cannot be greater than %d: %d", MaxMarketDecimals, mm.QuoteDecimals)
+	}
 	return nil
 }
 
+// MarketMetadataResponse the metadata of a market returned by queries, with the display name resolved
+type MarketMetadataResponse struct {
+	MarketID        string `json:"market_id" yaml:"market_id"`
+	BaseAsset       string `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset      string `json:"quote_asset" yaml:"quote_asset"`
+	Active          bool   `json:"active" yaml:"active"`
+	DisplayName     string `json:"display_name" yaml:"display_name"`
+	BaseDecimals    uint32 `json:"base_decimals" yaml:"base_decimals"`
+	QuoteDecimals   uint32 `json:"quote_decimals" yaml:"quote_decimals"`
+	DisplayInverted bool   `json:"display_inverted" yaml:"display_inverted"`
+}
+
+// NewMarketMetadataResponse returns the metadata response for a market
+func NewMarketMetadataResponse(m Market) MarketMetadataResponse {
+	return MarketMetadataResponse{
+		MarketID:        m.MarketID,
+		BaseAsset:       m.BaseAsset,
+		QuoteAsset:      m.QuoteAsset,
+		Active:          m.Active,
+		DisplayName:     m.DisplayName(),
+		BaseDecimals:    m.Metadata.BaseDecimals,
+		QuoteDecimals:   m.Metadata.QuoteDecimals,
+		DisplayInverted: m.Metadata.DisplayInverted,
+	}
+}
+
+// MarketMetadataResponses array of MarketMetadataResponse
+type MarketMetadataResponses []MarketMetadataResponse
+
 // Markets array type for oracle
 type Markets []Market
 
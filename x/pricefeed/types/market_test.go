@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -77,6 +78,152 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid oracle reward",
+			Market{
+				MarketID:            "market",
+				BaseAsset:           "xrp",
+				QuoteAsset:          "bnb",
+				Oracles:             []sdk.AccAddress{addr},
+				OracleRewardPerPost: sdk.NewCoins(sdk.NewInt64Coin("ukava", 10)),
+			},
+			true,
+		},
+		{
+			"invalid oracle reward",
+			Market{
+				MarketID:            "market",
+				BaseAsset:           "xrp",
+				QuoteAsset:          "bnb",
+				Oracles:             []sdk.AccAddress{addr},
+				OracleRewardPerPost: sdk.Coins{sdk.Coin{Denom: "ukava", Amount: sdk.NewInt(-10)}},
+			},
+			false,
+		},
+		{
+			"valid heartbeat",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				HeartbeatInterval: time.Hour,
+				DeactivateOnStale: true,
+			},
+			true,
+		},
+		{
+			"negative heartbeat interval",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				HeartbeatInterval: -time.Hour,
+			},
+			false,
+		},
+		{
+			"deactivate on stale without heartbeat",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				DeactivateOnStale: true,
+			},
+			false,
+		},
+		{
+			"valid min post interval",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				HeartbeatInterval: time.Hour,
+				MinPostInterval:   time.Minute,
+			},
+			true,
+		},
+		{
+			"negative min post interval",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				Oracles:         []sdk.AccAddress{addr},
+				MinPostInterval: -time.Minute,
+			},
+			false,
+		},
+		{
+			"min post interval not shorter than heartbeat",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				HeartbeatInterval: time.Hour,
+				MinPostInterval:   time.Hour,
+			},
+			false,
+		},
+		{
+			"valid metadata",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Metadata:   NewMarketMetadata("XRP/BNB", 6, 8, true),
+			},
+			true,
+		},
+		{
+			"display name with whitespace",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Metadata:   NewMarketMetadata(" XRP/BNB", 6, 8, false),
+			},
+			false,
+		},
+		{
+			"display name too long",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Metadata:   NewMarketMetadata(strings.Repeat("x", MaxDisplayNameLength+1), 6, 8, false),
+			},
+			false,
+		},
+		{
+			"too many base decimals",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Metadata:   NewMarketMetadata("", MaxMarketDecimals+1, 8, false),
+			},
+			false,
+		},
+		{
+			"too many quote decimals",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				Metadata:   NewMarketMetadata("", 6, MaxMarketDecimals+1, false),
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -89,6 +236,17 @@ func TestMarketValidate(t *testing.T) {
 	}
 }
 
+func TestMarketDisplayName(t *testing.T) {
+	market := NewMarket("bnb:usd", "bnb", "usd", nil, true)
+	require.Equal(t, "BNB/USD", market.DisplayName())
+
+	market.Metadata.DisplayInverted = true
+	require.Equal(t, "USD/BNB", market.DisplayName())
+
+	market.Metadata.DisplayName = "Binance Coin"
+	require.Equal(t, "Binance Coin", market.DisplayName())
+}
+
 func TestPostedPriceValidate(t *testing.T) {
 	now := time.Now()
 	mockPrivKey := tmtypes.NewMockPV()
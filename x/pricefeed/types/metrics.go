@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MetricsSubsystem is the subsystem of all metrics exposed by the pricefeed module
+const MetricsSubsystem = ModuleName
+
+// Metrics contains the metrics exposed by the pricefeed module
+type Metrics struct {
+	// Number of unexpired oracle prices, labeled by market
+	ValidPrices metrics.Gauge
+	// Seconds until the last oracle price expires, negative once every price has expired, labeled by market
+	TimeToPriceExpiry metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics registered with the default Prometheus registry
+func PrometheusMetrics(namespace string) *Metrics {
+	return &Metrics{
+		ValidPrices: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "valid_prices",
+			Help:      "Number of unexpired oracle prices for a market.",
+		}, []string{"market_id"}),
+		TimeToPriceExpiry: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "time_to_price_expiry_seconds",
+			Help:      "Seconds until the last oracle price for a market expires, negative once every price has expired.",
+		}, []string{"market_id"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ValidPrices:       discard.NewGauge(),
+		TimeToPriceExpiry: discard.NewGauge(),
+	}
+}
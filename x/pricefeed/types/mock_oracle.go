@@ -0,0 +1,91 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var (
+	// MockOracleAddress is the oracle address the mock oracle posts prices from
+	MockOracleAddress = sdk.AccAddress(crypto.AddressHash([]byte("pricefeed_mock_oracle")))
+	// MockPriceExpiry is how long prices posted by the mock oracle are valid for. Prices are posted every block, so
+	// they only expire if the chain is halted for longer than this.
+	MockPriceExpiry = time.Hour
+)
+
+// MockPrice is the price the mock oracle posts for a market
+type MockPrice struct {
+	MarketID string  `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec `json:"price" yaml:"price"`
+}
+
+// Validate performs a basic validation of the mock price
+func (mp MockPrice) Validate() error {
+	if strings.TrimSpace(mp.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if mp.Price.IsNil() || !mp.Price.IsPositive() {
+		return fmt.Errorf("mock price for %s must be positive: %s", mp.MarketID, mp.Price)
+	}
+	return nil
+}
+
+// MockPrices is a slice of MockPrice
+type MockPrices []MockPrice
+
+// ParseMockPrices parses mock prices of the form market_id=price, for example "kava:usd=2.00"
+func ParseMockPrices(prices []string) (MockPrices, error) {
+	mockPrices := make(MockPrices, 0, len(prices))
+	for _, p := range prices {
+		parts := strings.Split(p, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mock price %q, expected market_id=price", p)
+		}
+		price, err := sdk.NewDecFromStr(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mock price %q: %w", p, err)
+		}
+		mockPrice := MockPrice{MarketID: strings.TrimSpace(parts[0]), Price: price}
+		if err := mockPrice.Validate(); err != nil {
+			return nil, err
+		}
+		mockPrices = append(mockPrices, mockPrice)
+	}
+	return mockPrices, nil
+}
+
+// MockOracleConfig configures the mock oracle, which posts a price for each configured market every block so that
+// prices never expire on local networks. It must not be enabled on public networks.
+type MockOracleConfig struct {
+	Prices MockPrices
+	// RandomWalk is the largest fraction a price moves by each block. Zero or nil posts the configured prices unchanged.
+	RandomWalk sdk.Dec
+}
+
+// Enabled returns true if the mock oracle posts prices for any market
+func (c MockOracleConfig) Enabled() bool {
+	return len(c.Prices) > 0
+}
+
+// Validate performs a basic validation of the mock oracle config
+func (c MockOracleConfig) Validate() error {
+	seen := make(map[string]bool)
+	for _, mp := range c.Prices {
+		if err := mp.Validate(); err != nil {
+			return err
+		}
+		if seen[mp.MarketID] {
+			return fmt.Errorf("duplicate mock price for market %s", mp.MarketID)
+		}
+		seen[mp.MarketID] = true
+	}
+	if !c.RandomWalk.IsNil() && (c.RandomWalk.IsNegative() || c.RandomWalk.GTE(sdk.OneDec())) {
+		return fmt.Errorf("random walk must be at least zero and less than one: %s", c.RandomWalk)
+	}
+	return nil
+}
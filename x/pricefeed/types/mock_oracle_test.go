@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseMockPrices(t *testing.T) {
+	testCases := []struct {
+		name       string
+		prices     []string
+		expected   MockPrices
+		expectPass bool
+	}{
+		{"empty", []string{}, MockPrices{}, true},
+		{
+			"valid",
+			[]string{"kava:usd=2.00", " bnb:usd = 300"},
+			MockPrices{
+				{MarketID: "kava:usd", Price: sdk.MustNewDecFromStr("2.00")},
+				{MarketID: "bnb:usd", Price: sdk.MustNewDecFromStr("300")},
+			},
+			true,
+		},
+		{"missing price", []string{"kava:usd"}, nil, false},
+		{"invalid price", []string{"kava:usd=two"}, nil, false},
+		{"zero price", []string{"kava:usd=0"}, nil, false},
+		{"blank market", []string{"=2.00"}, nil, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prices, err := ParseMockPrices(tc.prices)
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expected, prices)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMockOracleConfigValidate(t *testing.T) {
+	prices := MockPrices{{MarketID: "kava:usd", Price: sdk.MustNewDecFromStr("2.00")}}
+
+	require.NoError(t, MockOracleConfig{}.Validate())
+	require.NoError(t, MockOracleConfig{Prices: prices, RandomWalk: sdk.MustNewDecFromStr("0.01")}.Validate())
+	require.Error(t, MockOracleConfig{Prices: append(prices, prices...)}.Validate())
+	require.Error(t, MockOracleConfig{Prices: prices, RandomWalk: sdk.OneDec()}.Validate())
+	require.Error(t, MockOracleConfig{Prices: prices, RandomWalk: sdk.MustNewDecFromStr("-0.01")}.Validate())
+}
@@ -13,13 +13,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgClaimOracleReward type of ClaimOracleReward msg
+	TypeMsgClaimOracleReward = "claim_oracle_reward"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgClaimOracleReward{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -77,3 +82,40 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// MsgClaimOracleReward struct representing a claim of the reward an oracle has accrued for posting prices
+type MsgClaimOracleReward struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+}
+
+// NewMsgClaimOracleReward creates a new claim oracle reward msg
+func NewMsgClaimOracleReward(oracle sdk.AccAddress) MsgClaimOracleReward {
+	return MsgClaimOracleReward{
+		Oracle: oracle,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgClaimOracleReward) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgClaimOracleReward) Type() string { return TypeMsgClaimOracleReward }
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimOracleReward) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimOracleReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Oracle}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgClaimOracleReward) ValidateBasic() error {
+	if msg.Oracle.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "oracle address cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleReward is the reward an oracle has accrued for posting prices and not yet claimed
+type OracleReward struct {
+	Oracle sdk.AccAddress `json:"oracle" yaml:"oracle"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewOracleReward returns a new OracleReward
+func NewOracleReward(oracle sdk.AccAddress, amount sdk.Coins) OracleReward {
+	return OracleReward{
+		Oracle: oracle,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic check of an OracleReward
+func (or OracleReward) Validate() error {
+	if or.Oracle.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	if !or.Amount.IsValid() {
+		return fmt.Errorf("invalid oracle reward amount: %s", or.Amount)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (or OracleReward) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Oracle: %s
+Amount: %s`, or.Oracle, or.Amount))
+}
+
+// OracleRewards type for an array of OracleReward
+type OracleRewards []OracleReward
+
+// Validate checks if all the oracle rewards are valid and there are no duplicated oracles
+func (ors OracleRewards) Validate() error {
+	seenOracles := make(map[string]bool)
+	for _, or := range ors {
+		if err := or.Validate(); err != nil {
+			return err
+		}
+		if seenOracles[or.Oracle.String()] {
+			return fmt.Errorf("duplicated oracle reward for oracle %s", or.Oracle)
+		}
+		seenOracles[or.Oracle.String()] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (ors OracleRewards) String() string {
+	out := "Oracle Rewards:\n"
+	for _, or := range ors {
+		out += fmt.Sprintf("%s\n", or.String())
+	}
+	return strings.TrimSpace(out)
+}
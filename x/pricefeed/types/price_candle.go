@@ -0,0 +1,125 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Intervals that current prices are downsampled into candles over
+const (
+	CandleIntervalHour = "hour"
+	CandleIntervalDay  = "day"
+)
+
+// CandleIntervals lists the supported candle intervals, shortest first
+var CandleIntervals = []string{CandleIntervalHour, CandleIntervalDay}
+
+// CandleIntervalDuration returns the length of a candle interval
+func CandleIntervalDuration(interval string) (time.Duration, error) {
+	switch interval {
+	case CandleIntervalHour:
+		return time.Hour, nil
+	case CandleIntervalDay:
+		return 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid candle interval %s, must be one of %s", interval, strings.Join(CandleIntervals, ", "))
+	}
+}
+
+// PriceCandle summarizes the current prices of a market over one interval
+type PriceCandle struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Interval string    `json:"interval" yaml:"interval"`
+	OpenTime time.Time `json:"open_time" yaml:"open_time"`
+	Open     sdk.Dec   `json:"open" yaml:"open"`
+	High     sdk.Dec   `json:"high" yaml:"high"`
+	Low      sdk.Dec   `json:"low" yaml:"low"`
+	Close    sdk.Dec   `json:"close" yaml:"close"`
+}
+
+// NewPriceCandle returns a new PriceCandle opened at a price
+func NewPriceCandle(marketID, interval string, openTime time.Time, price sdk.Dec) PriceCandle {
+	return PriceCandle{
+		MarketID: marketID,
+		Interval: interval,
+		OpenTime: openTime,
+		Open:     price,
+		High:     price,
+		Low:      price,
+		Close:    price,
+	}
+}
+
+// Update returns the candle with a new price applied to its high, low and close
+func (pc PriceCandle) Update(price sdk.Dec) PriceCandle {
+	pc.High = sdk.MaxDec(pc.High, price)
+	pc.Low = sdk.MinDec(pc.Low, price)
+	pc.Close = price
+	return pc
+}
+
+// Validate performs a basic check of a PriceCandle
+func (pc PriceCandle) Validate() error {
+	if strings.TrimSpace(pc.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	interval, err := CandleIntervalDuration(pc.Interval)
+	if err != nil {
+		return err
+	}
+	if pc.OpenTime.IsZero() || !pc.OpenTime.Equal(pc.OpenTime.Truncate(interval)) {
+		return fmt.Errorf("open time %s is not the start of a %s interval", pc.OpenTime, pc.Interval)
+	}
+	for _, price := range []sdk.Dec{pc.Open, pc.High, pc.Low, pc.Close} {
+		if price.IsNil() || !price.IsPositive() {
+			return fmt.Errorf("candle prices must be positive: %s", pc)
+		}
+	}
+	if pc.Low.GT(pc.High) || pc.Open.GT(pc.High) || pc.Close.GT(pc.High) || pc.Open.LT(pc.Low) || pc.Close.LT(pc.Low) {
+		return fmt.Errorf("candle open and close must be between low and high: %s", pc)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pc PriceCandle) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Interval: %s
+Open Time: %s
+Open: %s
+High: %s
+Low: %s
+Close: %s`, pc.MarketID, pc.Interval, pc.OpenTime, pc.Open, pc.High, pc.Low, pc.Close))
+}
+
+// PriceCandles type for an array of PriceCandle
+type PriceCandles []PriceCandle
+
+// Validate checks if all the candles are valid and there are no duplicated entries
+func (pcs PriceCandles) Validate() error {
+	seenCandles := make(map[string]bool)
+	for _, pc := range pcs {
+		if err := pc.Validate(); err != nil {
+			return err
+		}
+		key := string(PriceCandleKey(pc.MarketID, pc.Interval, pc.OpenTime))
+		if seenCandles[key] {
+			return fmt.Errorf("duplicated %s candle for market %s at %s", pc.Interval, pc.MarketID, pc.OpenTime)
+		}
+		seenCandles[key] = true
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pcs PriceCandles) String() string {
+	out := "Price Candles:\n"
+	for _, pc := range pcs {
+		out += fmt.Sprintf("%s\n", pc.String())
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+
+	yaml "gopkg.in/yaml.v2"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeMarketStatus defines the type for a MarketStatusProposal
+	ProposalTypeMarketStatus = "PricefeedMarketStatus"
+)
+
+// ensure proposal types fulfill the gov Content interface
+var _ govtypes.Content = MarketStatusProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeMarketStatus)
+	govtypes.RegisterProposalTypeCodec(MarketStatusProposal{}, "pricefeed/MarketStatusProposal")
+}
+
+// MarketStatusProposal is a proposal to activate or deactivate a market. Deactivating a market clears its current
+// price, so consumers stop reading it as soon as the proposal is enacted.
+type MarketStatusProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+	Active      bool   `json:"active" yaml:"active"`
+}
+
+// NewMarketStatusProposal returns a new MarketStatusProposal
+func NewMarketStatusProposal(title, description, marketID string, active bool) MarketStatusProposal {
+	return MarketStatusProposal{
+		Title:       title,
+		Description: description,
+		MarketID:    marketID,
+		Active:      active,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (msp MarketStatusProposal) GetTitle() string { return msp.Title }
+
+// GetDescription returns the description of the proposal.
+func (msp MarketStatusProposal) GetDescription() string { return msp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (msp MarketStatusProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (msp MarketStatusProposal) ProposalType() string { return ProposalTypeMarketStatus }
+
+// ValidateBasic runs basic stateless validity checks
+func (msp MarketStatusProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(msp); err != nil {
+		return err
+	}
+	if len(msp.MarketID) == 0 {
+		return errors.New("market id cannot be empty")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (msp MarketStatusProposal) String() string {
+	bz, _ := yaml.Marshal(msp)
+	return string(bz)
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarketStatusProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   MarketStatusProposal
+		expectPass bool
+	}{
+		{"deactivate", NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", false), true},
+		{"activate", NewMarketStatusProposal("A Title", "A description for this proposal.", "btc:usd", true), true},
+		{"empty market id", NewMarketStatusProposal("A Title", "A description for this proposal.", "", false), false},
+		{"empty title", NewMarketStatusProposal("", "A description for this proposal.", "btc:usd", false), false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.proposal.ValidateBasic())
+			} else {
+				require.Error(t, tc.proposal.ValidateBasic())
+			}
+		})
+	}
+}
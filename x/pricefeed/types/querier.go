@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -17,6 +23,14 @@ const (
 	QueryPrice = "price"
 	// QueryPrices command for quering all prices
 	QueryPrices = "prices"
+	// QueryOracleReward command for querying the unclaimed reward of an oracle
+	QueryOracleReward = "oracle-reward"
+	// QueryOracleRewards command for querying the unclaimed rewards of all oracles
+	QueryOracleRewards = "oracle-rewards"
+	// QueryPriceHistory command for querying the downsampled price candles of a market
+	QueryPriceHistory = "price-history"
+	// QueryMarketMetadata command for querying the display metadata of markets
+	QueryMarketMetadata = "market-metadata"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -30,3 +44,33 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// QueryOracleRewardParams fields for querying the reward of a specific oracle
+type QueryOracleRewardParams struct {
+	Oracle sdk.AccAddress
+}
+
+// NewQueryOracleRewardParams creates a new instance of QueryOracleRewardParams
+func NewQueryOracleRewardParams(oracle sdk.AccAddress) QueryOracleRewardParams {
+	return QueryOracleRewardParams{
+		Oracle: oracle,
+	}
+}
+
+// QueryPriceHistoryParams fields for querying the price candles of a market over an interval
+type QueryPriceHistoryParams struct {
+	MarketID string
+	Interval string
+	Start    time.Time
+	End      time.Time
+}
+
+// NewQueryPriceHistoryParams creates a new instance of QueryPriceHistoryParams
+func NewQueryPriceHistoryParams(marketID, interval string, start, end time.Time) QueryPriceHistoryParams {
+	return QueryPriceHistoryParams{
+		MarketID: marketID,
+		Interval: interval,
+		Start:    start,
+		End:      end,
+	}
+}
@@ -0,0 +1,10 @@
+package savings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker accrues interest on every allowed denom
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.AccrueInterest(ctx)
+}
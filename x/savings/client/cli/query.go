@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// flags for cli queries
+const (
+	flagDenom = "denom"
+	flagOwner = "owner"
+)
+
+// GetQueryCmd returns the cli query commands for the savings module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	savingsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the savings module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	savingsQueryCmd.AddCommand(flags.GetCommands(
+		queryParamsCmd(queryRoute, cdc),
+		queryDepositsCmd(queryRoute, cdc),
+		queryTotalDepositedCmd(queryRoute, cdc),
+	)...)
+
+	return savingsQueryCmd
+}
+
+func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the savings module parameters",
+		Long:  "Get the current savings module parameters, including the allowed denoms and their interest rates.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetParams)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func queryDepositsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits",
+		Short: "query savings module deposits with optional filters",
+		Long: strings.TrimSpace(`query for all savings module deposits or a specific deposit using flags:
+
+		Example:
+		$ kvcli q savings deposits
+		$ kvcli q savings deposits --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny
+		$ kvcli q savings deposits --denom usdx`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+			if ownerBech := viper.GetString(flagOwner); len(ownerBech) != 0 {
+				depositOwner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				owner = depositOwner
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			params := types.NewQueryDepositsParams(page, limit, viper.GetString(flagDenom), owner)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeposits)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var deposits types.Deposits
+			if err := cdc.UnmarshalJSON(res, &deposits); err != nil {
+				return fmt.Errorf("failed to unmarshal deposits: %w", err)
+			}
+			return cliCtx.PrintOutput(deposits)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+	cmd.Flags().String(flagOwner, "", "(optional) filter for deposits by owner address")
+	cmd.Flags().String(flagDenom, "", "(optional) filter for deposits by denom")
+	return cmd
+}
+
+func queryTotalDepositedCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "total-deposited",
+		Short: "get total current deposited amount",
+		Long: strings.TrimSpace(`get the total amount of coins currently deposited in savings using flags:
+
+		Example:
+		$ kvcli q savings total-deposited
+		$ kvcli q savings total-deposited --denom usdx`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryTotalDepositedParams(viper.GetString(flagDenom))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetTotalDeposited)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var totalDeposited sdk.Coins
+			if err := cdc.UnmarshalJSON(res, &totalDeposited); err != nil {
+				return fmt.Errorf("failed to unmarshal total deposited coins: %w", err)
+			}
+			return cliCtx.PrintOutput(totalDeposited)
+		},
+	}
+	cmd.Flags().String(flagDenom, "", "(optional) filter total deposited coins by denom")
+	return cmd
+}
@@ -0,0 +1,36 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// REST variable names
+// nolint
+const (
+	RestOwner = "owner"
+	RestDenom = "denom"
+)
+
+// RegisterRoutes registers savings-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
+
+// PostCreateDepositReq defines the properties of a deposit create request's body
+type PostCreateDepositReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// PostCreateWithdrawReq defines the properties of a deposit withdraw request's body
+type PostCreateWithdrawReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	From    sdk.AccAddress `json:"from" yaml:"from"`
+	Amount  sdk.Coins      `json:"amount" yaml:"amount"`
+}
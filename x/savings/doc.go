@@ -0,0 +1,8 @@
+/*
+Package savings implements a vault that pays a fixed interest rate on deposits of allowed denoms.
+
+Unlike hard, deposited coins are never lent out, so depositors carry no borrow-side risk and can withdraw at any
+time. Interest is simple interest on each denom's total deposits, paid every block from the denom's funding source
+(the kavadist module account or the hard reserves) and capped at what the funding source holds.
+*/
+package savings
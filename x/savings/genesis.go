@@ -0,0 +1,67 @@
+package savings
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, supplyKeeper types.SupplyKeeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	for _, gat := range gs.PreviousAccrualTimes {
+		// a zero accrual time means the denom starts accruing interest from the first block
+		if !gat.PreviousAccrualTime.IsZero() {
+			k.SetPreviousAccrualTime(ctx, gat.Denom, gat.PreviousAccrualTime)
+		}
+		k.SetInterestFactor(ctx, gat.Denom, gat.InterestFactor)
+	}
+
+	// the total deposited is derived from the deposits so the two can never disagree
+	totalDeposited := sdk.NewCoins()
+	for _, deposit := range gs.Deposits {
+		k.SetDeposit(ctx, deposit)
+		totalDeposited = totalDeposited.Add(deposit.Amount...)
+	}
+	k.SetTotalDeposited(ctx, totalDeposited)
+
+	// check if the module account exists
+	moduleAcc := supplyKeeper.GetModuleAccount(ctx, ModuleAccountName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", ModuleAccountName))
+	}
+}
+
+// ExportGenesis export genesis state for savings module
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	params := k.GetParams(ctx)
+
+	deposits := types.Deposits{}
+	k.IterateDeposits(ctx, func(d types.Deposit) bool {
+		syncedDeposit, found := k.GetSyncedDeposit(ctx, d.Depositor)
+		if !found {
+			panic(fmt.Sprintf("syncable deposit not found for %s", d.Depositor))
+		}
+		deposits = append(deposits, syncedDeposit)
+		return false
+	})
+
+	gats := types.GenesisAccrualTimes{}
+	for _, ad := range params.AllowedDenoms {
+		interestFactor, found := k.GetInterestFactor(ctx, ad.Denom)
+		if !found {
+			interestFactor = sdk.OneDec()
+		}
+		previousAccrualTime, _ := k.GetPreviousAccrualTime(ctx, ad.Denom)
+		gats = append(gats, types.NewGenesisAccrualTime(ad.Denom, previousAccrualTime, interestFactor))
+	}
+
+	return NewGenesisState(params, gats, deposits)
+}
@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// Deposit deposits coins into the savings module account. Coins are never lent out, so they can be withdrawn
+// at any time along with the interest they have earned.
+func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if _, found := k.GetAllowedDenom(ctx, coin.Denom); !found {
+			return sdkerrors.Wrapf(types.ErrInvalidDepositDenom, "%s", coin.Denom)
+		}
+		k.AccrueDenomInterest(ctx, coin.Denom)
+	}
+
+	existingDeposit, foundDeposit := k.GetDeposit(ctx, depositor)
+	if foundDeposit {
+		k.BeforeSavingsDepositModified(ctx, existingDeposit)
+	}
+	deposit := k.syncDeposit(ctx, depositor)
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, coins)
+	if err != nil {
+		return err
+	}
+
+	if !foundDeposit {
+		deposit = types.NewDeposit(depositor, sdk.NewCoins(), types.InterestFactors{})
+	}
+	for _, coin := range coins {
+		interestFactor, _ := k.GetInterestFactor(ctx, coin.Denom)
+		deposit.Index = deposit.Index.SetInterestFactor(coin.Denom, interestFactor)
+	}
+	deposit.Amount = deposit.Amount.Add(coins...)
+	k.SetDeposit(ctx, deposit)
+	k.IncrementTotalDeposited(ctx, coins)
+
+	if !foundDeposit {
+		k.AfterSavingsDepositCreated(ctx, deposit)
+	} else {
+		k.AfterSavingsDepositModified(ctx, deposit)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// Withdraw returns deposited coins and the interest they have earned to the depositor
+func (k Keeper) Withdraw(ctx sdk.Context, depositor sdk.AccAddress, coins sdk.Coins) error {
+	existingDeposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit found for %s", depositor)
+	}
+	for _, coin := range coins {
+		k.AccrueDenomInterest(ctx, coin.Denom)
+	}
+	k.BeforeSavingsDepositModified(ctx, existingDeposit)
+	deposit := k.syncDeposit(ctx, depositor)
+
+	remaining, isNegative := deposit.Amount.SafeSub(coins)
+	if isNegative {
+		return sdkerrors.Wrapf(types.ErrInvalidWithdrawAmount, "%s exceeds deposit %s", coins, deposit.Amount)
+	}
+
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, depositor, coins)
+	if err != nil {
+		return err
+	}
+	if err := k.DecrementTotalDeposited(ctx, coins); err != nil {
+		return err
+	}
+
+	for _, coin := range coins {
+		if remaining.AmountOf(coin.Denom).IsZero() {
+			deposit.Index, _ = deposit.Index.RemoveInterestFactor(coin.Denom)
+		}
+	}
+	deposit.Amount = remaining
+	if deposit.Amount.Empty() {
+		k.DeleteDeposit(ctx, deposit)
+	} else {
+		k.SetDeposit(ctx, deposit)
+	}
+	k.AfterSavingsDepositModified(ctx, deposit)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// GetSyncedDeposit returns a deposit including the interest it has earned, without updating state
+func (k Keeper) GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return types.Deposit{}, false
+	}
+	return k.loadSyncedDeposit(ctx, deposit), true
+}
+
+// syncDeposit adds the interest a deposit has earned to its amount and stores the synced deposit
+func (k Keeper) syncDeposit(ctx sdk.Context, depositor sdk.AccAddress) types.Deposit {
+	deposit, found := k.GetDeposit(ctx, depositor)
+	if !found {
+		return types.Deposit{}
+	}
+	deposit = k.loadSyncedDeposit(ctx, deposit)
+	k.SetDeposit(ctx, deposit)
+	return deposit
+}
+
+// loadSyncedDeposit calculates the interest earned by each denom of a deposit since its index was last updated
+func (k Keeper) loadSyncedDeposit(ctx sdk.Context, deposit types.Deposit) types.Deposit {
+	interest := sdk.NewCoins()
+	index := types.InterestFactors{}
+	for _, coin := range deposit.Amount {
+		interestFactor, found := k.GetInterestFactor(ctx, coin.Denom)
+		if !found {
+			interestFactor = sdk.OneDec()
+		}
+		if prevFactor, found := deposit.Index.GetInterestFactor(coin.Denom); found {
+			amount := coin.Amount.ToDec()
+			coinInterest := amount.Quo(prevFactor).Mul(interestFactor).Sub(amount).TruncateInt()
+			interest = interest.Add(sdk.NewCoin(coin.Denom, coinInterest))
+		}
+		index = index.SetInterestFactor(coin.Denom, interestFactor)
+	}
+	return types.NewDeposit(deposit.Depositor, deposit.Amount.Add(interest...), index)
+}
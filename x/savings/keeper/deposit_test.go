@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+func (suite *KeeperTestSuite) TestDeposit() {
+	depositor := suite.addrs[0]
+	coins := sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)))
+
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, coins))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, coins))
+
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(200*USDX_CF))), deposit.Amount)
+	suite.Require().Equal(types.InterestFactors{types.NewInterestFactor("usdx", sdk.OneDec())}, deposit.Index)
+	total, _ := suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().Equal(deposit.Amount, total)
+	suite.Require().Equal(deposit.Amount, suite.getModuleBalance(types.ModuleAccountName))
+	suite.Require().Equal(sdk.NewInt(800*USDX_CF), suite.getAccount(depositor).GetCoins().AmountOf("usdx"))
+
+	// denoms without an allowed denom param cannot be deposited
+	err := suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("bnb", sdk.NewInt(USDX_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInvalidDepositDenom))
+
+	// deposits are limited by the depositor's balance
+	err = suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(801*USDX_CF))))
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestWithdraw() {
+	depositor := suite.addrs[0]
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(
+		sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(100*USDX_CF)),
+	)))
+
+	err := suite.keeper.Withdraw(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF))))
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+
+	err = suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(101*USDX_CF))))
+	suite.Require().True(errors.Is(err, types.ErrInvalidWithdrawAmount))
+
+	// withdrawing a whole denom removes it from the deposit's index
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF)))))
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*USDX_CF))), deposit.Amount)
+	suite.Require().Equal(types.InterestFactors{types.NewInterestFactor("ukava", sdk.OneDec())}, deposit.Index)
+	suite.Require().Equal(sdk.NewInt(1000*USDX_CF), suite.getAccount(depositor).GetCoins().AmountOf("usdx"))
+
+	// withdrawing everything deletes the deposit
+	suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, deposit.Amount))
+	_, found = suite.keeper.GetDeposit(suite.ctx, depositor)
+	suite.Require().False(found)
+	total, _ := suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().True(total.Empty())
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const (
+	secondsPerYear = 31536000
+	// interestFundingIncident is the incident recorded when interest is paid out of the hard reserves
+	interestFundingIncident = "savings interest"
+)
+
+// AccrueInterest accrues interest on every allowed denom
+func (k Keeper) AccrueInterest(ctx sdk.Context) {
+	for _, allowedDenom := range k.GetParams(ctx).AllowedDenoms {
+		k.AccrueDenomInterest(ctx, allowedDenom.Denom)
+	}
+}
+
+// AccrueDenomInterest pays the simple interest earned by a denom's total deposits since the previous accrual,
+// taking it from the denom's funding source. Interest is capped at what the funding source holds, and is
+// credited to depositors by increasing the denom's interest factor.
+func (k Keeper) AccrueDenomInterest(ctx sdk.Context, denom string) {
+	previousAccrualTime, found := k.GetPreviousAccrualTime(ctx, denom)
+	if !found {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		k.SetInterestFactor(ctx, denom, sdk.OneDec())
+		return
+	}
+	timeElapsed := int64(ctx.BlockTime().Sub(previousAccrualTime).Seconds())
+	if timeElapsed <= 0 {
+		return
+	}
+
+	interestFactor, found := k.GetInterestFactor(ctx, denom)
+	if !found {
+		interestFactor = sdk.OneDec()
+	}
+	totalDeposited, _ := k.GetTotalDeposited(ctx)
+	total := totalDeposited.AmountOf(denom)
+	allowedDenom, found := k.GetAllowedDenom(ctx, denom)
+	if !found || !total.IsPositive() || allowedDenom.InterestRateAPY.IsZero() {
+		k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+		return
+	}
+
+	interest := total.ToDec().Mul(allowedDenom.InterestRateAPY).MulInt64(timeElapsed).QuoInt64(secondsPerYear).TruncateInt()
+	if interest.IsZero() {
+		// wait for enough time to pass for the interest to reach a whole unit
+		return
+	}
+
+	// a funding source that fails to pay leaves depositors without interest for the period instead of halting the chain
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	funded, err := k.fundInterest(cacheCtx, allowedDenom, sdk.NewCoin(denom, interest))
+	k.SetPreviousAccrualTime(ctx, denom, ctx.BlockTime())
+	if err != nil {
+		k.Logger(ctx).Error("failed to fund savings interest", "denom", denom, "err", err)
+		return
+	}
+	if funded.IsZero() {
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	interestFactor = interestFactor.Mul(sdk.OneDec().Add(funded.Amount.ToDec().Quo(total.ToDec())))
+	k.SetInterestFactor(ctx, denom, interestFactor)
+	k.IncrementTotalDeposited(ctx, sdk.NewCoins(funded))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsInterestAccrual,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyInterest, funded.String()),
+			sdk.NewAttribute(types.AttributeKeyFundingSource, string(allowedDenom.FundingSource)),
+		),
+	)
+}
+
+// fundInterest moves interest from a denom's funding source into the savings module account and returns the
+// amount that was paid, which is less than the interest owed when the funding source runs low
+func (k Keeper) fundInterest(ctx sdk.Context, allowedDenom types.AllowedDenom, interest sdk.Coin) (sdk.Coin, error) {
+	switch allowedDenom.FundingSource {
+	case types.FundingSourceKavadist:
+		available := k.supplyKeeper.GetModuleAccount(ctx, kavadisttypes.KavaDistMacc).GetCoins().AmountOf(interest.Denom)
+		funded := sdk.NewCoin(interest.Denom, sdk.MinInt(interest.Amount, available))
+		if funded.IsZero() {
+			return funded, nil
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, kavadisttypes.KavaDistMacc, types.ModuleAccountName, sdk.NewCoins(funded))
+		return funded, err
+	case types.FundingSourceHardReserves:
+		reserves, _ := k.hardKeeper.GetTotalReserves(ctx)
+		funded := sdk.NewCoin(interest.Denom, sdk.MinInt(interest.Amount, reserves.AmountOf(interest.Denom)))
+		if funded.IsZero() {
+			return funded, nil
+		}
+		payouts := hardtypes.ReservePayouts{
+			hardtypes.NewReservePayout(k.supplyKeeper.GetModuleAddress(types.ModuleAccountName), sdk.NewCoins(funded)),
+		}
+		return funded, k.hardKeeper.PayoutReserves(ctx, interestFundingIncident, payouts)
+	default:
+		return sdk.Coin{}, fmt.Errorf("invalid funding source: %s", allowedDenom.FundingSource)
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	kavadisttypes "github.com/kava-labs/kava/x/kavadist/types"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const oneYear = 365 * 24 * time.Hour
+
+func (suite *KeeperTestSuite) TestAccrueInterestFromKavadist() {
+	testCases := []struct {
+		name             string
+		kavadistBalance  sdk.Int
+		expectedInterest sdk.Int
+	}{
+		{
+			name:             "fully funded",
+			kavadistBalance:  sdk.NewInt(1000 * USDX_CF),
+			expectedInterest: sdk.NewInt(10 * USDX_CF),
+		},
+		{
+			name:             "capped at the funding source balance",
+			kavadistBalance:  sdk.NewInt(4 * USDX_CF),
+			expectedInterest: sdk.NewInt(4 * USDX_CF),
+		},
+		{
+			name:             "unfunded",
+			kavadistBalance:  sdk.ZeroInt(),
+			expectedInterest: sdk.ZeroInt(),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.kavadistBalance.IsPositive() {
+				err := suite.app.GetSupplyKeeper().MintCoins(suite.ctx, kavadisttypes.KavaDistMacc, sdk.NewCoins(sdk.NewCoin("usdx", tc.kavadistBalance)))
+				suite.Require().NoError(err)
+			}
+
+			depositor := suite.addrs[0]
+			principal := sdk.NewCoin("usdx", sdk.NewInt(100*USDX_CF))
+			suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(principal)))
+
+			suite.advanceTime(oneYear)
+			suite.keeper.AccrueInterest(suite.ctx)
+
+			expected := sdk.NewCoins(principal.Add(sdk.NewCoin("usdx", tc.expectedInterest)))
+			total, _ := suite.keeper.GetTotalDeposited(suite.ctx)
+			suite.Require().Equal(expected, total)
+			suite.Require().Equal(expected, suite.getModuleBalance(types.ModuleAccountName))
+			deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, depositor)
+			suite.Require().True(found)
+			suite.Require().Equal(expected, deposit.Amount)
+
+			// the earned interest can be withdrawn along with the deposit
+			suite.Require().NoError(suite.keeper.Withdraw(suite.ctx, depositor, expected))
+			suite.Require().Equal(sdk.NewInt(900*USDX_CF).Add(expected.AmountOf("usdx")), suite.getAccount(depositor).GetCoins().AmountOf("usdx"))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccrueInterestFromHardReserves() {
+	reserves := sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(100*USDX_CF)))
+	suite.Require().NoError(suite.app.GetSupplyKeeper().MintCoins(suite.ctx, hardtypes.ModuleAccountName, reserves))
+	hardKeeper := suite.app.GetHardKeeper()
+	hardKeeper.SetTotalReserves(suite.ctx, reserves)
+
+	depositor := suite.addrs[0]
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, depositor, sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(200*USDX_CF)))))
+
+	// interest is shared between depositors in proportion to their deposits
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[1], sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(200*USDX_CF)))))
+
+	suite.advanceTime(oneYear / 2)
+	suite.keeper.AccrueInterest(suite.ctx)
+
+	remainingReserves, _ := hardKeeper.GetTotalReserves(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(90*USDX_CF))), remainingReserves)
+	for _, addr := range suite.addrs {
+		deposit, found := suite.keeper.GetSyncedDeposit(suite.ctx, addr)
+		suite.Require().True(found)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("ukava", sdk.NewInt(205*USDX_CF))), deposit.Amount)
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccrueInterestCarriesDust() {
+	suite.Require().NoError(suite.app.GetSupplyKeeper().MintCoins(suite.ctx, kavadisttypes.KavaDistMacc, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(USDX_CF)))))
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100)))))
+	previousAccrualTime, _ := suite.keeper.GetPreviousAccrualTime(suite.ctx, "usdx")
+
+	// 100usdx at 10% earns less than one unit per block, so the accrual time is held until a whole unit is owed
+	suite.advanceTime(time.Hour)
+	suite.keeper.AccrueInterest(suite.ctx)
+	accrualTime, _ := suite.keeper.GetPreviousAccrualTime(suite.ctx, "usdx")
+	suite.Require().Equal(previousAccrualTime, accrualTime)
+
+	suite.advanceTime(oneYear / 10)
+	suite.keeper.AccrueInterest(suite.ctx)
+	accrualTime, _ = suite.keeper.GetPreviousAccrualTime(suite.ctx, "usdx")
+	suite.Require().Equal(suite.ctx.BlockTime(), accrualTime)
+	total, _ := suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(101))), total)
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// Keeper keeper for the savings module
+type Keeper struct {
+	key           sdk.StoreKey
+	cdc           *codec.Codec
+	paramSubspace subspace.Subspace
+	supplyKeeper  types.SupplyKeeper
+	hardKeeper    types.HardKeeper
+	hooks         types.SavingsHooks
+}
+
+// NewKeeper creates a new keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
+	sk types.SupplyKeeper, hk types.HardKeeper) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		key:           key,
+		cdc:           cdc,
+		paramSubspace: paramstore,
+		supplyKeeper:  sk,
+		hardKeeper:    hk,
+		hooks:         nil,
+	}
+}
+
+// SetHooks sets the savings keeper hooks
+func (k *Keeper) SetHooks(hooks types.SavingsHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set savings hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetDeposit returns a deposit from the store for a particular depositor address
+func (k Keeper) GetDeposit(ctx sdk.Context, depositor sdk.AccAddress) (types.Deposit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	bz := store.Get(types.DepositKey(depositor))
+	if bz == nil {
+		return types.Deposit{}, false
+	}
+	var deposit types.Deposit
+	k.cdc.MustUnmarshalBinaryBare(bz, &deposit)
+	return deposit, true
+}
+
+// SetDeposit sets the input deposit in the store, keyed by the depositor address
+func (k Keeper) SetDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(deposit)
+	store.Set(types.DepositKey(deposit.Depositor), bz)
+}
+
+// DeleteDeposit deletes a deposit from the store
+func (k Keeper) DeleteDeposit(ctx sdk.Context, deposit types.Deposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	store.Delete(types.DepositKey(deposit.Depositor))
+}
+
+// IterateDeposits iterates over all deposit objects in the store and performs a callback function
+func (k Keeper) IterateDeposits(ctx sdk.Context, cb func(deposit types.Deposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositsKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.Deposit
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetTotalDeposited returns the total amount of coins deposited into the savings module
+func (k Keeper) GetTotalDeposited(ctx sdk.Context) (sdk.Coins, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalDepositedKey)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return sdk.Coins{}, false
+	}
+	var totalDeposited sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &totalDeposited)
+	return totalDeposited, true
+}
+
+// SetTotalDeposited sets the total amount of coins deposited into the savings module
+func (k Keeper) SetTotalDeposited(ctx sdk.Context, totalDeposited sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TotalDepositedKey)
+	if totalDeposited.Empty() {
+		store.Set([]byte{}, []byte{})
+	} else {
+		bz := k.cdc.MustMarshalBinaryBare(totalDeposited)
+		store.Set([]byte{}, bz)
+	}
+}
+
+// IncrementTotalDeposited increments the total amount of coins deposited into the savings module
+func (k Keeper) IncrementTotalDeposited(ctx sdk.Context, coins sdk.Coins) {
+	totalDeposited, _ := k.GetTotalDeposited(ctx)
+	k.SetTotalDeposited(ctx, totalDeposited.Add(coins...))
+}
+
+// DecrementTotalDeposited decrements the total amount of coins deposited into the savings module
+func (k Keeper) DecrementTotalDeposited(ctx sdk.Context, coins sdk.Coins) error {
+	totalDeposited, _ := k.GetTotalDeposited(ctx)
+	updatedTotal, isNegative := totalDeposited.SafeSub(coins)
+	if isNegative {
+		return types.ErrInsufficientTotalDeposited
+	}
+	k.SetTotalDeposited(ctx, updatedTotal)
+	return nil
+}
+
+// GetInterestFactor returns the current interest factor for an individual denom
+func (k Keeper) GetInterestFactor(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+	var interestFactor sdk.Dec
+	k.cdc.MustUnmarshalBinaryBare(bz, &interestFactor)
+	return interestFactor, true
+}
+
+// SetInterestFactor sets the current interest factor for an individual denom
+func (k Keeper) SetInterestFactor(ctx sdk.Context, denom string, interestFactor sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.InterestFactorPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(interestFactor)
+	store.Set([]byte(denom), bz)
+}
+
+// GetPreviousAccrualTime returns the last time interest was accrued for a denom
+func (k Keeper) GetPreviousAccrualTime(ctx sdk.Context, denom string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var previousAccrualTime time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &previousAccrualTime)
+	return previousAccrualTime, true
+}
+
+// SetPreviousAccrualTime sets the most recent interest accrual time for a denom
+func (k Keeper) SetPreviousAccrualTime(ctx sdk.Context, denom string, previousAccrualTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousAccrualTimePrefix)
+	bz := k.cdc.MustMarshalBinaryBare(previousAccrualTime)
+	store.Set([]byte(denom), bz)
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/savings/keeper"
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+const USDX_CF = 1000000
+
+// Test suite used for all keeper tests
+type KeeperTestSuite struct {
+	suite.Suite
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+// The default state used by each test: usdx pays 10% from kavadist and ukava pays 5% from the hard reserves
+func (suite *KeeperTestSuite) SetupTest() {
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	coins := sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(1000*USDX_CF)), sdk.NewCoin("ukava", sdk.NewInt(1000*USDX_CF)), sdk.NewCoin("bnb", sdk.NewInt(1000*USDX_CF)))
+	authGS := app.NewAuthGenState(addrs, []sdk.Coins{coins, coins})
+
+	savingsGS := types.NewGenesisState(types.NewParams(types.AllowedDenoms{
+		types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.1"), types.FundingSourceKavadist),
+		types.NewAllowedDenom("ukava", sdk.MustNewDecFromStr("0.05"), types.FundingSourceHardReserves),
+	}), types.DefaultAccrualTimes, types.DefaultDeposits)
+
+	tApp.InitializeFromGenesisStates(authGS,
+		app.GenesisState{types.ModuleName: types.ModuleCdc.MustMarshalJSON(savingsGS)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetSavingsKeeper()
+	suite.addrs = addrs
+}
+
+func (suite *KeeperTestSuite) TestGetSetDeleteDeposit() {
+	dep := types.NewDeposit(sdk.AccAddress("test"), sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100))),
+		types.InterestFactors{types.NewInterestFactor("usdx", sdk.OneDec())})
+
+	_, f := suite.keeper.GetDeposit(suite.ctx, sdk.AccAddress("test"))
+	suite.Require().False(f)
+
+	suite.keeper.SetDeposit(suite.ctx, dep)
+
+	testDeposit, f := suite.keeper.GetDeposit(suite.ctx, sdk.AccAddress("test"))
+	suite.Require().True(f)
+	suite.Require().Equal(dep, testDeposit)
+
+	suite.keeper.DeleteDeposit(suite.ctx, dep)
+
+	_, f = suite.keeper.GetDeposit(suite.ctx, sdk.AccAddress("test"))
+	suite.Require().False(f)
+}
+
+func (suite *KeeperTestSuite) TestGetSetTotalDeposited() {
+	total, _ := suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().True(total.Empty())
+
+	coins := sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100)))
+	suite.keeper.IncrementTotalDeposited(suite.ctx, coins)
+	total, _ = suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().Equal(coins, total)
+
+	suite.Require().NoError(suite.keeper.DecrementTotalDeposited(suite.ctx, coins))
+	total, found := suite.keeper.GetTotalDeposited(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().True(total.Empty())
+
+	err := suite.keeper.DecrementTotalDeposited(suite.ctx, coins)
+	suite.Require().True(errors.Is(err, types.ErrInsufficientTotalDeposited))
+}
+
+func (suite *KeeperTestSuite) getAccount(addr sdk.AccAddress) authexported.Account {
+	return suite.app.GetAccountKeeper().GetAccount(suite.ctx, addr)
+}
+
+func (suite *KeeperTestSuite) getModuleBalance(name string) sdk.Coins {
+	return suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, name).GetCoins()
+}
+
+func (suite *KeeperTestSuite) advanceTime(d time.Duration) {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(d))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
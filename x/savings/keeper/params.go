@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSubspace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetAllowedDenom returns the allowed denom param for a specific denom
+func (k Keeper) GetAllowedDenom(ctx sdk.Context, denom string) (types.AllowedDenom, bool) {
+	return k.GetParams(ctx).AllowedDenoms.Get(denom)
+}
+
+// InitializeParams sets the params to their defaults if they have not been set, such as on chains that were started
+// before the savings module was added
+func (k Keeper) InitializeParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeyAllowedDenoms) {
+		return
+	}
+	k.SetParams(ctx, types.DefaultParams())
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryGetParams:
+			return queryGetParams(ctx, req, k)
+		case types.QueryGetDeposits:
+			return queryGetDeposits(ctx, req, k)
+		case types.QueryGetTotalDeposited:
+			return queryGetTotalDeposited(ctx, req, k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
+		}
+	}
+}
+
+func queryGetParams(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDepositsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	deposits := types.Deposits{}
+	if len(params.Owner) > 0 {
+		deposit, found := k.GetSyncedDeposit(ctx, params.Owner)
+		if found && (len(params.Denom) == 0 || deposit.Amount.AmountOf(params.Denom).IsPositive()) {
+			deposits = append(deposits, deposit)
+		}
+	} else {
+		k.IterateDeposits(ctx, func(deposit types.Deposit) (stop bool) {
+			if len(params.Denom) == 0 || deposit.Amount.AmountOf(params.Denom).IsPositive() {
+				deposits = append(deposits, k.loadSyncedDeposit(ctx, deposit))
+			}
+			return false
+		})
+	}
+
+	start, end := client.Paginate(len(deposits), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		deposits = types.Deposits{}
+	} else {
+		deposits = deposits[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, deposits)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetTotalDeposited(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryTotalDepositedParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	totalDeposited, _ := k.GetTotalDeposited(ctx)
+
+	// If user specified a denom only return coins of that denom type
+	if len(params.Denom) > 0 {
+		totalDeposited = sdk.NewCoins(sdk.NewCoin(params.Denom, totalDeposited.AmountOf(params.Denom)))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, totalDeposited)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
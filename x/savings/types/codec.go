@@ -0,0 +1,19 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/codec"
+
+// ModuleCdc generic sealed codec to be used throughout module
+var ModuleCdc *codec.Codec
+
+func init() {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	ModuleCdc = cdc.Seal()
+}
+
+// RegisterCodec registers the necessary types for savings module
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgDeposit{}, "savings/MsgDeposit", nil)
+	cdc.RegisterConcrete(MsgWithdraw{}, "savings/MsgWithdraw", nil)
+}
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Deposit defines an amount of coins deposited into the savings module account
+type Deposit struct {
+	Depositor sdk.AccAddress  `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins       `json:"amount" yaml:"amount"`
+	Index     InterestFactors `json:"index" yaml:"index"`
+}
+
+// NewDeposit returns a new deposit
+func NewDeposit(depositor sdk.AccAddress, amount sdk.Coins, indexes InterestFactors) Deposit {
+	return Deposit{
+		Depositor: depositor,
+		Amount:    amount,
+		Index:     indexes,
+	}
+}
+
+// Validate deposit validation
+func (d Deposit) Validate() error {
+	if d.Depositor.Empty() {
+		return fmt.Errorf("depositor cannot be empty")
+	}
+	if !d.Amount.IsValid() {
+		return fmt.Errorf("invalid deposit coins: %s", d.Amount)
+	}
+	return d.Index.Validate()
+}
+
+func (d Deposit) String() string {
+	return fmt.Sprintf(`Deposit:
+	Depositor: %s
+	Amount: %s
+	Index: %s
+	`, d.Depositor, d.Amount, d.Index)
+}
+
+// Deposits is a slice of Deposit
+type Deposits []Deposit
+
+// Validate validates Deposits
+func (ds Deposits) Validate() error {
+	seenDepositors := make(map[string]bool)
+	for _, d := range ds {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		if seenDepositors[d.Depositor.String()] {
+			return fmt.Errorf("duplicate depositor: %s", d.Depositor)
+		}
+		seenDepositors[d.Depositor.String()] = true
+	}
+	return nil
+}
+
+// InterestFactor defines the interest factor of a denom, which grows as interest is paid to depositors
+type InterestFactor struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Value sdk.Dec `json:"value" yaml:"value"`
+}
+
+// NewInterestFactor returns a new InterestFactor instance
+func NewInterestFactor(denom string, value sdk.Dec) InterestFactor {
+	return InterestFactor{
+		Denom: denom,
+		Value: value,
+	}
+}
+
+// Validate validates InterestFactor values
+func (f InterestFactor) Validate() error {
+	if strings.TrimSpace(f.Denom) == "" {
+		return fmt.Errorf("interest factor denom cannot be empty")
+	}
+	if f.Value.IsNil() || f.Value.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest factor value should be ≥ 1.0, is %s for %s", f.Value, f.Denom)
+	}
+	return nil
+}
+
+func (f InterestFactor) String() string {
+	return fmt.Sprintf(`[%s,%s]
+	`, f.Denom, f.Value)
+}
+
+// InterestFactors is a slice of InterestFactor, because Amino won't marshal maps
+type InterestFactors []InterestFactor
+
+// GetInterestFactor returns a denom's interest factor value
+func (fs InterestFactors) GetInterestFactor(denom string) (sdk.Dec, bool) {
+	for _, f := range fs {
+		if f.Denom == denom {
+			return f.Value, true
+		}
+	}
+	return sdk.ZeroDec(), false
+}
+
+// SetInterestFactor sets a denom's interest factor value
+func (fs InterestFactors) SetInterestFactor(denom string, factor sdk.Dec) InterestFactors {
+	for i, f := range fs {
+		if f.Denom == denom {
+			f.Value = factor
+			fs[i] = f
+			return fs
+		}
+	}
+	return append(fs, NewInterestFactor(denom, factor))
+}
+
+// RemoveInterestFactor removes a denom's interest factor value
+func (fs InterestFactors) RemoveInterestFactor(denom string) (InterestFactors, bool) {
+	for i, f := range fs {
+		if f.Denom == denom {
+			return append(fs[:i], fs[i+1:]...), true
+		}
+	}
+	return fs, false
+}
+
+// Validate validates InterestFactors
+func (fs InterestFactors) Validate() error {
+	for _, f := range fs {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fs InterestFactors) String() string {
+	out := ""
+	for _, f := range fs {
+		out += f.String()
+	}
+	return out
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DONTCOVER
+
+// Errors used by the savings module
+var (
+	// ErrInvalidDepositDenom error for when a deposit denom is not on the allowed list
+	ErrInvalidDepositDenom = sdkerrors.Register(ModuleName, 2, "invalid deposit denom")
+	// ErrDepositNotFound error for when a depositor has no deposit
+	ErrDepositNotFound = sdkerrors.Register(ModuleName, 3, "deposit not found")
+	// ErrInvalidWithdrawAmount error for when a withdrawal exceeds the deposit
+	ErrInvalidWithdrawAmount = sdkerrors.Register(ModuleName, 4, "invalid withdraw amount")
+	// ErrInsufficientTotalDeposited error for when subtracting from the total deposited results in a negative amount
+	ErrInsufficientTotalDeposited = sdkerrors.Register(ModuleName, 5, "subtraction results in negative total deposited amount")
+)
@@ -0,0 +1,13 @@
+package types
+
+// Event types for savings module
+const (
+	EventTypeSavingsDeposit         = "savings_deposit"
+	EventTypeSavingsWithdrawal      = "savings_withdrawal"
+	EventTypeSavingsInterestAccrual = "savings_interest_accrual"
+	AttributeValueCategory          = ModuleName
+	AttributeKeyDepositor           = "depositor"
+	AttributeKeyDenom               = "denom"
+	AttributeKeyInterest            = "interest"
+	AttributeKeyFundingSource       = "funding_source"
+)
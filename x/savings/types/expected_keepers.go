@@ -0,0 +1,30 @@
+package types // noalias
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
+)
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) exported.ModuleAccountI
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// HardKeeper defines the expected hard keeper, used to fund interest from the hard reserves
+type HardKeeper interface {
+	GetTotalReserves(ctx sdk.Context) (sdk.Coins, bool)
+	PayoutReserves(ctx sdk.Context, incident string, payouts hardtypes.ReservePayouts) error
+}
+
+// SavingsHooks event hooks for other keepers to run code in response to savings deposit modifications
+type SavingsHooks interface {
+	AfterSavingsDepositCreated(ctx sdk.Context, deposit Deposit)
+	BeforeSavingsDepositModified(ctx sdk.Context, deposit Deposit)
+	AfterSavingsDepositModified(ctx sdk.Context, deposit Deposit)
+}
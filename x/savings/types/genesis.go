@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the state that must be provided at genesis.
+type GenesisState struct {
+	Params               Params              `json:"params" yaml:"params"`
+	PreviousAccrualTimes GenesisAccrualTimes `json:"previous_accrual_times" yaml:"previous_accrual_times"`
+	Deposits             Deposits            `json:"deposits" yaml:"deposits"`
+}
+
+// NewGenesisState returns a new genesis state
+func NewGenesisState(params Params, prevAccrualTimes GenesisAccrualTimes, deposits Deposits) GenesisState {
+	return GenesisState{
+		Params:               params,
+		PreviousAccrualTimes: prevAccrualTimes,
+		Deposits:             deposits,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), DefaultAccrualTimes, DefaultDeposits)
+}
+
+// Validate performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.PreviousAccrualTimes.Validate(); err != nil {
+		return err
+	}
+	return gs.Deposits.Validate()
+}
+
+// Equal checks whether two gov GenesisState structs are equivalent
+func (gs GenesisState) Equal(gs2 GenesisState) bool {
+	b1 := ModuleCdc.MustMarshalBinaryBare(gs)
+	b2 := ModuleCdc.MustMarshalBinaryBare(gs2)
+	return bytes.Equal(b1, b2)
+}
+
+// IsEmpty returns true if a GenesisState is empty
+func (gs GenesisState) IsEmpty() bool {
+	return gs.Equal(GenesisState{})
+}
+
+// GenesisAccrualTime stores the previous interest accrual time and interest factor of a denom
+type GenesisAccrualTime struct {
+	Denom               string    `json:"denom" yaml:"denom"`
+	PreviousAccrualTime time.Time `json:"previous_accrual_time" yaml:"previous_accrual_time"`
+	InterestFactor      sdk.Dec   `json:"interest_factor" yaml:"interest_factor"`
+}
+
+// NewGenesisAccrualTime returns a new GenesisAccrualTime
+func NewGenesisAccrualTime(denom string, prevTime time.Time, factor sdk.Dec) GenesisAccrualTime {
+	return GenesisAccrualTime{
+		Denom:               denom,
+		PreviousAccrualTime: prevTime,
+		InterestFactor:      factor,
+	}
+}
+
+// Validate performs validation of GenesisAccrualTime
+func (gat GenesisAccrualTime) Validate() error {
+	if err := sdk.ValidateDenom(gat.Denom); err != nil {
+		return err
+	}
+	if gat.InterestFactor.IsNil() || gat.InterestFactor.LT(sdk.OneDec()) {
+		return fmt.Errorf("interest factor should be ≥ 1.0, is %s for %s", gat.InterestFactor, gat.Denom)
+	}
+	return nil
+}
+
+// GenesisAccrualTimes slice of GenesisAccrualTime
+type GenesisAccrualTimes []GenesisAccrualTime
+
+// Validate performs validation of GenesisAccrualTimes
+func (gats GenesisAccrualTimes) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, gat := range gats {
+		if err := gat.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[gat.Denom] {
+			return fmt.Errorf("duplicate accrual time denom: %s", gat.Denom)
+		}
+		seenDenoms[gat.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func (suite *GenesisTestSuite) TestGenesisValidation() {
+	depositor := sdk.AccAddress("test1")
+	params := types.NewParams(types.AllowedDenoms{
+		types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.05"), types.FundingSourceKavadist),
+	})
+	validTime := types.NewGenesisAccrualTime("usdx", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), sdk.MustNewDecFromStr("1.02"))
+	validDeposit := types.NewDeposit(depositor, sdk.NewCoins(sdk.NewCoin("usdx", sdk.NewInt(100))),
+		types.InterestFactors{types.NewInterestFactor("usdx", sdk.MustNewDecFromStr("1.01"))})
+
+	testCases := []struct {
+		name        string
+		gs          types.GenesisState
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:       "default",
+			gs:         types.DefaultGenesisState(),
+			expectPass: true,
+		},
+		{
+			name:       "valid",
+			gs:         types.NewGenesisState(params, types.GenesisAccrualTimes{validTime}, types.Deposits{validDeposit}),
+			expectPass: true,
+		},
+		{
+			name: "interest factor below one",
+			gs: types.NewGenesisState(params, types.GenesisAccrualTimes{
+				types.NewGenesisAccrualTime("usdx", time.Time{}, sdk.MustNewDecFromStr("0.9")),
+			}, types.DefaultDeposits),
+			expectPass:  false,
+			expectedErr: "interest factor should be ≥ 1.0",
+		},
+		{
+			name:        "duplicate accrual time",
+			gs:          types.NewGenesisState(params, types.GenesisAccrualTimes{validTime, validTime}, types.DefaultDeposits),
+			expectPass:  false,
+			expectedErr: "duplicate accrual time denom",
+		},
+		{
+			name:        "duplicate depositor",
+			gs:          types.NewGenesisState(params, types.DefaultAccrualTimes, types.Deposits{validDeposit, validDeposit}),
+			expectPass:  false,
+			expectedErr: "duplicate depositor",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.gs.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
@@ -0,0 +1,32 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiSavingsHooks combine multiple savings hooks, all hook functions are run in array sequence
+type MultiSavingsHooks []SavingsHooks
+
+// NewMultiSavingsHooks returns a new MultiSavingsHooks
+func NewMultiSavingsHooks(hooks ...SavingsHooks) MultiSavingsHooks {
+	return hooks
+}
+
+// AfterSavingsDepositCreated runs after a deposit is created
+func (h MultiSavingsHooks) AfterSavingsDepositCreated(ctx sdk.Context, deposit Deposit) {
+	for i := range h {
+		h[i].AfterSavingsDepositCreated(ctx, deposit)
+	}
+}
+
+// BeforeSavingsDepositModified runs before a deposit is modified
+func (h MultiSavingsHooks) BeforeSavingsDepositModified(ctx sdk.Context, deposit Deposit) {
+	for i := range h {
+		h[i].BeforeSavingsDepositModified(ctx, deposit)
+	}
+}
+
+// AfterSavingsDepositModified runs after a deposit is modified
+func (h MultiSavingsHooks) AfterSavingsDepositModified(ctx sdk.Context, deposit Deposit) {
+	for i := range h {
+		h[i].AfterSavingsDepositModified(ctx, deposit)
+	}
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName name that will be used throughout the module
+	ModuleName = "savings"
+
+	// ModuleAccountName name of module account used to hold deposits
+	ModuleAccountName = ModuleName
+
+	// StoreKey Top level store key where all module items will be stored
+	StoreKey = ModuleName
+
+	// RouterKey Top level router key
+	RouterKey = ModuleName
+
+	// QuerierRoute Top level query string
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
+)
+
+var (
+	DepositsKeyPrefix         = []byte{0x01} // depositor address -> Deposit
+	TotalDepositedKey         = []byte{0x02} // -> sdk.Coins
+	InterestFactorPrefix      = []byte{0x03} // denom -> sdk.Dec
+	PreviousAccrualTimePrefix = []byte{0x04} // denom -> time
+)
+
+// DepositKey returns the key of a depositor's deposit
+func DepositKey(depositor sdk.AccAddress) []byte {
+	return depositor.Bytes()
+}
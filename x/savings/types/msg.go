@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg = &MsgDeposit{}
+	_ sdk.Msg = &MsgWithdraw{}
+)
+
+// MsgDeposit deposits coins into savings
+type MsgDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDeposit returns a new MsgDeposit
+func NewMsgDeposit(depositor sdk.AccAddress, amount sdk.Coins) MsgDeposit {
+	return MsgDeposit{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDeposit) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDeposit) Type() string { return "savings_deposit" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDeposit) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgDeposit) String() string {
+	return fmt.Sprintf(`Savings Deposit Message:
+	Depositor: %s
+	Amount: %s
+`, msg.Depositor, msg.Amount)
+}
+
+// MsgWithdraw withdraws coins from savings
+type MsgWithdraw struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdraw returns a new MsgWithdraw
+func NewMsgWithdraw(depositor sdk.AccAddress, amount sdk.Coins) MsgWithdraw {
+	return MsgWithdraw{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdraw) Type() string { return "savings_withdraw" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdraw) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "withdraw amount %s", msg.Amount)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgWithdraw) String() string {
+	return fmt.Sprintf(`Savings Withdraw Message:
+	Depositor: %s
+	Amount: %s
+`, msg.Depositor, msg.Amount)
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyAllowedDenoms     = []byte("AllowedDenoms")
+	DefaultAllowedDenoms = AllowedDenoms{}
+	DefaultDeposits      = Deposits{}
+	DefaultAccrualTimes  = GenesisAccrualTimes{}
+	// MaxInterestRateAPY is the highest interest rate that can be paid on a savings denom
+	MaxInterestRateAPY = sdk.OneDec()
+)
+
+// FundingSource selects the module account that the interest paid on a savings denom is taken from
+type FundingSource string
+
+// Supported funding sources
+const (
+	// FundingSourceKavadist pays interest from the kavadist module account
+	FundingSourceKavadist FundingSource = "kavadist"
+	// FundingSourceHardReserves pays interest from the hard module reserves
+	FundingSourceHardReserves FundingSource = "hard_reserves"
+)
+
+// Validate checks the funding source is supported
+func (fs FundingSource) Validate() error {
+	switch fs {
+	case FundingSourceKavadist, FundingSourceHardReserves:
+		return nil
+	default:
+		return fmt.Errorf("invalid funding source: %s", fs)
+	}
+}
+
+// Params governance parameters for the savings module
+type Params struct {
+	AllowedDenoms AllowedDenoms `json:"allowed_denoms" yaml:"allowed_denoms"`
+}
+
+// NewParams returns a new params object
+func NewParams(allowedDenoms AllowedDenoms) Params {
+	return Params{
+		AllowedDenoms: allowedDenoms,
+	}
+}
+
+// DefaultParams returns default params for savings module
+func DefaultParams() Params {
+	return NewParams(DefaultAllowedDenoms)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Allowed Denoms: %s`, p.AllowedDenoms)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenomsParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	return validateAllowedDenomsParam(p.AllowedDenoms)
+}
+
+func validateAllowedDenomsParam(i interface{}) error {
+	allowedDenoms, ok := i.(AllowedDenoms)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return allowedDenoms.Validate()
+}
+
+// AllowedDenom is a denom that can be deposited into savings, with the simple interest rate paid on deposits and
+// the module account the interest is paid from
+type AllowedDenom struct {
+	Denom           string        `json:"denom" yaml:"denom"`
+	InterestRateAPY sdk.Dec       `json:"interest_rate_apy" yaml:"interest_rate_apy"`
+	FundingSource   FundingSource `json:"funding_source" yaml:"funding_source"`
+}
+
+// NewAllowedDenom returns a new AllowedDenom
+func NewAllowedDenom(denom string, interestRateAPY sdk.Dec, fundingSource FundingSource) AllowedDenom {
+	return AllowedDenom{
+		Denom:           denom,
+		InterestRateAPY: interestRateAPY,
+		FundingSource:   fundingSource,
+	}
+}
+
+// Validate performs basic validation of an AllowedDenom
+func (ad AllowedDenom) Validate() error {
+	if err := sdk.ValidateDenom(ad.Denom); err != nil {
+		return err
+	}
+	if ad.InterestRateAPY.IsNil() || ad.InterestRateAPY.IsNegative() || ad.InterestRateAPY.GT(MaxInterestRateAPY) {
+		return fmt.Errorf("interest rate APY must be between 0.0-%s for %s", MaxInterestRateAPY, ad.Denom)
+	}
+	return ad.FundingSource.Validate()
+}
+
+// String implements fmt.Stringer
+func (ad AllowedDenom) String() string {
+	return fmt.Sprintf(`Allowed Denom:
+		Denom: %s
+		Interest Rate APY: %s
+		Funding Source: %s
+`, ad.Denom, ad.InterestRateAPY, ad.FundingSource)
+}
+
+// AllowedDenoms slice of AllowedDenom
+type AllowedDenoms []AllowedDenom
+
+// Validate performs basic validation of each allowed denom and checks that no denom is repeated
+func (ads AllowedDenoms) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, ad := range ads {
+		if err := ad.Validate(); err != nil {
+			return err
+		}
+		if seenDenoms[ad.Denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", ad.Denom)
+		}
+		seenDenoms[ad.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the allowed denom entry for a denom
+func (ads AllowedDenoms) Get(denom string) (AllowedDenom, bool) {
+	for _, ad := range ads {
+		if ad.Denom == denom {
+			return ad, true
+		}
+	}
+	return AllowedDenom{}, false
+}
+
+// String implements fmt.Stringer
+func (ads AllowedDenoms) String() string {
+	out := ""
+	for _, ad := range ads {
+		out += ad.String()
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/savings/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ParamsTestSuite) TestParamValidation() {
+	testCases := []struct {
+		name          string
+		allowedDenoms types.AllowedDenoms
+		expectPass    bool
+		expectedErr   string
+	}{
+		{
+			name:          "default",
+			allowedDenoms: types.DefaultAllowedDenoms,
+			expectPass:    true,
+		},
+		{
+			name: "valid",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.05"), types.FundingSourceKavadist),
+				types.NewAllowedDenom("ukava", sdk.ZeroDec(), types.FundingSourceHardReserves),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid denom",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("", sdk.MustNewDecFromStr("0.05"), types.FundingSourceKavadist),
+			},
+			expectPass:  false,
+			expectedErr: "invalid denom",
+		},
+		{
+			name: "negative interest rate",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("-0.05"), types.FundingSourceKavadist),
+			},
+			expectPass:  false,
+			expectedErr: "interest rate APY must be between",
+		},
+		{
+			name: "interest rate above max",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("1.01"), types.FundingSourceKavadist),
+			},
+			expectPass:  false,
+			expectedErr: "interest rate APY must be between",
+		},
+		{
+			name: "invalid funding source",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.05"), types.FundingSource("community_pool")),
+			},
+			expectPass:  false,
+			expectedErr: "invalid funding source",
+		},
+		{
+			name: "duplicate denom",
+			allowedDenoms: types.AllowedDenoms{
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.05"), types.FundingSourceKavadist),
+				types.NewAllowedDenom("usdx", sdk.MustNewDecFromStr("0.10"), types.FundingSourceHardReserves),
+			},
+			expectPass:  false,
+			expectedErr: "duplicate allowed denom",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := types.NewParams(tc.allowedDenoms).Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
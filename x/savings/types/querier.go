@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the savings module
+const (
+	QueryGetParams         = "params"
+	QueryGetDeposits       = "deposits"
+	QueryGetTotalDeposited = "total-deposited"
+)
+
+// QueryDepositsParams is the params for a filtered deposit query
+type QueryDepositsParams struct {
+	Page  int            `json:"page" yaml:"page"`
+	Limit int            `json:"limit" yaml:"limit"`
+	Denom string         `json:"denom" yaml:"denom"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewQueryDepositsParams creates a new QueryDepositsParams
+func NewQueryDepositsParams(page, limit int, denom string, owner sdk.AccAddress) QueryDepositsParams {
+	return QueryDepositsParams{
+		Page:  page,
+		Limit: limit,
+		Denom: denom,
+		Owner: owner,
+	}
+}
+
+// QueryTotalDepositedParams is the params for a filtered total deposited query
+type QueryTotalDepositedParams struct {
+	Denom string `json:"denom" yaml:"denom"`
+}
+
+// NewQueryTotalDepositedParams creates a new QueryTotalDepositedParams
+func NewQueryTotalDepositedParams(denom string) QueryTotalDepositedParams {
+	return QueryTotalDepositedParams{
+		Denom: denom,
+	}
+}
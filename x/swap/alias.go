@@ -0,0 +1,112 @@
+package swap
+
+import (
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/kava-labs/kava/x/swap/keeper
+// ALIASGEN: github.com/kava-labs/kava/x/swap/types
+
+const (
+	EventTypeSwapDeposit       = types.EventTypeSwapDeposit
+	EventTypeSwapWithdraw      = types.EventTypeSwapWithdraw
+	EventTypeSwapTrade         = types.EventTypeSwapTrade
+	EventTypePoolCreated       = types.EventTypePoolCreated
+	AttributeValueCategory     = types.AttributeValueCategory
+	AttributeKeyPoolID         = types.AttributeKeyPoolID
+	AttributeKeyDepositor      = types.AttributeKeyDepositor
+	AttributeKeyOwner          = types.AttributeKeyOwner
+	AttributeKeyRequester      = types.AttributeKeyRequester
+	AttributeKeyAmount         = types.AttributeKeyAmount
+	AttributeKeyShares         = types.AttributeKeyShares
+	AttributeKeySwapInput      = types.AttributeKeySwapInput
+	AttributeKeySwapOutput     = types.AttributeKeySwapOutput
+	AttributeKeyFeePaid        = types.AttributeKeyFeePaid
+	AttributeKeyProtocolFee    = types.AttributeKeyProtocolFee
+	AttributeKeyExactDirection = types.AttributeKeyExactDirection
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	DefaultParamspace          = types.DefaultParamspace
+	ModuleAccountName          = types.ModuleAccountName
+	QueryGetParams             = types.QueryGetParams
+	QueryGetPool               = types.QueryGetPool
+	QueryGetPools              = types.QueryGetPools
+	QueryGetDeposits           = types.QueryGetDeposits
+)
+
+var (
+	// functions aliases
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	RegisterCodec            = types.RegisterCodec
+	NewGenesisState          = types.NewGenesisState
+	DefaultGenesisState      = types.DefaultGenesisState
+	PoolKey                  = types.PoolKey
+	DepositorPoolSharesKey   = types.DepositorPoolSharesKey
+	NewMsgDeposit            = types.NewMsgDeposit
+	NewMsgWithdraw           = types.NewMsgWithdraw
+	NewMsgSwapExactForTokens = types.NewMsgSwapExactForTokens
+	NewMsgSwapForExactTokens = types.NewMsgSwapForExactTokens
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	ParamKeyTable            = types.ParamKeyTable
+	NewAllowedPool           = types.NewAllowedPool
+	PoolID                   = types.PoolID
+	NewPool                  = types.NewPool
+	NewShareRecord           = types.NewShareRecord
+	NewQueryPoolParams       = types.NewQueryPoolParams
+	NewQueryDepositsParams   = types.NewQueryDepositsParams
+	NewPoolStatsQueryResult  = types.NewPoolStatsQueryResult
+	NewDepositsQueryResult   = types.NewDepositsQueryResult
+
+	// variable aliases
+	ModuleCdc                 = types.ModuleCdc
+	ErrNotAllowed             = types.ErrNotAllowed
+	ErrInvalidDeadline        = types.ErrInvalidDeadline
+	ErrDeadlineExceeded       = types.ErrDeadlineExceeded
+	ErrInvalidSlippage        = types.ErrInvalidSlippage
+	ErrSlippageExceeded       = types.ErrSlippageExceeded
+	ErrInvalidPool            = types.ErrInvalidPool
+	ErrInvalidShares          = types.ErrInvalidShares
+	ErrPoolNotFound           = types.ErrPoolNotFound
+	ErrDepositNotFound        = types.ErrDepositNotFound
+	ErrInsufficientLiquidity  = types.ErrInsufficientLiquidity
+	PoolKeyPrefix             = types.PoolKeyPrefix
+	DepositorPoolSharesPrefix = types.DepositorPoolSharesPrefix
+	KeyAllowedPools           = types.KeyAllowedPools
+	KeySwapFee                = types.KeySwapFee
+	KeyProtocolFee            = types.KeyProtocolFee
+	DefaultAllowedPools       = types.DefaultAllowedPools
+	DefaultSwapFee            = types.DefaultSwapFee
+	DefaultProtocolFee        = types.DefaultProtocolFee
+	MaxSwapFee                = types.MaxSwapFee
+)
+
+type (
+	Keeper                = keeper.Keeper
+	GenesisState          = types.GenesisState
+	MsgWithDeadline       = types.MsgWithDeadline
+	MsgDeposit            = types.MsgDeposit
+	MsgWithdraw           = types.MsgWithdraw
+	MsgSwapExactForTokens = types.MsgSwapExactForTokens
+	MsgSwapForExactTokens = types.MsgSwapForExactTokens
+	Params                = types.Params
+	AllowedPool           = types.AllowedPool
+	AllowedPools          = types.AllowedPools
+	Pool                  = types.Pool
+	Pools                 = types.Pools
+	ShareRecord           = types.ShareRecord
+	ShareRecords          = types.ShareRecords
+	QueryPoolParams       = types.QueryPoolParams
+	QueryDepositsParams   = types.QueryDepositsParams
+	PoolStatsQueryResult  = types.PoolStatsQueryResult
+	PoolStatsQueryResults = types.PoolStatsQueryResults
+	DepositsQueryResult   = types.DepositsQueryResult
+	DepositsQueryResults  = types.DepositsQueryResults
+)
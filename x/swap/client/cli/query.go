@@ -0,0 +1,179 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// flags for cli queries
+const (
+	flagOwner = "owner"
+	flagPool  = "pool"
+)
+
+// GetQueryCmd returns the cli query commands for the swap module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	swapQueryCmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+	}
+
+	swapQueryCmd.AddCommand(flags.GetCommands(
+		queryParamsCmd(queryRoute, cdc),
+		queryPoolCmd(queryRoute, cdc),
+		queryPoolsCmd(queryRoute, cdc),
+		queryDepositsCmd(queryRoute, cdc),
+	)...)
+
+	return swapQueryCmd
+}
+
+func queryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: fmt.Sprintf("get the %s module parameters", types.ModuleName),
+		Long:  "Get the current global swap module parameters.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetParams)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var params types.Params
+			if err := cdc.UnmarshalJSON(res, &params); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func queryPoolCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "pool [pool-id]",
+		Short:   "get a liquidity pool by id",
+		Example: fmt.Sprintf("%s q %s pool ukava:usdx", "kvcli", types.ModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPoolParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetPool)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var pool types.PoolStatsQueryResult
+			if err := cdc.UnmarshalJSON(res, &pool); err != nil {
+				return fmt.Errorf("failed to unmarshal pool: %w", err)
+			}
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+func queryPoolsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pools",
+		Short: "get all liquidity pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetPools)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var pools types.PoolStatsQueryResults
+			if err := cdc.UnmarshalJSON(res, &pools); err != nil {
+				return fmt.Errorf("failed to unmarshal pools: %w", err)
+			}
+			return cliCtx.PrintOutput(pools)
+		},
+	}
+}
+
+func queryDepositsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposits",
+		Short: "query swap module deposits with optional filters",
+		Long: strings.TrimSpace(`query for all swap module deposits or a specific deposit using flags:
+
+		Example:
+		$ kvcli q swap deposits
+		$ kvcli q swap deposits --owner kava1l0xsq2z7gqd7yly0g40y5836g0appumark77ny --pool ukava:usdx
+		$ kvcli q swap deposits --pool bnb:usdx`,
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var owner sdk.AccAddress
+
+			ownerBech := viper.GetString(flagOwner)
+			poolID := viper.GetString(flagPool)
+
+			if len(ownerBech) != 0 {
+				depositOwner, err := sdk.AccAddressFromBech32(ownerBech)
+				if err != nil {
+					return err
+				}
+				owner = depositOwner
+			}
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			params := types.NewQueryDepositsParams(page, limit, owner, poolID)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeposits)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			var deposits types.DepositsQueryResults
+			if err := cdc.UnmarshalJSON(res, &deposits); err != nil {
+				return fmt.Errorf("failed to unmarshal deposits: %w", err)
+			}
+			return cliCtx.PrintOutput(deposits)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit (max 100)")
+	cmd.Flags().String(flagOwner, "", "(optional) filter for deposits by owner address")
+	cmd.Flags().String(flagPool, "", "(optional) filter for deposits by pool id")
+	return cmd
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// flags for cli transactions
+const (
+	flagDeadline = "deadline"
+)
+
+// GetTxCmd returns the transaction cli commands for the swap module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	swapTxCmd := &cobra.Command{
+		Use:   types.ModuleName,
+		Short: "transaction commands for the swap module",
+	}
+
+	cmds := []*cobra.Command{
+		getCmdDeposit(cdc),
+		getCmdWithdraw(cdc),
+		getCmdSwapExactForTokens(cdc),
+		getCmdSwapForExactTokens(cdc),
+	}
+	for _, cmd := range cmds {
+		cmd.Flags().Duration(flagDeadline, 5*time.Minute, "time after which the transaction is no longer valid")
+	}
+
+	swapTxCmd.AddCommand(flags.PostCommands(cmds...)...)
+
+	return swapTxCmd
+}
+
+func getCmdDeposit(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [tokenA] [tokenB] [slippage]",
+		Short: "deposit liquidity into a pool",
+		Long:  "Deposit tokens into a liquidity pool in exchange for shares. Creates the pool if the pair is allowed and the pool does not exist yet.",
+		Example: fmt.Sprintf(`$ %s tx %s deposit 10000000ukava 50000000usdx 0.01 --from <key>
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tokenA, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			tokenB, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeposit(cliCtx.GetFromAddress(), tokenA, tokenB, slippage, deadline())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdWithdraw(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw [shares] [minTokenA] [minTokenB]",
+		Short: "withdraw liquidity from a pool",
+		Long:  "Redeem shares of a liquidity pool for the pool tokens, failing if less than the minimum amount of either token would be received.",
+		Example: fmt.Sprintf(`$ %s tx %s withdraw 153000 10000ukava 50000usdx --from <key>
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			shares, ok := sdk.NewIntFromString(args[0])
+			if !ok {
+				return fmt.Errorf("invalid shares: %s", args[0])
+			}
+			minTokenA, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			minTokenB, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdraw(cliCtx.GetFromAddress(), shares, minTokenA, minTokenB, deadline())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSwapExactForTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-exact-for-tokens [exactTokenA] [tokenB] [slippage]",
+		Short: "swap an exact amount of token a for token b",
+		Long:  "Swap an exact amount of token a for token b, failing if the output is less than the expected token b amount by more than the slippage.",
+		Example: fmt.Sprintf(`$ %s tx %s swap-exact-for-tokens 1000000ukava 5000000usdx 0.01 --from <key>
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			exactTokenA, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			tokenB, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapExactForTokens(cliCtx.GetFromAddress(), exactTokenA, tokenB, slippage, deadline())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+func getCmdSwapForExactTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "swap-for-exact-tokens [tokenA] [exactTokenB] [slippage]",
+		Short: "swap token a for an exact amount of token b",
+		Long:  "Swap token a for an exact amount of token b, failing if the input is greater than the expected token a amount by more than the slippage.",
+		Example: fmt.Sprintf(`$ %s tx %s swap-for-exact-tokens 1000000ukava 5000000usdx 0.01 --from <key>
+		`, version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			tokenA, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			exactTokenB, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapForExactTokens(cliCtx.GetFromAddress(), tokenA, exactTokenB, slippage, deadline())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// deadline returns the unix time after which a transaction is no longer valid
+func deadline() int64 {
+	return time.Now().Add(viper.GetDuration(flagDeadline)).Unix()
+}
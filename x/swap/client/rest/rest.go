@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+// REST variable names
+// nolint
+const (
+	RestOwner  = "owner"
+	RestPoolID = "pool_id"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
+
+// PostDepositReq defines the properties of a deposit request's body
+type PostDepositReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	TokenA   sdk.Coin     `json:"token_a" yaml:"token_a"`
+	TokenB   sdk.Coin     `json:"token_b" yaml:"token_b"`
+	Slippage sdk.Dec      `json:"slippage" yaml:"slippage"`
+	Deadline int64        `json:"deadline" yaml:"deadline"`
+}
+
+// PostWithdrawReq defines the properties of a withdraw request's body
+type PostWithdrawReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Shares    sdk.Int      `json:"shares" yaml:"shares"`
+	MinTokenA sdk.Coin     `json:"min_token_a" yaml:"min_token_a"`
+	MinTokenB sdk.Coin     `json:"min_token_b" yaml:"min_token_b"`
+	Deadline  int64        `json:"deadline" yaml:"deadline"`
+}
+
+// PostSwapExactForTokensReq defines the properties of a swap exact for tokens request's body
+type PostSwapExactForTokensReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	ExactTokenA sdk.Coin     `json:"exact_token_a" yaml:"exact_token_a"`
+	TokenB      sdk.Coin     `json:"token_b" yaml:"token_b"`
+	Slippage    sdk.Dec      `json:"slippage" yaml:"slippage"`
+	Deadline    int64        `json:"deadline" yaml:"deadline"`
+}
+
+// PostSwapForExactTokensReq defines the properties of a swap for exact tokens request's body
+type PostSwapForExactTokensReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	TokenA      sdk.Coin     `json:"token_a" yaml:"token_a"`
+	ExactTokenB sdk.Coin     `json:"exact_token_b" yaml:"exact_token_b"`
+	Slippage    sdk.Dec      `json:"slippage" yaml:"slippage"`
+	Deadline    int64        `json:"deadline" yaml:"deadline"`
+}
@@ -0,0 +1,46 @@
+package swap
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// InitGenesis initializes the store state from a genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeeper, gs types.GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	// check if the module account exists
+	moduleAcc := supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+	if moduleAcc == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.ModuleAccountName))
+	}
+
+	k.SetParams(ctx, gs.Params)
+
+	totalReserves := sdk.NewCoins()
+	for _, pool := range gs.Pools {
+		k.SetPool(ctx, pool)
+		totalReserves = totalReserves.Add(pool.Reserves()...)
+	}
+	for _, record := range gs.ShareRecords {
+		k.SetDepositorShares(ctx, record)
+	}
+
+	if !moduleAcc.GetCoins().IsAllGTE(totalReserves) {
+		panic(fmt.Sprintf("%s module account balance %s is less than total pool reserves %s", types.ModuleAccountName, moduleAcc.GetCoins(), totalReserves))
+	}
+}
+
+// ExportGenesis export genesis state for swap module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	params := k.GetParams(ctx)
+	pools := k.GetAllPools(ctx)
+	records := k.GetAllDepositorShares(ctx)
+	return types.NewGenesisState(params, pools, records)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Deposit adds liquidity to a pool. If the pool does not exist, it is created at the price of the
+// deposit as long as the pair is allowed by params. For existing pools, the deposit is made at the
+// current pool price and fails if the price of the desired deposit differs by more than the slippage limit.
+func (k Keeper) Deposit(ctx sdk.Context, depositor sdk.AccAddress, coinA, coinB sdk.Coin, slippageLimit sdk.Dec) error {
+	desired := sdk.NewCoins(coinA, coinB)
+	poolID := types.PoolID(coinA.Denom, coinB.Denom)
+
+	var depositCoins sdk.Coins
+	var shares sdk.Int
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		if _, allowed := k.GetAllowedPool(ctx, poolID); !allowed {
+			return sdkerrors.Wrapf(types.ErrNotAllowed, "can not create pool '%s'", poolID)
+		}
+		newPool, err := types.NewPool(desired)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalidPool, err.Error())
+		}
+		pool = newPool
+		depositCoins = desired
+		shares = pool.TotalShares
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePoolCreated,
+				sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			),
+		)
+	} else {
+		poolPrice := pool.Reserves().AmountOf(coinB.Denom).ToDec().Quo(pool.Reserves().AmountOf(coinA.Denom).ToDec())
+		desiredPrice := coinB.Amount.ToDec().Quo(coinA.Amount.ToDec())
+		slippage := sdk.OneDec().Sub(sdk.MinDec(desiredPrice.Quo(poolPrice), poolPrice.Quo(desiredPrice)))
+		if slippage.GT(slippageLimit) {
+			return sdkerrors.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
+		}
+
+		var err error
+		depositCoins, shares, err = pool.AddLiquidity(desired)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInsufficientLiquidity, err.Error())
+		}
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleAccountName, depositCoins); err != nil {
+		return err
+	}
+
+	k.SetPool(ctx, pool)
+	k.addSharesToDepositor(ctx, depositor, poolID, shares)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapDeposit,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, depositCoins.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return nil
+}
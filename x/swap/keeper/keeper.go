@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Keeper keeper for the swap module
+type Keeper struct {
+	key                sdk.StoreKey
+	cdc                *codec.Codec
+	paramSubspace      subspace.Subspace
+	accountKeeper      types.AccountKeeper
+	supplyKeeper       types.SupplyKeeper
+	distributionKeeper types.DistributionKeeper
+}
+
+// NewKeeper returns a new keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, ak types.AccountKeeper,
+	sk types.SupplyKeeper, dk types.DistributionKeeper) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		key:                key,
+		cdc:                cdc,
+		paramSubspace:      paramstore,
+		accountKeeper:      ak,
+		supplyKeeper:       sk,
+		distributionKeeper: dk,
+	}
+}
+
+// GetPool returns a pool from the store
+func (k Keeper) GetPool(ctx sdk.Context, poolID string) (types.Pool, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
+	bz := store.Get(types.PoolKey(poolID))
+	if bz == nil {
+		return types.Pool{}, false
+	}
+	var pool types.Pool
+	k.cdc.MustUnmarshalBinaryBare(bz, &pool)
+	return pool, true
+}
+
+// SetPool saves a pool to the store
+func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
+	store.Set(types.PoolKey(pool.PoolID), k.cdc.MustMarshalBinaryBare(pool))
+}
+
+// DeletePool deletes a pool from the store
+func (k Keeper) DeletePool(ctx sdk.Context, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PoolKeyPrefix)
+	store.Delete(types.PoolKey(poolID))
+}
+
+// IteratePools iterates over all pools objects in the store and performs a callback function
+func (k Keeper) IteratePools(ctx sdk.Context, cb func(pool types.Pool) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.PoolKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var pool types.Pool
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &pool)
+		if cb(pool) {
+			break
+		}
+	}
+}
+
+// GetAllPools returns all pools from the store
+func (k Keeper) GetAllPools(ctx sdk.Context) (pools types.Pools) {
+	k.IteratePools(ctx, func(pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	return
+}
+
+// GetDepositorShares returns a share record from the store
+func (k Keeper) GetDepositorShares(ctx sdk.Context, depositor sdk.AccAddress, poolID string) (types.ShareRecord, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositorPoolSharesPrefix)
+	bz := store.Get(types.DepositorPoolSharesKey(depositor, poolID))
+	if bz == nil {
+		return types.ShareRecord{}, false
+	}
+	var record types.ShareRecord
+	k.cdc.MustUnmarshalBinaryBare(bz, &record)
+	return record, true
+}
+
+// SetDepositorShares saves a share record to the store
+func (k Keeper) SetDepositorShares(ctx sdk.Context, record types.ShareRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositorPoolSharesPrefix)
+	store.Set(types.DepositorPoolSharesKey(record.Depositor, record.PoolID), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// DeleteDepositorShares deletes a share record from the store
+func (k Keeper) DeleteDepositorShares(ctx sdk.Context, depositor sdk.AccAddress, poolID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositorPoolSharesPrefix)
+	store.Delete(types.DepositorPoolSharesKey(depositor, poolID))
+}
+
+// IterateDepositorShares iterates over all share records in the store and performs a callback function
+func (k Keeper) IterateDepositorShares(ctx sdk.Context, cb func(record types.ShareRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.DepositorPoolSharesPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllDepositorShares returns all share records from the store
+func (k Keeper) GetAllDepositorShares(ctx sdk.Context) (records types.ShareRecords) {
+	k.IterateDepositorShares(ctx, func(record types.ShareRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return
+}
+
+// GetAllDepositorSharesByOwner returns all share records owned by a depositor
+func (k Keeper) GetAllDepositorSharesByOwner(ctx sdk.Context, owner sdk.AccAddress) (records types.ShareRecords) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), append(types.DepositorPoolSharesPrefix, owner...))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ShareRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		records = append(records, record)
+	}
+	return
+}
+
+// addSharesToDepositor increments the shares owned by a depositor in a pool
+func (k Keeper) addSharesToDepositor(ctx sdk.Context, depositor sdk.AccAddress, poolID string, shares sdk.Int) {
+	record, found := k.GetDepositorShares(ctx, depositor, poolID)
+	if !found {
+		record = types.NewShareRecord(depositor, poolID, sdk.ZeroInt())
+	}
+	record.SharesOwned = record.SharesOwned.Add(shares)
+	k.SetDepositorShares(ctx, record)
+}
+
+// removeSharesFromDepositor decrements the shares owned by a depositor in a pool, deleting the record when no shares remain
+func (k Keeper) removeSharesFromDepositor(ctx sdk.Context, record types.ShareRecord, shares sdk.Int) {
+	record.SharesOwned = record.SharesOwned.Sub(shares)
+	if record.SharesOwned.IsZero() {
+		k.DeleteDepositorShares(ctx, record.Depositor, record.PoolID)
+		return
+	}
+	k.SetDepositorShares(ctx, record)
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Test suite used for all keeper tests
+type KeeperTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+// The default state used by each test
+func (suite *KeeperTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	coins := []sdk.Coins{}
+	for range addrs {
+		coins = append(coins, cs(c("ukava", 100000000), c("usdx", 500000000), c("bnb", 100000000)))
+	}
+	authGS := app.NewAuthGenState(addrs, coins)
+
+	params := types.NewParams(
+		types.AllowedPools{types.NewAllowedPool("ukava", "usdx")},
+		sdk.MustNewDecFromStr("0.003"),
+		sdk.MustNewDecFromStr("0.5"),
+	)
+	swapGS := app.GenesisState{
+		types.ModuleName: types.ModuleCdc.MustMarshalJSON(types.NewGenesisState(params, types.Pools{}, types.ShareRecords{})),
+	}
+
+	tApp.InitializeFromGenesisStates(authGS, swapGS)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)})
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetSwapKeeper()
+	suite.addrs = addrs
+}
+
+func (suite *KeeperTestSuite) TestGetSetParams() {
+	params := types.NewParams(types.AllowedPools{types.NewAllowedPool("bnb", "usdx")}, sdk.MustNewDecFromStr("0.01"), sdk.ZeroDec())
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestDeposit_CreatesPool() {
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec())
+	suite.Require().NoError(err)
+
+	pool, found := suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(c("ukava", 1000000), pool.ReservesA)
+	suite.Equal(c("usdx", 5000000), pool.ReservesB)
+	suite.Equal(sdk.NewInt(2236067), pool.TotalShares)
+
+	record, found := suite.keeper.GetDepositorShares(suite.ctx, suite.addrs[0], "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(pool.TotalShares, record.SharesOwned)
+
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[0], cs(c("ukava", 99000000), c("usdx", 495000000), c("bnb", 100000000)))
+	suite.Equal(pool.Reserves(), suite.getModuleBalance())
+}
+
+func (suite *KeeperTestSuite) TestDeposit_NotAllowed() {
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("bnb", 1000000), c("usdx", 5000000), sdk.ZeroDec())
+	suite.Require().True(errors.Is(err, types.ErrNotAllowed))
+}
+
+func (suite *KeeperTestSuite) TestDeposit_ExistingPool() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	// token order of the deposit does not matter, excess usdx is not deposited
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[1], c("usdx", 600000), c("ukava", 100000), sdk.MustNewDecFromStr("0.2"))
+	suite.Require().NoError(err)
+
+	record, found := suite.keeper.GetDepositorShares(suite.ctx, suite.addrs[1], "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(223606), record.SharesOwned)
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[1], cs(c("ukava", 99900000), c("usdx", 499500000), c("bnb", 100000000)))
+
+	pool, _ := suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.Equal(sdk.NewInt(2236067+223606), pool.TotalShares)
+	suite.Equal(pool.Reserves(), suite.getModuleBalance())
+}
+
+func (suite *KeeperTestSuite) TestDeposit_SlippageExceeded() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	err := suite.keeper.Deposit(suite.ctx, suite.addrs[1], c("ukava", 100000), c("usdx", 600000), sdk.MustNewDecFromStr("0.1"))
+	suite.Require().True(errors.Is(err, types.ErrSlippageExceeded))
+}
+
+func (suite *KeeperTestSuite) TestWithdraw() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	err := suite.keeper.Withdraw(suite.ctx, suite.addrs[0], sdk.NewInt(1118033), c("ukava", 499999), c("usdx", 2499998))
+	suite.Require().NoError(err)
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[0], cs(c("ukava", 99499999), c("usdx", 497499998), c("bnb", 100000000)))
+
+	pool, found := suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.Require().True(found)
+	suite.Equal(sdk.NewInt(1118034), pool.TotalShares)
+	suite.Equal(pool.Reserves(), suite.getModuleBalance())
+
+	// withdrawing the remaining shares deletes the pool
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[0], sdk.NewInt(1118034), c("ukava", 1), c("usdx", 1))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.False(found)
+	_, found = suite.keeper.GetDepositorShares(suite.ctx, suite.addrs[0], "ukava:usdx")
+	suite.False(found)
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[0], cs(c("ukava", 100000000), c("usdx", 500000000), c("bnb", 100000000)))
+}
+
+func (suite *KeeperTestSuite) TestWithdraw_Errors() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	err := suite.keeper.Withdraw(suite.ctx, suite.addrs[0], sdk.NewInt(1118033), c("ukava", 500000), c("usdx", 2499998))
+	suite.Require().True(errors.Is(err, types.ErrSlippageExceeded))
+
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[0], sdk.NewInt(2236068), c("ukava", 1), c("usdx", 1))
+	suite.Require().True(errors.Is(err, types.ErrInvalidShares))
+
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[1], sdk.NewInt(1), c("ukava", 1), c("usdx", 1))
+	suite.Require().True(errors.Is(err, types.ErrDepositNotFound))
+
+	err = suite.keeper.Withdraw(suite.ctx, suite.addrs[0], sdk.NewInt(1), c("bnb", 1), c("usdx", 1))
+	suite.Require().True(errors.Is(err, types.ErrPoolNotFound))
+}
+
+func (suite *KeeperTestSuite) TestSwapExactForTokens() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	err := suite.keeper.SwapExactForTokens(suite.ctx, suite.addrs[1], c("ukava", 10000), c("usdx", 50000), sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[1], cs(c("ukava", 99990000), c("usdx", 500049357), c("bnb", 100000000)))
+
+	// half of the 30ukava fee is sent to the community pool
+	pool, _ := suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.Equal(c("ukava", 1009985), pool.ReservesA)
+	suite.Equal(c("usdx", 4950643), pool.ReservesB)
+	suite.Equal(pool.Reserves(), suite.getModuleBalance())
+	suite.Equal(sdk.NewDecCoins(sdk.NewDecCoin("ukava", sdk.NewInt(15))).AmountOf("ukava"),
+		suite.app.GetDistrKeeper().GetFeePoolCommunityCoins(suite.ctx).AmountOf("ukava"))
+
+	err = suite.keeper.SwapExactForTokens(suite.ctx, suite.addrs[1], c("ukava", 10000), c("usdx", 50000), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().True(errors.Is(err, types.ErrSlippageExceeded))
+}
+
+func (suite *KeeperTestSuite) TestSwapForExactTokens() {
+	suite.Require().NoError(suite.keeper.Deposit(suite.ctx, suite.addrs[0], c("ukava", 1000000), c("usdx", 5000000), sdk.ZeroDec()))
+
+	err := suite.keeper.SwapForExactTokens(suite.ctx, suite.addrs[1], c("ukava", 10000), c("usdx", 50000), sdk.MustNewDecFromStr("0.02"))
+	suite.Require().NoError(err)
+	suite.app.CheckBalance(suite.T(), suite.ctx, suite.addrs[1], cs(c("ukava", 99989867), c("usdx", 500050000), c("bnb", 100000000)))
+
+	pool, _ := suite.keeper.GetPool(suite.ctx, "ukava:usdx")
+	suite.Equal(c("ukava", 1010118), pool.ReservesA)
+	suite.Equal(c("usdx", 4950000), pool.ReservesB)
+	suite.Equal(pool.Reserves(), suite.getModuleBalance())
+
+	err = suite.keeper.SwapForExactTokens(suite.ctx, suite.addrs[1], c("ukava", 10000), c("usdx", 50000), sdk.MustNewDecFromStr("0.01"))
+	suite.Require().True(errors.Is(err, types.ErrSlippageExceeded))
+
+	err = suite.keeper.SwapForExactTokens(suite.ctx, suite.addrs[1], c("ukava", 10000), c("usdx", 4950000), sdk.OneDec())
+	suite.Require().True(errors.Is(err, types.ErrInsufficientLiquidity))
+}
+
+func (suite *KeeperTestSuite) getModuleBalance() sdk.Coins {
+	return suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.ModuleAccountName).GetCoins()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
+func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSubspace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetAllowedPool returns the allowed pool for the input pool id and a boolean for if it was found
+func (k Keeper) GetAllowedPool(ctx sdk.Context, poolID string) (types.AllowedPool, bool) {
+	for _, allowedPool := range k.GetParams(ctx).AllowedPools {
+		if allowedPool.Name() == poolID {
+			return allowedPool, true
+		}
+	}
+	return types.AllowedPool{}, false
+}
+
+// GetSwapFee returns the swap fee from the params
+func (k Keeper) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).SwapFee
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryGetParams:
+			return queryGetParams(ctx, req, k)
+		case types.QueryGetPool:
+			return queryGetPool(ctx, req, k)
+		case types.QueryGetPools:
+			return queryGetPools(ctx, req, k)
+		case types.QueryGetDeposits:
+			return queryGetDeposits(ctx, req, k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
+		}
+	}
+}
+
+func queryGetParams(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	// Get params
+	params := k.GetParams(ctx)
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(k.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetPool(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPoolParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	pool, found := k.GetPool(ctx, params.PoolID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrPoolNotFound, params.PoolID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, types.NewPoolStatsQueryResult(pool))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetPools(ctx sdk.Context, _ abci.RequestQuery, k Keeper) ([]byte, error) {
+	results := types.PoolStatsQueryResults{}
+	k.IteratePools(ctx, func(pool types.Pool) bool {
+		results = append(results, types.NewPoolStatsQueryResult(pool))
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDepositsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var records types.ShareRecords
+	if len(params.Owner) > 0 {
+		records = k.GetAllDepositorSharesByOwner(ctx, params.Owner)
+	} else {
+		records = k.GetAllDepositorShares(ctx)
+	}
+
+	results := types.DepositsQueryResults{}
+	for _, record := range records {
+		if len(params.PoolID) > 0 && record.PoolID != params.PoolID {
+			continue
+		}
+		pool, found := k.GetPool(ctx, record.PoolID)
+		if !found {
+			return nil, sdkerrors.Wrap(types.ErrPoolNotFound, record.PoolID)
+		}
+		results = append(results, types.NewDepositsQueryResult(record, pool.ShareValue(record.SharesOwned)))
+	}
+
+	start, end := client.Paginate(len(results), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		results = types.DepositsQueryResults{}
+	} else {
+		results = results[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, results)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
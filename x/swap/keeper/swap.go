@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// SwapExactForTokens swaps an exact amount of coinA for coinB. The swap fails if the output is
+// less than the expected coinB by more than the slippage limit.
+func (k Keeper) SwapExactForTokens(ctx sdk.Context, requester sdk.AccAddress, exactCoinA, coinB sdk.Coin, slippageLimit sdk.Dec) error {
+	poolID := types.PoolID(exactCoinA.Denom, coinB.Denom)
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPoolNotFound, poolID)
+	}
+
+	output, fee, err := pool.SwapExactInput(exactCoinA, k.GetSwapFee(ctx))
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInsufficientLiquidity, err.Error())
+	}
+
+	if output.IsLT(coinB) {
+		slippage := coinB.Amount.Sub(output.Amount).ToDec().Quo(coinB.Amount.ToDec())
+		if slippage.GT(slippageLimit) {
+			return sdkerrors.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
+		}
+	}
+
+	return k.commitSwap(ctx, pool, requester, exactCoinA, output, fee, "input")
+}
+
+// SwapForExactTokens swaps coinA for an exact amount of coinB. The swap fails if the input required
+// is greater than the expected coinA by more than the slippage limit.
+func (k Keeper) SwapForExactTokens(ctx sdk.Context, requester sdk.AccAddress, coinA, exactCoinB sdk.Coin, slippageLimit sdk.Dec) error {
+	poolID := types.PoolID(coinA.Denom, exactCoinB.Denom)
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPoolNotFound, poolID)
+	}
+
+	input, fee, err := pool.SwapExactOutput(exactCoinB, k.GetSwapFee(ctx))
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInsufficientLiquidity, err.Error())
+	}
+
+	if coinA.IsLT(input) {
+		slippage := input.Amount.Sub(coinA.Amount).ToDec().Quo(coinA.Amount.ToDec())
+		if slippage.GT(slippageLimit) {
+			return sdkerrors.Wrapf(types.ErrSlippageExceeded, "slippage %s > limit %s", slippage, slippageLimit)
+		}
+	}
+
+	return k.commitSwap(ctx, pool, requester, input, exactCoinB, fee, "output")
+}
+
+// commitSwap transfers the swap input and output, routes the protocol portion of the fee to the
+// community pool, and saves the updated pool
+func (k Keeper) commitSwap(ctx sdk.Context, pool types.Pool, requester sdk.AccAddress, input, output, fee sdk.Coin, exactDirection string) error {
+	protocolFee := sdk.NewCoin(fee.Denom, fee.Amount.ToDec().Mul(k.GetParams(ctx).ProtocolFee).TruncateInt())
+	pool.RemoveFee(protocolFee)
+	k.SetPool(ctx, pool)
+
+	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, requester, types.ModuleAccountName, sdk.NewCoins(input)); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, requester, sdk.NewCoins(output)); err != nil {
+		return err
+	}
+	if protocolFee.IsPositive() {
+		macc := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleAccountName)
+		if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(protocolFee), macc.GetAddress()); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapTrade,
+			sdk.NewAttribute(types.AttributeKeyPoolID, pool.PoolID),
+			sdk.NewAttribute(types.AttributeKeyRequester, requester.String()),
+			sdk.NewAttribute(types.AttributeKeySwapInput, input.String()),
+			sdk.NewAttribute(types.AttributeKeySwapOutput, output.String()),
+			sdk.NewAttribute(types.AttributeKeyFeePaid, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyProtocolFee, protocolFee.String()),
+			sdk.NewAttribute(types.AttributeKeyExactDirection, exactDirection),
+		),
+	)
+	return nil
+}
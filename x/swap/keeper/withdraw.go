@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Withdraw redeems shares for the owner's proportion of the pool reserves. The withdraw fails
+// if the amount of either token withdrawn is less than the provided minimum.
+func (k Keeper) Withdraw(ctx sdk.Context, owner sdk.AccAddress, shares sdk.Int, minCoinA, minCoinB sdk.Coin) error {
+	poolID := types.PoolID(minCoinA.Denom, minCoinB.Denom)
+
+	pool, found := k.GetPool(ctx, poolID)
+	if !found {
+		return sdkerrors.Wrap(types.ErrPoolNotFound, poolID)
+	}
+	record, found := k.GetDepositorShares(ctx, owner, poolID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrDepositNotFound, "no deposit for %s in pool %s", owner, poolID)
+	}
+	if shares.GT(record.SharesOwned) {
+		return sdkerrors.Wrapf(types.ErrInvalidShares, "withdraw of %s shares greater than %s shares owned", shares, record.SharesOwned)
+	}
+
+	withdrawn, err := pool.RemoveLiquidity(shares)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidShares, err.Error())
+	}
+	if withdrawn.AmountOf(minCoinA.Denom).LT(minCoinA.Amount) || withdrawn.AmountOf(minCoinB.Denom).LT(minCoinB.Amount) {
+		return sdkerrors.Wrapf(types.ErrSlippageExceeded, "withdrawn %s less than minimum %s", withdrawn, sdk.NewCoins(minCoinA, minCoinB))
+	}
+
+	if pool.IsEmpty() {
+		k.DeletePool(ctx, poolID)
+	} else {
+		k.SetPool(ctx, pool)
+	}
+	k.removeSharesFromDepositor(ctx, record, shares)
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleAccountName, owner, withdrawn); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapWithdraw,
+			sdk.NewAttribute(types.AttributeKeyPoolID, poolID),
+			sdk.NewAttribute(types.AttributeKeyOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, withdrawn.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, shares.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding swap type
+func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.PoolKeyPrefix):
+		var poolA, poolB types.Pool
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &poolA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &poolB)
+		return fmt.Sprintf("%s\n%s", poolA, poolB)
+	case bytes.Equal(kvA.Key[:1], types.DepositorPoolSharesPrefix):
+		var recordA, recordB types.ShareRecord
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%s\n%s", recordA, recordB)
+	default:
+		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+	}
+}
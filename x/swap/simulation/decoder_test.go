@@ -0,0 +1,57 @@
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func makeTestCodec() (cdc *codec.Codec) {
+	cdc = codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	return
+}
+
+func TestDecodeSwapStore(t *testing.T) {
+	cdc := makeTestCodec()
+	pool, err := types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6)))
+	require.NoError(t, err)
+	depositor := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	record := types.NewShareRecord(depositor, pool.PoolID, pool.TotalShares)
+
+	kvPairs := kv.Pairs{
+		kv.Pair{Key: types.PoolKeyPrefix, Value: cdc.MustMarshalBinaryBare(pool)},
+		kv.Pair{Key: types.DepositorPoolSharesPrefix, Value: cdc.MustMarshalBinaryBare(record)},
+		kv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Pool", fmt.Sprintf("%v\n%v", pool, pool)},
+		{"ShareRecord", fmt.Sprintf("%v\n%v", record, record)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { DecodeStore(cdc, kvPairs[i], kvPairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, DecodeStore(cdc, kvPairs[i], kvPairs[i]), tt.name)
+			}
+		})
+	}
+}
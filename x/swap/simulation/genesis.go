@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// simulation accounts are funded with these denoms by the cdp simulation genesis
+var poolDenoms = []string{"bnb", "btc", "ukava", "usdx", "xrp"}
+
+// RandomizedGenState generates a random GenesisState for the module
+func RandomizedGenState(simState *module.SimulationState) {
+	params := randomizedParams(simState.Rand)
+	gs := types.NewGenesisState(params, types.Pools{}, types.ShareRecords{})
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, gs))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(gs)
+}
+
+func randomizedParams(r *rand.Rand) types.Params {
+	return types.NewParams(randomizedAllowedPools(r), randomizedSwapFee(r), randomizedProtocolFee(r))
+}
+
+func randomizedAllowedPools(r *rand.Rand) types.AllowedPools {
+	allowedPools := types.AllowedPools{}
+	for i := 0; i < len(poolDenoms); i++ {
+		for j := i + 1; j < len(poolDenoms); j++ {
+			if r.Intn(2) == 0 {
+				allowedPools = append(allowedPools, types.NewAllowedPool(poolDenoms[i], poolDenoms[j]))
+			}
+		}
+	}
+	if len(allowedPools) == 0 {
+		allowedPools = append(allowedPools, types.NewAllowedPool("ukava", "usdx"))
+	}
+	return allowedPools
+}
+
+// randomizedSwapFee returns a fee between 0 and 1%
+func randomizedSwapFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+}
+
+// randomizedProtocolFee returns a fraction of the swap fee between 0 and 50%
+func randomizedProtocolFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(51)), 2)
+}
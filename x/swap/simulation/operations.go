@@ -0,0 +1,217 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	appparams "github.com/kava-labs/kava/app/params"
+	"github.com/kava-labs/kava/x/swap/keeper"
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgDeposit            = "op_weight_msg_swap_deposit"
+	OpWeightMsgWithdraw           = "op_weight_msg_swap_withdraw"
+	OpWeightMsgSwapExactForTokens = "op_weight_msg_swap_exact_for_tokens"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak types.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgDeposit            int
+		weightMsgWithdraw           int
+		weightMsgSwapExactForTokens int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeposit, &weightMsgDeposit, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeposit = appparams.DefaultWeightMsgSwapDeposit
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWithdraw, &weightMsgWithdraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdraw = appparams.DefaultWeightMsgSwapWithdraw
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgSwapExactForTokens, &weightMsgSwapExactForTokens, nil,
+		func(_ *rand.Rand) {
+			weightMsgSwapExactForTokens = appparams.DefaultWeightMsgSwapExactForTokens
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgDeposit,
+			SimulateMsgDeposit(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWithdraw,
+			SimulateMsgWithdraw(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSwapExactForTokens,
+			SimulateMsgSwapExactForTokens(ak, k),
+		),
+	}
+}
+
+// SimulateMsgDeposit generates a MsgDeposit with random values
+func SimulateMsgDeposit(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		allowedPools := k.GetParams(ctx).AllowedPools
+		if len(allowedPools) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		allowedPool := allowedPools[r.Intn(len(allowedPools))]
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		acc := ak.GetAccount(ctx, simAccount.Address)
+		spendable := acc.SpendableCoins(ctx.BlockTime())
+
+		// deposit up to half the available balance of each token
+		amountA := randomPositiveAmount(r, spendable.AmountOf(allowedPool.TokenA).QuoRaw(2))
+		amountB := randomPositiveAmount(r, spendable.AmountOf(allowedPool.TokenB).QuoRaw(2))
+		if !amountA.IsPositive() || !amountB.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		tokenA := sdk.NewCoin(allowedPool.TokenA, amountA)
+		tokenB := sdk.NewCoin(allowedPool.TokenB, amountB)
+
+		// deposits into existing pools must be close to the pool price
+		if pool, found := k.GetPool(ctx, allowedPool.Name()); found {
+			tokenB = sdk.NewCoin(allowedPool.TokenB, amountA.Mul(pool.ReservesB.Amount).Quo(pool.ReservesA.Amount))
+			if !tokenB.IsPositive() || tokenB.Amount.GT(spendable.AmountOf(allowedPool.TokenB)) {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+		}
+
+		deadline := ctx.BlockTime().Add(time.Hour).Unix()
+		msg := types.NewMsgDeposit(acc.GetAddress(), tokenA, tokenB, sdk.NewDecWithPrec(1, 2), deadline)
+		return deliverMsg(r, app, ctx, chainID, acc.GetAccountNumber(), acc.GetSequence(), simAccount,
+			spendable.Sub(sdk.NewCoins(tokenA, tokenB)), msg)
+	}
+}
+
+// SimulateMsgWithdraw generates a MsgWithdraw with random values
+func SimulateMsgWithdraw(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		records := k.GetAllDepositorSharesByOwner(ctx, simAccount.Address)
+		if len(records) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		record := records[r.Intn(len(records))]
+		pool, found := k.GetPool(ctx, record.PoolID)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		shares := randomPositiveAmount(r, record.SharesOwned)
+		value := pool.ShareValue(shares)
+		amountA := value.AmountOf(pool.ReservesA.Denom)
+		amountB := value.AmountOf(pool.ReservesB.Denom)
+		if !amountA.IsPositive() || !amountB.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		acc := ak.GetAccount(ctx, simAccount.Address)
+		deadline := ctx.BlockTime().Add(time.Hour).Unix()
+		msg := types.NewMsgWithdraw(acc.GetAddress(), shares,
+			sdk.NewCoin(pool.ReservesA.Denom, amountA), sdk.NewCoin(pool.ReservesB.Denom, amountB), deadline)
+		return deliverMsg(r, app, ctx, chainID, acc.GetAccountNumber(), acc.GetSequence(), simAccount,
+			acc.SpendableCoins(ctx.BlockTime()), msg)
+	}
+}
+
+// SimulateMsgSwapExactForTokens generates a MsgSwapExactForTokens with random values
+func SimulateMsgSwapExactForTokens(ak types.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		pools := k.GetAllPools(ctx)
+		if len(pools) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		pool := pools[r.Intn(len(pools))]
+
+		inDenom, outDenom := pool.ReservesA.Denom, pool.ReservesB.Denom
+		if r.Intn(2) == 0 {
+			inDenom, outDenom = outDenom, inDenom
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		acc := ak.GetAccount(ctx, simAccount.Address)
+		spendable := acc.SpendableCoins(ctx.BlockTime())
+
+		// trade up to 10% of the pool reserves
+		maxInput := sdk.MinInt(spendable.AmountOf(inDenom), pool.Reserves().AmountOf(inDenom).QuoRaw(10))
+		amountIn := randomPositiveAmount(r, maxInput)
+		if !amountIn.IsPositive() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		exactTokenA := sdk.NewCoin(inDenom, amountIn)
+
+		expectedOutput, _, err := pool.SwapExactInput(exactTokenA, k.GetSwapFee(ctx))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+		if expectedOutput.Denom != outDenom {
+			panic("unexpected swap output denom")
+		}
+
+		deadline := ctx.BlockTime().Add(time.Hour).Unix()
+		msg := types.NewMsgSwapExactForTokens(acc.GetAddress(), exactTokenA, expectedOutput, sdk.NewDecWithPrec(1, 2), deadline)
+		return deliverMsg(r, app, ctx, chainID, acc.GetAccountNumber(), acc.GetSequence(), simAccount,
+			spendable.Sub(sdk.NewCoins(exactTokenA)), msg)
+	}
+}
+
+func deliverMsg(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, chainID string, accNum, seq uint64,
+	simAccount simulation.Account, spendableForFees sdk.Coins, msg sdk.Msg,
+) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+	fees, err := simulation.RandomFees(r, ctx, spendableForFees)
+	if err != nil {
+		return simulation.NoOpMsg(types.ModuleName), nil, err
+	}
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		helpers.DefaultGenTxGas,
+		chainID,
+		[]uint64{accNum},
+		[]uint64{seq},
+		simAccount.PrivKey,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return simulation.NoOpMsg(types.ModuleName), nil, err
+	}
+	return simulation.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomPositiveAmount returns a random amount between 1 and max, or zero if max is not positive
+func randomPositiveAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	if !max.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	if !max.IsInt64() {
+		max = sdk.NewInt(1 << 62)
+	}
+	return sdk.NewInt(r.Int63n(max.Int64()) + 1)
+}
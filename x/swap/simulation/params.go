@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+const (
+	keySwapFee     = "SwapFee"
+	keyProtocolFee = "ProtocolFee"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, keySwapFee,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", randomizedSwapFee(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, keyProtocolFee,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%s\"", randomizedProtocolFee(r))
+			},
+		),
+	}
+}
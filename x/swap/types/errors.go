@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// DONTCOVER
+
+// Errors used by the swap module
+var (
+	ErrNotAllowed            = sdkerrors.Register(ModuleName, 2, "not allowed")
+	ErrInvalidDeadline       = sdkerrors.Register(ModuleName, 3, "invalid deadline")
+	ErrDeadlineExceeded      = sdkerrors.Register(ModuleName, 4, "deadline exceeded")
+	ErrInvalidSlippage       = sdkerrors.Register(ModuleName, 5, "invalid slippage")
+	ErrSlippageExceeded      = sdkerrors.Register(ModuleName, 6, "slippage exceeded")
+	ErrInvalidPool           = sdkerrors.Register(ModuleName, 7, "invalid pool")
+	ErrInvalidShares         = sdkerrors.Register(ModuleName, 8, "invalid shares")
+	ErrPoolNotFound          = sdkerrors.Register(ModuleName, 9, "pool not found")
+	ErrDepositNotFound       = sdkerrors.Register(ModuleName, 10, "deposit not found")
+	ErrInsufficientLiquidity = sdkerrors.Register(ModuleName, 11, "insufficient liquidity")
+)
@@ -0,0 +1,21 @@
+package types
+
+// Events emitted by the swap module
+const (
+	EventTypeSwapDeposit       = "swap_deposit"
+	EventTypeSwapWithdraw      = "swap_withdraw"
+	EventTypeSwapTrade         = "swap_trade"
+	EventTypePoolCreated       = "pool_created"
+	AttributeValueCategory     = ModuleName
+	AttributeKeyPoolID         = "pool_id"
+	AttributeKeyDepositor      = "depositor"
+	AttributeKeyOwner          = "owner"
+	AttributeKeyRequester      = "requester"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyShares         = "shares"
+	AttributeKeySwapInput      = "input"
+	AttributeKeySwapOutput     = "output"
+	AttributeKeyFeePaid        = "fee"
+	AttributeKeyProtocolFee    = "protocol_fee"
+	AttributeKeyExactDirection = "exact"
+)
@@ -0,0 +1,73 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState is the state that must be provided at genesis for the swap module
+type GenesisState struct {
+	Params       Params       `json:"params" yaml:"params"`
+	Pools        Pools        `json:"pools" yaml:"pools"`
+	ShareRecords ShareRecords `json:"share_records" yaml:"share_records"`
+}
+
+// NewGenesisState returns a new GenesisState
+func NewGenesisState(params Params, pools Pools, shareRecords ShareRecords) GenesisState {
+	return GenesisState{
+		Params:       params,
+		Pools:        pools,
+		ShareRecords: shareRecords,
+	}
+}
+
+// DefaultGenesisState returns the default GenesisState for the swap module
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), Pools{}, ShareRecords{})
+}
+
+// Validate performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Pools.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ShareRecords.Validate(); err != nil {
+		return err
+	}
+
+	totalShares := make(map[string]sdk.Int)
+	for _, pool := range gs.Pools {
+		totalShares[pool.PoolID] = sdk.ZeroInt()
+	}
+	for _, sr := range gs.ShareRecords {
+		shares, found := totalShares[sr.PoolID]
+		if !found {
+			return fmt.Errorf("share record for depositor %s references missing pool %s", sr.Depositor, sr.PoolID)
+		}
+		totalShares[sr.PoolID] = shares.Add(sr.SharesOwned)
+	}
+	for _, pool := range gs.Pools {
+		if !pool.TotalShares.Equal(totalShares[pool.PoolID]) {
+			return fmt.Errorf("total shares of pool %s (%s) do not match share records (%s)", pool.PoolID, pool.TotalShares, totalShares[pool.PoolID])
+		}
+	}
+	return nil
+}
+
+// Equal checks whether two GenesisState structs are equivalent
+func (gs GenesisState) Equal(gs2 GenesisState) bool {
+	b1 := ModuleCdc.MustMarshalBinaryBare(gs)
+	b2 := ModuleCdc.MustMarshalBinaryBare(gs2)
+	return bytes.Equal(b1, b2)
+}
+
+// IsEmpty returns true if a GenesisState is empty
+func (gs GenesisState) IsEmpty() bool {
+	return gs.Equal(GenesisState{})
+}
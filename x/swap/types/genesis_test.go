@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	depositor := sdk.AccAddress("test_depositor_addr1")
+	params := types.NewParams(types.AllowedPools{types.NewAllowedPool("ukava", "usdx")}, sdk.MustNewDecFromStr("0.003"), sdk.ZeroDec())
+	pool, err := types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6)))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		genState   types.GenesisState
+		expectPass bool
+	}{
+		{"default", types.DefaultGenesisState(), true},
+		{"valid", types.NewGenesisState(params, types.Pools{pool}, types.ShareRecords{types.NewShareRecord(depositor, pool.PoolID, pool.TotalShares)}), true},
+		{"invalid params", types.NewGenesisState(types.NewParams(types.AllowedPools{types.NewAllowedPool("usdx", "ukava")}, sdk.ZeroDec(), sdk.ZeroDec()), types.Pools{}, types.ShareRecords{}), false},
+		{"duplicate pools", types.NewGenesisState(params, types.Pools{pool, pool}, types.ShareRecords{types.NewShareRecord(depositor, pool.PoolID, pool.TotalShares)}), false},
+		{"shares do not match pool", types.NewGenesisState(params, types.Pools{pool}, types.ShareRecords{types.NewShareRecord(depositor, pool.PoolID, sdk.OneInt())}), false},
+		{"share record for missing pool", types.NewGenesisState(params, types.Pools{}, types.ShareRecords{types.NewShareRecord(depositor, pool.PoolID, pool.TotalShares)}), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ensure Msg interface compliance at compile time
+var (
+	_ sdk.Msg         = &MsgDeposit{}
+	_ MsgWithDeadline = &MsgDeposit{}
+	_ sdk.Msg         = &MsgWithdraw{}
+	_ MsgWithDeadline = &MsgWithdraw{}
+	_ sdk.Msg         = &MsgSwapExactForTokens{}
+	_ MsgWithDeadline = &MsgSwapExactForTokens{}
+	_ sdk.Msg         = &MsgSwapForExactTokens{}
+	_ MsgWithDeadline = &MsgSwapForExactTokens{}
+)
+
+// MsgWithDeadline allows messages to define a deadline after which they are no longer valid
+type MsgWithDeadline interface {
+	GetDeadline() int64
+	DeadlineExceeded(blockTime int64) bool
+}
+
+// MsgDeposit deposits liquidity into a pool. If the pool does not exist and the pair is allowed
+// by params, the pool is created at the price defined by the deposit.
+type MsgDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	TokenA    sdk.Coin       `json:"token_a" yaml:"token_a"`
+	TokenB    sdk.Coin       `json:"token_b" yaml:"token_b"`
+	Slippage  sdk.Dec        `json:"slippage" yaml:"slippage"`
+	Deadline  int64          `json:"deadline" yaml:"deadline"`
+}
+
+// NewMsgDeposit returns a new MsgDeposit
+func NewMsgDeposit(depositor sdk.AccAddress, tokenA, tokenB sdk.Coin, slippage sdk.Dec, deadline int64) MsgDeposit {
+	return MsgDeposit{
+		Depositor: depositor,
+		TokenA:    tokenA,
+		TokenB:    tokenB,
+		Slippage:  slippage,
+		Deadline:  deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDeposit) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDeposit) Type() string { return "swap_deposit" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDeposit) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "depositor address cannot be empty")
+	}
+	if !msg.TokenA.IsValid() || !msg.TokenA.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token a deposit amount %s", msg.TokenA)
+	}
+	if !msg.TokenB.IsValid() || !msg.TokenB.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token b deposit amount %s", msg.TokenB)
+	}
+	if msg.TokenA.Denom == msg.TokenB.Denom {
+		return sdkerrors.Wrap(ErrInvalidPool, "denominations can not be equal")
+	}
+	if err := validateSlippage(msg.Slippage); err != nil {
+		return err
+	}
+	return validateDeadline(msg.Deadline)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDeposit) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDeposit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// GetDeadline returns the deadline of the message
+func (msg MsgDeposit) GetDeadline() int64 { return msg.Deadline }
+
+// DeadlineExceeded returns true if the block time is after the deadline
+func (msg MsgDeposit) DeadlineExceeded(blockTime int64) bool { return blockTime >= msg.Deadline }
+
+// String implements fmt.Stringer
+func (msg MsgDeposit) String() string {
+	return fmt.Sprintf(`Deposit Message:
+	Depositor: %s
+	Token A: %s
+	Token B: %s
+	Slippage: %s
+	Deadline: %d
+`, msg.Depositor, msg.TokenA, msg.TokenB, msg.Slippage, msg.Deadline)
+}
+
+// MsgWithdraw withdraws liquidity from a pool by redeeming shares
+type MsgWithdraw struct {
+	From      sdk.AccAddress `json:"from" yaml:"from"`
+	Shares    sdk.Int        `json:"shares" yaml:"shares"`
+	MinTokenA sdk.Coin       `json:"min_token_a" yaml:"min_token_a"`
+	MinTokenB sdk.Coin       `json:"min_token_b" yaml:"min_token_b"`
+	Deadline  int64          `json:"deadline" yaml:"deadline"`
+}
+
+// NewMsgWithdraw returns a new MsgWithdraw
+func NewMsgWithdraw(from sdk.AccAddress, shares sdk.Int, minTokenA, minTokenB sdk.Coin, deadline int64) MsgWithdraw {
+	return MsgWithdraw{
+		From:      from,
+		Shares:    shares,
+		MinTokenA: minTokenA,
+		MinTokenB: minTokenB,
+		Deadline:  deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdraw) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdraw) Type() string { return "swap_withdraw" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdraw) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "from address cannot be empty")
+	}
+	if msg.Shares.IsNil() || !msg.Shares.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidShares, "shares must be positive: %s", msg.Shares)
+	}
+	if !msg.MinTokenA.IsValid() || !msg.MinTokenA.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "min token a amount %s", msg.MinTokenA)
+	}
+	if !msg.MinTokenB.IsValid() || !msg.MinTokenB.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "min token b amount %s", msg.MinTokenB)
+	}
+	if msg.MinTokenA.Denom == msg.MinTokenB.Denom {
+		return sdkerrors.Wrap(ErrInvalidPool, "denominations can not be equal")
+	}
+	return validateDeadline(msg.Deadline)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdraw) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdraw) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// GetDeadline returns the deadline of the message
+func (msg MsgWithdraw) GetDeadline() int64 { return msg.Deadline }
+
+// DeadlineExceeded returns true if the block time is after the deadline
+func (msg MsgWithdraw) DeadlineExceeded(blockTime int64) bool { return blockTime >= msg.Deadline }
+
+// String implements fmt.Stringer
+func (msg MsgWithdraw) String() string {
+	return fmt.Sprintf(`Withdraw Message:
+	From: %s
+	Shares: %s
+	Min Token A: %s
+	Min Token B: %s
+	Deadline: %d
+`, msg.From, msg.Shares, msg.MinTokenA, msg.MinTokenB, msg.Deadline)
+}
+
+// MsgSwapExactForTokens trades an exact amount of token A for token B. TokenB is the expected
+// output, the swap fails if the actual output is less than expected by more than the slippage.
+type MsgSwapExactForTokens struct {
+	Requester   sdk.AccAddress `json:"requester" yaml:"requester"`
+	ExactTokenA sdk.Coin       `json:"exact_token_a" yaml:"exact_token_a"`
+	TokenB      sdk.Coin       `json:"token_b" yaml:"token_b"`
+	Slippage    sdk.Dec        `json:"slippage" yaml:"slippage"`
+	Deadline    int64          `json:"deadline" yaml:"deadline"`
+}
+
+// NewMsgSwapExactForTokens returns a new MsgSwapExactForTokens
+func NewMsgSwapExactForTokens(requester sdk.AccAddress, exactTokenA, tokenB sdk.Coin, slippage sdk.Dec, deadline int64) MsgSwapExactForTokens {
+	return MsgSwapExactForTokens{
+		Requester:   requester,
+		ExactTokenA: exactTokenA,
+		TokenB:      tokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapExactForTokens) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapExactForTokens) Type() string { return "swap_exact_for_tokens" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapExactForTokens) ValidateBasic() error {
+	if msg.Requester.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+	if !msg.ExactTokenA.IsValid() || !msg.ExactTokenA.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "exact token a amount %s", msg.ExactTokenA)
+	}
+	if !msg.TokenB.IsValid() || !msg.TokenB.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token b amount %s", msg.TokenB)
+	}
+	if msg.ExactTokenA.Denom == msg.TokenB.Denom {
+		return sdkerrors.Wrap(ErrInvalidPool, "denominations can not be equal")
+	}
+	if err := validateSlippage(msg.Slippage); err != nil {
+		return err
+	}
+	return validateDeadline(msg.Deadline)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapExactForTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapExactForTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Requester}
+}
+
+// GetDeadline returns the deadline of the message
+func (msg MsgSwapExactForTokens) GetDeadline() int64 { return msg.Deadline }
+
+// DeadlineExceeded returns true if the block time is after the deadline
+func (msg MsgSwapExactForTokens) DeadlineExceeded(blockTime int64) bool {
+	return blockTime >= msg.Deadline
+}
+
+// String implements fmt.Stringer
+func (msg MsgSwapExactForTokens) String() string {
+	return fmt.Sprintf(`Swap Exact For Tokens Message:
+	Requester: %s
+	Exact Token A: %s
+	Token B: %s
+	Slippage: %s
+	Deadline: %d
+`, msg.Requester, msg.ExactTokenA, msg.TokenB, msg.Slippage, msg.Deadline)
+}
+
+// MsgSwapForExactTokens trades token A for an exact amount of token B. TokenA is the expected
+// input, the swap fails if the actual input is greater than expected by more than the slippage.
+type MsgSwapForExactTokens struct {
+	Requester   sdk.AccAddress `json:"requester" yaml:"requester"`
+	TokenA      sdk.Coin       `json:"token_a" yaml:"token_a"`
+	ExactTokenB sdk.Coin       `json:"exact_token_b" yaml:"exact_token_b"`
+	Slippage    sdk.Dec        `json:"slippage" yaml:"slippage"`
+	Deadline    int64          `json:"deadline" yaml:"deadline"`
+}
+
+// NewMsgSwapForExactTokens returns a new MsgSwapForExactTokens
+func NewMsgSwapForExactTokens(requester sdk.AccAddress, tokenA, exactTokenB sdk.Coin, slippage sdk.Dec, deadline int64) MsgSwapForExactTokens {
+	return MsgSwapForExactTokens{
+		Requester:   requester,
+		TokenA:      tokenA,
+		ExactTokenB: exactTokenB,
+		Slippage:    slippage,
+		Deadline:    deadline,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSwapForExactTokens) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSwapForExactTokens) Type() string { return "swap_for_exact_tokens" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgSwapForExactTokens) ValidateBasic() error {
+	if msg.Requester.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "requester address cannot be empty")
+	}
+	if !msg.TokenA.IsValid() || !msg.TokenA.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "token a amount %s", msg.TokenA)
+	}
+	if !msg.ExactTokenB.IsValid() || !msg.ExactTokenB.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "exact token b amount %s", msg.ExactTokenB)
+	}
+	if msg.TokenA.Denom == msg.ExactTokenB.Denom {
+		return sdkerrors.Wrap(ErrInvalidPool, "denominations can not be equal")
+	}
+	if err := validateSlippage(msg.Slippage); err != nil {
+		return err
+	}
+	return validateDeadline(msg.Deadline)
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSwapForExactTokens) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSwapForExactTokens) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Requester}
+}
+
+// GetDeadline returns the deadline of the message
+func (msg MsgSwapForExactTokens) GetDeadline() int64 { return msg.Deadline }
+
+// DeadlineExceeded returns true if the block time is after the deadline
+func (msg MsgSwapForExactTokens) DeadlineExceeded(blockTime int64) bool {
+	return blockTime >= msg.Deadline
+}
+
+// String implements fmt.Stringer
+func (msg MsgSwapForExactTokens) String() string {
+	return fmt.Sprintf(`Swap For Exact Tokens Message:
+	Requester: %s
+	Token A: %s
+	Exact Token B: %s
+	Slippage: %s
+	Deadline: %d
+`, msg.Requester, msg.TokenA, msg.ExactTokenB, msg.Slippage, msg.Deadline)
+}
+
+func validateSlippage(slippage sdk.Dec) error {
+	if slippage.IsNil() {
+		return sdkerrors.Wrap(ErrInvalidSlippage, "slippage must be set")
+	}
+	if slippage.IsNegative() || slippage.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "slippage must be between 0 and 1: %s", slippage)
+	}
+	return nil
+}
+
+func validateDeadline(deadline int64) error {
+	if deadline <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidDeadline, "deadline must be a positive unix time: %d", deadline)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestMsgDeposit_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("test_depositor_addr1")
+	tokenA := sdk.NewInt64Coin("ukava", 1e6)
+	tokenB := sdk.NewInt64Coin("usdx", 5e6)
+	slippage := sdk.MustNewDecFromStr("0.01")
+
+	testCases := []struct {
+		name       string
+		msg        types.MsgDeposit
+		expectPass bool
+	}{
+		{"valid", types.NewMsgDeposit(addr, tokenA, tokenB, slippage, 1), true},
+		{"empty depositor", types.NewMsgDeposit(sdk.AccAddress{}, tokenA, tokenB, slippage, 1), false},
+		{"zero token", types.NewMsgDeposit(addr, sdk.NewInt64Coin("ukava", 0), tokenB, slippage, 1), false},
+		{"same denoms", types.NewMsgDeposit(addr, tokenA, tokenA, slippage, 1), false},
+		{"negative slippage", types.NewMsgDeposit(addr, tokenA, tokenB, sdk.MustNewDecFromStr("-0.1"), 1), false},
+		{"nil slippage", types.NewMsgDeposit(addr, tokenA, tokenB, sdk.Dec{}, 1), false},
+		{"zero deadline", types.NewMsgDeposit(addr, tokenA, tokenB, slippage, 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgWithdraw_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("test_depositor_addr1")
+	minTokenA := sdk.NewInt64Coin("ukava", 1)
+	minTokenB := sdk.NewInt64Coin("usdx", 1)
+
+	testCases := []struct {
+		name       string
+		msg        types.MsgWithdraw
+		expectPass bool
+	}{
+		{"valid", types.NewMsgWithdraw(addr, sdk.NewInt(100), minTokenA, minTokenB, 1), true},
+		{"empty from", types.NewMsgWithdraw(sdk.AccAddress{}, sdk.NewInt(100), minTokenA, minTokenB, 1), false},
+		{"zero shares", types.NewMsgWithdraw(addr, sdk.ZeroInt(), minTokenA, minTokenB, 1), false},
+		{"nil shares", types.NewMsgWithdraw(addr, sdk.Int{}, minTokenA, minTokenB, 1), false},
+		{"same denoms", types.NewMsgWithdraw(addr, sdk.NewInt(100), minTokenA, minTokenA, 1), false},
+		{"zero deadline", types.NewMsgWithdraw(addr, sdk.NewInt(100), minTokenA, minTokenB, 0), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSwap_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress("test_requester_addr1")
+	tokenA := sdk.NewInt64Coin("ukava", 1e6)
+	tokenB := sdk.NewInt64Coin("usdx", 5e6)
+	slippage := sdk.MustNewDecFromStr("0.01")
+
+	require.NoError(t, types.NewMsgSwapExactForTokens(addr, tokenA, tokenB, slippage, 1).ValidateBasic())
+	require.Error(t, types.NewMsgSwapExactForTokens(addr, tokenA, tokenA, slippage, 1).ValidateBasic())
+	require.Error(t, types.NewMsgSwapExactForTokens(addr, tokenA, tokenB, sdk.NewDec(2), 1).ValidateBasic())
+	require.NoError(t, types.NewMsgSwapForExactTokens(addr, tokenA, tokenB, slippage, 1).ValidateBasic())
+	require.Error(t, types.NewMsgSwapForExactTokens(sdk.AccAddress{}, tokenA, tokenB, slippage, 1).ValidateBasic())
+	require.Error(t, types.NewMsgSwapForExactTokens(addr, tokenA, tokenB, slippage, -1).ValidateBasic())
+
+	msg := types.NewMsgSwapExactForTokens(addr, tokenA, tokenB, slippage, 100)
+	require.False(t, msg.DeadlineExceeded(99))
+	require.True(t, msg.DeadlineExceeded(100))
+}
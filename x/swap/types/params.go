@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeyAllowedPools     = []byte("AllowedPools")
+	KeySwapFee          = []byte("SwapFee")
+	KeyProtocolFee      = []byte("ProtocolFee")
+	DefaultAllowedPools = AllowedPools{}
+	DefaultSwapFee      = sdk.ZeroDec()
+	DefaultProtocolFee  = sdk.ZeroDec()
+	MaxSwapFee          = sdk.OneDec()
+)
+
+// Params governance parameters for the swap module
+type Params struct {
+	AllowedPools AllowedPools `json:"allowed_pools" yaml:"allowed_pools"`
+	// SwapFee the fraction of each swap input that is charged as a fee
+	SwapFee sdk.Dec `json:"swap_fee" yaml:"swap_fee"`
+	// ProtocolFee the fraction of each swap fee that is routed to the community pool, the remainder is paid to liquidity providers
+	ProtocolFee sdk.Dec `json:"protocol_fee" yaml:"protocol_fee"`
+}
+
+// NewParams returns a new params object
+func NewParams(pairs AllowedPools, swapFee, protocolFee sdk.Dec) Params {
+	return Params{
+		AllowedPools: pairs,
+		SwapFee:      swapFee,
+		ProtocolFee:  protocolFee,
+	}
+}
+
+// DefaultParams returns default params for swap module
+func DefaultParams() Params {
+	return NewParams(DefaultAllowedPools, DefaultSwapFee, DefaultProtocolFee)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Allowed Pools: %s
+	Swap Fee: %s
+	Protocol Fee: %s`, p.AllowedPools, p.SwapFee, p.ProtocolFee)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAllowedPools, &p.AllowedPools, validateAllowedPoolsParams),
+		params.NewParamSetPair(KeySwapFee, &p.SwapFee, validateSwapFee),
+		params.NewParamSetPair(KeyProtocolFee, &p.ProtocolFee, validateProtocolFee),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateAllowedPoolsParams(p.AllowedPools); err != nil {
+		return err
+	}
+	if err := validateSwapFee(p.SwapFee); err != nil {
+		return err
+	}
+	return validateProtocolFee(p.ProtocolFee)
+}
+
+func validateAllowedPoolsParams(i interface{}) error {
+	p, ok := i.(AllowedPools)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return p.Validate()
+}
+
+func validateSwapFee(i interface{}) error {
+	swapFee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(MaxSwapFee) {
+		return fmt.Errorf("invalid swap fee: %s", swapFee)
+	}
+
+	return nil
+}
+
+func validateProtocolFee(i interface{}) error {
+	protocolFee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if protocolFee.IsNil() || protocolFee.IsNegative() || protocolFee.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid protocol fee: %s", protocolFee)
+	}
+
+	return nil
+}
+
+// AllowedPool defines a token pair that is allowed to have a pool created for it
+type AllowedPool struct {
+	TokenA string `json:"token_a" yaml:"token_a"`
+	TokenB string `json:"token_b" yaml:"token_b"`
+}
+
+// NewAllowedPool returns a new AllowedPool object
+func NewAllowedPool(tokenA, tokenB string) AllowedPool {
+	return AllowedPool{
+		TokenA: tokenA,
+		TokenB: tokenB,
+	}
+}
+
+// Validate validates allowedPool attributes and returns an error if invalid
+func (p AllowedPool) Validate() error {
+	if err := sdk.ValidateDenom(p.TokenA); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.TokenB); err != nil {
+		return err
+	}
+	if p.TokenA == p.TokenB {
+		return fmt.Errorf("pool cannot have two tokens of the same type, received '%s' and '%s'", p.TokenA, p.TokenB)
+	}
+	if p.TokenA > p.TokenB {
+		return fmt.Errorf("invalid token order: '%s' must come before '%s'", p.TokenB, p.TokenA)
+	}
+	return nil
+}
+
+// Name returns the id of the pool that can be created for the allowed pool
+func (p AllowedPool) Name() string {
+	return PoolID(p.TokenA, p.TokenB)
+}
+
+// String pretty prints the allowedPool
+func (p AllowedPool) String() string {
+	return fmt.Sprintf(`AllowedPool:
+	Name: %s
+	Token A: %s
+	Token B: %s
+`, p.Name(), p.TokenA, p.TokenB)
+}
+
+// AllowedPools is a slice of AllowedPool
+type AllowedPools []AllowedPool
+
+// Validate validates each allowedPool and checks for duplicates
+func (p AllowedPools) Validate() error {
+	seenAllowedPools := make(map[string]bool)
+	for _, allowedPool := range p {
+		if err := allowedPool.Validate(); err != nil {
+			return err
+		}
+
+		if seen := seenAllowedPools[allowedPool.Name()]; seen {
+			return fmt.Errorf("duplicate pool: %s", allowedPool.Name())
+		}
+		seenAllowedPools[allowedPool.Name()] = true
+	}
+
+	return nil
+}
+
+// PoolID returns the pool id for a pair of denoms, the denoms are sorted so the id is independent of order
+func PoolID(denomA, denomB string) string {
+	if strings.Compare(denomA, denomB) > 0 {
+		denomA, denomB = denomB, denomA
+	}
+	return fmt.Sprintf("%s:%s", denomA, denomB)
+}
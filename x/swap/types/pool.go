@@ -0,0 +1,306 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Pool is a constant product liquidity pool for a pair of tokens. The reserves of the pool
+// are held by the swap module account, liquidity providers are issued shares in the pool.
+type Pool struct {
+	PoolID      string   `json:"pool_id" yaml:"pool_id"`
+	ReservesA   sdk.Coin `json:"reserves_a" yaml:"reserves_a"`
+	ReservesB   sdk.Coin `json:"reserves_b" yaml:"reserves_b"`
+	TotalShares sdk.Int  `json:"total_shares" yaml:"total_shares"`
+}
+
+// NewPool creates a new pool from an initial deposit of reserves. The initial shares of the
+// pool are the geometric mean of the deposited reserves.
+func NewPool(reserves sdk.Coins) (Pool, error) {
+	if len(reserves) != 2 || !reserves.IsAllPositive() {
+		return Pool{}, fmt.Errorf("pool must be created with positive reserves of two tokens: %s", reserves)
+	}
+	// sdk.Coins are sorted by denom, so reserves A always has the lexicographically smallest denom
+	reservesA, reservesB := reserves[0], reserves[1]
+
+	product := new(big.Int).Mul(reservesA.Amount.BigInt(), reservesB.Amount.BigInt())
+	shares := sdk.NewIntFromBigInt(new(big.Int).Sqrt(product))
+
+	return Pool{
+		PoolID:      PoolID(reservesA.Denom, reservesB.Denom),
+		ReservesA:   reservesA,
+		ReservesB:   reservesB,
+		TotalShares: shares,
+	}, nil
+}
+
+// Validate performs basic validation of the pool fields
+func (p Pool) Validate() error {
+	if !p.ReservesA.IsValid() || !p.ReservesA.IsPositive() {
+		return fmt.Errorf("invalid reserves: %s", p.ReservesA)
+	}
+	if !p.ReservesB.IsValid() || !p.ReservesB.IsPositive() {
+		return fmt.Errorf("invalid reserves: %s", p.ReservesB)
+	}
+	if err := NewAllowedPool(p.ReservesA.Denom, p.ReservesB.Denom).Validate(); err != nil {
+		return err
+	}
+	if p.PoolID != PoolID(p.ReservesA.Denom, p.ReservesB.Denom) {
+		return fmt.Errorf("invalid pool id '%s' for reserves %s, %s", p.PoolID, p.ReservesA, p.ReservesB)
+	}
+	if p.TotalShares.IsNil() || !p.TotalShares.IsPositive() {
+		return fmt.Errorf("total shares must be positive: %s", p.TotalShares)
+	}
+	return nil
+}
+
+// Reserves returns the reserves of the pool as coins
+func (p Pool) Reserves() sdk.Coins {
+	return sdk.NewCoins(p.ReservesA, p.ReservesB)
+}
+
+// HasDenom returns true if the input denom is one of the pool tokens
+func (p Pool) HasDenom(denom string) bool {
+	return p.ReservesA.Denom == denom || p.ReservesB.Denom == denom
+}
+
+// SpotPrice returns the price of token A denominated in token B
+func (p Pool) SpotPrice() sdk.Dec {
+	return p.ReservesB.Amount.ToDec().Quo(p.ReservesA.Amount.ToDec())
+}
+
+// ShareValue returns the value of the input number of shares in pool tokens
+func (p Pool) ShareValue(shares sdk.Int) sdk.Coins {
+	amountA := shares.Mul(p.ReservesA.Amount).Quo(p.TotalShares)
+	amountB := shares.Mul(p.ReservesB.Amount).Quo(p.TotalShares)
+	return sdk.NewCoins(sdk.NewCoin(p.ReservesA.Denom, amountA), sdk.NewCoin(p.ReservesB.Denom, amountB))
+}
+
+// AddLiquidity adds liquidity to the pool at the current pool price. The deposit is capped by
+// the token that would be exhausted first, so the actual deposit may be less than the desired deposit.
+// Amounts are rounded in favor of the pool. Returns the coins deposited and the shares issued.
+func (p *Pool) AddLiquidity(desired sdk.Coins) (sdk.Coins, sdk.Int, error) {
+	desiredA := desired.AmountOf(p.ReservesA.Denom)
+	desiredB := desired.AmountOf(p.ReservesB.Denom)
+	if !desiredA.IsPositive() || !desiredB.IsPositive() {
+		return nil, sdk.ZeroInt(), fmt.Errorf("deposit must contain positive amounts of %s and %s: %s", p.ReservesA.Denom, p.ReservesB.Denom, desired)
+	}
+
+	var depositA, depositB sdk.Int
+	if desiredA.Mul(p.ReservesB.Amount).LTE(desiredB.Mul(p.ReservesA.Amount)) {
+		depositA = desiredA
+		depositB = quoCeil(desiredA.Mul(p.ReservesB.Amount), p.ReservesA.Amount)
+	} else {
+		depositB = desiredB
+		depositA = quoCeil(desiredB.Mul(p.ReservesA.Amount), p.ReservesB.Amount)
+	}
+
+	sharesA := depositA.Mul(p.TotalShares).Quo(p.ReservesA.Amount)
+	sharesB := depositB.Mul(p.TotalShares).Quo(p.ReservesB.Amount)
+	shares := sdk.MinInt(sharesA, sharesB)
+	if !shares.IsPositive() {
+		return nil, sdk.ZeroInt(), errors.New("deposit is too small to issue shares")
+	}
+
+	p.ReservesA = p.ReservesA.Add(sdk.NewCoin(p.ReservesA.Denom, depositA))
+	p.ReservesB = p.ReservesB.Add(sdk.NewCoin(p.ReservesB.Denom, depositB))
+	p.TotalShares = p.TotalShares.Add(shares)
+
+	return sdk.NewCoins(sdk.NewCoin(p.ReservesA.Denom, depositA), sdk.NewCoin(p.ReservesB.Denom, depositB)), shares, nil
+}
+
+// RemoveLiquidity removes the input number of shares from the pool and returns the coins withdrawn
+func (p *Pool) RemoveLiquidity(shares sdk.Int) (sdk.Coins, error) {
+	if !shares.IsPositive() || shares.GT(p.TotalShares) {
+		return nil, fmt.Errorf("invalid shares %s, pool has %s total shares", shares, p.TotalShares)
+	}
+
+	withdrawn := p.ShareValue(shares)
+	p.ReservesA = p.ReservesA.Sub(sdk.NewCoin(p.ReservesA.Denom, withdrawn.AmountOf(p.ReservesA.Denom)))
+	p.ReservesB = p.ReservesB.Sub(sdk.NewCoin(p.ReservesB.Denom, withdrawn.AmountOf(p.ReservesB.Denom)))
+	p.TotalShares = p.TotalShares.Sub(shares)
+
+	return withdrawn, nil
+}
+
+// IsEmpty returns true if all shares have been withdrawn from the pool
+func (p Pool) IsEmpty() bool {
+	return p.TotalShares.IsZero()
+}
+
+// SwapExactInput swaps an exact input amount for the other pool token. The fee is deducted from the
+// input before the constant product is applied and remains in the pool reserves.
+// Returns the output coin and the fee paid.
+func (p *Pool) SwapExactInput(input sdk.Coin, fee sdk.Dec) (sdk.Coin, sdk.Coin, error) {
+	inReserves, outReserves, err := p.orderedReserves(input.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if !input.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("swap input must be positive: %s", input)
+	}
+
+	feeAmount := input.Amount.ToDec().Mul(fee).Ceil().TruncateInt()
+	inputAfterFee := input.Amount.Sub(feeAmount)
+	outputAmount := outReserves.Amount.Mul(inputAfterFee).Quo(inReserves.Amount.Add(inputAfterFee))
+	if !outputAmount.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("swap input %s is too small to produce output", input)
+	}
+
+	output := sdk.NewCoin(outReserves.Denom, outputAmount)
+	p.applySwap(input, output)
+	return output, sdk.NewCoin(input.Denom, feeAmount), nil
+}
+
+// SwapExactOutput swaps the other pool token for an exact output amount. The input includes the fee.
+// Returns the input coin required and the fee paid.
+func (p *Pool) SwapExactOutput(output sdk.Coin, fee sdk.Dec) (sdk.Coin, sdk.Coin, error) {
+	outReserves, inReserves, err := p.orderedReserves(output.Denom)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	if !output.IsPositive() {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("swap output must be positive: %s", output)
+	}
+	if output.Amount.GTE(outReserves.Amount) {
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("swap output %s exceeds pool reserves %s", output, outReserves)
+	}
+
+	inputAfterFee := quoCeil(inReserves.Amount.Mul(output.Amount), outReserves.Amount.Sub(output.Amount))
+	inputAmount := inputAfterFee.ToDec().Quo(sdk.OneDec().Sub(fee)).Ceil().TruncateInt()
+	feeAmount := inputAmount.Sub(inputAfterFee)
+
+	input := sdk.NewCoin(inReserves.Denom, inputAmount)
+	p.applySwap(input, output)
+	return input, sdk.NewCoin(input.Denom, feeAmount), nil
+}
+
+// RemoveFee removes coins that were collected as fees from the pool reserves
+func (p *Pool) RemoveFee(fee sdk.Coin) {
+	switch fee.Denom {
+	case p.ReservesA.Denom:
+		p.ReservesA = p.ReservesA.Sub(fee)
+	case p.ReservesB.Denom:
+		p.ReservesB = p.ReservesB.Sub(fee)
+	default:
+		panic(fmt.Sprintf("invalid fee denom %s for pool %s", fee.Denom, p.PoolID))
+	}
+}
+
+func (p *Pool) applySwap(input, output sdk.Coin) {
+	if input.Denom == p.ReservesA.Denom {
+		p.ReservesA = p.ReservesA.Add(input)
+		p.ReservesB = p.ReservesB.Sub(output)
+	} else {
+		p.ReservesB = p.ReservesB.Add(input)
+		p.ReservesA = p.ReservesA.Sub(output)
+	}
+}
+
+// orderedReserves returns the reserves of the input denom followed by the reserves of the other pool token
+func (p Pool) orderedReserves(denom string) (sdk.Coin, sdk.Coin, error) {
+	switch denom {
+	case p.ReservesA.Denom:
+		return p.ReservesA, p.ReservesB, nil
+	case p.ReservesB.Denom:
+		return p.ReservesB, p.ReservesA, nil
+	default:
+		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("denom %s is not in pool %s", denom, p.PoolID)
+	}
+}
+
+// String implements fmt.Stringer
+func (p Pool) String() string {
+	return fmt.Sprintf(`Pool:
+	ID: %s
+	Reserves A: %s
+	Reserves B: %s
+	Total Shares: %s`, p.PoolID, p.ReservesA, p.ReservesB, p.TotalShares)
+}
+
+// Pools is a slice of Pool
+type Pools []Pool
+
+// Validate validates each pool and checks for duplicates
+func (ps Pools) Validate() error {
+	seenPools := make(map[string]bool)
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seenPools[p.PoolID] {
+			return fmt.Errorf("duplicate pool: %s", p.PoolID)
+		}
+		seenPools[p.PoolID] = true
+	}
+	return nil
+}
+
+// ShareRecord stores the shares owned by a depositor in a pool
+type ShareRecord struct {
+	Depositor   sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	PoolID      string         `json:"pool_id" yaml:"pool_id"`
+	SharesOwned sdk.Int        `json:"shares_owned" yaml:"shares_owned"`
+}
+
+// NewShareRecord returns a new ShareRecord
+func NewShareRecord(depositor sdk.AccAddress, poolID string, sharesOwned sdk.Int) ShareRecord {
+	return ShareRecord{
+		Depositor:   depositor,
+		PoolID:      poolID,
+		SharesOwned: sharesOwned,
+	}
+}
+
+// Validate performs basic validation of the share record fields
+func (sr ShareRecord) Validate() error {
+	if sr.Depositor.Empty() {
+		return errors.New("depositor cannot be empty")
+	}
+	if sr.PoolID == "" {
+		return errors.New("pool id cannot be empty")
+	}
+	if sr.SharesOwned.IsNil() || !sr.SharesOwned.IsPositive() {
+		return fmt.Errorf("shares owned must be positive: %s", sr.SharesOwned)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sr ShareRecord) String() string {
+	return fmt.Sprintf(`Share Record:
+	Depositor: %s
+	Pool ID: %s
+	Shares Owned: %s`, sr.Depositor, sr.PoolID, sr.SharesOwned)
+}
+
+// ShareRecords is a slice of ShareRecord
+type ShareRecords []ShareRecord
+
+// Validate validates each share record and checks for duplicates
+func (srs ShareRecords) Validate() error {
+	seen := make(map[string]bool)
+	for _, sr := range srs {
+		if err := sr.Validate(); err != nil {
+			return err
+		}
+		key := string(DepositorPoolSharesKey(sr.Depositor, sr.PoolID))
+		if seen[key] {
+			return fmt.Errorf("duplicate share record for depositor %s in pool %s", sr.Depositor, sr.PoolID)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// quoCeil divides a by b rounding up
+func quoCeil(a, b sdk.Int) sdk.Int {
+	quo := a.Quo(b)
+	if !quo.Mul(b).Equal(a) {
+		quo = quo.AddRaw(1)
+	}
+	return quo
+}
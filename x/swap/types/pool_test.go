@@ -0,0 +1,80 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/swap/types"
+)
+
+func TestNewPool(t *testing.T) {
+	pool, err := types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("usdx", 5e6), sdk.NewInt64Coin("ukava", 1e6)))
+	require.NoError(t, err)
+	require.Equal(t, "ukava:usdx", pool.PoolID)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 1e6), pool.ReservesA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 5e6), pool.ReservesB)
+	require.Equal(t, sdk.NewInt(2236067), pool.TotalShares)
+	require.NoError(t, pool.Validate())
+	require.Equal(t, sdk.NewDec(5), pool.SpotPrice())
+
+	_, err = types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6)))
+	require.Error(t, err)
+}
+
+func TestPool_AddRemoveLiquidity(t *testing.T) {
+	pool, err := types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6)))
+	require.NoError(t, err)
+
+	// token b is in excess, so the deposit is limited by token a
+	deposit, shares, err := pool.AddLiquidity(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e5), sdk.NewInt64Coin("usdx", 1e6)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e5), sdk.NewInt64Coin("usdx", 5e5)), deposit)
+	require.Equal(t, sdk.NewInt(223606), shares)
+
+	// token a is in excess, the deposit is rounded up in favor of the pool
+	deposit, shares, err = pool.AddLiquidity(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 3)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1), sdk.NewInt64Coin("usdx", 3)), deposit)
+	require.Equal(t, sdk.NewInt(1), shares)
+
+	_, _, err = pool.AddLiquidity(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1), sdk.NewInt64Coin("usdx", 1)))
+	require.Error(t, err)
+
+	withdrawn, err := pool.RemoveLiquidity(pool.TotalShares)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1100001), sdk.NewInt64Coin("usdx", 5500003)), withdrawn)
+	require.True(t, pool.IsEmpty())
+
+	_, err = pool.RemoveLiquidity(sdk.OneInt())
+	require.Error(t, err)
+}
+
+func TestPool_Swap(t *testing.T) {
+	fee := sdk.MustNewDecFromStr("0.003")
+
+	pool, err := types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6)))
+	require.NoError(t, err)
+	output, feePaid, err := pool.SwapExactInput(sdk.NewInt64Coin("ukava", 1e4), fee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 49357), output)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 30), feePaid)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 1010000), pool.ReservesA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 4950643), pool.ReservesB)
+
+	pool, err = types.NewPool(sdk.NewCoins(sdk.NewInt64Coin("ukava", 1e6), sdk.NewInt64Coin("usdx", 5e6)))
+	require.NoError(t, err)
+	input, feePaid, err := pool.SwapExactOutput(sdk.NewInt64Coin("usdx", 5e4), fee)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 10133), input)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 31), feePaid)
+	require.Equal(t, sdk.NewInt64Coin("ukava", 1010133), pool.ReservesA)
+	require.Equal(t, sdk.NewInt64Coin("usdx", 4950000), pool.ReservesB)
+
+	_, _, err = pool.SwapExactOutput(sdk.NewInt64Coin("usdx", 4950000), fee)
+	require.Error(t, err)
+	_, _, err = pool.SwapExactInput(sdk.NewInt64Coin("bnb", 1e4), fee)
+	require.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Querier routes for the swap module
+const (
+	QueryGetParams   = "params"
+	QueryGetPool     = "pool"
+	QueryGetPools    = "pools"
+	QueryGetDeposits = "deposits"
+)
+
+// QueryPoolParams params for querying a pool by id
+type QueryPoolParams struct {
+	PoolID string `json:"pool_id" yaml:"pool_id"`
+}
+
+// NewQueryPoolParams creates a new QueryPoolParams
+func NewQueryPoolParams(poolID string) QueryPoolParams {
+	return QueryPoolParams{
+		PoolID: poolID,
+	}
+}
+
+// QueryDepositsParams params for querying share records, filtered by owner and/or pool id
+type QueryDepositsParams struct {
+	Page   int            `json:"page" yaml:"page"`
+	Limit  int            `json:"limit" yaml:"limit"`
+	Owner  sdk.AccAddress `json:"owner" yaml:"owner"`
+	PoolID string         `json:"pool_id" yaml:"pool_id"`
+}
+
+// NewQueryDepositsParams creates a new QueryDepositsParams
+func NewQueryDepositsParams(page, limit int, owner sdk.AccAddress, poolID string) QueryDepositsParams {
+	return QueryDepositsParams{
+		Page:   page,
+		Limit:  limit,
+		Owner:  owner,
+		PoolID: poolID,
+	}
+}
+
+// PoolStatsQueryResult contains a pool and its spot price
+type PoolStatsQueryResult struct {
+	Pool      Pool    `json:"pool" yaml:"pool"`
+	SpotPrice sdk.Dec `json:"spot_price" yaml:"spot_price"`
+}
+
+// NewPoolStatsQueryResult creates a new PoolStatsQueryResult
+func NewPoolStatsQueryResult(pool Pool) PoolStatsQueryResult {
+	return PoolStatsQueryResult{
+		Pool:      pool,
+		SpotPrice: pool.SpotPrice(),
+	}
+}
+
+// PoolStatsQueryResults is a slice of PoolStatsQueryResult
+type PoolStatsQueryResults []PoolStatsQueryResult
+
+// DepositsQueryResult contains a share record and the value of the shares in pool tokens
+type DepositsQueryResult struct {
+	Depositor   sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	PoolID      string         `json:"pool_id" yaml:"pool_id"`
+	SharesOwned sdk.Int        `json:"shares_owned" yaml:"shares_owned"`
+	SharesValue sdk.Coins      `json:"shares_value" yaml:"shares_value"`
+}
+
+// NewDepositsQueryResult creates a new DepositsQueryResult
+func NewDepositsQueryResult(shareRecord ShareRecord, sharesValue sdk.Coins) DepositsQueryResult {
+	return DepositsQueryResult{
+		Depositor:   shareRecord.Depositor,
+		PoolID:      shareRecord.PoolID,
+		SharesOwned: shareRecord.SharesOwned,
+		SharesValue: sharesValue,
+	}
+}
+
+// DepositsQueryResults is a slice of DepositsQueryResult
+type DepositsQueryResults []DepositsQueryResult
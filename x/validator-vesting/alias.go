@@ -13,9 +13,13 @@ import (
 const (
 	ModuleName             = types.ModuleName
 	StoreKey               = types.StoreKey
+	DefaultParamspace      = types.DefaultParamspace
 	QuerierRoute           = types.QuerierRoute
 	QueryCirculatingSupply = types.QueryCirculatingSupply
 	QueryTotalSupply       = types.QueryTotalSupply
+	QueryVestingProgress   = types.QueryVestingProgress
+	QueryFailedPeriods     = types.QueryFailedPeriods
+	QueryGetParams         = types.QueryGetParams
 )
 
 var (
@@ -30,10 +34,16 @@ var (
 	CreateValidators                     = keeper.CreateValidators
 	RegisterCodec                        = types.RegisterCodec
 	NewGenesisState                      = types.NewGenesisState
+	NewParams                            = types.NewParams
+	DefaultParams                        = types.DefaultParams
+	ParamKeyTable                        = types.ParamKeyTable
 	DefaultGenesisState                  = types.DefaultGenesisState
 	ValidateGenesis                      = types.ValidateGenesis
 	ValidatorVestingAccountKey           = types.ValidatorVestingAccountKey
 	NewBaseQueryParams                   = types.NewBaseQueryParams
+	NewQueryAccountParams                = types.NewQueryAccountParams
+	NewPeriodStatus                      = types.NewPeriodStatus
+	NewAccountVestingProgress            = types.NewAccountVestingProgress
 	CreateTestAddrs                      = types.CreateTestAddrs
 	TestAddr                             = types.TestAddr
 	CreateTestPubKeys                    = types.CreateTestPubKeys
@@ -56,14 +66,25 @@ var (
 	ValConsAddr3                  = keeper.ValConsAddr3
 	TestAddrs                     = keeper.TestAddrs
 	ModuleCdc                     = types.ModuleCdc
+	ErrFailedUndelegation         = types.ErrFailedUndelegation
+	ErrAccountNotFound            = types.ErrAccountNotFound
 	BlocktimeKey                  = types.BlocktimeKey
 	ValidatorVestingAccountPrefix = types.ValidatorVestingAccountPrefix
+	KeySigningThreshold           = types.KeySigningThreshold
+	KeySignedBlocksWindow         = types.KeySignedBlocksWindow
+	DefaultSigningThreshold       = types.DefaultSigningThreshold
+	DefaultSignedBlocksWindow     = types.DefaultSignedBlocksWindow
 )
 
 type (
 	Keeper                  = keeper.Keeper
 	GenesisState            = types.GenesisState
+	Params                  = types.Params
 	BaseQueryParams         = types.BaseQueryParams
+	QueryAccountParams      = types.QueryAccountParams
+	PeriodStatus            = types.PeriodStatus
+	PeriodStatuses          = types.PeriodStatuses
+	AccountVestingProgress  = types.AccountVestingProgress
 	VestingProgress         = types.VestingProgress
 	CurrentPeriodProgress   = types.CurrentPeriodProgress
 	ValidatorVestingAccount = types.ValidatorVestingAccount
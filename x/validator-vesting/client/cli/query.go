@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/validator-vesting/types"
 )
@@ -26,6 +29,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		queryCirculatingSupplyUSDX(queryRoute, cdc),
 		queryTotalSupplyHARD(queryRoute, cdc),
 		queryTotalSupplyUSDX(queryRoute, cdc),
+		queryParams(queryRoute, cdc),
+		queryVestingProgress(queryRoute, cdc),
+		queryFailedPeriods(queryRoute, cdc),
 	)...)
 
 	return valVestingQueryCmd
@@ -187,3 +193,112 @@ func queryTotalSupplyUSDX(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func queryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "Get the validator vesting module parameters",
+		Long:  "Get the current signing threshold and signed blocks window of the validator vesting module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetParams), nil)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var out types.Params
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return fmt.Errorf("failed to unmarshal params: %w", err)
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func queryVestingProgress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-progress [address]",
+		Short: "Get the vesting progress of a validator vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the completed, failed, and forthcoming vesting periods of a validator vesting account,
+along with the coins that have been returned or burned so far.
+
+Example:
+$ %s query %s vesting-progress kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.ClientName, types.QueryPath)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountParams(address))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryVestingProgress), bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var out types.AccountVestingProgress
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return fmt.Errorf("failed to unmarshal vesting progress: %w", err)
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+func queryFailedPeriods(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "failed-periods [address]",
+		Short: "Get the failed vesting periods of a validator vesting account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the vesting periods of a validator vesting account in which the signing threshold was not met.
+
+Example:
+$ %s query %s failed-periods kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+`, version.ClientName, types.QueryPath)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryAccountParams(address))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFailedPeriods), bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var out types.PeriodStatuses
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return fmt.Errorf("failed to unmarshal failed periods: %w", err)
+			}
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
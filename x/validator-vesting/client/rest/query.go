@@ -8,11 +8,14 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/kava-labs/kava/x/validator-vesting/types"
 )
 
+const restAddress = "address"
+
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/circulatingsupply", types.QueryPath), getCirculatingSupplyHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/totalsupply", types.QueryPath), getTotalSupplyHandlerFn(cliCtx)).Methods("GET")
@@ -20,6 +23,8 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/circulatingsupplyusdx", types.QueryPath), getCirculatingSupplyUSDXHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/totalsupplyhard", types.QueryPath), getTotalSupplyHARDHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/totalsupplyusdx", types.QueryPath), getTotalSupplyUSDXHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/progress/{%s}", types.QueryPath, restAddress), getVestingProgressHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/failedperiods/{%s}", types.QueryPath, restAddress), getFailedPeriodsHandlerFn(cliCtx)).Methods("GET")
 }
 
 func getTotalSupplyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -270,3 +275,44 @@ func getTotalSupplyUSDXHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		w.Write(resBytes)
 	}
 }
+
+func getVestingProgressHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryAccountHandler(w, r, cliCtx, types.QueryVestingProgress)
+	}
+}
+
+func getFailedPeriodsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		queryAccountHandler(w, r, cliCtx, types.QueryFailedPeriods)
+	}
+}
+
+func queryAccountHandler(w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext, queryType string) {
+	cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+	if !ok {
+		return
+	}
+
+	vars := mux.Vars(r)
+	address, err := sdk.AccAddressFromBech32(vars[restAddress])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryAccountParams(address))
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
+		return
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, queryType)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
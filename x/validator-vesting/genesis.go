@@ -1,6 +1,8 @@
 package validatorvesting
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/validator-vesting/types"
@@ -9,6 +11,11 @@ import (
 // InitGenesis stores the account address of each ValidatorVestingAccount in the validator vesting keeper, for faster lookup.
 // CONTRACT: Accounts must have already been initialized/created by AccountKeeper
 func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeeper, data GenesisState) {
+	if err := types.ValidateGenesis(data); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", types.ModuleName, err))
+	}
+
+	keeper.SetParams(ctx, data.Params)
 
 	accounts := accountKeeper.GetAllAccounts(ctx)
 	for _, a := range accounts {
@@ -17,11 +24,14 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, accountKeeper types.AccountKeep
 			keeper.SetValidatorVestingAccountKey(ctx, vv.Address)
 		}
 	}
+	// accounts created before the signing threshold was moved to params are migrated to the param value
+	keeper.MigrateSigningThresholds(ctx)
 	keeper.SetPreviousBlockTime(ctx, data.PreviousBlockTime)
 }
 
-// ExportGenesis returns empty genesis state because auth exports all the genesis state we need.
+// ExportGenesis returns the module params and previous block time, auth exports the accounts.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	params := keeper.GetParams(ctx)
 	prevBlockTime := keeper.GetPreviousBlockTime(ctx)
-	return NewGenesisState(prevBlockTime)
+	return NewGenesisState(params, prevBlockTime)
 }
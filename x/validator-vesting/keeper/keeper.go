@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params/subspace"
 	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -18,6 +19,7 @@ import (
 type Keeper struct {
 	storeKey      sdk.StoreKey
 	cdc           *codec.Codec
+	paramSubspace subspace.Subspace
 	ak            types.AccountKeeper
 	bk            types.BankKeeper
 	supplyKeeper  types.SupplyKeeper
@@ -25,11 +27,15 @@ type Keeper struct {
 }
 
 // NewKeeper creates a new Keeper instance
-func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, sk types.SupplyKeeper, stk types.StakingKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, ak types.AccountKeeper, bk types.BankKeeper, sk types.SupplyKeeper, stk types.StakingKeeper) Keeper {
+	if !paramstore.HasKeyTable() {
+		paramstore = paramstore.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
+		paramSubspace: paramstore,
 		ak:            ak,
 		bk:            bk,
 		supplyKeeper:  sk,
@@ -102,6 +108,27 @@ func (k Keeper) GetAccountFromAuthKeeper(ctx sdk.Context, addr sdk.AccAddress) *
 	panic("validator vesting account not found")
 }
 
+// GetValidatorVestingAccount returns a ValidatorVestingAccount from the auth keeper and a boolean for if the account was found
+func (k Keeper) GetValidatorVestingAccount(ctx sdk.Context, addr sdk.AccAddress) (*types.ValidatorVestingAccount, bool) {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, false
+	}
+	vv, ok := acc.(*types.ValidatorVestingAccount)
+	return vv, ok
+}
+
+// GetAccountVestingProgress returns the vesting progress of a validator vesting account and a boolean for if the account was found
+func (k Keeper) GetAccountVestingProgress(ctx sdk.Context, addr sdk.AccAddress) (types.AccountVestingProgress, bool) {
+	vv, found := k.GetValidatorVestingAccount(ctx, addr)
+	if !found {
+		return types.AccountVestingProgress{}, false
+	}
+	progress := types.NewAccountVestingProgress(*vv)
+	progress.SigningThreshold = k.GetParams(ctx).SigningThreshold
+	return progress, true
+}
+
 // UpdateMissingSignCount increments the count of blocks missed during the current period
 func (k Keeper) UpdateMissingSignCount(ctx sdk.Context, addr sdk.AccAddress, missedBlock bool) {
 	vv := k.GetAccountFromAuthKeeper(ctx, addr)
@@ -112,14 +139,30 @@ func (k Keeper) UpdateMissingSignCount(ctx sdk.Context, addr sdk.AccAddress, mis
 	k.ak.SetAccount(ctx, vv)
 }
 
+// MigrateSigningThresholds sets the signing threshold of every validator vesting account to the
+// value of the SigningThreshold param, so that the threshold stored on accounts matches the threshold
+// that is enforced by the module.
+func (k Keeper) MigrateSigningThresholds(ctx sdk.Context) {
+	threshold := k.GetParams(ctx).SigningThreshold
+	for _, key := range k.GetAllAccountKeys(ctx) {
+		vv := k.GetAccountFromAuthKeeper(ctx, key)
+		if vv.SigningThreshold == threshold {
+			continue
+		}
+		vv.SigningThreshold = threshold
+		k.ak.SetAccount(ctx, vv)
+	}
+}
+
 // UpdateVestedCoinsProgress sets the VestingPeriodProgress variable (0 = coins did not vest for the period, 1 = coins did vest for the period) for the given address and period. If coins did not vest, those coins are added to DebtAfterFailedVesting. Finally, MissingSignCount is reset to [0,0], representing that the next period has started and no blocks have been missed.
 func (k Keeper) UpdateVestedCoinsProgress(ctx sdk.Context, addr sdk.AccAddress, period int) {
 	vv := k.GetAccountFromAuthKeeper(ctx, addr)
+	params := k.GetParams(ctx)
 	var successfulVest bool
-	if sdk.NewDec(vv.CurrentPeriodProgress.TotalBlocks).IsZero() {
+	if vv.CurrentPeriodProgress.TotalBlocks < params.SignedBlocksWindow {
 		successfulVest = true
 	} else {
-		successfulVest = vv.CurrentPeriodProgress.SignedPercetageIsOverThreshold(vv.SigningThreshold)
+		successfulVest = vv.CurrentPeriodProgress.SignedPercetageIsOverThreshold(params.SigningThreshold)
 	}
 
 	if successfulVest {
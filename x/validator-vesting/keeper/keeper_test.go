@@ -368,3 +368,84 @@ func TestHandleVestingDebtReturn(t *testing.T) {
 	//require that debt is now zero
 	require.Equal(t, sdk.Coins(nil), vva.DebtAfterFailedVesting)
 }
+
+func TestGetAccountVestingProgress(t *testing.T) {
+	ctx, ak, _, _, _, keeper := CreateTestInput(t, false, 1000)
+
+	now := tmtime.Now()
+
+	vva := ValidatorVestingDelegatorTestAccount(now)
+	ak.SetAccount(ctx, vva)
+	keeper.SetValidatorVestingAccountKey(ctx, vva.Address)
+
+	// regular accounts are not found
+	_, found := keeper.GetAccountVestingProgress(ctx, TestAddrs[0])
+	require.False(t, found)
+
+	progress, found := keeper.GetAccountVestingProgress(ctx, vva.Address)
+	require.True(t, found)
+	require.Equal(t, 0, len(progress.CompletedPeriods))
+	require.Equal(t, 0, len(progress.FailedPeriods))
+	require.Equal(t, 3, len(progress.ForthcomingPeriods))
+	require.Equal(t, now.Add(12*time.Hour).Unix(), progress.ForthcomingPeriods[0].EndTime)
+
+	// first period vests, second period fails and the debt is burned
+	keeper.SetVestingProgress(ctx, vva.Address, 0, true)
+	keeper.SetVestingProgress(ctx, vva.Address, 1, false)
+	keeper.AddDebt(ctx, vva.Address, vva.VestingPeriods[1].Amount)
+
+	progress, _ = keeper.GetAccountVestingProgress(ctx, vva.Address)
+	require.Equal(t, 1, len(progress.CompletedPeriods))
+	require.Equal(t, 1, len(progress.FailedPeriods))
+	require.Equal(t, 1, progress.FailedPeriods[0].Index)
+	require.Equal(t, 1, len(progress.ForthcomingPeriods))
+	require.Equal(t, vva.VestingPeriods[1].Amount, progress.OutstandingDebt)
+	require.True(t, progress.CoinsBurned.IsZero())
+
+	keeper.ResetDebt(ctx, vva.Address)
+	progress, _ = keeper.GetAccountVestingProgress(ctx, vva.Address)
+	require.Equal(t, vva.VestingPeriods[1].Amount, progress.CoinsBurned)
+	require.True(t, progress.CoinsReturned.IsZero())
+	require.True(t, progress.OutstandingDebt.IsZero())
+}
+
+func TestUpdateVestedCoinsProgressParams(t *testing.T) {
+	ctx, ak, _, _, _, keeper := CreateTestInput(t, false, 1000)
+
+	now := tmtime.Now()
+
+	vva := ValidatorVestingDelegatorTestAccount(now)
+	ak.SetAccount(ctx, vva)
+	keeper.SetValidatorVestingAccountKey(ctx, vva.Address)
+
+	// the account threshold is migrated to the param value
+	keeper.SetParams(ctx, types.NewParams(50, 10))
+	keeper.MigrateSigningThresholds(ctx)
+	vva = keeper.GetAccountFromAuthKeeper(ctx, vva.Address)
+	require.Equal(t, int64(50), vva.SigningThreshold)
+
+	// periods spanning fewer blocks than the window vest unconditionally
+	for i := 0; i < 9; i++ {
+		keeper.UpdateMissingSignCount(ctx, vva.Address, true)
+	}
+	keeper.UpdateVestedCoinsProgress(ctx, vva.Address, 0)
+	vva = keeper.GetAccountFromAuthKeeper(ctx, vva.Address)
+	require.Equal(t, types.VestingProgress{PeriodComplete: true, VestingSuccessful: true}, vva.VestingPeriodProgress[0])
+
+	// 60% signed is above the 50% threshold
+	for i := 0; i < 10; i++ {
+		keeper.UpdateMissingSignCount(ctx, vva.Address, i < 4)
+	}
+	keeper.UpdateVestedCoinsProgress(ctx, vva.Address, 1)
+	vva = keeper.GetAccountFromAuthKeeper(ctx, vva.Address)
+	require.Equal(t, types.VestingProgress{PeriodComplete: true, VestingSuccessful: true}, vva.VestingPeriodProgress[1])
+
+	// 40% signed is below the 50% threshold
+	for i := 0; i < 10; i++ {
+		keeper.UpdateMissingSignCount(ctx, vva.Address, i < 6)
+	}
+	keeper.UpdateVestedCoinsProgress(ctx, vva.Address, 2)
+	vva = keeper.GetAccountFromAuthKeeper(ctx, vva.Address)
+	require.Equal(t, types.VestingProgress{PeriodComplete: true, VestingSuccessful: false}, vva.VestingPeriodProgress[2])
+	require.Equal(t, vva.VestingPeriods[2].Amount, vva.DebtAfterFailedVesting)
+}
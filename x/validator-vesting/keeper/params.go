@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/validator-vesting/types"
+)
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var p types.Params
+	k.paramSubspace.GetParamSet(ctx, &p)
+	return p
+}
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// InitializeParams sets the params to their defaults if they have not been set, such as on chains that were started
+// before the validator vesting module had params
+func (k Keeper) InitializeParams(ctx sdk.Context) {
+	if k.paramSubspace.Has(ctx, types.KeySigningThreshold) {
+		return
+	}
+	k.SetParams(ctx, types.DefaultParams())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -27,6 +28,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return getTotalSupplyHARD(ctx, req, keeper)
 		case types.QueryTotalSupplyUSDX:
 			return getCirculatingSupplyUSDX(ctx, req, keeper) // Intentional - USDX total supply is the circulating supply
+		case types.QueryGetParams:
+			return queryGetParams(ctx, req, keeper)
+		case types.QueryVestingProgress:
+			return queryGetVestingProgress(ctx, req, keeper)
+		case types.QueryFailedPeriods:
+			return queryGetFailedPeriods(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -275,3 +282,51 @@ func getTotalSupplyHARD(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	}
 	return bz, nil
 }
+
+func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetVestingProgress(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAccountParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	progress, found := keeper.GetAccountVestingProgress(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotFound, "%s", params.Address)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, progress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetFailedPeriods(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAccountParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	progress, found := keeper.GetAccountVestingProgress(ctx, params.Address)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNotFound, "%s", params.Address)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, progress.FailedPeriods)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
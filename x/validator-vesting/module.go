@@ -52,7 +52,7 @@ func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
 	if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
 		return err
 	}
-	return types.ValidateGenesis(data)
+	return types.ValidateGenesis(data.WithDefaultParams())
 }
 
 // RegisterRESTRoutes registers no REST routes for the crisis module.
@@ -114,7 +114,7 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
 	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
-	InitGenesis(ctx, am.keeper, am.accountKeeper, genesisState)
+	InitGenesis(ctx, am.keeper, am.accountKeeper, genesisState.WithDefaultParams())
 	return []abci.ValidatorUpdate{}
 }
 
@@ -150,9 +150,9 @@ func (AppModuleBasic) ProposalContents(_ module.SimulationState) []sim.WeightedP
 	return nil
 }
 
-// RandomizedParams returns nil because validatorvesting has no params.
-func (AppModuleBasic) RandomizedParams(_ *rand.Rand) []sim.ParamChange {
-	return []sim.ParamChange{}
+// RandomizedParams creates randomized validator vesting param changes for the simulator.
+func (AppModuleBasic) RandomizedParams(r *rand.Rand) []sim.ParamChange {
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder for auth module's types
@@ -74,7 +74,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 	}
 	newAuthGenesis := authtypes.NewGenesisState(authGenState.Params, newGenesisAccs)
 	simState.GenState[authtypes.ModuleName] = simState.Cdc.MustMarshalJSON(newAuthGenesis)
-	vestGenState := types.DefaultGenesisState()
+	params := types.NewParams(genRandomSigningThreshold(simState.Rand), genRandomSignedBlocksWindow(simState.Rand))
+	vestGenState := types.NewGenesisState(params, types.DefaultGenesisState().PreviousBlockTime)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(vestGenState)
 }
 
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/kava-labs/kava/x/validator-vesting/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySigningThreshold),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genRandomSigningThreshold(r))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.KeySignedBlocksWindow),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genRandomSignedBlocksWindow(r))
+			},
+		),
+	}
+}
+
+func genRandomSigningThreshold(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 100))
+}
+
+func genRandomSignedBlocksWindow(r *rand.Rand) int64 {
+	return int64(simulation.RandIntBetween(r, 1, 10))
+}
@@ -74,7 +74,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState types.GenesisState, genAc
 	)
 
 	keeper := keeper.NewKeeper(
-		mApp.Cdc, keyValidatorVesting, mApp.AccountKeeper, bk, supplyKeeper, sk)
+		mApp.Cdc, keyValidatorVesting, pk.Subspace(types.DefaultParamspace), mApp.AccountKeeper, bk, supplyKeeper, sk)
 
 	mApp.SetBeginBlocker(getBeginBlocker(keeper))
 	mApp.SetInitChainer(getInitChainer(mApp, keeper, sk, supplyKeeper, genAccs, genState,
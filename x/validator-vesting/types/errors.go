@@ -9,4 +9,6 @@ import (
 var (
 	// ErrFailedUndelegation error for delegations that fail to unbond
 	ErrFailedUndelegation = sdkerrors.Register(ModuleName, 2, "undelegation failed")
+	// ErrAccountNotFound error for accounts that are not validator vesting accounts
+	ErrAccountNotFound = sdkerrors.Register(ModuleName, 3, "validator vesting account not found")
 )
@@ -10,19 +10,21 @@ import (
 
 // GenesisState - all auth state that must be provided at genesis
 type GenesisState struct {
+	Params            Params    `json:"params" yaml:"params"`
 	PreviousBlockTime time.Time `json:"previous_block_time" yaml:"previous_block_time"`
 }
 
 // NewGenesisState - Create a new genesis state
-func NewGenesisState(prevBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, prevBlockTime time.Time) GenesisState {
 	return GenesisState{
+		Params:            params,
 		PreviousBlockTime: prevBlockTime,
 	}
 }
 
 // DefaultGenesisState - Return a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(tmtime.Canonical(time.Unix(1, 0)))
+	return NewGenesisState(DefaultParams(), tmtime.Canonical(time.Unix(1, 0)))
 }
 
 // Equal checks whether two gov GenesisState structs are equivalent
@@ -37,8 +39,20 @@ func (data GenesisState) IsEmpty() bool {
 	return data.Equal(GenesisState{})
 }
 
+// WithDefaultParams returns the genesis state with default params if it has none, such as a genesis state exported
+// before the validator vesting module had params
+func (data GenesisState) WithDefaultParams() GenesisState {
+	if data.Params == (Params{}) {
+		data.Params = DefaultParams()
+	}
+	return data
+}
+
 // ValidateGenesis returns nil because accounts are validated by auth
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	if data.PreviousBlockTime.Unix() <= 0 {
 		return errors.New("previous block time cannot be zero")
 	}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/validator-vesting/types"
+)
+
+func TestGenesisStateWithDefaultParams(t *testing.T) {
+	// a genesis state exported before params were added has no params field
+	var gs types.GenesisState
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON([]byte(`{"previous_block_time":"2020-01-01T00:00:00Z"}`), &gs))
+	require.Error(t, types.ValidateGenesis(gs))
+
+	gs = gs.WithDefaultParams()
+	require.Equal(t, types.DefaultParams(), gs.Params)
+	require.NoError(t, types.ValidateGenesis(gs))
+
+	// params that are set are kept
+	params := types.NewParams(50, 10)
+	gs = types.NewGenesisState(params, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).WithDefaultParams()
+	require.Equal(t, params, gs.Params)
+}
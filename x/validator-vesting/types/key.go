@@ -16,6 +16,9 @@ const (
 
 	// QueryPath shortened name for public API (cli and REST)
 	QueryPath = "vesting"
+
+	// DefaultParamspace default name for parameter store
+	DefaultParamspace = ModuleName
 )
 
 var (
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter keys and default values
+var (
+	KeySigningThreshold        = []byte("SigningThreshold")
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	DefaultSigningThreshold    = int64(90)
+	DefaultSignedBlocksWindow  = int64(1)
+	MaxSigningThresholdPercent = int64(100)
+)
+
+// Params governance parameters for the validator vesting module
+type Params struct {
+	// SigningThreshold the percentage (0 to 100) of blocks that must be signed during a vesting period for the period to vest
+	SigningThreshold int64 `json:"signing_threshold" yaml:"signing_threshold"`
+	// SignedBlocksWindow the minimum number of blocks a vesting period must span before the signing threshold is enforced.
+	// Periods that span fewer blocks vest unconditionally.
+	SignedBlocksWindow int64 `json:"signed_blocks_window" yaml:"signed_blocks_window"`
+}
+
+// NewParams returns a new params object
+func NewParams(signingThreshold, signedBlocksWindow int64) Params {
+	return Params{
+		SigningThreshold:   signingThreshold,
+		SignedBlocksWindow: signedBlocksWindow,
+	}
+}
+
+// DefaultParams returns default params for the validator vesting module
+func DefaultParams() Params {
+	return NewParams(DefaultSigningThreshold, DefaultSignedBlocksWindow)
+}
+
+// String implements fmt.Stringer
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+	Signing Threshold: %d
+	Signed Blocks Window: %d`, p.SigningThreshold, p.SignedBlocksWindow)
+}
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeySigningThreshold, &p.SigningThreshold, validateSigningThresholdParam),
+		params.NewParamSetPair(KeySignedBlocksWindow, &p.SignedBlocksWindow, validateSignedBlocksWindowParam),
+	}
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if err := validateSigningThresholdParam(p.SigningThreshold); err != nil {
+		return err
+	}
+
+	return validateSignedBlocksWindowParam(p.SignedBlocksWindow)
+}
+
+func validateSigningThresholdParam(i interface{}) error {
+	threshold, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if threshold < 0 || threshold > MaxSigningThresholdPercent {
+		return fmt.Errorf("signing threshold must be between 0 and %d: %d", MaxSigningThresholdPercent, threshold)
+	}
+
+	return nil
+}
+
+func validateSignedBlocksWindowParam(i interface{}) error {
+	window, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if window < 1 {
+		return fmt.Errorf("signed blocks window must be positive: %d", window)
+	}
+
+	return nil
+}
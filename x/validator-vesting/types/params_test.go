@@ -0,0 +1,73 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/kava-labs/kava/x/validator-vesting/types"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ParamsTestSuite) TestParamValidation() {
+	type args struct {
+		signingThreshold   int64
+		signedBlocksWindow int64
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr string
+	}{
+		{
+			name:        "default",
+			args:        args{types.DefaultSigningThreshold, types.DefaultSignedBlocksWindow},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "zero threshold",
+			args:        args{0, 10},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name:        "threshold above 100",
+			args:        args{101, 10},
+			expectPass:  false,
+			expectedErr: "signing threshold must be between 0 and 100",
+		},
+		{
+			name:        "negative threshold",
+			args:        args{-1, 10},
+			expectPass:  false,
+			expectedErr: "signing threshold must be between 0 and 100",
+		},
+		{
+			name:        "zero window",
+			args:        args{90, 0},
+			expectPass:  false,
+			expectedErr: "signed blocks window must be positive",
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := types.NewParams(tc.args.signingThreshold, tc.args.signedBlocksWindow)
+			err := params.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+				suite.Contains(err.Error(), tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Querier routes for the validator vesting module
 const (
 	QueryCirculatingSupply     = "circulating-supply"
@@ -8,6 +12,9 @@ const (
 	QueryCirculatingSupplyUSDX = "circulating-supply-usdx"
 	QueryTotalSupplyHARD       = "total-supply-hard"
 	QueryTotalSupplyUSDX       = "total-supply-usdx"
+	QueryVestingProgress       = "vesting-progress"
+	QueryFailedPeriods         = "failed-periods"
+	QueryGetParams             = "params"
 )
 
 // BaseQueryParams defines the parameters necessary for querying for all Evidence.
@@ -23,3 +30,100 @@ func NewBaseQueryParams(page, limit int) BaseQueryParams {
 		Limit: limit,
 	}
 }
+
+// QueryAccountParams defines the parameters necessary for querying a single validator vesting account
+type QueryAccountParams struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryAccountParams returns a new QueryAccountParams
+func NewQueryAccountParams(address sdk.AccAddress) QueryAccountParams {
+	return QueryAccountParams{
+		Address: address,
+	}
+}
+
+// PeriodStatus describes the state of a single vesting period of a validator vesting account
+type PeriodStatus struct {
+	Index             int       `json:"index" yaml:"index"`
+	EndTime           int64     `json:"end_time" yaml:"end_time"`
+	Amount            sdk.Coins `json:"amount" yaml:"amount"`
+	PeriodComplete    bool      `json:"period_complete" yaml:"period_complete"`
+	VestingSuccessful bool      `json:"vesting_successful" yaml:"vesting_successful"`
+}
+
+// NewPeriodStatus returns a new PeriodStatus
+func NewPeriodStatus(index int, endTime int64, amount sdk.Coins, progress VestingProgress) PeriodStatus {
+	return PeriodStatus{
+		Index:             index,
+		EndTime:           endTime,
+		Amount:            amount,
+		PeriodComplete:    progress.PeriodComplete,
+		VestingSuccessful: progress.VestingSuccessful,
+	}
+}
+
+// PeriodStatuses slice of PeriodStatus
+type PeriodStatuses []PeriodStatus
+
+// AccountVestingProgress summarizes the vesting progress of a validator vesting account
+type AccountVestingProgress struct {
+	Address               sdk.AccAddress        `json:"address" yaml:"address"`
+	ValidatorAddress      sdk.ConsAddress       `json:"validator_address" yaml:"validator_address"`
+	ReturnAddress         sdk.AccAddress        `json:"return_address" yaml:"return_address"`
+	SigningThreshold      int64                 `json:"signing_threshold" yaml:"signing_threshold"`
+	CurrentPeriodProgress CurrentPeriodProgress `json:"current_period_progress" yaml:"current_period_progress"`
+	CompletedPeriods      PeriodStatuses        `json:"completed_periods" yaml:"completed_periods"`
+	FailedPeriods         PeriodStatuses        `json:"failed_periods" yaml:"failed_periods"`
+	ForthcomingPeriods    PeriodStatuses        `json:"forthcoming_periods" yaml:"forthcoming_periods"`
+	CoinsReturned         sdk.Coins             `json:"coins_returned" yaml:"coins_returned"`
+	CoinsBurned           sdk.Coins             `json:"coins_burned" yaml:"coins_burned"`
+	OutstandingDebt       sdk.Coins             `json:"outstanding_debt" yaml:"outstanding_debt"`
+}
+
+// NewAccountVestingProgress computes the vesting progress of the input validator vesting account
+func NewAccountVestingProgress(vva ValidatorVestingAccount) AccountVestingProgress {
+	progress := AccountVestingProgress{
+		Address:               vva.Address,
+		ValidatorAddress:      vva.ValidatorAddress,
+		ReturnAddress:         vva.ReturnAddress,
+		SigningThreshold:      vva.SigningThreshold,
+		CurrentPeriodProgress: vva.CurrentPeriodProgress,
+		CompletedPeriods:      PeriodStatuses{},
+		FailedPeriods:         PeriodStatuses{},
+		ForthcomingPeriods:    PeriodStatuses{},
+		CoinsReturned:         sdk.NewCoins(),
+		CoinsBurned:           sdk.NewCoins(),
+		OutstandingDebt:       vva.DebtAfterFailedVesting,
+	}
+
+	endTime := vva.StartTime
+	for i, p := range vva.VestingPeriods {
+		endTime += p.Length
+		status := NewPeriodStatus(i, endTime, p.Amount, vva.VestingPeriodProgress[i])
+		switch {
+		case !status.PeriodComplete:
+			progress.ForthcomingPeriods = append(progress.ForthcomingPeriods, status)
+		case status.VestingSuccessful:
+			progress.CompletedPeriods = append(progress.CompletedPeriods, status)
+		default:
+			progress.FailedPeriods = append(progress.FailedPeriods, status)
+		}
+	}
+
+	// coins from failed periods which are no longer owed as debt have already been returned or burned
+	failedCoins := sdk.NewCoins()
+	for _, p := range progress.FailedPeriods {
+		failedCoins = failedCoins.Add(p.Amount...)
+	}
+	settledCoins := sdk.NewCoins()
+	if failedCoins.IsAllGTE(vva.DebtAfterFailedVesting) {
+		settledCoins = failedCoins.Sub(vva.DebtAfterFailedVesting)
+	}
+	if vva.ReturnAddress != nil {
+		progress.CoinsReturned = settledCoins
+	} else {
+		progress.CoinsBurned = settledCoins
+	}
+	return progress
+}